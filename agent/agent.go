@@ -60,6 +60,11 @@ type Agent struct {
 	// completion.
 	addMetadataTagResp  func(*triggerAddMetadataTagResp)
 	addMetadataTagMutex sync.RWMutex
+	// taskAPIContext is the context of the running task, which the status
+	// server's task API routes act on. It's nil while no task is running.
+	taskAPIContext      *taskContext
+	taskAPIMutex        sync.RWMutex
+	taskAPIResultsMutex sync.Mutex
 	tracer              trace.Tracer
 	otelGrpcConn        *grpc.ClientConn
 	closers             []closerOp
@@ -639,8 +644,12 @@ func (a *Agent) startLogging(ctx context.Context, tc *taskContext) error {
 	taskLogDir := filepath.Join(a.opts.WorkingDirectory, taskLogDirectory)
 	grip.Error(errors.Wrapf(os.RemoveAll(taskLogDir), "removing task log directory '%s'", taskLogDir))
 	tc.logger, err = a.makeLoggerProducer(ctx, tc, "")
+	if err != nil {
+		return errors.Wrap(err, "making the logger producer")
+	}
+	tc.sections = newLogSectionRecorder(ctx, &tc.taskConfig.Task, tc.logger.Execution())
 
-	return errors.Wrap(err, "making the logger producer")
+	return nil
 }
 
 // runTask runs a task. It returns true if the agent should exit.
@@ -671,6 +680,9 @@ func (a *Agent) runTask(ctx context.Context, tcInput *taskContext, nt *apimodels
 
 	defer a.killProcs(ctx, tc, false, "task is finished")
 
+	a.setTaskAPIContext(tc)
+	defer a.setTaskAPIContext(nil)
+
 	grip.Info(message.Fields{
 		"message": "running task",
 		"task_id": tc.task.ID,
@@ -850,6 +862,8 @@ func (a *Agent) runTaskCommands(ctx context.Context, tc *taskContext) error {
 		return err
 	}
 
+	a.resumeFromCheckpoint(ctx, tc, task.Commands)
+
 	mainTask := commandBlock{
 		block:       command.MainTaskBlock,
 		commands:    &model.YAMLCommandSet{MultiCommand: task.Commands},
@@ -917,6 +931,10 @@ func (a *Agent) runTeardownGroupCommands(ctx context.Context, tc *taskContext) {
 	defer a.killProcs(ctx, tc, true, "teardown group commands are finished")
 
 	defer func() {
+		sectionsCtx, sectionsCancel := context.WithTimeout(context.WithoutCancel(ctx), logSectionFlushTimeout)
+		defer sectionsCancel()
+		tc.sections.flush(sectionsCtx)
+
 		if tc.logger != nil {
 			// If the logger from the task is still open, running the teardown
 			// group is the last thing that a task can do, so close the logger
@@ -1005,6 +1023,7 @@ func (a *Agent) finishTask(ctx context.Context, tc *taskContext, status string,
 
 		detail.PostErrored = tc.getPostErrored()
 		detail.OtherFailingCommands = tc.getOtherFailingCommands()
+		detail.RetriedCommands = tc.getRetriedCommands()
 
 	case evergreen.TaskFailed:
 		a.handleTimeoutAndOOM(ctx, tc, detail, status)
@@ -1017,6 +1036,7 @@ func (a *Agent) finishTask(ctx context.Context, tc *taskContext, status string,
 
 		detail.PostErrored = tc.getPostErrored()
 		detail.OtherFailingCommands = tc.getOtherFailingCommands()
+		detail.RetriedCommands = tc.getRetriedCommands()
 
 	case evergreen.TaskSystemFailed:
 		// This is a special status indicating that the agent failed for reasons
@@ -1057,6 +1077,11 @@ func (a *Agent) finishTask(ctx context.Context, tc *taskContext, status string,
 
 	a.killProcs(ctx, tc, false, "task is ending")
 
+	// Write the remaining log sections even if the task was canceled.
+	sectionsCtx, sectionsCancel := context.WithTimeout(context.WithoutCancel(ctx), logSectionFlushTimeout)
+	defer sectionsCancel()
+	tc.sections.flush(sectionsCtx)
+
 	if tc.logger != nil {
 		tc.logger.Execution().Infof("Sending final task status: '%s'.", detail.Status)
 		flushCtx, cancel := context.WithTimeout(ctx, time.Minute)
@@ -1220,6 +1245,7 @@ func setEndTaskFailureDetails(tc *taskContext, detail *apimodels.TaskEndDetail,
 	}
 
 	detail.OtherFailingCommands = tc.getOtherFailingCommands()
+	detail.RetriedCommands = tc.getRetriedCommands()
 
 	if !detail.TimedOut {
 		// Only set timeout details if a prior command in the task hasn't
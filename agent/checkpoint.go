@@ -0,0 +1,63 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/pkg/errors"
+)
+
+// resumeFromCheckpoint restores the checkpoint that the task's previous
+// execution saved before its host was lost, if any, so that the task skips
+// the main task commands that already completed. If the checkpoint cannot be
+// restored, the task starts over.
+func (a *Agent) resumeFromCheckpoint(ctx context.Context, tc *taskContext, commands []model.PluginCommandConf) {
+	if tc.taskConfig.Task.Execution == 0 || !command.CheckpointsEnabled(tc.taskConfig) {
+		return
+	}
+
+	checkpoint, err := a.comm.GetTaskCheckpoint(ctx, tc.task)
+	if err != nil {
+		tc.logger.Execution().Warning(errors.Wrap(err, "getting checkpoint to resume from"))
+		return
+	}
+	if checkpoint == nil {
+		return
+	}
+	if !hasMainTaskCommand(tc, commands, checkpoint.Command) {
+		tc.logger.Task().Warningf("Not resuming from checkpoint '%s' because the command that saved it (%s) is not in the task's commands, starting over.", checkpoint.Step, checkpoint.Command)
+		return
+	}
+
+	tc.logger.Task().Infof("Resuming from checkpoint '%s' from execution %d.", checkpoint.Step, checkpoint.Execution)
+	if err := command.RestoreCheckpoint(ctx, a.comm, tc.logger, tc.taskConfig, *checkpoint); err != nil {
+		tc.logger.Task().Warning(errors.Wrapf(err, "restoring checkpoint '%s', starting over", checkpoint.Step))
+		return
+	}
+	tc.logger.Execution().Warning(errors.Wrap(a.comm.MarkTaskResumed(ctx, tc.task), "marking task resumed from checkpoint"))
+
+	tc.setResumeCheckpoint(checkpoint)
+}
+
+// hasMainTaskCommand returns whether any of the task's main commands has the
+// given full display name.
+func hasMainTaskCommand(tc *taskContext, commands []model.PluginCommandConf, fullDisplayName string) bool {
+	for i, commandInfo := range commands {
+		blockInfo := command.BlockInfo{
+			Block:     command.MainTaskBlock,
+			CmdNum:    i + 1,
+			TotalCmds: len(commands),
+		}
+		cmds, err := command.Render(commandInfo, &tc.taskConfig.Project, blockInfo)
+		if err != nil {
+			continue
+		}
+		for _, cmd := range cmds {
+			if cmd.FullDisplayName() == fullDisplayName {
+				return true
+			}
+		}
+	}
+	return false
+}
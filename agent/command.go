@@ -8,7 +8,7 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/agent/command"
 	"github.com/evergreen-ci/evergreen/model"
-	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/evergreen/model/log"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/recovery"
 	"github.com/pkg/errors"
@@ -36,6 +36,16 @@ type runCommandsOptions struct {
 	block command.BlockType
 	// canFailTask indicates whether the command can fail the task.
 	canFailTask bool
+	// blockFailed indicates whether an earlier command in the block failed,
+	// in which case only commands whose conditions check the task's status
+	// run.
+	blockFailed bool
+	// blockInfo is the position of the command or function in its block,
+	// which is needed to render the command again before retrying it.
+	blockInfo command.BlockInfo
+	// cmdIndex is the position of the command in its rendered list of
+	// commands, which is only non-zero for commands in a function.
+	cmdIndex int
 }
 
 // runCommandsInBlock runs all the commands listed in a block (e.g. pre, post).
@@ -83,6 +93,13 @@ func (a *Agent) runCommandsInBlock(ctx context.Context, tc *taskContext, cmdBloc
 		}
 	}
 
+	// End the block's section after recovering from any panic so that the
+	// section fails if the block panics.
+	sectionID := tc.sections.begin(string(cmdBlock.block), log.SectionTypeBlock)
+	defer func() {
+		tc.sections.end(sectionID, sectionStatusFromError(err))
+	}()
+
 	defer func() {
 		op := fmt.Sprintf("running commands for block '%s'", cmdBlock.block)
 		pErr := recovery.HandlePanicWithError(recover(), nil, op)
@@ -102,11 +119,21 @@ func (a *Agent) runCommandsInBlock(ctx context.Context, tc *taskContext, cmdBloc
 		taskLogger.Infof("Finished running %s commands in %s.", legacyBlockName, time.Since(start).String())
 	}()
 
+	// After a command fails, the rest of the block still runs so that
+	// commands that check the task's status (e.g. "if: failure()") can run,
+	// but the block returns the first error.
+	var blockErr error
 	commands := cmdBlock.commands.List()
 	for i, commandInfo := range commands {
 		if err := blockCtx.Err(); err != nil {
+			if blockErr != nil {
+				return blockErr
+			}
 			return errors.Wrap(err, "canceled while running commands")
 		}
+		if blockErr != nil && userEndedTask(tc) {
+			return blockErr
+		}
 		blockInfo := command.BlockInfo{
 			Block:     cmdBlock.block,
 			CmdNum:    i + 1,
@@ -114,18 +141,39 @@ func (a *Agent) runCommandsInBlock(ctx context.Context, tc *taskContext, cmdBloc
 		}
 		cmds, err := command.Render(commandInfo, &tc.taskConfig.Project, blockInfo)
 		if err != nil {
-			return errors.Wrapf(err, "rendering command '%s'", commandInfo.Command)
+			if blockErr == nil {
+				blockErr = errors.Wrapf(err, "rendering command '%s'", commandInfo.Command)
+			}
+			continue
 		}
 		runCmdOpts := runCommandsOptions{
 			block:       cmdBlock.block,
 			canFailTask: cmdBlock.canFailTask,
+			blockFailed: blockErr != nil,
+			blockInfo:   blockInfo,
 		}
-		if err = a.runCommandOrFunc(blockCtx, tc, commandInfo, cmds, runCmdOpts); err != nil {
-			return errors.WithStack(err)
+		if err = a.runCommandOrFunc(blockCtx, tc, commandInfo, cmds, runCmdOpts); err != nil && blockErr == nil {
+			blockErr = errors.WithStack(err)
 		}
 	}
 
-	return errors.WithStack(err)
+	return blockErr
+}
+
+// sectionStatusFromError returns the status of a log section that ended with
+// the given error.
+func sectionStatusFromError(err error) log.SectionStatus {
+	if err != nil {
+		return log.SectionStatusFailed
+	}
+	return log.SectionStatusSucceeded
+}
+
+// userEndedTask returns whether a command explicitly ended the task, in which
+// case no more commands run, even ones that check the task's status.
+func userEndedTask(tc *taskContext) bool {
+	resp := tc.getUserEndTaskResponse()
+	return resp != nil && !resp.ShouldContinue
 }
 
 // blockToLegacyName converts the name of a command block to the name it has
@@ -158,7 +206,7 @@ func (a *Agent) blockToLegacyName(block command.BlockType) string {
 // either be a single standalone command or a list of sub-commands in a
 // function.
 func (a *Agent) runCommandOrFunc(ctx context.Context, tc *taskContext, commandInfo model.PluginCommandConf,
-	cmds []command.Command, options runCommandsOptions) error {
+	cmds []command.Command, options runCommandsOptions) (err error) {
 
 	if commandInfo.Function != "" {
 		var commandSetSpan trace.Span
@@ -166,18 +214,57 @@ func (a *Agent) runCommandOrFunc(ctx context.Context, tc *taskContext, commandIn
 			attribute.String(functionNameAttribute, commandInfo.Function),
 		))
 		defer commandSetSpan.End()
+
+		sectionID := tc.sections.begin(commandInfo.Function, log.SectionTypeFunction)
+		defer func() {
+			tc.sections.end(sectionID, sectionStatusFromError(err))
+		}()
 	}
 
-	for _, cmd := range cmds {
+	var cmdErr error
+	for i, cmd := range cmds {
 		if err := ctx.Err(); err != nil {
+			if cmdErr != nil {
+				return cmdErr
+			}
 			return errors.Wrap(err, "canceled while running command list")
 		}
+		if cmdErr != nil && userEndedTask(tc) {
+			return cmdErr
+		}
+
+		if options.block == command.MainTaskBlock {
+			if step, skip := tc.skipCompletedCommand(cmd); skip {
+				tc.logger.Task().Infof("Skipping command %s because it completed before checkpoint '%s'.", cmd.FullDisplayName(), step)
+				continue
+			}
+		}
 
 		if !commandInfo.RunOnVariant(tc.taskConfig.BuildVariant.Name) {
 			tc.logger.Task().Infof("Skipping command %s on variant %s.", cmd.FullDisplayName(), tc.taskConfig.BuildVariant.Name)
 			continue
 		}
 
+		if (options.blockFailed || cmdErr != nil) && !command.RunsAfterFailure(cmd.Conditions()) {
+			tc.logger.Task().Infof("Skipping command %s because an earlier command failed.", cmd.FullDisplayName())
+			continue
+		}
+
+		met, err := command.EvaluateConditions(cmd.Conditions(), command.ConditionState{
+			Expansions: &tc.taskConfig.Expansions,
+			Failed:     tc.hasFailingCommand() || options.blockFailed || cmdErr != nil,
+		})
+		if err != nil {
+			if cmdErr == nil {
+				cmdErr = errors.Wrapf(err, "evaluating condition for command %s", cmd.FullDisplayName())
+			}
+			continue
+		}
+		if !met {
+			tc.logger.Task().Infof("Skipping command %s because its condition was not met.", cmd.FullDisplayName())
+			continue
+		}
+
 		tc.logger.Task().Infof("Running command %s.", cmd.FullDisplayName())
 
 		ctx, commandSpan := a.tracer.Start(ctx, cmd.Name(), trace.WithAttributes(
@@ -190,7 +277,10 @@ func (a *Agent) runCommandOrFunc(ctx context.Context, tc *taskContext, commandIn
 
 		cmd.SetJasperManager(a.jasper)
 
-		if err := a.runCommand(ctx, tc, commandInfo, cmd, options); err != nil {
+		cmdOptions := options
+		cmdOptions.blockFailed = options.blockFailed || cmdErr != nil
+		cmdOptions.cmdIndex = i
+		if err := a.runCommand(ctx, tc, commandInfo, cmd, cmdOptions); err != nil {
 			commandSpan.SetStatus(codes.Error, "running command")
 			commandSpan.RecordError(err, trace.WithAttributes(tc.taskConfig.TaskAttributes()...))
 			commandSpan.End()
@@ -200,11 +290,14 @@ func (a *Agent) runCommandOrFunc(ctx context.Context, tc *taskContext, commandIn
 					tc.logger.Task().Errorf("Encountered error marking task to restart upon completion: %s", restartErr)
 				}
 			}
-			return errors.Wrap(err, "running command")
+			if cmdErr == nil {
+				cmdErr = errors.Wrap(err, "running command")
+			}
+			continue
 		}
 		commandSpan.End()
 	}
-	return nil
+	return cmdErr
 }
 
 // runCommand runs a single command, which is either a standalone command or a
@@ -225,8 +318,8 @@ func (a *Agent) runCommand(ctx context.Context, tc *taskContext, commandInfo mod
 	defer func() {
 		// This defer ensures that the function vars do not persist in the expansions after the function is over
 		// unless they were updated using expansions.update
+		updatedExpansions := tc.taskConfig.GetAndClearDynamicExpansions()
 		if cmd.Name() == "expansions.update" {
-			updatedExpansions := tc.taskConfig.DynamicExpansions.Map()
 			for k := range updatedExpansions {
 				if _, ok := commandInfo.Vars[k]; ok {
 					// If expansions.update updated this key, don't reset it
@@ -235,10 +328,13 @@ func (a *Agent) runCommand(ctx context.Context, tc *taskContext, commandInfo mod
 			}
 		}
 		tc.taskConfig.NewExpansions.Update(prevExp)
-		tc.taskConfig.DynamicExpansions = *util.NewExpansions(map[string]string{})
 	}()
 
-	tc.setCurrentCommand(cmd)
+	if !options.blockFailed {
+		// The current command is reported as the task's failing command, so
+		// commands that run after a failure must not replace it.
+		tc.setCurrentCommand(cmd)
+	}
 	switch options.block {
 	case command.PreBlock, command.SetupGroupBlock, command.SetupTaskBlock, command.MainTaskBlock:
 		// Only set the idle timeout in cases where the idle timeout is actually
@@ -260,6 +356,13 @@ func (a *Agent) runCommand(ctx context.Context, tc *taskContext, commandInfo mod
 		}
 	}()
 
+	sectionID := tc.sections.begin(cmd.FullDisplayName(), log.SectionTypeCommand)
+	sectionStatus := log.SectionStatusSucceeded
+	defer func() {
+		tc.sections.end(sectionID, sectionStatus)
+	}()
+	profiler := startCommandProfiler(ctx, a.jasper, cmd.FullDisplayName(), options.block, commandProfileInterval)
+	defer a.finishCommandProfile(ctx, tc, profiler)
 	start := time.Now()
 	defer func() {
 		tc.logger.Task().Infof("Finished command %s in %s.", cmd.FullDisplayName(), time.Since(start).String())
@@ -285,13 +388,14 @@ func (a *Agent) runCommand(ctx context.Context, tc *taskContext, commandInfo mod
 			cmdChan <- pErr
 		}()
 
-		cmdChan <- cmd.Execute(ctx, a.comm, tc.logger, tc.taskConfig)
+		cmdChan <- a.executeWithRetries(ctx, tc, commandInfo, cmd, options)
 	}()
 
 	select {
 	case err := <-cmdChan:
 		if err != nil {
 			tc.logger.Task().Errorf("Command %s failed: %s.", cmd.FullDisplayName(), err)
+			sectionStatus = log.SectionStatusFailed
 			tc.addFailingCommand(cmd)
 			if options.block == command.PostBlock {
 				tc.setPostErrored(true)
@@ -311,6 +415,7 @@ func (a *Agent) runCommand(ctx context.Context, tc *taskContext, commandInfo mod
 		case <-cmdChan:
 		}
 
+		sectionStatus = log.SectionStatusFailed
 		tc.addFailingCommand(cmd)
 		if options.block == command.PostBlock {
 			tc.setPostErrored(true)
@@ -0,0 +1,117 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/agent/globals"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// cacheRestore restores files that a previous task saved to the build cache
+// with cache.save.
+type cacheRestore struct {
+	// Key is the key of the entry to restore.
+	Key string `mapstructure:"key" plugin:"expand"`
+
+	// RestoreKeys are key prefixes to fall back to, in order, if there's no
+	// entry with the exact key. The most recently saved entry matching the
+	// first prefix with any matches is restored.
+	RestoreKeys []string `mapstructure:"restore_keys" plugin:"expand"`
+
+	// DestDir is the directory to restore the files to.
+	DestDir string `mapstructure:"dest_dir" plugin:"expand"`
+
+	base
+}
+
+func cacheRestoreFactory() Command   { return &cacheRestore{} }
+func (c *cacheRestore) Name() string { return "cache.restore" }
+
+// ParseParams reads in the given parameters for the command.
+func (c *cacheRestore) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(c.Key == "", "key cannot be blank")
+	catcher.NewWhen(c.DestDir == "", "destination directory cannot be blank")
+	return catcher.Resolve()
+}
+
+// Execute restores the matching build cache entry, if any, and sets the
+// cache_hit and cache_matched_key expansions.
+func (c *cacheRestore) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	req := apimodels.BuildCacheRestoreRequest{Key: c.Key, RestoreKeys: c.RestoreKeys}
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "invalid cache keys after expansion")
+	}
+
+	conf.NewExpansions.Put(globals.CacheHit, strconv.FormatBool(false))
+	conf.NewExpansions.Put(globals.CacheMatchedKey, "")
+	if !buildCacheEnabled(conf) {
+		logger.Task().Warningf("The build cache is not configured, not restoring cache key '%s'.", c.Key)
+		return nil
+	}
+
+	if !filepath.IsAbs(c.DestDir) {
+		c.DestDir = GetWorkingDirectory(conf, c.DestDir)
+	}
+
+	taskData := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	entry, err := comm.RestoreBuildCacheEntry(ctx, taskData, req)
+	if err != nil {
+		return errors.Wrapf(err, "finding cache entry for key '%s'", c.Key)
+	}
+	if entry == nil {
+		logger.Task().Infof("No cache entry found for key '%s'.", c.Key)
+		return nil
+	}
+
+	bucket, err := newBuildCacheBucket(ctx, comm, conf)
+	if err != nil {
+		return errors.Wrap(err, "creating build cache bucket")
+	}
+	tempDir, err := os.MkdirTemp("", "cache_restore")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary directory for archive")
+	}
+	defer func() {
+		logger.Execution().Error(errors.Wrap(os.RemoveAll(tempDir), "removing temporary archive"))
+	}()
+	archivePath := filepath.Join(tempDir, "cache.tar.gz")
+	if err := downloadVerifiedArchive(ctx, bucket, apimodels.BuildCacheBlobKey(conf.Task.Project, entry.SHA256), entry.SHA256, archivePath); err != nil {
+		return errors.Wrapf(err, "downloading cache archive for key '%s'", entry.Key)
+	}
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return errors.Wrapf(err, "opening cache archive for key '%s'", entry.Key)
+	}
+	defer f.Close()
+	if err := extractTarball(ctx, f, c.DestDir, []string{}); err != nil {
+		return errors.Wrapf(err, "extracting cache archive for key '%s'", entry.Key)
+	}
+
+	conf.NewExpansions.Put(globals.CacheHit, strconv.FormatBool(entry.Key == c.Key))
+	conf.NewExpansions.Put(globals.CacheMatchedKey, entry.Key)
+	if entry.Key == c.Key {
+		logger.Task().Infof("Restored cache key '%s'.", entry.Key)
+	} else {
+		logger.Task().Infof("No cache entry found for key '%s', restored cache key '%s' instead.", c.Key, entry.Key)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package command
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// cacheSave saves files to the build cache under a key so that later tasks
+// in the project can restore them with cache.restore.
+type cacheSave struct {
+	// Key is the key to save the files under. Keys are immutable, so if an
+	// entry with the key already exists, nothing is saved.
+	Key string `mapstructure:"key" plugin:"expand"`
+
+	// SourceDir is the directory containing the files to save.
+	SourceDir string `mapstructure:"source_dir" plugin:"expand"`
+
+	// Include is a list of filename blobs to save, e.g. "**", "vendor/**".
+	Include []string `mapstructure:"include" plugin:"expand"`
+
+	// ExcludeFiles is a list of filename blobs to exclude.
+	ExcludeFiles []string `mapstructure:"exclude_files" plugin:"expand"`
+
+	base
+}
+
+func cacheSaveFactory() Command   { return &cacheSave{} }
+func (c *cacheSave) Name() string { return "cache.save" }
+
+// ParseParams reads in the given parameters for the command.
+func (c *cacheSave) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(c.Key == "", "key cannot be blank")
+	catcher.NewWhen(c.SourceDir == "", "source directory cannot be blank")
+	catcher.NewWhen(len(c.Include) == 0, "include cannot be empty")
+	return catcher.Resolve()
+}
+
+// Execute archives the files and saves the archive to the build cache.
+func (c *cacheSave) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	if c.Key == "" {
+		return errors.New("key cannot be blank after expansion")
+	}
+	if !buildCacheEnabled(conf) {
+		logger.Task().Warningf("The build cache is not configured, not saving cache key '%s'.", c.Key)
+		return nil
+	}
+
+	if !filepath.IsAbs(c.SourceDir) {
+		c.SourceDir = GetWorkingDirectory(conf, c.SourceDir)
+	}
+
+	taskData := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	existing, err := comm.RestoreBuildCacheEntry(ctx, taskData, apimodels.BuildCacheRestoreRequest{Key: c.Key})
+	if err != nil {
+		return errors.Wrapf(err, "checking for existing cache key '%s'", c.Key)
+	}
+	if existing != nil && existing.Key == c.Key {
+		logger.Task().Infof("Cache key '%s' already exists, not saving it again.", c.Key)
+		return nil
+	}
+
+	tempDir, err := os.MkdirTemp("", "cache_save")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary directory for archive")
+	}
+	defer func() {
+		logger.Execution().Error(errors.Wrap(os.RemoveAll(tempDir), "removing temporary archive"))
+	}()
+	archivePath := filepath.Join(tempDir, "cache.tar.gz")
+
+	filesArchived, err := c.makeArchive(ctx, archivePath, logger.Execution())
+	if err != nil {
+		return errors.Wrap(err, "creating cache archive")
+	}
+	if filesArchived == 0 {
+		logger.Task().Warningf("No files matched, not saving cache key '%s'.", c.Key)
+		return nil
+	}
+
+	entry, err := newBuildCacheEntry(c.Key, archivePath)
+	if err != nil {
+		return errors.Wrap(err, "hashing cache archive")
+	}
+
+	bucket, err := newBuildCacheBucket(ctx, comm, conf)
+	if err != nil {
+		return errors.Wrap(err, "creating build cache bucket")
+	}
+	blobKey := apimodels.BuildCacheBlobKey(conf.Task.Project, entry.SHA256)
+	exists, err := bucket.Exists(ctx, blobKey)
+	if err != nil {
+		return errors.Wrap(err, "checking if cache archive already exists")
+	}
+	if !exists {
+		if err := bucket.Upload(ctx, blobKey, archivePath); err != nil {
+			return errors.Wrap(err, "uploading cache archive")
+		}
+	}
+
+	if err := comm.SaveBuildCacheEntry(ctx, taskData, *entry); err != nil {
+		return errors.Wrapf(err, "saving cache key '%s'", c.Key)
+	}
+	logger.Task().Infof("Saved %d files (%d bytes compressed) under cache key '%s'.", filesArchived, entry.SizeBytes, c.Key)
+
+	return nil
+}
+
+// makeArchive writes the files to save to a tarball at the given path. It
+// returns the number of files archived.
+func (c *cacheSave) makeArchive(ctx context.Context, archivePath string, logger grip.Journaler) (int, error) {
+	pathsToAdd, totalSize, err := findArchiveContents(ctx, c.SourceDir, c.Include, []string{})
+	if err != nil {
+		return 0, errors.Wrap(err, "getting archive contents")
+	}
+
+	f, gz, tarWriter, err := tarGzWriter(archivePath, totalSize > thresholdSizeForParallelGzipCompression)
+	if err != nil {
+		return 0, errors.Wrapf(err, "opening archive file '%s'", archivePath)
+	}
+
+	filesArchived, err := buildArchive(ctx, tarWriter, c.SourceDir, pathsToAdd, c.ExcludeFiles, logger)
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(err)
+	catcher.Add(tarWriter.Close())
+	catcher.Add(gz.Close())
+	catcher.Add(f.Close())
+
+	return filesArchived, catcher.Resolve()
+}
+
+// newBuildCacheEntry returns the build cache entry for the archive at the
+// given path.
+func newBuildCacheEntry(key, archivePath string) (*apimodels.BuildCacheEntry, error) {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening archive '%s'", archivePath)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading archive '%s'", archivePath)
+	}
+
+	return &apimodels.BuildCacheEntry{
+		Key:       key,
+		SHA256:    hex.EncodeToString(h.Sum(nil)),
+		SizeBytes: size,
+	}, nil
+}
@@ -0,0 +1,174 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/globals"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheSaveParseParams(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		params  map[string]any
+		isValid bool
+	}{
+		"SucceedsWithValidParams": {
+			params:  map[string]any{"key": "deps", "source_dir": "src", "include": []string{"**"}},
+			isValid: true,
+		},
+		"FailsWithoutKey": {
+			params: map[string]any{"source_dir": "src", "include": []string{"**"}},
+		},
+		"FailsWithoutSourceDir": {
+			params: map[string]any{"key": "deps", "include": []string{"**"}},
+		},
+		"FailsWithoutInclude": {
+			params: map[string]any{"key": "deps", "source_dir": "src"},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			err := cacheSaveFactory().ParseParams(tCase.params)
+			if tCase.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCacheRestoreParseParams(t *testing.T) {
+	cmd, ok := cacheRestoreFactory().(*cacheRestore)
+	require.True(t, ok)
+	require.NoError(t, cmd.ParseParams(map[string]any{"key": "deps-2", "restore_keys": []string{"deps-"}, "dest_dir": "dest"}))
+	assert.Equal(t, []string{"deps-"}, cmd.RestoreKeys)
+
+	assert.Error(t, cacheRestoreFactory().ParseParams(map[string]any{"dest_dir": "dest"}))
+	assert.Error(t, cacheRestoreFactory().ParseParams(map[string]any{"key": "deps"}))
+}
+
+func TestCacheSaveAndRestore(t *testing.T) {
+	setup := func(ctx context.Context, t *testing.T) (*client.Mock, client.LoggerProducer, *internal.TaskConfig) {
+		expansions := util.Expansions{}
+		conf := &internal.TaskConfig{
+			Task:          task.Task{Id: "task", Project: "project"},
+			Expansions:    expansions,
+			NewExpansions: agentutil.NewDynamicExpansions(expansions),
+			WorkDir:       t.TempDir(),
+			BuildCacheBucket: evergreen.BucketConfig{
+				Name: t.TempDir(),
+				Type: evergreen.BucketTypeLocal,
+			},
+		}
+		require.NoError(t, os.MkdirAll(filepath.Join(conf.WorkDir, "src", "dir"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "src", "a.txt"), []byte("a"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "src", "dir", "b.txt"), []byte("b"), 0644))
+
+		comm := client.NewMock("url")
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+		return comm, logger, conf
+	}
+	save := func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig, key string) {
+		cmd := cacheSaveFactory()
+		require.NoError(t, cmd.ParseParams(map[string]any{"key": key, "source_dir": "src", "include": []string{"**"}}))
+		require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+	}
+	restore := func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig, key string, restoreKeys []string) {
+		cmd := cacheRestoreFactory()
+		require.NoError(t, cmd.ParseParams(map[string]any{"key": key, "restore_keys": restoreKeys, "dest_dir": "dest"}))
+		require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+	}
+	checkRestored := func(t *testing.T, conf *internal.TaskConfig) {
+		a, err := os.ReadFile(filepath.Join(conf.WorkDir, "dest", "a.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "a", string(a))
+		b, err := os.ReadFile(filepath.Join(conf.WorkDir, "dest", "dir", "b.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "b", string(b))
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig){
+		"RestoresExactKey": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			save(ctx, t, comm, logger, conf, "deps-1")
+			require.Len(t, comm.BuildCacheEntries, 1)
+			entry := comm.BuildCacheEntries[0]
+			assert.Equal(t, "deps-1", entry.Key)
+			assert.NotZero(t, entry.SizeBytes)
+			assert.FileExists(t, filepath.Join(conf.BuildCacheBucket.Name, apimodels.BuildCacheBlobKey("project", entry.SHA256)))
+
+			restore(ctx, t, comm, logger, conf, "deps-1", nil)
+			checkRestored(t, conf)
+			assert.Equal(t, "true", conf.NewExpansions.Get(globals.CacheHit))
+			assert.Equal(t, "deps-1", conf.NewExpansions.Get(globals.CacheMatchedKey))
+		},
+		"RestoresFallbackKey": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			save(ctx, t, comm, logger, conf, "deps-1")
+
+			restore(ctx, t, comm, logger, conf, "deps-2", []string{"deps-"})
+			checkRestored(t, conf)
+			assert.Equal(t, "false", conf.NewExpansions.Get(globals.CacheHit))
+			assert.Equal(t, "deps-1", conf.NewExpansions.Get(globals.CacheMatchedKey))
+		},
+		"FailsWhenArchiveDoesNotMatchHash": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			save(ctx, t, comm, logger, conf, "deps-1")
+			require.Len(t, comm.BuildCacheEntries, 1)
+			blobPath := filepath.Join(conf.BuildCacheBucket.Name, apimodels.BuildCacheBlobKey("project", comm.BuildCacheEntries[0].SHA256))
+			require.NoError(t, os.WriteFile(blobPath, []byte("replaced"), 0644))
+
+			cmd := cacheRestoreFactory()
+			require.NoError(t, cmd.ParseParams(map[string]any{"key": "deps-1", "dest_dir": "dest"}))
+			assert.ErrorContains(t, cmd.Execute(ctx, comm, logger, conf), "sha256")
+			assert.NoDirExists(t, filepath.Join(conf.WorkDir, "dest"))
+			assert.Equal(t, "false", conf.NewExpansions.Get(globals.CacheHit))
+		},
+		"MissSetsExpansions": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			restore(ctx, t, comm, logger, conf, "deps-1", []string{"deps-"})
+			assert.NoDirExists(t, filepath.Join(conf.WorkDir, "dest"))
+			assert.Equal(t, "false", conf.NewExpansions.Get(globals.CacheHit))
+			assert.Empty(t, conf.NewExpansions.Get(globals.CacheMatchedKey))
+		},
+		"SaveDoesNotOverwriteExistingKey": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			save(ctx, t, comm, logger, conf, "deps-1")
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "src", "a.txt"), []byte("changed"), 0644))
+			save(ctx, t, comm, logger, conf, "deps-1")
+			require.Len(t, comm.BuildCacheEntries, 1)
+
+			restore(ctx, t, comm, logger, conf, "deps-1", nil)
+			checkRestored(t, conf)
+		},
+		"SaveWithoutMatchingFilesIsNoop": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			cmd := cacheSaveFactory()
+			require.NoError(t, cmd.ParseParams(map[string]any{"key": "deps-1", "source_dir": "src", "include": []string{"*.nonexistent"}}))
+			require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+			assert.Empty(t, comm.BuildCacheEntries)
+		},
+		"NoopsWithoutBuildCache": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			conf.BuildCacheBucket = evergreen.BucketConfig{}
+			save(ctx, t, comm, logger, conf, "deps-1")
+			assert.Empty(t, comm.BuildCacheEntries)
+
+			restore(ctx, t, comm, logger, conf, "deps-1", nil)
+			assert.Equal(t, "false", conf.NewExpansions.Get(globals.CacheHit))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			comm, logger, conf := setup(ctx, t)
+			tCase(ctx, t, comm, logger, conf)
+		})
+	}
+}
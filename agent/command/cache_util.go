@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/pail"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// buildCacheEnabled returns whether the build cache is configured for the
+// task.
+func buildCacheEnabled(conf *internal.TaskConfig) bool {
+	return conf.BuildCacheBucket.Name != ""
+}
+
+// newBuildCacheBucket returns the bucket that stores the build cache's
+// archives. S3 buckets use credentials scoped to the task's project.
+func newBuildCacheBucket(ctx context.Context, comm client.Communicator, conf *internal.TaskConfig) (pail.Bucket, error) {
+	bucketConf := conf.BuildCacheBucket
+	switch bucketConf.Type {
+	case evergreen.BucketTypeS3:
+		taskData := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+		return pail.NewS3MultiPartBucket(ctx, pail.S3Options{
+			Name:        bucketConf.Name,
+			Region:      evergreen.DefaultEC2Region,
+			Credentials: createEvergreenCredentials(comm, taskData, "", bucketConf.Name),
+			Permissions: pail.S3PermissionsPrivate,
+			MaxRetries:  utility.ToIntPtr(10),
+		})
+	case evergreen.BucketTypeLocal:
+		return pail.NewLocalBucket(pail.LocalOptions{
+			Path:     bucketConf.Name,
+			UseSlash: true,
+		})
+	default:
+		return nil, errors.Errorf("unsupported build cache bucket type '%s'", bucketConf.Type)
+	}
+}
+
+// downloadVerifiedArchive downloads the archive with the given key from the
+// build cache bucket to the given path and checks that its contents have the
+// expected SHA-256 hash. Tasks can write to their project's prefix in the
+// bucket directly, so an archive can't be trusted until it's verified.
+func downloadVerifiedArchive(ctx context.Context, bucket pail.Bucket, key, expectedSHA256, archivePath string) error {
+	reader, err := bucket.Get(ctx, key)
+	if err != nil {
+		return errors.Wrapf(err, "downloading archive '%s'", key)
+	}
+	defer reader.Close()
+
+	f, err := os.Create(archivePath)
+	if err != nil {
+		return errors.Wrapf(err, "creating archive file '%s'", archivePath)
+	}
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(f, h), reader)
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrapf(err, "writing archive '%s'", key)
+	catcher.Wrapf(f.Close(), "closing archive file '%s'", archivePath)
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+
+	if actualSHA256 := hex.EncodeToString(h.Sum(nil)); actualSHA256 != expectedSHA256 {
+		return errors.Errorf("archive '%s' has sha256 '%s' but expected '%s'", key, actualSHA256, expectedSHA256)
+	}
+	return nil
+}
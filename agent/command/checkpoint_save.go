@@ -0,0 +1,165 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// checkpointSave marks the task's main commands up to this one as completed
+// and saves a snapshot of the task's working directory. If the task's host
+// is lost, the next execution restores the snapshot and skips the completed
+// commands instead of starting over.
+type checkpointSave struct {
+	// Step is the name of the completed step.
+	Step string `mapstructure:"step" plugin:"expand"`
+
+	// Directory is the directory to snapshot, relative to the task's working
+	// directory. Defaults to the whole working directory.
+	Directory string `mapstructure:"directory" plugin:"expand"`
+
+	// ExcludeFiles is a list of filename blobs to leave out of the snapshot.
+	ExcludeFiles []string `mapstructure:"exclude_files" plugin:"expand"`
+
+	// Expansions are the names of expansions set by the completed commands
+	// that the remaining commands need. They're restored along with the
+	// snapshot.
+	Expansions []string `mapstructure:"expansions"`
+
+	base
+}
+
+func checkpointSaveFactory() Command   { return &checkpointSave{} }
+func (c *checkpointSave) Name() string { return "checkpoint.save" }
+
+// ParseParams reads in the given parameters for the command.
+func (c *checkpointSave) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(c.Step == "", "step cannot be blank")
+	catcher.NewWhen(filepath.IsAbs(c.Directory), "directory must be relative to the task's working directory")
+	return catcher.Resolve()
+}
+
+// Execute archives the directory, uploads the snapshot and records the
+// checkpoint.
+func (c *checkpointSave) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	if c.Step == "" {
+		return errors.New("step cannot be blank after expansion")
+	}
+	if !buildCacheEnabled(conf) {
+		logger.Task().Warningf("The build cache is not configured, not saving checkpoint '%s'.", c.Step)
+		return nil
+	}
+
+	tempDir, err := os.MkdirTemp("", "checkpoint_save")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary directory for snapshot")
+	}
+	defer func() {
+		logger.Execution().Error(errors.Wrap(os.RemoveAll(tempDir), "removing temporary snapshot"))
+	}()
+	archivePath := filepath.Join(tempDir, "checkpoint.tar.gz")
+
+	filesArchived, err := c.makeArchive(ctx, GetWorkingDirectory(conf, c.Directory), archivePath, logger.Execution())
+	if err != nil {
+		return errors.Wrap(err, "creating snapshot archive")
+	}
+	entry, err := newBuildCacheEntry(c.Step, archivePath)
+	if err != nil {
+		return errors.Wrap(err, "hashing snapshot archive")
+	}
+
+	bucket, err := newBuildCacheBucket(ctx, comm, conf)
+	if err != nil {
+		return errors.Wrap(err, "creating build cache bucket")
+	}
+	snapshotKey := apimodels.TaskCheckpointSnapshotKey(conf.Task.Project, conf.Task.Id, entry.SHA256)
+	if err := bucket.Upload(ctx, snapshotKey, archivePath); err != nil {
+		return errors.Wrap(err, "uploading snapshot archive")
+	}
+
+	taskData := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	replaced, err := comm.SaveTaskCheckpoint(ctx, taskData, apimodels.TaskCheckpoint{
+		Step:       c.Step,
+		Command:    c.FullDisplayName(),
+		Directory:  c.Directory,
+		SHA256:     entry.SHA256,
+		SizeBytes:  entry.SizeBytes,
+		Expansions: c.getExpansions(conf, logger),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "saving checkpoint '%s'", c.Step)
+	}
+	logger.Task().Infof("Saved checkpoint '%s' with %d files (%d bytes compressed).", c.Step, filesArchived, entry.SizeBytes)
+
+	// The replaced snapshot can no longer be restored, so it's only removed on
+	// a best-effort basis.
+	if replaced != nil && replaced.SHA256 != entry.SHA256 {
+		replacedKey := apimodels.TaskCheckpointSnapshotKey(conf.Task.Project, conf.Task.Id, replaced.SHA256)
+		logger.Execution().Warning(errors.Wrapf(bucket.Remove(ctx, replacedKey), "removing snapshot of replaced checkpoint '%s'", replaced.Step))
+	}
+
+	return nil
+}
+
+// makeArchive writes the snapshot to a tarball at the given path. It returns
+// the number of files archived.
+func (c *checkpointSave) makeArchive(ctx context.Context, rootPath, archivePath string, logger grip.Journaler) (int, error) {
+	pathsToAdd, totalSize, err := findArchiveContents(ctx, rootPath, []string{"**"}, []string{})
+	if err != nil {
+		return 0, errors.Wrap(err, "getting snapshot contents")
+	}
+
+	f, gz, tarWriter, err := tarGzWriter(archivePath, totalSize > thresholdSizeForParallelGzipCompression)
+	if err != nil {
+		return 0, errors.Wrapf(err, "opening archive file '%s'", archivePath)
+	}
+
+	filesArchived, err := buildArchive(ctx, tarWriter, rootPath, pathsToAdd, c.ExcludeFiles, logger)
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(err)
+	catcher.Add(tarWriter.Close())
+	catcher.Add(gz.Close())
+	catcher.Add(f.Close())
+
+	return filesArchived, catcher.Resolve()
+}
+
+// getExpansions returns the values of the expansions to save with the
+// checkpoint. Redacted expansions are never saved.
+func (c *checkpointSave) getExpansions(conf *internal.TaskConfig, logger client.LoggerProducer) map[string]string {
+	if len(c.Expansions) == 0 {
+		return nil
+	}
+
+	redacted := append([]string{}, conf.Redacted...)
+	for _, info := range conf.NewExpansions.GetRedacted() {
+		redacted = append(redacted, info.Key)
+	}
+
+	expansions := map[string]string{}
+	for _, name := range c.Expansions {
+		if utility.StringSliceContains(redacted, name) {
+			logger.Task().Warningf("Not saving redacted expansion '%s' in checkpoint '%s'.", name, c.Step)
+			continue
+		}
+		expansions[name] = conf.NewExpansions.Get(name)
+	}
+	return expansions
+}
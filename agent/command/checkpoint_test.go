@@ -0,0 +1,152 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointSaveParseParams(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		params  map[string]any
+		isValid bool
+	}{
+		"SucceedsWithValidParams": {
+			params:  map[string]any{"step": "compile", "directory": "src", "exclude_files": []string{"*.log"}, "expansions": []string{"version"}},
+			isValid: true,
+		},
+		"SucceedsWithOnlyStep": {
+			params:  map[string]any{"step": "compile"},
+			isValid: true,
+		},
+		"FailsWithoutStep": {
+			params: map[string]any{"directory": "src"},
+		},
+		"FailsWithAbsoluteDirectory": {
+			params: map[string]any{"step": "compile", "directory": "/src"},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			err := checkpointSaveFactory().ParseParams(tCase.params)
+			if tCase.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckpointSaveAndRestore(t *testing.T) {
+	setup := func(ctx context.Context, t *testing.T) (*client.Mock, client.LoggerProducer, *internal.TaskConfig) {
+		expansions := util.Expansions{"version": "1.0", "secret": "hunter2"}
+		conf := &internal.TaskConfig{
+			Task:          task.Task{Id: "task", Project: "project"},
+			Expansions:    expansions,
+			NewExpansions: agentutil.NewDynamicExpansions(expansions),
+			Redacted:      []string{"secret"},
+			WorkDir:       t.TempDir(),
+			BuildCacheBucket: evergreen.BucketConfig{
+				Name: t.TempDir(),
+				Type: evergreen.BucketTypeLocal,
+			},
+		}
+		require.NoError(t, os.MkdirAll(filepath.Join(conf.WorkDir, "src", "dir"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "src", "a.txt"), []byte("a"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "src", "dir", "b.log"), []byte("b"), 0644))
+
+		comm := client.NewMock("url")
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+		return comm, logger, conf
+	}
+	save := func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig, params map[string]any) {
+		cmd := checkpointSaveFactory()
+		require.NoError(t, cmd.ParseParams(params))
+		require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig){
+		"RestoresSnapshotAndExpansions": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			save(ctx, t, comm, logger, conf, map[string]any{"step": "compile", "directory": "src", "expansions": []string{"version", "secret"}})
+			require.Len(t, comm.SavedCheckpoints, 1)
+			saved := comm.SavedCheckpoints[0]
+			assert.Equal(t, "compile", saved.Step)
+			assert.Equal(t, "src", saved.Directory)
+			assert.NotZero(t, saved.SizeBytes)
+			assert.Equal(t, map[string]string{"version": "1.0"}, saved.Expansions, "redacted expansions should not be saved")
+			assert.FileExists(t, filepath.Join(conf.BuildCacheBucket.Name, apimodels.TaskCheckpointSnapshotKey("project", "task", saved.SHA256)))
+
+			// Simulate the next execution on a new host.
+			require.NoError(t, os.RemoveAll(filepath.Join(conf.WorkDir, "src")))
+			conf.NewExpansions = agentutil.NewDynamicExpansions(util.Expansions{})
+
+			require.NoError(t, RestoreCheckpoint(ctx, comm, logger, conf, saved))
+			a, err := os.ReadFile(filepath.Join(conf.WorkDir, "src", "a.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, "a", string(a))
+			b, err := os.ReadFile(filepath.Join(conf.WorkDir, "src", "dir", "b.log"))
+			require.NoError(t, err)
+			assert.Equal(t, "b", string(b))
+			assert.Equal(t, "1.0", conf.NewExpansions.Get("version"))
+		},
+		"ExcludesFiles": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			save(ctx, t, comm, logger, conf, map[string]any{"step": "compile", "directory": "src", "exclude_files": []string{"*.log"}})
+			require.Len(t, comm.SavedCheckpoints, 1)
+
+			require.NoError(t, os.RemoveAll(filepath.Join(conf.WorkDir, "src")))
+			require.NoError(t, RestoreCheckpoint(ctx, comm, logger, conf, comm.SavedCheckpoints[0]))
+			assert.FileExists(t, filepath.Join(conf.WorkDir, "src", "a.txt"))
+			assert.NoFileExists(t, filepath.Join(conf.WorkDir, "src", "dir", "b.log"))
+		},
+		"FailsWhenSnapshotDoesNotMatchHash": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			save(ctx, t, comm, logger, conf, map[string]any{"step": "compile", "directory": "src", "expansions": []string{"version"}})
+			require.Len(t, comm.SavedCheckpoints, 1)
+			saved := comm.SavedCheckpoints[0]
+			snapshotPath := filepath.Join(conf.BuildCacheBucket.Name, apimodels.TaskCheckpointSnapshotKey("project", "task", saved.SHA256))
+			require.NoError(t, os.WriteFile(snapshotPath, []byte("replaced"), 0644))
+
+			require.NoError(t, os.RemoveAll(filepath.Join(conf.WorkDir, "src")))
+			conf.NewExpansions = agentutil.NewDynamicExpansions(util.Expansions{})
+			assert.ErrorContains(t, RestoreCheckpoint(ctx, comm, logger, conf, saved), "sha256")
+			assert.NoDirExists(t, filepath.Join(conf.WorkDir, "src"))
+			assert.Empty(t, conf.NewExpansions.Get("version"))
+		},
+		"RemovesReplacedSnapshot": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			save(ctx, t, comm, logger, conf, map[string]any{"step": "compile", "directory": "src"})
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "src", "c.txt"), []byte("c"), 0644))
+			save(ctx, t, comm, logger, conf, map[string]any{"step": "test", "directory": "src"})
+			require.Len(t, comm.SavedCheckpoints, 2)
+
+			first, second := comm.SavedCheckpoints[0], comm.SavedCheckpoints[1]
+			require.NotEqual(t, first.SHA256, second.SHA256)
+			assert.NoFileExists(t, filepath.Join(conf.BuildCacheBucket.Name, apimodels.TaskCheckpointSnapshotKey("project", "task", first.SHA256)))
+			assert.FileExists(t, filepath.Join(conf.BuildCacheBucket.Name, apimodels.TaskCheckpointSnapshotKey("project", "task", second.SHA256)))
+		},
+		"NoopsWithoutBuildCache": func(ctx context.Context, t *testing.T, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			conf.BuildCacheBucket = evergreen.BucketConfig{}
+			assert.False(t, CheckpointsEnabled(conf))
+			save(ctx, t, comm, logger, conf, map[string]any{"step": "compile"})
+			assert.Empty(t, comm.SavedCheckpoints)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			comm, logger, conf := setup(ctx, t)
+			tCase(ctx, t, comm, logger, conf)
+		})
+	}
+}
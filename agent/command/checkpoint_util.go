@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/pkg/errors"
+)
+
+// CheckpointsEnabled returns whether the task can save and restore
+// checkpoints. Checkpoint snapshots are stored in the build cache bucket.
+func CheckpointsEnabled(conf *internal.TaskConfig) bool {
+	return buildCacheEnabled(conf)
+}
+
+// RestoreCheckpoint restores the working directory snapshot and expansions
+// that an earlier execution of the task saved with checkpoint.save. Files in
+// the snapshot overwrite any existing files in the working directory.
+func RestoreCheckpoint(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig, checkpoint apimodels.TaskCheckpoint) error {
+	bucket, err := newBuildCacheBucket(ctx, comm, conf)
+	if err != nil {
+		return errors.Wrap(err, "creating build cache bucket")
+	}
+	tempDir, err := os.MkdirTemp("", "checkpoint_restore")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary directory for snapshot")
+	}
+	defer func() {
+		logger.Execution().Error(errors.Wrap(os.RemoveAll(tempDir), "removing temporary snapshot"))
+	}()
+	archivePath := filepath.Join(tempDir, "checkpoint.tar.gz")
+	snapshotKey := apimodels.TaskCheckpointSnapshotKey(conf.Task.Project, conf.Task.Id, checkpoint.SHA256)
+	if err := downloadVerifiedArchive(ctx, bucket, snapshotKey, checkpoint.SHA256, archivePath); err != nil {
+		return errors.Wrapf(err, "downloading snapshot for checkpoint '%s'", checkpoint.Step)
+	}
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return errors.Wrapf(err, "opening snapshot for checkpoint '%s'", checkpoint.Step)
+	}
+	defer f.Close()
+	destDir := GetWorkingDirectory(conf, checkpoint.Directory)
+	if err := extractTarball(ctx, f, destDir, []string{}); err != nil {
+		return errors.Wrapf(err, "extracting snapshot for checkpoint '%s'", checkpoint.Step)
+	}
+	logger.Task().Infof("Restored snapshot for checkpoint '%s' (%d bytes compressed) to directory '%s'.", checkpoint.Step, checkpoint.SizeBytes, destDir)
+
+	for name, value := range checkpoint.Expansions {
+		conf.NewExpansions.Put(name, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,379 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/pkg/errors"
+)
+
+// ConditionState is the state of the task that a command's condition is
+// evaluated against.
+type ConditionState struct {
+	// Expansions are the task's expansions, which includes information like
+	// the requester (${requester}, ${is_patch}) and build variant
+	// (${build_variant}).
+	Expansions *util.Expansions
+	// Failed is whether any command that ran before in the task has failed.
+	Failed bool
+}
+
+// Condition is a parsed condition that determines whether a command runs. The
+// grammar is:
+//
+//	expr       = and { "||" and }
+//	and        = unary { "&&" unary }
+//	unary      = "!" unary | "(" expr ")" | comparison
+//	comparison = operand [ ( "==" | "!=" ) operand ]
+//	operand    = "success()" | "failure()" | "always()" | quoted string | word
+//
+// Words and quoted strings may contain expansions, which are expanded when the
+// condition is evaluated. An operand that isn't compared is true unless it is
+// empty, "false" or "0".
+type Condition struct {
+	raw  string
+	root conditionNode
+	// checksStatus is whether the condition uses success(), failure() or
+	// always().
+	checksStatus bool
+}
+
+// ParseCondition parses the condition.
+func ParseCondition(raw string) (*Condition, error) {
+	tokens, err := tokenizeCondition(raw)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid condition '%s'", raw)
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("condition cannot be empty")
+	}
+	p := &conditionParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = errors.Errorf("unexpected '%s'", p.tokens[p.pos].value)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid condition '%s'", raw)
+	}
+	return &Condition{raw: raw, root: root, checksStatus: p.checksStatus}, nil
+}
+
+// String returns the condition as it was written.
+func (c *Condition) String() string {
+	return c.raw
+}
+
+// ChecksStatus returns whether the condition depends on whether earlier
+// commands failed, i.e. whether it uses success(), failure() or always().
+func (c *Condition) ChecksStatus() bool {
+	return c.checksStatus
+}
+
+// Evaluate returns whether the condition is met.
+func (c *Condition) Evaluate(state ConditionState) (bool, error) {
+	if state.Expansions == nil {
+		state.Expansions = util.NewExpansions(map[string]string{})
+	}
+	met, err := c.root.evaluate(state)
+	return met, errors.Wrapf(err, "evaluating condition '%s'", c.raw)
+}
+
+// RunsAfterFailure returns whether a command with the given conditions can
+// run after an earlier command in its block failed. Like commands without a
+// condition, commands whose conditions only check expansions don't run after
+// a failure; a command must opt in with a condition that checks the task's
+// status, such as failure() or always().
+func RunsAfterFailure(conditions []string) bool {
+	for _, raw := range conditions {
+		cond, err := ParseCondition(raw)
+		if err == nil && cond.ChecksStatus() {
+			return true
+		}
+	}
+	return false
+}
+
+// EvaluateConditions returns whether all of the conditions are met.
+func EvaluateConditions(conditions []string, state ConditionState) (bool, error) {
+	for _, raw := range conditions {
+		cond, err := ParseCondition(raw)
+		if err != nil {
+			return false, err
+		}
+		met, err := cond.Evaluate(state)
+		if err != nil || !met {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+type conditionTokenKind int
+
+const (
+	conditionTokenOperand conditionTokenKind = iota
+	conditionTokenString
+	conditionTokenOperator
+)
+
+type conditionToken struct {
+	kind  conditionTokenKind
+	value string
+}
+
+var conditionOperators = []string{"&&", "||", "==", "!=", "!", "(", ")"}
+
+func tokenizeCondition(raw string) ([]conditionToken, error) {
+	var tokens []conditionToken
+	for i := 0; i < len(raw); {
+		if unicode.IsSpace(rune(raw[i])) {
+			i++
+			continue
+		}
+		if raw[i] == '\'' || raw[i] == '"' {
+			end := strings.IndexByte(raw[i+1:], raw[i])
+			if end < 0 {
+				return nil, errors.New("unterminated string")
+			}
+			tokens = append(tokens, conditionToken{kind: conditionTokenString, value: raw[i+1 : i+1+end]})
+			i += end + 2
+			continue
+		}
+		if op := conditionOperatorAt(raw, i); op != "" {
+			tokens = append(tokens, conditionToken{kind: conditionTokenOperator, value: op})
+			i += len(op)
+			continue
+		}
+
+		start := i
+		for i < len(raw) && !unicode.IsSpace(rune(raw[i])) {
+			if strings.HasPrefix(raw[i:], "${") {
+				// Expansions may contain operator characters, such as the
+				// "|" that separates an expansion from its default value.
+				end := strings.IndexByte(raw[i:], '}')
+				if end < 0 {
+					return nil, errors.New("unterminated expansion")
+				}
+				i += end + 1
+				continue
+			}
+			if strings.HasPrefix(raw[i:], "()") {
+				// Function calls end the word.
+				i += 2
+				break
+			}
+			if conditionOperatorAt(raw, i) != "" {
+				break
+			}
+			i++
+		}
+		word := raw[start:i]
+		tokens = append(tokens, conditionToken{kind: conditionTokenOperand, value: word})
+	}
+	return tokens, nil
+}
+
+// conditionOperatorAt returns the operator at the given index in the
+// condition, if any.
+func conditionOperatorAt(raw string, i int) string {
+	for _, op := range conditionOperators {
+		if strings.HasPrefix(raw[i:], op) {
+			return op
+		}
+	}
+	return ""
+}
+
+type conditionParser struct {
+	tokens       []conditionToken
+	pos          int
+	checksStatus bool
+}
+
+func (p *conditionParser) peekOperator(op string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == conditionTokenOperator && p.tokens[p.pos].value == op
+}
+
+func (p *conditionParser) parseOr() (conditionNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekOperator("||") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &conditionOr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseAnd() (conditionNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekOperator("&&") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &conditionAnd{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseUnary() (conditionNode, error) {
+	if p.peekOperator("!") {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &conditionNot{operand: operand}, nil
+	}
+	if p.peekOperator("(") {
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peekOperator(")") {
+			return nil, errors.New("missing ')'")
+		}
+		p.pos++
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *conditionParser) parseComparison() (conditionNode, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!="} {
+		if p.peekOperator(op) {
+			p.pos++
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return &conditionComparison{left: left, right: right, negate: op == "!="}, nil
+		}
+	}
+	return &conditionTruthy{operand: left}, nil
+}
+
+func (p *conditionParser) parseOperand() (conditionOperand, error) {
+	if p.pos >= len(p.tokens) {
+		return conditionOperand{}, errors.New("unexpected end of condition")
+	}
+	token := p.tokens[p.pos]
+	if token.kind == conditionTokenOperator {
+		return conditionOperand{}, errors.Errorf("unexpected '%s'", token.value)
+	}
+	p.pos++
+	if token.kind == conditionTokenOperand && strings.HasSuffix(token.value, "()") {
+		switch token.value {
+		case "success()", "failure()", "always()":
+		default:
+			return conditionOperand{}, errors.Errorf("unknown function '%s'", token.value)
+		}
+		p.checksStatus = true
+		return conditionOperand{function: token.value}, nil
+	}
+	return conditionOperand{value: token.value}, nil
+}
+
+type conditionNode interface {
+	evaluate(ConditionState) (bool, error)
+}
+
+type conditionOperand struct {
+	function string
+	value    string
+}
+
+func (o conditionOperand) resolve(state ConditionState) (string, error) {
+	switch o.function {
+	case "success()":
+		return fmt.Sprint(!state.Failed), nil
+	case "failure()":
+		return fmt.Sprint(state.Failed), nil
+	case "always()":
+		return "true", nil
+	}
+	return state.Expansions.ExpandString(o.value)
+}
+
+type conditionTruthy struct {
+	operand conditionOperand
+}
+
+func (n *conditionTruthy) evaluate(state ConditionState) (bool, error) {
+	value, err := n.operand.resolve(state)
+	if err != nil {
+		return false, err
+	}
+	value = strings.TrimSpace(value)
+	return value != "" && value != "0" && !strings.EqualFold(value, "false"), nil
+}
+
+type conditionComparison struct {
+	left   conditionOperand
+	right  conditionOperand
+	negate bool
+}
+
+func (n *conditionComparison) evaluate(state ConditionState) (bool, error) {
+	left, err := n.left.resolve(state)
+	if err != nil {
+		return false, err
+	}
+	right, err := n.right.resolve(state)
+	if err != nil {
+		return false, err
+	}
+	return (left == right) != n.negate, nil
+}
+
+type conditionNot struct {
+	operand conditionNode
+}
+
+func (n *conditionNot) evaluate(state ConditionState) (bool, error) {
+	met, err := n.operand.evaluate(state)
+	return !met, err
+}
+
+type conditionAnd struct {
+	left  conditionNode
+	right conditionNode
+}
+
+func (n *conditionAnd) evaluate(state ConditionState) (bool, error) {
+	met, err := n.left.evaluate(state)
+	if err != nil || !met {
+		return false, err
+	}
+	return n.right.evaluate(state)
+}
+
+type conditionOr struct {
+	left  conditionNode
+	right conditionNode
+}
+
+func (n *conditionOr) evaluate(state ConditionState) (bool, error) {
+	met, err := n.left.evaluate(state)
+	if err != nil || met {
+		return met, err
+	}
+	return n.right.evaluate(state)
+}
@@ -0,0 +1,135 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCondition(t *testing.T) {
+	for _, raw := range []string{
+		"${is_patch} == true",
+		"failure()",
+		"!success()",
+		"always()",
+		"${is_patch} && (${build_variant} == 'linux' || ${build_variant} == \"macos\")",
+		"${requester|commit} != patch",
+		"${flag}",
+	} {
+		t.Run("Valid/"+raw, func(t *testing.T) {
+			cond, err := ParseCondition(raw)
+			require.NoError(t, err)
+			assert.Equal(t, raw, cond.String())
+		})
+	}
+	for tName, raw := range map[string]string{
+		"Empty":                 "  ",
+		"UnterminatedString":    "${requester} == 'patch",
+		"UnterminatedExpansion": "${requester == patch",
+		"MissingParenthesis":    "(failure() || success()",
+		"TrailingOperator":      "failure() &&",
+		"UnknownFunction":       "cancelled()",
+		"MissingOperand":        "== true",
+		"UnexpectedOperand":     "${is_patch} true",
+	} {
+		t.Run("Invalid/"+tName, func(t *testing.T) {
+			_, err := ParseCondition(raw)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRunsAfterFailure(t *testing.T) {
+	assert.False(t, RunsAfterFailure(nil))
+	assert.False(t, RunsAfterFailure([]string{"${is_patch} == true"}))
+	assert.False(t, RunsAfterFailure([]string{"failure() ||"}), "invalid conditions should not run")
+	assert.True(t, RunsAfterFailure([]string{"failure()"}))
+	assert.True(t, RunsAfterFailure([]string{"always()"}))
+	assert.True(t, RunsAfterFailure([]string{"${is_patch} == true", "!success()"}))
+}
+
+func TestEvaluateConditions(t *testing.T) {
+	expansions := util.NewExpansions(map[string]string{
+		"is_patch":      "true",
+		"requester":     "patch",
+		"build_variant": "linux",
+		"zero":          "0",
+	})
+	for tName, tCase := range map[string]struct {
+		conditions []string
+		failed     bool
+		met        bool
+	}{
+		"NoConditions": {
+			met: true,
+		},
+		"ExpansionEquals": {
+			conditions: []string{"${is_patch} == true"},
+			met:        true,
+		},
+		"ExpansionNotEquals": {
+			conditions: []string{"${requester} != patch"},
+		},
+		"QuotedString": {
+			conditions: []string{"${build_variant} == 'linux'"},
+			met:        true,
+		},
+		"ExpansionDefault": {
+			conditions: []string{"${missing|fallback} == fallback"},
+			met:        true,
+		},
+		"TruthyExpansion": {
+			conditions: []string{"${is_patch}"},
+			met:        true,
+		},
+		"FalsyExpansions": {
+			conditions: []string{"${missing} || ${zero}"},
+		},
+		"FailureWithoutFailedCommand": {
+			conditions: []string{"failure()"},
+		},
+		"FailureWithFailedCommand": {
+			conditions: []string{"failure()"},
+			failed:     true,
+			met:        true,
+		},
+		"SuccessWithFailedCommand": {
+			conditions: []string{"success()"},
+			failed:     true,
+		},
+		"AlwaysWithFailedCommand": {
+			conditions: []string{"always()"},
+			failed:     true,
+			met:        true,
+		},
+		"Not": {
+			conditions: []string{"!failure()"},
+			met:        true,
+		},
+		"AndBindsTighterThanOr": {
+			conditions: []string{"${is_patch} == true || ${zero} && failure()"},
+			met:        true,
+		},
+		"Parentheses": {
+			conditions: []string{"(${is_patch} == true || ${zero}) && failure()"},
+		},
+		"AllConditionsMustBeMet": {
+			conditions: []string{"${is_patch} == true", "${build_variant} == windows"},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			met, err := EvaluateConditions(tCase.conditions, ConditionState{
+				Expansions: expansions,
+				Failed:     tCase.failed,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tCase.met, met)
+		})
+	}
+	t.Run("InvalidConditionErrors", func(t *testing.T) {
+		_, err := EvaluateConditions([]string{"failure() ||"}, ConditionState{Expansions: expansions})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// exitCodeError is the error returned when a process that a command runs
+// exits with a non-zero exit code.
+type exitCodeError struct {
+	exitCode int
+	// prefix describes the failure before the exit code.
+	prefix string
+}
+
+func newExitCodeError(prefix string, exitCode int) error {
+	return &exitCodeError{exitCode: exitCode, prefix: prefix}
+}
+
+func (e *exitCodeError) Error() string {
+	if e.prefix == "" {
+		return fmt.Sprintf("exit code %d", e.exitCode)
+	}
+	return fmt.Sprintf("%s: exit code %d", e.prefix, e.exitCode)
+}
+
+// GetExitCode returns the exit code of the process that caused the command
+// error, if the command failed because a process exited with a non-zero exit
+// code.
+func GetExitCode(err error) (int, bool) {
+	var exitErr *exitCodeError
+	if errors.As(err, &exitErr) {
+		return exitErr.exitCode, true
+	}
+	return 0, false
+}
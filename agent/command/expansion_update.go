@@ -6,7 +6,6 @@ import (
 
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
-	"github.com/evergreen-ci/evergreen/util"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 )
@@ -74,9 +73,6 @@ func (c *update) ParseParams(params map[string]any) error {
 }
 
 func (c *update) executeUpdates(ctx context.Context, conf *internal.TaskConfig) error {
-	if conf.DynamicExpansions == nil {
-		conf.DynamicExpansions = util.Expansions{}
-	}
 	for _, update := range c.Updates {
 		if err := ctx.Err(); err != nil {
 			return errors.Wrap(err, "operation aborted")
@@ -99,7 +95,7 @@ func (c *update) executeUpdates(ctx context.Context, conf *internal.TaskConfig)
 			expanded = existingValue + expanded
 		}
 
-		conf.DynamicExpansions.Put(update.Key, expanded)
+		conf.PutDynamicExpansion(update.Key, expanded)
 		if update.Redact {
 			conf.NewExpansions.PutAndRedact(update.Key, expanded)
 		} else {
@@ -144,7 +140,7 @@ func (c *update) Execute(ctx context.Context,
 		if err != nil {
 			return errors.WithStack(err)
 		}
-		if _, err = conf.DynamicExpansions.UpdateFromYaml(filename); err != nil {
+		if err = conf.UpdateDynamicExpansionsFromYaml(filename); err != nil {
 			return errors.WithStack(err)
 		}
 	}
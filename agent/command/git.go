@@ -46,6 +46,10 @@ const (
 
 	generatedTokenKey = "EVERGREEN_GENERATED_GITHUB_TOKEN"
 
+	// generatedGitLabTokenKey is the key under which a GitLab project's
+	// token is redacted from task logs.
+	generatedGitLabTokenKey = "EVERGREEN_GITLAB_TOKEN"
+
 	// githubMergeQueueInvalidRefError is the error message returned by Git when it fails to find
 	// a GitHub merge queue reference.
 	githubMergeQueueInvalidRefError = "couldn't find remote ref gh-readonly-queue"
@@ -109,6 +113,12 @@ type cloneOpts struct {
 	useScalar          bool
 	useScalarFullClone bool
 	cloneDepth         int
+	// gitLabHost is the host of the GitLab instance to clone from. If it's
+	// not set, the repository is cloned from GitHub.
+	gitLabHost string
+	// remoteURL is the plain git remote to clone from. If it's set, it takes
+	// precedence over the owner and repo.
+	remoteURL string
 }
 
 // validateCloneMethod checks that the clone mechanism is one of the supported
@@ -128,8 +138,8 @@ func (opts cloneOpts) validate() error {
 	usesScalar := opts.useScalar || opts.useScalarFullClone
 	catcher.NewWhen(usesScalar && opts.cloneDepth > 0, "cannot use scalar with clone depth")
 	catcher.NewWhen(usesScalar && opts.recurseSubmodules, "cannot use scalar with recurse submodules")
-	catcher.NewWhen(opts.owner == "", "missing required owner")
-	catcher.NewWhen(opts.repo == "", "missing required repo")
+	catcher.NewWhen(opts.remoteURL == "" && opts.owner == "", "missing required owner")
+	catcher.NewWhen(opts.remoteURL == "" && opts.repo == "", "missing required repo")
 	catcher.NewWhen(opts.location == "", "missing required location")
 	catcher.Wrapf(validateCloneMethod(opts.method), "invalid clone method '%s'", opts.method)
 	catcher.NewWhen(opts.token == "" && opts.method == cloneMethodOAuth, "cannot clone using OAuth if token is not set")
@@ -139,6 +149,12 @@ func (opts cloneOpts) validate() error {
 }
 
 func (opts cloneOpts) httpLocation() string {
+	if opts.remoteURL != "" {
+		return opts.remoteURL
+	}
+	if opts.gitLabHost != "" {
+		return fmt.Sprintf("https://%s/%s/%s.git", opts.gitLabHost, opts.owner, opts.repo)
+	}
 	return fmt.Sprintf("https://github.com/%s/%s.git", opts.owner, opts.repo)
 }
 
@@ -163,6 +179,19 @@ func getProjectMethodAndToken(ctx context.Context, comm client.Communicator, td
 		return cloneMethodAccessToken, token, err
 	}
 
+	if conf.ProjectRef.GitRemote.IsEnabled() {
+		// Plain git remotes are cloned with whatever credentials git on the
+		// host is configured with.
+		return cloneMethodAccessToken, "", nil
+	}
+	if conf.ProjectRef.GitLab.IsEnabled() {
+		token := conf.ProjectRef.GitLab.Token
+		if token != "" {
+			conf.NewExpansions.Redact(generatedGitLabTokenKey, token)
+		}
+		return cloneMethodAccessToken, token, nil
+	}
+
 	owner := conf.ProjectRef.Owner
 	repo := conf.ProjectRef.Repo
 	appToken, err := comm.CreateInstallationTokenForClone(ctx, td, owner, repo)
@@ -205,15 +234,21 @@ func (opts cloneOpts) getCloneCommand(logger client.LoggerProducer) ([]string, e
 }
 
 func (opts cloneOpts) buildHTTPCloneCommand(logger client.LoggerProducer, forApp bool) ([]string, error) {
-	urlLocation, err := url.Parse(opts.location)
-	if err != nil {
-		return nil, errors.Wrap(err, "parsing URL from location")
-	}
 	var gitURL string
-	if forApp {
-		gitURL = thirdparty.FormGitURLForApp(urlLocation.Host, opts.owner, opts.repo, opts.token)
+	if opts.remoteURL != "" {
+		gitURL = opts.remoteURL
 	} else {
-		gitURL = thirdparty.FormGitURL(urlLocation.Host, opts.owner, opts.repo, opts.token)
+		urlLocation, err := url.Parse(opts.location)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing URL from location")
+		}
+		if opts.gitLabHost != "" {
+			gitURL = thirdparty.FormGitLabURL(urlLocation.Host, fmt.Sprintf("%s/%s", opts.owner, opts.repo), opts.token)
+		} else if forApp {
+			gitURL = thirdparty.FormGitURLForApp(urlLocation.Host, opts.owner, opts.repo, opts.token)
+		} else {
+			gitURL = thirdparty.FormGitURL(urlLocation.Host, opts.owner, opts.repo, opts.token)
+		}
 	}
 
 	gitCommand := "git clone"
@@ -415,6 +450,14 @@ func (c *gitFetchProject) opts(projectMethod, projectToken string, logger client
 		useScalar:          c.UseScalar,
 		useScalarFullClone: c.UseScalarFullClone,
 	}
+	if conf.ProjectRef.GitLab.IsEnabled() {
+		opts.owner = conf.ProjectRef.GitLab.Owner
+		opts.repo = conf.ProjectRef.GitLab.Repo
+		opts.gitLabHost = conf.ProjectRef.GitLab.Host()
+	}
+	if conf.ProjectRef.GitRemote.IsEnabled() {
+		opts.remoteURL = conf.ProjectRef.GitRemote.URL
+	}
 	cloneDepth := c.CloneDepth
 	if cloneDepth == 0 && c.ShallowClone {
 		// Experiments with shallow clone on AWS hosts suggest that depth 100 is as fast as 1, but 1000 is slower.
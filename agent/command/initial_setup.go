@@ -7,6 +7,7 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
 	"github.com/mongodb/jasper"
 )
 
@@ -30,6 +31,12 @@ func (*initialSetup) RetryOnFailure() bool                    { return false }
 func (*initialSetup) SetRetryOnFailure(bool)                  {}
 func (*initialSetup) FailureMetadataTags() []string           { return nil }
 func (*initialSetup) SetFailureMetadataTags([]string)         {}
+func (*initialSetup) Conditions() []string                    { return nil }
+func (*initialSetup) SetConditions([]string)                  {}
+
+func (*initialSetup) RetryPolicy() *model.CommandRetryPolicy   { return nil }
+func (*initialSetup) SetRetryPolicy(*model.CommandRetryPolicy) {}
+
 func (*initialSetup) Execute(ctx context.Context,
 	client client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
 
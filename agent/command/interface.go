@@ -7,6 +7,7 @@ import (
 
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
 	"github.com/mongodb/jasper"
 )
 
@@ -61,6 +62,18 @@ type Command interface {
 	// the command/function if it fails.
 	FailureMetadataTags() []string
 	SetFailureMetadataTags([]string)
+
+	// Conditions are the conditions that must all be met for the command to
+	// run. A command in a function has the function call's condition as well
+	// as its own.
+	Conditions() []string
+	SetConditions([]string)
+
+	// RetryPolicy is the policy for running the command again if it fails,
+	// if any. A command in a function uses the function call's retry policy
+	// if it does not have its own.
+	RetryPolicy() *model.CommandRetryPolicy
+	SetRetryPolicy(*model.CommandRetryPolicy)
 }
 
 // base contains a basic implementation of functionality that is
@@ -71,6 +84,8 @@ type base struct {
 	fullDisplayName     string
 	retryOnFailure      bool
 	failureMetadataTags []string
+	conditions          []string
+	retryPolicy         *model.CommandRetryPolicy
 	jasper              jasper.Manager
 	mu                  sync.RWMutex
 }
@@ -158,3 +173,31 @@ func (b *base) FailureMetadataTags() []string {
 
 	return b.failureMetadataTags
 }
+
+func (b *base) Conditions() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.conditions
+}
+
+func (b *base) SetConditions(conditions []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.conditions = conditions
+}
+
+func (b *base) RetryPolicy() *model.CommandRetryPolicy {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.retryPolicy
+}
+
+func (b *base) SetRetryPolicy(policy *model.CommandRetryPolicy) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.retryPolicy = policy
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,8 +25,12 @@ func init() {
 		"archive.targz_extract":                 tarballExtractFactory,
 		"archive.zip_pack":                      zipArchiveCreateFactory,
 		"archive.zip_extract":                   zipExtractFactory,
+		"cache.restore":                         cacheRestoreFactory,
+		"cache.save":                            cacheSaveFactory,
+		"checkpoint.save":                       checkpointSaveFactory,
 		evergreen.AttachResultsCommandName:      attachResultsFactory,
 		evergreen.AttachXUnitResultsCommandName: xunitResultsFactory,
+		evergreen.AttachTestResultsCommandName:  formatTestResultsFactory,
 		evergreen.AttachArtifactsCommandName:    attachArtifactsFactory,
 		evergreen.HostCreateCommandName:         createHostFactory,
 		"ec2.assume_role":                       ec2AssumeRoleFactory,
@@ -134,6 +139,9 @@ func (r *commandRegistry) renderCommands(commandInfo model.PluginCommandConf,
 	project *model.Project, blockInfo BlockInfo) ([]Command, error) {
 
 	var parsed []model.PluginCommandConf
+	// conditions are the conditions for each parsed command, which includes
+	// both the function call's condition and the command's own.
+	var conditions [][]string
 
 	catcher := grip.NewBasicCatcher()
 
@@ -149,39 +157,53 @@ func (r *commandRegistry) renderCommands(commandInfo model.PluginCommandConf,
 					continue
 				}
 
-				// If there's no command-specific type/timeout, use the
-				// function's command type/timeout
+				// If there's no command-specific type/timeout/retry policy,
+				// use the function's command type/timeout/retry policy
 				if c.Type == "" {
 					c.Type = commandInfo.Type
 				}
 				if c.TimeoutSecs == 0 {
 					c.TimeoutSecs = commandInfo.TimeoutSecs
 				}
+				if c.Retry == nil {
+					c.Retry = commandInfo.Retry
+				}
 
 				funcInfo := FunctionInfo{
 					Function:     funcName,
 					SubCmdNum:    i + 1,
 					TotalSubCmds: len(cmdsInFunc),
 				}
-				c.DisplayName = GetFullDisplayName(c.Command, c.DisplayName, blockInfo, funcInfo)
+				cmdConditions := renderConditions(catcher, commandInfo.If, c.If)
+				c.DisplayName = withConditions(GetFullDisplayName(c.Command, c.DisplayName, blockInfo, funcInfo), cmdConditions)
 				c.FailureMetadataTags = utility.UniqueStrings(append(c.FailureMetadataTags, commandInfo.FailureMetadataTags...))
 
 				parsed = append(parsed, c)
+				conditions = append(conditions, cmdConditions)
 			}
 		}
 	} else {
-		commandInfo.DisplayName = GetFullDisplayName(commandInfo.Command, commandInfo.DisplayName, blockInfo, FunctionInfo{})
+		cmdConditions := renderConditions(catcher, commandInfo.If)
+		commandInfo.DisplayName = withConditions(GetFullDisplayName(commandInfo.Command, commandInfo.DisplayName, blockInfo, FunctionInfo{}), cmdConditions)
 		parsed = append(parsed, commandInfo)
+		conditions = append(conditions, cmdConditions)
 	}
 
 	var out []Command
-	for _, c := range parsed {
+	for i, c := range parsed {
 		factory, ok := r.getCommandFactory(c.Command)
 		if !ok {
 			catcher.Errorf("command '%s' is not registered", c.Command)
 			continue
 		}
 
+		if c.Retry != nil {
+			if err := c.Retry.Validate(); err != nil {
+				catcher.Wrapf(err, "invalid retry policy for command %s", c.DisplayName)
+				continue
+			}
+		}
+
 		cmd := factory()
 		// Note: this parses the parameters before expansions are applied.
 		// Expansions are only available when the command is executed.
@@ -194,6 +216,8 @@ func (r *commandRegistry) renderCommands(commandInfo model.PluginCommandConf,
 		cmd.SetIdleTimeout(time.Duration(c.TimeoutSecs) * time.Second)
 		cmd.SetRetryOnFailure(c.RetryOnFailure)
 		cmd.SetFailureMetadataTags(c.FailureMetadataTags)
+		cmd.SetConditions(conditions[i])
+		cmd.SetRetryPolicy(c.Retry)
 
 		out = append(out, cmd)
 	}
@@ -205,6 +229,32 @@ func (r *commandRegistry) renderCommands(commandInfo model.PluginCommandConf,
 	return out, nil
 }
 
+// renderConditions returns the non-empty conditions, adding an error to the
+// catcher for any condition that can't be parsed.
+func renderConditions(catcher grip.Catcher, rawConditions ...string) []string {
+	var conditions []string
+	for _, raw := range rawConditions {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		if _, err := ParseCondition(raw); err != nil {
+			catcher.Add(err)
+			continue
+		}
+		conditions = append(conditions, raw)
+	}
+	return conditions
+}
+
+// withConditions appends the conditions to the command's display name so that
+// it's clear when a command only runs conditionally.
+func withConditions(displayName string, conditions []string) string {
+	if len(conditions) == 0 {
+		return displayName
+	}
+	return fmt.Sprintf("%s if '%s'", displayName, strings.Join(conditions, "' and '"))
+}
+
 // BlockType is the name of the block that a command runs in.
 type BlockType string
 
@@ -168,6 +168,89 @@ func TestRenderCommands(t *testing.T) {
 		assert.Equal(t, "'command.mock' in function 'my-func' (step 1.2 of 1) in block 'pre'", cmds[1].FullDisplayName())
 		assert.Equal(t, "'command.mock' ('run-a-shell-thing') in function 'my-func' (step 1.3 of 1) in block 'pre'", cmds[2].FullDisplayName())
 	})
+	t.Run("CommandWithConditionIncludesConditionInDisplayName", func(t *testing.T) {
+		info := model.PluginCommandConf{
+			Command: "command.mock",
+			If:      "${is_patch} == true",
+		}
+		cmds, err := registry.renderCommands(info, &model.Project{}, BlockInfo{})
+		require.NoError(t, err)
+		require.Len(t, cmds, 1)
+		assert.Equal(t, "'command.mock' if '${is_patch} == true'", cmds[0].FullDisplayName())
+		assert.Equal(t, []string{"${is_patch} == true"}, cmds[0].Conditions())
+	})
+	t.Run("CommandsInFuncHaveFunctionAndCommandConditions", func(t *testing.T) {
+		info := model.PluginCommandConf{
+			Function: "my-func",
+			If:       "failure()",
+		}
+		p := &model.Project{
+			Functions: map[string]*model.YAMLCommandSet{
+				"my-func": {
+					MultiCommand: []model.PluginCommandConf{
+						{
+							Command: "command.mock",
+						},
+						{
+							Command: "command.mock",
+							If:      "${build_variant} != windows",
+						},
+					},
+				},
+			},
+		}
+		cmds, err := registry.renderCommands(info, p, BlockInfo{})
+		require.NoError(t, err)
+		require.Len(t, cmds, 2)
+		assert.Equal(t, "'command.mock' in function 'my-func' if 'failure()'", cmds[0].FullDisplayName())
+		assert.Equal(t, []string{"failure()"}, cmds[0].Conditions())
+		assert.Equal(t, "'command.mock' in function 'my-func' if 'failure()' and '${build_variant} != windows'", cmds[1].FullDisplayName())
+		assert.Equal(t, []string{"failure()", "${build_variant} != windows"}, cmds[1].Conditions())
+	})
+	t.Run("InvalidConditionErrors", func(t *testing.T) {
+		info := model.PluginCommandConf{
+			Command: "command.mock",
+			If:      "(failure()",
+		}
+		_, err := registry.renderCommands(info, &model.Project{}, BlockInfo{})
+		assert.Error(t, err)
+	})
+	t.Run("CommandsInFuncInheritFunctionRetryPolicy", func(t *testing.T) {
+		funcPolicy := &model.CommandRetryPolicy{Attempts: 3, BackoffSecs: 5}
+		cmdPolicy := &model.CommandRetryPolicy{Attempts: 2}
+		info := model.PluginCommandConf{
+			Function: "my-func",
+			Retry:    funcPolicy,
+		}
+		p := &model.Project{
+			Functions: map[string]*model.YAMLCommandSet{
+				"my-func": {
+					MultiCommand: []model.PluginCommandConf{
+						{
+							Command: "command.mock",
+						},
+						{
+							Command: "command.mock",
+							Retry:   cmdPolicy,
+						},
+					},
+				},
+			},
+		}
+		cmds, err := registry.renderCommands(info, p, BlockInfo{})
+		require.NoError(t, err)
+		require.Len(t, cmds, 2)
+		assert.Equal(t, funcPolicy, cmds[0].RetryPolicy())
+		assert.Equal(t, cmdPolicy, cmds[1].RetryPolicy())
+	})
+	t.Run("InvalidRetryPolicyErrors", func(t *testing.T) {
+		info := model.PluginCommandConf{
+			Command: "command.mock",
+			Retry:   &model.CommandRetryPolicy{Attempts: 0},
+		}
+		_, err := registry.renderCommands(info, &model.Project{}, BlockInfo{})
+		assert.Error(t, err)
+	})
 }
 
 func TestGetFullDisplayName(t *testing.T) {
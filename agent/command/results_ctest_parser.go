@@ -0,0 +1,169 @@
+package command
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+const (
+	ctestStatusPassed = "passed"
+	ctestStatusNotRun = "notrun"
+
+	ctestExecutionTimeMeasurement    = "Execution Time"
+	ctestCompletionStatusMeasurement = "Completion Status"
+)
+
+// ctestSite is the root of the Test.xml file written by "ctest -T Test".
+type ctestSite struct {
+	Testing ctestTesting `xml:"Testing"`
+}
+
+type ctestTesting struct {
+	Tests []ctestTest `xml:"Test"`
+}
+
+type ctestTest struct {
+	Status          string        `xml:"Status,attr"`
+	Name            string        `xml:"Name"`
+	FullName        string        `xml:"FullName"`
+	FullCommandLine string        `xml:"FullCommandLine"`
+	Results         ctestTestInfo `xml:"Results"`
+}
+
+type ctestTestInfo struct {
+	NamedMeasurements []ctestNamedMeasurement `xml:"NamedMeasurement"`
+	Measurement       ctestMeasurement        `xml:"Measurement"`
+}
+
+type ctestNamedMeasurement struct {
+	Name  string `xml:"name,attr"`
+	Type  string `xml:"type,attr"`
+	Value string `xml:"Value"`
+}
+
+type ctestMeasurement struct {
+	Value ctestMeasurementValue `xml:"Value"`
+}
+
+type ctestMeasurementValue struct {
+	Encoding    string `xml:"encoding,attr"`
+	Compression string `xml:"compression,attr"`
+	Content     string `xml:",chardata"`
+}
+
+// ctestParser parses the Test.xml file that CTest writes to the Testing
+// directory when run with "-T Test".
+type ctestParser struct{}
+
+func ctestParserFactory() ResultsParser { return &ctestParser{} }
+
+// Parse reads a CTest XML report and returns each test along with its
+// captured output.
+func (*ctestParser) Parse(report io.Reader) ([]ParsedTestResult, error) {
+	site := ctestSite{}
+	if err := xml.NewDecoder(report).Decode(&site); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling CTest XML")
+	}
+
+	results := make([]ParsedTestResult, 0, len(site.Testing.Tests))
+	for _, t := range site.Testing.Tests {
+		res, err := t.toParsedTestResult()
+		if err != nil {
+			return nil, errors.Wrapf(err, "converting CTest test '%s'", t.Name)
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func (t ctestTest) toParsedTestResult() (ParsedTestResult, error) {
+	res := ParsedTestResult{Name: t.Name}
+	if res.Name == "" {
+		res.Name = t.FullName
+	}
+
+	switch strings.ToLower(t.Status) {
+	case ctestStatusPassed:
+		res.Status = evergreen.TestSucceededStatus
+	case ctestStatusNotRun:
+		res.Status = evergreen.TestSkippedStatus
+	default:
+		res.Status = evergreen.TestFailedStatus
+	}
+
+	var completionStatus string
+	for _, m := range t.Results.NamedMeasurements {
+		switch m.Name {
+		case ctestExecutionTimeMeasurement:
+			secs, err := strconv.ParseFloat(strings.TrimSpace(m.Value), 64)
+			if err != nil {
+				return res, errors.Wrap(err, "parsing execution time")
+			}
+			res.Duration = time.Duration(secs * float64(time.Second))
+		case ctestCompletionStatusMeasurement:
+			completionStatus = strings.TrimSpace(m.Value)
+		}
+	}
+
+	if t.FullCommandLine != "" {
+		res.Lines = append(res.Lines, "Command: "+t.FullCommandLine)
+	}
+	if completionStatus != "" {
+		res.Lines = append(res.Lines, "Completion Status: "+completionStatus)
+	}
+	output, err := t.Results.Measurement.Value.decode()
+	if err != nil {
+		return res, errors.Wrap(err, "decoding test output")
+	}
+	if output = strings.TrimRight(output, "\n"); output != "" {
+		res.Lines = append(res.Lines, strings.Split(output, "\n")...)
+	}
+
+	return res, nil
+}
+
+// decode returns the test output, which CTest may store base64-encoded and
+// gzip-compressed when it is large.
+func (v ctestMeasurementValue) decode() (string, error) {
+	if v.Encoding == "" && v.Compression == "" {
+		return v.Content, nil
+	}
+
+	data := []byte(strings.TrimSpace(v.Content))
+	if strings.EqualFold(v.Encoding, "base64") {
+		decoded, err := base64.StdEncoding.DecodeString(string(data))
+		if err != nil {
+			return "", errors.Wrap(err, "decoding base64 content")
+		}
+		data = decoded
+	}
+	if strings.EqualFold(v.Compression, "gzip") {
+		// Despite the attribute value, CTest compresses output with zlib,
+		// so fall back to it when the content has no gzip header.
+		var r io.ReadCloser
+		gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+		if err == nil {
+			r = gzipReader
+		} else if r, err = zlib.NewReader(bytes.NewReader(data)); err != nil {
+			return "", errors.Wrap(err, "creating decompression reader")
+		}
+		defer r.Close()
+		data, err = io.ReadAll(r)
+		if err != nil {
+			return "", errors.Wrap(err, "decompressing content")
+		}
+	}
+
+	return string(data), nil
+}
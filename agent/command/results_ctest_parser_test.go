@@ -0,0 +1,66 @@
+package command
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCTestParser(t *testing.T) {
+	t.Run("ParsesTestXML", func(t *testing.T) {
+		f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "results", "ctest.xml"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		results, err := ctestParserFactory().Parse(f)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		assert.Equal(t, "unit_math", results[0].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[0].Status)
+		assert.Equal(t, 21500*time.Microsecond, results[0].Duration)
+		assert.Equal(t, []string{"Command: /build/tests/unit_math", "Completion Status: Completed", "all 12 assertions passed"}, results[0].Lines)
+
+		assert.Equal(t, "unit_io", results[1].Name)
+		assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+		assert.Equal(t, 1500*time.Millisecond, results[1].Duration)
+		assert.Contains(t, results[1].Lines, "assertion failed: read_all() == 42")
+
+		assert.Equal(t, "integration_net", results[2].Name)
+		assert.Equal(t, evergreen.TestSkippedStatus, results[2].Status)
+		assert.Zero(t, results[2].Duration)
+	})
+	t.Run("DecodesCompressedOutput", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := zlib.NewWriter(&buf)
+		_, err := w.Write([]byte("line one\nline two\n"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		xml := fmt.Sprintf(`<Site><Testing><Test Status="passed"><Name>compressed</Name><Results><Measurement><Value encoding="base64" compression="gzip">%s</Value></Measurement></Results></Test></Testing></Site>`, base64.StdEncoding.EncodeToString(buf.Bytes()))
+		results, err := ctestParserFactory().Parse(strings.NewReader(xml))
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, []string{"line one", "line two"}, results[0].Lines)
+	})
+	t.Run("FailsWithInvalidXML", func(t *testing.T) {
+		_, err := ctestParserFactory().Parse(strings.NewReader("<Site><Testing>"))
+		assert.Error(t, err)
+	})
+	t.Run("FailsWithInvalidExecutionTime", func(t *testing.T) {
+		xml := `<Site><Testing><Test Status="passed"><Name>bad</Name><Results><NamedMeasurement name="Execution Time"><Value>abc</Value></NamedMeasurement></Results></Test></Testing></Site>`
+		_, err := ctestParserFactory().Parse(strings.NewReader(xml))
+		assert.Error(t, err)
+	})
+}
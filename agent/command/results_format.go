@@ -0,0 +1,178 @@
+package command
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+// formatTestResults parses files containing test results in one of the
+// registered results formats and sends the test results and logs back to
+// the server.
+type formatTestResults struct {
+	// Files is a list of file globs containing the test results to parse,
+	// relative to the task's working directory.
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	// Format is the name of the registered results format that the files
+	// are written in.
+	Format string `mapstructure:"format" plugin:"expand"`
+
+	// OptionalOutput, when set to true, causes this command to be skipped
+	// over without an error when no files are found to be parsed.
+	OptionalOutput   string `mapstructure:"optional_output" plugin:"expand"`
+	outputIsOptional bool
+
+	base
+}
+
+func formatTestResultsFactory() Command   { return &formatTestResults{} }
+func (c *formatTestResults) Name() string { return evergreen.AttachTestResultsCommandName }
+
+// ParseParams reads and validates the command parameters.
+func (c *formatTestResults) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	if len(c.Files) == 0 {
+		return errors.New("must specify at least one file pattern to parse")
+	}
+	if c.Format == "" {
+		return errors.New("must specify a results format")
+	}
+	// The format may be set by an expansion, in which case it can only be
+	// checked once the command runs.
+	if !strings.Contains(c.Format, "${") {
+		if _, ok := GetResultsParserFactory(c.Format); !ok {
+			return errors.Errorf("unrecognized results format '%s', must be one of: %s", c.Format, strings.Join(RegisteredResultsFormats(), ", "))
+		}
+	}
+
+	return nil
+}
+
+// Execute parses the specified result files and sends the test results and
+// logs found in them back to the server.
+func (c *formatTestResults) Execute(ctx context.Context,
+	comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+
+	if c.OptionalOutput != "" {
+		var err error
+		c.outputIsOptional, err = strconv.ParseBool(c.OptionalOutput)
+		if err != nil {
+			return errors.Wrap(err, "parsing optional output parameter as a boolean")
+		}
+	}
+
+	factory, ok := GetResultsParserFactory(c.Format)
+	if !ok {
+		return errors.Errorf("unrecognized results format '%s', must be one of: %s", c.Format, strings.Join(RegisteredResultsFormats(), ", "))
+	}
+
+	// All file patterns should be relative to the task's working directory.
+	for i, file := range c.Files {
+		c.Files[i] = GetWorkingDirectory(conf, file)
+	}
+
+	resultFiles, err := globFiles(c.Files...)
+	if err != nil {
+		return errors.Wrap(err, "obtaining names of result files")
+	}
+	if len(resultFiles) == 0 {
+		if c.outputIsOptional {
+			logger.Task().Info("No result files found to parse, skipping because output is optional.")
+			return nil
+		}
+		return errors.New("no files found to be parsed")
+	}
+
+	var (
+		allLogs    []testlog.TestLog
+		allResults []testresult.TestResult
+	)
+	for _, resultFile := range resultFiles {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "canceled while parsing result files")
+		}
+
+		log, results, err := c.parseResultFile(conf, factory(), resultFile)
+		if err != nil {
+			return errors.Wrapf(err, "parsing '%s' results file '%s'", c.Format, resultFile)
+		}
+		logger.Task().Infof("Parsed %d test result(s) from file '%s'.", len(results), resultFile)
+
+		allResults = append(allResults, results...)
+		if len(log.Lines) > 0 {
+			allLogs = append(allLogs, log)
+		}
+	}
+
+	if len(allResults) == 0 {
+		logger.Task().Warningf("Result files did not contain any '%s' test results.", c.Format)
+		return nil
+	}
+
+	return errors.Wrap(sendTestLogsAndResults(ctx, comm, logger, conf, allLogs, allResults), "sending test logs and test results")
+}
+
+// parseResultFile parses a single result file into test results and a
+// single test log containing every test's log lines. Each test result points
+// to the line in the test log where its own lines begin.
+func (c *formatTestResults) parseResultFile(conf *internal.TaskConfig, parser ResultsParser, fileName string) (testlog.TestLog, []testresult.TestResult, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return testlog.TestLog{}, nil, errors.Wrap(err, "opening file")
+	}
+	defer f.Close()
+
+	parsed, err := parser.Parse(f)
+	if err != nil {
+		return testlog.TestLog{}, nil, errors.WithStack(err)
+	}
+
+	// Log names must be unique, since different directories may contain
+	// result files with the same name.
+	log := testlog.TestLog{
+		Name:          utility.RandomString(),
+		Task:          conf.Task.Id,
+		TaskExecution: conf.Task.Execution,
+	}
+
+	results := make([]testresult.TestResult, 0, len(parsed))
+	for _, p := range parsed {
+		start := time.Now()
+		res := testresult.TestResult{
+			TestName:      p.Name,
+			Status:        p.Status,
+			TestStartTime: start,
+			TestEndTime:   start.Add(p.Duration),
+		}
+		if len(p.Lines) > 0 {
+			res.LogInfo = &testresult.TestLogInfo{
+				LogName: log.Name,
+				LineNum: int32(len(log.Lines)),
+			}
+			log.Lines = append(log.Lines, p.Lines...)
+		}
+		results = append(results, res)
+	}
+
+	return log, results, nil
+}
@@ -0,0 +1,133 @@
+package command
+
+import (
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// Names of the test result formats supported by attach.test_results.
+const (
+	ResultsFormatGoTest      = "gotest"
+	ResultsFormatXUnit       = "xunit"
+	ResultsFormatTAP         = "tap"
+	ResultsFormatCTest       = "ctest"
+	ResultsFormatPytestJSON  = "pytest_json"
+	ResultsFormatLibtestJSON = "libtest_json"
+)
+
+var resultsFormatRegistry *resultsParserRegistry
+
+func init() {
+	resultsFormatRegistry = newResultsParserRegistry()
+
+	parsers := map[string]ResultsParserFactory{
+		ResultsFormatGoTest:      goTestFormatParserFactory,
+		ResultsFormatXUnit:       xunitFormatParserFactory,
+		ResultsFormatTAP:         tapParserFactory,
+		ResultsFormatCTest:       ctestParserFactory,
+		ResultsFormatPytestJSON:  pytestJSONParserFactory,
+		ResultsFormatLibtestJSON: libtestJSONParserFactory,
+	}
+
+	for name, factory := range parsers {
+		grip.EmergencyPanic(RegisterResultsFormat(name, factory))
+	}
+}
+
+// ParsedTestResult is a format-agnostic test result produced by a
+// ResultsParser. It is converted into a test result and a section of the
+// file's test log before it is sent to the server.
+type ParsedTestResult struct {
+	// Name is the name of the test.
+	Name string
+	// Status is the Evergreen test status (e.g. evergreen.TestFailedStatus).
+	Status string
+	// Duration is how long the test took to run, if known.
+	Duration time.Duration
+	// Lines are the log lines that belong to this test.
+	Lines []string
+}
+
+// ResultsParser parses test results in a particular format.
+type ResultsParser interface {
+	// Parse reads the test results from the given report.
+	Parse(io.Reader) ([]ParsedTestResult, error)
+}
+
+// ResultsParserFactory returns a new ResultsParser.
+type ResultsParserFactory func() ResultsParser
+
+// RegisterResultsFormat makes a test result parser available to the
+// attach.test_results command under the given format name.
+func RegisterResultsFormat(name string, factory ResultsParserFactory) error {
+	return errors.Wrapf(resultsFormatRegistry.registerParser(name, factory), "registering results format '%s'", name)
+}
+
+// GetResultsParserFactory returns the parser factory for the given format
+// name.
+func GetResultsParserFactory(name string) (ResultsParserFactory, bool) {
+	return resultsFormatRegistry.getParserFactory(name)
+}
+
+// RegisteredResultsFormats returns the sorted names of all registered test
+// result formats.
+func RegisteredResultsFormats() []string { return resultsFormatRegistry.registeredFormats() }
+
+type resultsParserRegistry struct {
+	mu      *sync.RWMutex
+	parsers map[string]ResultsParserFactory
+}
+
+func newResultsParserRegistry() *resultsParserRegistry {
+	return &resultsParserRegistry{
+		parsers: map[string]ResultsParserFactory{},
+		mu:      &sync.RWMutex{},
+	}
+}
+
+func (r *resultsParserRegistry) registeredFormats() []string {
+	out := []string{}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for name := range r.parsers {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+func (r *resultsParserRegistry) registerParser(name string, factory ResultsParserFactory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if name == "" {
+		return errors.New("cannot register a results format without a name")
+	}
+
+	if _, ok := r.parsers[name]; ok {
+		return errors.Errorf("results format '%s' is already registered", name)
+	}
+
+	if factory == nil {
+		return errors.Errorf("cannot register a nil factory for results format '%s'", name)
+	}
+
+	r.parsers[name] = factory
+	return nil
+}
+
+func (r *resultsParserRegistry) getParserFactory(name string) (ResultsParserFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	factory, ok := r.parsers[name]
+	return factory, ok
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockResultsParser struct{}
+
+func (*mockResultsParser) Parse(io.Reader) ([]ParsedTestResult, error) { return nil, nil }
+
+func TestResultsParserRegistry(t *testing.T) {
+	assert := assert.New(t)
+
+	r := newResultsParserRegistry()
+	assert.NotNil(r.parsers)
+	assert.NotNil(r.mu)
+	assert.Empty(r.parsers)
+
+	factory := ResultsParserFactory(func() ResultsParser { return &mockResultsParser{} })
+	assert.Error(r.registerParser("", factory))
+	assert.Empty(r.parsers)
+	assert.Error(r.registerParser("mock", nil))
+	assert.Empty(r.parsers)
+
+	assert.NoError(r.registerParser("mock", factory))
+	assert.Len(r.parsers, 1)
+	assert.Error(r.registerParser("mock", factory))
+	assert.Len(r.parsers, 1)
+
+	retFactory, ok := r.getParserFactory("mock")
+	assert.True(ok)
+	assert.NotNil(retFactory)
+	_, ok = r.getParserFactory("nonexistent")
+	assert.False(ok)
+
+	assert.Equal([]string{"mock"}, r.registeredFormats())
+}
+
+func TestGlobalResultsFormatRegistry(t *testing.T) {
+	for _, format := range []string{
+		ResultsFormatGoTest,
+		ResultsFormatXUnit,
+		ResultsFormatTAP,
+		ResultsFormatCTest,
+		ResultsFormatPytestJSON,
+		ResultsFormatLibtestJSON,
+	} {
+		factory, ok := GetResultsParserFactory(format)
+		assert.True(t, ok, format)
+		assert.NotNil(t, factory(), format)
+	}
+	assert.Len(t, RegisteredResultsFormats(), 6)
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatTestResultsParseParams(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		params    map[string]any
+		shouldErr bool
+	}{
+		"SucceedsWithRegisteredFormat": {
+			params: map[string]any{"files": []string{"results.tap"}, "format": ResultsFormatTAP},
+		},
+		"SucceedsWithExpansionFormat": {
+			params: map[string]any{"files": []string{"results.tap"}, "format": "${results_format}"},
+		},
+		"FailsWithoutFiles": {
+			params:    map[string]any{"format": ResultsFormatTAP},
+			shouldErr: true,
+		},
+		"FailsWithoutFormat": {
+			params:    map[string]any{"files": []string{"results.tap"}},
+			shouldErr: true,
+		},
+		"FailsWithUnregisteredFormat": {
+			params:    map[string]any{"files": []string{"results.tap"}, "format": "nonexistent"},
+			shouldErr: true,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			cmd := formatTestResultsFactory()
+			err := cmd.ParseParams(tCase.params)
+			if tCase.shouldErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFormatTestResultsParseResultFile(t *testing.T) {
+	conf := &internal.TaskConfig{Task: task.Task{Id: "task_id", Execution: 2}}
+	cmd := &formatTestResults{Format: ResultsFormatTAP}
+
+	log, results, err := cmd.parseResultFile(conf, tapParserFactory(), filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "results", "tap_v13.tap"))
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	assert.NotEmpty(t, log.Name)
+	assert.Equal(t, "task_id", log.Task)
+	assert.Equal(t, 2, log.TaskExecution)
+	assert.Len(t, log.Lines, 14)
+
+	expectedLineNums := []int32{0, 4, 10, 11, 12}
+	for i, res := range results {
+		require.NotNil(t, res.LogInfo)
+		assert.Equal(t, log.Name, res.LogInfo.LogName)
+		assert.Equal(t, expectedLineNums[i], res.LogInfo.LineNum)
+		assert.False(t, res.TestEndTime.Before(res.TestStartTime))
+	}
+	assert.Equal(t, "rejects bad header", results[1].TestName)
+	assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+	assert.Equal(t, "not ok 2 - rejects bad header", log.Lines[results[1].LogInfo.LineNum])
+
+	_, _, err = cmd.parseResultFile(conf, tapParserFactory(), "nonexistent.tap")
+	assert.Error(t, err)
+}
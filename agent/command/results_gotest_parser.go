@@ -255,3 +255,49 @@ func pathNameFromLogLine(line string) (string, error) {
 	}
 	return matches[1], nil
 }
+
+// goTestFormatParser adapts goTestParser to the ResultsParser interface so
+// that go test output can be parsed by attach.test_results.
+type goTestFormatParser struct{}
+
+func goTestFormatParserFactory() ResultsParser { return &goTestFormatParser{} }
+
+// Parse reads go test output and returns each test along with the log lines
+// between its start and end lines.
+func (*goTestFormatParser) Parse(report io.Reader) ([]ParsedTestResult, error) {
+	vp := &goTestParser{}
+	if err := vp.Parse(report); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var results []ParsedTestResult
+	for _, res := range vp.Results() {
+		var status string
+		switch res.Status {
+		case PASS:
+			status = evergreen.TestSucceededStatus
+		case SKIP:
+			status = evergreen.TestSkippedStatus
+		default:
+			status = evergreen.TestFailedStatus
+		}
+
+		parsed := ParsedTestResult{
+			Name:     res.Name,
+			Status:   status,
+			Duration: res.RunTime,
+		}
+		start := res.StartLine - 1
+		end := res.EndLine
+		if end < res.StartLine {
+			// The test never finished, so it owns the rest of the output.
+			end = len(vp.logs)
+		}
+		if start >= 0 && start < end && end <= len(vp.logs) {
+			parsed.Lines = vp.logs[start:end]
+		}
+		results = append(results, parsed)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,99 @@
+package command
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+const (
+	libtestTypeTest = "test"
+
+	libtestEventOK      = "ok"
+	libtestEventFailed  = "failed"
+	libtestEventIgnored = "ignored"
+	libtestEventTimeout = "timeout"
+)
+
+// libtestEvent is a single line of the JSON event stream written by the Rust
+// test harness (cargo test -- -Z unstable-options --format json).
+type libtestEvent struct {
+	Type     string  `json:"type"`
+	Event    string  `json:"event"`
+	Name     string  `json:"name"`
+	Stdout   string  `json:"stdout"`
+	Message  string  `json:"message"`
+	ExecTime float64 `json:"exec_time"`
+}
+
+// libtestJSONParser parses the JSON event stream written by the Rust libtest
+// harness. Since Cargo runs one harness per test binary, a single file may
+// contain several suites, and any lines that are not JSON events (e.g.
+// Cargo's own progress output) are ignored.
+type libtestJSONParser struct{}
+
+func libtestJSONParserFactory() ResultsParser { return &libtestJSONParser{} }
+
+// Parse reads a libtest JSON event stream and returns each finished test.
+func (*libtestJSONParser) Parse(report io.Reader) ([]ParsedTestResult, error) {
+	var results []ParsedTestResult
+	timedOut := map[string]bool{}
+
+	scanner := bufio.NewScanner(report)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+
+		event := libtestEvent{}
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			return nil, errors.Wrapf(err, "unmarshalling libtest event '%s'", line)
+		}
+		if event.Type != libtestTypeTest {
+			continue
+		}
+
+		res := ParsedTestResult{
+			Name:     event.Name,
+			Duration: time.Duration(event.ExecTime * float64(time.Second)),
+		}
+		switch event.Event {
+		case libtestEventOK:
+			res.Status = evergreen.TestSucceededStatus
+		case libtestEventFailed:
+			res.Status = evergreen.TestFailedStatus
+		case libtestEventIgnored:
+			res.Status = evergreen.TestSkippedStatus
+		case libtestEventTimeout:
+			// The harness warns that a test is running long but still
+			// reports its final outcome later.
+			timedOut[event.Name] = true
+			continue
+		default:
+			continue
+		}
+
+		if timedOut[event.Name] {
+			res.Lines = append(res.Lines, "test ran longer than the harness's time limit warning")
+		}
+		if stdout := strings.TrimRight(event.Stdout, "\n"); stdout != "" {
+			res.Lines = append(res.Lines, strings.Split(stdout, "\n")...)
+		}
+		if event.Message != "" {
+			res.Lines = append(res.Lines, event.Message)
+		}
+		results = append(results, res)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading libtest output")
+	}
+
+	return results, nil
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibtestJSONParser(t *testing.T) {
+	t.Run("ParsesEventStreamWithMultipleSuites", func(t *testing.T) {
+		f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "results", "libtest.json"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		results, err := libtestJSONParserFactory().Parse(f)
+		require.NoError(t, err)
+		require.Len(t, results, 4)
+
+		assert.Equal(t, "tests::adds", results[0].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[0].Status)
+		assert.Equal(t, 2*time.Millisecond, results[0].Duration)
+		assert.Empty(t, results[0].Lines)
+
+		assert.Equal(t, "tests::divides", results[1].Name)
+		assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+		assert.Equal(t, []string{"thread 'tests::divides' panicked at src/lib.rs:10:9:", "attempt to divide by zero"}, results[1].Lines)
+
+		assert.Equal(t, "tests::slow", results[2].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[2].Status)
+		assert.Len(t, results[2].Lines, 1)
+
+		assert.Equal(t, "requires_network", results[3].Name)
+		assert.Equal(t, evergreen.TestSkippedStatus, results[3].Status)
+		assert.Equal(t, []string{"needs network"}, results[3].Lines)
+	})
+	t.Run("FailsWithInvalidEvent", func(t *testing.T) {
+		_, err := libtestJSONParserFactory().Parse(strings.NewReader(`{ "type": "test", `))
+		assert.Error(t, err)
+	})
+}
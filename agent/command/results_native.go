@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -116,8 +117,28 @@ func (c *attachResults) Execute(ctx context.Context, comm client.Communicator, l
 		return errors.Wrapf(err, "reading report file '%s'", reportFileLoc)
 	}
 
+	return nativeResults.send(ctx, comm, logger, conf)
+}
+
+// SendNativeTestResults reads test results in the same JSON format as the
+// attach.results report file and sends them for the task. It returns the
+// number of test results that were sent.
+func SendNativeTestResults(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig, r io.ReadCloser) (int, error) {
+	var nativeResults nativeTestResults
+	if err := utility.ReadJSON(r, &nativeResults); err != nil {
+		return 0, errors.Wrap(err, "reading test results")
+	}
+	if len(nativeResults.Results) == 0 {
+		return 0, errors.New("must specify at least one test result")
+	}
+
+	return len(nativeResults.Results), nativeResults.send(ctx, comm, logger, conf)
+}
+
+// send sends the test results along with any raw test logs they contain.
+func (t nativeTestResults) send(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
 	var testLogs []testlog.TestLog
-	for i, res := range nativeResults.Results {
+	for i, res := range t.Results {
 		if res.LogRaw != "" {
 			testLogs = append(testLogs, testlog.TestLog{
 				// When sending test logs we need to use a
@@ -128,9 +149,9 @@ func (c *attachResults) Execute(ctx context.Context, comm client.Communicator, l
 				TaskExecution: conf.Task.Execution,
 				Lines:         strings.Split(res.LogRaw, "\n"),
 			})
-			nativeResults.Results[i].LogInfo = &testresult.TestLogInfo{LogName: testLogs[len(testLogs)-1].Name}
+			t.Results[i].LogInfo = &testresult.TestLogInfo{LogName: testLogs[len(testLogs)-1].Name}
 		}
 	}
 
-	return sendTestLogsAndResults(ctx, comm, logger, conf, testLogs, nativeResults.convertToService())
+	return sendTestLogsAndResults(ctx, comm, logger, conf, testLogs, t.convertToService())
 }
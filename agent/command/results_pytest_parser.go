@@ -0,0 +1,127 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+const (
+	pytestOutcomePassed  = "passed"
+	pytestOutcomeXPassed = "xpassed"
+	pytestOutcomeSkipped = "skipped"
+	pytestOutcomeXFailed = "xfailed"
+)
+
+// pytestReport is the report written by the pytest-json-report plugin
+// (pytest --json-report).
+type pytestReport struct {
+	Tests []pytestTest `json:"tests"`
+}
+
+type pytestTest struct {
+	NodeID   string           `json:"nodeid"`
+	Outcome  string           `json:"outcome"`
+	Setup    *pytestTestStage `json:"setup"`
+	Call     *pytestTestStage `json:"call"`
+	Teardown *pytestTestStage `json:"teardown"`
+}
+
+type pytestTestStage struct {
+	Duration float64         `json:"duration"`
+	Outcome  string          `json:"outcome"`
+	Stdout   string          `json:"stdout"`
+	Stderr   string          `json:"stderr"`
+	Log      []pytestLogLine `json:"log"`
+	Longrepr string          `json:"longrepr"`
+}
+
+type pytestLogLine struct {
+	Level string `json:"levelname"`
+	Msg   string `json:"msg"`
+}
+
+// pytestJSONParser parses the JSON report generated by the pytest-json-report
+// plugin.
+type pytestJSONParser struct{}
+
+func pytestJSONParserFactory() ResultsParser { return &pytestJSONParser{} }
+
+// Parse reads a pytest JSON report and returns each test along with the
+// output captured during its setup, call and teardown stages.
+func (*pytestJSONParser) Parse(report io.Reader) ([]ParsedTestResult, error) {
+	r := pytestReport{}
+	if err := json.NewDecoder(report).Decode(&r); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling pytest JSON report")
+	}
+
+	results := make([]ParsedTestResult, 0, len(r.Tests))
+	for _, t := range r.Tests {
+		results = append(results, t.toParsedTestResult())
+	}
+
+	return results, nil
+}
+
+func (t pytestTest) toParsedTestResult() ParsedTestResult {
+	res := ParsedTestResult{Name: t.NodeID}
+
+	switch t.Outcome {
+	case pytestOutcomePassed, pytestOutcomeXPassed:
+		res.Status = evergreen.TestSucceededStatus
+	case pytestOutcomeSkipped, pytestOutcomeXFailed:
+		// Expected failures are reported as skipped so that they do not
+		// fail the task.
+		res.Status = evergreen.TestSkippedStatus
+	default:
+		res.Status = evergreen.TestFailedStatus
+	}
+
+	stages := []struct {
+		name  string
+		stage *pytestTestStage
+	}{
+		{name: "setup", stage: t.Setup},
+		{name: "call", stage: t.Call},
+		{name: "teardown", stage: t.Teardown},
+	}
+	for _, s := range stages {
+		if s.stage == nil {
+			continue
+		}
+		res.Duration += time.Duration(s.stage.Duration * float64(time.Second))
+		res.Lines = append(res.Lines, s.stage.logLines(s.name)...)
+	}
+
+	return res
+}
+
+// logLines returns the captured output of the stage, prefixed with a header
+// naming the stage and the kind of output.
+func (s pytestTestStage) logLines(stageName string) []string {
+	var lines []string
+	addSection := func(kind, content string) {
+		content = strings.TrimRight(content, "\n")
+		if content == "" {
+			return
+		}
+		lines = append(lines, fmt.Sprintf("%s %s:", stageName, kind))
+		lines = append(lines, strings.Split(content, "\n")...)
+	}
+
+	addSection("stdout", s.Stdout)
+	addSection("stderr", s.Stderr)
+	var logMsgs []string
+	for _, l := range s.Log {
+		logMsgs = append(logMsgs, fmt.Sprintf("%s %s", l.Level, l.Msg))
+	}
+	addSection("log", strings.Join(logMsgs, "\n"))
+	addSection("failure", s.Longrepr)
+
+	return lines
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPytestJSONParser(t *testing.T) {
+	t.Run("ParsesReport", func(t *testing.T) {
+		f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "results", "pytest_report.json"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		results, err := pytestJSONParserFactory().Parse(f)
+		require.NoError(t, err)
+		require.Len(t, results, 5)
+
+		assert.Equal(t, "tests/test_math.py::test_add", results[0].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[0].Status)
+		assert.Equal(t, 252*time.Millisecond, results[0].Duration)
+		assert.Equal(t, []string{"call stdout:", "adding numbers"}, results[0].Lines)
+
+		assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+		assert.Equal(t, []string{
+			"call stderr:",
+			"warning: dividing",
+			"call log:",
+			"ERROR division by zero",
+			"call failure:",
+			"def test_divide():",
+			">       assert 1 / 0",
+			"E       ZeroDivisionError: division by zero",
+		}, results[1].Lines)
+
+		assert.Equal(t, evergreen.TestSkippedStatus, results[2].Status)
+		assert.Equal(t, evergreen.TestSkippedStatus, results[3].Status)
+
+		assert.Equal(t, evergreen.TestFailedStatus, results[4].Status)
+		assert.Equal(t, []string{"setup failure:", "fixture 'db' not found"}, results[4].Lines)
+	})
+	t.Run("FailsWithInvalidJSON", func(t *testing.T) {
+		_, err := pytestJSONParserFactory().Parse(strings.NewReader("{"))
+		assert.Error(t, err)
+	})
+	t.Run("EmptyReportHasNoResults", func(t *testing.T) {
+		results, err := pytestJSONParserFactory().Parse(strings.NewReader(`{"tests": []}`))
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
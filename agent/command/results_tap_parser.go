@@ -0,0 +1,142 @@
+package command
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+var (
+	// Match a TAP test point, saving whether it passed, the optional test
+	// number and the rest of the line (description and directive).
+	tapTestPointRegex = regexp.MustCompile(`^(ok|not ok)\b\s*(\d+)?\s*(?:-\s*)?(.*)$`)
+
+	// Match a SKIP or TODO directive at the end of a test point.
+	tapDirectiveRegex = regexp.MustCompile(`(?i)(?:^|\s)#\s*(SKIP|TODO)\S*\s*(.*)$`)
+
+	// Match the duration reported by common TAP producers in the YAML
+	// diagnostic block.
+	tapDurationRegex = regexp.MustCompile(`^\s*duration_ms:\s*([0-9.]+)`)
+)
+
+const (
+	tapBailOutPrefix = "Bail out!"
+	tapYAMLStart     = "---"
+	tapYAMLEnd       = "..."
+)
+
+// tapParser parses test results in the Test Anything Protocol (TAP) version
+// 13 format. Output lines that precede a test point (e.g. comments or
+// captured output) and the YAML diagnostic block that follows a test point
+// are attributed to that test.
+type tapParser struct {
+	results []ParsedTestResult
+	pending []string
+	inYAML  bool
+}
+
+func tapParserFactory() ResultsParser { return &tapParser{} }
+
+// Parse reads TAP output and returns the test points in it.
+func (p *tapParser) Parse(report io.Reader) ([]ParsedTestResult, error) {
+	scanner := bufio.NewScanner(report)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		p.handleLine(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading TAP output")
+	}
+
+	if len(p.pending) > 0 && len(p.results) > 0 {
+		last := &p.results[len(p.results)-1]
+		last.Lines = append(last.Lines, p.pending...)
+	}
+
+	return p.results, nil
+}
+
+func (p *tapParser) handleLine(line string) {
+	trimmed := strings.TrimSpace(line)
+
+	if p.inYAML {
+		last := &p.results[len(p.results)-1]
+		last.Lines = append(last.Lines, line)
+		if trimmed == tapYAMLEnd {
+			p.inYAML = false
+		} else if matches := tapDurationRegex.FindStringSubmatch(line); len(matches) == 2 {
+			if ms, err := strconv.ParseFloat(matches[1], 64); err == nil {
+				last.Duration = time.Duration(ms * float64(time.Millisecond))
+			}
+		}
+		return
+	}
+
+	switch {
+	case trimmed == tapYAMLStart && len(p.results) > 0 && line != trimmed:
+		// YAML blocks are indented and belong to the preceding test point.
+		p.inYAML = true
+		last := &p.results[len(p.results)-1]
+		last.Lines = append(last.Lines, line)
+	case tapTestPointRegex.MatchString(line):
+		p.handleTestPoint(line)
+	case strings.HasPrefix(trimmed, tapBailOutPrefix):
+		// A bail out means the remaining tests will not run, so record
+		// it as a failure rather than silently dropping the rest.
+		p.results = append(p.results, ParsedTestResult{
+			Name:   "bail_out",
+			Status: evergreen.TestFailedStatus,
+			Lines:  append(p.pending, line),
+		})
+		p.pending = nil
+	default:
+		p.pending = append(p.pending, line)
+	}
+}
+
+func (p *tapParser) handleTestPoint(line string) {
+	matches := tapTestPointRegex.FindStringSubmatch(line)
+	passed := matches[1] == "ok"
+	num := matches[2]
+	desc := matches[3]
+
+	status := evergreen.TestFailedStatus
+	if passed {
+		status = evergreen.TestSucceededStatus
+	}
+	if directive := tapDirectiveRegex.FindStringSubmatch(desc); len(directive) == 3 {
+		desc = strings.TrimSpace(desc[:len(desc)-len(directive[0])])
+		switch strings.ToUpper(directive[1]) {
+		case "SKIP":
+			status = evergreen.TestSkippedStatus
+		case "TODO":
+			// Failing TODO tests are expected to fail and do not count as
+			// failures.
+			if !passed {
+				status = evergreen.TestSkippedStatus
+			}
+		}
+	}
+
+	if num == "" {
+		num = strconv.Itoa(len(p.results) + 1)
+	}
+	name := strings.TrimSpace(desc)
+	if name == "" {
+		name = fmt.Sprintf("test %s", num)
+	}
+
+	p.results = append(p.results, ParsedTestResult{
+		Name:   name,
+		Status: status,
+		Lines:  append(p.pending, line),
+	})
+	p.pending = nil
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTAPParser(t *testing.T) {
+	t.Run("ParsesTAPVersion13File", func(t *testing.T) {
+		f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "results", "tap_v13.tap"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		results, err := tapParserFactory().Parse(f)
+		require.NoError(t, err)
+		require.Len(t, results, 5)
+
+		assert.Equal(t, "parses empty input", results[0].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[0].Status)
+		assert.Equal(t, []string{"TAP version 13", "1..5", "# starting suite", "ok 1 - parses empty input"}, results[0].Lines)
+
+		assert.Equal(t, "rejects bad header", results[1].Name)
+		assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+		assert.Equal(t, 12500*time.Microsecond, results[1].Duration)
+		require.Len(t, results[1].Lines, 6)
+		assert.Equal(t, "  message: 'expected error'", results[1].Lines[2])
+		assert.Equal(t, "  ...", results[1].Lines[5])
+
+		assert.Equal(t, "network access", results[2].Name)
+		assert.Equal(t, evergreen.TestSkippedStatus, results[2].Status)
+
+		assert.Equal(t, "future feature", results[3].Name)
+		assert.Equal(t, evergreen.TestSkippedStatus, results[3].Status)
+
+		assert.Equal(t, "test 5", results[4].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[4].Status)
+		assert.Equal(t, []string{"captured output line", "ok 5"}, results[4].Lines)
+	})
+	t.Run("TrailingOutputIsAttachedToLastTest", func(t *testing.T) {
+		results, err := tapParserFactory().Parse(strings.NewReader("ok 1 - first\n# all done\n"))
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, []string{"ok 1 - first", "# all done"}, results[0].Lines)
+	})
+	t.Run("BailOutIsRecordedAsFailure", func(t *testing.T) {
+		results, err := tapParserFactory().Parse(strings.NewReader("1..3\nok 1 - first\nBail out! database is down\n"))
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "bail_out", results[1].Name)
+		assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+		assert.Equal(t, []string{"Bail out! database is down"}, results[1].Lines)
+	})
+	t.Run("PassingTODOTestSucceeds", func(t *testing.T) {
+		results, err := tapParserFactory().Parse(strings.NewReader("ok 1 - flaky thing # TODO fix it\n"))
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "flaky thing", results[0].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[0].Status)
+	})
+	t.Run("EmptyInputHasNoResults", func(t *testing.T) {
+		results, err := tapParserFactory().Parse(strings.NewReader(""))
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
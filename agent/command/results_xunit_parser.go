@@ -166,3 +166,76 @@ func (fd failureDetails) toBasicTestLog(fdType string) *testlog.TestLog {
 	log.Lines = append(log.Lines, logLines...)
 	return &log
 }
+
+// xunitFormatParser adapts the XUnit XML parser to the ResultsParser
+// interface so that XUnit results can be parsed by attach.test_results.
+type xunitFormatParser struct{}
+
+func xunitFormatParserFactory() ResultsParser { return &xunitFormatParser{} }
+
+// Parse reads an XUnit XML report and returns every test case in it,
+// including those in nested suites.
+func (*xunitFormatParser) Parse(report io.Reader) ([]ParsedTestResult, error) {
+	suites, err := parseXMLResults(report)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var results []ParsedTestResult
+	for idx, suite := range suites {
+		results = append(results, suite.toParsedTestResults(idx)...)
+	}
+
+	return results, nil
+}
+
+func (suite testSuite) toParsedTestResults(idx int) []ParsedTestResult {
+	if len(suite.TestCases) == 0 && suite.Error != nil {
+		tc := testCase{
+			Name:  suite.Name,
+			Time:  suite.Time,
+			Error: suite.Error,
+		}
+		if tc.Name == "" {
+			tc.Name = fmt.Sprintf("Unnamed Test-%d", idx)
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	var results []ParsedTestResult
+	for _, tc := range suite.TestCases {
+		res := ParsedTestResult{Name: tc.Name}
+		if tc.ClassName != "" {
+			res.Name = fmt.Sprintf("%v.%v", tc.ClassName, tc.Name)
+		}
+		res.Name = util.CleanForPath(res.Name)
+
+		if secs := float64(tc.Time); !math.IsNaN(secs) && !math.IsInf(secs, 0) {
+			res.Duration = time.Duration(secs * float64(time.Second))
+		}
+
+		switch {
+		case tc.Failure != nil:
+			res.Status = evergreen.TestFailedStatus
+			res.Lines = tc.Failure.toBasicTestLog("FAILURE").Lines
+		case tc.Error != nil:
+			res.Status = evergreen.TestFailedStatus
+			res.Lines = tc.Error.toBasicTestLog("ERROR").Lines
+		case tc.Skipped != nil:
+			res.Status = evergreen.TestSkippedStatus
+		default:
+			res.Status = evergreen.TestSucceededStatus
+		}
+		res.Lines = append(res.Lines, constructSystemLogs(tc.SysOut, tc.SysErr)...)
+		if res.Status == evergreen.TestFailedStatus {
+			res.Lines = append(res.Lines, constructSystemLogs(suite.SysOut, suite.SysErr)...)
+		}
+
+		results = append(results, res)
+	}
+	if suite.NestedSuites != nil {
+		results = append(results, suite.NestedSuites.toParsedTestResults(idx)...)
+	}
+
+	return results
+}
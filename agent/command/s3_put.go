@@ -575,10 +575,11 @@ func (s3pc *s3put) attachFiles(ctx context.Context, comm client.Communicator, lo
 		} else if s3pc.isMulti() {
 			displayName = fmt.Sprintf("%s %s", s3pc.ResourceDisplayName, filepath.Base(fn))
 		}
-		var key, secret, bucket, fileKey string
+		var key, secret, bucket, fileKey, region string
 		if s3pc.Visibility == artifact.Signed {
 			bucket = s3pc.Bucket
 			fileKey = remoteFileName
+			region = s3pc.Region
 			// If the bucket is an internal one, Evergreen does not need the credentials
 			// to sign the URL. If the bucket is not an internal one, Evergreen needs the
 			// credentials to sign the URL.
@@ -597,6 +598,7 @@ func (s3pc *s3put) attachFiles(ctx context.Context, comm client.Communicator, lo
 			AWSRoleARN:  s3pc.TemporaryRoleARN,
 			Bucket:      bucket,
 			FileKey:     fileKey,
+			Region:      region,
 			ContentType: s3pc.ContentType,
 		})
 	}
@@ -233,7 +233,7 @@ func (c *shellExec) Execute(ctx context.Context, _ client.Communicator, logger c
 	err = cmd.Run(ctx)
 	if !c.Background && err != nil {
 		if exitCode, _ := cmd.Wait(ctx); exitCode != 0 {
-			err = errors.Errorf("exit code %d", exitCode)
+			err = newExitCodeError("", exitCode)
 		}
 	}
 	err = errors.Wrapf(err, "shell script encountered problem")
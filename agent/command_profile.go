@@ -0,0 +1,256 @@
+package agent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+const (
+	// commandProfileInterval is how often the processes of a running command
+	// are sampled.
+	commandProfileInterval = 2 * time.Second
+	// commandProfileSendTimeout is how long to wait to record a command's
+	// profile after the command finishes.
+	commandProfileSendTimeout = 30 * time.Second
+)
+
+// commandProfiler measures the resources that a command uses while it runs.
+// It periodically samples the process trees of the processes that the Jasper
+// manager is running, so every process that is running while the command runs
+// is attributed to it, including background processes started by earlier
+// commands. Processes that start and exit between samples are not observed.
+type commandProfiler struct {
+	jasper   jasper.Manager
+	interval time.Duration
+	profile  task.CommandProfile
+	// usage is the usage observed for each process while the command runs,
+	// keyed by PID.
+	usage map[int32]processUsage
+	// exited is the usage of processes whose PIDs were reused by a new
+	// process while the command ran.
+	exited []processUsage
+	cancel context.CancelFunc
+	done   chan struct{}
+	mu     sync.Mutex
+}
+
+// processUsage is the cumulative resource usage of a process.
+type processUsage struct {
+	// createTime distinguishes processes that reuse the same PID.
+	createTime int64
+	// baseline is the process's usage when it was first observed if it
+	// started before the command. Only usage beyond the baseline is
+	// attributed to the command.
+	baseline *processUsage
+	// cpuSeconds is the user and system CPU time that the process has used.
+	cpuSeconds float64
+	readBytes  uint64
+	writeBytes uint64
+}
+
+// startCommandProfiler begins profiling the named command until stop is
+// called.
+func startCommandProfiler(ctx context.Context, jpm jasper.Manager, name string, block command.BlockType, interval time.Duration) *commandProfiler {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &commandProfiler{
+		jasper:   jpm,
+		interval: interval,
+		profile: task.CommandProfile{
+			Command: name,
+			Block:   string(block),
+			Start:   time.Now(),
+		},
+		usage:  map[int32]processUsage{},
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go p.run(ctx)
+
+	return p
+}
+
+func (p *commandProfiler) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.sample(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// stop stops profiling the command and returns its profile.
+func (p *commandProfiler) stop() task.CommandProfile {
+	p.cancel()
+	<-p.done
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	profile := p.profile
+	profile.End = time.Now()
+	var readBytes, writeBytes uint64
+	usages := append([]processUsage{}, p.exited...)
+	for _, usage := range p.usage {
+		usages = append(usages, usage)
+	}
+	for _, usage := range usages {
+		cpuSeconds, read, write := usage.cpuSeconds, usage.readBytes, usage.writeBytes
+		if usage.baseline != nil {
+			cpuSeconds -= usage.baseline.cpuSeconds
+			read -= min(read, usage.baseline.readBytes)
+			write -= min(write, usage.baseline.writeBytes)
+		}
+		profile.CPUSeconds += max(cpuSeconds, 0)
+		readBytes += read
+		writeBytes += write
+	}
+	profile.DiskReadBytes = int64(readBytes)
+	profile.DiskWriteBytes = int64(writeBytes)
+
+	return profile
+}
+
+// sample observes the current resource usage of the running processes.
+func (p *commandProfiler) sample(ctx context.Context) {
+	if p.jasper == nil {
+		return
+	}
+	procs, err := p.jasper.List(ctx, options.Running)
+	if err != nil {
+		return
+	}
+	var roots []int32
+	for _, proc := range procs {
+		if pid := proc.Info(ctx).PID; pid > 0 {
+			roots = append(roots, int32(pid))
+		}
+	}
+	if len(roots) == 0 {
+		return
+	}
+
+	var rss int64
+	observed := map[int32]processUsage{}
+	for _, pid := range processTree(ctx, roots) {
+		proc, err := process.NewProcessWithContext(ctx, pid)
+		if err != nil {
+			continue
+		}
+		if mem, err := proc.MemoryInfoWithContext(ctx); err == nil {
+			rss += int64(mem.RSS)
+		}
+		usage, err := getProcessUsage(ctx, proc)
+		if err != nil {
+			continue
+		}
+		observed[pid] = usage
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.profile.PeakRSSBytes = max(p.profile.PeakRSSBytes, rss)
+	for pid, usage := range observed {
+		prev, ok := p.usage[pid]
+		switch {
+		case ok && prev.createTime == usage.createTime:
+			usage.baseline = prev.baseline
+		case ok:
+			p.exited = append(p.exited, prev)
+			fallthrough
+		default:
+			// Process create times are only precise to about a second on
+			// some platforms, so allow for that before deciding that the
+			// process started before the command.
+			if usage.createTime < p.profile.Start.Add(-time.Second).UnixMilli() {
+				baseline := usage
+				usage.baseline = &baseline
+			}
+		}
+		p.usage[pid] = usage
+	}
+}
+
+// getProcessUsage returns the cumulative resource usage of the process. Disk
+// I/O is omitted on platforms that do not report it.
+func getProcessUsage(ctx context.Context, proc *process.Process) (processUsage, error) {
+	createTime, err := proc.CreateTimeWithContext(ctx)
+	if err != nil {
+		return processUsage{}, errors.Wrap(err, "getting process create time")
+	}
+	times, err := proc.TimesWithContext(ctx)
+	if err != nil {
+		return processUsage{}, errors.Wrap(err, "getting process CPU times")
+	}
+	usage := processUsage{
+		createTime: createTime,
+		cpuSeconds: times.User + times.System,
+	}
+	if io, err := proc.IOCountersWithContext(ctx); err == nil {
+		usage.readBytes = io.ReadBytes
+		usage.writeBytes = io.WriteBytes
+	}
+
+	return usage, nil
+}
+
+// processTree returns the PIDs of the given processes and all of their
+// descendants.
+func processTree(ctx context.Context, roots []int32) []int32 {
+	procs, err := process.ProcessesWithContext(ctx)
+	if err != nil {
+		return roots
+	}
+	children := map[int32][]int32{}
+	for _, proc := range procs {
+		ppid, err := proc.PpidWithContext(ctx)
+		if err != nil {
+			continue
+		}
+		children[ppid] = append(children[ppid], proc.Pid)
+	}
+
+	seen := map[int32]bool{}
+	pids := append([]int32{}, roots...)
+	for i := 0; i < len(pids); i++ {
+		if seen[pids[i]] {
+			continue
+		}
+		seen[pids[i]] = true
+		pids = append(pids, children[pids[i]]...)
+	}
+
+	tree := make([]int32, 0, len(seen))
+	for pid := range seen {
+		tree = append(tree, pid)
+	}
+	return tree
+}
+
+// finishCommandProfile stops profiling the command and records its profile
+// in the task's command timeline. The profile is recorded even if the command
+// was stopped because the task context errored, since slow commands are the
+// ones that most often time out.
+func (a *Agent) finishCommandProfile(ctx context.Context, tc *taskContext, p *commandProfiler) {
+	profile := p.stop()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), commandProfileSendTimeout)
+	defer cancel()
+	if err := a.comm.AddCommandProfile(ctx, tc.task, profile); err != nil {
+		tc.logger.Execution().Warning(errors.Wrapf(err, "recording resource usage of command %s", profile.Command))
+	}
+}
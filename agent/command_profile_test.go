@@ -0,0 +1,68 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandProfiler(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses a POSIX shell")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	jpm, err := jasper.NewSynchronizedManager(false)
+	require.NoError(t, err)
+	defer jpm.Close(ctx)
+
+	t.Run("AttributesProcessTreeToCommand", func(t *testing.T) {
+		p := startCommandProfiler(ctx, jpm, "'shell.exec'", command.PreBlock, 50*time.Millisecond)
+
+		// The trailing command keeps the shell from exec'ing the loop, so
+		// the loop runs in a child of the Jasper process.
+		proc, err := jpm.CreateProcess(ctx, &options.Create{
+			Args: []string{"sh", "-c", "i=0; while [ $i -lt 200000 ]; do i=$((i+1)); done; sleep 1; true"},
+		})
+		require.NoError(t, err)
+		_, err = proc.Wait(ctx)
+		require.NoError(t, err)
+
+		profile := p.stop()
+		require.NoError(t, profile.Validate())
+		assert.Equal(t, "'shell.exec'", profile.Command)
+		assert.EqualValues(t, command.PreBlock, profile.Block)
+		assert.Positive(t, profile.PeakRSSBytes)
+		assert.Positive(t, profile.CPUSeconds)
+		assert.True(t, profile.End.After(profile.Start))
+	})
+	t.Run("RecordsNoUsageWithoutProcesses", func(t *testing.T) {
+		p := startCommandProfiler(ctx, jpm, "'timeout.update'", command.MainTaskBlock, 50*time.Millisecond)
+		time.Sleep(100 * time.Millisecond)
+
+		profile := p.stop()
+		require.NoError(t, profile.Validate())
+		assert.Empty(t, profile.Block)
+		assert.Zero(t, profile.PeakRSSBytes)
+		assert.Zero(t, profile.CPUSeconds)
+		assert.Zero(t, profile.DiskReadBytes)
+		assert.Zero(t, profile.DiskWriteBytes)
+	})
+}
+
+func TestProcessTree(t *testing.T) {
+	pid := int32(os.Getpid())
+	tree := processTree(context.Background(), []int32{pid})
+	assert.Contains(t, tree, pid)
+	assert.NotContains(t, tree, int32(os.Getppid()))
+}
@@ -0,0 +1,185 @@
+package agent
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/logging"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+// executeWithRetries runs the command and, if it fails, retries it according
+// to its retry policy. Commands without a retry policy run exactly once. Each
+// retry runs a newly-rendered command, since running a command can modify its
+// state (e.g. by applying expansions to its parameters). The command's idle
+// timeout applies to each attempt separately, but the task's exec timeout
+// covers all attempts.
+func (a *Agent) executeWithRetries(ctx context.Context, tc *taskContext, commandInfo model.PluginCommandConf, cmd command.Command, options runCommandsOptions) error {
+	policy := cmd.RetryPolicy()
+	if policy == nil || policy.Attempts <= 1 {
+		return cmd.Execute(ctx, a.comm, tc.logger, tc.taskConfig)
+	}
+
+	logger := tc.logger
+	var outputMatcher *outputMatchLogger
+	if policy.OnOutputRegex != "" {
+		re, err := regexp.Compile(policy.OnOutputRegex)
+		if err != nil {
+			return errors.Wrapf(err, "compiling retry output regex '%s'", policy.OnOutputRegex)
+		}
+		outputMatcher = newOutputMatchLogger(tc.logger, re)
+		logger = outputMatcher
+	}
+
+	var err error
+	attempt := 1
+	attemptCmd := cmd
+	for ; ; attempt++ {
+		if outputMatcher != nil {
+			outputMatcher.reset()
+		}
+		if attempt > 1 {
+			attemptCmd, err = a.renderRetriedCommand(tc, commandInfo, options)
+			if err != nil {
+				err = errors.Wrapf(err, "rendering command %s to retry it", cmd.FullDisplayName())
+				break
+			}
+		}
+
+		a.comm.UpdateLastMessageTime()
+		err = attemptCmd.Execute(ctx, a.comm, logger, tc.taskConfig)
+		if err == nil || ctx.Err() != nil || attempt >= policy.Attempts {
+			break
+		}
+		if !shouldRetryCommand(err, policy.OnExitCodes, outputMatcher) {
+			tc.logger.Task().Infof("Command %s failed on attempt %d of %d, but the failure does not match its retry policy, so it will not be retried.", cmd.FullDisplayName(), attempt, policy.Attempts)
+			break
+		}
+
+		delay := policy.BackoffDelay(attempt)
+		tc.logger.Task().Warningf("Command %s failed on attempt %d of %d: %s. Retrying in %s.", cmd.FullDisplayName(), attempt, policy.Attempts, err, delay.String())
+		if !a.waitForRetry(ctx, delay) {
+			break
+		}
+	}
+
+	if attempt > 1 {
+		tc.addRetriedCommand(cmd, attempt, err == nil)
+		if err == nil {
+			tc.logger.Task().Infof("Command %s succeeded on attempt %d of %d.", cmd.FullDisplayName(), attempt, policy.Attempts)
+		}
+	}
+
+	return err
+}
+
+// renderRetriedCommand renders the command again from its configuration so
+// that the retry starts from the same state as the first attempt.
+func (a *Agent) renderRetriedCommand(tc *taskContext, commandInfo model.PluginCommandConf, options runCommandsOptions) (command.Command, error) {
+	cmds, err := command.Render(commandInfo, &tc.taskConfig.Project, options.blockInfo)
+	if err != nil {
+		return nil, err
+	}
+	if options.cmdIndex >= len(cmds) {
+		return nil, errors.Errorf("command index %d is out of range for %d rendered commands", options.cmdIndex, len(cmds))
+	}
+
+	cmd := cmds[options.cmdIndex]
+	cmd.SetJasperManager(a.jasper)
+	return cmd, nil
+}
+
+// shouldRetryCommand returns whether the command failure matches the retry
+// conditions. If there are no conditions, any failure is retried; otherwise,
+// the failure is retried if it matches any of them.
+func shouldRetryCommand(err error, exitCodes []int, outputMatcher *outputMatchLogger) bool {
+	if len(exitCodes) == 0 && outputMatcher == nil {
+		return true
+	}
+	if exitCode, ok := command.GetExitCode(err); ok && slices.Contains(exitCodes, exitCode) {
+		return true
+	}
+	return outputMatcher != nil && outputMatcher.matched()
+}
+
+// waitForRetry waits for the given delay before the next attempt. It returns
+// false if the context errors before then. The wait does not count toward the
+// command's idle timeout.
+func (a *Agent) waitForRetry(ctx context.Context, delay time.Duration) bool {
+	if delay <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			a.comm.UpdateLastMessageTime()
+		case <-timer.C:
+			return true
+		}
+	}
+}
+
+// outputMatchLogger wraps a task's loggers to detect whether any of the
+// messages logged by a command match a regular expression.
+type outputMatchLogger struct {
+	client.LoggerProducer
+	found     *atomic.Bool
+	execution grip.Journaler
+	task      grip.Journaler
+	system    grip.Journaler
+}
+
+func newOutputMatchLogger(logger client.LoggerProducer, re *regexp.Regexp) *outputMatchLogger {
+	found := &atomic.Bool{}
+	wrap := func(j grip.Journaler) grip.Journaler {
+		return logging.MakeGrip(&outputMatchSender{Sender: j.GetSender(), re: re, found: found})
+	}
+	return &outputMatchLogger{
+		LoggerProducer: logger,
+		found:          found,
+		execution:      wrap(logger.Execution()),
+		task:           wrap(logger.Task()),
+		system:         wrap(logger.System()),
+	}
+}
+
+func (l *outputMatchLogger) Execution() grip.Journaler { return l.execution }
+func (l *outputMatchLogger) Task() grip.Journaler      { return l.task }
+func (l *outputMatchLogger) System() grip.Journaler    { return l.system }
+
+// reset clears any match from a previous attempt.
+func (l *outputMatchLogger) reset() { l.found.Store(false) }
+
+// matched returns whether any message matched since the last reset.
+func (l *outputMatchLogger) matched() bool { return l.found.Load() }
+
+// outputMatchSender records whether any message it sends matches a regular
+// expression.
+type outputMatchSender struct {
+	send.Sender
+	re    *regexp.Regexp
+	found *atomic.Bool
+}
+
+func (s *outputMatchSender) Send(m message.Composer) {
+	if s.Level().ShouldLog(m) && !s.found.Load() && s.re.MatchString(m.String()) {
+		s.found.Store(true)
+	}
+	s.Sender.Send(m)
+}
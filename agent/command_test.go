@@ -2,11 +2,14 @@ package agent
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/command"
 	"github.com/evergreen-ci/evergreen/agent/globals"
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
@@ -144,6 +147,15 @@ func (s *CommandSuite) TestShellExec() {
 	s.Require().NoError(err)
 	s.Equal("shell.exec test message", strings.Trim(string(data), "\r\n"))
 
+	var profiled bool
+	for _, profile := range s.mockCommunicator.CommandProfiles {
+		if strings.HasPrefix(profile.Command, "'shell.exec' in function 'foo'") {
+			s.NoError(profile.Validate())
+			profiled = true
+		}
+	}
+	s.True(profiled, "shell.exec command should be profiled")
+
 	taskData := s.mockCommunicator.EndTaskResult.TaskData
 	s.Equal(taskID, taskData.ID)
 	s.Equal(s.tc.task.Secret, taskData.Secret)
@@ -302,3 +314,229 @@ functions:
 	s.Error(err)
 	s.True(s.mockCommunicator.TaskShouldRetryOnFail)
 }
+
+func (s *CommandSuite) TestCommandRetryPolicyRetriesMatchingExitCode() {
+	marker := filepath.Join(s.tmpDirName, "attempted")
+	projYml := fmt.Sprintf(`
+functions:
+  flaky:
+    command: shell.exec
+    retry:
+      attempts: 3
+      on_exit_codes: [3]
+    params:
+      script: |
+        if [ -f "%s" ]; then exit 0; fi
+        touch "%s"
+        exit 3
+`, marker, marker)
+	s.setUpConfigAndProject(projYml)
+
+	cmdBlock := commandBlock{
+		commands:    &model.YAMLCommandSet{SingleCommand: &model.PluginCommandConf{Function: "flaky"}},
+		canFailTask: true,
+	}
+	s.NoError(s.a.runCommandsInBlock(s.ctx, s.tc, cmdBlock))
+
+	retried := s.tc.getRetriedCommands()
+	s.Require().Len(retried, 1)
+	s.Equal(2, retried[0].Attempts)
+	s.True(retried[0].Succeeded)
+	s.Empty(s.tc.getOtherFailingCommands())
+}
+
+func (s *CommandSuite) TestCommandRetryPolicyRetriesNewlyRenderedCommandInFunction() {
+	marker := filepath.Join(s.tmpDirName, "attempted")
+	output := filepath.Join(s.tmpDirName, "output")
+	projYml := fmt.Sprintf(`
+functions:
+  flaky:
+    - command: shell.exec
+      params:
+        script: echo -n "a" >> "%s"
+    - command: shell.exec
+      retry:
+        attempts: 2
+      params:
+        script: |
+          echo -n "b" >> "%s"
+          if [ -f "%s" ]; then exit 0; fi
+          touch "%s"
+          exit 1
+`, output, output, marker, marker)
+	s.setUpConfigAndProject(projYml)
+
+	cmdBlock := commandBlock{
+		commands:    &model.YAMLCommandSet{SingleCommand: &model.PluginCommandConf{Function: "flaky"}},
+		canFailTask: true,
+	}
+	s.NoError(s.a.runCommandsInBlock(s.ctx, s.tc, cmdBlock))
+
+	out, err := os.ReadFile(output)
+	s.Require().NoError(err)
+	s.Equal("abb", string(out), "only the failed command should be retried")
+
+	retried := s.tc.getRetriedCommands()
+	s.Require().Len(retried, 1)
+	s.Contains(retried[0].FullDisplayName, "step 1.2 of 1")
+	s.Equal(2, retried[0].Attempts)
+	s.True(retried[0].Succeeded)
+
+	cmds, err := command.Render(model.PluginCommandConf{Function: "flaky"}, &s.tc.taskConfig.Project, command.BlockInfo{CmdNum: 1, TotalCmds: 1})
+	s.Require().NoError(err)
+	rendered, err := s.a.renderRetriedCommand(s.tc, model.PluginCommandConf{Function: "flaky"}, runCommandsOptions{
+		blockInfo: command.BlockInfo{CmdNum: 1, TotalCmds: 1},
+		cmdIndex:  1,
+	})
+	s.Require().NoError(err)
+	s.NotSame(cmds[1], rendered)
+	s.Equal(cmds[1].FullDisplayName(), rendered.FullDisplayName())
+	s.NotNil(rendered.JasperManager())
+}
+
+func (s *CommandSuite) TestCommandRetryPolicyRetriesMatchingOutput() {
+	projYml := `
+functions:
+  flaky:
+    command: shell.exec
+    retry:
+      attempts: 2
+      on_output_regex: connection reset
+    params:
+      script: |
+        echo "connection reset by peer"
+        exit 1
+`
+	s.setUpConfigAndProject(projYml)
+
+	cmdBlock := commandBlock{
+		commands:    &model.YAMLCommandSet{SingleCommand: &model.PluginCommandConf{Function: "flaky"}},
+		canFailTask: true,
+	}
+	s.Error(s.a.runCommandsInBlock(s.ctx, s.tc, cmdBlock))
+
+	retried := s.tc.getRetriedCommands()
+	s.Require().Len(retried, 1)
+	s.Equal(2, retried[0].Attempts)
+	s.False(retried[0].Succeeded)
+}
+
+func (s *CommandSuite) TestCommandRetryPolicyDoesNotRetryUnmatchedFailure() {
+	projYml := `
+functions:
+  broken:
+    command: shell.exec
+    retry:
+      attempts: 3
+      on_exit_codes: [3]
+      on_output_regex: connection reset
+    params:
+      script: |
+        echo "compilation failed"
+        exit 1
+`
+	s.setUpConfigAndProject(projYml)
+
+	cmdBlock := commandBlock{
+		commands:    &model.YAMLCommandSet{SingleCommand: &model.PluginCommandConf{Function: "broken"}},
+		canFailTask: true,
+	}
+	s.Error(s.a.runCommandsInBlock(s.ctx, s.tc, cmdBlock))
+	s.Empty(s.tc.getRetriedCommands())
+}
+
+func (s *CommandSuite) TestMainTaskCommandsCompletedBeforeCheckpointAreSkipped() {
+	output := filepath.Join(s.tmpDirName, "output")
+	s.setUpConfigAndProject(`
+tasks:
+  - name: some task
+`)
+
+	var cmds []model.PluginCommandConf
+	for i := 1; i <= 3; i++ {
+		cmds = append(cmds, model.PluginCommandConf{
+			Command: "shell.exec",
+			Params:  map[string]any{"script": fmt.Sprintf(`echo -n "%d" >> "%s"`, i, output)},
+		})
+	}
+	checkpointCmds, err := command.Render(cmds[1], &s.tc.taskConfig.Project, command.BlockInfo{
+		Block:     command.MainTaskBlock,
+		CmdNum:    2,
+		TotalCmds: len(cmds),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(checkpointCmds, 1)
+	s.True(hasMainTaskCommand(s.tc, cmds, checkpointCmds[0].FullDisplayName()))
+	s.False(hasMainTaskCommand(s.tc, cmds, "'checkpoint.save' (step 5 of 5)"))
+
+	s.tc.setResumeCheckpoint(&apimodels.TaskCheckpoint{Step: "compile", Command: checkpointCmds[0].FullDisplayName()})
+	cmdBlock := commandBlock{
+		block:       command.MainTaskBlock,
+		commands:    &model.YAMLCommandSet{MultiCommand: cmds},
+		canFailTask: true,
+	}
+	s.NoError(s.a.runCommandsInBlock(s.ctx, s.tc, cmdBlock))
+
+	data, err := os.ReadFile(output)
+	s.Require().NoError(err)
+	s.Equal("3", string(data), "only the commands after the checkpoint should run")
+}
+
+func (s *CommandSuite) TestResumeFromCheckpointStartsOverWithoutSavingCommand() {
+	s.setUpConfigAndProject(`
+tasks:
+  - name: some task
+`)
+	s.tc.taskConfig.Task.Execution = 1
+	s.tc.taskConfig.BuildCacheBucket = evergreen.BucketConfig{Name: s.T().TempDir(), Type: evergreen.BucketTypeLocal}
+	s.mockCommunicator.ResumableCheckpoint = &apimodels.TaskCheckpoint{Step: "compile", Command: "'checkpoint.save' (step 2 of 2)"}
+
+	s.a.resumeFromCheckpoint(s.ctx, s.tc, []model.PluginCommandConf{{Command: "shell.exec", Params: map[string]any{"script": "true"}}})
+	s.False(s.mockCommunicator.TaskResumed)
+	s.Nil(s.tc.resumeCheckpoint)
+}
+
+func (s *CommandSuite) TestCommandsCheckingStatusRunAfterFailureInBlock() {
+	output := filepath.Join(s.tmpDirName, "output")
+	appendOutput := func(val string) map[string]any {
+		return map[string]any{"script": fmt.Sprintf(`echo "%s" >> "%s"`, val, output)}
+	}
+	projYml := fmt.Sprintf(`
+functions:
+  fail_then_collect:
+    - command: shell.exec
+      params:
+        script: exit 1
+    - command: shell.exec
+      params:
+        script: echo "function_not_run" >> "%s"
+    - command: shell.exec
+      if: always()
+      params:
+        script: echo "function_always" >> "%s"
+`, output, output)
+	s.setUpConfigAndProject(projYml)
+
+	cmds := []model.PluginCommandConf{
+		{Function: "fail_then_collect"},
+		{Command: "shell.exec", Params: appendOutput("not_run")},
+		{Command: "shell.exec", If: "${key1} == expansionVar", Params: appendOutput("expansion_only")},
+		{Command: "shell.exec", If: "success()", Params: appendOutput("success")},
+		{Command: "shell.exec", If: "failure()", Params: appendOutput("failure")},
+		{Command: "shell.exec", If: "always()", Params: appendOutput("always")},
+	}
+	cmdBlock := commandBlock{
+		block:       command.MainTaskBlock,
+		commands:    &model.YAMLCommandSet{MultiCommand: cmds},
+		canFailTask: true,
+	}
+	err := s.a.runCommandsInBlock(s.ctx, s.tc, cmdBlock)
+	s.Require().Error(err, "block should return the error from the failed command")
+
+	data, err := os.ReadFile(output)
+	s.Require().NoError(err)
+	s.Equal([]string{"function_always", "failure", "always"}, strings.Fields(string(data)))
+
+	s.Require().NotNil(s.tc.getCurrentCommand())
+	s.Contains(s.tc.getCurrentCommand().FullDisplayName(), "in function 'fail_then_collect' (step 1.1 of 6)", "failed command should still be the current command")
+}
@@ -129,6 +129,13 @@ const (
 	// AWSRoleExpiration is the expansion name for the expiration of a temporary AWS access key.
 	AWSRoleExpiration = "AWS_ROLE_EXPIRATION"
 
+	// CacheHit is the expansion name for whether cache.restore restored the
+	// build cache entry with the exact key requested.
+	CacheHit = "cache_hit"
+	// CacheMatchedKey is the expansion name for the key of the build cache
+	// entry that cache.restore restored, if any.
+	CacheMatchedKey = "cache_matched_key"
+
 	// HostSecret is the placeholder name within the agent for the host's
 	// secret. The host secret is not an expansion, but is still a sensitive
 	// Evergreen-internal value that should be redacted.
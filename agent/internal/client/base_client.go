@@ -1068,3 +1068,99 @@ func (c *baseCommunicator) S3Credentials(ctx context.Context, td TaskData, bucke
 	}
 	return &creds, nil
 }
+
+func (c *baseCommunicator) RestoreBuildCacheEntry(ctx context.Context, td TaskData, req apimodels.BuildCacheRestoreRequest) (*apimodels.BuildCacheEntry, error) {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &td,
+	}
+	info.setTaskPathSuffix("build_cache/restore")
+	resp, err := c.retryRequest(ctx, info, req)
+	if err != nil {
+		return nil, util.RespError(resp, errors.Wrapf(err, "finding build cache entry '%s'", req.Key).Error())
+	}
+	defer resp.Body.Close()
+	var restoreResp apimodels.BuildCacheRestoreResponse
+	if err := utility.ReadJSON(resp.Body, &restoreResp); err != nil {
+		return nil, errors.Wrap(err, "reading build cache restore response")
+	}
+	return restoreResp.Entry, nil
+}
+
+func (c *baseCommunicator) SaveBuildCacheEntry(ctx context.Context, td TaskData, entry apimodels.BuildCacheEntry) error {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &td,
+	}
+	info.setTaskPathSuffix("build_cache/save")
+	resp, err := c.retryRequest(ctx, info, entry)
+	if err != nil {
+		return util.RespError(resp, errors.Wrapf(err, "saving build cache entry '%s'", entry.Key).Error())
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (c *baseCommunicator) AddCommandProfile(ctx context.Context, td TaskData, profile task.CommandProfile) error {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &td,
+	}
+	info.setTaskPathSuffix("command_profile")
+	resp, err := c.retryRequest(ctx, info, profile)
+	if err != nil {
+		return util.RespError(resp, errors.Wrapf(err, "adding profile of command '%s'", profile.Command).Error())
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (c *baseCommunicator) GetTaskCheckpoint(ctx context.Context, td TaskData) (*apimodels.TaskCheckpoint, error) {
+	info := requestInfo{
+		method:   http.MethodGet,
+		taskData: &td,
+	}
+	info.setTaskPathSuffix("checkpoint")
+	resp, err := c.retryRequest(ctx, info, nil)
+	if err != nil {
+		return nil, util.RespError(resp, errors.Wrap(err, "getting task checkpoint").Error())
+	}
+	defer resp.Body.Close()
+	var checkpointResp apimodels.TaskCheckpointResponse
+	if err := utility.ReadJSON(resp.Body, &checkpointResp); err != nil {
+		return nil, errors.Wrap(err, "reading task checkpoint response")
+	}
+	return checkpointResp.Checkpoint, nil
+}
+
+func (c *baseCommunicator) SaveTaskCheckpoint(ctx context.Context, td TaskData, checkpoint apimodels.TaskCheckpoint) (*apimodels.TaskCheckpoint, error) {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &td,
+	}
+	info.setTaskPathSuffix("checkpoint")
+	resp, err := c.retryRequest(ctx, info, checkpoint)
+	if err != nil {
+		return nil, util.RespError(resp, errors.Wrapf(err, "saving task checkpoint '%s'", checkpoint.Step).Error())
+	}
+	defer resp.Body.Close()
+	var saveResp apimodels.TaskCheckpointSaveResponse
+	if err := utility.ReadJSON(resp.Body, &saveResp); err != nil {
+		return nil, errors.Wrap(err, "reading task checkpoint save response")
+	}
+	return saveResp.Replaced, nil
+}
+
+func (c *baseCommunicator) MarkTaskResumed(ctx context.Context, td TaskData) error {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &td,
+	}
+	info.setTaskPathSuffix("checkpoint/resume")
+	resp, err := c.retryRequest(ctx, info, nil)
+	if err != nil {
+		return util.RespError(resp, errors.Wrap(err, "marking task resumed from checkpoint").Error())
+	}
+	defer resp.Body.Close()
+	return nil
+}
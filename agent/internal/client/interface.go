@@ -141,6 +141,30 @@ type SharedCommunicator interface {
 
 	// S3Credentials returns the S3 credentials for the task when uploading to devprod owned buckets.
 	S3Credentials(ctx context.Context, td TaskData, bucket string) (*apimodels.AWSCredentials, error)
+
+	// RestoreBuildCacheEntry returns the build cache entry to restore for the
+	// request, or nil if no entry matches.
+	RestoreBuildCacheEntry(ctx context.Context, td TaskData, req apimodels.BuildCacheRestoreRequest) (*apimodels.BuildCacheEntry, error)
+
+	// SaveBuildCacheEntry records an archive that the task uploaded to the
+	// build cache.
+	SaveBuildCacheEntry(ctx context.Context, td TaskData, entry apimodels.BuildCacheEntry) error
+
+	// AddCommandProfile records the resources that a command used while it
+	// ran in the task.
+	AddCommandProfile(ctx context.Context, td TaskData, profile task.CommandProfile) error
+
+	// GetTaskCheckpoint returns the checkpoint that the task can resume
+	// from, or nil if it cannot resume from a checkpoint.
+	GetTaskCheckpoint(ctx context.Context, td TaskData) (*apimodels.TaskCheckpoint, error)
+
+	// SaveTaskCheckpoint records a checkpoint whose snapshot the task
+	// uploaded. It returns the checkpoint that it replaced, if any.
+	SaveTaskCheckpoint(ctx context.Context, td TaskData, checkpoint apimodels.TaskCheckpoint) (*apimodels.TaskCheckpoint, error)
+
+	// MarkTaskResumed records that the task resumed from its checkpoint
+	// instead of starting over.
+	MarkTaskResumed(ctx context.Context, td TaskData) error
 }
 
 // TaskData contains the taskData.ID and taskData.Secret. It must be set for
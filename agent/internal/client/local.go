@@ -0,0 +1,467 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal/redactor"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/cloud"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/manifest"
+	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/google/go-github/v52/github"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/logging"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// Names of the files that the local communicator writes to its output
+// directory.
+const (
+	LocalTaskLogFileName     = "task.log"
+	LocalSystemLogFileName   = "system.log"
+	LocalTestLogsDirName     = "test_logs"
+	LocalTestResultsFileName = "test_results.json"
+	LocalArtifactsFileName   = "artifacts.json"
+	LocalGeneratedTasksName  = "generated_tasks.json"
+	LocalCommandProfilesName = "command_profiles.json"
+	LocalTaskEndFileName     = "task_end.json"
+)
+
+// TestResultsRecorder is implemented by communicators that record test
+// results themselves rather than sending them to the test results service.
+type TestResultsRecorder interface {
+	// RecordTestResults records the given test results for the task.
+	RecordTestResults(context.Context, TaskData, []testresult.TestResult) error
+}
+
+// LocalOptions are the options for creating a local communicator.
+type LocalOptions struct {
+	// OutputDir is the directory that logs, test results and artifacts are
+	// written to.
+	OutputDir string
+	// Task is the task being run.
+	Task *task.Task
+	// Project is the project that the task belongs to.
+	Project *model.Project
+	// ProjectRef is the project ref that the task belongs to.
+	ProjectRef *model.ProjectRef
+	// ExpansionsAndVars are the expansions and variables available to the
+	// task.
+	ExpansionsAndVars *apimodels.ExpansionsAndVars
+	// OnEndTask, if set, is called with the final task details when the task
+	// ends.
+	OnEndTask func(*apimodels.TaskEndDetail)
+}
+
+// Validate checks that the required local options are set.
+func (o *LocalOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(o.OutputDir == "", "must specify an output directory")
+	catcher.NewWhen(o.Task == nil, "must specify a task")
+	catcher.NewWhen(o.Project == nil, "must specify a project")
+	catcher.NewWhen(o.ProjectRef == nil, "must specify a project ref")
+	catcher.NewWhen(o.ExpansionsAndVars == nil, "must specify expansions and vars")
+	return catcher.Resolve()
+}
+
+// localCommunicator is a Communicator for running a single task on the local
+// machine without an Evergreen app server. Task logs, test results and
+// artifacts are written to files in the output directory instead of being
+// sent to the server, and operations that can only be done by the app server
+// return an error.
+type localCommunicator struct {
+	opts LocalOptions
+
+	lastMessageSent time.Time
+	keyVals         map[string]*model.KeyVal
+	testResults     []testresult.TestResult
+	artifacts       []*artifact.File
+	generatedTasks  []json.RawMessage
+	commandProfiles []task.CommandProfile
+	resultsFailed   bool
+
+	mu sync.Mutex
+}
+
+// NewLocalCommunicator returns a Communicator that persists a locally-run
+// task's output to the output directory.
+func NewLocalCommunicator(opts LocalOptions) (Communicator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid local communicator options")
+	}
+	if err := os.MkdirAll(filepath.Join(opts.OutputDir, LocalTestLogsDirName), 0755); err != nil {
+		return nil, errors.Wrapf(err, "creating output directory '%s'", opts.OutputDir)
+	}
+
+	return &localCommunicator{
+		opts:    opts,
+		keyVals: map[string]*model.KeyVal{},
+	}, nil
+}
+
+var errNotSupportedLocally = errors.New("operation requires an Evergreen app server and is not supported when running a task locally")
+
+func (c *localCommunicator) Close() {}
+
+func (c *localCommunicator) UpdateLastMessageTime() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastMessageSent = time.Now()
+}
+
+func (c *localCommunicator) LastMessageAt() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastMessageSent
+}
+
+// EndTask records the task end details in the output directory.
+func (c *localCommunicator) EndTask(_ context.Context, detail *apimodels.TaskEndDetail, _ TaskData) (*apimodels.EndTaskResponse, error) {
+	if err := c.writeJSON(LocalTaskEndFileName, detail); err != nil {
+		return nil, errors.Wrap(err, "writing task end details")
+	}
+	if c.opts.OnEndTask != nil {
+		c.opts.OnEndTask(detail)
+	}
+	return &apimodels.EndTaskResponse{ShouldExit: true}, nil
+}
+
+// GetNextTask always indicates there are no more tasks since only a single
+// task is run locally.
+func (c *localCommunicator) GetNextTask(context.Context, *apimodels.GetNextTaskDetails) (*apimodels.NextTaskResponse, error) {
+	return &apimodels.NextTaskResponse{ShouldExit: true}, nil
+}
+
+func (c *localCommunicator) GetAgentSetupData(context.Context) (*apimodels.AgentSetupData, error) {
+	return &apimodels.AgentSetupData{}, nil
+}
+
+func (c *localCommunicator) StartTask(context.Context, TaskData) error { return nil }
+
+func (c *localCommunicator) GetTask(context.Context, TaskData) (*task.Task, error) {
+	return c.opts.Task, nil
+}
+
+func (c *localCommunicator) GetDisplayTaskInfoFromExecution(context.Context, TaskData) (*apimodels.DisplayTaskInfo, error) {
+	return &apimodels.DisplayTaskInfo{}, nil
+}
+
+func (c *localCommunicator) GetProjectRef(context.Context, TaskData) (*model.ProjectRef, error) {
+	return c.opts.ProjectRef, nil
+}
+
+func (c *localCommunicator) GetDistroView(context.Context, TaskData) (*apimodels.DistroView, error) {
+	return &apimodels.DistroView{}, nil
+}
+
+func (c *localCommunicator) GetHostView(context.Context, TaskData) (*apimodels.HostView, error) {
+	return &apimodels.HostView{}, nil
+}
+
+func (c *localCommunicator) GetDistroAMI(context.Context, string, string, TaskData) (string, error) {
+	return "", errNotSupportedLocally
+}
+
+func (c *localCommunicator) GetProject(context.Context, TaskData) (*model.Project, error) {
+	return c.opts.Project, nil
+}
+
+// Heartbeat always succeeds since a local task cannot be aborted by the app
+// server.
+func (c *localCommunicator) Heartbeat(context.Context, TaskData) (string, error) {
+	c.UpdateLastMessageTime()
+	return "", nil
+}
+
+func (c *localCommunicator) GetExpansionsAndVars(context.Context, TaskData) (*apimodels.ExpansionsAndVars, error) {
+	return c.opts.ExpansionsAndVars, nil
+}
+
+func (c *localCommunicator) GetCedarConfig(context.Context) (*apimodels.CedarConfig, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) GetCedarGRPCConn(context.Context) (*grpc.ClientConn, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) SetResultsInfo(_ context.Context, _ TaskData, _ string, failed bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.resultsFailed = c.resultsFailed || failed
+	return nil
+}
+
+// RecordTestResults appends the test results to the test results file in the
+// output directory.
+func (c *localCommunicator) RecordTestResults(_ context.Context, _ TaskData, results []testresult.TestResult) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, r := range results {
+		r.TaskID = c.opts.Task.Id
+		r.Execution = c.opts.Task.Execution
+		c.testResults = append(c.testResults, r)
+		if r.Status == evergreen.TestFailedStatus {
+			c.resultsFailed = true
+		}
+	}
+
+	return c.writeJSON(LocalTestResultsFileName, c.testResults)
+}
+
+func (c *localCommunicator) DisableHost(context.Context, string, apimodels.DisableInfo) error {
+	return errNotSupportedLocally
+}
+
+// GetLoggerProducer returns a logger producer that writes the task and
+// execution logs to standard output and the task log file, and the system
+// logs to the system log file.
+func (c *localCommunicator) GetLoggerProducer(_ context.Context, tsk *task.Task, config *LoggerConfig) (LoggerProducer, error) {
+	var redactorOpts redactor.RedactionOptions
+	if config != nil {
+		redactorOpts = config.RedactorOpts
+	}
+
+	// Each channel gets its own senders since closing the log harness closes
+	// every channel's sender.
+	execSender, err := c.makeFileSender(tsk.Id, LocalTaskLogFileName, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "making execution logger")
+	}
+	taskSender, err := c.makeFileSender(tsk.Id, LocalTaskLogFileName, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "making task logger")
+	}
+	systemSender, err := c.makeFileSender(tsk.Id, LocalSystemLogFileName, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "making system logger")
+	}
+
+	return &logHarness{
+		execution: logging.MakeGrip(redactor.NewRedactingSender(execSender, redactorOpts)),
+		task:      logging.MakeGrip(redactor.NewRedactingSender(taskSender, redactorOpts)),
+		system:    logging.MakeGrip(redactor.NewRedactingSender(systemSender, redactorOpts)),
+	}, nil
+}
+
+// makeFileSender returns a sender that appends to the named file in the
+// output directory and, if requested, also writes to standard output.
+func (c *localCommunicator) makeFileSender(name, fileName string, stdout bool) (send.Sender, error) {
+	levelInfo := send.LevelInfo{Default: level.Info, Threshold: level.Debug}
+
+	fileSender, err := send.NewFileLogger(name, filepath.Join(c.opts.OutputDir, fileName), levelInfo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating file logger for '%s'", fileName)
+	}
+	if !stdout {
+		return fileSender, nil
+	}
+
+	stdoutSender, err := send.NewNativeLogger(name, levelInfo)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating standard output logger")
+	}
+
+	return send.NewConfiguredMultiSender(stdoutSender, fileSender), nil
+}
+
+// SendTestLog writes the test log to the test logs directory.
+func (c *localCommunicator) SendTestLog(_ context.Context, _ TaskData, log *testlog.TestLog) (string, error) {
+	if log == nil {
+		return "", nil
+	}
+
+	data, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "marshalling test log")
+	}
+	fileName := filepath.Join(c.opts.OutputDir, LocalTestLogsDirName, utility.RandomString()+".json")
+	if err := os.WriteFile(fileName, data, 0644); err != nil {
+		return "", errors.Wrapf(err, "writing test log '%s'", log.Name)
+	}
+
+	return fileName, nil
+}
+
+func (c *localCommunicator) GetTaskPatch(context.Context, TaskData, string) (*patchmodel.Patch, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) GetTaskVersion(context.Context, TaskData) (*model.Version, error) {
+	return &model.Version{
+		Id:         c.opts.Task.Version,
+		Identifier: c.opts.Task.Project,
+		Revision:   c.opts.Task.Revision,
+		Requester:  c.opts.Task.Requester,
+	}, nil
+}
+
+func (c *localCommunicator) GetPatchFile(context.Context, TaskData, string) (string, error) {
+	return "", errNotSupportedLocally
+}
+
+func (c *localCommunicator) NewPush(context.Context, TaskData, *apimodels.S3CopyRequest) (*model.PushLog, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) UpdatePushStatus(context.Context, TaskData, *model.PushLog) error {
+	return errNotSupportedLocally
+}
+
+// AttachFiles appends the artifact metadata to the artifacts file in the
+// output directory.
+func (c *localCommunicator) AttachFiles(_ context.Context, _ TaskData, files []*artifact.File) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.artifacts = append(c.artifacts, files...)
+	return c.writeJSON(LocalArtifactsFileName, c.artifacts)
+}
+
+func (c *localCommunicator) GetManifest(context.Context, TaskData) (*manifest.Manifest, error) {
+	return &manifest.Manifest{}, nil
+}
+
+// KeyValInc increments the key in an in-memory store that only lasts for the
+// duration of the local task run.
+func (c *localCommunicator) KeyValInc(_ context.Context, _ TaskData, kv *model.KeyVal) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.keyVals[kv.Key]; ok {
+		*kv = *cached
+	} else {
+		c.keyVals[kv.Key] = kv
+	}
+	kv.Value++
+	return nil
+}
+
+// GenerateTasks writes the JSON for the generated tasks to the output
+// directory. The tasks are not actually created.
+func (c *localCommunicator) GenerateTasks(_ context.Context, _ TaskData, jsonBytes []json.RawMessage) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generatedTasks = append(c.generatedTasks, jsonBytes...)
+	return c.writeJSON(LocalGeneratedTasksName, c.generatedTasks)
+}
+
+func (c *localCommunicator) GenerateTasksPoll(context.Context, TaskData) (*apimodels.GeneratePollResponse, error) {
+	return &apimodels.GeneratePollResponse{Finished: true}, nil
+}
+
+func (c *localCommunicator) CreateHost(context.Context, TaskData, apimodels.CreateHost) ([]string, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) ListHosts(context.Context, TaskData) (restmodel.HostListResults, error) {
+	return restmodel.HostListResults{}, errNotSupportedLocally
+}
+
+func (c *localCommunicator) GetDockerLogs(context.Context, string, time.Time, time.Time, bool) ([]byte, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) GetDockerStatus(context.Context, string) (*cloud.ContainerStatus, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) ConcludeMerge(context.Context, string, string, TaskData) error {
+	return errNotSupportedLocally
+}
+
+func (c *localCommunicator) GetAdditionalPatches(context.Context, string, TaskData) ([]string, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) SetDownstreamParams(context.Context, []patchmodel.Parameter, TaskData) error {
+	return errNotSupportedLocally
+}
+
+// CreateInstallationTokenForClone returns no token, so commands fall back to
+// the local machine's git credentials.
+func (c *localCommunicator) CreateInstallationTokenForClone(context.Context, TaskData, string, string) (string, error) {
+	return "", nil
+}
+
+func (c *localCommunicator) CreateGitHubDynamicAccessToken(context.Context, TaskData, string, string, *github.InstallationPermissions) (string, *github.InstallationPermissions, error) {
+	return "", nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) RevokeGitHubDynamicAccessToken(context.Context, TaskData, string) error {
+	return errNotSupportedLocally
+}
+
+// MarkFailedTaskToRestart is a no-op since a locally-run task cannot be
+// restarted.
+func (c *localCommunicator) MarkFailedTaskToRestart(context.Context, TaskData) error {
+	return nil
+}
+
+func (c *localCommunicator) UpsertCheckRun(context.Context, TaskData, apimodels.CheckRunOutput) error {
+	return errNotSupportedLocally
+}
+
+func (c *localCommunicator) AssumeRole(context.Context, TaskData, apimodels.AssumeRoleRequest) (*apimodels.AWSCredentials, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) S3Credentials(context.Context, TaskData, string) (*apimodels.AWSCredentials, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) RestoreBuildCacheEntry(context.Context, TaskData, apimodels.BuildCacheRestoreRequest) (*apimodels.BuildCacheEntry, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) SaveBuildCacheEntry(context.Context, TaskData, apimodels.BuildCacheEntry) error {
+	return errNotSupportedLocally
+}
+
+func (c *localCommunicator) AddCommandProfile(ctx context.Context, td TaskData, profile task.CommandProfile) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.commandProfiles = append(c.commandProfiles, profile)
+	return c.writeJSON(LocalCommandProfilesName, c.commandProfiles)
+}
+
+func (c *localCommunicator) GetTaskCheckpoint(context.Context, TaskData) (*apimodels.TaskCheckpoint, error) {
+	// Local tasks always start from scratch.
+	return nil, nil
+}
+
+func (c *localCommunicator) SaveTaskCheckpoint(context.Context, TaskData, apimodels.TaskCheckpoint) (*apimodels.TaskCheckpoint, error) {
+	return nil, errNotSupportedLocally
+}
+
+func (c *localCommunicator) MarkTaskResumed(context.Context, TaskData) error {
+	return errNotSupportedLocally
+}
+
+// writeJSON overwrites the named file in the output directory with the JSON
+// representation of the data.
+func (c *localCommunicator) writeJSON(fileName string, data any) error {
+	out, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "marshalling '%s'", fileName)
+	}
+	return errors.Wrapf(os.WriteFile(filepath.Join(c.opts.OutputDir, fileName), out, 0644), "writing '%s'", fileName)
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalCommunicator(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	makeOpts := func(t *testing.T) LocalOptions {
+		return LocalOptions{
+			OutputDir:         t.TempDir(),
+			Task:              &task.Task{Id: "local_task", Project: "project"},
+			Project:           &model.Project{Identifier: "project"},
+			ProjectRef:        &model.ProjectRef{Id: "project", Identifier: "project"},
+			ExpansionsAndVars: &apimodels.ExpansionsAndVars{},
+		}
+	}
+	readJSON := func(t *testing.T, fileName string, out any) {
+		data, err := os.ReadFile(fileName)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, out))
+	}
+	td := TaskData{ID: "local_task"}
+
+	t.Run("RequiresOutputDirectory", func(t *testing.T) {
+		opts := makeOpts(t)
+		opts.OutputDir = ""
+		_, err := NewLocalCommunicator(opts)
+		assert.Error(t, err)
+	})
+	t.Run("RequiresTask", func(t *testing.T) {
+		opts := makeOpts(t)
+		opts.Task = nil
+		_, err := NewLocalCommunicator(opts)
+		assert.Error(t, err)
+	})
+	t.Run("ReturnsTaskData", func(t *testing.T) {
+		opts := makeOpts(t)
+		comm, err := NewLocalCommunicator(opts)
+		require.NoError(t, err)
+
+		tsk, err := comm.GetTask(ctx, td)
+		require.NoError(t, err)
+		assert.Equal(t, opts.Task, tsk)
+		project, err := comm.GetProject(ctx, td)
+		require.NoError(t, err)
+		assert.Equal(t, opts.Project, project)
+		ref, err := comm.GetProjectRef(ctx, td)
+		require.NoError(t, err)
+		assert.Equal(t, opts.ProjectRef, ref)
+		expAndVars, err := comm.GetExpansionsAndVars(ctx, td)
+		require.NoError(t, err)
+		assert.Equal(t, opts.ExpansionsAndVars, expAndVars)
+	})
+	t.Run("RecordsTestResults", func(t *testing.T) {
+		opts := makeOpts(t)
+		comm, err := NewLocalCommunicator(opts)
+		require.NoError(t, err)
+		recorder, ok := comm.(TestResultsRecorder)
+		require.True(t, ok)
+
+		require.NoError(t, recorder.RecordTestResults(ctx, td, []testresult.TestResult{{TestName: "test1", Status: evergreen.TestSucceededStatus}}))
+		require.NoError(t, recorder.RecordTestResults(ctx, td, []testresult.TestResult{{TestName: "test2", Status: evergreen.TestFailedStatus}}))
+
+		var results []testresult.TestResult
+		readJSON(t, filepath.Join(opts.OutputDir, LocalTestResultsFileName), &results)
+		require.Len(t, results, 2)
+		assert.Equal(t, "test1", results[0].TestName)
+		assert.Equal(t, "test2", results[1].TestName)
+		assert.Equal(t, opts.Task.Id, results[1].TaskID)
+	})
+	t.Run("AttachesFiles", func(t *testing.T) {
+		opts := makeOpts(t)
+		comm, err := NewLocalCommunicator(opts)
+		require.NoError(t, err)
+
+		require.NoError(t, comm.AttachFiles(ctx, td, []*artifact.File{{Name: "file1", Link: "https://example.com/file1"}}))
+		require.NoError(t, comm.AttachFiles(ctx, td, []*artifact.File{{Name: "file2", Link: "https://example.com/file2"}}))
+
+		var files []artifact.File
+		readJSON(t, filepath.Join(opts.OutputDir, LocalArtifactsFileName), &files)
+		require.Len(t, files, 2)
+		assert.Equal(t, "file1", files[0].Name)
+		assert.Equal(t, "file2", files[1].Name)
+	})
+	t.Run("IncrementsKeyVals", func(t *testing.T) {
+		comm, err := NewLocalCommunicator(makeOpts(t))
+		require.NoError(t, err)
+
+		kv := &model.KeyVal{Key: "key"}
+		require.NoError(t, comm.KeyValInc(ctx, td, kv))
+		assert.EqualValues(t, 1, kv.Value)
+		kv = &model.KeyVal{Key: "key"}
+		require.NoError(t, comm.KeyValInc(ctx, td, kv))
+		assert.EqualValues(t, 2, kv.Value)
+	})
+	t.Run("EndsTask", func(t *testing.T) {
+		opts := makeOpts(t)
+		var ended *apimodels.TaskEndDetail
+		opts.OnEndTask = func(detail *apimodels.TaskEndDetail) {
+			ended = detail
+		}
+		comm, err := NewLocalCommunicator(opts)
+		require.NoError(t, err)
+
+		detail := &apimodels.TaskEndDetail{Status: evergreen.TaskFailed, FailingCommand: "shell.exec"}
+		resp, err := comm.EndTask(ctx, detail, td)
+		require.NoError(t, err)
+		assert.True(t, resp.ShouldExit)
+		assert.Equal(t, detail, ended)
+
+		written := apimodels.TaskEndDetail{}
+		readJSON(t, filepath.Join(opts.OutputDir, LocalTaskEndFileName), &written)
+		assert.Equal(t, evergreen.TaskFailed, written.Status)
+		assert.Equal(t, "shell.exec", written.FailingCommand)
+	})
+	t.Run("WritesTaskLogs", func(t *testing.T) {
+		opts := makeOpts(t)
+		comm, err := NewLocalCommunicator(opts)
+		require.NoError(t, err)
+
+		logger, err := comm.GetLoggerProducer(ctx, opts.Task, nil)
+		require.NoError(t, err)
+		logger.Task().Info("task message")
+		logger.System().Info("system message")
+		require.NoError(t, logger.Close())
+
+		taskLog, err := os.ReadFile(filepath.Join(opts.OutputDir, LocalTaskLogFileName))
+		require.NoError(t, err)
+		assert.Contains(t, string(taskLog), "task message")
+		assert.NotContains(t, string(taskLog), "system message")
+		systemLog, err := os.ReadFile(filepath.Join(opts.OutputDir, LocalSystemLogFileName))
+		require.NoError(t, err)
+		assert.Contains(t, string(systemLog), "system message")
+	})
+	t.Run("RejectsServerOnlyOperations", func(t *testing.T) {
+		comm, err := NewLocalCommunicator(makeOpts(t))
+		require.NoError(t, err)
+
+		_, err = comm.GetCedarGRPCConn(ctx)
+		assert.Error(t, err)
+		_, err = comm.CreateHost(ctx, td, apimodels.CreateHost{})
+		assert.Error(t, err)
+		_, err = comm.GetTaskPatch(ctx, td, "")
+		assert.Error(t, err)
+	})
+}
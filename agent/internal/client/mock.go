@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync"
 	"time"
 
@@ -73,6 +74,19 @@ type Mock struct {
 	RevokeGitHubDynamicAccessTokenFail   bool
 	AssumeRoleResponse                   *apimodels.AWSCredentials
 	S3Response                           *apimodels.AWSCredentials
+	// BuildCacheEntries are the saved build cache entries, in the order they
+	// were saved.
+	BuildCacheEntries []apimodels.BuildCacheEntry
+	// CommandProfiles are the profiles of the commands that ran, in the
+	// order they were added.
+	CommandProfiles []task.CommandProfile
+	// ResumableCheckpoint is the checkpoint that the task can resume from.
+	ResumableCheckpoint *apimodels.TaskCheckpoint
+	// SavedCheckpoints are the checkpoints that the task saved, in the order
+	// they were saved.
+	SavedCheckpoints []apimodels.TaskCheckpoint
+	// TaskResumed is whether the task resumed from its checkpoint.
+	TaskResumed bool
 
 	CedarGRPCConn *grpc.ClientConn
 
@@ -619,3 +633,65 @@ func (c *Mock) AssumeRole(ctx context.Context, td TaskData, request apimodels.As
 func (c *Mock) S3Credentials(ctx context.Context, td TaskData, bucket string) (*apimodels.AWSCredentials, error) {
 	return c.S3Response, nil
 }
+
+func (c *Mock) RestoreBuildCacheEntry(ctx context.Context, td TaskData, req apimodels.BuildCacheRestoreRequest) (*apimodels.BuildCacheEntry, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, e := range c.BuildCacheEntries {
+		if e.Key == req.Key {
+			return &e, nil
+		}
+	}
+	for _, prefix := range req.RestoreKeys {
+		for i := len(c.BuildCacheEntries) - 1; i >= 0; i-- {
+			if e := c.BuildCacheEntries[i]; strings.HasPrefix(e.Key, prefix) {
+				return &e, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (c *Mock) SaveBuildCacheEntry(ctx context.Context, td TaskData, entry apimodels.BuildCacheEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.BuildCacheEntries {
+		if e.Key == entry.Key {
+			return nil
+		}
+	}
+	c.BuildCacheEntries = append(c.BuildCacheEntries, entry)
+	return nil
+}
+
+func (c *Mock) AddCommandProfile(ctx context.Context, td TaskData, profile task.CommandProfile) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.CommandProfiles = append(c.CommandProfiles, profile)
+	return nil
+}
+
+func (c *Mock) GetTaskCheckpoint(ctx context.Context, td TaskData) (*apimodels.TaskCheckpoint, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ResumableCheckpoint, nil
+}
+
+func (c *Mock) SaveTaskCheckpoint(ctx context.Context, td TaskData, checkpoint apimodels.TaskCheckpoint) (*apimodels.TaskCheckpoint, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var replaced *apimodels.TaskCheckpoint
+	if len(c.SavedCheckpoints) > 0 {
+		prev := c.SavedCheckpoints[len(c.SavedCheckpoints)-1]
+		replaced = &prev
+	}
+	c.SavedCheckpoints = append(c.SavedCheckpoints, checkpoint)
+	return replaced, nil
+}
+
+func (c *Mock) MarkTaskResumed(ctx context.Context, td TaskData) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.TaskResumed = true
+	return nil
+}
@@ -37,7 +37,9 @@ type TaskConfig struct {
 	NewExpansions *agentutil.DynamicExpansions
 
 	// DynamicExpansions holds expansions that were set from 'expansions.update'
-	// and should persist throughout the task's execution.
+	// and should persist throughout the task's execution. Since the task API
+	// can set them while a command is running, they should only be accessed
+	// through the TaskConfig's dynamic expansion methods.
 	DynamicExpansions util.Expansions
 
 	// AssumeRoleRoles holds the session tokens and role ARNs that have
@@ -73,6 +75,11 @@ type TaskConfig struct {
 	// via their IRSA role.
 	InternalBuckets []string
 
+	// BuildCacheBucket is where the cache.save and cache.restore commands
+	// store cache archives. Its name is empty if the build cache is not
+	// configured.
+	BuildCacheBucket evergreen.BucketConfig
+
 	mu sync.RWMutex
 }
 
@@ -110,6 +117,42 @@ func (t *TaskConfig) GetAndClearCommandCleanups() []CommandCleanup {
 	return cleanups
 }
 
+// PutDynamicExpansion records an expansion that was set during the task's
+// execution so that it persists after the current command finishes.
+func (t *TaskConfig) PutDynamicExpansion(key, value string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.DynamicExpansions == nil {
+		t.DynamicExpansions = util.Expansions{}
+	}
+	t.DynamicExpansions.Put(key, value)
+}
+
+// UpdateDynamicExpansionsFromYaml records the expansions in the given YAML
+// file as dynamic expansions.
+func (t *TaskConfig) UpdateDynamicExpansionsFromYaml(filename string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.DynamicExpansions == nil {
+		t.DynamicExpansions = util.Expansions{}
+	}
+	_, err := t.DynamicExpansions.UpdateFromYaml(filename)
+	return errors.WithStack(err)
+}
+
+// GetAndClearDynamicExpansions returns the dynamic expansions that have been
+// set since they were last cleared and clears them.
+func (t *TaskConfig) GetAndClearDynamicExpansions() map[string]string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	updated := t.DynamicExpansions.Map()
+	t.DynamicExpansions = util.Expansions{}
+	return updated
+}
+
 // Timeout records dynamic timeout information that has been explicitly set by
 // the user during task runtime.
 type Timeout struct {
@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/evergreen-ci/evergreen/apimodels"
@@ -73,3 +75,21 @@ func TestNewTaskConfig(t *testing.T) {
 	assert.Equal(t, p, &taskConfig.Project)
 	assert.Equal(t, task, &taskConfig.Task)
 }
+
+func TestDynamicExpansions(t *testing.T) {
+	conf := &TaskConfig{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conf.PutDynamicExpansion(strconv.Itoa(i), "value")
+		}(i)
+	}
+	wg.Wait()
+
+	updated := conf.GetAndClearDynamicExpansions()
+	assert.Len(t, updated, 10)
+	assert.Equal(t, "value", updated["3"])
+	assert.Empty(t, conf.GetAndClearDynamicExpansions())
+}
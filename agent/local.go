@@ -0,0 +1,286 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/globals"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	localProjectIdentifier = "local"
+	localVersionID         = "local"
+	localTaskOutputDirName = "task_output"
+	localAgentLogPrefix    = "agent"
+)
+
+// LocalTaskOptions are the options for running a single task locally without
+// an Evergreen app server.
+type LocalTaskOptions struct {
+	// Project is the parsed project configuration containing the task.
+	Project *model.Project
+	// TaskName is the name of the task to run.
+	TaskName string
+	// BuildVariant is the name of the build variant to run the task on.
+	BuildVariant string
+	// Expansions are user-supplied expansions, which take precedence over
+	// the default task expansions, build variant expansions and project
+	// parameters.
+	Expansions map[string]string
+	// WorkingDirectory is the directory the task runs in. It is created if it
+	// does not already exist.
+	WorkingDirectory string
+	// OutputDirectory is the directory that task logs, test results and
+	// artifacts are written to.
+	OutputDirectory string
+}
+
+// Validate checks that the options are valid and that the task exists on the
+// build variant.
+func (o *LocalTaskOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(o.Project == nil, "must specify a project")
+	catcher.NewWhen(o.TaskName == "", "must specify a task name")
+	catcher.NewWhen(o.BuildVariant == "", "must specify a build variant")
+	catcher.NewWhen(o.WorkingDirectory == "", "must specify a working directory")
+	catcher.NewWhen(o.OutputDirectory == "", "must specify an output directory")
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+
+	if o.Project.FindBuildVariant(o.BuildVariant) == nil {
+		return errors.Errorf("build variant '%s' does not exist in the project", o.BuildVariant)
+	}
+	if o.Project.FindTaskForVariant(o.TaskName, o.BuildVariant) == nil {
+		return errors.Errorf("task '%s' does not run on build variant '%s'", o.TaskName, o.BuildVariant)
+	}
+
+	return nil
+}
+
+// LocalTaskResult is the outcome of running a task locally.
+type LocalTaskResult struct {
+	// Status is the final task status.
+	Status string
+	// Details are the final task details, including the failing command (if
+	// any).
+	Details apimodels.TaskEndDetail
+	// WorkingDirectory is the directory the task ran in.
+	WorkingDirectory string
+	// OutputDirectory is the directory containing the task's output.
+	OutputDirectory string
+}
+
+// RunLocalTask runs a single task from the project on the local machine in the
+// same way the agent would run it on a host, including its pre, post and task
+// group blocks. Since there is no app server, the task output is written to
+// the output directory and commands that require the app server fail.
+func RunLocalTask(ctx context.Context, opts LocalTaskOptions) (*LocalTaskResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid local task options")
+	}
+
+	workDir, err := filepath.Abs(opts.WorkingDirectory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting absolute path of working directory '%s'", opts.WorkingDirectory)
+	}
+	outputDir, err := filepath.Abs(opts.OutputDirectory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting absolute path of output directory '%s'", opts.OutputDirectory)
+	}
+	for _, dir := range []string{workDir, outputDir} {
+		if err = os.MkdirAll(dir, 0755); err != nil {
+			return nil, errors.Wrapf(err, "creating directory '%s'", dir)
+		}
+	}
+
+	tsk, err := makeLocalTask(opts, outputDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "making local task")
+	}
+	identifier := opts.Project.Identifier
+	if identifier == "" {
+		identifier = localProjectIdentifier
+	}
+
+	result := &LocalTaskResult{
+		WorkingDirectory: workDir,
+		OutputDirectory:  outputDir,
+	}
+	comm, err := client.NewLocalCommunicator(client.LocalOptions{
+		OutputDir:         outputDir,
+		Task:              tsk,
+		Project:           opts.Project,
+		ProjectRef:        &model.ProjectRef{Id: identifier, Identifier: identifier, Enabled: true},
+		ExpansionsAndVars: makeLocalExpansionsAndVars(opts, tsk, identifier),
+		OnEndTask: func(detail *apimodels.TaskEndDetail) {
+			result.Status = detail.Status
+			result.Details = *detail
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "making local communicator")
+	}
+
+	// The agent's own logs go to a file in the output directory so that the
+	// console only shows the task's logs.
+	agentOpts := Options{
+		Mode:             globals.HostMode,
+		LogOutput:        globals.LogOutputFile,
+		LogPrefix:        filepath.Join(outputDir, localAgentLogPrefix),
+		WorkingDirectory: outputDir,
+	}
+	a, err := newWithCommunicator(ctx, agentOpts, comm)
+	if err != nil {
+		return nil, errors.Wrap(err, "making agent")
+	}
+	defer a.Close(ctx)
+	a.tracer = otel.GetTracerProvider().Tracer("noop_tracer")
+	defer func() {
+		grip.Error(errors.Wrap(a.jasper.Close(ctx), "closing processes started by the task"))
+	}()
+
+	defaultLogger, err := send.NewNativeLogger("evergreen.local", send.LevelInfo{Default: level.Info, Threshold: level.Info})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating default logger")
+	}
+	a.SetDefaultLogger(defaultLogger)
+	a.SetHomeDirectory()
+
+	if err = a.runLocalTask(ctx, tsk, workDir); err != nil {
+		return result, errors.WithStack(err)
+	}
+
+	return result, nil
+}
+
+// runLocalTask sets up and runs the task, then runs its teardown group if it
+// is part of a task group. Unlike the regular agent flow, it never removes the
+// task directory or cleans up git configuration in the home directory, since
+// those belong to the user.
+func (a *Agent) runLocalTask(ctx context.Context, tsk *task.Task, workDir string) error {
+	tc := &taskContext{
+		task: client.TaskData{
+			ID:     tsk.Id,
+			Secret: tsk.Secret,
+		},
+		oomTracker: jasper.NewOOMTracker(),
+		logger:     client.NewSingleChannelLogHarness("default", a.defaultLogger),
+	}
+
+	tc, shouldExit, err := a.setupTask(ctx, ctx, tc, nil, true, workDir)
+	if err != nil {
+		return errors.Wrap(err, "setting up task")
+	}
+	if shouldExit {
+		return nil
+	}
+	defer func() {
+		if tc.logger != nil {
+			grip.Error(errors.Wrap(tc.logger.Close(), "closing the logger producer"))
+		}
+	}()
+
+	status := a.runPreAndMain(ctx, tc)
+	if _, err = a.handleTaskResponse(ctx, tc, status, ""); err != nil {
+		return errors.Wrap(err, "finishing task")
+	}
+
+	teardownGroup, err := tc.getTeardownGroup()
+	if err != nil {
+		tc.logger.Execution().Error(errors.Wrap(err, "fetching teardown-group commands"))
+		return nil
+	}
+	if teardownGroup.commands != nil {
+		// Teardown group cannot fail the task, so its error is only logged.
+		_ = a.runCommandsInBlock(ctx, tc, *teardownGroup)
+		tc.runTaskCommandCleanups(ctx, tc.logger, a.tracer)
+		tc.runSetupGroupCommandCleanups(ctx, tc.logger, a.tracer)
+	}
+
+	return nil
+}
+
+// makeLocalTask makes the task document for a locally-run task, storing its
+// task output in local buckets in the output directory.
+func makeLocalTask(opts LocalTaskOptions, outputDir string) (*task.Task, error) {
+	var taskGroup string
+	if tg := opts.Project.FindTaskGroupForTask(opts.BuildVariant, opts.TaskName); tg != nil {
+		taskGroup = tg.Name
+	}
+
+	taskOutputDir := filepath.Join(outputDir, localTaskOutputDirName)
+	if err := os.MkdirAll(taskOutputDir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "creating task output directory '%s'", taskOutputDir)
+	}
+	bucket := evergreen.BucketConfig{Name: taskOutputDir, Type: evergreen.BucketTypeFilesystem}
+
+	return &task.Task{
+		Id:           util.CleanName(fmt.Sprintf("local_%s_%s", opts.BuildVariant, opts.TaskName)),
+		Secret:       utility.RandomString(),
+		DisplayName:  opts.TaskName,
+		BuildVariant: opts.BuildVariant,
+		BuildId:      util.CleanName(fmt.Sprintf("local_%s", opts.BuildVariant)),
+		Project:      opts.Project.Identifier,
+		Version:      localVersionID,
+		Revision:     opts.Expansions["revision"],
+		Requester:    evergreen.RepotrackerVersionRequester,
+		TaskGroup:    taskGroup,
+		CreateTime:   time.Now(),
+		TaskOutputInfo: &taskoutput.TaskOutput{
+			TaskLogs: taskoutput.TaskLogOutput{Version: 1, BucketConfig: bucket},
+			TestLogs: taskoutput.TestLogOutput{Version: 1, BucketConfig: bucket},
+		},
+	}, nil
+}
+
+// makeLocalExpansionsAndVars returns the default expansions that the app
+// server would populate for the task. The user-supplied expansions are set as
+// parameters so that they take precedence over every other expansion.
+func makeLocalExpansionsAndVars(opts LocalTaskOptions, tsk *task.Task, identifier string) *apimodels.ExpansionsAndVars {
+	expansions := util.Expansions{}
+	expansions.Put("execution", fmt.Sprintf("%d", tsk.Execution))
+	expansions.Put("version_id", tsk.Version)
+	expansions.Put("task_id", tsk.Id)
+	expansions.Put("task_name", tsk.DisplayName)
+	expansions.Put("build_id", tsk.BuildId)
+	expansions.Put("build_variant", tsk.BuildVariant)
+	expansions.Put("revision", tsk.Revision)
+	expansions.Put("github_commit", tsk.Revision)
+	expansions.Put("project", identifier)
+	expansions.Put("project_identifier", identifier)
+	expansions.Put("project_id", identifier)
+	expansions.Put("is_patch", "")
+	expansions.Put("requester", string(evergreen.InternalRequesterToUserRequester(tsk.Requester)))
+	expansions.Put("created_at", tsk.CreateTime.Format(build.IdTimeLayout))
+
+	params := map[string]string{}
+	for k, v := range opts.Expansions {
+		params[k] = v
+	}
+
+	return &apimodels.ExpansionsAndVars{
+		Expansions:  expansions,
+		Parameters:  params,
+		Vars:        map[string]string{},
+		PrivateVars: map[string]bool{},
+	}
+}
@@ -0,0 +1,172 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLocalTask(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test project uses a POSIX shell")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const projectYAML = `
+pre:
+  - command: shell.exec
+    params:
+      working_dir: ${workdir}
+      script: echo "pre" > pre.txt
+
+post:
+  - command: shell.exec
+    params:
+      working_dir: ${workdir}
+      script: echo "post" > post.txt
+
+tasks:
+  - name: passing_task
+    commands:
+      - command: shell.exec
+        params:
+          working_dir: ${workdir}
+          script: echo "${task_name} ${build_variant} ${greeting}" > main.txt
+  - name: failing_task
+    commands:
+      - command: shell.exec
+        params:
+          script: exit 1
+  - name: group_task
+
+task_groups:
+  - name: group
+    setup_group:
+      - command: shell.exec
+        params:
+          working_dir: ${workdir}
+          script: echo "setup_group" > setup_group.txt
+    teardown_group:
+      - command: shell.exec
+        params:
+          working_dir: ${workdir}
+          script: echo "teardown_group" > teardown_group.txt
+    tasks:
+      - group_task
+
+buildvariants:
+  - name: bv
+    expansions:
+      greeting: hello
+    tasks:
+      - name: passing_task
+      - name: failing_task
+      - name: group
+`
+
+	loadProject := func(t *testing.T) *model.Project {
+		p := &model.Project{}
+		_, err := model.LoadProjectInto(ctx, []byte(projectYAML), &model.GetProjectOpts{ReadFileFrom: model.ReadFromLocal}, "", p)
+		require.NoError(t, err)
+		return p
+	}
+	readFile := func(t *testing.T, fileName string) string {
+		data, err := os.ReadFile(fileName)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("RunsPreMainAndPost", func(t *testing.T) {
+		opts := LocalTaskOptions{
+			Project:          loadProject(t),
+			TaskName:         "passing_task",
+			BuildVariant:     "bv",
+			WorkingDirectory: t.TempDir(),
+			OutputDirectory:  t.TempDir(),
+		}
+		res, err := RunLocalTask(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, evergreen.TaskSucceeded, res.Status)
+
+		assert.Equal(t, "pre\n", readFile(t, filepath.Join(res.WorkingDirectory, "pre.txt")))
+		assert.Equal(t, "passing_task bv hello\n", readFile(t, filepath.Join(res.WorkingDirectory, "main.txt")))
+		assert.Equal(t, "post\n", readFile(t, filepath.Join(res.WorkingDirectory, "post.txt")))
+		assert.Contains(t, readFile(t, filepath.Join(res.OutputDirectory, client.LocalTaskLogFileName)), "Running command 'shell.exec'")
+		assert.FileExists(t, filepath.Join(res.OutputDirectory, client.LocalTaskEndFileName))
+	})
+	t.Run("UserExpansionsOverrideVariantExpansions", func(t *testing.T) {
+		opts := LocalTaskOptions{
+			Project:          loadProject(t),
+			TaskName:         "passing_task",
+			BuildVariant:     "bv",
+			Expansions:       map[string]string{"greeting": "goodbye"},
+			WorkingDirectory: t.TempDir(),
+			OutputDirectory:  t.TempDir(),
+		}
+		res, err := RunLocalTask(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, evergreen.TaskSucceeded, res.Status)
+		assert.Equal(t, "passing_task bv goodbye\n", readFile(t, filepath.Join(res.WorkingDirectory, "main.txt")))
+	})
+	t.Run("ReportsFailingCommand", func(t *testing.T) {
+		opts := LocalTaskOptions{
+			Project:          loadProject(t),
+			TaskName:         "failing_task",
+			BuildVariant:     "bv",
+			WorkingDirectory: t.TempDir(),
+			OutputDirectory:  t.TempDir(),
+		}
+		res, err := RunLocalTask(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, evergreen.TaskFailed, res.Status)
+		assert.Contains(t, res.Details.FailingCommand, "shell.exec")
+		assert.FileExists(t, filepath.Join(res.WorkingDirectory, "post.txt"))
+	})
+	t.Run("RunsTaskGroupSetupAndTeardown", func(t *testing.T) {
+		opts := LocalTaskOptions{
+			Project:          loadProject(t),
+			TaskName:         "group_task",
+			BuildVariant:     "bv",
+			WorkingDirectory: t.TempDir(),
+			OutputDirectory:  t.TempDir(),
+		}
+		res, err := RunLocalTask(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, evergreen.TaskSucceeded, res.Status)
+		assert.FileExists(t, filepath.Join(res.WorkingDirectory, "setup_group.txt"))
+		assert.FileExists(t, filepath.Join(res.WorkingDirectory, "teardown_group.txt"))
+		assert.NoFileExists(t, filepath.Join(res.WorkingDirectory, "pre.txt"), "task group tasks should not run pre")
+	})
+	t.Run("FailsWithNonexistentTask", func(t *testing.T) {
+		opts := LocalTaskOptions{
+			Project:          loadProject(t),
+			TaskName:         "nonexistent",
+			BuildVariant:     "bv",
+			WorkingDirectory: t.TempDir(),
+			OutputDirectory:  t.TempDir(),
+		}
+		_, err := RunLocalTask(ctx, opts)
+		assert.Error(t, err)
+	})
+	t.Run("FailsWithNonexistentVariant", func(t *testing.T) {
+		opts := LocalTaskOptions{
+			Project:          loadProject(t),
+			TaskName:         "passing_task",
+			BuildVariant:     "nonexistent",
+			WorkingDirectory: t.TempDir(),
+			OutputDirectory:  t.TempDir(),
+		}
+		_, err := RunLocalTask(ctx, opts)
+		assert.Error(t, err)
+	})
+}
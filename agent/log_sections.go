@@ -0,0 +1,204 @@
+package agent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	// logSectionFlushInterval is how long section records are buffered
+	// before they're written in the background.
+	logSectionFlushInterval = 10 * time.Second
+	// logSectionFlushTimeout is how long writing a batch of section records
+	// can take.
+	logSectionFlushTimeout = time.Minute
+)
+
+// logSectionRecorder records the sections of a task's logs as the task runs.
+// Sections are nested in the innermost section that is open when they begin,
+// so blocks contain functions and commands, functions contain their commands,
+// and commands contain any sections the task marks itself. Section records are
+// buffered and written in batches in the background so that recording a
+// section never waits on the log service.
+//
+// A nil recorder does not record anything, which allows commands to run
+// without a task's logs (e.g. during teardown group).
+type logSectionRecorder struct {
+	ctx      context.Context
+	output   taskoutput.TaskLogOutput
+	taskOpts taskoutput.TaskOptions
+	logger   grip.Journaler
+	// open are the sections that have begun but not ended, ordered from
+	// outermost to innermost.
+	open []log.Section
+	// pending are the section records that have not been written yet.
+	pending []log.Section
+	// flushTimer writes the pending records once it fires. It's nil if
+	// there's no write scheduled.
+	flushTimer *time.Timer
+	mu         sync.Mutex
+
+	// sequence is the sequence number of the next batch of records. It's
+	// protected by flushMu, which ensures batches are written in order.
+	sequence int
+	flushMu  sync.Mutex
+}
+
+// newLogSectionRecorder returns a recorder for the sections of the task's
+// logs. Errors recording sections are logged to the given logger rather than
+// interrupting the task.
+func newLogSectionRecorder(ctx context.Context, tsk *task.Task, logger grip.Journaler) *logSectionRecorder {
+	if tsk.TaskOutputInfo == nil {
+		return nil
+	}
+
+	return &logSectionRecorder{
+		ctx:    ctx,
+		output: tsk.TaskOutputInfo.TaskLogs,
+		taskOpts: taskoutput.TaskOptions{
+			ProjectID: tsk.Project,
+			TaskID:    tsk.Id,
+			Execution: tsk.Execution,
+		},
+		logger: logger,
+	}
+}
+
+// begin starts a new section nested in the innermost open section and returns
+// its ID.
+func (r *logSectionRecorder) begin(name string, sectionType log.SectionType) string {
+	if r == nil {
+		return ""
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	section := log.Section{
+		ID:     utility.RandomString(),
+		Name:   name,
+		Type:   sectionType,
+		Status: log.SectionStatusRunning,
+		Start:  time.Now().UnixNano(),
+	}
+	if len(r.open) > 0 {
+		section.ParentID = r.open[len(r.open)-1].ID
+	}
+	r.open = append(r.open, section)
+	r.write([]log.Section{section})
+
+	return section.ID
+}
+
+// end ends the open section with the given ID with the given status. Any
+// sections still open inside it end with it; they have the same status unless
+// it succeeded, in which case they are marked as failed because they did not
+// end on their own.
+func (r *logSectionRecorder) end(id string, status log.SectionStatus) {
+	if r == nil || id == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := len(r.open) - 1; i >= 0; i-- {
+		if r.open[i].ID == id {
+			r.endFrom(i, status)
+			return
+		}
+	}
+}
+
+// endUserSection ends the innermost open section that the task marked itself
+// with the given name.
+func (r *logSectionRecorder) endUserSection(name string, status log.SectionStatus) error {
+	if r == nil {
+		return errors.New("task logs do not support sections")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := len(r.open) - 1; i >= 0; i-- {
+		if r.open[i].Type != log.SectionTypeUser {
+			// User sections can only be ended while the command that
+			// began them is still running.
+			break
+		}
+		if r.open[i].Name == name {
+			r.endFrom(i, status)
+			return nil
+		}
+	}
+
+	return errors.Errorf("no section named '%s' is open", name)
+}
+
+// endFrom ends the open sections from the given index onward.
+func (r *logSectionRecorder) endFrom(idx int, status log.SectionStatus) {
+	ts := time.Now().UnixNano()
+	ended := make([]log.Section, 0, len(r.open)-idx)
+	for i := len(r.open) - 1; i >= idx; i-- {
+		section := r.open[i]
+		section.End = ts
+		section.Status = status
+		if i > idx && status == log.SectionStatusSucceeded {
+			section.Status = log.SectionStatusFailed
+		}
+		ended = append(ended, section)
+	}
+	r.open = r.open[:idx]
+	r.write(ended)
+}
+
+// write buffers the section records and schedules a background write if one
+// isn't already scheduled. The caller must hold the lock.
+func (r *logSectionRecorder) write(sections []log.Section) {
+	r.pending = append(r.pending, sections...)
+	if r.flushTimer != nil {
+		return
+	}
+	r.flushTimer = time.AfterFunc(logSectionFlushInterval, func() {
+		// The records should still be written if the task is canceled.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(r.ctx), logSectionFlushTimeout)
+		defer cancel()
+		r.flush(ctx)
+	})
+}
+
+// flush writes all the buffered section records in a single batch.
+func (r *logSectionRecorder) flush(ctx context.Context) {
+	if r == nil {
+		return
+	}
+
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = nil
+	if r.flushTimer != nil {
+		r.flushTimer.Stop()
+		r.flushTimer = nil
+	}
+	r.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+	err := r.output.AppendSections(ctx, r.taskOpts, r.sequence, pending)
+	r.sequence++
+	if r.logger != nil {
+		r.logger.Warning(errors.Wrap(err, "writing task log sections"))
+	}
+}
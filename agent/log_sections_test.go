@@ -0,0 +1,141 @@
+package agent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal/testutil"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSectionRecorder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	makeRecorder := func(t *testing.T) (*logSectionRecorder, func() []log.Section) {
+		tsk := &task.Task{
+			Id:             "task_id",
+			Project:        "project",
+			TaskOutputInfo: testutil.InitializeTaskOutput(t),
+		}
+		r := newLogSectionRecorder(ctx, tsk, nil)
+		require.NotNil(t, r)
+
+		getSections := func() []log.Section {
+			r.flush(ctx)
+			sections, err := tsk.TaskOutputInfo.TaskLogs.GetSections(ctx, taskoutput.TaskOptions{
+				ProjectID: tsk.Project,
+				TaskID:    tsk.Id,
+				Execution: tsk.Execution,
+			})
+			require.NoError(t, err)
+			return sections
+		}
+		return r, getSections
+	}
+
+	t.Run("NestsSections", func(t *testing.T) {
+		r, getSections := makeRecorder(t)
+		blockID := r.begin("main", log.SectionTypeBlock)
+		funcID := r.begin("func", log.SectionTypeFunction)
+		cmdID := r.begin("'shell.exec'", log.SectionTypeCommand)
+
+		sections := getSections()
+		require.Len(t, sections, 3)
+		for _, section := range sections {
+			assert.Equal(t, log.SectionStatusRunning, section.Status)
+			assert.Zero(t, section.End)
+		}
+
+		r.end(cmdID, log.SectionStatusFailed)
+		r.end(funcID, log.SectionStatusFailed)
+		r.end(blockID, log.SectionStatusFailed)
+
+		roots := log.NewSectionTree(getSections())
+		require.Len(t, roots, 1)
+		assert.Equal(t, "main", roots[0].Name)
+		assert.Equal(t, log.SectionTypeBlock, roots[0].Type)
+		require.Len(t, roots[0].Children, 1)
+		assert.Equal(t, "func", roots[0].Children[0].Name)
+		require.Len(t, roots[0].Children[0].Children, 1)
+		cmd := roots[0].Children[0].Children[0]
+		assert.Equal(t, "'shell.exec'", cmd.Name)
+		assert.Equal(t, log.SectionStatusFailed, cmd.Status)
+		assert.NotZero(t, cmd.End)
+		assert.Empty(t, r.open)
+	})
+	t.Run("EndsNestedSectionsWithParent", func(t *testing.T) {
+		r, getSections := makeRecorder(t)
+		cmdID := r.begin("'shell.exec'", log.SectionTypeCommand)
+		r.begin("compile", log.SectionTypeUser)
+
+		r.end(cmdID, log.SectionStatusSucceeded)
+
+		sections := getSections()
+		require.Len(t, sections, 2)
+		assert.Equal(t, log.SectionStatusSucceeded, sections[0].Status)
+		assert.Equal(t, "compile", sections[1].Name)
+		assert.Equal(t, log.SectionStatusFailed, sections[1].Status, "section that did not end on its own should fail")
+		assert.Equal(t, sections[0].End, sections[1].End)
+	})
+	t.Run("EndsUserSectionByName", func(t *testing.T) {
+		r, getSections := makeRecorder(t)
+		r.begin("'shell.exec'", log.SectionTypeCommand)
+		r.begin("compile", log.SectionTypeUser)
+		r.begin("link", log.SectionTypeUser)
+
+		require.NoError(t, r.endUserSection("link", log.SectionStatusSucceeded))
+		assert.Error(t, r.endUserSection("link", log.SectionStatusSucceeded))
+		require.NoError(t, r.endUserSection("compile", log.SectionStatusFailed))
+		assert.Error(t, r.endUserSection("'shell.exec'", log.SectionStatusSucceeded), "user should not be able to end command sections")
+
+		sections := getSections()
+		require.Len(t, sections, 3)
+		assert.Equal(t, log.SectionStatusRunning, sections[0].Status)
+		assert.Equal(t, log.SectionStatusFailed, sections[1].Status)
+		assert.Equal(t, log.SectionStatusSucceeded, sections[2].Status)
+	})
+	t.Run("BuffersSectionsUntilFlushed", func(t *testing.T) {
+		tsk := &task.Task{
+			Id:             "task_id",
+			Project:        "project",
+			TaskOutputInfo: testutil.InitializeTaskOutput(t),
+		}
+		r := newLogSectionRecorder(ctx, tsk, nil)
+		require.NotNil(t, r)
+		cmdID := r.begin("'shell.exec'", log.SectionTypeCommand)
+		r.begin("compile", log.SectionTypeUser)
+		r.end(cmdID, log.SectionStatusSucceeded)
+
+		taskOpts := taskoutput.TaskOptions{ProjectID: tsk.Project, TaskID: tsk.Id}
+		sections, err := tsk.TaskOutputInfo.TaskLogs.GetSections(ctx, taskOpts)
+		require.NoError(t, err)
+		assert.Empty(t, sections, "sections should not be written until they're flushed")
+		assert.Len(t, r.pending, 4)
+
+		r.flush(ctx)
+		assert.Empty(t, r.pending)
+		assert.Nil(t, r.flushTimer)
+		assert.Equal(t, 1, r.sequence, "buffered records should be written in one batch")
+		sections, err = tsk.TaskOutputInfo.TaskLogs.GetSections(ctx, taskOpts)
+		require.NoError(t, err)
+		require.Len(t, sections, 2)
+		assert.Equal(t, log.SectionStatusSucceeded, sections[0].Status)
+		assert.Equal(t, log.SectionStatusFailed, sections[1].Status)
+
+		r.flush(ctx)
+		assert.Equal(t, 1, r.sequence, "flushing without buffered records should not write anything")
+	})
+	t.Run("NilRecorderNoops", func(t *testing.T) {
+		var r *logSectionRecorder
+		id := r.begin("main", log.SectionTypeBlock)
+		assert.Empty(t, id)
+		r.end(id, log.SectionStatusSucceeded)
+		assert.Error(t, r.endUserSection("compile", log.SectionStatusSucceeded))
+		r.flush(ctx)
+	})
+}
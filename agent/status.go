@@ -37,6 +37,11 @@ func (a *Agent) startStatusServer(ctx context.Context, port int) error {
 	app.AddRoute("/status").Handler(a.statusHandler()).Get()
 	app.AddRoute("/failure_metadata_tag").Handler(a.addMetadataTagHandler).Post()
 	app.AddRoute("/task_status").Handler(a.endTaskHandler).Post()
+	app.AddRoute("/task/test_results").Handler(a.taskTestResultsHandler).Post()
+	app.AddRoute("/task/artifacts").Handler(a.taskArtifactsHandler).Post()
+	app.AddRoute("/task/expansions").Handler(a.taskExpansionsHandler).Post()
+	app.AddRoute("/task/log").Handler(a.taskLogHandler).Post()
+	app.AddRoute("/task/section").Handler(a.taskSectionHandler).Post()
 	app.AddRoute("/oom/clear").Handler(http.RedirectHandler("/jasper/v1/list/oom", http.StatusMovedPermanently).ServeHTTP).Delete()
 	app.AddRoute("/oom/check").Handler(http.RedirectHandler("/jasper/v1/list/oom", http.StatusMovedPermanently).ServeHTTP).Get()
 
@@ -0,0 +1,289 @@
+package agent
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/level"
+	"github.com/pkg/errors"
+)
+
+// The task API lets processes that a task starts report test results,
+// artifacts, expansions and log lines to the agent while a command is running,
+// rather than writing files for a later command to attach. The status server
+// binds to the loopback address, and these routes additionally reject any
+// request whose remote address is not a loopback address.
+
+// taskExpansionUpdate is a single expansion for the task API to set.
+type taskExpansionUpdate struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Redact bool   `json:"redact"`
+}
+
+// taskExpansionsRequest is the request body for updating expansions for the
+// task's subsequent commands.
+type taskExpansionsRequest struct {
+	Updates []taskExpansionUpdate `json:"updates"`
+}
+
+// taskLogRequest is the request body for writing lines to the task log.
+type taskLogRequest struct {
+	// Severity is the level to log the lines at. Defaults to info.
+	Severity string   `json:"severity"`
+	Lines    []string `json:"lines"`
+}
+
+const (
+	taskSectionActionBegin = "begin"
+	taskSectionActionEnd   = "end"
+)
+
+// taskSectionRequest is the request body for marking a section of the task's
+// logs.
+type taskSectionRequest struct {
+	Name string `json:"name"`
+	// Action is either "begin" to begin a section nested in the running
+	// command or "end" to end the innermost open section with the name.
+	Action string `json:"action"`
+	// Status is the outcome of a section that is ending. Defaults to
+	// success.
+	Status string `json:"status"`
+}
+
+// taskAPIResponse is the response body for the task API routes.
+type taskAPIResponse struct {
+	Count int `json:"count"`
+}
+
+// setTaskAPIContext sets the task context that the task API acts on. It
+// should be cleared once the task is no longer running.
+func (a *Agent) setTaskAPIContext(tc *taskContext) {
+	a.taskAPIMutex.Lock()
+	defer a.taskAPIMutex.Unlock()
+
+	a.taskAPIContext = tc
+}
+
+// getTaskAPIContext returns the context of the task that's running, or writes
+// an error response if the request can't act on a running task.
+func (a *Agent) getTaskAPIContext(w http.ResponseWriter, r *http.Request) *taskContext {
+	if !isLocalRequest(r) {
+		writeTaskAPIError(w, http.StatusForbidden, errors.New("task API only accepts requests from localhost"))
+		return nil
+	}
+
+	a.taskAPIMutex.RLock()
+	tc := a.taskAPIContext
+	a.taskAPIMutex.RUnlock()
+
+	if tc == nil || tc.taskConfig == nil || tc.logger == nil {
+		writeTaskAPIError(w, http.StatusConflict, errors.New("no task is running"))
+		return nil
+	}
+
+	return tc
+}
+
+// isLocalRequest returns whether the request came from the loopback interface.
+func isLocalRequest(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func writeTaskAPIError(w http.ResponseWriter, status int, err error) {
+	gimlet.WriteJSONResponse(w, status, gimlet.ErrorResponse{
+		StatusCode: status,
+		Message:    err.Error(),
+	})
+}
+
+// taskTestResultsHandler appends test results to the running task. The request
+// body has the same format as the attach.results report file.
+func (a *Agent) taskTestResultsHandler(w http.ResponseWriter, r *http.Request) {
+	tc := a.getTaskAPIContext(w, r)
+	if tc == nil {
+		return
+	}
+
+	// Sending test results reuses the task's test results record, so only
+	// send one batch at a time.
+	a.taskAPIResultsMutex.Lock()
+	defer a.taskAPIResultsMutex.Unlock()
+
+	count, err := command.SendNativeTestResults(r.Context(), a.comm, tc.logger, tc.taskConfig, r.Body)
+	if err != nil {
+		writeTaskAPIError(w, http.StatusBadRequest, errors.Wrap(err, "sending test results"))
+		return
+	}
+
+	gimlet.WriteJSON(w, taskAPIResponse{Count: count})
+}
+
+// taskArtifactsHandler attaches artifacts to the running task. The request body
+// has the same format as the attach.artifacts file.
+func (a *Agent) taskArtifactsHandler(w http.ResponseWriter, r *http.Request) {
+	tc := a.getTaskAPIContext(w, r)
+	if tc == nil {
+		return
+	}
+
+	files := []*artifact.File{}
+	if err := utility.ReadJSON(r.Body, &files); err != nil {
+		writeTaskAPIError(w, http.StatusBadRequest, errors.Wrap(err, "reading artifacts from JSON request body"))
+		return
+	}
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(len(files) == 0, "must specify at least one artifact")
+	for i, f := range files {
+		if f == nil {
+			catcher.Errorf("artifact at index %d is empty", i)
+			continue
+		}
+		catcher.ErrorfWhen(f.Name == "", "artifact at index %d is missing a name", i)
+		catcher.ErrorfWhen(f.Link == "", "artifact '%s' is missing a link", f.Name)
+	}
+	if catcher.HasErrors() {
+		writeTaskAPIError(w, http.StatusBadRequest, catcher.Resolve())
+		return
+	}
+
+	td := client.TaskData{ID: tc.taskConfig.Task.Id, Secret: tc.taskConfig.Task.Secret}
+	if err := a.comm.AttachFiles(r.Context(), td, files); err != nil {
+		writeTaskAPIError(w, http.StatusInternalServerError, errors.Wrap(err, "attaching artifacts"))
+		return
+	}
+	tc.logger.Task().Infof("Task API attached %d artifact(s) to task.", len(files))
+
+	gimlet.WriteJSON(w, taskAPIResponse{Count: len(files)})
+}
+
+// taskExpansionsHandler sets expansions for the running task's subsequent
+// commands, the same way expansions.update does.
+func (a *Agent) taskExpansionsHandler(w http.ResponseWriter, r *http.Request) {
+	tc := a.getTaskAPIContext(w, r)
+	if tc == nil {
+		return
+	}
+
+	req := taskExpansionsRequest{}
+	if err := utility.ReadJSON(r.Body, &req); err != nil {
+		writeTaskAPIError(w, http.StatusBadRequest, errors.Wrap(err, "reading expansions from JSON request body"))
+		return
+	}
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(len(req.Updates) == 0, "must specify at least one expansion to update")
+	for i, update := range req.Updates {
+		catcher.ErrorfWhen(update.Key == "", "expansion at index %d is missing a key", i)
+	}
+	if catcher.HasErrors() {
+		writeTaskAPIError(w, http.StatusBadRequest, catcher.Resolve())
+		return
+	}
+
+	tc.Lock()
+	defer tc.Unlock()
+	conf := tc.taskConfig
+	for _, update := range req.Updates {
+		conf.PutDynamicExpansion(update.Key, update.Value)
+		if update.Redact {
+			conf.NewExpansions.PutAndRedact(update.Key, update.Value)
+		} else {
+			conf.NewExpansions.Put(update.Key, update.Value)
+		}
+	}
+	tc.logger.Task().Infof("Task API updated %d expansion(s).", len(req.Updates))
+
+	gimlet.WriteJSON(w, taskAPIResponse{Count: len(req.Updates)})
+}
+
+// taskLogHandler writes lines to the running task's task log.
+func (a *Agent) taskLogHandler(w http.ResponseWriter, r *http.Request) {
+	tc := a.getTaskAPIContext(w, r)
+	if tc == nil {
+		return
+	}
+
+	req := taskLogRequest{}
+	if err := utility.ReadJSON(r.Body, &req); err != nil {
+		writeTaskAPIError(w, http.StatusBadRequest, errors.Wrap(err, "reading log lines from JSON request body"))
+		return
+	}
+	if len(req.Lines) == 0 {
+		writeTaskAPIError(w, http.StatusBadRequest, errors.New("must specify at least one log line"))
+		return
+	}
+	priority := level.Info
+	if req.Severity != "" {
+		priority = level.FromString(strings.ToLower(req.Severity))
+		if priority == level.Invalid {
+			writeTaskAPIError(w, http.StatusBadRequest, errors.Errorf("invalid log severity '%s'", req.Severity))
+			return
+		}
+	}
+
+	for _, line := range req.Lines {
+		tc.logger.Task().Log(priority, line)
+	}
+
+	gimlet.WriteJSON(w, taskAPIResponse{Count: len(req.Lines)})
+}
+
+// taskSectionHandler begins or ends a section of the running task's logs.
+func (a *Agent) taskSectionHandler(w http.ResponseWriter, r *http.Request) {
+	tc := a.getTaskAPIContext(w, r)
+	if tc == nil {
+		return
+	}
+	if tc.sections == nil {
+		writeTaskAPIError(w, http.StatusConflict, errors.New("task logs do not support sections"))
+		return
+	}
+
+	req := taskSectionRequest{}
+	if err := utility.ReadJSON(r.Body, &req); err != nil {
+		writeTaskAPIError(w, http.StatusBadRequest, errors.Wrap(err, "reading section from JSON request body"))
+		return
+	}
+	if req.Name == "" {
+		writeTaskAPIError(w, http.StatusBadRequest, errors.New("must specify a section name"))
+		return
+	}
+
+	switch req.Action {
+	case taskSectionActionBegin:
+		tc.sections.begin(req.Name, log.SectionTypeUser)
+		tc.logger.Task().Infof("Beginning section '%s'.", req.Name)
+	case taskSectionActionEnd:
+		status := log.SectionStatusSucceeded
+		if req.Status != "" {
+			status = log.SectionStatus(req.Status)
+		}
+		if status != log.SectionStatusSucceeded && status != log.SectionStatusFailed {
+			writeTaskAPIError(w, http.StatusBadRequest, errors.Errorf("invalid section status '%s'", req.Status))
+			return
+		}
+		if err := tc.sections.endUserSection(req.Name, status); err != nil {
+			writeTaskAPIError(w, http.StatusBadRequest, err)
+			return
+		}
+		tc.logger.Task().Infof("Ended section '%s' with status '%s'.", req.Name, status)
+	default:
+		writeTaskAPIError(w, http.StatusBadRequest, errors.Errorf("invalid section action '%s'", req.Action))
+		return
+	}
+
+	gimlet.WriteJSON(w, taskAPIResponse{Count: 1})
+}
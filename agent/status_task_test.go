@@ -0,0 +1,151 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/agent/internal/testutil"
+	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskAPIHandlers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// makeRequest sends a local request with the given body to the handler
+	// and returns the response.
+	makeRequest := func(handler http.HandlerFunc, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/task", bytes.NewBufferString(body))
+		r.RemoteAddr = "127.0.0.1:12345"
+		rw := httptest.NewRecorder()
+		handler(rw, r)
+		return rw
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext){
+		"FailsWithoutRunningTask": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			a.setTaskAPIContext(nil)
+			rw := makeRequest(a.taskLogHandler, `{"lines": ["hello"]}`)
+			assert.Equal(t, http.StatusConflict, rw.Code)
+		},
+		"FailsForRemoteRequest": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			r := httptest.NewRequest(http.MethodPost, "/task/log", bytes.NewBufferString(`{"lines": ["hello"]}`))
+			r.RemoteAddr = "10.0.0.1:12345"
+			rw := httptest.NewRecorder()
+			a.taskLogHandler(rw, r)
+			assert.Equal(t, http.StatusForbidden, rw.Code)
+		},
+		"AttachesArtifacts": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			rw := makeRequest(a.taskArtifactsHandler, `[{"name": "report", "link": "https://example.com/report.html"}]`)
+			require.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+			assert.JSONEq(t, `{"count": 1}`, rw.Body.String())
+
+			files := comm.AttachedFiles[tc.taskConfig.Task.Id]
+			require.Len(t, files, 1)
+			assert.Equal(t, "report", files[0].Name)
+			assert.Equal(t, "https://example.com/report.html", files[0].Link)
+		},
+		"RejectsArtifactWithoutLink": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			rw := makeRequest(a.taskArtifactsHandler, `[{"name": "report"}]`)
+			assert.Equal(t, http.StatusBadRequest, rw.Code)
+			assert.Empty(t, comm.AttachedFiles[tc.taskConfig.Task.Id])
+		},
+		"UpdatesExpansions": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			rw := makeRequest(a.taskExpansionsHandler, `{"updates": [{"key": "foo", "value": "bar"}, {"key": "secret", "value": "shh", "redact": true}]}`)
+			require.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+
+			assert.Equal(t, "bar", tc.taskConfig.NewExpansions.Get("foo"))
+			assert.Equal(t, "shh", tc.taskConfig.NewExpansions.Get("secret"))
+			assert.Equal(t, "bar", tc.taskConfig.DynamicExpansions.Get("foo"))
+			redacted := tc.taskConfig.NewExpansions.GetRedacted()
+			require.Len(t, redacted, 1)
+			assert.Equal(t, "secret", redacted[0].Key)
+		},
+		"RejectsExpansionWithoutKey": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			rw := makeRequest(a.taskExpansionsHandler, `{"updates": [{"value": "bar"}]}`)
+			assert.Equal(t, http.StatusBadRequest, rw.Code)
+		},
+		"WritesTaskLog": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			rw := makeRequest(a.taskLogHandler, `{"severity": "warning", "lines": ["first line", "second line"]}`)
+			require.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+			assert.JSONEq(t, `{"count": 2}`, rw.Body.String())
+
+			require.NoError(t, tc.logger.Close())
+			checkMockLogs(t, comm, tc.taskConfig.Task.Id, []string{"first line", "second line"}, nil)
+		},
+		"RejectsInvalidLogSeverity": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			rw := makeRequest(a.taskLogHandler, `{"severity": "loud", "lines": ["hello"]}`)
+			assert.Equal(t, http.StatusBadRequest, rw.Code)
+		},
+		"MarksUserSections": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			tsk := tc.taskConfig.Task
+			tsk.Project = "project"
+			tsk.TaskOutputInfo = testutil.InitializeTaskOutput(t)
+			tc.sections = newLogSectionRecorder(ctx, &tsk, nil)
+			cmdID := tc.sections.begin("'shell.exec'", log.SectionTypeCommand)
+
+			rw := makeRequest(a.taskSectionHandler, `{"name": "compile", "action": "begin"}`)
+			require.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+			rw = makeRequest(a.taskSectionHandler, `{"name": "compile", "action": "end", "status": "failed"}`)
+			require.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+			rw = makeRequest(a.taskSectionHandler, `{"name": "compile", "action": "end"}`)
+			assert.Equal(t, http.StatusBadRequest, rw.Code, "section should only end once")
+			tc.sections.end(cmdID, log.SectionStatusSucceeded)
+			tc.sections.flush(ctx)
+
+			sections, err := tsk.TaskOutputInfo.TaskLogs.GetSections(ctx, taskoutput.TaskOptions{ProjectID: tsk.Project, TaskID: tsk.Id})
+			require.NoError(t, err)
+			require.Len(t, sections, 2)
+			assert.Equal(t, "compile", sections[1].Name)
+			assert.Equal(t, log.SectionTypeUser, sections[1].Type)
+			assert.Equal(t, log.SectionStatusFailed, sections[1].Status)
+			assert.Equal(t, sections[0].ID, sections[1].ParentID)
+		},
+		"RejectsInvalidSectionAction": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			tsk := tc.taskConfig.Task
+			tsk.TaskOutputInfo = testutil.InitializeTaskOutput(t)
+			tc.sections = newLogSectionRecorder(ctx, &tsk, nil)
+
+			rw := makeRequest(a.taskSectionHandler, `{"name": "compile", "action": "pause"}`)
+			assert.Equal(t, http.StatusBadRequest, rw.Code)
+		},
+		"FailsSectionWithoutTaskLogs": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			rw := makeRequest(a.taskSectionHandler, `{"name": "compile", "action": "begin"}`)
+			assert.Equal(t, http.StatusConflict, rw.Code)
+		},
+		"RejectsEmptyTestResults": func(t *testing.T, a *Agent, comm *client.Mock, tc *taskContext) {
+			rw := makeRequest(a.taskTestResultsHandler, `{"results": []}`)
+			assert.Equal(t, http.StatusBadRequest, rw.Code)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			comm := client.NewMock("url")
+			a := &Agent{comm: comm}
+			tsk := task.Task{Id: "task_id", Secret: "secret"}
+			tc := &taskContext{
+				taskConfig: &internal.TaskConfig{
+					Task:          tsk,
+					Expansions:    util.Expansions{},
+					NewExpansions: agentutil.NewDynamicExpansions(util.Expansions{}),
+				},
+			}
+			var err error
+			tc.logger, err = comm.GetLoggerProducer(ctx, &tsk, nil)
+			require.NoError(t, err)
+			a.setTaskAPIContext(tc)
+
+			tCase(t, a, comm, tc)
+		})
+	}
+}
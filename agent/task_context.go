@@ -34,9 +34,18 @@ type taskContext struct {
 	// post_error_fails_task). Does not include commands that suppress errors,
 	// such as s3.put with optional: true.
 	otherFailingCommands []command.Command
-	postErrored          bool
-	logger               client.LoggerProducer
-	task                 client.TaskData
+	// retriedCommands keeps track of commands that were retried according to
+	// their retry policy.
+	retriedCommands []apimodels.RetriedCommand
+	// resumeCheckpoint is the checkpoint that the task is resuming from, if
+	// any. Main task commands are skipped until the command that saved it is
+	// reached.
+	resumeCheckpoint *apimodels.TaskCheckpoint
+	postErrored      bool
+	logger           client.LoggerProducer
+	// sections records the sections of the task's logs.
+	sections *logSectionRecorder
+	task     client.TaskData
 	// ranSetupGroup is true during task setup if the task is a new standalone
 	// task or if it's the first task in a task group.
 	ranSetupGroup bool
@@ -149,6 +158,13 @@ func (tc *taskContext) addFailingCommand(cmd command.Command) {
 	tc.otherFailingCommands = append(tc.otherFailingCommands, cmd)
 }
 
+// hasFailingCommand returns whether any command in the task has failed so far.
+func (tc *taskContext) hasFailingCommand() bool {
+	tc.RLock()
+	defer tc.RUnlock()
+	return tc.failingCommand != nil || len(tc.otherFailingCommands) > 0
+}
+
 func (tc *taskContext) getOtherFailingCommands() []apimodels.FailingCommand {
 	tc.RLock()
 	defer tc.RUnlock()
@@ -167,6 +183,45 @@ func (tc *taskContext) getOtherFailingCommands() []apimodels.FailingCommand {
 	return otherFailingCmds
 }
 
+func (tc *taskContext) addRetriedCommand(cmd command.Command, attempts int, succeeded bool) {
+	tc.Lock()
+	defer tc.Unlock()
+	tc.retriedCommands = append(tc.retriedCommands, apimodels.RetriedCommand{
+		FullDisplayName: cmd.FullDisplayName(),
+		Attempts:        attempts,
+		Succeeded:       succeeded,
+	})
+}
+
+func (tc *taskContext) getRetriedCommands() []apimodels.RetriedCommand {
+	tc.RLock()
+	defer tc.RUnlock()
+	return append([]apimodels.RetriedCommand(nil), tc.retriedCommands...)
+}
+
+func (tc *taskContext) setResumeCheckpoint(checkpoint *apimodels.TaskCheckpoint) {
+	tc.Lock()
+	defer tc.Unlock()
+	tc.resumeCheckpoint = checkpoint
+}
+
+// skipCompletedCommand returns whether the main task command already
+// completed before the checkpoint that the task is resuming from, along with
+// the checkpoint's step. Once the command that saved the checkpoint is
+// skipped, the remaining commands run as usual.
+func (tc *taskContext) skipCompletedCommand(cmd command.Command) (string, bool) {
+	tc.Lock()
+	defer tc.Unlock()
+	if tc.resumeCheckpoint == nil {
+		return "", false
+	}
+	step := tc.resumeCheckpoint.Step
+	if cmd.FullDisplayName() == tc.resumeCheckpoint.Command {
+		tc.resumeCheckpoint = nil
+	}
+	return step, true
+}
+
 func (tc *taskContext) setCurrentCommand(command command.Command) {
 	tc.Lock()
 	defer tc.Unlock()
@@ -397,6 +452,7 @@ func (a *Agent) makeTaskConfig(ctx context.Context, tc *taskContext) (*internal.
 	taskConfig.TaskOutput = a.opts.SetupData.TaskOutput
 	taskConfig.MaxExecTimeoutSecs = a.opts.SetupData.MaxExecTimeoutSecs
 	taskConfig.InternalBuckets = a.opts.SetupData.InternalBuckets
+	taskConfig.BuildCacheBucket = a.opts.SetupData.BuildCacheBucket
 
 	// Set AWS credentials for task output buckets.
 	awsCreds := pail.CreateAWSStaticCredentials(taskConfig.TaskOutput.Key, taskConfig.TaskOutput.Secret, "")
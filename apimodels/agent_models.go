@@ -2,6 +2,9 @@ package apimodels
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -73,6 +76,9 @@ type TaskEndDetail struct {
 	Modules              ModuleCloneInfo  `bson:"modules,omitempty" json:"modules,omitempty"`
 	TraceID              string           `bson:"trace_id,omitempty" json:"trace_id,omitempty"`
 	DiskDevices          []string         `bson:"disk_devices,omitempty" json:"disk_devices,omitempty"`
+	// RetriedCommands contains information about commands that were retried
+	// according to their retry policy while the task was running.
+	RetriedCommands []RetriedCommand `bson:"retried_commands,omitempty" json:"retried_commands,omitempty"`
 }
 
 // FailingCommand represents a command that failed in a task.
@@ -81,6 +87,17 @@ type FailingCommand struct {
 	FailureMetadataTags []string `bson:"failure_metadata_tags,omitempty" json:"failure_metadata_tags,omitempty"`
 }
 
+// RetriedCommand contains information about a command that was retried
+// according to its retry policy.
+type RetriedCommand struct {
+	FullDisplayName string `bson:"full_display_name,omitempty" json:"full_display_name,omitempty"`
+	// Attempts is the total number of times the command ran, including the
+	// first attempt.
+	Attempts int `bson:"attempts" json:"attempts"`
+	// Succeeded is whether the last attempt of the command succeeded.
+	Succeeded bool `bson:"succeeded" json:"succeeded"`
+}
+
 type OOMTrackerInfo struct {
 	Detected bool  `bson:"detected" json:"detected"`
 	Pids     []int `bson:"pids" json:"pids"`
@@ -117,6 +134,9 @@ type AgentSetupData struct {
 	TraceCollectorEndpoint string                  `json:"trace_collector_endpoint"`
 	MaxExecTimeoutSecs     int                     `json:"max_exec_timeout_secs"`
 	InternalBuckets        []string                `json:"internal_buckets"`
+	// BuildCacheBucket is where the cache.save and cache.restore commands
+	// store cache archives. It's empty if the build cache is not configured.
+	BuildCacheBucket evergreen.BucketConfig `json:"build_cache_bucket"`
 }
 
 // NextTaskResponse represents the response sent back when an agent asks for a next task
@@ -247,6 +267,115 @@ func (ar *S3CredentialsRequest) Validate() error {
 	return catcher.Resolve()
 }
 
+// BuildCacheEntry is an archive in the build cache.
+type BuildCacheEntry struct {
+	// Key is the user-supplied key that the archive is saved under.
+	Key string `json:"key"`
+	// SHA256 is the hash of the archive's contents, which determines where
+	// the archive is stored (see BuildCacheBlobKey).
+	SHA256    string `json:"sha256"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// Validate checks that the entry has valid values.
+func (e *BuildCacheEntry) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(e.Key == "", "must specify key")
+	hash, err := hex.DecodeString(e.SHA256)
+	catcher.ErrorfWhen(err != nil || len(hash) != sha256.Size, "sha256 '%s' is not a valid SHA-256 hash", e.SHA256)
+	catcher.NewWhen(e.SizeBytes < 0, "size cannot be negative")
+	return catcher.Resolve()
+}
+
+// BuildCacheRestoreRequest looks up the build cache entry to restore.
+type BuildCacheRestoreRequest struct {
+	// Key is the key of the entry to restore.
+	Key string `json:"key"`
+	// RestoreKeys are key prefixes to fall back to, in order, if there's no
+	// entry with the exact key. The most recently saved entry matching the
+	// first prefix with any matches is restored.
+	RestoreKeys []string `json:"restore_keys,omitempty"`
+}
+
+// Validate checks that the request has valid values.
+func (r *BuildCacheRestoreRequest) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(r.Key == "", "must specify key")
+	for _, prefix := range r.RestoreKeys {
+		catcher.NewWhen(prefix == "", "restore keys cannot be empty")
+	}
+	return catcher.Resolve()
+}
+
+// BuildCacheRestoreResponse is the build cache entry to restore.
+type BuildCacheRestoreResponse struct {
+	// Entry is the entry to restore, or nil if no entry matched.
+	Entry *BuildCacheEntry `json:"entry,omitempty"`
+}
+
+// BuildCacheBlobKey returns the key in the build cache bucket of the archive
+// with the given hash. Archives are content-addressed, so identical archives
+// saved under different keys are only stored once.
+func BuildCacheBlobKey(projectID, hash string) string {
+	return path.Join(projectID, "build_cache", hash+".tar.gz")
+}
+
+// TaskCheckpoint is a point in a task's main commands that the task reached,
+// along with a snapshot of its working directory at that point. If the task's
+// host is lost, the next execution can resume from the checkpoint.
+type TaskCheckpoint struct {
+	// Step is the user-supplied name of the completed step.
+	Step string `json:"step"`
+	// Command is the full display name of the checkpoint.save command that
+	// saved the checkpoint. When resuming, every main task command up to and
+	// including this one is skipped.
+	Command string `json:"command"`
+	// Execution is the task execution that saved the checkpoint. It's set
+	// by the app server.
+	Execution int `json:"execution"`
+	// Directory is the directory that the snapshot contains, relative to the
+	// task's working directory.
+	Directory string `json:"directory"`
+	// SHA256 is the hash of the snapshot archive, which determines where the
+	// archive is stored (see TaskCheckpointSnapshotKey).
+	SHA256    string `json:"sha256"`
+	SizeBytes int64  `json:"size_bytes"`
+	// Expansions are expansions set by the skipped commands that the
+	// remaining commands need.
+	Expansions map[string]string `json:"expansions,omitempty"`
+}
+
+// Validate checks that the checkpoint has valid values.
+func (c *TaskCheckpoint) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(c.Step == "", "must specify step")
+	catcher.NewWhen(c.Command == "", "must specify command")
+	catcher.NewWhen(path.IsAbs(c.Directory), "directory must be relative to the task's working directory")
+	hash, err := hex.DecodeString(c.SHA256)
+	catcher.ErrorfWhen(err != nil || len(hash) != sha256.Size, "sha256 '%s' is not a valid SHA-256 hash", c.SHA256)
+	catcher.NewWhen(c.SizeBytes < 0, "size cannot be negative")
+	return catcher.Resolve()
+}
+
+// TaskCheckpointResponse is the checkpoint that a task can resume from.
+type TaskCheckpointResponse struct {
+	// Checkpoint is the checkpoint to resume from, or nil if the task
+	// cannot resume from a checkpoint.
+	Checkpoint *TaskCheckpoint `json:"checkpoint,omitempty"`
+}
+
+// TaskCheckpointSaveResponse is the response to saving a task checkpoint.
+type TaskCheckpointSaveResponse struct {
+	// Replaced is the checkpoint that the saved checkpoint replaced, if any.
+	Replaced *TaskCheckpoint `json:"replaced,omitempty"`
+}
+
+// TaskCheckpointSnapshotKey returns the key in the build cache bucket of the
+// snapshot archive with the given hash that the task saved.
+func TaskCheckpointSnapshotKey(projectID, taskID, hash string) string {
+	return path.Join(projectID, "checkpoints", taskID, hash+".tar.gz")
+}
+
 func (ted *TaskEndDetail) IsEmpty() bool {
 	return ted == nil || ted.Status == ""
 }
@@ -125,6 +125,8 @@ func GetSettings(provider string) (ProviderSettings, error) {
 		return &MockProviderSettings{}, nil
 	case evergreen.ProviderNameDocker, evergreen.ProviderNameDockerMock:
 		return &dockerSettings{}, nil
+	case evergreen.ProviderNameLibvirt:
+		return &LibvirtProviderSettings{}, nil
 	}
 	return nil, errors.Errorf("invalid provider name '%s'", provider)
 }
@@ -159,6 +161,8 @@ func GetManager(ctx context.Context, env evergreen.Environment, mgrOpts ManagerO
 		provider = &dockerManager{env: env}
 	case evergreen.ProviderNameDockerMock:
 		provider = &dockerManager{env: env, client: &dockerClientMock{}}
+	case evergreen.ProviderNameLibvirt:
+		provider = &libvirtManager{env: env}
 	default:
 		return nil, errors.Errorf("no known provider '%s'", mgrOpts.Provider)
 	}
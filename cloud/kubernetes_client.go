@@ -0,0 +1,374 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+// KubernetesClient provides a means to interact with the pods and secrets in a
+// single Kubernetes namespace.
+type KubernetesClient interface {
+	// Namespace returns the namespace that the client manages.
+	Namespace() string
+	// CreatePod creates a pod. If a pod with the same name already exists,
+	// it returns the existing pod.
+	CreatePod(ctx context.Context, p KubernetesPod) (*KubernetesPod, error)
+	// GetPod gets the pod with the given name. If the pod does not exist, it
+	// returns nil.
+	GetPod(ctx context.Context, name string) (*KubernetesPod, error)
+	// DeletePod deletes the pod with the given name. It is a no-op if the pod
+	// does not exist.
+	DeletePod(ctx context.Context, name string) error
+	// CreateSecret creates a secret. If a secret with the same name already
+	// exists, it is left unmodified.
+	CreateSecret(ctx context.Context, s KubernetesSecret) error
+	// DeleteSecret deletes the secret with the given name. It is a no-op if the
+	// secret does not exist.
+	DeleteSecret(ctx context.Context, name string) error
+	// ListSecrets lists up to limit secrets that match the label selector. If
+	// limit is not positive, it lists all matching secrets.
+	ListSecrets(ctx context.Context, labelSelector string, limit int) ([]KubernetesSecret, error)
+}
+
+// KubernetesObjectMeta is the metadata common to all Kubernetes objects.
+type KubernetesObjectMeta struct {
+	Name      string            `json:"name,omitempty"`
+	Namespace string            `json:"namespace,omitempty"`
+	UID       string            `json:"uid,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// KubernetesPod is the subset of the Kubernetes pod API object that is
+// relevant to running containers.
+type KubernetesPod struct {
+	APIVersion string               `json:"apiVersion,omitempty"`
+	Kind       string               `json:"kind,omitempty"`
+	Metadata   KubernetesObjectMeta `json:"metadata"`
+	Spec       KubernetesPodSpec    `json:"spec"`
+	Status     KubernetesPodStatus  `json:"status,omitempty"`
+}
+
+// KubernetesPodSpec describes the desired state of a Kubernetes pod.
+type KubernetesPodSpec struct {
+	Containers       []KubernetesContainer            `json:"containers"`
+	RestartPolicy    string                           `json:"restartPolicy,omitempty"`
+	NodeSelector     map[string]string                `json:"nodeSelector,omitempty"`
+	ImagePullSecrets []KubernetesLocalObjectReference `json:"imagePullSecrets,omitempty"`
+}
+
+// KubernetesLocalObjectReference refers to another object by name in the same
+// namespace.
+type KubernetesLocalObjectReference struct {
+	Name string `json:"name"`
+}
+
+// KubernetesContainer describes a single container in a Kubernetes pod.
+type KubernetesContainer struct {
+	Name       string                         `json:"name"`
+	Image      string                         `json:"image"`
+	WorkingDir string                         `json:"workingDir,omitempty"`
+	Command    []string                       `json:"command,omitempty"`
+	Env        []KubernetesEnvVar             `json:"env,omitempty"`
+	Ports      []KubernetesContainerPort      `json:"ports,omitempty"`
+	Resources  KubernetesResourceRequirements `json:"resources,omitempty"`
+}
+
+// KubernetesEnvVar is an environment variable set in a container, either from
+// a literal value or from a key in a secret.
+type KubernetesEnvVar struct {
+	Name      string                  `json:"name"`
+	Value     string                  `json:"value,omitempty"`
+	ValueFrom *KubernetesEnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// KubernetesEnvVarSource is the source of an environment variable's value.
+type KubernetesEnvVarSource struct {
+	SecretKeyRef *KubernetesSecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// KubernetesSecretKeySelector selects a key in a secret.
+type KubernetesSecretKeySelector struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// KubernetesContainerPort is a port exposed by a container.
+type KubernetesContainerPort struct {
+	ContainerPort int `json:"containerPort"`
+}
+
+// KubernetesResourceRequirements are the compute resources requested by a
+// container and the limits it cannot exceed.
+type KubernetesResourceRequirements struct {
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
+}
+
+// KubernetesPodStatus is the observed state of a Kubernetes pod.
+type KubernetesPodStatus struct {
+	Phase   KubernetesPodPhase `json:"phase,omitempty"`
+	Reason  string             `json:"reason,omitempty"`
+	Message string             `json:"message,omitempty"`
+}
+
+// KubernetesPodPhase is the high-level summary of where a Kubernetes pod is in
+// its lifecycle.
+type KubernetesPodPhase string
+
+const (
+	// KubernetesPodPhasePending indicates that the pod has been accepted but
+	// its containers are not all running yet.
+	KubernetesPodPhasePending KubernetesPodPhase = "Pending"
+	// KubernetesPodPhaseRunning indicates that the pod's containers have
+	// started.
+	KubernetesPodPhaseRunning KubernetesPodPhase = "Running"
+	// KubernetesPodPhaseSucceeded indicates that the pod's containers exited
+	// successfully.
+	KubernetesPodPhaseSucceeded KubernetesPodPhase = "Succeeded"
+	// KubernetesPodPhaseFailed indicates that at least one of the pod's
+	// containers exited with an error.
+	KubernetesPodPhaseFailed KubernetesPodPhase = "Failed"
+	// KubernetesPodPhaseUnknown indicates that the state of the pod could not
+	// be obtained.
+	KubernetesPodPhaseUnknown KubernetesPodPhase = "Unknown"
+)
+
+// KubernetesSecret is the subset of the Kubernetes secret API object that is
+// relevant to storing container secrets.
+type KubernetesSecret struct {
+	APIVersion string               `json:"apiVersion,omitempty"`
+	Kind       string               `json:"kind,omitempty"`
+	Metadata   KubernetesObjectMeta `json:"metadata"`
+	Type       string               `json:"type,omitempty"`
+	// StringData contains the secret's unencoded values. It is write-only, so
+	// it is never returned by the API server.
+	StringData map[string]string `json:"stringData,omitempty"`
+	// Data contains the secret's base64-encoded values.
+	Data map[string]string `json:"data,omitempty"`
+}
+
+type kubernetesSecretList struct {
+	Items []KubernetesSecret `json:"items"`
+}
+
+// kubernetesStatus is the error response returned by the API server.
+type kubernetesStatus struct {
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
+}
+
+const kubernetesClientTimeout = time.Minute
+
+type kubernetesClientImpl struct {
+	httpClient   *http.Client
+	apiServerURL string
+	namespace    string
+	token        string
+}
+
+// NewKubernetesClient creates a KubernetesClient that manages pods and secrets
+// in the namespace given by the Kubernetes settings.
+func NewKubernetesClient(conf evergreen.KubernetesConfig) (KubernetesClient, error) {
+	if conf.APIServerURL == "" {
+		return nil, errors.New("must specify an API server URL")
+	}
+	if conf.Namespace == "" {
+		return nil, errors.New("must specify a namespace")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if conf.CACert != "" {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM([]byte(conf.CACert)) {
+			return nil, errors.New("CA certificate is not a valid PEM-encoded certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: certPool}
+	}
+
+	return &kubernetesClientImpl{
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   kubernetesClientTimeout,
+		},
+		apiServerURL: strings.TrimSuffix(conf.APIServerURL, "/"),
+		namespace:    conf.Namespace,
+		token:        conf.Token,
+	}, nil
+}
+
+// MakeKubernetesClient creates a KubernetesClient for the container pool with
+// the given ID.
+func MakeKubernetesClient(settings *evergreen.Settings, poolID string) (KubernetesClient, error) {
+	pool := settings.ContainerPools.GetContainerPool(poolID)
+	if pool == nil {
+		return nil, errors.Errorf("container pool '%s' not found", poolID)
+	}
+	if !pool.IsKubernetes() {
+		return nil, errors.Errorf("container pool '%s' does not run pods in Kubernetes", poolID)
+	}
+
+	return NewKubernetesClient(pool.Kubernetes)
+}
+
+func (c *kubernetesClientImpl) Namespace() string {
+	return c.namespace
+}
+
+func (c *kubernetesClientImpl) CreatePod(ctx context.Context, p KubernetesPod) (*KubernetesPod, error) {
+	p.APIVersion = "v1"
+	p.Kind = "Pod"
+	p.Metadata.Namespace = c.namespace
+
+	created := &KubernetesPod{}
+	status, err := c.do(ctx, http.MethodPost, c.resourcePath("pods", ""), nil, p, created)
+	if status == http.StatusConflict {
+		existing, err := c.GetPod(ctx, p.Metadata.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting existing pod '%s'", p.Metadata.Name)
+		}
+		if existing == nil {
+			return nil, errors.Errorf("pod '%s' already exists but could not be found", p.Metadata.Name)
+		}
+		return existing, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating pod '%s'", p.Metadata.Name)
+	}
+
+	return created, nil
+}
+
+func (c *kubernetesClientImpl) GetPod(ctx context.Context, name string) (*KubernetesPod, error) {
+	p := &KubernetesPod{}
+	status, err := c.do(ctx, http.MethodGet, c.resourcePath("pods", name), nil, nil, p)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting pod '%s'", name)
+	}
+
+	return p, nil
+}
+
+func (c *kubernetesClientImpl) DeletePod(ctx context.Context, name string) error {
+	status, err := c.do(ctx, http.MethodDelete, c.resourcePath("pods", name), nil, nil, nil)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return errors.Wrapf(err, "deleting pod '%s'", name)
+}
+
+func (c *kubernetesClientImpl) CreateSecret(ctx context.Context, s KubernetesSecret) error {
+	s.APIVersion = "v1"
+	s.Kind = "Secret"
+	s.Metadata.Namespace = c.namespace
+
+	status, err := c.do(ctx, http.MethodPost, c.resourcePath("secrets", ""), nil, s, nil)
+	if status == http.StatusConflict {
+		return nil
+	}
+
+	return errors.Wrapf(err, "creating secret '%s'", s.Metadata.Name)
+}
+
+func (c *kubernetesClientImpl) DeleteSecret(ctx context.Context, name string) error {
+	status, err := c.do(ctx, http.MethodDelete, c.resourcePath("secrets", name), nil, nil, nil)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return errors.Wrapf(err, "deleting secret '%s'", name)
+}
+
+func (c *kubernetesClientImpl) ListSecrets(ctx context.Context, labelSelector string, limit int) ([]KubernetesSecret, error) {
+	query := url.Values{}
+	if labelSelector != "" {
+		query.Set("labelSelector", labelSelector)
+	}
+	if limit > 0 {
+		query.Set("limit", fmt.Sprint(limit))
+	}
+
+	list := kubernetesSecretList{}
+	if _, err := c.do(ctx, http.MethodGet, c.resourcePath("secrets", ""), query, nil, &list); err != nil {
+		return nil, errors.Wrap(err, "listing secrets")
+	}
+
+	return list.Items, nil
+}
+
+// resourcePath returns the API path to the namespaced resource. If name is
+// empty, it returns the path to the resource collection.
+func (c *kubernetesClientImpl) resourcePath(resource, name string) string {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/%s", url.PathEscape(c.namespace), resource)
+	if name != "" {
+		path = fmt.Sprintf("%s/%s", path, url.PathEscape(name))
+	}
+	return path
+}
+
+// do makes a request to the API server and decodes the JSON response into out,
+// if given. It returns the response status code along with an error if the
+// request did not succeed.
+func (c *kubernetesClientImpl) do(ctx context.Context, method, path string, query url.Values, in, out any) (int, error) {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return 0, errors.Wrap(err, "marshalling request body")
+		}
+		body = bytes.NewReader(b)
+	}
+
+	u := c.apiServerURL + path
+	if len(query) > 0 {
+		u = fmt.Sprintf("%s?%s", u, query.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return 0, errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, "making request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		status := kubernetesStatus{}
+		if err := json.NewDecoder(resp.Body).Decode(&status); err != nil || status.Message == "" {
+			return resp.StatusCode, errors.Errorf("API server returned status %d", resp.StatusCode)
+		}
+		return resp.StatusCode, errors.Errorf("API server returned status %d: %s", resp.StatusCode, status.Message)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp.StatusCode, errors.Wrap(err, "decoding response body")
+		}
+	}
+
+	return resp.StatusCode, nil
+}
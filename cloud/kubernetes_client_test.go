@@ -0,0 +1,164 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubernetesClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const namespace = "evergreen"
+
+	for tName, tCase := range map[string]func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient){
+		"CreatePodSucceeds": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			p, err := c.CreatePod(ctx, KubernetesPod{Metadata: KubernetesObjectMeta{Name: "pod"}})
+			require.NoError(t, err)
+			assert.Equal(t, "pod", p.Metadata.Name)
+			assert.Equal(t, namespace, p.Metadata.Namespace)
+			assert.NotZero(t, p.Metadata.UID)
+			assert.Equal(t, KubernetesPodPhasePending, p.Status.Phase)
+
+			_, ok := server.GetPod(namespace, "pod")
+			assert.True(t, ok)
+		},
+		"CreatePodReturnsExistingPod": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			server.SetPod(namespace, KubernetesPod{Metadata: KubernetesObjectMeta{Name: "pod", UID: "uid"}, Status: KubernetesPodStatus{Phase: KubernetesPodPhaseRunning}})
+
+			p, err := c.CreatePod(ctx, KubernetesPod{Metadata: KubernetesObjectMeta{Name: "pod"}})
+			require.NoError(t, err)
+			assert.Equal(t, "uid", p.Metadata.UID)
+			assert.Equal(t, KubernetesPodPhaseRunning, p.Status.Phase)
+		},
+		"GetPodReturnsNilForNonexistentPod": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			p, err := c.GetPod(ctx, "nonexistent")
+			assert.NoError(t, err)
+			assert.Nil(t, p)
+		},
+		"DeletePodSucceeds": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			server.SetPod(namespace, KubernetesPod{Metadata: KubernetesObjectMeta{Name: "pod"}})
+
+			require.NoError(t, c.DeletePod(ctx, "pod"))
+			_, ok := server.GetPod(namespace, "pod")
+			assert.False(t, ok)
+		},
+		"DeletePodNoopsForNonexistentPod": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			assert.NoError(t, c.DeletePod(ctx, "nonexistent"))
+		},
+		"CreateSecretSucceeds": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			require.NoError(t, c.CreateSecret(ctx, KubernetesSecret{
+				Metadata:   KubernetesObjectMeta{Name: "secret"},
+				StringData: map[string]string{"key": "value"},
+			}))
+
+			s, ok := server.GetSecret(namespace, "secret")
+			require.True(t, ok)
+			assert.Equal(t, "value", s.StringData["key"])
+		},
+		"CreateSecretNoopsForExistingSecret": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			server.SetSecret(namespace, KubernetesSecret{Metadata: KubernetesObjectMeta{Name: "secret"}})
+			assert.NoError(t, c.CreateSecret(ctx, KubernetesSecret{Metadata: KubernetesObjectMeta{Name: "secret"}}))
+		},
+		"ListSecretsFiltersByLabelSelector": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			server.SetSecret(namespace, KubernetesSecret{Metadata: KubernetesObjectMeta{Name: "managed", Labels: map[string]string{KubernetesManagedByLabel: KubernetesManagedByValue}}})
+			server.SetSecret(namespace, KubernetesSecret{Metadata: KubernetesObjectMeta{Name: "unmanaged"}})
+			server.SetSecret("other", KubernetesSecret{Metadata: KubernetesObjectMeta{Name: "other", Labels: map[string]string{KubernetesManagedByLabel: KubernetesManagedByValue}}})
+
+			secrets, err := c.ListSecrets(ctx, KubernetesManagedSecretSelector(), 0)
+			require.NoError(t, err)
+			require.Len(t, secrets, 1)
+			assert.Equal(t, "managed", secrets[0].Metadata.Name)
+		},
+		"DeleteSecretSucceeds": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			server.SetSecret(namespace, KubernetesSecret{Metadata: KubernetesObjectMeta{Name: "secret"}})
+
+			require.NoError(t, c.DeleteSecret(ctx, "secret"))
+			_, ok := server.GetSecret(namespace, "secret")
+			assert.False(t, ok)
+		},
+		"DeleteSecretNoopsForNonexistentSecret": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			assert.NoError(t, c.DeleteSecret(ctx, "nonexistent"))
+		},
+		"RequestsFailWhenServerErrors": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			server.FailRequests = true
+
+			_, err := c.CreatePod(ctx, KubernetesPod{Metadata: KubernetesObjectMeta{Name: "pod"}})
+			assert.Error(t, err)
+			_, err = c.GetPod(ctx, "pod")
+			assert.Error(t, err)
+			assert.Error(t, c.DeletePod(ctx, "pod"))
+			assert.Error(t, c.CreateSecret(ctx, KubernetesSecret{Metadata: KubernetesObjectMeta{Name: "secret"}}))
+			assert.Error(t, c.DeleteSecret(ctx, "secret"))
+			_, err = c.ListSecrets(ctx, "", 0)
+			assert.Error(t, err)
+		},
+		"RequestsUseNamespacedPaths": func(t *testing.T, server *MockKubernetesAPIServer, c KubernetesClient) {
+			_, err := c.GetPod(ctx, "pod")
+			require.NoError(t, err)
+			assert.Equal(t, []string{http.MethodGet + " /api/v1/namespaces/evergreen/pods/pod"}, server.Requests)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			server := NewMockKubernetesAPIServer()
+			defer server.Close()
+
+			c, err := NewKubernetesClient(server.Config(namespace))
+			require.NoError(t, err)
+
+			tCase(t, server, c)
+		})
+	}
+}
+
+func TestNewKubernetesClient(t *testing.T) {
+	t.Run("FailsWithoutAPIServerURL", func(t *testing.T) {
+		_, err := NewKubernetesClient(evergreen.KubernetesConfig{Namespace: "namespace"})
+		assert.Error(t, err)
+	})
+	t.Run("FailsWithoutNamespace", func(t *testing.T) {
+		_, err := NewKubernetesClient(evergreen.KubernetesConfig{APIServerURL: "https://kubernetes.example.com"})
+		assert.Error(t, err)
+	})
+	t.Run("FailsWithInvalidCACert", func(t *testing.T) {
+		_, err := NewKubernetesClient(evergreen.KubernetesConfig{
+			APIServerURL: "https://kubernetes.example.com",
+			Namespace:    "namespace",
+			CACert:       "not a certificate",
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestMakeKubernetesClient(t *testing.T) {
+	settings := &evergreen.Settings{
+		ContainerPools: evergreen.ContainerPoolsConfig{
+			Pools: []evergreen.ContainerPool{
+				{Id: "docker", Distro: "distro", MaxContainers: 1},
+				{
+					Id:       "k8s",
+					Provider: evergreen.PodProviderKubernetes,
+					Kubernetes: evergreen.KubernetesConfig{
+						APIServerURL: "https://kubernetes.example.com",
+						Namespace:    "namespace",
+					},
+				},
+			},
+		},
+	}
+
+	c, err := MakeKubernetesClient(settings, "k8s")
+	require.NoError(t, err)
+	assert.Equal(t, "namespace", c.Namespace())
+
+	_, err = MakeKubernetesClient(settings, "docker")
+	assert.Error(t, err)
+
+	_, err = MakeKubernetesClient(settings, "nonexistent")
+	assert.Error(t, err)
+}
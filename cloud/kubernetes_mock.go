@@ -0,0 +1,262 @@
+package cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/utility"
+)
+
+// MockKubernetesAPIServer is a fake Kubernetes API server that stores pods and
+// secrets in memory. It implements just enough of the API for
+// KubernetesClient, so it should only ever be used for testing purposes.
+type MockKubernetesAPIServer struct {
+	server *httptest.Server
+
+	mu      sync.Mutex
+	pods    map[string]KubernetesPod
+	secrets map[string]KubernetesSecret
+	// Requests records the method and path of each request made to the
+	// server.
+	Requests []string
+	// FailRequests causes all requests to fail with an internal server error.
+	FailRequests bool
+}
+
+// NewMockKubernetesAPIServer starts a fake Kubernetes API server. Callers must
+// call Close when they are done with it.
+func NewMockKubernetesAPIServer() *MockKubernetesAPIServer {
+	s := &MockKubernetesAPIServer{
+		pods:    map[string]KubernetesPod{},
+		secrets: map[string]KubernetesSecret{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// URL returns the URL of the fake API server.
+func (s *MockKubernetesAPIServer) URL() string {
+	return s.server.URL
+}
+
+// Config returns Kubernetes settings to connect to the fake API server in the
+// given namespace.
+func (s *MockKubernetesAPIServer) Config(namespace string) evergreen.KubernetesConfig {
+	return evergreen.KubernetesConfig{
+		APIServerURL: s.URL(),
+		Namespace:    namespace,
+		Token:        "token",
+	}
+}
+
+// Close shuts down the fake API server.
+func (s *MockKubernetesAPIServer) Close() {
+	s.server.Close()
+}
+
+// GetPod returns the pod with the given name in the namespace, if it exists.
+func (s *MockKubernetesAPIServer) GetPod(namespace, name string) (KubernetesPod, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.pods[mockKubernetesKey(namespace, name)]
+	return p, ok
+}
+
+// SetPod creates or replaces a pod in the namespace.
+func (s *MockKubernetesAPIServer) SetPod(namespace string, p KubernetesPod) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p.Metadata.Namespace = namespace
+	s.pods[mockKubernetesKey(namespace, p.Metadata.Name)] = p
+}
+
+// SetPodPhase sets the phase of an existing pod.
+func (s *MockKubernetesAPIServer) SetPodPhase(namespace, name string, phase KubernetesPodPhase) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := mockKubernetesKey(namespace, name)
+	if p, ok := s.pods[key]; ok {
+		p.Status.Phase = phase
+		s.pods[key] = p
+	}
+}
+
+// GetSecret returns the secret with the given name in the namespace, if it
+// exists.
+func (s *MockKubernetesAPIServer) GetSecret(namespace, name string) (KubernetesSecret, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	secret, ok := s.secrets[mockKubernetesKey(namespace, name)]
+	return secret, ok
+}
+
+// SetSecret creates or replaces a secret in the namespace.
+func (s *MockKubernetesAPIServer) SetSecret(namespace string, secret KubernetesSecret) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	secret.Metadata.Namespace = namespace
+	s.secrets[mockKubernetesKey(namespace, secret.Metadata.Name)] = secret
+}
+
+func mockKubernetesKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+func (s *MockKubernetesAPIServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Requests = append(s.Requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+
+	if s.FailRequests {
+		writeMockKubernetesStatus(w, http.StatusInternalServerError, "InternalError", "injected failure")
+		return
+	}
+
+	// Paths have the form /api/v1/namespaces/{namespace}/{resource}[/{name}].
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 5 || len(parts) > 6 || parts[0] != "api" || parts[1] != "v1" || parts[2] != "namespaces" {
+		writeMockKubernetesStatus(w, http.StatusNotFound, "NotFound", "unrecognized path")
+		return
+	}
+	namespace, resource := parts[3], parts[4]
+	var name string
+	if len(parts) == 6 {
+		name = parts[5]
+	}
+
+	switch resource {
+	case "pods":
+		s.handlePods(w, r, namespace, name)
+	case "secrets":
+		s.handleSecrets(w, r, namespace, name)
+	default:
+		writeMockKubernetesStatus(w, http.StatusNotFound, "NotFound", fmt.Sprintf("unrecognized resource '%s'", resource))
+	}
+}
+
+func (s *MockKubernetesAPIServer) handlePods(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	switch {
+	case r.Method == http.MethodPost && name == "":
+		p := KubernetesPod{}
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			writeMockKubernetesStatus(w, http.StatusBadRequest, "BadRequest", err.Error())
+			return
+		}
+		key := mockKubernetesKey(namespace, p.Metadata.Name)
+		if _, ok := s.pods[key]; ok {
+			writeMockKubernetesStatus(w, http.StatusConflict, "AlreadyExists", fmt.Sprintf("pod '%s' already exists", p.Metadata.Name))
+			return
+		}
+		p.Metadata.Namespace = namespace
+		p.Metadata.UID = utility.RandomString()
+		p.Status.Phase = KubernetesPodPhasePending
+		s.pods[key] = p
+		writeMockKubernetesJSON(w, http.StatusCreated, p)
+	case r.Method == http.MethodGet && name != "":
+		p, ok := s.pods[mockKubernetesKey(namespace, name)]
+		if !ok {
+			writeMockKubernetesStatus(w, http.StatusNotFound, "NotFound", fmt.Sprintf("pod '%s' not found", name))
+			return
+		}
+		writeMockKubernetesJSON(w, http.StatusOK, p)
+	case r.Method == http.MethodDelete && name != "":
+		key := mockKubernetesKey(namespace, name)
+		p, ok := s.pods[key]
+		if !ok {
+			writeMockKubernetesStatus(w, http.StatusNotFound, "NotFound", fmt.Sprintf("pod '%s' not found", name))
+			return
+		}
+		delete(s.pods, key)
+		writeMockKubernetesJSON(w, http.StatusOK, p)
+	default:
+		writeMockKubernetesStatus(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "unsupported pod operation")
+	}
+}
+
+func (s *MockKubernetesAPIServer) handleSecrets(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	switch {
+	case r.Method == http.MethodPost && name == "":
+		secret := KubernetesSecret{}
+		if err := json.NewDecoder(r.Body).Decode(&secret); err != nil {
+			writeMockKubernetesStatus(w, http.StatusBadRequest, "BadRequest", err.Error())
+			return
+		}
+		key := mockKubernetesKey(namespace, secret.Metadata.Name)
+		if _, ok := s.secrets[key]; ok {
+			writeMockKubernetesStatus(w, http.StatusConflict, "AlreadyExists", fmt.Sprintf("secret '%s' already exists", secret.Metadata.Name))
+			return
+		}
+		secret.Metadata.Namespace = namespace
+		secret.Metadata.UID = utility.RandomString()
+		s.secrets[key] = secret
+		// Like the real API server, the write-only data is omitted from the
+		// response.
+		secret.StringData = nil
+		writeMockKubernetesJSON(w, http.StatusCreated, secret)
+	case r.Method == http.MethodGet && name == "":
+		selector := parseMockKubernetesLabelSelector(r.URL.Query().Get("labelSelector"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		list := kubernetesSecretList{Items: []KubernetesSecret{}}
+		for _, secret := range s.secrets {
+			if limit > 0 && len(list.Items) >= limit {
+				break
+			}
+			if secret.Metadata.Namespace != namespace || !mockKubernetesLabelsMatch(secret.Metadata.Labels, selector) {
+				continue
+			}
+			secret.StringData = nil
+			list.Items = append(list.Items, secret)
+		}
+		writeMockKubernetesJSON(w, http.StatusOK, list)
+	case r.Method == http.MethodDelete && name != "":
+		key := mockKubernetesKey(namespace, name)
+		if _, ok := s.secrets[key]; !ok {
+			writeMockKubernetesStatus(w, http.StatusNotFound, "NotFound", fmt.Sprintf("secret '%s' not found", name))
+			return
+		}
+		delete(s.secrets, key)
+		writeMockKubernetesStatus(w, http.StatusOK, "", "")
+	default:
+		writeMockKubernetesStatus(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "unsupported secret operation")
+	}
+}
+
+// parseMockKubernetesLabelSelector parses an equality-based label selector of
+// the form "key1=value1,key2=value2".
+func parseMockKubernetesLabelSelector(selector string) map[string]string {
+	labels := map[string]string{}
+	for _, requirement := range strings.Split(selector, ",") {
+		if requirement == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(requirement, "=")
+		labels[key] = value
+	}
+	return labels
+}
+
+func mockKubernetesLabelsMatch(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func writeMockKubernetesJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func writeMockKubernetesStatus(w http.ResponseWriter, status int, reason, message string) {
+	writeMockKubernetesJSON(w, status, kubernetesStatus{Reason: reason, Message: message})
+}
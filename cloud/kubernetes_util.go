@@ -0,0 +1,193 @@
+package cloud
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/pod"
+	"github.com/pkg/errors"
+)
+
+const (
+	// KubernetesManagedByLabel is the standard Kubernetes label indicating the
+	// tool that manages a resource.
+	KubernetesManagedByLabel = "app.kubernetes.io/managed-by"
+	// KubernetesManagedByValue is the value of KubernetesManagedByLabel for
+	// resources that Evergreen manages.
+	KubernetesManagedByValue = "evergreen"
+	// KubernetesPodIDLabel is the label containing the ID of the Evergreen pod
+	// that owns a Kubernetes resource.
+	KubernetesPodIDLabel = "evergreen/pod-id"
+
+	// kubernetesCPUUnitsPerVCPU is the number of CPU units (as defined by ECS)
+	// in a single vCPU.
+	kubernetesCPUUnitsPerVCPU = 1024
+)
+
+// kubernetesWindowsBuilds maps Windows versions to the node build label value
+// for nodes running that version.
+var kubernetesWindowsBuilds = map[pod.WindowsVersion]string{
+	pod.WindowsVersionServer2016: "10.0.14393",
+	pod.WindowsVersionServer2019: "10.0.17763",
+	pod.WindowsVersionServer2022: "10.0.20348",
+}
+
+// KubernetesPodName returns the name of the Kubernetes pod for the Evergreen
+// pod with the given ID.
+func KubernetesPodName(podID string) string {
+	return fmt.Sprintf("evg-pod-%s", strings.ToLower(podID))
+}
+
+// KubernetesPodSecretName returns the name of the Kubernetes secret containing
+// the environment secrets for the Evergreen pod with the given ID.
+func KubernetesPodSecretName(podID string) string {
+	return fmt.Sprintf("%s-secrets", KubernetesPodName(podID))
+}
+
+// KubernetesManagedSecretSelector returns the label selector that matches all
+// Kubernetes secrets managed by Evergreen.
+func KubernetesManagedSecretSelector() string {
+	return fmt.Sprintf("%s=%s", KubernetesManagedByLabel, KubernetesManagedByValue)
+}
+
+// kubernetesPodLabels returns the labels to apply to Kubernetes resources
+// owned by the pod.
+func kubernetesPodLabels(p *pod.Pod) map[string]string {
+	return map[string]string{
+		KubernetesManagedByLabel: KubernetesManagedByValue,
+		KubernetesPodIDLabel:     p.ID,
+	}
+}
+
+// ExportKubernetesPodSecret exports the pod's environment secrets into a
+// Kubernetes secret. Kubernetes cannot read secrets from Secrets Manager, so
+// the secret is populated from the values cached in the pod.
+func ExportKubernetesPodSecret(p *pod.Pod) (*KubernetesSecret, error) {
+	data := map[string]string{}
+	for envVar, s := range p.TaskContainerCreationOpts.EnvSecrets {
+		if s.Value == "" {
+			return nil, errors.Errorf("secret for environment variable '%s' is missing a value", envVar)
+		}
+		data[envVar] = s.Value
+	}
+
+	return &KubernetesSecret{
+		Metadata: KubernetesObjectMeta{
+			Name:   KubernetesPodSecretName(p.ID),
+			Labels: kubernetesPodLabels(p),
+		},
+		Type:       "Opaque",
+		StringData: data,
+	}, nil
+}
+
+// ExportKubernetesPod exports the pod DB model into a Kubernetes pod that runs
+// the agent in the cluster described by the Kubernetes settings. The pod reads
+// its environment secrets from the secret returned by
+// ExportKubernetesPodSecret.
+func ExportKubernetesPod(settings *evergreen.Settings, conf evergreen.KubernetesConfig, p *pod.Pod) (*KubernetesPod, error) {
+	opts := p.TaskContainerCreationOpts
+	if opts.RepoCredsExternalID != "" && conf.ImagePullSecret == "" {
+		return nil, errors.New("repository credentials from Secrets Manager cannot be used in Kubernetes, the container pool must specify an image pull secret instead")
+	}
+
+	var env []KubernetesEnvVar
+	for name, value := range opts.EnvVars {
+		env = append(env, KubernetesEnvVar{Name: name, Value: value})
+	}
+	secretName := KubernetesPodSecretName(p.ID)
+	for name := range opts.EnvSecrets {
+		env = append(env, KubernetesEnvVar{
+			Name: name,
+			ValueFrom: &KubernetesEnvVarSource{
+				SecretKeyRef: &KubernetesSecretKeySelector{
+					Name: secretName,
+					Key:  name,
+				},
+			},
+		})
+	}
+	// Sort the environment variables so the pod spec is deterministic.
+	sort.Slice(env, func(i, j int) bool {
+		return env[i].Name < env[j].Name
+	})
+
+	resources := map[string]string{
+		"cpu":    kubernetesCPU(opts.CPU),
+		"memory": fmt.Sprintf("%dMi", opts.MemoryMB),
+	}
+
+	nodeSelector := map[string]string{
+		"kubernetes.io/os":   string(opts.OS),
+		"kubernetes.io/arch": string(opts.Arch),
+	}
+	if opts.OS == pod.OSWindows {
+		build, ok := kubernetesWindowsBuilds[opts.WindowsVersion]
+		if !ok {
+			return nil, errors.Errorf("unrecognized Windows version '%s'", opts.WindowsVersion)
+		}
+		nodeSelector["node.kubernetes.io/windows-build"] = build
+	}
+
+	kp := &KubernetesPod{
+		Metadata: KubernetesObjectMeta{
+			Name:   KubernetesPodName(p.ID),
+			Labels: kubernetesPodLabels(p),
+		},
+		Spec: KubernetesPodSpec{
+			Containers: []KubernetesContainer{
+				{
+					Name:       agentContainerName,
+					Image:      opts.Image,
+					WorkingDir: opts.WorkingDir,
+					Command:    bootstrapContainerCommand(settings, opts),
+					Env:        env,
+					Ports:      []KubernetesContainerPort{{ContainerPort: agentPort}},
+					Resources: KubernetesResourceRequirements{
+						Requests: resources,
+						Limits:   resources,
+					},
+				},
+			},
+			// The pod should not restart once the agent exits, since
+			// Evergreen is responsible for the pod's lifecycle.
+			RestartPolicy: "Never",
+			NodeSelector:  nodeSelector,
+		},
+	}
+	if conf.ImagePullSecret != "" {
+		kp.Spec.ImagePullSecrets = []KubernetesLocalObjectReference{{Name: conf.ImagePullSecret}}
+	}
+
+	return kp, nil
+}
+
+// kubernetesCPU converts CPU units into the equivalent Kubernetes CPU quantity
+// in millicores.
+func kubernetesCPU(cpu int) string {
+	millicores := cpu * 1000 / kubernetesCPUUnitsPerVCPU
+	if millicores < 1 {
+		millicores = 1
+	}
+	return fmt.Sprintf("%dm", millicores)
+}
+
+// ImportKubernetesPodResources imports the resources owned by a Kubernetes pod
+// into the equivalent pod DB model resources.
+func ImportKubernetesPodResources(namespace string, kp KubernetesPod, secretName string) pod.ResourceInfo {
+	containers := make([]pod.ContainerResourceInfo, 0, len(kp.Spec.Containers))
+	for _, c := range kp.Spec.Containers {
+		containers = append(containers, pod.ContainerResourceInfo{
+			Name:      c.Name,
+			SecretIDs: []string{secretName},
+		})
+	}
+
+	return pod.ResourceInfo{
+		ExternalID: kp.Metadata.Name,
+		Cluster:    namespace,
+		Containers: containers,
+	}
+}
@@ -0,0 +1,145 @@
+package cloud
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/pod"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportKubernetesPod(t *testing.T) {
+	settings := &evergreen.Settings{Api: evergreen.APIConfig{URL: "https://example.com"}}
+	conf := evergreen.KubernetesConfig{
+		APIServerURL: "https://kubernetes.example.com",
+		Namespace:    "namespace",
+	}
+	makePod := func() *pod.Pod {
+		return &pod.Pod{
+			ID:       "ABC123",
+			Provider: evergreen.PodProviderKubernetes,
+			TaskContainerCreationOpts: pod.TaskContainerCreationOptions{
+				Image:      "image",
+				CPU:        2048,
+				MemoryMB:   4096,
+				OS:         pod.OSLinux,
+				Arch:       pod.ArchARM64,
+				WorkingDir: "/data",
+				EnvVars: map[string]string{
+					pod.PodIDEnvVar: "ABC123",
+				},
+				EnvSecrets: map[string]pod.Secret{
+					pod.PodSecretEnvVar: {ExternalID: "external_id", Value: "secret_value"},
+				},
+			},
+		}
+	}
+
+	t.Run("SucceedsWithLinuxPod", func(t *testing.T) {
+		p := makePod()
+		kp, err := ExportKubernetesPod(settings, conf, p)
+		require.NoError(t, err)
+
+		assert.Equal(t, "evg-pod-abc123", kp.Metadata.Name)
+		assert.Equal(t, KubernetesManagedByValue, kp.Metadata.Labels[KubernetesManagedByLabel])
+		assert.Equal(t, p.ID, kp.Metadata.Labels[KubernetesPodIDLabel])
+		assert.Equal(t, "Never", kp.Spec.RestartPolicy)
+		assert.Equal(t, "linux", kp.Spec.NodeSelector["kubernetes.io/os"])
+		assert.Equal(t, "arm64", kp.Spec.NodeSelector["kubernetes.io/arch"])
+		assert.Empty(t, kp.Spec.ImagePullSecrets)
+
+		require.Len(t, kp.Spec.Containers, 1)
+		c := kp.Spec.Containers[0]
+		assert.Equal(t, agentContainerName, c.Name)
+		assert.Equal(t, "image", c.Image)
+		assert.Equal(t, "/data", c.WorkingDir)
+		assert.Equal(t, bootstrapContainerCommand(settings, p.TaskContainerCreationOpts), c.Command)
+		assert.Equal(t, "2000m", c.Resources.Limits["cpu"])
+		assert.Equal(t, "4096Mi", c.Resources.Limits["memory"])
+		assert.Equal(t, c.Resources.Limits, c.Resources.Requests)
+		assert.Equal(t, []KubernetesEnvVar{
+			{Name: pod.PodIDEnvVar, Value: "ABC123"},
+			{Name: pod.PodSecretEnvVar, ValueFrom: &KubernetesEnvVarSource{
+				SecretKeyRef: &KubernetesSecretKeySelector{Name: KubernetesPodSecretName(p.ID), Key: pod.PodSecretEnvVar},
+			}},
+		}, c.Env)
+	})
+	t.Run("SelectsWindowsBuild", func(t *testing.T) {
+		p := makePod()
+		p.TaskContainerCreationOpts.OS = pod.OSWindows
+		p.TaskContainerCreationOpts.Arch = pod.ArchAMD64
+		p.TaskContainerCreationOpts.WindowsVersion = pod.WindowsVersionServer2022
+
+		kp, err := ExportKubernetesPod(settings, conf, p)
+		require.NoError(t, err)
+		assert.Equal(t, "windows", kp.Spec.NodeSelector["kubernetes.io/os"])
+		assert.Equal(t, "10.0.20348", kp.Spec.NodeSelector["node.kubernetes.io/windows-build"])
+	})
+	t.Run("UsesImagePullSecret", func(t *testing.T) {
+		p := makePod()
+		p.TaskContainerCreationOpts.RepoCredsExternalID = "repo_creds"
+		conf := conf
+		conf.ImagePullSecret = "pull_secret"
+
+		kp, err := ExportKubernetesPod(settings, conf, p)
+		require.NoError(t, err)
+		assert.Equal(t, []KubernetesLocalObjectReference{{Name: "pull_secret"}}, kp.Spec.ImagePullSecrets)
+	})
+	t.Run("FailsWithRepoCredsAndNoImagePullSecret", func(t *testing.T) {
+		p := makePod()
+		p.TaskContainerCreationOpts.RepoCredsExternalID = "repo_creds"
+
+		_, err := ExportKubernetesPod(settings, conf, p)
+		assert.Error(t, err)
+	})
+}
+
+func TestExportKubernetesPodSecret(t *testing.T) {
+	p := &pod.Pod{
+		ID: "pod",
+		TaskContainerCreationOpts: pod.TaskContainerCreationOptions{
+			EnvSecrets: map[string]pod.Secret{
+				pod.PodSecretEnvVar: {ExternalID: "external_id", Value: "secret_value"},
+			},
+		},
+	}
+
+	t.Run("Succeeds", func(t *testing.T) {
+		s, err := ExportKubernetesPodSecret(p)
+		require.NoError(t, err)
+		assert.Equal(t, KubernetesPodSecretName(p.ID), s.Metadata.Name)
+		assert.Equal(t, p.ID, s.Metadata.Labels[KubernetesPodIDLabel])
+		assert.Equal(t, map[string]string{pod.PodSecretEnvVar: "secret_value"}, s.StringData)
+	})
+	t.Run("FailsWithoutCachedSecretValue", func(t *testing.T) {
+		p := &pod.Pod{
+			ID: "pod",
+			TaskContainerCreationOpts: pod.TaskContainerCreationOptions{
+				EnvSecrets: map[string]pod.Secret{
+					pod.PodSecretEnvVar: {ExternalID: "external_id"},
+				},
+			},
+		}
+		_, err := ExportKubernetesPodSecret(p)
+		assert.Error(t, err)
+	})
+}
+
+func TestImportKubernetesPodResources(t *testing.T) {
+	kp := KubernetesPod{
+		Metadata: KubernetesObjectMeta{Name: "evg-pod-id"},
+		Spec: KubernetesPodSpec{
+			Containers: []KubernetesContainer{{Name: agentContainerName}},
+		},
+	}
+
+	res := ImportKubernetesPodResources("namespace", kp, "secret")
+	assert.Equal(t, pod.ResourceInfo{
+		ExternalID: "evg-pod-id",
+		Cluster:    "namespace",
+		Containers: []pod.ContainerResourceInfo{
+			{Name: agentContainerName, SecretIDs: []string{"secret"}},
+		},
+	}, res)
+}
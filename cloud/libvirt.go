@@ -0,0 +1,683 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// libvirtRootDeviceName is the device name of a VM's root disk.
+	libvirtRootDeviceName = "vda"
+)
+
+// LibvirtProviderSettings describes how to create VMs for a distro on a
+// libvirt hypervisor. VMs are booted from a copy-on-write clone of the base
+// image, so the base image must already be configured to accept SSH
+// connections with the distro's SSH key.
+type LibvirtProviderSettings struct {
+	// URI is the libvirt connection URI of the hypervisor
+	// (e.g. qemu+ssh://user@hypervisor/system).
+	URI string `mapstructure:"uri" json:"uri" bson:"uri"`
+
+	// StoragePool is the name of the storage pool containing the base image.
+	// Each VM's root disk is created in the same pool.
+	StoragePool string `mapstructure:"storage_pool" json:"storage_pool" bson:"storage_pool"`
+
+	// BaseImage is the name of the qcow2 volume in the storage pool to clone
+	// for each VM's root disk.
+	BaseImage string `mapstructure:"base_image" json:"base_image" bson:"base_image"`
+
+	// Network is the name of the libvirt network to attach VMs to. The
+	// network must lease addresses over DHCP.
+	Network string `mapstructure:"network" json:"network" bson:"network"`
+
+	// VCPUs is the number of virtual CPUs allocated to each VM.
+	VCPUs int `mapstructure:"vcpus" json:"vcpus" bson:"vcpus"`
+
+	// MemoryMB is the amount of memory allocated to each VM.
+	MemoryMB int `mapstructure:"memory_mb" json:"memory_mb" bson:"memory_mb"`
+
+	// DiskSizeGB is the size of each VM's root disk. If unset, the root disk
+	// is the same size as the base image.
+	DiskSizeGB int32 `mapstructure:"disk_size_gb" json:"disk_size_gb,omitempty" bson:"disk_size_gb,omitempty"`
+}
+
+// Validate checks that the settings have everything needed to create a VM.
+func (s *LibvirtProviderSettings) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(s.URI == "", "libvirt connection URI must not be empty")
+	catcher.NewWhen(s.StoragePool == "", "storage pool must not be empty")
+	catcher.NewWhen(s.BaseImage == "", "base image must not be empty")
+	catcher.NewWhen(s.Network == "", "network must not be empty")
+	catcher.NewWhen(s.VCPUs <= 0, "number of vCPUs must be positive")
+	catcher.NewWhen(s.MemoryMB <= 0, "memory must be positive")
+	catcher.NewWhen(s.DiskSizeGB < 0, "disk size cannot be negative")
+	return catcher.Resolve()
+}
+
+// FromDistroSettings loads the libvirt settings from the distro's provider
+// settings.
+func (s *LibvirtProviderSettings) FromDistroSettings(d distro.Distro, _ string) error {
+	if len(d.ProviderSettingsList) != 0 {
+		bytes, err := d.ProviderSettingsList[0].MarshalBSON()
+		if err != nil {
+			return errors.Wrap(err, "marshalling provider setting into BSON")
+		}
+		if err := bson.Unmarshal(bytes, s); err != nil {
+			return errors.Wrap(err, "unmarshalling BSON into libvirt provider settings")
+		}
+	}
+	return nil
+}
+
+// libvirtManager implements the Manager interface for VMs running on libvirt
+// hypervisors.
+type libvirtManager struct {
+	client LibvirtClient
+	env    evergreen.Environment
+	// volumeConf is the hypervisor where volumes are created.
+	volumeConf evergreen.LibvirtConfig
+}
+
+// Configure populates a libvirtManager by reading relevant settings from the
+// config object.
+func (m *libvirtManager) Configure(ctx context.Context, s *evergreen.Settings) error {
+	if m.env == nil {
+		return errors.New("libvirt manager requires a non-nil Evergreen environment")
+	}
+	if m.client == nil {
+		m.client = newVirshClient()
+	}
+	m.volumeConf = s.Providers.Libvirt
+	return nil
+}
+
+// getSettings returns the validated libvirt settings for the host's distro.
+func (m *libvirtManager) getSettings(h *host.Host) (*LibvirtProviderSettings, error) {
+	s := &LibvirtProviderSettings{}
+	if err := s.FromDistroSettings(h.Distro, ""); err != nil {
+		return nil, errors.Wrapf(err, "getting libvirt settings for distro '%s'", h.Distro.Id)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid libvirt settings in distro '%s'", h.Distro.Id)
+	}
+	return s, nil
+}
+
+// libvirtRootVolumeName returns the name of the volume containing the host's
+// root disk.
+func libvirtRootVolumeName(hostID string) string {
+	return fmt.Sprintf("%s-root", hostID)
+}
+
+// SpawnHost clones the distro's base image and boots a new VM from it.
+func (m *libvirtManager) SpawnHost(ctx context.Context, h *host.Host) (*host.Host, error) {
+	if h.Distro.Provider != evergreen.ProviderNameLibvirt {
+		return nil, errors.Errorf("can't spawn instance of provider '%s' for distro '%s': distro provider is '%s'", evergreen.ProviderNameLibvirt, h.Distro.Id, h.Distro.Provider)
+	}
+	if h.Distro.BootstrapSettings.Method == distro.BootstrapMethodUserData {
+		return nil, errors.Errorf("libvirt host '%s' cannot be bootstrapped with user data", h.Id)
+	}
+
+	s, err := m.getSettings(h)
+	if err != nil {
+		return nil, err
+	}
+
+	rootDisk := LibvirtDisk{
+		Pool:   s.StoragePool,
+		Volume: libvirtRootVolumeName(h.Id),
+		Target: libvirtRootDeviceName,
+	}
+	if err = m.client.CreateVolume(ctx, s.URI, LibvirtVolume{
+		Pool:       rootDisk.Pool,
+		Name:       rootDisk.Volume,
+		SizeGB:     s.DiskSizeGB,
+		BaseVolume: s.BaseImage,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "creating root disk for host '%s'", h.Id)
+	}
+
+	if err = m.client.DefineDomain(ctx, s.URI, LibvirtDomain{
+		Name:     h.Id,
+		VCPUs:    s.VCPUs,
+		MemoryMB: s.MemoryMB,
+		Network:  s.Network,
+		Disks:    []LibvirtDisk{rootDisk},
+	}); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrapf(err, "defining VM for host '%s'", h.Id)
+		catcher.Wrap(m.client.DeleteVolume(ctx, s.URI, rootDisk.Pool, rootDisk.Volume), "cleaning up root disk after failing to define VM")
+		return nil, catcher.Resolve()
+	}
+
+	if err = m.client.StartDomain(ctx, s.URI, h.Id); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrapf(err, "starting VM for host '%s'", h.Id)
+		catcher.Wrap(m.client.UndefineDomain(ctx, s.URI, h.Id), "cleaning up VM after failing to start it")
+		catcher.Wrap(m.client.DeleteVolume(ctx, s.URI, rootDisk.Pool, rootDisk.Volume), "cleaning up root disk after failing to start VM")
+		grip.Info(message.WrapError(catcher.Resolve(), message.Fields{
+			"message": "start libvirt host failed",
+			"host_id": h.Id,
+			"distro":  h.Distro.Id,
+		}))
+		return nil, catcher.Resolve()
+	}
+
+	grip.Info(message.Fields{
+		"message":       "created and started libvirt VM",
+		"host_id":       h.Id,
+		"host_provider": h.Distro.Provider,
+		"distro":        h.Distro.Id,
+	})
+
+	return h, nil
+}
+
+func (m *libvirtManager) ModifyHost(context.Context, *host.Host, host.HostModifyOptions) error {
+	return errors.New("can't modify instances with libvirt provider")
+}
+
+// GetInstanceState returns the state of the host's VM. If the VM is running,
+// it also caches its IP address as the host's DNS name.
+func (m *libvirtManager) GetInstanceState(ctx context.Context, h *host.Host) (CloudInstanceState, error) {
+	info := CloudInstanceState{Status: StatusUnknown}
+	s, err := m.getSettings(h)
+	if err != nil {
+		return info, err
+	}
+
+	domain, err := m.client.GetDomain(ctx, s.URI, h.Id)
+	if err != nil {
+		return info, errors.Wrapf(err, "getting VM for host '%s'", h.Id)
+	}
+	if domain == nil {
+		info.Status = StatusNonExistent
+		return info, nil
+	}
+
+	info.Status = libvirtDomainStateToEvergreenStatus(domain.State)
+	info.StateReason = string(domain.State)
+	if info.Status == StatusRunning {
+		grip.Error(message.WrapError(m.cacheHostData(ctx, s.URI, h), message.Fields{
+			"message": "can't update host cached data",
+			"type":    "libvirt",
+			"host_id": h.Id,
+		}))
+	}
+
+	return info, nil
+}
+
+// GetInstanceStatuses returns the statuses of the hosts' VMs, checking each
+// hypervisor once.
+func (m *libvirtManager) GetInstanceStatuses(ctx context.Context, hosts []host.Host) (map[string]CloudStatus, error) {
+	hostsByURI := map[string][]*host.Host{}
+	for i := range hosts {
+		s, err := m.getSettings(&hosts[i])
+		if err != nil {
+			return nil, err
+		}
+		hostsByURI[s.URI] = append(hostsByURI[s.URI], &hosts[i])
+	}
+
+	statuses := make(map[string]CloudStatus, len(hosts))
+	for uri, uriHosts := range hostsByURI {
+		states, err := m.client.ListDomainStates(ctx, uri)
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing VMs on hypervisor '%s'", uri)
+		}
+		for _, h := range uriHosts {
+			state, ok := states[h.Id]
+			if !ok {
+				statuses[h.Id] = StatusNonExistent
+				continue
+			}
+			statuses[h.Id] = libvirtDomainStateToEvergreenStatus(state)
+			if statuses[h.Id] == StatusRunning && h.Host == "" {
+				grip.Error(message.WrapError(m.cacheHostData(ctx, uri, h), message.Fields{
+					"message": "can't update host cached data",
+					"type":    "libvirt",
+					"host_id": h.Id,
+				}))
+			}
+		}
+	}
+
+	return statuses, nil
+}
+
+// cacheHostData caches the running VM's IP address in the host. Hosts on
+// libvirt networks do not have DNS names, so the IP address is also used as
+// the DNS name.
+func (m *libvirtManager) cacheHostData(ctx context.Context, uri string, h *host.Host) error {
+	addr, err := m.client.GetDomainIPAddress(ctx, uri, h.Id)
+	if err != nil {
+		return errors.Wrap(err, "getting IP address")
+	}
+	if addr == "" || addr == h.Host {
+		return nil
+	}
+
+	startedAt := h.StartTime
+	if utility.IsZeroTime(startedAt) {
+		startedAt = time.Now()
+	}
+	data := host.CloudProviderData{
+		StartedAt:   startedAt,
+		PublicDNS:   addr,
+		PrivateIPv4: addr,
+		Volumes:     h.Volumes,
+	}
+	if err := host.CacheAllCloudProviderData(ctx, m.env, map[string]host.CloudProviderData{h.Id: data}); err != nil {
+		return errors.Wrap(err, "caching host data")
+	}
+	h.Host = addr
+	h.IPv4 = addr
+	h.StartTime = startedAt
+	return nil
+}
+
+func (m *libvirtManager) SetPortMappings(context.Context, *host.Host, *host.Host) error {
+	return errors.New("can't set port mappings with libvirt provider")
+}
+
+// TerminateInstance powers off and deletes the host's VM along with its root
+// disk. Attached volumes are kept so they can be reattached elsewhere.
+func (m *libvirtManager) TerminateInstance(ctx context.Context, h *host.Host, user, reason string) error {
+	if h.Status == evergreen.HostTerminated {
+		return errors.Errorf("cannot terminate host '%s' because it's already marked as terminated", h.Id)
+	}
+
+	s, err := m.getSettings(h)
+	if err != nil {
+		return err
+	}
+
+	if err = m.client.DestroyDomain(ctx, s.URI, h.Id); err != nil {
+		return errors.Wrapf(err, "powering off VM for host '%s'", h.Id)
+	}
+	if err = m.client.UndefineDomain(ctx, s.URI, h.Id); err != nil {
+		return errors.Wrapf(err, "deleting VM for host '%s'", h.Id)
+	}
+	if err = m.client.DeleteVolume(ctx, s.URI, s.StoragePool, libvirtRootVolumeName(h.Id)); err != nil {
+		return errors.Wrapf(err, "deleting root disk for host '%s'", h.Id)
+	}
+
+	grip.Info(message.Fields{
+		"message":       "terminated libvirt VM",
+		"user":          user,
+		"host_provider": h.Distro.Provider,
+		"host_id":       h.Id,
+		"distro":        h.Distro.Id,
+	})
+
+	for _, vol := range h.Volumes {
+		volDB, err := host.FindVolumeByID(ctx, vol.VolumeID)
+		if err != nil {
+			return errors.Wrap(err, "finding volumes for host")
+		}
+		if volDB == nil {
+			continue
+		}
+
+		if volDB.Expiration.Before(time.Now().Add(evergreen.UnattachedVolumeExpiration)) {
+			if err = volDB.SetExpiration(ctx, time.Now().Add(evergreen.UnattachedVolumeExpiration)); err != nil {
+				return errors.Wrapf(err, "updating expiration for volume '%s'", volDB.ID)
+			}
+		}
+
+		grip.Error(message.WrapError(host.UnsetVolumeHost(ctx, volDB.ID), message.Fields{
+			"host_id":   h.Id,
+			"volume_id": volDB.ID,
+			"op":        "terminating host",
+			"message":   "problem un-setting host info on volume records",
+		}))
+	}
+
+	return errors.Wrap(h.Terminate(ctx, user, reason), "terminating host in DB")
+}
+
+// StopInstance gracefully shuts down the host's VM.
+func (m *libvirtManager) StopInstance(ctx context.Context, h *host.Host, shouldKeepOff bool, user string) error {
+	if !utility.StringSliceContains(evergreen.StoppableHostStatuses, h.Status) {
+		return errors.Errorf("host cannot be stopped because its status ('%s') is not a stoppable state", h.Status)
+	}
+
+	s, err := m.getSettings(h)
+	if err != nil {
+		return err
+	}
+
+	if err = m.client.ShutdownDomain(ctx, s.URI, h.Id); err != nil {
+		return errors.Wrapf(err, "shutting down VM for host '%s'", h.Id)
+	}
+	grip.Error(message.WrapError(h.SetStopping(ctx, user), message.Fields{
+		"message": "could not mark host as stopping, continuing to poll instance status anyways",
+		"host_id": h.Id,
+		"user":    user,
+	}))
+
+	// VMs shut down asynchronously, so before we can say the host is stopped,
+	// we have to poll the status until it's actually stopped.
+	if err = m.waitForStatus(ctx, h, StatusStopped); err != nil {
+		return errors.Wrap(err, "checking if host stopped")
+	}
+
+	grip.Info(message.Fields{
+		"message":       "stopped instance",
+		"user":          user,
+		"host_provider": h.Distro.Provider,
+		"host_id":       h.Id,
+		"distro":        h.Distro.Id,
+	})
+
+	return errors.Wrap(h.SetStopped(ctx, shouldKeepOff, user), "marking DB host as stopped")
+}
+
+// StartInstance boots the host's stopped VM.
+func (m *libvirtManager) StartInstance(ctx context.Context, h *host.Host, user string) error {
+	if !utility.StringSliceContains(evergreen.StartableHostStatuses, h.Status) {
+		return errors.Errorf("host cannot be started because its status ('%s') is not a startable state", h.Status)
+	}
+
+	s, err := m.getSettings(h)
+	if err != nil {
+		return err
+	}
+
+	if err = m.client.StartDomain(ctx, s.URI, h.Id); err != nil {
+		return errors.Wrapf(err, "starting VM for host '%s'", h.Id)
+	}
+
+	if err = m.waitForStatus(ctx, h, StatusRunning); err != nil {
+		return errors.Wrap(err, "checking if host started")
+	}
+
+	grip.Info(message.Fields{
+		"message":       "started instance",
+		"user":          user,
+		"host_provider": h.Distro.Provider,
+		"host_id":       h.Id,
+		"distro":        h.Distro.Id,
+	})
+
+	return errors.Wrap(h.SetRunning(ctx, user), "marking host as running")
+}
+
+// waitForStatus polls the host's VM until it reaches the given status.
+func (m *libvirtManager) waitForStatus(ctx context.Context, h *host.Host, status CloudStatus) error {
+	return utility.Retry(
+		ctx,
+		func() (bool, error) {
+			info, err := m.GetInstanceState(ctx, h)
+			if err != nil {
+				return false, errors.Wrap(err, "getting instance status")
+			}
+			if info.Status == status {
+				return false, nil
+			}
+			return true, errors.Errorf("host is not %s, current status is '%s' because '%s'", status, info.Status, info.StateReason)
+		}, utility.RetryOptions{
+			MaxAttempts: checkSuccessAttempts,
+			MinDelay:    checkSuccessInitPeriod,
+			MaxDelay:    checkSuccessMaxDelay,
+		})
+}
+
+// GetDNSName returns the IP address of the host's VM, since VMs on libvirt
+// networks do not have DNS names.
+func (m *libvirtManager) GetDNSName(ctx context.Context, h *host.Host) (string, error) {
+	s, err := m.getSettings(h)
+	if err != nil {
+		return "", err
+	}
+	return m.client.GetDomainIPAddress(ctx, s.URI, h.Id)
+}
+
+// checkVolumeHypervisor checks that volumes can be attached to the host.
+// Volumes are stored on the admin-configured hypervisor, so they can only be
+// attached to VMs running on that same hypervisor.
+func (m *libvirtManager) checkVolumeHypervisor(h *host.Host) (*LibvirtProviderSettings, error) {
+	if m.volumeConf.URI == "" {
+		return nil, errors.New("no libvirt hypervisor is configured for volumes")
+	}
+	s, err := m.getSettings(h)
+	if err != nil {
+		return nil, err
+	}
+	if s.URI != m.volumeConf.URI {
+		return nil, errors.Errorf("host '%s' is on hypervisor '%s', but volumes can only be attached to hosts on hypervisor '%s'", h.Id, s.URI, m.volumeConf.URI)
+	}
+	return s, nil
+}
+
+// AttachVolume attaches a volume to the host's VM as a new disk.
+func (m *libvirtManager) AttachVolume(ctx context.Context, h *host.Host, attachment *host.VolumeAttachment) error {
+	if _, err := m.checkVolumeHypervisor(h); err != nil {
+		return err
+	}
+
+	if attachment.DeviceName == "" {
+		deviceName, err := nextLibvirtDeviceName(h.HostVolumeDeviceNames())
+		if err != nil {
+			return errors.Wrap(err, "generating device name")
+		}
+		attachment.DeviceName = deviceName
+	}
+
+	disk := LibvirtDisk{
+		Pool:   m.volumeConf.StoragePool,
+		Volume: attachment.VolumeID,
+		Target: attachment.DeviceName,
+	}
+	if err := m.client.AttachDisk(ctx, m.volumeConf.URI, h.Id, disk); err != nil {
+		return errors.Wrapf(err, "attaching volume '%s' to host '%s'", attachment.VolumeID, h.Id)
+	}
+
+	return errors.Wrapf(h.AddVolumeToHost(ctx, attachment), "attaching volume '%s' to host '%s' in DB", attachment.VolumeID, h.Id)
+}
+
+// nextLibvirtDeviceName returns the first virtio device name that is not the
+// root disk and is not already in use.
+func nextLibvirtDeviceName(existingDeviceNames []string) (string, error) {
+	for letter := 'b'; letter <= 'z'; letter++ {
+		name := fmt.Sprintf("vd%c", letter)
+		if !utility.StringSliceContains(existingDeviceNames, name) {
+			return name, nil
+		}
+	}
+	return "", errors.New("no device names are available")
+}
+
+// DetachVolume detaches a volume from the host's VM.
+func (m *libvirtManager) DetachVolume(ctx context.Context, h *host.Host, volumeID string) error {
+	v, err := host.FindVolumeByID(ctx, volumeID)
+	if err != nil {
+		return errors.Wrapf(err, "getting volume '%s'", volumeID)
+	}
+	if v == nil {
+		return errors.Errorf("volume '%s' not found", volumeID)
+	}
+	if _, err = m.checkVolumeHypervisor(h); err != nil {
+		return err
+	}
+
+	var deviceName string
+	for _, attachment := range h.Volumes {
+		if attachment.VolumeID == volumeID {
+			deviceName = attachment.DeviceName
+			break
+		}
+	}
+	if deviceName == "" {
+		return errors.Errorf("volume '%s' is not attached to host '%s'", volumeID, h.Id)
+	}
+
+	disk := LibvirtDisk{
+		Pool:   m.volumeConf.StoragePool,
+		Volume: volumeID,
+		Target: deviceName,
+	}
+	if err = m.client.DetachDisk(ctx, m.volumeConf.URI, h.Id, disk); err != nil {
+		return errors.Wrapf(err, "detaching volume '%s' from host '%s' in client", volumeID, h.Id)
+	}
+
+	if v.Expiration.Before(time.Now().Add(evergreen.DefaultSpawnHostExpiration)) {
+		if err = v.SetExpiration(ctx, time.Now().Add(evergreen.DefaultSpawnHostExpiration)); err != nil {
+			return errors.Wrapf(err, "updating expiration for volume '%s'", volumeID)
+		}
+	}
+
+	return errors.Wrapf(h.RemoveVolumeFromHost(ctx, volumeID), "detaching volume '%s' from host '%s' in DB", volumeID, h.Id)
+}
+
+// CreateVolume creates a new empty volume on the admin-configured hypervisor.
+func (m *libvirtManager) CreateVolume(ctx context.Context, volume *host.Volume) (*host.Volume, error) {
+	if m.volumeConf.URI == "" {
+		return nil, errors.New("no libvirt hypervisor is configured for volumes")
+	}
+	if volume.Size <= 0 {
+		return nil, errors.New("volume size must be positive")
+	}
+
+	if volume.ID == "" {
+		volume.ID = fmt.Sprintf("evg-vol-%s", utility.RandomString())
+	}
+	volume.Expiration = time.Now().Add(evergreen.DefaultSpawnHostExpiration)
+
+	if err := m.client.CreateVolume(ctx, m.volumeConf.URI, LibvirtVolume{
+		Pool:   m.volumeConf.StoragePool,
+		Name:   volume.ID,
+		SizeGB: volume.Size,
+	}); err != nil {
+		return nil, errors.Wrap(err, "creating volume in client")
+	}
+
+	if err := volume.Insert(); err != nil {
+		return nil, errors.Wrap(err, "creating volume in DB")
+	}
+
+	return volume, nil
+}
+
+// DeleteVolume deletes a volume from the admin-configured hypervisor.
+func (m *libvirtManager) DeleteVolume(ctx context.Context, volume *host.Volume) error {
+	if m.volumeConf.URI == "" {
+		return errors.New("no libvirt hypervisor is configured for volumes")
+	}
+	if err := m.client.DeleteVolume(ctx, m.volumeConf.URI, m.volumeConf.StoragePool, volume.ID); err != nil {
+		return errors.Wrapf(err, "deleting volume '%s' in client", volume.ID)
+	}
+
+	return errors.Wrapf(volume.Remove(ctx), "deleting volume '%s' in DB", volume.ID)
+}
+
+// ModifyVolume modifies a volume's expiration, size or name.
+func (m *libvirtManager) ModifyVolume(ctx context.Context, volume *host.Volume, opts *model.VolumeModifyOptions) error {
+	if opts.NoExpiration && opts.HasExpiration {
+		return errors.New("can't set both no expiration and has expiration")
+	}
+
+	if !utility.IsZeroTime(opts.Expiration) {
+		if err := volume.SetExpiration(ctx, opts.Expiration); err != nil {
+			return errors.Wrapf(err, "modifying volume '%s' expiration", volume.ID)
+		}
+		if err := volume.SetNoExpiration(ctx, false); err != nil {
+			return errors.Wrapf(err, "clearing volume '%s' no-expiration in DB", volume.ID)
+		}
+	}
+
+	if opts.NoExpiration {
+		if err := volume.SetNoExpiration(ctx, true); err != nil {
+			return errors.Wrapf(err, "setting volume '%s' no-expiration in DB", volume.ID)
+		}
+	}
+
+	if opts.HasExpiration {
+		if err := volume.SetNoExpiration(ctx, false); err != nil {
+			return errors.Wrapf(err, "clearing volume '%s' no-expiration in DB", volume.ID)
+		}
+	}
+
+	if opts.Size > 0 {
+		if m.volumeConf.URI == "" {
+			return errors.New("no libvirt hypervisor is configured for volumes")
+		}
+		if err := m.client.ResizeVolume(ctx, m.volumeConf.URI, m.volumeConf.StoragePool, volume.ID, opts.Size); err != nil {
+			return errors.Wrapf(err, "modifying volume '%s' size in client", volume.ID)
+		}
+		if err := volume.SetSize(ctx, opts.Size); err != nil {
+			return errors.Wrapf(err, "modifying volume '%s' size in DB", volume.ID)
+		}
+	}
+
+	if opts.NewName != "" {
+		if err := volume.SetDisplayName(ctx, opts.NewName); err != nil {
+			return errors.Wrapf(err, "modifying volume '%s' name in DB", volume.ID)
+		}
+	}
+	return nil
+}
+
+// GetVolumeAttachment returns the VM that the volume is attached to, if any.
+func (m *libvirtManager) GetVolumeAttachment(ctx context.Context, volumeID string) (*VolumeAttachment, error) {
+	if m.volumeConf.URI == "" {
+		return nil, errors.New("no libvirt hypervisor is configured for volumes")
+	}
+	v, err := host.FindVolumeByID(ctx, volumeID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting volume '%s'", volumeID)
+	}
+	if v == nil {
+		return nil, errors.Errorf("volume '%s' not found", volumeID)
+	}
+	if v.Host == "" {
+		return nil, nil
+	}
+
+	domain, err := m.client.GetDomain(ctx, m.volumeConf.URI, v.Host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting VM for host '%s'", v.Host)
+	}
+	if domain == nil {
+		return nil, nil
+	}
+	for _, disk := range domain.Disks {
+		if disk.Volume == volumeID {
+			return &VolumeAttachment{
+				VolumeID:   volumeID,
+				HostID:     v.Host,
+				DeviceName: disk.Target,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (m *libvirtManager) CheckInstanceType(context.Context, string) error {
+	return errors.New("can't specify instance type with libvirt provider")
+}
+
+// TimeTilNextPayment returns the amount of time until the next payment is due
+// for the host. For libvirt this is not relevant.
+func (m *libvirtManager) TimeTilNextPayment(_ *host.Host) time.Duration {
+	return time.Duration(0)
+}
+
+// Cleanup is a noop for the libvirt provider.
+func (m *libvirtManager) Cleanup(context.Context) error {
+	return nil
+}
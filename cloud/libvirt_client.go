@@ -0,0 +1,505 @@
+package cloud
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// LibvirtClient manages VMs and their storage on libvirt hypervisors. Every
+// operation takes the libvirt connection URI of the hypervisor to act on.
+type LibvirtClient interface {
+	// CreateVolume creates a new volume in a storage pool. If the volume has a
+	// base volume, the new volume is a copy-on-write clone of it.
+	CreateVolume(ctx context.Context, uri string, v LibvirtVolume) error
+	// ResizeVolume grows a volume to the given size.
+	ResizeVolume(ctx context.Context, uri, pool, name string, sizeGB int32) error
+	// DeleteVolume deletes a volume. It no-ops if the volume does not exist.
+	DeleteVolume(ctx context.Context, uri, pool, name string) error
+
+	// DefineDomain defines a new persistent domain without starting it.
+	DefineDomain(ctx context.Context, uri string, d LibvirtDomain) error
+	// UndefineDomain removes a stopped domain's definition. It no-ops if the
+	// domain does not exist.
+	UndefineDomain(ctx context.Context, uri, name string) error
+	// StartDomain boots a defined domain.
+	StartDomain(ctx context.Context, uri, name string) error
+	// ShutdownDomain asks a domain's guest OS to shut down gracefully.
+	ShutdownDomain(ctx context.Context, uri, name string) error
+	// DestroyDomain immediately powers off a domain. It no-ops if the domain
+	// does not exist or is not running.
+	DestroyDomain(ctx context.Context, uri, name string) error
+	// GetDomain returns information about a domain. It returns nil if the
+	// domain does not exist.
+	GetDomain(ctx context.Context, uri, name string) (*LibvirtDomainInfo, error)
+	// ListDomainStates returns the states of all domains on the hypervisor,
+	// keyed by domain name.
+	ListDomainStates(ctx context.Context, uri string) (map[string]LibvirtDomainState, error)
+	// GetDomainIPAddress returns the IPv4 address leased to a domain. It
+	// returns an empty string if the domain does not have an address yet.
+	GetDomainIPAddress(ctx context.Context, uri, name string) (string, error)
+
+	// AttachDisk attaches a volume as a disk to a domain.
+	AttachDisk(ctx context.Context, uri, domain string, disk LibvirtDisk) error
+	// DetachDisk detaches a disk from a domain.
+	DetachDisk(ctx context.Context, uri, domain string, disk LibvirtDisk) error
+}
+
+// LibvirtVolume describes a volume in a libvirt storage pool.
+type LibvirtVolume struct {
+	Pool string
+	Name string
+	// SizeGB is the capacity of the volume. For clones, this may be zero to
+	// use the capacity of the base volume.
+	SizeGB int32
+	// BaseVolume is the optional name of a volume in the same pool to clone.
+	BaseVolume string
+}
+
+// LibvirtDisk describes a volume attached to a domain as a disk.
+type LibvirtDisk struct {
+	Pool   string
+	Volume string
+	// Target is the device name of the disk in the guest (e.g. vdb).
+	Target string
+}
+
+// LibvirtDomain describes a domain to define.
+type LibvirtDomain struct {
+	Name     string
+	VCPUs    int
+	MemoryMB int
+	// Network is the name of the libvirt network the domain is attached to.
+	Network string
+	Disks   []LibvirtDisk
+}
+
+// LibvirtDomainInfo is information about an existing domain.
+type LibvirtDomainInfo struct {
+	Name  string
+	State LibvirtDomainState
+	Disks []LibvirtDisk
+}
+
+// LibvirtDomainState is the state of a libvirt domain as reported by virsh.
+type LibvirtDomainState string
+
+const (
+	LibvirtDomainStateRunning     LibvirtDomainState = "running"
+	LibvirtDomainStateIdle        LibvirtDomainState = "idle"
+	LibvirtDomainStatePaused      LibvirtDomainState = "paused"
+	LibvirtDomainStateInShutdown  LibvirtDomainState = "in shutdown"
+	LibvirtDomainStateShutOff     LibvirtDomainState = "shut off"
+	LibvirtDomainStateCrashed     LibvirtDomainState = "crashed"
+	LibvirtDomainStatePMSuspended LibvirtDomainState = "pmsuspended"
+)
+
+// libvirtDomainStateToEvergreenStatus converts a libvirt domain state into
+// the equivalent cloud status.
+func libvirtDomainStateToEvergreenStatus(state LibvirtDomainState) CloudStatus {
+	switch state {
+	case LibvirtDomainStateRunning, LibvirtDomainStateIdle:
+		return StatusRunning
+	case LibvirtDomainStateInShutdown:
+		return StatusStopping
+	case LibvirtDomainStateShutOff, LibvirtDomainStatePaused, LibvirtDomainStatePMSuspended:
+		return StatusStopped
+	case LibvirtDomainStateCrashed:
+		return StatusFailed
+	default:
+		return StatusUnknown
+	}
+}
+
+// virshRunner runs a virsh command against the hypervisor at the given URI and
+// returns its standard output.
+type virshRunner func(ctx context.Context, uri string, args ...string) (string, error)
+
+// virshClient implements LibvirtClient by running virsh commands.
+type virshClient struct {
+	run virshRunner
+}
+
+// newVirshClient returns a LibvirtClient that shells out to the virsh binary.
+func newVirshClient() *virshClient {
+	return &virshClient{run: runVirsh}
+}
+
+func runVirsh(ctx context.Context, uri string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "virsh", append([]string{"--connect", uri, "--quiet"}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrapf(err, "running virsh %s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// isLibvirtNotFound returns whether the error indicates that the requested
+// domain or volume does not exist.
+func isLibvirtNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, notFound := range []string{
+		"failed to get domain",
+		"Domain not found",
+		"failed to get vol",
+		"Storage volume not found",
+	} {
+		if strings.Contains(msg, notFound) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLibvirtDomainNotRunning returns whether the error indicates that the
+// domain cannot be stopped because it is not running.
+func isLibvirtDomainNotRunning(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "domain is not running")
+}
+
+func (c *virshClient) CreateVolume(ctx context.Context, uri string, v LibvirtVolume) error {
+	var capacity string
+	if v.SizeGB > 0 {
+		capacity = fmt.Sprintf("%dG", v.SizeGB)
+	} else if v.BaseVolume != "" {
+		baseCapacity, err := c.getVolumeCapacity(ctx, uri, v.Pool, v.BaseVolume)
+		if err != nil {
+			return errors.Wrapf(err, "getting capacity of base volume '%s'", v.BaseVolume)
+		}
+		capacity = strconv.FormatInt(baseCapacity, 10)
+	} else {
+		return errors.Errorf("volume '%s' must have a size or a base volume", v.Name)
+	}
+
+	args := []string{"vol-create-as", "--pool", v.Pool, "--name", v.Name, "--capacity", capacity, "--format", "qcow2"}
+	if v.BaseVolume != "" {
+		args = append(args, "--backing-vol", v.BaseVolume, "--backing-vol-format", "qcow2")
+	}
+	_, err := c.run(ctx, uri, args...)
+	return errors.Wrapf(err, "creating volume '%s' in pool '%s'", v.Name, v.Pool)
+}
+
+// getVolumeCapacity returns the capacity of the volume in bytes.
+func (c *virshClient) getVolumeCapacity(ctx context.Context, uri, pool, name string) (int64, error) {
+	out, err := c.run(ctx, uri, "vol-info", "--pool", pool, "--bytes", name)
+	if err != nil {
+		return 0, err
+	}
+	return parseVirshVolumeCapacity(out)
+}
+
+// parseVirshVolumeCapacity parses the capacity from the output of
+// "virsh vol-info --bytes".
+func parseVirshVolumeCapacity(out string) (int64, error) {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok || strings.TrimSpace(key) != "Capacity" {
+			continue
+		}
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			break
+		}
+		capacity, err := strconv.ParseInt(fields[0], 10, 64)
+		return capacity, errors.Wrapf(err, "parsing volume capacity '%s'", fields[0])
+	}
+	return 0, errors.New("volume info is missing capacity")
+}
+
+func (c *virshClient) ResizeVolume(ctx context.Context, uri, pool, name string, sizeGB int32) error {
+	_, err := c.run(ctx, uri, "vol-resize", "--pool", pool, name, fmt.Sprintf("%dG", sizeGB))
+	return errors.Wrapf(err, "resizing volume '%s' in pool '%s'", name, pool)
+}
+
+func (c *virshClient) DeleteVolume(ctx context.Context, uri, pool, name string) error {
+	_, err := c.run(ctx, uri, "vol-delete", "--pool", pool, name)
+	if isLibvirtNotFound(err) {
+		return nil
+	}
+	return errors.Wrapf(err, "deleting volume '%s' in pool '%s'", name, pool)
+}
+
+func (c *virshClient) DefineDomain(ctx context.Context, uri string, d LibvirtDomain) error {
+	return errors.Wrapf(c.runWithXMLFile(ctx, uri, newLibvirtDomainXML(d), "define"), "defining domain '%s'", d.Name)
+}
+
+func (c *virshClient) UndefineDomain(ctx context.Context, uri, name string) error {
+	_, err := c.run(ctx, uri, "undefine", name)
+	if isLibvirtNotFound(err) {
+		return nil
+	}
+	return errors.Wrapf(err, "undefining domain '%s'", name)
+}
+
+func (c *virshClient) StartDomain(ctx context.Context, uri, name string) error {
+	_, err := c.run(ctx, uri, "start", name)
+	return errors.Wrapf(err, "starting domain '%s'", name)
+}
+
+func (c *virshClient) ShutdownDomain(ctx context.Context, uri, name string) error {
+	_, err := c.run(ctx, uri, "shutdown", name)
+	return errors.Wrapf(err, "shutting down domain '%s'", name)
+}
+
+func (c *virshClient) DestroyDomain(ctx context.Context, uri, name string) error {
+	_, err := c.run(ctx, uri, "destroy", name)
+	if isLibvirtNotFound(err) || isLibvirtDomainNotRunning(err) {
+		return nil
+	}
+	return errors.Wrapf(err, "destroying domain '%s'", name)
+}
+
+func (c *virshClient) GetDomain(ctx context.Context, uri, name string) (*LibvirtDomainInfo, error) {
+	state, err := c.run(ctx, uri, "domstate", name)
+	if isLibvirtNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting state of domain '%s'", name)
+	}
+
+	out, err := c.run(ctx, uri, "dumpxml", name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting definition of domain '%s'", name)
+	}
+	domainXML := libvirtDomainXML{}
+	if err := xml.Unmarshal([]byte(out), &domainXML); err != nil {
+		return nil, errors.Wrapf(err, "parsing definition of domain '%s'", name)
+	}
+
+	info := &LibvirtDomainInfo{
+		Name:  name,
+		State: LibvirtDomainState(strings.TrimSpace(state)),
+	}
+	for _, disk := range domainXML.Devices.Disks {
+		if disk.Source.Pool == "" {
+			continue
+		}
+		info.Disks = append(info.Disks, LibvirtDisk{
+			Pool:   disk.Source.Pool,
+			Volume: disk.Source.Volume,
+			Target: disk.Target.Dev,
+		})
+	}
+	return info, nil
+}
+
+func (c *virshClient) ListDomainStates(ctx context.Context, uri string) (map[string]LibvirtDomainState, error) {
+	out, err := c.run(ctx, uri, "list", "--all")
+	if err != nil {
+		return nil, errors.Wrap(err, "listing domains")
+	}
+	return parseVirshDomainList(out), nil
+}
+
+// parseVirshDomainList parses the domain names and states from the output of
+// "virsh --quiet list --all", which has one line per domain of the form
+// "<ID or -> <name> <state>".
+func parseVirshDomainList(out string) map[string]LibvirtDomainState {
+	states := map[string]LibvirtDomainState{}
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+		states[fields[1]] = LibvirtDomainState(strings.Join(fields[2:], " "))
+	}
+	return states
+}
+
+func (c *virshClient) GetDomainIPAddress(ctx context.Context, uri, name string) (string, error) {
+	out, err := c.run(ctx, uri, "domifaddr", name, "--source", "lease")
+	if err != nil {
+		return "", errors.Wrapf(err, "getting addresses of domain '%s'", name)
+	}
+	return parseVirshDomainIPv4Address(out), nil
+}
+
+// parseVirshDomainIPv4Address parses the first IPv4 address from the output of
+// "virsh --quiet domifaddr", which has one line per address of the form
+// "<interface> <MAC> <protocol> <address>/<prefix>".
+func parseVirshDomainIPv4Address(out string) string {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || fields[2] != "ipv4" {
+			continue
+		}
+		addr, _, _ := strings.Cut(fields[3], "/")
+		return addr
+	}
+	return ""
+}
+
+func (c *virshClient) AttachDisk(ctx context.Context, uri, domain string, disk LibvirtDisk) error {
+	err := c.runWithXMLFile(ctx, uri, newLibvirtDiskXML(disk), "attach-device", domain, "--persistent")
+	return errors.Wrapf(err, "attaching volume '%s' to domain '%s'", disk.Volume, domain)
+}
+
+func (c *virshClient) DetachDisk(ctx context.Context, uri, domain string, disk LibvirtDisk) error {
+	err := c.runWithXMLFile(ctx, uri, newLibvirtDiskXML(disk), "detach-device", domain, "--persistent")
+	return errors.Wrapf(err, "detaching volume '%s' from domain '%s'", disk.Volume, domain)
+}
+
+// runWithXMLFile writes the XML document to a temporary file and runs the
+// virsh command with the file path inserted after the command name and its
+// first argument, if any.
+func (c *virshClient) runWithXMLFile(ctx context.Context, uri string, doc any, command string, args ...string) error {
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshalling XML")
+	}
+
+	f, err := os.CreateTemp("", "evergreen-libvirt-*.xml")
+	if err != nil {
+		return errors.Wrap(err, "creating XML file")
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return errors.Wrap(err, "writing XML file")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "closing XML file")
+	}
+
+	cmdArgs := []string{command}
+	if len(args) > 0 {
+		cmdArgs = append(cmdArgs, args[0], f.Name())
+		cmdArgs = append(cmdArgs, args[1:]...)
+	} else {
+		cmdArgs = append(cmdArgs, f.Name())
+	}
+	_, err = c.run(ctx, uri, cmdArgs...)
+	return err
+}
+
+type libvirtDomainXML struct {
+	XMLName  xml.Name            `xml:"domain"`
+	Type     string              `xml:"type,attr"`
+	Name     string              `xml:"name"`
+	Memory   libvirtMemoryXML    `xml:"memory"`
+	VCPU     int                 `xml:"vcpu"`
+	OS       libvirtOSXML        `xml:"os"`
+	Features *libvirtFeaturesXML `xml:"features,omitempty"`
+	CPU      *libvirtCPUXML      `xml:"cpu,omitempty"`
+	Devices  libvirtDevicesXML   `xml:"devices"`
+}
+
+type libvirtMemoryXML struct {
+	Unit  string `xml:"unit,attr"`
+	Value int    `xml:",chardata"`
+}
+
+type libvirtOSXML struct {
+	Type string         `xml:"type"`
+	Boot libvirtBootXML `xml:"boot"`
+}
+
+type libvirtBootXML struct {
+	Dev string `xml:"dev,attr"`
+}
+
+type libvirtFeaturesXML struct {
+	// ACPI must be enabled for the guest to respond to graceful shutdown.
+	ACPI *struct{} `xml:"acpi"`
+}
+
+type libvirtCPUXML struct {
+	Mode string `xml:"mode,attr"`
+}
+
+type libvirtDevicesXML struct {
+	Disks      []libvirtDiskXML      `xml:"disk"`
+	Interfaces []libvirtInterfaceXML `xml:"interface"`
+}
+
+type libvirtDiskXML struct {
+	XMLName xml.Name             `xml:"disk"`
+	Type    string               `xml:"type,attr"`
+	Device  string               `xml:"device,attr"`
+	Driver  libvirtDiskDriverXML `xml:"driver"`
+	Source  libvirtDiskSourceXML `xml:"source"`
+	Target  libvirtDiskTargetXML `xml:"target"`
+}
+
+type libvirtDiskDriverXML struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type libvirtDiskSourceXML struct {
+	Pool   string `xml:"pool,attr,omitempty"`
+	Volume string `xml:"volume,attr,omitempty"`
+}
+
+type libvirtDiskTargetXML struct {
+	Dev string `xml:"dev,attr"`
+	Bus string `xml:"bus,attr,omitempty"`
+}
+
+type libvirtInterfaceXML struct {
+	Type   string                    `xml:"type,attr"`
+	Source libvirtInterfaceSourceXML `xml:"source"`
+	Model  libvirtInterfaceModelXML  `xml:"model"`
+}
+
+type libvirtInterfaceSourceXML struct {
+	Network string `xml:"network,attr"`
+}
+
+type libvirtInterfaceModelXML struct {
+	Type string `xml:"type,attr"`
+}
+
+func newLibvirtDiskXML(disk LibvirtDisk) libvirtDiskXML {
+	return libvirtDiskXML{
+		Type:   "volume",
+		Device: "disk",
+		Driver: libvirtDiskDriverXML{Name: "qemu", Type: "qcow2"},
+		Source: libvirtDiskSourceXML{Pool: disk.Pool, Volume: disk.Volume},
+		Target: libvirtDiskTargetXML{Dev: disk.Target, Bus: "virtio"},
+	}
+}
+
+func newLibvirtDomainXML(d LibvirtDomain) libvirtDomainXML {
+	disks := make([]libvirtDiskXML, 0, len(d.Disks))
+	for _, disk := range d.Disks {
+		disks = append(disks, newLibvirtDiskXML(disk))
+	}
+
+	return libvirtDomainXML{
+		Type:     "kvm",
+		Name:     d.Name,
+		Memory:   libvirtMemoryXML{Unit: "MiB", Value: d.MemoryMB},
+		VCPU:     d.VCPUs,
+		OS:       libvirtOSXML{Type: "hvm", Boot: libvirtBootXML{Dev: "hd"}},
+		Features: &libvirtFeaturesXML{ACPI: &struct{}{}},
+		CPU:      &libvirtCPUXML{Mode: "host-passthrough"},
+		Devices: libvirtDevicesXML{
+			Disks: disks,
+			Interfaces: []libvirtInterfaceXML{
+				{
+					Type:   "network",
+					Source: libvirtInterfaceSourceXML{Network: d.Network},
+					Model:  libvirtInterfaceModelXML{Type: "virtio"},
+				},
+			},
+		},
+	}
+}
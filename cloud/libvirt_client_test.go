@@ -0,0 +1,174 @@
+package cloud
+
+import (
+	"context"
+	"encoding/xml"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVirsh records virsh invocations and returns canned output for them.
+type fakeVirsh struct {
+	calls [][]string
+	// outputs maps virsh commands to their output.
+	outputs map[string]string
+	// errs maps virsh commands to the error they return.
+	errs map[string]error
+	// xmlFiles contains the contents of XML files passed to virsh commands.
+	xmlFiles []string
+}
+
+func (f *fakeVirsh) run(_ context.Context, uri string, args ...string) (string, error) {
+	f.calls = append(f.calls, append([]string{uri}, args...))
+	for _, arg := range args {
+		if strings.HasSuffix(arg, ".xml") {
+			data, err := os.ReadFile(arg)
+			if err != nil {
+				return "", err
+			}
+			f.xmlFiles = append(f.xmlFiles, string(data))
+		}
+	}
+	if err := f.errs[args[0]]; err != nil {
+		return "", err
+	}
+	return f.outputs[args[0]], nil
+}
+
+func TestVirshClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const uri = "qemu:///system"
+
+	for tName, tCase := range map[string]func(t *testing.T, f *fakeVirsh, c *virshClient){
+		"CreateVolumeClonesBaseVolumeWithItsCapacity": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			f.outputs["vol-info"] = "Name:           base.qcow2\nType:           file\nCapacity:       21474836480 bytes\nAllocation:     5368709120 bytes\n"
+			require.NoError(t, c.CreateVolume(ctx, uri, LibvirtVolume{Pool: "default", Name: "vm-root", BaseVolume: "base.qcow2"}))
+			require.Len(t, f.calls, 2)
+			assert.Equal(t, []string{uri, "vol-create-as", "--pool", "default", "--name", "vm-root", "--capacity", "21474836480", "--format", "qcow2", "--backing-vol", "base.qcow2", "--backing-vol-format", "qcow2"}, f.calls[1])
+		},
+		"CreateVolumeUsesRequestedSize": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			require.NoError(t, c.CreateVolume(ctx, uri, LibvirtVolume{Pool: "default", Name: "data", SizeGB: 50}))
+			require.Len(t, f.calls, 1)
+			assert.Equal(t, []string{uri, "vol-create-as", "--pool", "default", "--name", "data", "--capacity", "50G", "--format", "qcow2"}, f.calls[0])
+		},
+		"CreateVolumeFailsWithoutSizeOrBaseVolume": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			assert.Error(t, c.CreateVolume(ctx, uri, LibvirtVolume{Pool: "default", Name: "data"}))
+			assert.Empty(t, f.calls)
+		},
+		"DeleteVolumeNoopsForNonexistentVolume": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			f.errs["vol-delete"] = errors.New("error: failed to get vol 'data'")
+			assert.NoError(t, c.DeleteVolume(ctx, uri, "default", "data"))
+		},
+		"DefineDomainWritesDomainXML": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			require.NoError(t, c.DefineDomain(ctx, uri, LibvirtDomain{
+				Name:     "vm",
+				VCPUs:    4,
+				MemoryMB: 8192,
+				Network:  "default",
+				Disks:    []LibvirtDisk{{Pool: "default", Volume: "vm-root", Target: "vda"}},
+			}))
+			require.Len(t, f.xmlFiles, 1)
+
+			domainXML := libvirtDomainXML{}
+			require.NoError(t, xml.Unmarshal([]byte(f.xmlFiles[0]), &domainXML))
+			assert.Equal(t, "vm", domainXML.Name)
+			assert.Equal(t, 4, domainXML.VCPU)
+			assert.Equal(t, 8192, domainXML.Memory.Value)
+			assert.Equal(t, "MiB", domainXML.Memory.Unit)
+			require.Len(t, domainXML.Devices.Disks, 1)
+			assert.Equal(t, "vm-root", domainXML.Devices.Disks[0].Source.Volume)
+			assert.Equal(t, "vda", domainXML.Devices.Disks[0].Target.Dev)
+			require.Len(t, domainXML.Devices.Interfaces, 1)
+			assert.Equal(t, "default", domainXML.Devices.Interfaces[0].Source.Network)
+		},
+		"DestroyDomainNoopsForStoppedDomain": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			f.errs["destroy"] = errors.New("error: Requested operation is not valid: domain is not running")
+			assert.NoError(t, c.DestroyDomain(ctx, uri, "vm"))
+		},
+		"GetDomainParsesStateAndDisks": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			f.outputs["domstate"] = "shut off\n"
+			f.outputs["dumpxml"] = `<domain type="kvm">
+  <name>vm</name>
+  <devices>
+    <disk type="volume" device="disk">
+      <source pool="default" volume="vm-root"/>
+      <target dev="vda" bus="virtio"/>
+    </disk>
+    <disk type="file" device="cdrom">
+      <source file="/tmp/seed.iso"/>
+      <target dev="sda" bus="sata"/>
+    </disk>
+  </devices>
+</domain>`
+			info, err := c.GetDomain(ctx, uri, "vm")
+			require.NoError(t, err)
+			require.NotZero(t, info)
+			assert.Equal(t, LibvirtDomainStateShutOff, info.State)
+			assert.Equal(t, []LibvirtDisk{{Pool: "default", Volume: "vm-root", Target: "vda"}}, info.Disks)
+		},
+		"GetDomainReturnsNilForNonexistentDomain": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			f.errs["domstate"] = errors.New("error: failed to get domain 'vm'")
+			info, err := c.GetDomain(ctx, uri, "vm")
+			assert.NoError(t, err)
+			assert.Zero(t, info)
+		},
+		"ListDomainStatesParsesDomainList": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			f.outputs["list"] = " 1    vm1   running\n -    vm2   shut off\n\n"
+			states, err := c.ListDomainStates(ctx, uri)
+			require.NoError(t, err)
+			assert.Equal(t, map[string]LibvirtDomainState{
+				"vm1": LibvirtDomainStateRunning,
+				"vm2": LibvirtDomainStateShutOff,
+			}, states)
+		},
+		"GetDomainIPAddressReturnsFirstIPv4Address": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			f.outputs["domifaddr"] = " vnet0      52:54:00:12:34:56    ipv6         fe80::1/64\n vnet0      52:54:00:12:34:56    ipv4         192.168.122.45/24\n"
+			addr, err := c.GetDomainIPAddress(ctx, uri, "vm")
+			require.NoError(t, err)
+			assert.Equal(t, "192.168.122.45", addr)
+		},
+		"GetDomainIPAddressReturnsEmptyWithoutLease": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			addr, err := c.GetDomainIPAddress(ctx, uri, "vm")
+			require.NoError(t, err)
+			assert.Empty(t, addr)
+		},
+		"AttachDiskPassesDeviceXML": func(t *testing.T, f *fakeVirsh, c *virshClient) {
+			require.NoError(t, c.AttachDisk(ctx, uri, "vm", LibvirtDisk{Pool: "default", Volume: "data", Target: "vdb"}))
+			require.Len(t, f.calls, 1)
+			args := f.calls[0]
+			require.Len(t, args, 5)
+			assert.Equal(t, "attach-device", args[1])
+			assert.Equal(t, "vm", args[2])
+			assert.Equal(t, "--persistent", args[4])
+
+			require.Len(t, f.xmlFiles, 1)
+			diskXML := libvirtDiskXML{}
+			require.NoError(t, xml.Unmarshal([]byte(f.xmlFiles[0]), &diskXML))
+			assert.Equal(t, "data", diskXML.Source.Volume)
+			assert.Equal(t, "vdb", diskXML.Target.Dev)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			f := &fakeVirsh{
+				outputs: map[string]string{},
+				errs:    map[string]error{},
+			}
+			tCase(t, f, &virshClient{run: f.run})
+		})
+	}
+}
+
+func TestLibvirtDomainStateToEvergreenStatus(t *testing.T) {
+	assert.Equal(t, StatusRunning, libvirtDomainStateToEvergreenStatus(LibvirtDomainStateRunning))
+	assert.Equal(t, StatusStopping, libvirtDomainStateToEvergreenStatus(LibvirtDomainStateInShutdown))
+	assert.Equal(t, StatusStopped, libvirtDomainStateToEvergreenStatus(LibvirtDomainStateShutOff))
+	assert.Equal(t, StatusFailed, libvirtDomainStateToEvergreenStatus(LibvirtDomainStateCrashed))
+	assert.Equal(t, StatusUnknown, libvirtDomainStateToEvergreenStatus("no state"))
+}
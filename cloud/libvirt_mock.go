@@ -0,0 +1,258 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// libvirtClientMock is an in-memory fake of a set of libvirt hypervisors. VMs
+// change state instantly, so it should only ever be used for testing purposes.
+type libvirtClientMock struct {
+	mu      sync.Mutex
+	domains map[string]*LibvirtDomainInfo
+	volumes map[string]LibvirtVolume
+	// domainIPs maps domain keys to the IP address leased to the domain.
+	domainIPs map[string]string
+	nextIP    int
+
+	// API call options
+	failCreateVolume bool
+	failDefine       bool
+	failStart        bool
+	failList         bool
+}
+
+func newLibvirtClientMock() *libvirtClientMock {
+	return &libvirtClientMock{
+		domains:   map[string]*LibvirtDomainInfo{},
+		volumes:   map[string]LibvirtVolume{},
+		domainIPs: map[string]string{},
+	}
+}
+
+func mockLibvirtDomainKey(uri, name string) string {
+	return fmt.Sprintf("%s/%s", uri, name)
+}
+
+func mockLibvirtVolumeKey(uri, pool, name string) string {
+	return fmt.Sprintf("%s/%s/%s", uri, pool, name)
+}
+
+func (c *libvirtClientMock) CreateVolume(_ context.Context, uri string, v LibvirtVolume) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failCreateVolume {
+		return errors.New("failed to create volume")
+	}
+	key := mockLibvirtVolumeKey(uri, v.Pool, v.Name)
+	if _, ok := c.volumes[key]; ok {
+		return errors.Errorf("volume '%s' already exists", v.Name)
+	}
+	if v.BaseVolume != "" {
+		base, ok := c.volumes[mockLibvirtVolumeKey(uri, v.Pool, v.BaseVolume)]
+		if !ok {
+			return errors.Errorf("base volume '%s' not found", v.BaseVolume)
+		}
+		if v.SizeGB == 0 {
+			v.SizeGB = base.SizeGB
+		}
+	}
+	c.volumes[key] = v
+	return nil
+}
+
+func (c *libvirtClientMock) ResizeVolume(_ context.Context, uri, pool, name string, sizeGB int32) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := mockLibvirtVolumeKey(uri, pool, name)
+	v, ok := c.volumes[key]
+	if !ok {
+		return errors.Errorf("volume '%s' not found", name)
+	}
+	if sizeGB < v.SizeGB {
+		return errors.New("cannot shrink volume")
+	}
+	v.SizeGB = sizeGB
+	c.volumes[key] = v
+	return nil
+}
+
+func (c *libvirtClientMock) DeleteVolume(_ context.Context, uri, pool, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.volumes, mockLibvirtVolumeKey(uri, pool, name))
+	return nil
+}
+
+func (c *libvirtClientMock) DefineDomain(_ context.Context, uri string, d LibvirtDomain) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failDefine {
+		return errors.New("failed to define domain")
+	}
+	key := mockLibvirtDomainKey(uri, d.Name)
+	if _, ok := c.domains[key]; ok {
+		return errors.Errorf("domain '%s' already exists", d.Name)
+	}
+	for _, disk := range d.Disks {
+		if _, ok := c.volumes[mockLibvirtVolumeKey(uri, disk.Pool, disk.Volume)]; !ok {
+			return errors.Errorf("volume '%s' not found", disk.Volume)
+		}
+	}
+	c.domains[key] = &LibvirtDomainInfo{
+		Name:  d.Name,
+		State: LibvirtDomainStateShutOff,
+		Disks: append([]LibvirtDisk{}, d.Disks...),
+	}
+	return nil
+}
+
+func (c *libvirtClientMock) UndefineDomain(_ context.Context, uri, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := mockLibvirtDomainKey(uri, name)
+	d, ok := c.domains[key]
+	if !ok {
+		return nil
+	}
+	if d.State == LibvirtDomainStateRunning {
+		return errors.Errorf("cannot undefine running domain '%s'", name)
+	}
+	delete(c.domains, key)
+	delete(c.domainIPs, key)
+	return nil
+}
+
+func (c *libvirtClientMock) StartDomain(_ context.Context, uri, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failStart {
+		return errors.New("failed to start domain")
+	}
+	key := mockLibvirtDomainKey(uri, name)
+	d, ok := c.domains[key]
+	if !ok {
+		return errors.Errorf("domain '%s' not found", name)
+	}
+	d.State = LibvirtDomainStateRunning
+	if _, ok := c.domainIPs[key]; !ok {
+		c.nextIP++
+		c.domainIPs[key] = fmt.Sprintf("192.168.122.%d", c.nextIP+1)
+	}
+	return nil
+}
+
+func (c *libvirtClientMock) ShutdownDomain(_ context.Context, uri, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.domains[mockLibvirtDomainKey(uri, name)]
+	if !ok {
+		return errors.Errorf("domain '%s' not found", name)
+	}
+	if d.State != LibvirtDomainStateRunning {
+		return errors.Errorf("domain '%s' is not running", name)
+	}
+	d.State = LibvirtDomainStateShutOff
+	return nil
+}
+
+func (c *libvirtClientMock) DestroyDomain(_ context.Context, uri, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if d, ok := c.domains[mockLibvirtDomainKey(uri, name)]; ok {
+		d.State = LibvirtDomainStateShutOff
+	}
+	return nil
+}
+
+func (c *libvirtClientMock) GetDomain(_ context.Context, uri, name string) (*LibvirtDomainInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.domains[mockLibvirtDomainKey(uri, name)]
+	if !ok {
+		return nil, nil
+	}
+	info := *d
+	info.Disks = append([]LibvirtDisk{}, d.Disks...)
+	return &info, nil
+}
+
+func (c *libvirtClientMock) ListDomainStates(_ context.Context, uri string) (map[string]LibvirtDomainState, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failList {
+		return nil, errors.New("failed to list domains")
+	}
+	states := map[string]LibvirtDomainState{}
+	for key, d := range c.domains {
+		if key == mockLibvirtDomainKey(uri, d.Name) {
+			states[d.Name] = d.State
+		}
+	}
+	return states, nil
+}
+
+func (c *libvirtClientMock) GetDomainIPAddress(_ context.Context, uri, name string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := mockLibvirtDomainKey(uri, name)
+	d, ok := c.domains[key]
+	if !ok {
+		return "", errors.Errorf("domain '%s' not found", name)
+	}
+	if d.State != LibvirtDomainStateRunning {
+		return "", nil
+	}
+	return c.domainIPs[key], nil
+}
+
+func (c *libvirtClientMock) AttachDisk(_ context.Context, uri, domain string, disk LibvirtDisk) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.domains[mockLibvirtDomainKey(uri, domain)]
+	if !ok {
+		return errors.Errorf("domain '%s' not found", domain)
+	}
+	if _, ok := c.volumes[mockLibvirtVolumeKey(uri, disk.Pool, disk.Volume)]; !ok {
+		return errors.Errorf("volume '%s' not found", disk.Volume)
+	}
+	for _, existing := range d.Disks {
+		if existing.Target == disk.Target {
+			return errors.Errorf("target '%s' is already in use", disk.Target)
+		}
+	}
+	d.Disks = append(d.Disks, disk)
+	return nil
+}
+
+func (c *libvirtClientMock) DetachDisk(_ context.Context, uri, domain string, disk LibvirtDisk) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.domains[mockLibvirtDomainKey(uri, domain)]
+	if !ok {
+		return errors.Errorf("domain '%s' not found", domain)
+	}
+	for i, existing := range d.Disks {
+		if existing.Target == disk.Target {
+			d.Disks = append(d.Disks[:i], d.Disks[i+1:]...)
+			return nil
+		}
+	}
+	return errors.Errorf("no disk with target '%s'", disk.Target)
+}
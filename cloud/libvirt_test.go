@@ -0,0 +1,327 @@
+package cloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evergreen-ci/birch"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibvirtProviderSettings(t *testing.T) {
+	makeDistro := func() distro.Distro {
+		return distro.Distro{
+			Id:       "distro",
+			Provider: evergreen.ProviderNameLibvirt,
+			ProviderSettingsList: []*birch.Document{birch.NewDocument(
+				birch.EC.String("uri", "qemu:///system"),
+				birch.EC.String("storage_pool", "default"),
+				birch.EC.String("base_image", "ubuntu2204.qcow2"),
+				birch.EC.String("network", "default"),
+				birch.EC.Int("vcpus", 2),
+				birch.EC.Int("memory_mb", 4096),
+			)},
+		}
+	}
+
+	t.Run("SucceedsWithValidSettings", func(t *testing.T) {
+		s := &LibvirtProviderSettings{}
+		require.NoError(t, s.FromDistroSettings(makeDistro(), ""))
+		assert.NoError(t, s.Validate())
+		assert.Equal(t, "qemu:///system", s.URI)
+		assert.Equal(t, "default", s.StoragePool)
+		assert.Equal(t, "ubuntu2204.qcow2", s.BaseImage)
+		assert.Equal(t, "default", s.Network)
+		assert.Equal(t, 2, s.VCPUs)
+		assert.Equal(t, 4096, s.MemoryMB)
+		assert.Zero(t, s.DiskSizeGB)
+	})
+	t.Run("FailsWithoutBaseImage", func(t *testing.T) {
+		d := makeDistro()
+		d.ProviderSettingsList[0].Set(birch.EC.String("base_image", ""))
+		s := &LibvirtProviderSettings{}
+		require.NoError(t, s.FromDistroSettings(d, ""))
+		assert.Error(t, s.Validate())
+	})
+	t.Run("FailsWithoutResources", func(t *testing.T) {
+		s := &LibvirtProviderSettings{
+			URI:         "qemu:///system",
+			StoragePool: "default",
+			BaseImage:   "ubuntu2204.qcow2",
+			Network:     "default",
+		}
+		assert.Error(t, s.Validate())
+	})
+	t.Run("GetSettingsReturnsLibvirtSettings", func(t *testing.T) {
+		s, err := GetSettings(evergreen.ProviderNameLibvirt)
+		require.NoError(t, err)
+		assert.IsType(t, &LibvirtProviderSettings{}, s)
+	})
+}
+
+func TestLibvirtManager(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const (
+		uri  = "qemu+ssh://hypervisor/system"
+		pool = "default"
+	)
+
+	makeHost := func() *host.Host {
+		return &host.Host{
+			Id:     "h1",
+			Status: evergreen.HostRunning,
+			Distro: distro.Distro{
+				Id:       "distro",
+				Provider: evergreen.ProviderNameLibvirt,
+				BootstrapSettings: distro.BootstrapSettings{
+					Method: distro.BootstrapMethodSSH,
+				},
+				ProviderSettingsList: []*birch.Document{birch.NewDocument(
+					birch.EC.String("uri", uri),
+					birch.EC.String("storage_pool", pool),
+					birch.EC.String("base_image", "base.qcow2"),
+					birch.EC.String("network", "default"),
+					birch.EC.Int("vcpus", 2),
+					birch.EC.Int("memory_mb", 2048),
+				)},
+			},
+		}
+	}
+
+	// spawn creates the host's VM and inserts the host into the DB.
+	spawn := func(t *testing.T, m *libvirtManager, h *host.Host) {
+		_, err := m.SpawnHost(ctx, h)
+		require.NoError(t, err)
+		require.NoError(t, h.Insert(ctx))
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, m *libvirtManager, c *libvirtClientMock){
+		"SpawnHostClonesBaseImageAndStartsVM": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			h := makeHost()
+			spawn(t, m, h)
+
+			root, ok := c.volumes[mockLibvirtVolumeKey(uri, pool, libvirtRootVolumeName(h.Id))]
+			require.True(t, ok)
+			assert.Equal(t, "base.qcow2", root.BaseVolume)
+			assert.EqualValues(t, 20, root.SizeGB, "clone should inherit the base image size")
+
+			domain, err := c.GetDomain(ctx, uri, h.Id)
+			require.NoError(t, err)
+			require.NotZero(t, domain)
+			assert.Equal(t, LibvirtDomainStateRunning, domain.State)
+			require.Len(t, domain.Disks, 1)
+			assert.Equal(t, libvirtRootDeviceName, domain.Disks[0].Target)
+		},
+		"SpawnHostFailsWithUserDataBootstrapping": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			h := makeHost()
+			h.Distro.BootstrapSettings.Method = distro.BootstrapMethodUserData
+			_, err := m.SpawnHost(ctx, h)
+			assert.Error(t, err)
+			assert.Empty(t, c.domains)
+		},
+		"SpawnHostFailsWithNonLibvirtDistro": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			h := makeHost()
+			h.Distro.Provider = evergreen.ProviderNameStatic
+			_, err := m.SpawnHost(ctx, h)
+			assert.Error(t, err)
+		},
+		"SpawnHostCleansUpAfterStartFailure": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			c.failStart = true
+			_, err := m.SpawnHost(ctx, makeHost())
+			assert.Error(t, err)
+			assert.Empty(t, c.domains)
+			assert.Len(t, c.volumes, 1, "only the base image should remain")
+		},
+		"GetInstanceStateCachesIPAddress": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			h := makeHost()
+			spawn(t, m, h)
+
+			info, err := m.GetInstanceState(ctx, h)
+			require.NoError(t, err)
+			assert.Equal(t, StatusRunning, info.Status)
+			assert.NotEmpty(t, h.Host)
+
+			dbHost, err := host.FindOneId(ctx, h.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbHost)
+			assert.Equal(t, h.Host, dbHost.Host)
+			assert.Equal(t, h.Host, dbHost.IPv4)
+
+			dnsName, err := m.GetDNSName(ctx, h)
+			require.NoError(t, err)
+			assert.Equal(t, h.Host, dnsName)
+		},
+		"GetInstanceStateReturnsNonexistentForMissingVM": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			info, err := m.GetInstanceState(ctx, makeHost())
+			require.NoError(t, err)
+			assert.Equal(t, StatusNonExistent, info.Status)
+		},
+		"GetInstanceStatusesChecksAllHosts": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			running := makeHost()
+			spawn(t, m, running)
+			stopped := makeHost()
+			stopped.Id = "h2"
+			spawn(t, m, stopped)
+			require.NoError(t, c.ShutdownDomain(ctx, uri, stopped.Id))
+			missing := makeHost()
+			missing.Id = "h3"
+
+			statuses, err := m.GetInstanceStatuses(ctx, []host.Host{*running, *stopped, *missing})
+			require.NoError(t, err)
+			assert.Equal(t, map[string]CloudStatus{
+				running.Id: StatusRunning,
+				stopped.Id: StatusStopped,
+				missing.Id: StatusNonExistent,
+			}, statuses)
+		},
+		"GetInstanceStatusesFailsWhenListingFails": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			c.failList = true
+			_, err := m.GetInstanceStatuses(ctx, []host.Host{*makeHost()})
+			assert.Error(t, err)
+		},
+		"StopAndStartInstance": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			h := makeHost()
+			spawn(t, m, h)
+
+			require.NoError(t, m.StopInstance(ctx, h, false, evergreen.User))
+			dbHost, err := host.FindOneId(ctx, h.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbHost)
+			assert.Equal(t, evergreen.HostStopped, dbHost.Status)
+
+			require.NoError(t, m.StartInstance(ctx, dbHost, evergreen.User))
+			dbHost, err = host.FindOneId(ctx, h.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbHost)
+			assert.Equal(t, evergreen.HostRunning, dbHost.Status)
+
+			domain, err := c.GetDomain(ctx, uri, h.Id)
+			require.NoError(t, err)
+			require.NotZero(t, domain)
+			assert.Equal(t, LibvirtDomainStateRunning, domain.State)
+		},
+		"TerminateInstanceDeletesVMAndRootDisk": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			h := makeHost()
+			spawn(t, m, h)
+
+			require.NoError(t, m.TerminateInstance(ctx, h, evergreen.User, ""))
+
+			domain, err := c.GetDomain(ctx, uri, h.Id)
+			require.NoError(t, err)
+			assert.Zero(t, domain)
+			_, ok := c.volumes[mockLibvirtVolumeKey(uri, pool, libvirtRootVolumeName(h.Id))]
+			assert.False(t, ok)
+
+			dbHost, err := host.FindOneId(ctx, h.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbHost)
+			assert.Equal(t, evergreen.HostTerminated, dbHost.Status)
+		},
+		"TerminateInstanceFailsForTerminatedHost": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			h := makeHost()
+			h.Status = evergreen.HostTerminated
+			assert.Error(t, m.TerminateInstance(ctx, h, evergreen.User, ""))
+		},
+		"VolumeLifecycle": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			h := makeHost()
+			spawn(t, m, h)
+
+			v, err := m.CreateVolume(ctx, &host.Volume{Size: 50, CreatedBy: "user"})
+			require.NoError(t, err)
+			require.NotEmpty(t, v.ID)
+			_, ok := c.volumes[mockLibvirtVolumeKey(uri, pool, v.ID)]
+			require.True(t, ok)
+
+			attachment := &host.VolumeAttachment{VolumeID: v.ID}
+			require.NoError(t, m.AttachVolume(ctx, h, attachment))
+			assert.Equal(t, "vdb", attachment.DeviceName)
+
+			volumeAttachment, err := m.GetVolumeAttachment(ctx, v.ID)
+			require.NoError(t, err)
+			require.NotZero(t, volumeAttachment)
+			assert.Equal(t, VolumeAttachment{VolumeID: v.ID, HostID: h.Id, DeviceName: "vdb"}, *volumeAttachment)
+
+			require.NoError(t, m.ModifyVolume(ctx, v, &model.VolumeModifyOptions{Size: 100, NewName: "data"}))
+			assert.EqualValues(t, 100, c.volumes[mockLibvirtVolumeKey(uri, pool, v.ID)].SizeGB)
+			dbVolume, err := host.FindVolumeByID(ctx, v.ID)
+			require.NoError(t, err)
+			require.NotZero(t, dbVolume)
+			assert.EqualValues(t, 100, dbVolume.Size)
+			assert.Equal(t, "data", dbVolume.DisplayName)
+
+			require.NoError(t, m.DetachVolume(ctx, h, v.ID))
+			volumeAttachment, err = m.GetVolumeAttachment(ctx, v.ID)
+			require.NoError(t, err)
+			assert.Zero(t, volumeAttachment)
+
+			require.NoError(t, m.DeleteVolume(ctx, v))
+			_, ok = c.volumes[mockLibvirtVolumeKey(uri, pool, v.ID)]
+			assert.False(t, ok)
+			dbVolume, err = host.FindVolumeByID(ctx, v.ID)
+			require.NoError(t, err)
+			assert.Zero(t, dbVolume)
+		},
+		"AttachVolumeFailsForHostOnOtherHypervisor": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			h := makeHost()
+			spawn(t, m, h)
+			v, err := m.CreateVolume(ctx, &host.Volume{Size: 50})
+			require.NoError(t, err)
+
+			m.volumeConf.URI = "qemu+ssh://other/system"
+			assert.Error(t, m.AttachVolume(ctx, h, &host.VolumeAttachment{VolumeID: v.ID}))
+		},
+		"CreateVolumeFailsWithoutVolumeHypervisor": func(t *testing.T, m *libvirtManager, c *libvirtClientMock) {
+			m.volumeConf = evergreen.LibvirtConfig{}
+			_, err := m.CreateVolume(ctx, &host.Volume{Size: 50})
+			assert.Error(t, err)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(host.Collection, host.VolumesCollection))
+			defer func() {
+				assert.NoError(t, db.ClearCollections(host.Collection, host.VolumesCollection))
+			}()
+
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(ctx))
+			env.EvergreenSettings.Providers.Libvirt = evergreen.LibvirtConfig{
+				URI:         uri,
+				StoragePool: pool,
+			}
+
+			c := newLibvirtClientMock()
+			require.NoError(t, c.CreateVolume(ctx, uri, LibvirtVolume{Pool: pool, Name: "base.qcow2", SizeGB: 20}))
+
+			m := &libvirtManager{client: c, env: env}
+			require.NoError(t, m.Configure(ctx, env.Settings()))
+
+			tCase(t, m, c)
+		})
+	}
+}
+
+func TestNextLibvirtDeviceName(t *testing.T) {
+	name, err := nextLibvirtDeviceName(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "vdb", name)
+
+	name, err = nextLibvirtDeviceName([]string{"vdb", "vdc"})
+	require.NoError(t, err)
+	assert.Equal(t, "vdd", name)
+
+	var all []string
+	for letter := 'b'; letter <= 'z'; letter++ {
+		all = append(all, "vd"+string(letter))
+	}
+	_, err = nextLibvirtDeviceName(all)
+	assert.Error(t, err)
+}
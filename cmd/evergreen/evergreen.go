@@ -54,6 +54,7 @@ func buildApp() *cli.App {
 		operations.Fetch(),
 		operations.Evaluate(),
 		operations.Validate(),
+		operations.RunLocal(),
 		operations.List(),
 		operations.LastGreen(),
 		operations.Subscriptions(),
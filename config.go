@@ -71,6 +71,7 @@ type Settings struct {
 	Cedar               CedarConfig             `bson:"cedar" json:"cedar" yaml:"cedar" id:"cedar"`
 	ConfigDir           string                  `yaml:"configdir" bson:"configdir" json:"configdir"`
 	ContainerPools      ContainerPoolsConfig    `yaml:"container_pools" bson:"container_pools" json:"container_pools" id:"container_pools"`
+	Cost                CostConfig              `yaml:"cost" bson:"cost" json:"cost" id:"cost"`
 	Database            DBSettings              `yaml:"database" json:"database" bson:"database"`
 	DomainName          string                  `yaml:"domain_name" bson:"domain_name" json:"domain_name"`
 	Expansions          map[string]string       `yaml:"expansions" bson:"expansions" json:"expansions"`
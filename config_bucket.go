@@ -3,6 +3,7 @@ package evergreen
 import (
 	"context"
 
+	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/anser/bsonutil"
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
@@ -15,11 +16,14 @@ const (
 	BucketTypeGridFS BucketType = "gridfs"
 	BucketTypeLocal  BucketType = "local"
 	BucketTypeS3     BucketType = "s3"
+	// BucketTypeFilesystem stores logs in an indexed directory on the local
+	// filesystem rather than in a pail bucket.
+	BucketTypeFilesystem BucketType = "filesystem"
 )
 
 func (b BucketType) validate() error {
 	switch b {
-	case BucketTypeGridFS, BucketTypeLocal, BucketTypeS3:
+	case BucketTypeGridFS, BucketTypeLocal, BucketTypeS3, BucketTypeFilesystem:
 		return nil
 	default:
 		return errors.Errorf("unrecognized bucket type '%s'", b)
@@ -68,6 +72,10 @@ type BucketsConfig struct {
 	// ProjectToBucketMappings is a list of project to bucket mappings.
 	// This is used to connect projects to buckets Evergreen has access to.
 	ProjectToBucketMappings []ProjectToBucketMapping `yaml:"project_to_bucket_mappings" bson:"project_to_bucket_mappings" json:"project_to_bucket_mappings"`
+
+	// BuildCache is where the cache.save and cache.restore commands store
+	// cache archives.
+	BuildCache BuildCacheConfig `yaml:"build_cache" bson:"build_cache" json:"build_cache"`
 }
 
 var (
@@ -77,8 +85,48 @@ var (
 	bucketsConfigInternalBucketsKey = bsonutil.MustHaveTag(BucketsConfig{}, "InternalBuckets")
 	projectToPrefixMappingsKey      = bsonutil.MustHaveTag(BucketsConfig{}, "ProjectToPrefixMappings")
 	projectToBucketMappingsKey      = bsonutil.MustHaveTag(BucketsConfig{}, "ProjectToBucketMappings")
+	bucketsConfigBuildCacheKey      = bsonutil.MustHaveTag(BucketsConfig{}, "BuildCache")
 )
 
+// defaultBuildCacheProjectSizeLimitMB is the default maximum total size of a
+// project's build cache entries.
+const defaultBuildCacheProjectSizeLimitMB = 10 * 1024
+
+// BuildCacheConfig represents the admin config for the build cache, which
+// stores the archives saved by tasks so that later tasks in the same project
+// can restore them.
+type BuildCacheConfig struct {
+	// Bucket is where cache archives are stored. An S3 bucket must be one of
+	// the internal buckets so that tasks can get credentials for it. A local
+	// bucket is a directory, which is only useful when the app server and
+	// the hosts share a filesystem (e.g. in local development).
+	Bucket BucketConfig `yaml:"bucket" bson:"bucket" json:"bucket"`
+	// ProjectSizeLimitMB is the maximum total size of a project's cache
+	// entries. Once a project exceeds it, its least recently used entries are
+	// removed.
+	ProjectSizeLimitMB int `yaml:"project_size_limit_mb" bson:"project_size_limit_mb" json:"project_size_limit_mb"`
+}
+
+// IsEnabled returns whether the build cache is configured.
+func (c *BuildCacheConfig) IsEnabled() bool {
+	return c.Bucket.Name != ""
+}
+
+func (c *BuildCacheConfig) validate(internalBuckets []string) error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	if c.ProjectSizeLimitMB <= 0 {
+		c.ProjectSizeLimitMB = defaultBuildCacheProjectSizeLimitMB
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(c.Bucket.validate())
+	catcher.ErrorfWhen(c.Bucket.Type != BucketTypeS3 && c.Bucket.Type != BucketTypeLocal, "build cache bucket must be of type '%s' or '%s'", BucketTypeS3, BucketTypeLocal)
+	catcher.ErrorfWhen(c.Bucket.Type == BucketTypeS3 && !utility.StringSliceContains(internalBuckets, c.Bucket.Name), "build cache bucket '%s' must be an internal bucket", c.Bucket.Name)
+	return catcher.Resolve()
+}
+
 // BucketConfig represents the admin config for an individual bucket.
 type BucketConfig struct {
 	Name   string     `bson:"name" json:"name" yaml:"name"`
@@ -94,6 +142,7 @@ func (c *BucketConfig) validate() error {
 	catcher := grip.NewBasicCatcher()
 	catcher.Add(c.Type.validate())
 	catcher.NewWhen(c.Type == BucketTypeGridFS && c.DBName == "", "must specify DB name for GridFS bucket")
+	catcher.NewWhen(c.Type == BucketTypeFilesystem && c.Name == "", "must specify directory name for filesystem bucket")
 
 	return catcher.Resolve()
 }
@@ -113,10 +162,14 @@ func (c *BucketsConfig) Set(ctx context.Context) error {
 			bucketsConfigInternalBucketsKey: c.InternalBuckets,
 			projectToPrefixMappingsKey:      c.ProjectToPrefixMappings,
 			projectToBucketMappingsKey:      c.ProjectToBucketMappings,
+			bucketsConfigBuildCacheKey:      c.BuildCache,
 		}}), "updating config section '%s'", c.SectionId(),
 	)
 }
 
 func (c *BucketsConfig) ValidateAndDefault() error {
-	return c.LogBucket.validate()
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(c.LogBucket.validate())
+	catcher.Wrap(c.BuildCache.validate(c.InternalBuckets), "invalid build cache")
+	return catcher.Resolve()
 }
@@ -10,14 +10,16 @@ import (
 )
 
 var (
-	cloudProvidersAWSKey    = bsonutil.MustHaveTag(CloudProviders{}, "AWS")
-	cloudProvidersDockerKey = bsonutil.MustHaveTag(CloudProviders{}, "Docker")
+	cloudProvidersAWSKey     = bsonutil.MustHaveTag(CloudProviders{}, "AWS")
+	cloudProvidersDockerKey  = bsonutil.MustHaveTag(CloudProviders{}, "Docker")
+	cloudProvidersLibvirtKey = bsonutil.MustHaveTag(CloudProviders{}, "Libvirt")
 )
 
 // CloudProviders stores configuration settings for the supported cloud host providers.
 type CloudProviders struct {
-	AWS    AWSConfig    `bson:"aws" json:"aws" yaml:"aws"`
-	Docker DockerConfig `bson:"docker" json:"docker" yaml:"docker"`
+	AWS     AWSConfig     `bson:"aws" json:"aws" yaml:"aws"`
+	Docker  DockerConfig  `bson:"docker" json:"docker" yaml:"docker"`
+	Libvirt LibvirtConfig `bson:"libvirt" json:"libvirt" yaml:"libvirt"`
 }
 
 func (c *CloudProviders) SectionId() string { return "providers" }
@@ -29,8 +31,9 @@ func (c *CloudProviders) Get(ctx context.Context) error {
 func (c *CloudProviders) Set(ctx context.Context) error {
 	return errors.Wrapf(setConfigSection(ctx, c.SectionId(), bson.M{
 		"$set": bson.M{
-			cloudProvidersAWSKey:    c.AWS,
-			cloudProvidersDockerKey: c.Docker,
+			cloudProvidersAWSKey:     c.AWS,
+			cloudProvidersDockerKey:  c.Docker,
+			cloudProvidersLibvirtKey: c.Libvirt,
 		}}), "updating config section '%s'", c.SectionId(),
 	)
 }
@@ -38,6 +41,7 @@ func (c *CloudProviders) Set(ctx context.Context) error {
 func (c *CloudProviders) ValidateAndDefault() error {
 	catcher := grip.NewBasicCatcher()
 	catcher.Wrap(c.AWS.Pod.Validate(), "invalid ECS config")
+	catcher.Wrap(c.Libvirt.Validate(), "invalid libvirt config")
 	return catcher.Resolve()
 }
 
@@ -323,3 +327,23 @@ const (
 type DockerConfig struct {
 	APIVersion string `bson:"api_version" json:"api_version" yaml:"api_version"`
 }
+
+// LibvirtConfig stores settings for libvirt-managed VMs that are not specific
+// to a single distro.
+type LibvirtConfig struct {
+	// URI is the libvirt connection URI of the hypervisor where volumes are
+	// created (e.g. qemu+ssh://user@hypervisor/system).
+	URI string `bson:"uri" json:"uri" yaml:"uri"`
+	// StoragePool is the name of the storage pool on the hypervisor where
+	// volumes are created.
+	StoragePool string `bson:"storage_pool" json:"storage_pool" yaml:"storage_pool"`
+}
+
+// Validate checks that if a libvirt hypervisor is configured for volumes, it
+// also specifies where to store them.
+func (c *LibvirtConfig) Validate() error {
+	if c.URI != "" && c.StoragePool == "" {
+		return errors.New("must specify a storage pool for libvirt volumes")
+	}
+	return nil
+}
@@ -19,6 +19,61 @@ type ContainerPool struct {
 	Port uint16 `bson:"port" json:"port" yaml:"port"`
 	// # of images that can be on a single host, defaults to 3 if not set
 	MaxImages int
+	// Provider is the container orchestration service that runs the pods for
+	// container tasks that use this pool. If unset, it defaults to ECS.
+	Provider PodProvider `bson:"provider,omitempty" json:"provider,omitempty" yaml:"provider"`
+	// Kubernetes contains the settings to connect to the Kubernetes cluster if
+	// the provider is Kubernetes.
+	Kubernetes KubernetesConfig `bson:"kubernetes,omitempty" json:"kubernetes,omitempty" yaml:"kubernetes"`
+}
+
+// IsKubernetes returns whether the pool runs its pods in Kubernetes.
+func (p *ContainerPool) IsKubernetes() bool {
+	return p.Provider == PodProviderKubernetes
+}
+
+// PodProvider is a container orchestration service that can run pods.
+type PodProvider string
+
+const (
+	// PodProviderECS indicates that pods run in ECS.
+	PodProviderECS PodProvider = "ecs"
+	// PodProviderKubernetes indicates that pods run in a Kubernetes cluster.
+	PodProviderKubernetes PodProvider = "kubernetes"
+)
+
+// Validate checks that the pod provider is recognized.
+func (p PodProvider) Validate() error {
+	switch p {
+	case "", PodProviderECS, PodProviderKubernetes:
+		return nil
+	default:
+		return errors.Errorf("unrecognized pod provider '%s'", p)
+	}
+}
+
+// KubernetesConfig represents settings to connect to a Kubernetes cluster.
+type KubernetesConfig struct {
+	// APIServerURL is the URL of the cluster's API server.
+	APIServerURL string `bson:"api_server_url,omitempty" json:"api_server_url,omitempty" yaml:"api_server_url"`
+	// Namespace is the namespace in which to create pods and secrets.
+	Namespace string `bson:"namespace,omitempty" json:"namespace,omitempty" yaml:"namespace"`
+	// Token is the bearer token of the service account used to authenticate
+	// with the API server.
+	Token string `bson:"token,omitempty" json:"token,omitempty" yaml:"token"`
+	// CACert is the PEM-encoded certificate authority used to verify the API
+	// server's certificate. If unset, the system's certificate authorities are
+	// used.
+	CACert string `bson:"ca_cert,omitempty" json:"ca_cert,omitempty" yaml:"ca_cert"`
+	// ImagePullSecret is the name of an existing secret in the namespace that
+	// contains the credentials to pull private images.
+	ImagePullSecret string `bson:"image_pull_secret,omitempty" json:"image_pull_secret,omitempty" yaml:"image_pull_secret"`
+}
+
+// IsZero implements the bsoncodec.Zeroer interface for the sake of defining the
+// zero value for BSON marshalling.
+func (c KubernetesConfig) IsZero() bool {
+	return c == KubernetesConfig{}
 }
 
 type ContainerPoolsConfig struct {
@@ -50,12 +105,51 @@ func (c *ContainerPoolsConfig) GetContainerPool(id string) *ContainerPool {
 	return nil
 }
 
+// UnredactKubernetesTokens replaces the Kubernetes tokens that are redacted or
+// empty with the tokens of the original pools with the same IDs, so that
+// saving settings that were read back does not overwrite the tokens.
+func (c *ContainerPoolsConfig) UnredactKubernetesTokens(original ContainerPoolsConfig) {
+	for i, pool := range c.Pools {
+		if pool.Kubernetes.Token != "" && pool.Kubernetes.Token != RedactedValue {
+			continue
+		}
+		if originalPool := original.GetContainerPool(pool.Id); originalPool != nil {
+			c.Pools[i].Kubernetes.Token = originalPool.Kubernetes.Token
+		}
+	}
+}
+
 func (c *ContainerPoolsConfig) ValidateAndDefault() error {
-	// ensure that max_containers is positive
 	for _, pool := range c.Pools {
+		if err := pool.Provider.Validate(); err != nil {
+			return errors.Wrapf(err, "container pool '%s'", pool.Id)
+		}
+		if pool.IsKubernetes() {
+			// Kubernetes pools do not run containers on parent hosts, so
+			// they need cluster settings instead of a container limit.
+			if pool.Kubernetes.APIServerURL == "" {
+				return errors.Errorf("Kubernetes container pool '%s' must specify an API server URL", pool.Id)
+			}
+			if pool.Kubernetes.Namespace == "" {
+				return errors.Errorf("Kubernetes container pool '%s' must specify a namespace", pool.Id)
+			}
+			continue
+		}
+		// ensure that max_containers is positive
 		if pool.MaxContainers <= 0 {
 			return errors.Errorf("container pool max containers must be positive integer")
 		}
 	}
 	return nil
 }
+
+// GetKubernetesPools returns the container pools that run pods in Kubernetes.
+func (c *ContainerPoolsConfig) GetKubernetesPools() []ContainerPool {
+	var pools []ContainerPool
+	for _, pool := range c.Pools {
+		if pool.IsKubernetes() {
+			pools = append(pools, pool)
+		}
+	}
+	return pools
+}
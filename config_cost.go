@@ -0,0 +1,97 @@
+package evergreen
+
+import (
+	"context"
+
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CostConfig represents the configuration for attributing the cost of the
+// compute used by tasks and spawn hosts.
+type CostConfig struct {
+	// InstanceTypeRates are the hourly rates of EC2 instance types.
+	InstanceTypeRates []InstanceTypeRate `bson:"instance_type_rates" json:"instance_type_rates" yaml:"instance_type_rates"`
+	// DefaultHourlyRate is the hourly rate of EC2 hosts whose instance type
+	// has no configured rate.
+	DefaultHourlyRate float64 `bson:"default_hourly_rate" json:"default_hourly_rate" yaml:"default_hourly_rate"`
+	// PodVCPUHourlyRate is the hourly rate of a single vCPU allocated to a
+	// pod.
+	PodVCPUHourlyRate float64 `bson:"pod_vcpu_hourly_rate" json:"pod_vcpu_hourly_rate" yaml:"pod_vcpu_hourly_rate"`
+	// PodMemoryGBHourlyRate is the hourly rate of a single GB of memory
+	// allocated to a pod.
+	PodMemoryGBHourlyRate float64 `bson:"pod_memory_gb_hourly_rate" json:"pod_memory_gb_hourly_rate" yaml:"pod_memory_gb_hourly_rate"`
+}
+
+// InstanceTypeRate is the hourly rate of an EC2 instance type.
+type InstanceTypeRate struct {
+	InstanceType string `bson:"instance_type" json:"instance_type" yaml:"instance_type"`
+	// OnDemandHourlyRate is the hourly rate of an on-demand instance.
+	OnDemandHourlyRate float64 `bson:"on_demand_hourly_rate" json:"on_demand_hourly_rate" yaml:"on_demand_hourly_rate"`
+	// SpotHourlyRate is the hourly rate of a spot instance. If unset, spot
+	// instances are charged the on-demand rate.
+	SpotHourlyRate float64 `bson:"spot_hourly_rate" json:"spot_hourly_rate" yaml:"spot_hourly_rate"`
+}
+
+var (
+	costInstanceTypeRatesKey     = bsonutil.MustHaveTag(CostConfig{}, "InstanceTypeRates")
+	costDefaultHourlyRateKey     = bsonutil.MustHaveTag(CostConfig{}, "DefaultHourlyRate")
+	costPodVCPUHourlyRateKey     = bsonutil.MustHaveTag(CostConfig{}, "PodVCPUHourlyRate")
+	costPodMemoryGBHourlyRateKey = bsonutil.MustHaveTag(CostConfig{}, "PodMemoryGBHourlyRate")
+)
+
+func (c *CostConfig) SectionId() string { return "cost" }
+
+func (c *CostConfig) Get(ctx context.Context) error {
+	return getConfigSection(ctx, c)
+}
+
+func (c *CostConfig) Set(ctx context.Context) error {
+	return errors.Wrapf(setConfigSection(ctx, c.SectionId(), bson.M{
+		"$set": bson.M{
+			costInstanceTypeRatesKey:     c.InstanceTypeRates,
+			costDefaultHourlyRateKey:     c.DefaultHourlyRate,
+			costPodVCPUHourlyRateKey:     c.PodVCPUHourlyRate,
+			costPodMemoryGBHourlyRateKey: c.PodMemoryGBHourlyRate,
+		}}), "updating config section '%s'", c.SectionId(),
+	)
+}
+
+func (c *CostConfig) ValidateAndDefault() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(c.DefaultHourlyRate < 0, "default hourly rate cannot be negative")
+	catcher.NewWhen(c.PodVCPUHourlyRate < 0, "pod vCPU hourly rate cannot be negative")
+	catcher.NewWhen(c.PodMemoryGBHourlyRate < 0, "pod memory hourly rate cannot be negative")
+
+	instanceTypes := map[string]bool{}
+	for _, rate := range c.InstanceTypeRates {
+		if rate.InstanceType == "" {
+			catcher.New("instance type rate must specify an instance type")
+			continue
+		}
+		catcher.ErrorfWhen(instanceTypes[rate.InstanceType], "instance type '%s' has multiple rates", rate.InstanceType)
+		catcher.ErrorfWhen(rate.OnDemandHourlyRate < 0, "on-demand hourly rate for instance type '%s' cannot be negative", rate.InstanceType)
+		catcher.ErrorfWhen(rate.SpotHourlyRate < 0, "spot hourly rate for instance type '%s' cannot be negative", rate.InstanceType)
+		instanceTypes[rate.InstanceType] = true
+	}
+
+	return catcher.Resolve()
+}
+
+// HourlyRate returns the hourly rate of the given EC2 instance type. If the
+// instance type has no configured rate, the default hourly rate is returned.
+func (c *CostConfig) HourlyRate(instanceType string, spot bool) float64 {
+	for _, rate := range c.InstanceTypeRates {
+		if rate.InstanceType != instanceType {
+			continue
+		}
+		if spot && rate.SpotHourlyRate > 0 {
+			return rate.SpotHourlyRate
+		}
+		return rate.OnDemandHourlyRate
+	}
+
+	return c.DefaultHourlyRate
+}
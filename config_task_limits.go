@@ -51,6 +51,10 @@ type TaskLimitsConfig struct {
 
 	// MaxDailyAutomaticRestarts is the maximum number of times a project can automatically restart a task within a 24-hour period.
 	MaxDailyAutomaticRestarts int `bson:"max_daily_automatic_restarts" json:"max_daily_automatic_restarts" yaml:"max_daily_automatic_restarts"`
+
+	// RemoteIncludeAllowedHosts are the hosts that projects can include files
+	// from by URL. If it's empty, projects cannot include files by URL.
+	RemoteIncludeAllowedHosts []string `bson:"remote_include_allowed_hosts" json:"remote_include_allowed_hosts" yaml:"remote_include_allowed_hosts"`
 }
 
 var (
@@ -66,6 +70,7 @@ var (
 	maxDegradedModeConcurrentLargeParserProjectTasks = bsonutil.MustHaveTag(TaskLimitsConfig{}, "MaxDegradedModeConcurrentLargeParserProjectTasks")
 	maxTaskExecutionKey                              = bsonutil.MustHaveTag(TaskLimitsConfig{}, "MaxTaskExecution")
 	maxDailyAutomaticRestartsKey                     = bsonutil.MustHaveTag(TaskLimitsConfig{}, "MaxDailyAutomaticRestarts")
+	remoteIncludeAllowedHostsKey                     = bsonutil.MustHaveTag(TaskLimitsConfig{}, "RemoteIncludeAllowedHosts")
 )
 
 func (c *TaskLimitsConfig) SectionId() string { return "task_limits" }
@@ -89,6 +94,7 @@ func (c *TaskLimitsConfig) Set(ctx context.Context) error {
 			maxDegradedModeConcurrentLargeParserProjectTasks: c.MaxDegradedModeConcurrentLargeParserProjectTasks,
 			maxTaskExecutionKey:                              c.MaxTaskExecution,
 			maxDailyAutomaticRestartsKey:                     c.MaxDailyAutomaticRestarts,
+			remoteIncludeAllowedHostsKey:                     c.RemoteIncludeAllowedHosts,
 		},
 	}), "updating config section '%s'", c.SectionId())
 }
@@ -386,6 +386,10 @@ func (s *AdminSuite) TestProvidersConfig() {
 		Docker: DockerConfig{
 			APIVersion: "docker_version",
 		},
+		Libvirt: LibvirtConfig{
+			URI:         "qemu:///system",
+			StoragePool: "default",
+		},
 	}
 
 	err := config.Set(ctx)
@@ -616,6 +620,22 @@ func (s *AdminSuite) TestContainerPoolsConfig() {
 	err := invalidConfig.ValidateAndDefault()
 	s.EqualError(err, "container pool max containers must be positive integer")
 
+	invalidConfig = ContainerPoolsConfig{
+		Pools: []ContainerPool{
+			{
+				Id:       "test-pool-1",
+				Provider: PodProviderKubernetes,
+				Kubernetes: KubernetesConfig{
+					APIServerURL: "https://kubernetes.example.com",
+				},
+			},
+		},
+	}
+	s.EqualError(invalidConfig.ValidateAndDefault(), "Kubernetes container pool 'test-pool-1' must specify a namespace")
+
+	invalidConfig.Pools[0].Provider = "nomad"
+	s.Error(invalidConfig.ValidateAndDefault())
+
 	validConfig := ContainerPoolsConfig{
 		Pools: []ContainerPool{
 			{
@@ -628,8 +648,18 @@ func (s *AdminSuite) TestContainerPoolsConfig() {
 				Id:            "test-pool-2",
 				MaxContainers: 1,
 			},
+			{
+				Id:       "test-pool-k8s",
+				Provider: PodProviderKubernetes,
+				Kubernetes: KubernetesConfig{
+					APIServerURL: "https://kubernetes.example.com",
+					Namespace:    "evergreen",
+					Token:        "token",
+				},
+			},
 		},
 	}
+	s.NoError(validConfig.ValidateAndDefault())
 
 	err = validConfig.Set(ctx)
 	s.NoError(err)
@@ -657,6 +687,10 @@ func (s *AdminSuite) TestContainerPoolsConfig() {
 
 	lookup = settings.ContainerPools.GetContainerPool("test-pool-3")
 	s.Nil(lookup)
+
+	k8sPools := settings.ContainerPools.GetKubernetesPools()
+	s.Require().Len(k8sPools, 1)
+	s.Equal(validConfig.Pools[2], k8sPools[0])
 }
 
 func (s *AdminSuite) TestJIRANotificationsConfig() {
@@ -763,6 +797,42 @@ func (s *AdminSuite) TestSleepScheduleConfig() {
 	s.Equal(config, settings.SleepSchedule)
 }
 
+func (s *AdminSuite) TestCostConfig() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	emptyConfig := CostConfig{}
+	s.NoError(emptyConfig.ValidateAndDefault())
+
+	config := CostConfig{
+		InstanceTypeRates: []InstanceTypeRate{
+			{InstanceType: "m5.xlarge", OnDemandHourlyRate: 0.192, SpotHourlyRate: 0.07},
+			{InstanceType: "c5.large", OnDemandHourlyRate: 0.085},
+		},
+		DefaultHourlyRate:     0.1,
+		PodVCPUHourlyRate:     0.04,
+		PodMemoryGBHourlyRate: 0.004,
+	}
+
+	s.NoError(config.ValidateAndDefault())
+	s.NoError(config.Set(ctx))
+
+	settings, err := GetConfig(ctx)
+	s.Require().NoError(err)
+	s.Equal(config, settings.Cost)
+
+	s.Equal(0.192, config.HourlyRate("m5.xlarge", false))
+	s.Equal(0.07, config.HourlyRate("m5.xlarge", true))
+	s.Equal(0.085, config.HourlyRate("c5.large", true), "spot instances without a spot rate should use the on-demand rate")
+	s.Equal(0.1, config.HourlyRate("unknown", false))
+
+	config.InstanceTypeRates = append(config.InstanceTypeRates, InstanceTypeRate{InstanceType: "m5.xlarge", OnDemandHourlyRate: 1})
+	s.Error(config.ValidateAndDefault(), "duplicate instance types should be invalid")
+
+	config = CostConfig{DefaultHourlyRate: -1}
+	s.Error(config.ValidateAndDefault(), "negative rates should be invalid")
+}
+
 func (s *AdminSuite) TestCedarConfig() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -892,6 +962,13 @@ func (s *AdminSuite) TestBucketsConfig() {
 				Prefix:    "prefix-1",
 			},
 		},
+		BuildCache: BuildCacheConfig{
+			Bucket: BucketConfig{
+				Name: "test-bucket",
+				Type: "s3",
+			},
+			ProjectSizeLimitMB: 1024,
+		},
 	}
 
 	err := config.Set(ctx)
@@ -920,3 +997,60 @@ func (s *AdminSuite) TestBucketsConfig() {
 	s.NotNil(settings)
 	s.Equal(config, settings.Buckets)
 }
+
+func TestBuildCacheConfigValidate(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		conf    BuildCacheConfig
+		isValid bool
+	}{
+		"DisabledIsValid": {
+			isValid: true,
+		},
+		"InternalS3Bucket": {
+			conf:    BuildCacheConfig{Bucket: BucketConfig{Name: "internal", Type: BucketTypeS3}},
+			isValid: true,
+		},
+		"LocalBucket": {
+			conf:    BuildCacheConfig{Bucket: BucketConfig{Name: "/tmp/cache", Type: BucketTypeLocal}},
+			isValid: true,
+		},
+		"S3BucketThatIsNotInternal": {
+			conf: BuildCacheConfig{Bucket: BucketConfig{Name: "external", Type: BucketTypeS3}},
+		},
+		"GridFSBucket": {
+			conf: BuildCacheConfig{Bucket: BucketConfig{Name: "cache", Type: BucketTypeGridFS}},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			err := tCase.conf.validate([]string{"internal"})
+			if !tCase.isValid {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tCase.conf.IsEnabled() {
+				assert.Equal(t, defaultBuildCacheProjectSizeLimitMB, tCase.conf.ProjectSizeLimitMB)
+			}
+		})
+	}
+}
+
+func TestContainerPoolsConfigUnredactKubernetesTokens(t *testing.T) {
+	original := ContainerPoolsConfig{Pools: []ContainerPool{
+		{Id: "redacted", Provider: PodProviderKubernetes, Kubernetes: KubernetesConfig{Token: "redacted_token"}},
+		{Id: "empty", Provider: PodProviderKubernetes, Kubernetes: KubernetesConfig{Token: "empty_token"}},
+		{Id: "changed", Provider: PodProviderKubernetes, Kubernetes: KubernetesConfig{Token: "old_token"}},
+	}}
+	conf := ContainerPoolsConfig{Pools: []ContainerPool{
+		{Id: "redacted", Provider: PodProviderKubernetes, Kubernetes: KubernetesConfig{Token: RedactedValue}},
+		{Id: "empty", Provider: PodProviderKubernetes},
+		{Id: "changed", Provider: PodProviderKubernetes, Kubernetes: KubernetesConfig{Token: "new_token"}},
+		{Id: "new", Provider: PodProviderKubernetes},
+	}}
+
+	conf.UnredactKubernetesTokens(original)
+	assert.Equal(t, "redacted_token", conf.Pools[0].Kubernetes.Token)
+	assert.Equal(t, "empty_token", conf.Pools[1].Kubernetes.Token)
+	assert.Equal(t, "new_token", conf.Pools[2].Kubernetes.Token)
+	assert.Empty(t, conf.Pools[3].Kubernetes.Token)
+}
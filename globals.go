@@ -17,6 +17,7 @@ const (
 	User              = "mci"
 	GithubPatchUser   = "github_pull_request"
 	GithubMergeUser   = "github_merge_queue"
+	GitLabPatchUser   = "gitlab_merge_request"
 	PeriodicBuildUser = "periodic_build_user"
 	ParentPatchUser   = "parent_patch"
 
@@ -145,6 +146,10 @@ const (
 	// TaskDescriptionAborted indicates that the reason a task failed is specifically
 	// because it was manually aborted.
 	TaskDescriptionAborted = "aborted"
+	// TaskDescriptionCached indicates that a task succeeded because it
+	// reused the results of a previous successful task with the same inputs
+	// instead of running.
+	TaskDescriptionCached = "reused results of task with same inputs"
 
 	// Task Statuses that are only used by the UI, event log  and tests
 	// (these may be used in old tasks as actual task statuses rather than just
@@ -224,6 +229,9 @@ const (
 	// StepbackTaskActivator represents the activator for tasks activated
 	// due to stepback.
 	StepbackTaskActivator = "stepback"
+	// CulpritFinderTaskActivator represents the activator for tasks activated
+	// by a search for the commit that caused a task to fail.
+	CulpritFinderTaskActivator = "culprit-finder"
 	// CheckBlockedTasksActivator represents the activator for task deactivated
 	// by the check blocked tasks job.
 	CheckBlockedTasksActivator = "check-blocked-tasks-job-activator"
@@ -242,6 +250,9 @@ const (
 	// AutoRestartActivator represents the activator for tasks that have been
 	// automatically restarted via the retry_on_failure command flag.
 	AutoRestartActivator = "automatic_restart"
+	// TaskCacheActivator represents the caller that finishes tasks that
+	// reuse the results of a previous successful task with the same inputs.
+	TaskCacheActivator = "task-cache"
 
 	// StaleContainerTaskMonitor is the special name representing the unit
 	// responsible for monitoring container tasks that have not dispatched but
@@ -577,6 +588,7 @@ const (
 	ProviderNameDockerMock  = "docker-mock"
 	ProviderNameStatic      = "static"
 	ProviderNameMock        = "mock"
+	ProviderNameLibvirt     = "libvirt"
 
 	// DefaultEC2Region is the default region where hosts should be spawned and
 	// general Evergreen operations occur in AWS if no particular region is
@@ -626,6 +638,7 @@ var (
 		ProviderNameEc2Fleet,
 		ProviderNameMock,
 		ProviderNameDocker,
+		ProviderNameLibvirt,
 	}
 
 	// ProviderUserSpawnable includes all cloud provider types where a user can
@@ -770,12 +783,14 @@ const (
 	AttachResultsCommandName      = "attach.results"
 	AttachArtifactsCommandName    = "attach.artifacts"
 	AttachXUnitResultsCommandName = "attach.xunit_results"
+	AttachTestResultsCommandName  = "attach.test_results"
 )
 
 var AttachCommands = []string{
 	AttachResultsCommandName,
 	AttachArtifactsCommandName,
 	AttachXUnitResultsCommandName,
+	AttachTestResultsCommandName,
 }
 
 type SenderKey int
@@ -791,12 +806,15 @@ const (
 	SenderJIRAComment
 	SenderEmail
 	SenderGeneric
+	// SenderGitLabStatus sends commit statuses to GitLab for merge request
+	// patches.
+	SenderGitLabStatus
 )
 
 func (k SenderKey) Validate() error {
 	switch k {
 	case SenderGithubStatus, SenderEvergreenWebhook, SenderSlack, SenderJIRAComment, SenderJIRAIssue,
-		SenderEmail, SenderGeneric:
+		SenderEmail, SenderGeneric, SenderGitLabStatus:
 		return nil
 	default:
 		return errors.New("invalid sender defined")
@@ -819,6 +837,8 @@ func (k SenderKey) String() string {
 		return "jira-issue"
 	case SenderGeneric:
 		return "generic"
+	case SenderGitLabStatus:
+		return "gitlab-status"
 	default:
 		return "<error:unknown>"
 	}
@@ -102,6 +102,8 @@ func (r *distroResolver) Provider(ctx context.Context, obj *model.APIDistro) (Pr
 		return ProviderEc2Fleet, nil
 	case evergreen.ProviderNameEc2OnDemand:
 		return ProviderEc2OnDemand, nil
+	case evergreen.ProviderNameLibvirt:
+		return ProviderLibvirt, nil
 	case evergreen.ProviderNameStatic:
 		return ProviderStatic, nil
 	default:
@@ -294,6 +296,8 @@ func (r *distroInputResolver) Provider(ctx context.Context, obj *model.APIDistro
 		obj.Provider = utility.ToStringPtr(evergreen.ProviderNameEc2Fleet)
 	case ProviderEc2OnDemand:
 		obj.Provider = utility.ToStringPtr(evergreen.ProviderNameEc2OnDemand)
+	case ProviderLibvirt:
+		obj.Provider = utility.ToStringPtr(evergreen.ProviderNameLibvirt)
 	case ProviderStatic:
 		obj.Provider = utility.ToStringPtr(evergreen.ProviderNameStatic)
 	default:
@@ -202,6 +202,18 @@ type ComplexityRoot struct {
 		AWS func(childComplexity int) int
 	}
 
+	CommandProfile struct {
+		Block          func(childComplexity int) int
+		Command        func(childComplexity int) int
+		CPUSeconds     func(childComplexity int) int
+		DiskReadBytes  func(childComplexity int) int
+		DiskWriteBytes func(childComplexity int) int
+		Duration       func(childComplexity int) int
+		EndTime        func(childComplexity int) int
+		PeakRSSBytes   func(childComplexity int) int
+		StartTime      func(childComplexity int) int
+	}
+
 	CommitQueueParams struct {
 		Enabled     func(childComplexity int) int
 		MergeMethod func(childComplexity int) int
@@ -225,6 +237,23 @@ type ComplexityRoot struct {
 		Name     func(childComplexity int) int
 	}
 
+	CostBreakdown struct {
+		Cost    func(childComplexity int) int
+		Name    func(childComplexity int) int
+		Runtime func(childComplexity int) int
+	}
+
+	CostReport struct {
+		ByBuildVariant func(childComplexity int) int
+		ByDay          func(childComplexity int) int
+		ByUser         func(childComplexity int) int
+		EndDate        func(childComplexity int) int
+		SpawnHostCost  func(childComplexity int) int
+		StartDate      func(childComplexity int) int
+		TaskCost       func(childComplexity int) int
+		TotalCost      func(childComplexity int) int
+	}
+
 	DeleteDistroPayload struct {
 		DeletedDistroID func(childComplexity int) int
 	}
@@ -336,6 +365,7 @@ type ComplexityRoot struct {
 	}
 
 	File struct {
+		Expired    func(childComplexity int) int
 		Link       func(childComplexity int) int
 		Name       func(childComplexity int) int
 		URLParsley func(childComplexity int) int
@@ -619,6 +649,28 @@ type ComplexityRoot struct {
 		Version   func(childComplexity int) int
 	}
 
+	LogSearchMatch struct {
+		Execution   func(childComplexity int) int
+		LineNumber  func(childComplexity int) int
+		LogType     func(childComplexity int) int
+		Message     func(childComplexity int) int
+		Severity    func(childComplexity int) int
+		TaskID      func(childComplexity int) int
+		TestLogPath func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+	}
+
+	LogSection struct {
+		ID       func(childComplexity int) int
+		Children func(childComplexity int) int
+		Duration func(childComplexity int) int
+		End      func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Start    func(childComplexity int) int
+		Status   func(childComplexity int) int
+		Type     func(childComplexity int) int
+	}
+
 	LogkeeperBuild struct {
 		BuildNum      func(childComplexity int) int
 		Builder       func(childComplexity int) int
@@ -1074,6 +1126,7 @@ type ComplexityRoot struct {
 		Images                   func(childComplexity int) int
 		InstanceTypes            func(childComplexity int) int
 		IsRepo                   func(childComplexity int, projectOrRepoID string) int
+		LogSearch                func(childComplexity int, opts LogSearchInput) int
 		LogkeeperBuildMetadata   func(childComplexity int, buildID string) int
 		MainlineCommits          func(childComplexity int, options MainlineCommitsOptions, buildVariantOptions *BuildVariantOptions) int
 		MyHosts                  func(childComplexity int) int
@@ -1082,6 +1135,7 @@ type ComplexityRoot struct {
 		Patch                    func(childComplexity int, patchID string) int
 		Pod                      func(childComplexity int, podID string) int
 		Project                  func(childComplexity int, projectIdentifier string) int
+		ProjectCost              func(childComplexity int, projectIdentifier string, startDate *time.Time, endDate *time.Time) int
 		ProjectEvents            func(childComplexity int, projectIdentifier string, limit *int, before *time.Time) int
 		ProjectSettings          func(childComplexity int, projectIdentifier string) int
 		Projects                 func(childComplexity int) int
@@ -1300,6 +1354,7 @@ type ComplexityRoot struct {
 		CanSchedule             func(childComplexity int) int
 		CanSetPriority          func(childComplexity int) int
 		CanUnschedule           func(childComplexity int) int
+		CommandProfiles         func(childComplexity int) int
 		ContainerAllocatedTime  func(childComplexity int) int
 		CreateTime              func(childComplexity int) int
 		DependsOn               func(childComplexity int) int
@@ -1426,6 +1481,7 @@ type ComplexityRoot struct {
 		AllLogs    func(childComplexity int) int
 		EventLogs  func(childComplexity int) int
 		Execution  func(childComplexity int) int
+		Sections   func(childComplexity int) int
 		SystemLogs func(childComplexity int) int
 		TaskID     func(childComplexity int) int
 		TaskLogs   func(childComplexity int) int
@@ -1947,6 +2003,7 @@ type QueryResolver interface {
 	Projects(ctx context.Context) ([]*GroupedProjects, error)
 	ProjectEvents(ctx context.Context, projectIdentifier string, limit *int, before *time.Time) (*ProjectEvents, error)
 	ProjectSettings(ctx context.Context, projectIdentifier string) (*model.APIProjectSettings, error)
+	ProjectCost(ctx context.Context, projectIdentifier string, startDate *time.Time, endDate *time.Time) (*model.APICostReport, error)
 	RepoEvents(ctx context.Context, repoID string, limit *int, before *time.Time) (*ProjectEvents, error)
 	RepoSettings(ctx context.Context, repoID string) (*model.APIProjectSettings, error)
 	ViewableProjectRefs(ctx context.Context) ([]*GroupedProjects, error)
@@ -1960,6 +2017,7 @@ type QueryResolver interface {
 	MyPublicKeys(ctx context.Context) ([]*model.APIPubKey, error)
 	User(ctx context.Context, userID *string) (*model.APIDBUser, error)
 	UserConfig(ctx context.Context) (*UserConfig, error)
+	LogSearch(ctx context.Context, opts LogSearchInput) ([]*model.APILogSearchMatch, error)
 	BuildVariantsForTaskName(ctx context.Context, projectIdentifier string, taskName string) ([]*task.BuildVariantTuple, error)
 	MainlineCommits(ctx context.Context, options MainlineCommitsOptions, buildVariantOptions *BuildVariantOptions) (*MainlineCommits, error)
 	TaskNamesForBuildVariant(ctx context.Context, projectIdentifier string, buildVariant string) ([]string, error)
@@ -2003,6 +2061,7 @@ type TaskResolver interface {
 	CanSchedule(ctx context.Context, obj *model.APITask) (bool, error)
 	CanSetPriority(ctx context.Context, obj *model.APITask) (bool, error)
 	CanUnschedule(ctx context.Context, obj *model.APITask) (bool, error)
+	CommandProfiles(ctx context.Context, obj *model.APITask) ([]*model.APICommandProfile, error)
 
 	DependsOn(ctx context.Context, obj *model.APITask) ([]*Dependency, error)
 
@@ -2050,6 +2109,7 @@ type TaskLogsResolver interface {
 	AllLogs(ctx context.Context, obj *TaskLogs) ([]*apimodels.LogMessage, error)
 	EventLogs(ctx context.Context, obj *TaskLogs) ([]*model.TaskAPIEventLogEntry, error)
 
+	Sections(ctx context.Context, obj *TaskLogs) ([]*model.APILogSection, error)
 	SystemLogs(ctx context.Context, obj *TaskLogs) ([]*apimodels.LogMessage, error)
 
 	TaskLogs(ctx context.Context, obj *TaskLogs) ([]*apimodels.LogMessage, error)
@@ -2564,6 +2624,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CloudProviderConfig.AWS(childComplexity), true
 
+	case "CommandProfile.block":
+		if e.complexity.CommandProfile.Block == nil {
+			break
+		}
+
+		return e.complexity.CommandProfile.Block(childComplexity), true
+
+	case "CommandProfile.command":
+		if e.complexity.CommandProfile.Command == nil {
+			break
+		}
+
+		return e.complexity.CommandProfile.Command(childComplexity), true
+
+	case "CommandProfile.cpuSeconds":
+		if e.complexity.CommandProfile.CPUSeconds == nil {
+			break
+		}
+
+		return e.complexity.CommandProfile.CPUSeconds(childComplexity), true
+
+	case "CommandProfile.diskReadBytes":
+		if e.complexity.CommandProfile.DiskReadBytes == nil {
+			break
+		}
+
+		return e.complexity.CommandProfile.DiskReadBytes(childComplexity), true
+
+	case "CommandProfile.diskWriteBytes":
+		if e.complexity.CommandProfile.DiskWriteBytes == nil {
+			break
+		}
+
+		return e.complexity.CommandProfile.DiskWriteBytes(childComplexity), true
+
+	case "CommandProfile.duration":
+		if e.complexity.CommandProfile.Duration == nil {
+			break
+		}
+
+		return e.complexity.CommandProfile.Duration(childComplexity), true
+
+	case "CommandProfile.endTime":
+		if e.complexity.CommandProfile.EndTime == nil {
+			break
+		}
+
+		return e.complexity.CommandProfile.EndTime(childComplexity), true
+
+	case "CommandProfile.peakRssBytes":
+		if e.complexity.CommandProfile.PeakRSSBytes == nil {
+			break
+		}
+
+		return e.complexity.CommandProfile.PeakRSSBytes(childComplexity), true
+
+	case "CommandProfile.startTime":
+		if e.complexity.CommandProfile.StartTime == nil {
+			break
+		}
+
+		return e.complexity.CommandProfile.StartTime(childComplexity), true
+
 	case "CommitQueueParams.enabled":
 		if e.complexity.CommitQueueParams.Enabled == nil {
 			break
@@ -2641,6 +2764,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ContainerResources.Name(childComplexity), true
 
+	case "CostBreakdown.cost":
+		if e.complexity.CostBreakdown.Cost == nil {
+			break
+		}
+
+		return e.complexity.CostBreakdown.Cost(childComplexity), true
+
+	case "CostBreakdown.name":
+		if e.complexity.CostBreakdown.Name == nil {
+			break
+		}
+
+		return e.complexity.CostBreakdown.Name(childComplexity), true
+
+	case "CostBreakdown.runtime":
+		if e.complexity.CostBreakdown.Runtime == nil {
+			break
+		}
+
+		return e.complexity.CostBreakdown.Runtime(childComplexity), true
+
+	case "CostReport.byBuildVariant":
+		if e.complexity.CostReport.ByBuildVariant == nil {
+			break
+		}
+
+		return e.complexity.CostReport.ByBuildVariant(childComplexity), true
+
+	case "CostReport.byDay":
+		if e.complexity.CostReport.ByDay == nil {
+			break
+		}
+
+		return e.complexity.CostReport.ByDay(childComplexity), true
+
+	case "CostReport.byUser":
+		if e.complexity.CostReport.ByUser == nil {
+			break
+		}
+
+		return e.complexity.CostReport.ByUser(childComplexity), true
+
+	case "CostReport.endDate":
+		if e.complexity.CostReport.EndDate == nil {
+			break
+		}
+
+		return e.complexity.CostReport.EndDate(childComplexity), true
+
+	case "CostReport.spawnHostCost":
+		if e.complexity.CostReport.SpawnHostCost == nil {
+			break
+		}
+
+		return e.complexity.CostReport.SpawnHostCost(childComplexity), true
+
+	case "CostReport.startDate":
+		if e.complexity.CostReport.StartDate == nil {
+			break
+		}
+
+		return e.complexity.CostReport.StartDate(childComplexity), true
+
+	case "CostReport.taskCost":
+		if e.complexity.CostReport.TaskCost == nil {
+			break
+		}
+
+		return e.complexity.CostReport.TaskCost(childComplexity), true
+
+	case "CostReport.totalCost":
+		if e.complexity.CostReport.TotalCost == nil {
+			break
+		}
+
+		return e.complexity.CostReport.TotalCost(childComplexity), true
+
 	case "DeleteDistroPayload.deletedDistroId":
 		if e.complexity.DeleteDistroPayload.DeletedDistroID == nil {
 			break
@@ -3117,6 +3317,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ExternalLinkForMetadata.URL(childComplexity), true
 
+	case "File.expired":
+		if e.complexity.File.Expired == nil {
+			break
+		}
+
+		return e.complexity.File.Expired(childComplexity), true
+
 	case "File.link":
 		if e.complexity.File.Link == nil {
 			break
@@ -4332,6 +4539,118 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LogMessage.Version(childComplexity), true
 
+	case "LogSearchMatch.execution":
+		if e.complexity.LogSearchMatch.Execution == nil {
+			break
+		}
+
+		return e.complexity.LogSearchMatch.Execution(childComplexity), true
+
+	case "LogSearchMatch.lineNumber":
+		if e.complexity.LogSearchMatch.LineNumber == nil {
+			break
+		}
+
+		return e.complexity.LogSearchMatch.LineNumber(childComplexity), true
+
+	case "LogSearchMatch.logType":
+		if e.complexity.LogSearchMatch.LogType == nil {
+			break
+		}
+
+		return e.complexity.LogSearchMatch.LogType(childComplexity), true
+
+	case "LogSearchMatch.message":
+		if e.complexity.LogSearchMatch.Message == nil {
+			break
+		}
+
+		return e.complexity.LogSearchMatch.Message(childComplexity), true
+
+	case "LogSearchMatch.severity":
+		if e.complexity.LogSearchMatch.Severity == nil {
+			break
+		}
+
+		return e.complexity.LogSearchMatch.Severity(childComplexity), true
+
+	case "LogSearchMatch.taskId":
+		if e.complexity.LogSearchMatch.TaskID == nil {
+			break
+		}
+
+		return e.complexity.LogSearchMatch.TaskID(childComplexity), true
+
+	case "LogSearchMatch.testLogPath":
+		if e.complexity.LogSearchMatch.TestLogPath == nil {
+			break
+		}
+
+		return e.complexity.LogSearchMatch.TestLogPath(childComplexity), true
+
+	case "LogSearchMatch.timestamp":
+		if e.complexity.LogSearchMatch.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.LogSearchMatch.Timestamp(childComplexity), true
+
+	case "LogSection.id":
+		if e.complexity.LogSection.ID == nil {
+			break
+		}
+
+		return e.complexity.LogSection.ID(childComplexity), true
+
+	case "LogSection.children":
+		if e.complexity.LogSection.Children == nil {
+			break
+		}
+
+		return e.complexity.LogSection.Children(childComplexity), true
+
+	case "LogSection.duration":
+		if e.complexity.LogSection.Duration == nil {
+			break
+		}
+
+		return e.complexity.LogSection.Duration(childComplexity), true
+
+	case "LogSection.end":
+		if e.complexity.LogSection.End == nil {
+			break
+		}
+
+		return e.complexity.LogSection.End(childComplexity), true
+
+	case "LogSection.name":
+		if e.complexity.LogSection.Name == nil {
+			break
+		}
+
+		return e.complexity.LogSection.Name(childComplexity), true
+
+	case "LogSection.start":
+		if e.complexity.LogSection.Start == nil {
+			break
+		}
+
+		return e.complexity.LogSection.Start(childComplexity), true
+
+	case "LogSection.status":
+		if e.complexity.LogSection.Status == nil {
+			break
+		}
+
+		return e.complexity.LogSection.Status(childComplexity), true
+
+	case "LogSection.type":
+		if e.complexity.LogSection.Type == nil {
+			break
+		}
+
+		return e.complexity.LogSection.Type(childComplexity), true
+
 	case "LogkeeperBuild.buildNum":
 		if e.complexity.LogkeeperBuild.BuildNum == nil {
 			break
@@ -6992,6 +7311,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.IsRepo(childComplexity, args["projectOrRepoId"].(string)), true
 
+	case "Query.logSearch":
+		if e.complexity.Query.LogSearch == nil {
+			break
+		}
+
+		args, err := ec.field_Query_logSearch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LogSearch(childComplexity, args["opts"].(LogSearchInput)), true
+
 	case "Query.logkeeperBuildMetadata":
 		if e.complexity.Query.LogkeeperBuildMetadata == nil {
 			break
@@ -7073,6 +7404,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Project(childComplexity, args["projectIdentifier"].(string)), true
 
+	case "Query.projectCost":
+		if e.complexity.Query.ProjectCost == nil {
+			break
+		}
+
+		args, err := ec.field_Query_projectCost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProjectCost(childComplexity, args["projectIdentifier"].(string), args["startDate"].(*time.Time), args["endDate"].(*time.Time)), true
+
 	case "Query.projectEvents":
 		if e.complexity.Query.ProjectEvents == nil {
 			break
@@ -8192,6 +8535,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Task.CanUnschedule(childComplexity), true
 
+	case "Task.commandProfiles":
+		if e.complexity.Task.CommandProfiles == nil {
+			break
+		}
+
+		return e.complexity.Task.CommandProfiles(childComplexity), true
+
 	case "Task.containerAllocatedTime":
 		if e.complexity.Task.ContainerAllocatedTime == nil {
 			break
@@ -8890,6 +9240,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TaskLogs.Execution(childComplexity), true
 
+	case "TaskLogs.sections":
+		if e.complexity.TaskLogs.Sections == nil {
+			break
+		}
+
+		return e.complexity.TaskLogs.Sections(childComplexity), true
+
 	case "TaskLogs.systemLogs":
 		if e.complexity.TaskLogs.SystemLogs == nil {
 			break
@@ -10407,6 +10764,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputInstanceTagInput,
 		ec.unmarshalInputIssueLinkInput,
 		ec.unmarshalInputJiraIssueSubscriberInput,
+		ec.unmarshalInputLogSearchInput,
 		ec.unmarshalInputMainlineCommitsOptions,
 		ec.unmarshalInputMetadataLinkInput,
 		ec.unmarshalInputMoveProjectInput,
@@ -15014,6 +15372,34 @@ func (ec *executionContext) field_Query_isRepo_argsProjectOrRepoID(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_logSearch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_logSearch_argsOpts(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["opts"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_logSearch_argsOpts(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (LogSearchInput, error) {
+	if _, ok := rawArgs["opts"]; !ok {
+		var zeroVal LogSearchInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("opts"))
+	if tmp, ok := rawArgs["opts"]; ok {
+		return ec.unmarshalNLogSearchInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐLogSearchInput(ctx, tmp)
+	}
+
+	var zeroVal LogSearchInput
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_logkeeperBuildMetadata_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -15181,27 +15567,27 @@ func (ec *executionContext) field_Query_pod_argsPodID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_projectEvents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_projectCost_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Query_projectEvents_argsProjectIdentifier(ctx, rawArgs)
+	arg0, err := ec.field_Query_projectCost_argsProjectIdentifier(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["projectIdentifier"] = arg0
-	arg1, err := ec.field_Query_projectEvents_argsLimit(ctx, rawArgs)
+	arg1, err := ec.field_Query_projectCost_argsStartDate(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["limit"] = arg1
-	arg2, err := ec.field_Query_projectEvents_argsBefore(ctx, rawArgs)
+	args["startDate"] = arg1
+	arg2, err := ec.field_Query_projectCost_argsEndDate(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["before"] = arg2
+	args["endDate"] = arg2
 	return args, nil
 }
-func (ec *executionContext) field_Query_projectEvents_argsProjectIdentifier(
+func (ec *executionContext) field_Query_projectCost_argsProjectIdentifier(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
@@ -15221,7 +15607,7 @@ func (ec *executionContext) field_Query_projectEvents_argsProjectIdentifier(
 	}
 
 	directive1 := func(ctx context.Context) (any, error) {
-		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "SETTINGS")
+		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
 		if err != nil {
 			var zeroVal string
 			return zeroVal, err
@@ -15251,35 +15637,35 @@ func (ec *executionContext) field_Query_projectEvents_argsProjectIdentifier(
 	}
 }
 
-func (ec *executionContext) field_Query_projectEvents_argsLimit(
+func (ec *executionContext) field_Query_projectCost_argsStartDate(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (*int, error) {
-	if _, ok := rawArgs["limit"]; !ok {
-		var zeroVal *int
+) (*time.Time, error) {
+	if _, ok := rawArgs["startDate"]; !ok {
+		var zeroVal *time.Time
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-	if tmp, ok := rawArgs["limit"]; ok {
-		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("startDate"))
+	if tmp, ok := rawArgs["startDate"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
 	}
 
-	var zeroVal *int
+	var zeroVal *time.Time
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_projectEvents_argsBefore(
+func (ec *executionContext) field_Query_projectCost_argsEndDate(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (*time.Time, error) {
-	if _, ok := rawArgs["before"]; !ok {
+	if _, ok := rawArgs["endDate"]; !ok {
 		var zeroVal *time.Time
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
-	if tmp, ok := rawArgs["before"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("endDate"))
+	if tmp, ok := rawArgs["endDate"]; ok {
 		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
 	}
 
@@ -15287,17 +15673,27 @@ func (ec *executionContext) field_Query_projectEvents_argsBefore(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_projectSettings_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_projectEvents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Query_projectSettings_argsProjectIdentifier(ctx, rawArgs)
+	arg0, err := ec.field_Query_projectEvents_argsProjectIdentifier(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["projectIdentifier"] = arg0
+	arg1, err := ec.field_Query_projectEvents_argsLimit(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	arg2, err := ec.field_Query_projectEvents_argsBefore(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["before"] = arg2
 	return args, nil
 }
-func (ec *executionContext) field_Query_projectSettings_argsProjectIdentifier(
+func (ec *executionContext) field_Query_projectEvents_argsProjectIdentifier(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
@@ -15347,17 +15743,53 @@ func (ec *executionContext) field_Query_projectSettings_argsProjectIdentifier(
 	}
 }
 
-func (ec *executionContext) field_Query_project_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_projectEvents_argsLimit(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["limit"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+	if tmp, ok := rawArgs["limit"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_projectEvents_argsBefore(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*time.Time, error) {
+	if _, ok := rawArgs["before"]; !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+	if tmp, ok := rawArgs["before"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_projectSettings_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Query_project_argsProjectIdentifier(ctx, rawArgs)
+	arg0, err := ec.field_Query_projectSettings_argsProjectIdentifier(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["projectIdentifier"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query_project_argsProjectIdentifier(
+func (ec *executionContext) field_Query_projectSettings_argsProjectIdentifier(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
@@ -15377,7 +15809,67 @@ func (ec *executionContext) field_Query_project_argsProjectIdentifier(
 	}
 
 	directive1 := func(ctx context.Context) (any, error) {
-		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
+		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "SETTINGS")
+		if err != nil {
+			var zeroVal string
+			return zeroVal, err
+		}
+		access, err := ec.unmarshalNAccessLevel2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐAccessLevel(ctx, "VIEW")
+		if err != nil {
+			var zeroVal string
+			return zeroVal, err
+		}
+		if ec.directives.RequireProjectAccess == nil {
+			var zeroVal string
+			return zeroVal, errors.New("directive requireProjectAccess is not implemented")
+		}
+		return ec.directives.RequireProjectAccess(ctx, rawArgs, directive0, permission, access)
+	}
+
+	tmp, err := directive1(ctx)
+	if err != nil {
+		var zeroVal string
+		return zeroVal, graphql.ErrorOnPath(ctx, err)
+	}
+	if data, ok := tmp.(string); ok {
+		return data, nil
+	} else {
+		var zeroVal string
+		return zeroVal, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp))
+	}
+}
+
+func (ec *executionContext) field_Query_project_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_project_argsProjectIdentifier(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["projectIdentifier"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_project_argsProjectIdentifier(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["projectIdentifier"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("projectIdentifier"))
+	directive0 := func(ctx context.Context) (any, error) {
+		tmp, ok := rawArgs["projectIdentifier"]
+		if !ok {
+			var zeroVal string
+			return zeroVal, nil
+		}
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	directive1 := func(ctx context.Context) (any, error) {
+		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
 		if err != nil {
 			var zeroVal string
 			return zeroVal, err
@@ -18861,8 +19353,8 @@ func (ec *executionContext) fieldContext_CloudProviderConfig_aws(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _CommitQueueParams_enabled(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CommitQueueParams_enabled(ctx, field)
+func (ec *executionContext) _CommandProfile_block(ctx context.Context, field graphql.CollectedField, obj *model.APICommandProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommandProfile_block(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -18875,7 +19367,7 @@ func (ec *executionContext) _CommitQueueParams_enabled(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Enabled, nil
+		return obj.Block, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -18884,26 +19376,26 @@ func (ec *executionContext) _CommitQueueParams_enabled(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CommitQueueParams_enabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommandProfile_block(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CommitQueueParams",
+		Object:     "CommandProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CommitQueueParams_mergeMethod(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CommitQueueParams_mergeMethod(ctx, field)
+func (ec *executionContext) _CommandProfile_command(ctx context.Context, field graphql.CollectedField, obj *model.APICommandProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommandProfile_command(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -18916,7 +19408,7 @@ func (ec *executionContext) _CommitQueueParams_mergeMethod(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MergeMethod, nil
+		return obj.Command, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -18933,9 +19425,9 @@ func (ec *executionContext) _CommitQueueParams_mergeMethod(ctx context.Context,
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CommitQueueParams_mergeMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommandProfile_command(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CommitQueueParams",
+		Object:     "CommandProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -18946,8 +19438,8 @@ func (ec *executionContext) fieldContext_CommitQueueParams_mergeMethod(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _CommitQueueParams_message(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CommitQueueParams_message(ctx, field)
+func (ec *executionContext) _CommandProfile_cpuSeconds(ctx context.Context, field graphql.CollectedField, obj *model.APICommandProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommandProfile_cpuSeconds(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -18960,7 +19452,7 @@ func (ec *executionContext) _CommitQueueParams_message(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.CPUSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -18972,26 +19464,26 @@ func (ec *executionContext) _CommitQueueParams_message(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CommitQueueParams_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommandProfile_cpuSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CommitQueueParams",
+		Object:     "CommandProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ContainerPool_id(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ContainerPool_id(ctx, field)
+func (ec *executionContext) _CommandProfile_diskReadBytes(ctx context.Context, field graphql.CollectedField, obj *model.APICommandProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommandProfile_diskReadBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19004,7 +19496,7 @@ func (ec *executionContext) _ContainerPool_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Id, nil
+		return obj.DiskReadBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19016,26 +19508,26 @@ func (ec *executionContext) _ContainerPool_id(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ContainerPool_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommandProfile_diskReadBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ContainerPool",
+		Object:     "CommandProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ContainerPool_distro(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ContainerPool_distro(ctx, field)
+func (ec *executionContext) _CommandProfile_diskWriteBytes(ctx context.Context, field graphql.CollectedField, obj *model.APICommandProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommandProfile_diskWriteBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19048,7 +19540,7 @@ func (ec *executionContext) _ContainerPool_distro(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Distro, nil
+		return obj.DiskWriteBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19060,26 +19552,26 @@ func (ec *executionContext) _ContainerPool_distro(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ContainerPool_distro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommandProfile_diskWriteBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ContainerPool",
+		Object:     "CommandProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ContainerPool_maxContainers(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ContainerPool_maxContainers(ctx, field)
+func (ec *executionContext) _CommandProfile_duration(ctx context.Context, field graphql.CollectedField, obj *model.APICommandProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommandProfile_duration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19092,7 +19584,7 @@ func (ec *executionContext) _ContainerPool_maxContainers(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxContainers, nil
+		return obj.Duration, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19104,26 +19596,26 @@ func (ec *executionContext) _ContainerPool_maxContainers(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.APIDuration)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ContainerPool_maxContainers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommandProfile_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ContainerPool",
+		Object:     "CommandProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Duration does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ContainerPool_port(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ContainerPool_port(ctx, field)
+func (ec *executionContext) _CommandProfile_endTime(ctx context.Context, field graphql.CollectedField, obj *model.APICommandProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommandProfile_endTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19136,7 +19628,48 @@ func (ec *executionContext) _ContainerPool_port(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ContainerPool().Port(rctx, obj)
+		return obj.EndTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CommandProfile_endTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CommandProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CommandProfile_peakRssBytes(ctx context.Context, field graphql.CollectedField, obj *model.APICommandProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommandProfile_peakRssBytes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PeakRSSBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19148,17 +19681,17 @@ func (ec *executionContext) _ContainerPool_port(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ContainerPool_port(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommandProfile_peakRssBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ContainerPool",
+		Object:     "CommandProfile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Int does not have child fields")
 		},
@@ -19166,8 +19699,8 @@ func (ec *executionContext) fieldContext_ContainerPool_port(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _ContainerPoolsConfig_pools(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPoolsConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ContainerPoolsConfig_pools(ctx, field)
+func (ec *executionContext) _CommandProfile_startTime(ctx context.Context, field graphql.CollectedField, obj *model.APICommandProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommandProfile_startTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19180,48 +19713,76 @@ func (ec *executionContext) _ContainerPoolsConfig_pools(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pools, nil
+		return obj.StartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CommandProfile_startTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CommandProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CommitQueueParams_enabled(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommitQueueParams_enabled(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	res := resTmp.([]model.APIContainerPool)
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalNContainerPool2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPoolᚄ(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ContainerPoolsConfig_pools(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommitQueueParams_enabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ContainerPoolsConfig",
+		Object:     "CommitQueueParams",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ContainerPool_id(ctx, field)
-			case "distro":
-				return ec.fieldContext_ContainerPool_distro(ctx, field)
-			case "maxContainers":
-				return ec.fieldContext_ContainerPool_maxContainers(ctx, field)
-			case "port":
-				return ec.fieldContext_ContainerPool_port(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ContainerPool", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ContainerResources_name(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerResources) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ContainerResources_name(ctx, field)
+func (ec *executionContext) _CommitQueueParams_mergeMethod(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommitQueueParams_mergeMethod(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19234,7 +19795,7 @@ func (ec *executionContext) _ContainerResources_name(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.MergeMethod, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19251,9 +19812,9 @@ func (ec *executionContext) _ContainerResources_name(ctx context.Context, field
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ContainerResources_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommitQueueParams_mergeMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ContainerResources",
+		Object:     "CommitQueueParams",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -19264,8 +19825,8 @@ func (ec *executionContext) fieldContext_ContainerResources_name(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _ContainerResources_cpu(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerResources) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ContainerResources_cpu(ctx, field)
+func (ec *executionContext) _CommitQueueParams_message(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommitQueueParams_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19278,7 +19839,7 @@ func (ec *executionContext) _ContainerResources_cpu(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CPU, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19290,26 +19851,26 @@ func (ec *executionContext) _ContainerResources_cpu(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ContainerResources_cpu(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommitQueueParams_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ContainerResources",
+		Object:     "CommitQueueParams",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ContainerResources_memoryMb(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerResources) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ContainerResources_memoryMb(ctx, field)
+func (ec *executionContext) _ContainerPool_id(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ContainerPool_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19322,7 +19883,7 @@ func (ec *executionContext) _ContainerResources_memoryMb(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MemoryMB, nil
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19334,26 +19895,26 @@ func (ec *executionContext) _ContainerResources_memoryMb(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ContainerResources_memoryMb(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ContainerPool_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ContainerResources",
+		Object:     "ContainerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeleteDistroPayload_deletedDistroId(ctx context.Context, field graphql.CollectedField, obj *DeleteDistroPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeleteDistroPayload_deletedDistroId(ctx, field)
+func (ec *executionContext) _ContainerPool_distro(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ContainerPool_distro(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19366,7 +19927,7 @@ func (ec *executionContext) _DeleteDistroPayload_deletedDistroId(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedDistroID, nil
+		return obj.Distro, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19378,14 +19939,14 @@ func (ec *executionContext) _DeleteDistroPayload_deletedDistroId(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeleteDistroPayload_deletedDistroId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ContainerPool_distro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeleteDistroPayload",
+		Object:     "ContainerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -19396,8 +19957,8 @@ func (ec *executionContext) fieldContext_DeleteDistroPayload_deletedDistroId(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _DeleteGithubAppCredentialsPayload_oldAppId(ctx context.Context, field graphql.CollectedField, obj *DeleteGithubAppCredentialsPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeleteGithubAppCredentialsPayload_oldAppId(ctx, field)
+func (ec *executionContext) _ContainerPool_maxContainers(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ContainerPool_maxContainers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19410,7 +19971,7 @@ func (ec *executionContext) _DeleteGithubAppCredentialsPayload_oldAppId(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OldAppID, nil
+		return obj.MaxContainers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19427,9 +19988,9 @@ func (ec *executionContext) _DeleteGithubAppCredentialsPayload_oldAppId(ctx cont
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeleteGithubAppCredentialsPayload_oldAppId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ContainerPool_maxContainers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeleteGithubAppCredentialsPayload",
+		Object:     "ContainerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -19440,8 +20001,8 @@ func (ec *executionContext) fieldContext_DeleteGithubAppCredentialsPayload_oldAp
 	return fc, nil
 }
 
-func (ec *executionContext) _Dependency_buildVariant(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Dependency_buildVariant(ctx, field)
+func (ec *executionContext) _ContainerPool_port(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ContainerPool_port(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19454,7 +20015,7 @@ func (ec *executionContext) _Dependency_buildVariant(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BuildVariant, nil
+		return ec.resolvers.ContainerPool().Port(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19466,26 +20027,26 @@ func (ec *executionContext) _Dependency_buildVariant(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Dependency_buildVariant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ContainerPool_port(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Dependency",
+		Object:     "ContainerPool",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Dependency_metStatus(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Dependency_metStatus(ctx, field)
+func (ec *executionContext) _ContainerPoolsConfig_pools(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPoolsConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ContainerPoolsConfig_pools(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19498,7 +20059,7 @@ func (ec *executionContext) _Dependency_metStatus(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MetStatus, nil
+		return obj.Pools, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19510,26 +20071,36 @@ func (ec *executionContext) _Dependency_metStatus(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(MetStatus)
+	res := resTmp.([]model.APIContainerPool)
 	fc.Result = res
-	return ec.marshalNMetStatus2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐMetStatus(ctx, field.Selections, res)
+	return ec.marshalNContainerPool2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPoolᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Dependency_metStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ContainerPoolsConfig_pools(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Dependency",
+		Object:     "ContainerPoolsConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MetStatus does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ContainerPool_id(ctx, field)
+			case "distro":
+				return ec.fieldContext_ContainerPool_distro(ctx, field)
+			case "maxContainers":
+				return ec.fieldContext_ContainerPool_maxContainers(ctx, field)
+			case "port":
+				return ec.fieldContext_ContainerPool_port(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ContainerPool", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Dependency_name(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Dependency_name(ctx, field)
+func (ec *executionContext) _ContainerResources_name(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerResources) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ContainerResources_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19554,14 +20125,14 @@ func (ec *executionContext) _Dependency_name(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Dependency_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ContainerResources_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Dependency",
+		Object:     "ContainerResources",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -19572,8 +20143,8 @@ func (ec *executionContext) fieldContext_Dependency_name(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Dependency_requiredStatus(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Dependency_requiredStatus(ctx, field)
+func (ec *executionContext) _ContainerResources_cpu(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerResources) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ContainerResources_cpu(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19586,7 +20157,7 @@ func (ec *executionContext) _Dependency_requiredStatus(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequiredStatus, nil
+		return obj.CPU, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19598,26 +20169,26 @@ func (ec *executionContext) _Dependency_requiredStatus(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(RequiredStatus)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNRequiredStatus2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRequiredStatus(ctx, field.Selections, res)
+	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Dependency_requiredStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ContainerResources_cpu(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Dependency",
+		Object:     "ContainerResources",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RequiredStatus does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Dependency_taskId(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Dependency_taskId(ctx, field)
+func (ec *executionContext) _ContainerResources_memoryMb(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerResources) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ContainerResources_memoryMb(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19630,7 +20201,7 @@ func (ec *executionContext) _Dependency_taskId(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskID, nil
+		return obj.MemoryMB, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19642,26 +20213,26 @@ func (ec *executionContext) _Dependency_taskId(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Dependency_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ContainerResources_memoryMb(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Dependency",
+		Object:     "ContainerResources",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DispatcherSettings_version(ctx context.Context, field graphql.CollectedField, obj *model.APIDispatcherSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DispatcherSettings_version(ctx, field)
+func (ec *executionContext) _CostBreakdown_cost(ctx context.Context, field graphql.CollectedField, obj *model.APICostBreakdown) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostBreakdown_cost(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19674,7 +20245,7 @@ func (ec *executionContext) _DispatcherSettings_version(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DispatcherSettings().Version(rctx, obj)
+		return obj.Cost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19686,26 +20257,26 @@ func (ec *executionContext) _DispatcherSettings_version(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(DispatcherVersion)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNDispatcherVersion2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐDispatcherVersion(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DispatcherSettings_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostBreakdown_cost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DispatcherSettings",
+		Object:     "CostBreakdown",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DispatcherVersion does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_adminOnly(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_adminOnly(ctx, field)
+func (ec *executionContext) _CostBreakdown_name(ctx context.Context, field graphql.CollectedField, obj *model.APICostBreakdown) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostBreakdown_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19718,7 +20289,7 @@ func (ec *executionContext) _Distro_adminOnly(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AdminOnly, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19730,26 +20301,26 @@ func (ec *executionContext) _Distro_adminOnly(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_adminOnly(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostBreakdown_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostBreakdown",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_aliases(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_aliases(ctx, field)
+func (ec *executionContext) _CostBreakdown_runtime(ctx context.Context, field graphql.CollectedField, obj *model.APICostBreakdown) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostBreakdown_runtime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19762,7 +20333,7 @@ func (ec *executionContext) _Distro_aliases(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Aliases, nil
+		return obj.Runtime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19774,26 +20345,26 @@ func (ec *executionContext) _Distro_aliases(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(model.APIDuration)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_aliases(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostBreakdown_runtime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostBreakdown",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Duration does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_arch(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_arch(ctx, field)
+func (ec *executionContext) _CostReport_byBuildVariant(ctx context.Context, field graphql.CollectedField, obj *model.APICostReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostReport_byBuildVariant(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19806,7 +20377,7 @@ func (ec *executionContext) _Distro_arch(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Distro().Arch(rctx, obj)
+		return obj.ByBuildVariant, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19818,26 +20389,34 @@ func (ec *executionContext) _Distro_arch(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(Arch)
+	res := resTmp.([]model.APICostBreakdown)
 	fc.Result = res
-	return ec.marshalNArch2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐArch(ctx, field.Selections, res)
+	return ec.marshalNCostBreakdown2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBreakdownᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_arch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostReport_byBuildVariant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostReport",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Arch does not have child fields")
+			switch field.Name {
+			case "cost":
+				return ec.fieldContext_CostBreakdown_cost(ctx, field)
+			case "name":
+				return ec.fieldContext_CostBreakdown_name(ctx, field)
+			case "runtime":
+				return ec.fieldContext_CostBreakdown_runtime(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CostBreakdown", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_authorizedKeysFile(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_authorizedKeysFile(ctx, field)
+func (ec *executionContext) _CostReport_byDay(ctx context.Context, field graphql.CollectedField, obj *model.APICostReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostReport_byDay(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19850,7 +20429,7 @@ func (ec *executionContext) _Distro_authorizedKeysFile(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AuthorizedKeysFile, nil
+		return obj.ByDay, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19862,26 +20441,34 @@ func (ec *executionContext) _Distro_authorizedKeysFile(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]model.APICostBreakdown)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNCostBreakdown2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBreakdownᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_authorizedKeysFile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostReport_byDay(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cost":
+				return ec.fieldContext_CostBreakdown_cost(ctx, field)
+			case "name":
+				return ec.fieldContext_CostBreakdown_name(ctx, field)
+			case "runtime":
+				return ec.fieldContext_CostBreakdown_runtime(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CostBreakdown", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_bootstrapSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_bootstrapSettings(ctx, field)
+func (ec *executionContext) _CostReport_byUser(ctx context.Context, field graphql.CollectedField, obj *model.APICostReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostReport_byUser(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19894,7 +20481,7 @@ func (ec *executionContext) _Distro_bootstrapSettings(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BootstrapSettings, nil
+		return obj.ByUser, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19906,50 +20493,34 @@ func (ec *executionContext) _Distro_bootstrapSettings(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIBootstrapSettings)
+	res := resTmp.([]model.APICostBreakdown)
 	fc.Result = res
-	return ec.marshalNBootstrapSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIBootstrapSettings(ctx, field.Selections, res)
+	return ec.marshalNCostBreakdown2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBreakdownᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_bootstrapSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostReport_byUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "clientDir":
-				return ec.fieldContext_BootstrapSettings_clientDir(ctx, field)
-			case "communication":
-				return ec.fieldContext_BootstrapSettings_communication(ctx, field)
-			case "env":
-				return ec.fieldContext_BootstrapSettings_env(ctx, field)
-			case "jasperBinaryDir":
-				return ec.fieldContext_BootstrapSettings_jasperBinaryDir(ctx, field)
-			case "jasperCredentialsPath":
-				return ec.fieldContext_BootstrapSettings_jasperCredentialsPath(ctx, field)
-			case "method":
-				return ec.fieldContext_BootstrapSettings_method(ctx, field)
-			case "preconditionScripts":
-				return ec.fieldContext_BootstrapSettings_preconditionScripts(ctx, field)
-			case "resourceLimits":
-				return ec.fieldContext_BootstrapSettings_resourceLimits(ctx, field)
-			case "rootDir":
-				return ec.fieldContext_BootstrapSettings_rootDir(ctx, field)
-			case "serviceUser":
-				return ec.fieldContext_BootstrapSettings_serviceUser(ctx, field)
-			case "shellPath":
-				return ec.fieldContext_BootstrapSettings_shellPath(ctx, field)
+			case "cost":
+				return ec.fieldContext_CostBreakdown_cost(ctx, field)
+			case "name":
+				return ec.fieldContext_CostBreakdown_name(ctx, field)
+			case "runtime":
+				return ec.fieldContext_CostBreakdown_runtime(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type BootstrapSettings", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CostBreakdown", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_containerPool(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_containerPool(ctx, field)
+func (ec *executionContext) _CostReport_endDate(ctx context.Context, field graphql.CollectedField, obj *model.APICostReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostReport_endDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19962,7 +20533,7 @@ func (ec *executionContext) _Distro_containerPool(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContainerPool, nil
+		return obj.EndDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19974,26 +20545,26 @@ func (ec *executionContext) _Distro_containerPool(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_containerPool(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostReport_endDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_disabled(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_disabled(ctx, field)
+func (ec *executionContext) _CostReport_spawnHostCost(ctx context.Context, field graphql.CollectedField, obj *model.APICostReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostReport_spawnHostCost(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20006,7 +20577,7 @@ func (ec *executionContext) _Distro_disabled(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Disabled, nil
+		return obj.SpawnHostCost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20018,26 +20589,26 @@ func (ec *executionContext) _Distro_disabled(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_disabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostReport_spawnHostCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_disableShallowClone(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_disableShallowClone(ctx, field)
+func (ec *executionContext) _CostReport_startDate(ctx context.Context, field graphql.CollectedField, obj *model.APICostReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostReport_startDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20050,7 +20621,7 @@ func (ec *executionContext) _Distro_disableShallowClone(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisableShallowClone, nil
+		return obj.StartDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20062,26 +20633,26 @@ func (ec *executionContext) _Distro_disableShallowClone(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_disableShallowClone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostReport_startDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_dispatcherSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_dispatcherSettings(ctx, field)
+func (ec *executionContext) _CostReport_taskCost(ctx context.Context, field graphql.CollectedField, obj *model.APICostReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostReport_taskCost(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20094,7 +20665,7 @@ func (ec *executionContext) _Distro_dispatcherSettings(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DispatcherSettings, nil
+		return obj.TaskCost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20106,30 +20677,26 @@ func (ec *executionContext) _Distro_dispatcherSettings(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIDispatcherSettings)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNDispatcherSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDispatcherSettings(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_dispatcherSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostReport_taskCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "version":
-				return ec.fieldContext_DispatcherSettings_version(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DispatcherSettings", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_execUser(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_execUser(ctx, field)
+func (ec *executionContext) _CostReport_totalCost(ctx context.Context, field graphql.CollectedField, obj *model.APICostReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostReport_totalCost(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20142,7 +20709,7 @@ func (ec *executionContext) _Distro_execUser(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExecUser, nil
+		return obj.TotalCost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20154,26 +20721,26 @@ func (ec *executionContext) _Distro_execUser(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_execUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostReport_totalCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "CostReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_expansions(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_expansions(ctx, field)
+func (ec *executionContext) _DeleteDistroPayload_deletedDistroId(ctx context.Context, field graphql.CollectedField, obj *DeleteDistroPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeleteDistroPayload_deletedDistroId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20186,7 +20753,7 @@ func (ec *executionContext) _Distro_expansions(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Expansions, nil
+		return obj.DeletedDistroID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20198,32 +20765,26 @@ func (ec *executionContext) _Distro_expansions(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.APIExpansion)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNExpansion2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIExpansionᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_expansions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeleteDistroPayload_deletedDistroId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "DeleteDistroPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "key":
-				return ec.fieldContext_Expansion_key(ctx, field)
-			case "value":
-				return ec.fieldContext_Expansion_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Expansion", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_finderSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_finderSettings(ctx, field)
+func (ec *executionContext) _DeleteGithubAppCredentialsPayload_oldAppId(ctx context.Context, field graphql.CollectedField, obj *DeleteGithubAppCredentialsPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeleteGithubAppCredentialsPayload_oldAppId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20236,7 +20797,7 @@ func (ec *executionContext) _Distro_finderSettings(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FinderSettings, nil
+		return obj.OldAppID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20248,30 +20809,26 @@ func (ec *executionContext) _Distro_finderSettings(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIFinderSettings)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNFinderSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFinderSettings(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_finderSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeleteGithubAppCredentialsPayload_oldAppId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "DeleteGithubAppCredentialsPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "version":
-				return ec.fieldContext_FinderSettings_version(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FinderSettings", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_homeVolumeSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_homeVolumeSettings(ctx, field)
+func (ec *executionContext) _Dependency_buildVariant(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Dependency_buildVariant(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20284,7 +20841,7 @@ func (ec *executionContext) _Distro_homeVolumeSettings(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HomeVolumeSettings, nil
+		return obj.BuildVariant, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20296,30 +20853,26 @@ func (ec *executionContext) _Distro_homeVolumeSettings(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIHomeVolumeSettings)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNHomeVolumeSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIHomeVolumeSettings(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_homeVolumeSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Dependency_buildVariant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "Dependency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "formatCommand":
-				return ec.fieldContext_HomeVolumeSettings_formatCommand(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type HomeVolumeSettings", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_hostAllocatorSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_hostAllocatorSettings(ctx, field)
+func (ec *executionContext) _Dependency_metStatus(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Dependency_metStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20332,7 +20885,7 @@ func (ec *executionContext) _Distro_hostAllocatorSettings(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HostAllocatorSettings, nil
+		return obj.MetStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20344,44 +20897,26 @@ func (ec *executionContext) _Distro_hostAllocatorSettings(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIHostAllocatorSettings)
+	res := resTmp.(MetStatus)
 	fc.Result = res
-	return ec.marshalNHostAllocatorSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIHostAllocatorSettings(ctx, field.Selections, res)
+	return ec.marshalNMetStatus2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐMetStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_hostAllocatorSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Dependency_metStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "Dependency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "acceptableHostIdleTime":
-				return ec.fieldContext_HostAllocatorSettings_acceptableHostIdleTime(ctx, field)
-			case "feedbackRule":
-				return ec.fieldContext_HostAllocatorSettings_feedbackRule(ctx, field)
-			case "futureHostFraction":
-				return ec.fieldContext_HostAllocatorSettings_futureHostFraction(ctx, field)
-			case "hostsOverallocatedRule":
-				return ec.fieldContext_HostAllocatorSettings_hostsOverallocatedRule(ctx, field)
-			case "maximumHosts":
-				return ec.fieldContext_HostAllocatorSettings_maximumHosts(ctx, field)
-			case "minimumHosts":
-				return ec.fieldContext_HostAllocatorSettings_minimumHosts(ctx, field)
-			case "roundingRule":
-				return ec.fieldContext_HostAllocatorSettings_roundingRule(ctx, field)
-			case "version":
-				return ec.fieldContext_HostAllocatorSettings_version(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type HostAllocatorSettings", field.Name)
+			return nil, errors.New("field of type MetStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_iceCreamSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_iceCreamSettings(ctx, field)
+func (ec *executionContext) _Dependency_name(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Dependency_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20394,7 +20929,7 @@ func (ec *executionContext) _Distro_iceCreamSettings(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IcecreamSettings, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20406,32 +20941,26 @@ func (ec *executionContext) _Distro_iceCreamSettings(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIIceCreamSettings)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNIceCreamSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIIceCreamSettings(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_iceCreamSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Dependency_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "Dependency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "configPath":
-				return ec.fieldContext_IceCreamSettings_configPath(ctx, field)
-			case "schedulerHost":
-				return ec.fieldContext_IceCreamSettings_schedulerHost(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type IceCreamSettings", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_imageId(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_imageId(ctx, field)
+func (ec *executionContext) _Dependency_requiredStatus(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Dependency_requiredStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20444,7 +20973,7 @@ func (ec *executionContext) _Distro_imageId(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ImageID, nil
+		return obj.RequiredStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20456,26 +20985,26 @@ func (ec *executionContext) _Distro_imageId(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(RequiredStatus)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNRequiredStatus2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRequiredStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_imageId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Dependency_requiredStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "Dependency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type RequiredStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_isCluster(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_isCluster(ctx, field)
+func (ec *executionContext) _Dependency_taskId(ctx context.Context, field graphql.CollectedField, obj *Dependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Dependency_taskId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20488,7 +21017,7 @@ func (ec *executionContext) _Distro_isCluster(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsCluster, nil
+		return obj.TaskID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20500,26 +21029,26 @@ func (ec *executionContext) _Distro_isCluster(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_isCluster(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Dependency_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "Dependency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_isVirtualWorkStation(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_isVirtualWorkStation(ctx, field)
+func (ec *executionContext) _DispatcherSettings_version(ctx context.Context, field graphql.CollectedField, obj *model.APIDispatcherSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DispatcherSettings_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20532,7 +21061,7 @@ func (ec *executionContext) _Distro_isVirtualWorkStation(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsVirtualWorkstation, nil
+		return ec.resolvers.DispatcherSettings().Version(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20544,26 +21073,26 @@ func (ec *executionContext) _Distro_isVirtualWorkStation(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(DispatcherVersion)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNDispatcherVersion2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐDispatcherVersion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_isVirtualWorkStation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DispatcherSettings_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Distro",
+		Object:     "DispatcherSettings",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type DispatcherVersion does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_mountpoints(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_mountpoints(ctx, field)
+func (ec *executionContext) _Distro_adminOnly(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_adminOnly(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20576,7 +21105,7 @@ func (ec *executionContext) _Distro_mountpoints(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Mountpoints, nil
+		return obj.AdminOnly, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20588,26 +21117,26 @@ func (ec *executionContext) _Distro_mountpoints(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_mountpoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_adminOnly(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_name(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_name(ctx, field)
+func (ec *executionContext) _Distro_aliases(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_aliases(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20620,7 +21149,7 @@ func (ec *executionContext) _Distro_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Aliases, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20632,12 +21161,12 @@ func (ec *executionContext) _Distro_name(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_aliases(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
@@ -20650,8 +21179,8 @@ func (ec *executionContext) fieldContext_Distro_name(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_note(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_note(ctx, field)
+func (ec *executionContext) _Distro_arch(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_arch(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20664,7 +21193,7 @@ func (ec *executionContext) _Distro_note(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Note, nil
+		return ec.resolvers.Distro().Arch(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20676,26 +21205,26 @@ func (ec *executionContext) _Distro_note(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(Arch)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNArch2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐArch(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_note(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_arch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Arch does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_plannerSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_plannerSettings(ctx, field)
+func (ec *executionContext) _Distro_authorizedKeysFile(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_authorizedKeysFile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20708,7 +21237,7 @@ func (ec *executionContext) _Distro_plannerSettings(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PlannerSettings, nil
+		return obj.AuthorizedKeysFile, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20720,48 +21249,26 @@ func (ec *executionContext) _Distro_plannerSettings(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIPlannerSettings)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNPlannerSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPlannerSettings(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_plannerSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_authorizedKeysFile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "commitQueueFactor":
-				return ec.fieldContext_PlannerSettings_commitQueueFactor(ctx, field)
-			case "expectedRuntimeFactor":
-				return ec.fieldContext_PlannerSettings_expectedRuntimeFactor(ctx, field)
-			case "generateTaskFactor":
-				return ec.fieldContext_PlannerSettings_generateTaskFactor(ctx, field)
-			case "numDependentsFactor":
-				return ec.fieldContext_PlannerSettings_numDependentsFactor(ctx, field)
-			case "groupVersions":
-				return ec.fieldContext_PlannerSettings_groupVersions(ctx, field)
-			case "mainlineTimeInQueueFactor":
-				return ec.fieldContext_PlannerSettings_mainlineTimeInQueueFactor(ctx, field)
-			case "patchFactor":
-				return ec.fieldContext_PlannerSettings_patchFactor(ctx, field)
-			case "patchTimeInQueueFactor":
-				return ec.fieldContext_PlannerSettings_patchTimeInQueueFactor(ctx, field)
-			case "targetTime":
-				return ec.fieldContext_PlannerSettings_targetTime(ctx, field)
-			case "version":
-				return ec.fieldContext_PlannerSettings_version(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PlannerSettings", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_provider(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_provider(ctx, field)
+func (ec *executionContext) _Distro_bootstrapSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_bootstrapSettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20774,7 +21281,7 @@ func (ec *executionContext) _Distro_provider(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Distro().Provider(rctx, obj)
+		return obj.BootstrapSettings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20786,26 +21293,50 @@ func (ec *executionContext) _Distro_provider(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(Provider)
+	res := resTmp.(model.APIBootstrapSettings)
 	fc.Result = res
-	return ec.marshalNProvider2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProvider(ctx, field.Selections, res)
+	return ec.marshalNBootstrapSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIBootstrapSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_provider(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_bootstrapSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Provider does not have child fields")
+			switch field.Name {
+			case "clientDir":
+				return ec.fieldContext_BootstrapSettings_clientDir(ctx, field)
+			case "communication":
+				return ec.fieldContext_BootstrapSettings_communication(ctx, field)
+			case "env":
+				return ec.fieldContext_BootstrapSettings_env(ctx, field)
+			case "jasperBinaryDir":
+				return ec.fieldContext_BootstrapSettings_jasperBinaryDir(ctx, field)
+			case "jasperCredentialsPath":
+				return ec.fieldContext_BootstrapSettings_jasperCredentialsPath(ctx, field)
+			case "method":
+				return ec.fieldContext_BootstrapSettings_method(ctx, field)
+			case "preconditionScripts":
+				return ec.fieldContext_BootstrapSettings_preconditionScripts(ctx, field)
+			case "resourceLimits":
+				return ec.fieldContext_BootstrapSettings_resourceLimits(ctx, field)
+			case "rootDir":
+				return ec.fieldContext_BootstrapSettings_rootDir(ctx, field)
+			case "serviceUser":
+				return ec.fieldContext_BootstrapSettings_serviceUser(ctx, field)
+			case "shellPath":
+				return ec.fieldContext_BootstrapSettings_shellPath(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BootstrapSettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_providerSettingsList(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_providerSettingsList(ctx, field)
+func (ec *executionContext) _Distro_containerPool(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_containerPool(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20818,7 +21349,7 @@ func (ec *executionContext) _Distro_providerSettingsList(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Distro().ProviderSettingsList(rctx, obj)
+		return obj.ContainerPool, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20830,26 +21361,26 @@ func (ec *executionContext) _Distro_providerSettingsList(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]map[string]any)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNMap2ᚕmapᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_providerSettingsList(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_containerPool(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_setup(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_setup(ctx, field)
+func (ec *executionContext) _Distro_disabled(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_disabled(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20862,7 +21393,7 @@ func (ec *executionContext) _Distro_setup(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Setup, nil
+		return obj.Disabled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20874,26 +21405,26 @@ func (ec *executionContext) _Distro_setup(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_setup(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_disabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_setupAsSudo(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_setupAsSudo(ctx, field)
+func (ec *executionContext) _Distro_disableShallowClone(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_disableShallowClone(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20906,7 +21437,7 @@ func (ec *executionContext) _Distro_setupAsSudo(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SetupAsSudo, nil
+		return obj.DisableShallowClone, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20923,7 +21454,7 @@ func (ec *executionContext) _Distro_setupAsSudo(ctx context.Context, field graph
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_setupAsSudo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_disableShallowClone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
@@ -20936,8 +21467,8 @@ func (ec *executionContext) fieldContext_Distro_setupAsSudo(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_singleTaskDistro(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_singleTaskDistro(ctx, field)
+func (ec *executionContext) _Distro_dispatcherSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_dispatcherSettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20950,7 +21481,7 @@ func (ec *executionContext) _Distro_singleTaskDistro(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SingleTaskDistro, nil
+		return obj.DispatcherSettings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20962,26 +21493,30 @@ func (ec *executionContext) _Distro_singleTaskDistro(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.APIDispatcherSettings)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNDispatcherSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDispatcherSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_singleTaskDistro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_dispatcherSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "version":
+				return ec.fieldContext_DispatcherSettings_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DispatcherSettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_sshOptions(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_sshOptions(ctx, field)
+func (ec *executionContext) _Distro_execUser(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_execUser(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20994,7 +21529,7 @@ func (ec *executionContext) _Distro_sshOptions(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SSHOptions, nil
+		return obj.ExecUser, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21006,12 +21541,12 @@ func (ec *executionContext) _Distro_sshOptions(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_sshOptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_execUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
@@ -21024,8 +21559,8 @@ func (ec *executionContext) fieldContext_Distro_sshOptions(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_user(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_user(ctx, field)
+func (ec *executionContext) _Distro_expansions(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_expansions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21038,7 +21573,7 @@ func (ec *executionContext) _Distro_user(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.Expansions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21050,26 +21585,32 @@ func (ec *executionContext) _Distro_user(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]model.APIExpansion)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNExpansion2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIExpansionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_expansions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_Expansion_key(ctx, field)
+			case "value":
+				return ec.fieldContext_Expansion_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Expansion", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_userSpawnAllowed(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_userSpawnAllowed(ctx, field)
+func (ec *executionContext) _Distro_finderSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_finderSettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21082,7 +21623,7 @@ func (ec *executionContext) _Distro_userSpawnAllowed(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserSpawnAllowed, nil
+		return obj.FinderSettings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21094,26 +21635,30 @@ func (ec *executionContext) _Distro_userSpawnAllowed(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.APIFinderSettings)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFinderSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFinderSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_userSpawnAllowed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_finderSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "version":
+				return ec.fieldContext_FinderSettings_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FinderSettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_validProjects(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_validProjects(ctx, field)
+func (ec *executionContext) _Distro_homeVolumeSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_homeVolumeSettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21126,7 +21671,7 @@ func (ec *executionContext) _Distro_validProjects(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ValidProjects, nil
+		return obj.HomeVolumeSettings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21138,26 +21683,30 @@ func (ec *executionContext) _Distro_validProjects(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*string)
+	res := resTmp.(model.APIHomeVolumeSettings)
 	fc.Result = res
-	return ec.marshalNString2ᚕᚖstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNHomeVolumeSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIHomeVolumeSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_validProjects(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_homeVolumeSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "formatCommand":
+				return ec.fieldContext_HomeVolumeSettings_formatCommand(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HomeVolumeSettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_warningNote(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_warningNote(ctx, field)
+func (ec *executionContext) _Distro_hostAllocatorSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_hostAllocatorSettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21170,7 +21719,7 @@ func (ec *executionContext) _Distro_warningNote(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.WarningNote, nil
+		return obj.HostAllocatorSettings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21182,26 +21731,44 @@ func (ec *executionContext) _Distro_warningNote(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.APIHostAllocatorSettings)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNHostAllocatorSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIHostAllocatorSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_warningNote(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_hostAllocatorSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "acceptableHostIdleTime":
+				return ec.fieldContext_HostAllocatorSettings_acceptableHostIdleTime(ctx, field)
+			case "feedbackRule":
+				return ec.fieldContext_HostAllocatorSettings_feedbackRule(ctx, field)
+			case "futureHostFraction":
+				return ec.fieldContext_HostAllocatorSettings_futureHostFraction(ctx, field)
+			case "hostsOverallocatedRule":
+				return ec.fieldContext_HostAllocatorSettings_hostsOverallocatedRule(ctx, field)
+			case "maximumHosts":
+				return ec.fieldContext_HostAllocatorSettings_maximumHosts(ctx, field)
+			case "minimumHosts":
+				return ec.fieldContext_HostAllocatorSettings_minimumHosts(ctx, field)
+			case "roundingRule":
+				return ec.fieldContext_HostAllocatorSettings_roundingRule(ctx, field)
+			case "version":
+				return ec.fieldContext_HostAllocatorSettings_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HostAllocatorSettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Distro_workDir(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Distro_workDir(ctx, field)
+func (ec *executionContext) _Distro_iceCreamSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_iceCreamSettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21214,7 +21781,7 @@ func (ec *executionContext) _Distro_workDir(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.WorkDir, nil
+		return obj.IcecreamSettings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21226,26 +21793,32 @@ func (ec *executionContext) _Distro_workDir(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.APIIceCreamSettings)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNIceCreamSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIIceCreamSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Distro_workDir(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_iceCreamSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "configPath":
+				return ec.fieldContext_IceCreamSettings_configPath(ctx, field)
+			case "schedulerHost":
+				return ec.fieldContext_IceCreamSettings_schedulerHost(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IceCreamSettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroEvent_after(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroEvent_after(ctx, field)
+func (ec *executionContext) _Distro_imageId(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_imageId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21258,35 +21831,38 @@ func (ec *executionContext) _DistroEvent_after(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.After, nil
+		return obj.ImageID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]any)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroEvent_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_imageId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroEvent",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroEvent_before(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroEvent_before(ctx, field)
+func (ec *executionContext) _Distro_isCluster(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_isCluster(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21299,35 +21875,38 @@ func (ec *executionContext) _DistroEvent_before(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Before, nil
+		return obj.IsCluster, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]any)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroEvent_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_isCluster(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroEvent",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroEvent_data(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroEvent_data(ctx, field)
+func (ec *executionContext) _Distro_isVirtualWorkStation(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_isVirtualWorkStation(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21340,35 +21919,38 @@ func (ec *executionContext) _DistroEvent_data(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return obj.IsVirtualWorkstation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]any)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroEvent_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_isVirtualWorkStation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroEvent",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroEvent_timestamp(ctx, field)
+func (ec *executionContext) _Distro_mountpoints(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_mountpoints(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21381,7 +21963,7 @@ func (ec *executionContext) _DistroEvent_timestamp(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Timestamp, nil
+		return obj.Mountpoints, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21393,26 +21975,26 @@ func (ec *executionContext) _DistroEvent_timestamp(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroEvent_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_mountpoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroEvent",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroEvent_user(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroEvent_user(ctx, field)
+func (ec *executionContext) _Distro_name(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21425,7 +22007,7 @@ func (ec *executionContext) _DistroEvent_user(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21437,14 +22019,14 @@ func (ec *executionContext) _DistroEvent_user(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroEvent_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroEvent",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -21455,8 +22037,8 @@ func (ec *executionContext) fieldContext_DistroEvent_user(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroEventsPayload_count(ctx context.Context, field graphql.CollectedField, obj *DistroEventsPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroEventsPayload_count(ctx, field)
+func (ec *executionContext) _Distro_note(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_note(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21469,7 +22051,7 @@ func (ec *executionContext) _DistroEventsPayload_count(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.Note, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21481,26 +22063,26 @@ func (ec *executionContext) _DistroEventsPayload_count(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroEventsPayload_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_note(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroEventsPayload",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroEventsPayload_eventLogEntries(ctx context.Context, field graphql.CollectedField, obj *DistroEventsPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroEventsPayload_eventLogEntries(ctx, field)
+func (ec *executionContext) _Distro_plannerSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_plannerSettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21513,7 +22095,7 @@ func (ec *executionContext) _DistroEventsPayload_eventLogEntries(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventLogEntries, nil
+		return obj.PlannerSettings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21525,38 +22107,48 @@ func (ec *executionContext) _DistroEventsPayload_eventLogEntries(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*DistroEvent)
+	res := resTmp.(model.APIPlannerSettings)
 	fc.Result = res
-	return ec.marshalNDistroEvent2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐDistroEventᚄ(ctx, field.Selections, res)
+	return ec.marshalNPlannerSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPlannerSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroEventsPayload_eventLogEntries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_plannerSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroEventsPayload",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "after":
-				return ec.fieldContext_DistroEvent_after(ctx, field)
-			case "before":
-				return ec.fieldContext_DistroEvent_before(ctx, field)
-			case "data":
-				return ec.fieldContext_DistroEvent_data(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_DistroEvent_timestamp(ctx, field)
-			case "user":
-				return ec.fieldContext_DistroEvent_user(ctx, field)
+			case "commitQueueFactor":
+				return ec.fieldContext_PlannerSettings_commitQueueFactor(ctx, field)
+			case "expectedRuntimeFactor":
+				return ec.fieldContext_PlannerSettings_expectedRuntimeFactor(ctx, field)
+			case "generateTaskFactor":
+				return ec.fieldContext_PlannerSettings_generateTaskFactor(ctx, field)
+			case "numDependentsFactor":
+				return ec.fieldContext_PlannerSettings_numDependentsFactor(ctx, field)
+			case "groupVersions":
+				return ec.fieldContext_PlannerSettings_groupVersions(ctx, field)
+			case "mainlineTimeInQueueFactor":
+				return ec.fieldContext_PlannerSettings_mainlineTimeInQueueFactor(ctx, field)
+			case "patchFactor":
+				return ec.fieldContext_PlannerSettings_patchFactor(ctx, field)
+			case "patchTimeInQueueFactor":
+				return ec.fieldContext_PlannerSettings_patchTimeInQueueFactor(ctx, field)
+			case "targetTime":
+				return ec.fieldContext_PlannerSettings_targetTime(ctx, field)
+			case "version":
+				return ec.fieldContext_PlannerSettings_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DistroEvent", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PlannerSettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroInfo_id(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroInfo_id(ctx, field)
+func (ec *executionContext) _Distro_provider(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_provider(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21569,35 +22161,38 @@ func (ec *executionContext) _DistroInfo_id(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Id, nil
+		return ec.resolvers.Distro().Provider(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(Provider)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNProvider2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProvider(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroInfo_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_provider(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroInfo",
+		Object:     "Distro",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Provider does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroInfo_bootstrapMethod(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroInfo_bootstrapMethod(ctx, field)
+func (ec *executionContext) _Distro_providerSettingsList(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_providerSettingsList(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21610,35 +22205,38 @@ func (ec *executionContext) _DistroInfo_bootstrapMethod(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BootstrapMethod, nil
+		return ec.resolvers.Distro().ProviderSettingsList(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]map[string]any)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNMap2ᚕmapᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroInfo_bootstrapMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_providerSettingsList(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroInfo",
+		Object:     "Distro",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroInfo_isVirtualWorkStation(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroInfo_isVirtualWorkStation(ctx, field)
+func (ec *executionContext) _Distro_setup(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_setup(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21651,35 +22249,38 @@ func (ec *executionContext) _DistroInfo_isVirtualWorkStation(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsVirtualWorkstation, nil
+		return obj.Setup, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroInfo_isVirtualWorkStation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_setup(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroInfo",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroInfo_isWindows(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroInfo_isWindows(ctx, field)
+func (ec *executionContext) _Distro_setupAsSudo(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_setupAsSudo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21692,23 +22293,26 @@ func (ec *executionContext) _DistroInfo_isWindows(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsWindows, nil
+		return obj.SetupAsSudo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroInfo_isWindows(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_setupAsSudo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroInfo",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -21719,8 +22323,8 @@ func (ec *executionContext) fieldContext_DistroInfo_isWindows(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroInfo_user(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroInfo_user(ctx, field)
+func (ec *executionContext) _Distro_singleTaskDistro(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_singleTaskDistro(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21733,35 +22337,38 @@ func (ec *executionContext) _DistroInfo_user(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.SingleTaskDistro, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroInfo_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_singleTaskDistro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroInfo",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroInfo_workDir(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroInfo_workDir(ctx, field)
+func (ec *executionContext) _Distro_sshOptions(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_sshOptions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21774,23 +22381,26 @@ func (ec *executionContext) _DistroInfo_workDir(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.WorkDir, nil
+		return obj.SSHOptions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroInfo_workDir(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_sshOptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroInfo",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -21801,8 +22411,8 @@ func (ec *executionContext) fieldContext_DistroInfo_workDir(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroPermissions_admin(ctx context.Context, field graphql.CollectedField, obj *DistroPermissions) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroPermissions_admin(ctx, field)
+func (ec *executionContext) _Distro_user(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21815,7 +22425,7 @@ func (ec *executionContext) _DistroPermissions_admin(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Admin, nil
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21827,26 +22437,26 @@ func (ec *executionContext) _DistroPermissions_admin(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroPermissions_admin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroPermissions",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroPermissions_edit(ctx context.Context, field graphql.CollectedField, obj *DistroPermissions) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroPermissions_edit(ctx, field)
+func (ec *executionContext) _Distro_userSpawnAllowed(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_userSpawnAllowed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21859,7 +22469,7 @@ func (ec *executionContext) _DistroPermissions_edit(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edit, nil
+		return obj.UserSpawnAllowed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21876,9 +22486,9 @@ func (ec *executionContext) _DistroPermissions_edit(ctx context.Context, field g
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroPermissions_edit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_userSpawnAllowed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroPermissions",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -21889,8 +22499,8 @@ func (ec *executionContext) fieldContext_DistroPermissions_edit(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _DistroPermissions_view(ctx context.Context, field graphql.CollectedField, obj *DistroPermissions) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DistroPermissions_view(ctx, field)
+func (ec *executionContext) _Distro_validProjects(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_validProjects(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21903,7 +22513,7 @@ func (ec *executionContext) _DistroPermissions_view(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.View, nil
+		return obj.ValidProjects, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21915,26 +22525,26 @@ func (ec *executionContext) _DistroPermissions_view(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕᚖstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DistroPermissions_view(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_validProjects(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DistroPermissions",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ECSConfig_maxCPU(ctx context.Context, field graphql.CollectedField, obj *model.APIECSConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ECSConfig_maxCPU(ctx, field)
+func (ec *executionContext) _Distro_warningNote(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_warningNote(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21947,7 +22557,7 @@ func (ec *executionContext) _ECSConfig_maxCPU(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxCPU, nil
+		return obj.WarningNote, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -21959,26 +22569,26 @@ func (ec *executionContext) _ECSConfig_maxCPU(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ECSConfig_maxCPU(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_warningNote(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ECSConfig",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ECSConfig_maxMemoryMb(ctx context.Context, field graphql.CollectedField, obj *model.APIECSConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ECSConfig_maxMemoryMb(ctx, field)
+func (ec *executionContext) _Distro_workDir(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Distro_workDir(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21991,7 +22601,7 @@ func (ec *executionContext) _ECSConfig_maxMemoryMb(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxMemoryMB, nil
+		return obj.WorkDir, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22003,26 +22613,26 @@ func (ec *executionContext) _ECSConfig_maxMemoryMb(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ECSConfig_maxMemoryMb(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Distro_workDir(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ECSConfig",
+		Object:     "Distro",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EnvVar_key(ctx context.Context, field graphql.CollectedField, obj *model.APIEnvVar) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_EnvVar_key(ctx, field)
+func (ec *executionContext) _DistroEvent_after(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroEvent_after(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22035,38 +22645,35 @@ func (ec *executionContext) _EnvVar_key(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.After, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(map[string]any)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EnvVar_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroEvent_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EnvVar",
+		Object:     "DistroEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EnvVar_value(ctx context.Context, field graphql.CollectedField, obj *model.APIEnvVar) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_EnvVar_value(ctx, field)
+func (ec *executionContext) _DistroEvent_before(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroEvent_before(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22079,38 +22686,35 @@ func (ec *executionContext) _EnvVar_value(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Before, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(map[string]any)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EnvVar_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroEvent_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EnvVar",
+		Object:     "DistroEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Expansion_key(ctx context.Context, field graphql.CollectedField, obj *model.APIExpansion) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Expansion_key(ctx, field)
+func (ec *executionContext) _DistroEvent_data(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroEvent_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22123,38 +22727,35 @@ func (ec *executionContext) _Expansion_key(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(map[string]any)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Expansion_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroEvent_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Expansion",
+		Object:     "DistroEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Expansion_value(ctx context.Context, field graphql.CollectedField, obj *model.APIExpansion) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Expansion_value(ctx, field)
+func (ec *executionContext) _DistroEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroEvent_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22167,7 +22768,7 @@ func (ec *executionContext) _Expansion_value(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22179,26 +22780,26 @@ func (ec *executionContext) _Expansion_value(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Expansion_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroEvent_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Expansion",
+		Object:     "DistroEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExternalLink_displayName(ctx context.Context, field graphql.CollectedField, obj *model.APIExternalLink) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExternalLink_displayName(ctx, field)
+func (ec *executionContext) _DistroEvent_user(ctx context.Context, field graphql.CollectedField, obj *DistroEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroEvent_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22211,7 +22812,7 @@ func (ec *executionContext) _ExternalLink_displayName(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayName, nil
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22223,14 +22824,14 @@ func (ec *executionContext) _ExternalLink_displayName(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExternalLink_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroEvent_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExternalLink",
+		Object:     "DistroEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -22241,8 +22842,8 @@ func (ec *executionContext) fieldContext_ExternalLink_displayName(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _ExternalLink_requesters(ctx context.Context, field graphql.CollectedField, obj *model.APIExternalLink) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExternalLink_requesters(ctx, field)
+func (ec *executionContext) _DistroEventsPayload_count(ctx context.Context, field graphql.CollectedField, obj *DistroEventsPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroEventsPayload_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22255,7 +22856,7 @@ func (ec *executionContext) _ExternalLink_requesters(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Requesters, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22267,26 +22868,26 @@ func (ec *executionContext) _ExternalLink_requesters(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2ᚕᚖstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExternalLink_requesters(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroEventsPayload_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExternalLink",
+		Object:     "DistroEventsPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExternalLink_urlTemplate(ctx context.Context, field graphql.CollectedField, obj *model.APIExternalLink) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExternalLink_urlTemplate(ctx, field)
+func (ec *executionContext) _DistroEventsPayload_eventLogEntries(ctx context.Context, field graphql.CollectedField, obj *DistroEventsPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroEventsPayload_eventLogEntries(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22299,7 +22900,7 @@ func (ec *executionContext) _ExternalLink_urlTemplate(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URLTemplate, nil
+		return obj.EventLogEntries, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22311,26 +22912,38 @@ func (ec *executionContext) _ExternalLink_urlTemplate(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*DistroEvent)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNDistroEvent2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐDistroEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExternalLink_urlTemplate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroEventsPayload_eventLogEntries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExternalLink",
+		Object:     "DistroEventsPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "after":
+				return ec.fieldContext_DistroEvent_after(ctx, field)
+			case "before":
+				return ec.fieldContext_DistroEvent_before(ctx, field)
+			case "data":
+				return ec.fieldContext_DistroEvent_data(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_DistroEvent_timestamp(ctx, field)
+			case "user":
+				return ec.fieldContext_DistroEvent_user(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DistroEvent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExternalLinkForMetadata_url(ctx context.Context, field graphql.CollectedField, obj *ExternalLinkForMetadata) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExternalLinkForMetadata_url(ctx, field)
+func (ec *executionContext) _DistroInfo_id(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroInfo_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22343,26 +22956,23 @@ func (ec *executionContext) _ExternalLinkForMetadata_url(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExternalLinkForMetadata_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroInfo_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExternalLinkForMetadata",
+		Object:     "DistroInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -22373,8 +22983,8 @@ func (ec *executionContext) fieldContext_ExternalLinkForMetadata_url(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _ExternalLinkForMetadata_displayName(ctx context.Context, field graphql.CollectedField, obj *ExternalLinkForMetadata) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExternalLinkForMetadata_displayName(ctx, field)
+func (ec *executionContext) _DistroInfo_bootstrapMethod(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroInfo_bootstrapMethod(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22387,26 +22997,23 @@ func (ec *executionContext) _ExternalLinkForMetadata_displayName(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayName, nil
+		return obj.BootstrapMethod, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExternalLinkForMetadata_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroInfo_bootstrapMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExternalLinkForMetadata",
+		Object:     "DistroInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -22417,8 +23024,8 @@ func (ec *executionContext) fieldContext_ExternalLinkForMetadata_displayName(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _File_link(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_link(ctx, field)
+func (ec *executionContext) _DistroInfo_isVirtualWorkStation(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroInfo_isVirtualWorkStation(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22431,38 +23038,35 @@ func (ec *executionContext) _File_link(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Link, nil
+		return obj.IsVirtualWorkstation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_link(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroInfo_isVirtualWorkStation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DistroInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _File_name(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_name(ctx, field)
+func (ec *executionContext) _DistroInfo_isWindows(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroInfo_isWindows(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22475,38 +23079,35 @@ func (ec *executionContext) _File_name(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.IsWindows, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroInfo_isWindows(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DistroInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _File_urlParsley(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_urlParsley(ctx, field)
+func (ec *executionContext) _DistroInfo_user(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroInfo_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22519,7 +23120,7 @@ func (ec *executionContext) _File_urlParsley(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URLParsley, nil
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22533,9 +23134,9 @@ func (ec *executionContext) _File_urlParsley(ctx context.Context, field graphql.
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_urlParsley(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroInfo_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DistroInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -22546,8 +23147,8 @@ func (ec *executionContext) fieldContext_File_urlParsley(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _File_visibility(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_visibility(ctx, field)
+func (ec *executionContext) _DistroInfo_workDir(ctx context.Context, field graphql.CollectedField, obj *model.DistroInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroInfo_workDir(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22560,26 +23161,23 @@ func (ec *executionContext) _File_visibility(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Visibility, nil
+		return obj.WorkDir, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_visibility(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroInfo_workDir(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DistroInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -22590,8 +23188,8 @@ func (ec *executionContext) fieldContext_File_visibility(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _FileDiff_additions(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileDiff_additions(ctx, field)
+func (ec *executionContext) _DistroPermissions_admin(ctx context.Context, field graphql.CollectedField, obj *DistroPermissions) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroPermissions_admin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22604,7 +23202,7 @@ func (ec *executionContext) _FileDiff_additions(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Additions, nil
+		return obj.Admin, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22616,26 +23214,26 @@ func (ec *executionContext) _FileDiff_additions(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileDiff_additions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroPermissions_admin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileDiff",
+		Object:     "DistroPermissions",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileDiff_deletions(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileDiff_deletions(ctx, field)
+func (ec *executionContext) _DistroPermissions_edit(ctx context.Context, field graphql.CollectedField, obj *DistroPermissions) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroPermissions_edit(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22648,7 +23246,7 @@ func (ec *executionContext) _FileDiff_deletions(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Deletions, nil
+		return obj.Edit, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22660,26 +23258,26 @@ func (ec *executionContext) _FileDiff_deletions(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileDiff_deletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroPermissions_edit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileDiff",
+		Object:     "DistroPermissions",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileDiff_description(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileDiff_description(ctx, field)
+func (ec *executionContext) _DistroPermissions_view(ctx context.Context, field graphql.CollectedField, obj *DistroPermissions) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DistroPermissions_view(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22692,7 +23290,7 @@ func (ec *executionContext) _FileDiff_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.View, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22704,26 +23302,26 @@ func (ec *executionContext) _FileDiff_description(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileDiff_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DistroPermissions_view(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileDiff",
+		Object:     "DistroPermissions",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileDiff_diffLink(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileDiff_diffLink(ctx, field)
+func (ec *executionContext) _ECSConfig_maxCPU(ctx context.Context, field graphql.CollectedField, obj *model.APIECSConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ECSConfig_maxCPU(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22736,7 +23334,7 @@ func (ec *executionContext) _FileDiff_diffLink(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DiffLink, nil
+		return obj.MaxCPU, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22748,26 +23346,26 @@ func (ec *executionContext) _FileDiff_diffLink(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileDiff_diffLink(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ECSConfig_maxCPU(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileDiff",
+		Object:     "ECSConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileDiff_fileName(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileDiff_fileName(ctx, field)
+func (ec *executionContext) _ECSConfig_maxMemoryMb(ctx context.Context, field graphql.CollectedField, obj *model.APIECSConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ECSConfig_maxMemoryMb(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22780,7 +23378,7 @@ func (ec *executionContext) _FileDiff_fileName(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FileName, nil
+		return obj.MaxMemoryMB, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22792,26 +23390,26 @@ func (ec *executionContext) _FileDiff_fileName(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileDiff_fileName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ECSConfig_maxMemoryMb(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileDiff",
+		Object:     "ECSConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FinderSettings_version(ctx context.Context, field graphql.CollectedField, obj *model.APIFinderSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FinderSettings_version(ctx, field)
+func (ec *executionContext) _EnvVar_key(ctx context.Context, field graphql.CollectedField, obj *model.APIEnvVar) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EnvVar_key(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22824,7 +23422,7 @@ func (ec *executionContext) _FinderSettings_version(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.FinderSettings().Version(rctx, obj)
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22836,26 +23434,26 @@ func (ec *executionContext) _FinderSettings_version(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(FinderVersion)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNFinderVersion2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐFinderVersion(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FinderSettings_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EnvVar_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FinderSettings",
+		Object:     "EnvVar",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type FinderVersion does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneralSubscription_id(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneralSubscription_id(ctx, field)
+func (ec *executionContext) _EnvVar_value(ctx context.Context, field graphql.CollectedField, obj *model.APIEnvVar) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EnvVar_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22868,7 +23466,7 @@ func (ec *executionContext) _GeneralSubscription_id(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22885,9 +23483,9 @@ func (ec *executionContext) _GeneralSubscription_id(ctx context.Context, field g
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneralSubscription_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EnvVar_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneralSubscription",
+		Object:     "EnvVar",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -22898,8 +23496,8 @@ func (ec *executionContext) fieldContext_GeneralSubscription_id(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneralSubscription_ownerType(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneralSubscription_ownerType(ctx, field)
+func (ec *executionContext) _Expansion_key(ctx context.Context, field graphql.CollectedField, obj *model.APIExpansion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Expansion_key(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22912,7 +23510,7 @@ func (ec *executionContext) _GeneralSubscription_ownerType(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OwnerType, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22929,9 +23527,9 @@ func (ec *executionContext) _GeneralSubscription_ownerType(ctx context.Context,
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneralSubscription_ownerType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Expansion_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneralSubscription",
+		Object:     "Expansion",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -22942,8 +23540,8 @@ func (ec *executionContext) fieldContext_GeneralSubscription_ownerType(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneralSubscription_regexSelectors(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneralSubscription_regexSelectors(ctx, field)
+func (ec *executionContext) _Expansion_value(ctx context.Context, field graphql.CollectedField, obj *model.APIExpansion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Expansion_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22956,7 +23554,7 @@ func (ec *executionContext) _GeneralSubscription_regexSelectors(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RegexSelectors, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -22968,32 +23566,26 @@ func (ec *executionContext) _GeneralSubscription_regexSelectors(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.APISelector)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNSelector2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelectorᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneralSubscription_regexSelectors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Expansion_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneralSubscription",
+		Object:     "Expansion",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "data":
-				return ec.fieldContext_Selector_data(ctx, field)
-			case "type":
-				return ec.fieldContext_Selector_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Selector", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneralSubscription_resourceType(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneralSubscription_resourceType(ctx, field)
+func (ec *executionContext) _ExternalLink_displayName(ctx context.Context, field graphql.CollectedField, obj *model.APIExternalLink) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExternalLink_displayName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23006,7 +23598,7 @@ func (ec *executionContext) _GeneralSubscription_resourceType(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResourceType, nil
+		return obj.DisplayName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23023,9 +23615,9 @@ func (ec *executionContext) _GeneralSubscription_resourceType(ctx context.Contex
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneralSubscription_resourceType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExternalLink_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneralSubscription",
+		Object:     "ExternalLink",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23036,8 +23628,8 @@ func (ec *executionContext) fieldContext_GeneralSubscription_resourceType(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneralSubscription_selectors(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneralSubscription_selectors(ctx, field)
+func (ec *executionContext) _ExternalLink_requesters(ctx context.Context, field graphql.CollectedField, obj *model.APIExternalLink) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExternalLink_requesters(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23050,7 +23642,7 @@ func (ec *executionContext) _GeneralSubscription_selectors(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Selectors, nil
+		return obj.Requesters, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23062,32 +23654,26 @@ func (ec *executionContext) _GeneralSubscription_selectors(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.APISelector)
+	res := resTmp.([]*string)
 	fc.Result = res
-	return ec.marshalNSelector2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelectorᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕᚖstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneralSubscription_selectors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExternalLink_requesters(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneralSubscription",
+		Object:     "ExternalLink",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "data":
-				return ec.fieldContext_Selector_data(ctx, field)
-			case "type":
-				return ec.fieldContext_Selector_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Selector", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneralSubscription_subscriber(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneralSubscription_subscriber(ctx, field)
+func (ec *executionContext) _ExternalLink_urlTemplate(ctx context.Context, field graphql.CollectedField, obj *model.APIExternalLink) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExternalLink_urlTemplate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23100,41 +23686,38 @@ func (ec *executionContext) _GeneralSubscription_subscriber(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Subscriber, nil
+		return obj.URLTemplate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APISubscriber)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOSubscriberWrapper2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISubscriber(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneralSubscription_subscriber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExternalLink_urlTemplate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneralSubscription",
+		Object:     "ExternalLink",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "subscriber":
-				return ec.fieldContext_SubscriberWrapper_subscriber(ctx, field)
-			case "type":
-				return ec.fieldContext_SubscriberWrapper_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SubscriberWrapper", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneralSubscription_trigger(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneralSubscription_trigger(ctx, field)
+func (ec *executionContext) _ExternalLinkForMetadata_url(ctx context.Context, field graphql.CollectedField, obj *ExternalLinkForMetadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExternalLinkForMetadata_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23147,7 +23730,7 @@ func (ec *executionContext) _GeneralSubscription_trigger(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Trigger, nil
+		return obj.URL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23159,14 +23742,14 @@ func (ec *executionContext) _GeneralSubscription_trigger(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneralSubscription_trigger(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExternalLinkForMetadata_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneralSubscription",
+		Object:     "ExternalLinkForMetadata",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23177,8 +23760,8 @@ func (ec *executionContext) fieldContext_GeneralSubscription_trigger(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneralSubscription_triggerData(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneralSubscription_triggerData(ctx, field)
+func (ec *executionContext) _ExternalLinkForMetadata_displayName(ctx context.Context, field graphql.CollectedField, obj *ExternalLinkForMetadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExternalLinkForMetadata_displayName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23191,35 +23774,38 @@ func (ec *executionContext) _GeneralSubscription_triggerData(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TriggerData, nil
+		return obj.DisplayName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOStringMap2map(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneralSubscription_triggerData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExternalLinkForMetadata_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneralSubscription",
+		Object:     "ExternalLinkForMetadata",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type StringMap does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneratedTaskCountResults_buildVariantName(ctx context.Context, field graphql.CollectedField, obj *GeneratedTaskCountResults) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneratedTaskCountResults_buildVariantName(ctx, field)
+func (ec *executionContext) _File_expired(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_expired(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23232,35 +23818,38 @@ func (ec *executionContext) _GeneratedTaskCountResults_buildVariantName(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BuildVariantName, nil
+		return obj.Expired, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneratedTaskCountResults_buildVariantName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_expired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneratedTaskCountResults",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneratedTaskCountResults_taskName(ctx context.Context, field graphql.CollectedField, obj *GeneratedTaskCountResults) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneratedTaskCountResults_taskName(ctx, field)
+func (ec *executionContext) _File_link(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_link(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23273,23 +23862,26 @@ func (ec *executionContext) _GeneratedTaskCountResults_taskName(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskName, nil
+		return obj.Link, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneratedTaskCountResults_taskName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_link(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneratedTaskCountResults",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23300,8 +23892,8 @@ func (ec *executionContext) fieldContext_GeneratedTaskCountResults_taskName(_ co
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneratedTaskCountResults_taskId(ctx context.Context, field graphql.CollectedField, obj *GeneratedTaskCountResults) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneratedTaskCountResults_taskId(ctx, field)
+func (ec *executionContext) _File_name(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23314,23 +23906,26 @@ func (ec *executionContext) _GeneratedTaskCountResults_taskId(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneratedTaskCountResults_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneratedTaskCountResults",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23341,8 +23936,8 @@ func (ec *executionContext) fieldContext_GeneratedTaskCountResults_taskId(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _GeneratedTaskCountResults_estimatedTasks(ctx context.Context, field graphql.CollectedField, obj *GeneratedTaskCountResults) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeneratedTaskCountResults_estimatedTasks(ctx, field)
+func (ec *executionContext) _File_urlParsley(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_urlParsley(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23355,38 +23950,35 @@ func (ec *executionContext) _GeneratedTaskCountResults_estimatedTasks(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EstimatedTasks, nil
+		return obj.URLParsley, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeneratedTaskCountResults_estimatedTasks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_urlParsley(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeneratedTaskCountResults",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GitHubDynamicTokenPermissionGroup_name(ctx context.Context, field graphql.CollectedField, obj *model.APIGitHubDynamicTokenPermissionGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GitHubDynamicTokenPermissionGroup_name(ctx, field)
+func (ec *executionContext) _File_visibility(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_visibility(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23399,7 +23991,7 @@ func (ec *executionContext) _GitHubDynamicTokenPermissionGroup_name(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Visibility, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23416,9 +24008,9 @@ func (ec *executionContext) _GitHubDynamicTokenPermissionGroup_name(ctx context.
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GitHubDynamicTokenPermissionGroup_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_visibility(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GitHubDynamicTokenPermissionGroup",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23429,8 +24021,8 @@ func (ec *executionContext) fieldContext_GitHubDynamicTokenPermissionGroup_name(
 	return fc, nil
 }
 
-func (ec *executionContext) _GitHubDynamicTokenPermissionGroup_permissions(ctx context.Context, field graphql.CollectedField, obj *model.APIGitHubDynamicTokenPermissionGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GitHubDynamicTokenPermissionGroup_permissions(ctx, field)
+func (ec *executionContext) _FileDiff_additions(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileDiff_additions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23443,7 +24035,7 @@ func (ec *executionContext) _GitHubDynamicTokenPermissionGroup_permissions(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Permissions, nil
+		return obj.Additions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23455,26 +24047,26 @@ func (ec *executionContext) _GitHubDynamicTokenPermissionGroup_permissions(ctx c
 		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNStringMap2map(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GitHubDynamicTokenPermissionGroup_permissions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileDiff_additions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GitHubDynamicTokenPermissionGroup",
+		Object:     "FileDiff",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type StringMap does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GitTag_tag(ctx context.Context, field graphql.CollectedField, obj *model.APIGitTag) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GitTag_tag(ctx, field)
+func (ec *executionContext) _FileDiff_deletions(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileDiff_deletions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23487,7 +24079,7 @@ func (ec *executionContext) _GitTag_tag(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tag, nil
+		return obj.Deletions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23499,26 +24091,26 @@ func (ec *executionContext) _GitTag_tag(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GitTag_tag(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileDiff_deletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GitTag",
+		Object:     "FileDiff",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GitTag_pusher(ctx context.Context, field graphql.CollectedField, obj *model.APIGitTag) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GitTag_pusher(ctx, field)
+func (ec *executionContext) _FileDiff_description(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileDiff_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23531,7 +24123,7 @@ func (ec *executionContext) _GitTag_pusher(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pusher, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23543,14 +24135,14 @@ func (ec *executionContext) _GitTag_pusher(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GitTag_pusher(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileDiff_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GitTag",
+		Object:     "FileDiff",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23561,8 +24153,8 @@ func (ec *executionContext) fieldContext_GitTag_pusher(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubAppAuth_appId(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubAppAuth) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubAppAuth_appId(ctx, field)
+func (ec *executionContext) _FileDiff_diffLink(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileDiff_diffLink(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23575,35 +24167,38 @@ func (ec *executionContext) _GithubAppAuth_appId(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AppID, nil
+		return obj.DiffLink, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubAppAuth_appId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileDiff_diffLink(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubAppAuth",
+		Object:     "FileDiff",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubAppAuth_privateKey(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubAppAuth) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubAppAuth_privateKey(ctx, field)
+func (ec *executionContext) _FileDiff_fileName(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileDiff_fileName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23616,23 +24211,26 @@ func (ec *executionContext) _GithubAppAuth_privateKey(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PrivateKey, nil
+		return obj.FileName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubAppAuth_privateKey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileDiff_fileName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubAppAuth",
+		Object:     "FileDiff",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23643,8 +24241,8 @@ func (ec *executionContext) fieldContext_GithubAppAuth_privateKey(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubCheckSubscriber_owner(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubCheckSubscriber) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubCheckSubscriber_owner(ctx, field)
+func (ec *executionContext) _FinderSettings_version(ctx context.Context, field graphql.CollectedField, obj *model.APIFinderSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FinderSettings_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23657,7 +24255,51 @@ func (ec *executionContext) _GithubCheckSubscriber_owner(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Owner, nil
+		return ec.resolvers.FinderSettings().Version(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FinderVersion)
+	fc.Result = res
+	return ec.marshalNFinderVersion2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐFinderVersion(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FinderSettings_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FinderSettings",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FinderVersion does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeneralSubscription_id(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneralSubscription_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23674,9 +24316,9 @@ func (ec *executionContext) _GithubCheckSubscriber_owner(ctx context.Context, fi
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubCheckSubscriber_owner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneralSubscription_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubCheckSubscriber",
+		Object:     "GeneralSubscription",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23687,8 +24329,8 @@ func (ec *executionContext) fieldContext_GithubCheckSubscriber_owner(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubCheckSubscriber_ref(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubCheckSubscriber) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubCheckSubscriber_ref(ctx, field)
+func (ec *executionContext) _GeneralSubscription_ownerType(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneralSubscription_ownerType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23701,7 +24343,7 @@ func (ec *executionContext) _GithubCheckSubscriber_ref(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ref, nil
+		return obj.OwnerType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23718,9 +24360,9 @@ func (ec *executionContext) _GithubCheckSubscriber_ref(ctx context.Context, fiel
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubCheckSubscriber_ref(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneralSubscription_ownerType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubCheckSubscriber",
+		Object:     "GeneralSubscription",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23731,8 +24373,8 @@ func (ec *executionContext) fieldContext_GithubCheckSubscriber_ref(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubCheckSubscriber_repo(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubCheckSubscriber) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubCheckSubscriber_repo(ctx, field)
+func (ec *executionContext) _GeneralSubscription_regexSelectors(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneralSubscription_regexSelectors(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23745,7 +24387,7 @@ func (ec *executionContext) _GithubCheckSubscriber_repo(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Repo, nil
+		return obj.RegexSelectors, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23757,26 +24399,32 @@ func (ec *executionContext) _GithubCheckSubscriber_repo(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]model.APISelector)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNSelector2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelectorᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubCheckSubscriber_repo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneralSubscription_regexSelectors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubCheckSubscriber",
+		Object:     "GeneralSubscription",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "data":
+				return ec.fieldContext_Selector_data(ctx, field)
+			case "type":
+				return ec.fieldContext_Selector_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Selector", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubPRSubscriber_owner(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubPRSubscriber) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubPRSubscriber_owner(ctx, field)
+func (ec *executionContext) _GeneralSubscription_resourceType(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneralSubscription_resourceType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23789,7 +24437,7 @@ func (ec *executionContext) _GithubPRSubscriber_owner(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Owner, nil
+		return obj.ResourceType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23806,9 +24454,9 @@ func (ec *executionContext) _GithubPRSubscriber_owner(ctx context.Context, field
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubPRSubscriber_owner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneralSubscription_resourceType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubPRSubscriber",
+		Object:     "GeneralSubscription",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23819,8 +24467,8 @@ func (ec *executionContext) fieldContext_GithubPRSubscriber_owner(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubPRSubscriber_prNumber(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubPRSubscriber) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubPRSubscriber_prNumber(ctx, field)
+func (ec *executionContext) _GeneralSubscription_selectors(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneralSubscription_selectors(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23833,35 +24481,44 @@ func (ec *executionContext) _GithubPRSubscriber_prNumber(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PRNumber, nil
+		return obj.Selectors, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]model.APISelector)
 	fc.Result = res
-	return ec.marshalOInt2int(ctx, field.Selections, res)
+	return ec.marshalNSelector2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelectorᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubPRSubscriber_prNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneralSubscription_selectors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubPRSubscriber",
+		Object:     "GeneralSubscription",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "data":
+				return ec.fieldContext_Selector_data(ctx, field)
+			case "type":
+				return ec.fieldContext_Selector_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Selector", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubPRSubscriber_ref(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubPRSubscriber) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubPRSubscriber_ref(ctx, field)
+func (ec *executionContext) _GeneralSubscription_subscriber(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneralSubscription_subscriber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23874,38 +24531,41 @@ func (ec *executionContext) _GithubPRSubscriber_ref(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ref, nil
+		return obj.Subscriber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.APISubscriber)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOSubscriberWrapper2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISubscriber(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubPRSubscriber_ref(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneralSubscription_subscriber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubPRSubscriber",
+		Object:     "GeneralSubscription",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "subscriber":
+				return ec.fieldContext_SubscriberWrapper_subscriber(ctx, field)
+			case "type":
+				return ec.fieldContext_SubscriberWrapper_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SubscriberWrapper", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubPRSubscriber_repo(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubPRSubscriber) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubPRSubscriber_repo(ctx, field)
+func (ec *executionContext) _GeneralSubscription_trigger(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneralSubscription_trigger(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23918,7 +24578,7 @@ func (ec *executionContext) _GithubPRSubscriber_repo(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Repo, nil
+		return obj.Trigger, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23935,9 +24595,9 @@ func (ec *executionContext) _GithubPRSubscriber_repo(ctx context.Context, field
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubPRSubscriber_repo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneralSubscription_trigger(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubPRSubscriber",
+		Object:     "GeneralSubscription",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23948,8 +24608,8 @@ func (ec *executionContext) fieldContext_GithubPRSubscriber_repo(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubProjectConflicts_commitCheckIdentifiers(ctx context.Context, field graphql.CollectedField, obj *model1.GithubProjectConflicts) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubProjectConflicts_commitCheckIdentifiers(ctx, field)
+func (ec *executionContext) _GeneralSubscription_triggerData(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneralSubscription_triggerData(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23962,7 +24622,7 @@ func (ec *executionContext) _GithubProjectConflicts_commitCheckIdentifiers(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CommitCheckIdentifiers, nil
+		return obj.TriggerData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23971,26 +24631,26 @@ func (ec *executionContext) _GithubProjectConflicts_commitCheckIdentifiers(ctx c
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(map[string]string)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOStringMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubProjectConflicts_commitCheckIdentifiers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneralSubscription_triggerData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubProjectConflicts",
+		Object:     "GeneralSubscription",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type StringMap does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubProjectConflicts_commitQueueIdentifiers(ctx context.Context, field graphql.CollectedField, obj *model1.GithubProjectConflicts) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubProjectConflicts_commitQueueIdentifiers(ctx, field)
+func (ec *executionContext) _GeneratedTaskCountResults_buildVariantName(ctx context.Context, field graphql.CollectedField, obj *GeneratedTaskCountResults) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratedTaskCountResults_buildVariantName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24003,7 +24663,7 @@ func (ec *executionContext) _GithubProjectConflicts_commitQueueIdentifiers(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CommitQueueIdentifiers, nil
+		return obj.BuildVariantName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -24012,14 +24672,14 @@ func (ec *executionContext) _GithubProjectConflicts_commitQueueIdentifiers(ctx c
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubProjectConflicts_commitQueueIdentifiers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratedTaskCountResults_buildVariantName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubProjectConflicts",
+		Object:     "GeneratedTaskCountResults",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -24030,8 +24690,8 @@ func (ec *executionContext) fieldContext_GithubProjectConflicts_commitQueueIdent
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubProjectConflicts_prTestingIdentifiers(ctx context.Context, field graphql.CollectedField, obj *model1.GithubProjectConflicts) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubProjectConflicts_prTestingIdentifiers(ctx, field)
+func (ec *executionContext) _GeneratedTaskCountResults_taskName(ctx context.Context, field graphql.CollectedField, obj *GeneratedTaskCountResults) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratedTaskCountResults_taskName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24044,7 +24704,7 @@ func (ec *executionContext) _GithubProjectConflicts_prTestingIdentifiers(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PRTestingIdentifiers, nil
+		return obj.TaskName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -24053,14 +24713,14 @@ func (ec *executionContext) _GithubProjectConflicts_prTestingIdentifiers(ctx con
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubProjectConflicts_prTestingIdentifiers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratedTaskCountResults_taskName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubProjectConflicts",
+		Object:     "GeneratedTaskCountResults",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -24071,8 +24731,8 @@ func (ec *executionContext) fieldContext_GithubProjectConflicts_prTestingIdentif
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubUser_lastKnownAs(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubUser) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubUser_lastKnownAs(ctx, field)
+func (ec *executionContext) _GeneratedTaskCountResults_taskId(ctx context.Context, field graphql.CollectedField, obj *GeneratedTaskCountResults) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratedTaskCountResults_taskId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24085,7 +24745,7 @@ func (ec *executionContext) _GithubUser_lastKnownAs(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastKnownAs, nil
+		return obj.TaskID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -24099,9 +24759,9 @@ func (ec *executionContext) _GithubUser_lastKnownAs(ctx context.Context, field g
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubUser_lastKnownAs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratedTaskCountResults_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubUser",
+		Object:     "GeneratedTaskCountResults",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -24112,8 +24772,8 @@ func (ec *executionContext) fieldContext_GithubUser_lastKnownAs(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _GithubUser_uid(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubUser) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GithubUser_uid(ctx, field)
+func (ec *executionContext) _GeneratedTaskCountResults_estimatedTasks(ctx context.Context, field graphql.CollectedField, obj *GeneratedTaskCountResults) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeneratedTaskCountResults_estimatedTasks(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24126,23 +24786,26 @@ func (ec *executionContext) _GithubUser_uid(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UID, nil
+		return obj.EstimatedTasks, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOInt2int(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GithubUser_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GeneratedTaskCountResults_estimatedTasks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GithubUser",
+		Object:     "GeneratedTaskCountResults",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -24153,8 +24816,8 @@ func (ec *executionContext) fieldContext_GithubUser_uid(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedBuildVariant_displayName(ctx context.Context, field graphql.CollectedField, obj *GroupedBuildVariant) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedBuildVariant_displayName(ctx, field)
+func (ec *executionContext) _GitHubDynamicTokenPermissionGroup_name(ctx context.Context, field graphql.CollectedField, obj *model.APIGitHubDynamicTokenPermissionGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GitHubDynamicTokenPermissionGroup_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24167,7 +24830,7 @@ func (ec *executionContext) _GroupedBuildVariant_displayName(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayName, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -24179,14 +24842,14 @@ func (ec *executionContext) _GroupedBuildVariant_displayName(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedBuildVariant_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GitHubDynamicTokenPermissionGroup_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedBuildVariant",
+		Object:     "GitHubDynamicTokenPermissionGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -24197,8 +24860,8 @@ func (ec *executionContext) fieldContext_GroupedBuildVariant_displayName(_ conte
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedBuildVariant_tasks(ctx context.Context, field graphql.CollectedField, obj *GroupedBuildVariant) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedBuildVariant_tasks(ctx, field)
+func (ec *executionContext) _GitHubDynamicTokenPermissionGroup_permissions(ctx context.Context, field graphql.CollectedField, obj *model.APIGitHubDynamicTokenPermissionGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GitHubDynamicTokenPermissionGroup_permissions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24211,187 +24874,38 @@ func (ec *executionContext) _GroupedBuildVariant_tasks(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tasks, nil
+		return obj.Permissions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APITask)
+	res := resTmp.(map[string]string)
 	fc.Result = res
-	return ec.marshalOTask2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskᚄ(ctx, field.Selections, res)
+	return ec.marshalNStringMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedBuildVariant_tasks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GitHubDynamicTokenPermissionGroup_permissions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedBuildVariant",
+		Object:     "GitHubDynamicTokenPermissionGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Task_id(ctx, field)
-			case "aborted":
-				return ec.fieldContext_Task_aborted(ctx, field)
-			case "abortInfo":
-				return ec.fieldContext_Task_abortInfo(ctx, field)
-			case "activated":
-				return ec.fieldContext_Task_activated(ctx, field)
-			case "activatedBy":
-				return ec.fieldContext_Task_activatedBy(ctx, field)
-			case "activatedTime":
-				return ec.fieldContext_Task_activatedTime(ctx, field)
-			case "ami":
-				return ec.fieldContext_Task_ami(ctx, field)
-			case "annotation":
-				return ec.fieldContext_Task_annotation(ctx, field)
-			case "baseStatus":
-				return ec.fieldContext_Task_baseStatus(ctx, field)
-			case "baseTask":
-				return ec.fieldContext_Task_baseTask(ctx, field)
-			case "blocked":
-				return ec.fieldContext_Task_blocked(ctx, field)
-			case "buildId":
-				return ec.fieldContext_Task_buildId(ctx, field)
-			case "buildVariant":
-				return ec.fieldContext_Task_buildVariant(ctx, field)
-			case "buildVariantDisplayName":
-				return ec.fieldContext_Task_buildVariantDisplayName(ctx, field)
-			case "canAbort":
-				return ec.fieldContext_Task_canAbort(ctx, field)
-			case "canDisable":
-				return ec.fieldContext_Task_canDisable(ctx, field)
-			case "canModifyAnnotation":
-				return ec.fieldContext_Task_canModifyAnnotation(ctx, field)
-			case "canOverrideDependencies":
-				return ec.fieldContext_Task_canOverrideDependencies(ctx, field)
-			case "canRestart":
-				return ec.fieldContext_Task_canRestart(ctx, field)
-			case "canSchedule":
-				return ec.fieldContext_Task_canSchedule(ctx, field)
-			case "canSetPriority":
-				return ec.fieldContext_Task_canSetPriority(ctx, field)
-			case "canUnschedule":
-				return ec.fieldContext_Task_canUnschedule(ctx, field)
-			case "containerAllocatedTime":
-				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
-			case "createTime":
-				return ec.fieldContext_Task_createTime(ctx, field)
-			case "dependsOn":
-				return ec.fieldContext_Task_dependsOn(ctx, field)
-			case "details":
-				return ec.fieldContext_Task_details(ctx, field)
-			case "dispatchTime":
-				return ec.fieldContext_Task_dispatchTime(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Task_displayName(ctx, field)
-			case "displayStatus":
-				return ec.fieldContext_Task_displayStatus(ctx, field)
-			case "displayOnly":
-				return ec.fieldContext_Task_displayOnly(ctx, field)
-			case "displayTask":
-				return ec.fieldContext_Task_displayTask(ctx, field)
-			case "distroId":
-				return ec.fieldContext_Task_distroId(ctx, field)
-			case "estimatedStart":
-				return ec.fieldContext_Task_estimatedStart(ctx, field)
-			case "execution":
-				return ec.fieldContext_Task_execution(ctx, field)
-			case "executionTasks":
-				return ec.fieldContext_Task_executionTasks(ctx, field)
-			case "executionTasksFull":
-				return ec.fieldContext_Task_executionTasksFull(ctx, field)
-			case "expectedDuration":
-				return ec.fieldContext_Task_expectedDuration(ctx, field)
-			case "failedTestCount":
-				return ec.fieldContext_Task_failedTestCount(ctx, field)
-			case "finishTime":
-				return ec.fieldContext_Task_finishTime(ctx, field)
-			case "files":
-				return ec.fieldContext_Task_files(ctx, field)
-			case "generatedBy":
-				return ec.fieldContext_Task_generatedBy(ctx, field)
-			case "generatedByName":
-				return ec.fieldContext_Task_generatedByName(ctx, field)
-			case "generateTask":
-				return ec.fieldContext_Task_generateTask(ctx, field)
-			case "hasCedarResults":
-				return ec.fieldContext_Task_hasCedarResults(ctx, field)
-			case "hostId":
-				return ec.fieldContext_Task_hostId(ctx, field)
-			case "imageId":
-				return ec.fieldContext_Task_imageId(ctx, field)
-			case "ingestTime":
-				return ec.fieldContext_Task_ingestTime(ctx, field)
-			case "isPerfPluginEnabled":
-				return ec.fieldContext_Task_isPerfPluginEnabled(ctx, field)
-			case "latestExecution":
-				return ec.fieldContext_Task_latestExecution(ctx, field)
-			case "logs":
-				return ec.fieldContext_Task_logs(ctx, field)
-			case "minQueuePosition":
-				return ec.fieldContext_Task_minQueuePosition(ctx, field)
-			case "order":
-				return ec.fieldContext_Task_order(ctx, field)
-			case "patch":
-				return ec.fieldContext_Task_patch(ctx, field)
-			case "patchNumber":
-				return ec.fieldContext_Task_patchNumber(ctx, field)
-			case "pod":
-				return ec.fieldContext_Task_pod(ctx, field)
-			case "priority":
-				return ec.fieldContext_Task_priority(ctx, field)
-			case "project":
-				return ec.fieldContext_Task_project(ctx, field)
-			case "projectId":
-				return ec.fieldContext_Task_projectId(ctx, field)
-			case "projectIdentifier":
-				return ec.fieldContext_Task_projectIdentifier(ctx, field)
-			case "requester":
-				return ec.fieldContext_Task_requester(ctx, field)
-			case "resetWhenFinished":
-				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
-			case "revision":
-				return ec.fieldContext_Task_revision(ctx, field)
-			case "scheduledTime":
-				return ec.fieldContext_Task_scheduledTime(ctx, field)
-			case "spawnHostLink":
-				return ec.fieldContext_Task_spawnHostLink(ctx, field)
-			case "startTime":
-				return ec.fieldContext_Task_startTime(ctx, field)
-			case "status":
-				return ec.fieldContext_Task_status(ctx, field)
-			case "tags":
-				return ec.fieldContext_Task_tags(ctx, field)
-			case "taskGroup":
-				return ec.fieldContext_Task_taskGroup(ctx, field)
-			case "taskGroupMaxHosts":
-				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
-			case "taskLogs":
-				return ec.fieldContext_Task_taskLogs(ctx, field)
-			case "tests":
-				return ec.fieldContext_Task_tests(ctx, field)
-			case "timeTaken":
-				return ec.fieldContext_Task_timeTaken(ctx, field)
-			case "totalTestCount":
-				return ec.fieldContext_Task_totalTestCount(ctx, field)
-			case "versionMetadata":
-				return ec.fieldContext_Task_versionMetadata(ctx, field)
-			case "stepbackInfo":
-				return ec.fieldContext_Task_stepbackInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Task", field.Name)
+			return nil, errors.New("field of type StringMap does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedBuildVariant_variant(ctx context.Context, field graphql.CollectedField, obj *GroupedBuildVariant) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedBuildVariant_variant(ctx, field)
+func (ec *executionContext) _GitTag_tag(ctx context.Context, field graphql.CollectedField, obj *model.APIGitTag) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GitTag_tag(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24404,7 +24918,7 @@ func (ec *executionContext) _GroupedBuildVariant_variant(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Variant, nil
+		return obj.Tag, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -24416,14 +24930,14 @@ func (ec *executionContext) _GroupedBuildVariant_variant(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedBuildVariant_variant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GitTag_tag(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedBuildVariant",
+		Object:     "GitTag",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -24434,8 +24948,8 @@ func (ec *executionContext) fieldContext_GroupedBuildVariant_variant(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedFiles_files(ctx context.Context, field graphql.CollectedField, obj *GroupedFiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedFiles_files(ctx, field)
+func (ec *executionContext) _GitTag_pusher(ctx context.Context, field graphql.CollectedField, obj *model.APIGitTag) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GitTag_pusher(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24448,74 +24962,26 @@ func (ec *executionContext) _GroupedFiles_files(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Files, nil
+		return obj.Pusher, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*model.APIFile)
-	fc.Result = res
-	return ec.marshalOFile2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFileᚄ(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_GroupedFiles_files(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "GroupedFiles",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "link":
-				return ec.fieldContext_File_link(ctx, field)
-			case "name":
-				return ec.fieldContext_File_name(ctx, field)
-			case "urlParsley":
-				return ec.fieldContext_File_urlParsley(ctx, field)
-			case "visibility":
-				return ec.fieldContext_File_visibility(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _GroupedFiles_taskName(ctx context.Context, field graphql.CollectedField, obj *GroupedFiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedFiles_taskName(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.TaskName, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedFiles_taskName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GitTag_pusher(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedFiles",
+		Object:     "GitTag",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -24526,8 +24992,8 @@ func (ec *executionContext) fieldContext_GroupedFiles_taskName(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedFiles_taskId(ctx context.Context, field graphql.CollectedField, obj *GroupedFiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedFiles_taskId(ctx, field)
+func (ec *executionContext) _GithubAppAuth_appId(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubAppAuth) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubAppAuth_appId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24540,38 +25006,35 @@ func (ec *executionContext) _GroupedFiles_taskId(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskID, nil
+		return obj.AppID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedFiles_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubAppAuth_appId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedFiles",
+		Object:     "GithubAppAuth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedFiles_execution(ctx context.Context, field graphql.CollectedField, obj *GroupedFiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedFiles_execution(ctx, field)
+func (ec *executionContext) _GithubAppAuth_privateKey(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubAppAuth) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubAppAuth_privateKey(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24584,38 +25047,35 @@ func (ec *executionContext) _GroupedFiles_execution(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Execution, nil
+		return obj.PrivateKey, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedFiles_execution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubAppAuth_privateKey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedFiles",
+		Object:     "GithubAppAuth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedProjects_groupDisplayName(ctx context.Context, field graphql.CollectedField, obj *GroupedProjects) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedProjects_groupDisplayName(ctx, field)
+func (ec *executionContext) _GithubCheckSubscriber_owner(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubCheckSubscriber) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubCheckSubscriber_owner(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24628,7 +25088,7 @@ func (ec *executionContext) _GroupedProjects_groupDisplayName(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GroupDisplayName, nil
+		return obj.Owner, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -24640,14 +25100,14 @@ func (ec *executionContext) _GroupedProjects_groupDisplayName(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedProjects_groupDisplayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubCheckSubscriber_owner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedProjects",
+		Object:     "GithubCheckSubscriber",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -24658,8 +25118,8 @@ func (ec *executionContext) fieldContext_GroupedProjects_groupDisplayName(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedProjects_projects(ctx context.Context, field graphql.CollectedField, obj *GroupedProjects) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedProjects_projects(ctx, field)
+func (ec *executionContext) _GithubCheckSubscriber_ref(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubCheckSubscriber) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubCheckSubscriber_ref(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24672,7 +25132,7 @@ func (ec *executionContext) _GroupedProjects_projects(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Projects, nil
+		return obj.Ref, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -24684,128 +25144,26 @@ func (ec *executionContext) _GroupedProjects_projects(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APIProjectRef)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNProject2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectRefᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedProjects_projects(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubCheckSubscriber_ref(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedProjects",
+		Object:     "GithubCheckSubscriber",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Project_id(ctx, field)
-			case "admins":
-				return ec.fieldContext_Project_admins(ctx, field)
-			case "banner":
-				return ec.fieldContext_Project_banner(ctx, field)
-			case "batchTime":
-				return ec.fieldContext_Project_batchTime(ctx, field)
-			case "branch":
-				return ec.fieldContext_Project_branch(ctx, field)
-			case "buildBaronSettings":
-				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
-			case "commitQueue":
-				return ec.fieldContext_Project_commitQueue(ctx, field)
-			case "containerSizeDefinitions":
-				return ec.fieldContext_Project_containerSizeDefinitions(ctx, field)
-			case "deactivatePrevious":
-				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
-			case "disabledStatsCache":
-				return ec.fieldContext_Project_disabledStatsCache(ctx, field)
-			case "dispatchingDisabled":
-				return ec.fieldContext_Project_dispatchingDisabled(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Project_displayName(ctx, field)
-			case "enabled":
-				return ec.fieldContext_Project_enabled(ctx, field)
-			case "externalLinks":
-				return ec.fieldContext_Project_externalLinks(ctx, field)
-			case "githubChecksEnabled":
-				return ec.fieldContext_Project_githubChecksEnabled(ctx, field)
-			case "githubDynamicTokenPermissionGroups":
-				return ec.fieldContext_Project_githubDynamicTokenPermissionGroups(ctx, field)
-			case "githubPermissionGroupByRequester":
-				return ec.fieldContext_Project_githubPermissionGroupByRequester(ctx, field)
-			case "githubTriggerAliases":
-				return ec.fieldContext_Project_githubTriggerAliases(ctx, field)
-			case "gitTagAuthorizedTeams":
-				return ec.fieldContext_Project_gitTagAuthorizedTeams(ctx, field)
-			case "gitTagAuthorizedUsers":
-				return ec.fieldContext_Project_gitTagAuthorizedUsers(ctx, field)
-			case "gitTagVersionsEnabled":
-				return ec.fieldContext_Project_gitTagVersionsEnabled(ctx, field)
-			case "hidden":
-				return ec.fieldContext_Project_hidden(ctx, field)
-			case "identifier":
-				return ec.fieldContext_Project_identifier(ctx, field)
-			case "isFavorite":
-				return ec.fieldContext_Project_isFavorite(ctx, field)
-			case "manualPrTestingEnabled":
-				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
-			case "notifyOnBuildFailure":
-				return ec.fieldContext_Project_notifyOnBuildFailure(ctx, field)
-			case "oldestAllowedMergeBase":
-				return ec.fieldContext_Project_oldestAllowedMergeBase(ctx, field)
-			case "owner":
-				return ec.fieldContext_Project_owner(ctx, field)
-			case "parsleyFilters":
-				return ec.fieldContext_Project_parsleyFilters(ctx, field)
-			case "patches":
-				return ec.fieldContext_Project_patches(ctx, field)
-			case "patchingDisabled":
-				return ec.fieldContext_Project_patchingDisabled(ctx, field)
-			case "patchTriggerAliases":
-				return ec.fieldContext_Project_patchTriggerAliases(ctx, field)
-			case "perfEnabled":
-				return ec.fieldContext_Project_perfEnabled(ctx, field)
-			case "periodicBuilds":
-				return ec.fieldContext_Project_periodicBuilds(ctx, field)
-			case "projectHealthView":
-				return ec.fieldContext_Project_projectHealthView(ctx, field)
-			case "prTestingEnabled":
-				return ec.fieldContext_Project_prTestingEnabled(ctx, field)
-			case "remotePath":
-				return ec.fieldContext_Project_remotePath(ctx, field)
-			case "repo":
-				return ec.fieldContext_Project_repo(ctx, field)
-			case "repoRefId":
-				return ec.fieldContext_Project_repoRefId(ctx, field)
-			case "repotrackerDisabled":
-				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
-			case "repotrackerError":
-				return ec.fieldContext_Project_repotrackerError(ctx, field)
-			case "restricted":
-				return ec.fieldContext_Project_restricted(ctx, field)
-			case "spawnHostScriptPath":
-				return ec.fieldContext_Project_spawnHostScriptPath(ctx, field)
-			case "stepbackDisabled":
-				return ec.fieldContext_Project_stepbackDisabled(ctx, field)
-			case "stepbackBisect":
-				return ec.fieldContext_Project_stepbackBisect(ctx, field)
-			case "taskAnnotationSettings":
-				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
-			case "tracksPushEvents":
-				return ec.fieldContext_Project_tracksPushEvents(ctx, field)
-			case "triggers":
-				return ec.fieldContext_Project_triggers(ctx, field)
-			case "versionControlEnabled":
-				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
-			case "workstationConfig":
-				return ec.fieldContext_Project_workstationConfig(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedProjects_repo(ctx context.Context, field graphql.CollectedField, obj *GroupedProjects) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedProjects_repo(ctx, field)
+func (ec *executionContext) _GithubCheckSubscriber_repo(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubCheckSubscriber) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubCheckSubscriber_repo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24825,112 +25183,31 @@ func (ec *executionContext) _GroupedProjects_repo(ctx context.Context, field gra
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.APIProjectRef)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalORepoRef2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectRef(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedProjects_repo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubCheckSubscriber_repo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedProjects",
+		Object:     "GithubCheckSubscriber",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_RepoRef_id(ctx, field)
-			case "admins":
-				return ec.fieldContext_RepoRef_admins(ctx, field)
-			case "batchTime":
-				return ec.fieldContext_RepoRef_batchTime(ctx, field)
-			case "buildBaronSettings":
-				return ec.fieldContext_RepoRef_buildBaronSettings(ctx, field)
-			case "commitQueue":
-				return ec.fieldContext_RepoRef_commitQueue(ctx, field)
-			case "containerSizeDefinitions":
-				return ec.fieldContext_RepoRef_containerSizeDefinitions(ctx, field)
-			case "deactivatePrevious":
-				return ec.fieldContext_RepoRef_deactivatePrevious(ctx, field)
-			case "disabledStatsCache":
-				return ec.fieldContext_RepoRef_disabledStatsCache(ctx, field)
-			case "dispatchingDisabled":
-				return ec.fieldContext_RepoRef_dispatchingDisabled(ctx, field)
-			case "displayName":
-				return ec.fieldContext_RepoRef_displayName(ctx, field)
-			case "enabled":
-				return ec.fieldContext_RepoRef_enabled(ctx, field)
-			case "githubChecksEnabled":
-				return ec.fieldContext_RepoRef_githubChecksEnabled(ctx, field)
-			case "githubDynamicTokenPermissionGroups":
-				return ec.fieldContext_RepoRef_githubDynamicTokenPermissionGroups(ctx, field)
-			case "githubPermissionGroupByRequester":
-				return ec.fieldContext_RepoRef_githubPermissionGroupByRequester(ctx, field)
-			case "githubTriggerAliases":
-				return ec.fieldContext_RepoRef_githubTriggerAliases(ctx, field)
-			case "gitTagAuthorizedTeams":
-				return ec.fieldContext_RepoRef_gitTagAuthorizedTeams(ctx, field)
-			case "gitTagAuthorizedUsers":
-				return ec.fieldContext_RepoRef_gitTagAuthorizedUsers(ctx, field)
-			case "gitTagVersionsEnabled":
-				return ec.fieldContext_RepoRef_gitTagVersionsEnabled(ctx, field)
-			case "manualPrTestingEnabled":
-				return ec.fieldContext_RepoRef_manualPrTestingEnabled(ctx, field)
-			case "notifyOnBuildFailure":
-				return ec.fieldContext_RepoRef_notifyOnBuildFailure(ctx, field)
-			case "oldestAllowedMergeBase":
-				return ec.fieldContext_RepoRef_oldestAllowedMergeBase(ctx, field)
-			case "owner":
-				return ec.fieldContext_RepoRef_owner(ctx, field)
-			case "parsleyFilters":
-				return ec.fieldContext_RepoRef_parsleyFilters(ctx, field)
-			case "patchingDisabled":
-				return ec.fieldContext_RepoRef_patchingDisabled(ctx, field)
-			case "patchTriggerAliases":
-				return ec.fieldContext_RepoRef_patchTriggerAliases(ctx, field)
-			case "perfEnabled":
-				return ec.fieldContext_RepoRef_perfEnabled(ctx, field)
-			case "periodicBuilds":
-				return ec.fieldContext_RepoRef_periodicBuilds(ctx, field)
-			case "prTestingEnabled":
-				return ec.fieldContext_RepoRef_prTestingEnabled(ctx, field)
-			case "remotePath":
-				return ec.fieldContext_RepoRef_remotePath(ctx, field)
-			case "repo":
-				return ec.fieldContext_RepoRef_repo(ctx, field)
-			case "repotrackerDisabled":
-				return ec.fieldContext_RepoRef_repotrackerDisabled(ctx, field)
-			case "restricted":
-				return ec.fieldContext_RepoRef_restricted(ctx, field)
-			case "spawnHostScriptPath":
-				return ec.fieldContext_RepoRef_spawnHostScriptPath(ctx, field)
-			case "stepbackDisabled":
-				return ec.fieldContext_RepoRef_stepbackDisabled(ctx, field)
-			case "stepbackBisect":
-				return ec.fieldContext_RepoRef_stepbackBisect(ctx, field)
-			case "taskAnnotationSettings":
-				return ec.fieldContext_RepoRef_taskAnnotationSettings(ctx, field)
-			case "tracksPushEvents":
-				return ec.fieldContext_RepoRef_tracksPushEvents(ctx, field)
-			case "triggers":
-				return ec.fieldContext_RepoRef_triggers(ctx, field)
-			case "versionControlEnabled":
-				return ec.fieldContext_RepoRef_versionControlEnabled(ctx, field)
-			case "workstationConfig":
-				return ec.fieldContext_RepoRef_workstationConfig(ctx, field)
-			case "externalLinks":
-				return ec.fieldContext_RepoRef_externalLinks(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RepoRef", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedTaskStatusCount_displayName(ctx context.Context, field graphql.CollectedField, obj *task.GroupedTaskStatusCount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedTaskStatusCount_displayName(ctx, field)
+func (ec *executionContext) _GithubPRSubscriber_owner(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubPRSubscriber) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubPRSubscriber_owner(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24943,7 +25220,7 @@ func (ec *executionContext) _GroupedTaskStatusCount_displayName(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayName, nil
+		return obj.Owner, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -24955,14 +25232,14 @@ func (ec *executionContext) _GroupedTaskStatusCount_displayName(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedTaskStatusCount_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubPRSubscriber_owner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedTaskStatusCount",
+		Object:     "GithubPRSubscriber",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -24973,8 +25250,8 @@ func (ec *executionContext) fieldContext_GroupedTaskStatusCount_displayName(_ co
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedTaskStatusCount_statusCounts(ctx context.Context, field graphql.CollectedField, obj *task.GroupedTaskStatusCount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedTaskStatusCount_statusCounts(ctx, field)
+func (ec *executionContext) _GithubPRSubscriber_prNumber(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubPRSubscriber) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubPRSubscriber_prNumber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24987,44 +25264,35 @@ func (ec *executionContext) _GroupedTaskStatusCount_statusCounts(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StatusCounts, nil
+		return obj.PRNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*task.StatusCount)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNStatusCount2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋtaskᚐStatusCountᚄ(ctx, field.Selections, res)
+	return ec.marshalOInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedTaskStatusCount_statusCounts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubPRSubscriber_prNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedTaskStatusCount",
+		Object:     "GithubPRSubscriber",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "count":
-				return ec.fieldContext_StatusCount_count(ctx, field)
-			case "status":
-				return ec.fieldContext_StatusCount_status(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type StatusCount", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GroupedTaskStatusCount_variant(ctx context.Context, field graphql.CollectedField, obj *task.GroupedTaskStatusCount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GroupedTaskStatusCount_variant(ctx, field)
+func (ec *executionContext) _GithubPRSubscriber_ref(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubPRSubscriber) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubPRSubscriber_ref(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25037,7 +25305,7 @@ func (ec *executionContext) _GroupedTaskStatusCount_variant(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Variant, nil
+		return obj.Ref, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25049,14 +25317,14 @@ func (ec *executionContext) _GroupedTaskStatusCount_variant(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GroupedTaskStatusCount_variant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubPRSubscriber_ref(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GroupedTaskStatusCount",
+		Object:     "GithubPRSubscriber",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -25067,8 +25335,8 @@ func (ec *executionContext) fieldContext_GroupedTaskStatusCount_variant(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _HomeVolumeSettings_formatCommand(ctx context.Context, field graphql.CollectedField, obj *model.APIHomeVolumeSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HomeVolumeSettings_formatCommand(ctx, field)
+func (ec *executionContext) _GithubPRSubscriber_repo(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubPRSubscriber) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubPRSubscriber_repo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25081,7 +25349,7 @@ func (ec *executionContext) _HomeVolumeSettings_formatCommand(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FormatCommand, nil
+		return obj.Repo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25098,9 +25366,9 @@ func (ec *executionContext) _HomeVolumeSettings_formatCommand(ctx context.Contex
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HomeVolumeSettings_formatCommand(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubPRSubscriber_repo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HomeVolumeSettings",
+		Object:     "GithubPRSubscriber",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -25111,8 +25379,8 @@ func (ec *executionContext) fieldContext_HomeVolumeSettings_formatCommand(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_id(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_id(ctx, field)
+func (ec *executionContext) _GithubProjectConflicts_commitCheckIdentifiers(ctx context.Context, field graphql.CollectedField, obj *model1.GithubProjectConflicts) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubProjectConflicts_commitCheckIdentifiers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25125,38 +25393,35 @@ func (ec *executionContext) _Host_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Id, nil
+		return obj.CommitCheckIdentifiers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNID2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubProjectConflicts_commitCheckIdentifiers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "GithubProjectConflicts",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_availabilityZone(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_availabilityZone(ctx, field)
+func (ec *executionContext) _GithubProjectConflicts_commitQueueIdentifiers(ctx context.Context, field graphql.CollectedField, obj *model1.GithubProjectConflicts) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubProjectConflicts_commitQueueIdentifiers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25169,7 +25434,7 @@ func (ec *executionContext) _Host_availabilityZone(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AvailabilityZone, nil
+		return obj.CommitQueueIdentifiers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25178,14 +25443,14 @@ func (ec *executionContext) _Host_availabilityZone(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_availabilityZone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubProjectConflicts_commitQueueIdentifiers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "GithubProjectConflicts",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -25196,8 +25461,8 @@ func (ec *executionContext) fieldContext_Host_availabilityZone(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_ami(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_ami(ctx, field)
+func (ec *executionContext) _GithubProjectConflicts_prTestingIdentifiers(ctx context.Context, field graphql.CollectedField, obj *model1.GithubProjectConflicts) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubProjectConflicts_prTestingIdentifiers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25210,7 +25475,7 @@ func (ec *executionContext) _Host_ami(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Host().Ami(rctx, obj)
+		return obj.PRTestingIdentifiers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25219,17 +25484,17 @@ func (ec *executionContext) _Host_ami(ctx context.Context, field graphql.Collect
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_ami(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubProjectConflicts_prTestingIdentifiers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "GithubProjectConflicts",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -25237,8 +25502,8 @@ func (ec *executionContext) fieldContext_Host_ami(_ context.Context, field graph
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_displayName(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_displayName(ctx, field)
+func (ec *executionContext) _GithubUser_lastKnownAs(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubUser) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubUser_lastKnownAs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25251,7 +25516,7 @@ func (ec *executionContext) _Host_displayName(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayName, nil
+		return obj.LastKnownAs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25265,9 +25530,9 @@ func (ec *executionContext) _Host_displayName(ctx context.Context, field graphql
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubUser_lastKnownAs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "GithubUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -25278,8 +25543,8 @@ func (ec *executionContext) fieldContext_Host_displayName(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_distro(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_distro(ctx, field)
+func (ec *executionContext) _GithubUser_uid(ctx context.Context, field graphql.CollectedField, obj *model.APIGithubUser) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GithubUser_uid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25292,7 +25557,7 @@ func (ec *executionContext) _Host_distro(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Distro, nil
+		return obj.UID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25301,40 +25566,26 @@ func (ec *executionContext) _Host_distro(ctx context.Context, field graphql.Coll
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(model.DistroInfo)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalODistroInfo2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐDistroInfo(ctx, field.Selections, res)
+	return ec.marshalOInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_distro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GithubUser_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "GithubUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_DistroInfo_id(ctx, field)
-			case "bootstrapMethod":
-				return ec.fieldContext_DistroInfo_bootstrapMethod(ctx, field)
-			case "isVirtualWorkStation":
-				return ec.fieldContext_DistroInfo_isVirtualWorkStation(ctx, field)
-			case "isWindows":
-				return ec.fieldContext_DistroInfo_isWindows(ctx, field)
-			case "user":
-				return ec.fieldContext_DistroInfo_user(ctx, field)
-			case "workDir":
-				return ec.fieldContext_DistroInfo_workDir(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DistroInfo", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_distroId(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_distroId(ctx, field)
+func (ec *executionContext) _GroupedBuildVariant_displayName(ctx context.Context, field graphql.CollectedField, obj *GroupedBuildVariant) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedBuildVariant_displayName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25347,26 +25598,29 @@ func (ec *executionContext) _Host_distroId(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Host().DistroID(rctx, obj)
+		return obj.DisplayName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_distroId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GroupedBuildVariant_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "GroupedBuildVariant",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -25374,8 +25628,8 @@ func (ec *executionContext) fieldContext_Host_distroId(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_elapsed(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_elapsed(ctx, field)
+func (ec *executionContext) _GroupedBuildVariant_tasks(ctx context.Context, field graphql.CollectedField, obj *GroupedBuildVariant) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedBuildVariant_tasks(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25388,7 +25642,7 @@ func (ec *executionContext) _Host_elapsed(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Host().Elapsed(rctx, obj)
+		return obj.Tasks, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25397,26 +25651,1731 @@ func (ec *executionContext) _Host_elapsed(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.([]*model.APITask)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOTask2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedBuildVariant_tasks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedBuildVariant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Task_id(ctx, field)
+			case "aborted":
+				return ec.fieldContext_Task_aborted(ctx, field)
+			case "abortInfo":
+				return ec.fieldContext_Task_abortInfo(ctx, field)
+			case "activated":
+				return ec.fieldContext_Task_activated(ctx, field)
+			case "activatedBy":
+				return ec.fieldContext_Task_activatedBy(ctx, field)
+			case "activatedTime":
+				return ec.fieldContext_Task_activatedTime(ctx, field)
+			case "ami":
+				return ec.fieldContext_Task_ami(ctx, field)
+			case "annotation":
+				return ec.fieldContext_Task_annotation(ctx, field)
+			case "baseStatus":
+				return ec.fieldContext_Task_baseStatus(ctx, field)
+			case "baseTask":
+				return ec.fieldContext_Task_baseTask(ctx, field)
+			case "blocked":
+				return ec.fieldContext_Task_blocked(ctx, field)
+			case "buildId":
+				return ec.fieldContext_Task_buildId(ctx, field)
+			case "buildVariant":
+				return ec.fieldContext_Task_buildVariant(ctx, field)
+			case "buildVariantDisplayName":
+				return ec.fieldContext_Task_buildVariantDisplayName(ctx, field)
+			case "canAbort":
+				return ec.fieldContext_Task_canAbort(ctx, field)
+			case "canDisable":
+				return ec.fieldContext_Task_canDisable(ctx, field)
+			case "canModifyAnnotation":
+				return ec.fieldContext_Task_canModifyAnnotation(ctx, field)
+			case "canOverrideDependencies":
+				return ec.fieldContext_Task_canOverrideDependencies(ctx, field)
+			case "canRestart":
+				return ec.fieldContext_Task_canRestart(ctx, field)
+			case "canSchedule":
+				return ec.fieldContext_Task_canSchedule(ctx, field)
+			case "canSetPriority":
+				return ec.fieldContext_Task_canSetPriority(ctx, field)
+			case "canUnschedule":
+				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
+			case "containerAllocatedTime":
+				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
+			case "createTime":
+				return ec.fieldContext_Task_createTime(ctx, field)
+			case "dependsOn":
+				return ec.fieldContext_Task_dependsOn(ctx, field)
+			case "details":
+				return ec.fieldContext_Task_details(ctx, field)
+			case "dispatchTime":
+				return ec.fieldContext_Task_dispatchTime(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Task_displayName(ctx, field)
+			case "displayStatus":
+				return ec.fieldContext_Task_displayStatus(ctx, field)
+			case "displayOnly":
+				return ec.fieldContext_Task_displayOnly(ctx, field)
+			case "displayTask":
+				return ec.fieldContext_Task_displayTask(ctx, field)
+			case "distroId":
+				return ec.fieldContext_Task_distroId(ctx, field)
+			case "estimatedStart":
+				return ec.fieldContext_Task_estimatedStart(ctx, field)
+			case "execution":
+				return ec.fieldContext_Task_execution(ctx, field)
+			case "executionTasks":
+				return ec.fieldContext_Task_executionTasks(ctx, field)
+			case "executionTasksFull":
+				return ec.fieldContext_Task_executionTasksFull(ctx, field)
+			case "expectedDuration":
+				return ec.fieldContext_Task_expectedDuration(ctx, field)
+			case "failedTestCount":
+				return ec.fieldContext_Task_failedTestCount(ctx, field)
+			case "finishTime":
+				return ec.fieldContext_Task_finishTime(ctx, field)
+			case "files":
+				return ec.fieldContext_Task_files(ctx, field)
+			case "generatedBy":
+				return ec.fieldContext_Task_generatedBy(ctx, field)
+			case "generatedByName":
+				return ec.fieldContext_Task_generatedByName(ctx, field)
+			case "generateTask":
+				return ec.fieldContext_Task_generateTask(ctx, field)
+			case "hasCedarResults":
+				return ec.fieldContext_Task_hasCedarResults(ctx, field)
+			case "hostId":
+				return ec.fieldContext_Task_hostId(ctx, field)
+			case "imageId":
+				return ec.fieldContext_Task_imageId(ctx, field)
+			case "ingestTime":
+				return ec.fieldContext_Task_ingestTime(ctx, field)
+			case "isPerfPluginEnabled":
+				return ec.fieldContext_Task_isPerfPluginEnabled(ctx, field)
+			case "latestExecution":
+				return ec.fieldContext_Task_latestExecution(ctx, field)
+			case "logs":
+				return ec.fieldContext_Task_logs(ctx, field)
+			case "minQueuePosition":
+				return ec.fieldContext_Task_minQueuePosition(ctx, field)
+			case "order":
+				return ec.fieldContext_Task_order(ctx, field)
+			case "patch":
+				return ec.fieldContext_Task_patch(ctx, field)
+			case "patchNumber":
+				return ec.fieldContext_Task_patchNumber(ctx, field)
+			case "pod":
+				return ec.fieldContext_Task_pod(ctx, field)
+			case "priority":
+				return ec.fieldContext_Task_priority(ctx, field)
+			case "project":
+				return ec.fieldContext_Task_project(ctx, field)
+			case "projectId":
+				return ec.fieldContext_Task_projectId(ctx, field)
+			case "projectIdentifier":
+				return ec.fieldContext_Task_projectIdentifier(ctx, field)
+			case "requester":
+				return ec.fieldContext_Task_requester(ctx, field)
+			case "resetWhenFinished":
+				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "revision":
+				return ec.fieldContext_Task_revision(ctx, field)
+			case "scheduledTime":
+				return ec.fieldContext_Task_scheduledTime(ctx, field)
+			case "spawnHostLink":
+				return ec.fieldContext_Task_spawnHostLink(ctx, field)
+			case "startTime":
+				return ec.fieldContext_Task_startTime(ctx, field)
+			case "status":
+				return ec.fieldContext_Task_status(ctx, field)
+			case "tags":
+				return ec.fieldContext_Task_tags(ctx, field)
+			case "taskGroup":
+				return ec.fieldContext_Task_taskGroup(ctx, field)
+			case "taskGroupMaxHosts":
+				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
+			case "taskLogs":
+				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "tests":
+				return ec.fieldContext_Task_tests(ctx, field)
+			case "timeTaken":
+				return ec.fieldContext_Task_timeTaken(ctx, field)
+			case "totalTestCount":
+				return ec.fieldContext_Task_totalTestCount(ctx, field)
+			case "versionMetadata":
+				return ec.fieldContext_Task_versionMetadata(ctx, field)
+			case "stepbackInfo":
+				return ec.fieldContext_Task_stepbackInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Task", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedBuildVariant_variant(ctx context.Context, field graphql.CollectedField, obj *GroupedBuildVariant) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedBuildVariant_variant(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Variant, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedBuildVariant_variant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedBuildVariant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedFiles_files(ctx context.Context, field graphql.CollectedField, obj *GroupedFiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedFiles_files(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Files, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APIFile)
+	fc.Result = res
+	return ec.marshalOFile2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFileᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedFiles_files(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedFiles",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "expired":
+				return ec.fieldContext_File_expired(ctx, field)
+			case "link":
+				return ec.fieldContext_File_link(ctx, field)
+			case "name":
+				return ec.fieldContext_File_name(ctx, field)
+			case "urlParsley":
+				return ec.fieldContext_File_urlParsley(ctx, field)
+			case "visibility":
+				return ec.fieldContext_File_visibility(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedFiles_taskName(ctx context.Context, field graphql.CollectedField, obj *GroupedFiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedFiles_taskName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedFiles_taskName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedFiles",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedFiles_taskId(ctx context.Context, field graphql.CollectedField, obj *GroupedFiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedFiles_taskId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedFiles_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedFiles",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedFiles_execution(ctx context.Context, field graphql.CollectedField, obj *GroupedFiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedFiles_execution(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Execution, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedFiles_execution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedFiles",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedProjects_groupDisplayName(ctx context.Context, field graphql.CollectedField, obj *GroupedProjects) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedProjects_groupDisplayName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GroupDisplayName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedProjects_groupDisplayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedProjects",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedProjects_projects(ctx context.Context, field graphql.CollectedField, obj *GroupedProjects) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedProjects_projects(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Projects, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APIProjectRef)
+	fc.Result = res
+	return ec.marshalNProject2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectRefᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedProjects_projects(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedProjects",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Project_id(ctx, field)
+			case "admins":
+				return ec.fieldContext_Project_admins(ctx, field)
+			case "banner":
+				return ec.fieldContext_Project_banner(ctx, field)
+			case "batchTime":
+				return ec.fieldContext_Project_batchTime(ctx, field)
+			case "branch":
+				return ec.fieldContext_Project_branch(ctx, field)
+			case "buildBaronSettings":
+				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
+			case "commitQueue":
+				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "containerSizeDefinitions":
+				return ec.fieldContext_Project_containerSizeDefinitions(ctx, field)
+			case "deactivatePrevious":
+				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
+			case "disabledStatsCache":
+				return ec.fieldContext_Project_disabledStatsCache(ctx, field)
+			case "dispatchingDisabled":
+				return ec.fieldContext_Project_dispatchingDisabled(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Project_displayName(ctx, field)
+			case "enabled":
+				return ec.fieldContext_Project_enabled(ctx, field)
+			case "externalLinks":
+				return ec.fieldContext_Project_externalLinks(ctx, field)
+			case "githubChecksEnabled":
+				return ec.fieldContext_Project_githubChecksEnabled(ctx, field)
+			case "githubDynamicTokenPermissionGroups":
+				return ec.fieldContext_Project_githubDynamicTokenPermissionGroups(ctx, field)
+			case "githubPermissionGroupByRequester":
+				return ec.fieldContext_Project_githubPermissionGroupByRequester(ctx, field)
+			case "githubTriggerAliases":
+				return ec.fieldContext_Project_githubTriggerAliases(ctx, field)
+			case "gitTagAuthorizedTeams":
+				return ec.fieldContext_Project_gitTagAuthorizedTeams(ctx, field)
+			case "gitTagAuthorizedUsers":
+				return ec.fieldContext_Project_gitTagAuthorizedUsers(ctx, field)
+			case "gitTagVersionsEnabled":
+				return ec.fieldContext_Project_gitTagVersionsEnabled(ctx, field)
+			case "hidden":
+				return ec.fieldContext_Project_hidden(ctx, field)
+			case "identifier":
+				return ec.fieldContext_Project_identifier(ctx, field)
+			case "isFavorite":
+				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "manualPrTestingEnabled":
+				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
+			case "notifyOnBuildFailure":
+				return ec.fieldContext_Project_notifyOnBuildFailure(ctx, field)
+			case "oldestAllowedMergeBase":
+				return ec.fieldContext_Project_oldestAllowedMergeBase(ctx, field)
+			case "owner":
+				return ec.fieldContext_Project_owner(ctx, field)
+			case "parsleyFilters":
+				return ec.fieldContext_Project_parsleyFilters(ctx, field)
+			case "patches":
+				return ec.fieldContext_Project_patches(ctx, field)
+			case "patchingDisabled":
+				return ec.fieldContext_Project_patchingDisabled(ctx, field)
+			case "patchTriggerAliases":
+				return ec.fieldContext_Project_patchTriggerAliases(ctx, field)
+			case "perfEnabled":
+				return ec.fieldContext_Project_perfEnabled(ctx, field)
+			case "periodicBuilds":
+				return ec.fieldContext_Project_periodicBuilds(ctx, field)
+			case "projectHealthView":
+				return ec.fieldContext_Project_projectHealthView(ctx, field)
+			case "prTestingEnabled":
+				return ec.fieldContext_Project_prTestingEnabled(ctx, field)
+			case "remotePath":
+				return ec.fieldContext_Project_remotePath(ctx, field)
+			case "repo":
+				return ec.fieldContext_Project_repo(ctx, field)
+			case "repoRefId":
+				return ec.fieldContext_Project_repoRefId(ctx, field)
+			case "repotrackerDisabled":
+				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
+			case "repotrackerError":
+				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "restricted":
+				return ec.fieldContext_Project_restricted(ctx, field)
+			case "spawnHostScriptPath":
+				return ec.fieldContext_Project_spawnHostScriptPath(ctx, field)
+			case "stepbackDisabled":
+				return ec.fieldContext_Project_stepbackDisabled(ctx, field)
+			case "stepbackBisect":
+				return ec.fieldContext_Project_stepbackBisect(ctx, field)
+			case "taskAnnotationSettings":
+				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
+			case "tracksPushEvents":
+				return ec.fieldContext_Project_tracksPushEvents(ctx, field)
+			case "triggers":
+				return ec.fieldContext_Project_triggers(ctx, field)
+			case "versionControlEnabled":
+				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
+			case "workstationConfig":
+				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedProjects_repo(ctx context.Context, field graphql.CollectedField, obj *GroupedProjects) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedProjects_repo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APIProjectRef)
+	fc.Result = res
+	return ec.marshalORepoRef2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectRef(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedProjects_repo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedProjects",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RepoRef_id(ctx, field)
+			case "admins":
+				return ec.fieldContext_RepoRef_admins(ctx, field)
+			case "batchTime":
+				return ec.fieldContext_RepoRef_batchTime(ctx, field)
+			case "buildBaronSettings":
+				return ec.fieldContext_RepoRef_buildBaronSettings(ctx, field)
+			case "commitQueue":
+				return ec.fieldContext_RepoRef_commitQueue(ctx, field)
+			case "containerSizeDefinitions":
+				return ec.fieldContext_RepoRef_containerSizeDefinitions(ctx, field)
+			case "deactivatePrevious":
+				return ec.fieldContext_RepoRef_deactivatePrevious(ctx, field)
+			case "disabledStatsCache":
+				return ec.fieldContext_RepoRef_disabledStatsCache(ctx, field)
+			case "dispatchingDisabled":
+				return ec.fieldContext_RepoRef_dispatchingDisabled(ctx, field)
+			case "displayName":
+				return ec.fieldContext_RepoRef_displayName(ctx, field)
+			case "enabled":
+				return ec.fieldContext_RepoRef_enabled(ctx, field)
+			case "githubChecksEnabled":
+				return ec.fieldContext_RepoRef_githubChecksEnabled(ctx, field)
+			case "githubDynamicTokenPermissionGroups":
+				return ec.fieldContext_RepoRef_githubDynamicTokenPermissionGroups(ctx, field)
+			case "githubPermissionGroupByRequester":
+				return ec.fieldContext_RepoRef_githubPermissionGroupByRequester(ctx, field)
+			case "githubTriggerAliases":
+				return ec.fieldContext_RepoRef_githubTriggerAliases(ctx, field)
+			case "gitTagAuthorizedTeams":
+				return ec.fieldContext_RepoRef_gitTagAuthorizedTeams(ctx, field)
+			case "gitTagAuthorizedUsers":
+				return ec.fieldContext_RepoRef_gitTagAuthorizedUsers(ctx, field)
+			case "gitTagVersionsEnabled":
+				return ec.fieldContext_RepoRef_gitTagVersionsEnabled(ctx, field)
+			case "manualPrTestingEnabled":
+				return ec.fieldContext_RepoRef_manualPrTestingEnabled(ctx, field)
+			case "notifyOnBuildFailure":
+				return ec.fieldContext_RepoRef_notifyOnBuildFailure(ctx, field)
+			case "oldestAllowedMergeBase":
+				return ec.fieldContext_RepoRef_oldestAllowedMergeBase(ctx, field)
+			case "owner":
+				return ec.fieldContext_RepoRef_owner(ctx, field)
+			case "parsleyFilters":
+				return ec.fieldContext_RepoRef_parsleyFilters(ctx, field)
+			case "patchingDisabled":
+				return ec.fieldContext_RepoRef_patchingDisabled(ctx, field)
+			case "patchTriggerAliases":
+				return ec.fieldContext_RepoRef_patchTriggerAliases(ctx, field)
+			case "perfEnabled":
+				return ec.fieldContext_RepoRef_perfEnabled(ctx, field)
+			case "periodicBuilds":
+				return ec.fieldContext_RepoRef_periodicBuilds(ctx, field)
+			case "prTestingEnabled":
+				return ec.fieldContext_RepoRef_prTestingEnabled(ctx, field)
+			case "remotePath":
+				return ec.fieldContext_RepoRef_remotePath(ctx, field)
+			case "repo":
+				return ec.fieldContext_RepoRef_repo(ctx, field)
+			case "repotrackerDisabled":
+				return ec.fieldContext_RepoRef_repotrackerDisabled(ctx, field)
+			case "restricted":
+				return ec.fieldContext_RepoRef_restricted(ctx, field)
+			case "spawnHostScriptPath":
+				return ec.fieldContext_RepoRef_spawnHostScriptPath(ctx, field)
+			case "stepbackDisabled":
+				return ec.fieldContext_RepoRef_stepbackDisabled(ctx, field)
+			case "stepbackBisect":
+				return ec.fieldContext_RepoRef_stepbackBisect(ctx, field)
+			case "taskAnnotationSettings":
+				return ec.fieldContext_RepoRef_taskAnnotationSettings(ctx, field)
+			case "tracksPushEvents":
+				return ec.fieldContext_RepoRef_tracksPushEvents(ctx, field)
+			case "triggers":
+				return ec.fieldContext_RepoRef_triggers(ctx, field)
+			case "versionControlEnabled":
+				return ec.fieldContext_RepoRef_versionControlEnabled(ctx, field)
+			case "workstationConfig":
+				return ec.fieldContext_RepoRef_workstationConfig(ctx, field)
+			case "externalLinks":
+				return ec.fieldContext_RepoRef_externalLinks(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RepoRef", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedTaskStatusCount_displayName(ctx context.Context, field graphql.CollectedField, obj *task.GroupedTaskStatusCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedTaskStatusCount_displayName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DisplayName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedTaskStatusCount_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedTaskStatusCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedTaskStatusCount_statusCounts(ctx context.Context, field graphql.CollectedField, obj *task.GroupedTaskStatusCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedTaskStatusCount_statusCounts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StatusCounts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*task.StatusCount)
+	fc.Result = res
+	return ec.marshalNStatusCount2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋtaskᚐStatusCountᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedTaskStatusCount_statusCounts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedTaskStatusCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "count":
+				return ec.fieldContext_StatusCount_count(ctx, field)
+			case "status":
+				return ec.fieldContext_StatusCount_status(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StatusCount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupedTaskStatusCount_variant(ctx context.Context, field graphql.CollectedField, obj *task.GroupedTaskStatusCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GroupedTaskStatusCount_variant(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Variant, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GroupedTaskStatusCount_variant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupedTaskStatusCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HomeVolumeSettings_formatCommand(ctx context.Context, field graphql.CollectedField, obj *model.APIHomeVolumeSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HomeVolumeSettings_formatCommand(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FormatCommand, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HomeVolumeSettings_formatCommand(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HomeVolumeSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_id(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Id, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_availabilityZone(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_availabilityZone(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AvailabilityZone, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_availabilityZone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_ami(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_ami(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Host().Ami(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_ami(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_displayName(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_displayName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DisplayName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_distro(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_distro(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Distro, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(model.DistroInfo)
+	fc.Result = res
+	return ec.marshalODistroInfo2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐDistroInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_distro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_DistroInfo_id(ctx, field)
+			case "bootstrapMethod":
+				return ec.fieldContext_DistroInfo_bootstrapMethod(ctx, field)
+			case "isVirtualWorkStation":
+				return ec.fieldContext_DistroInfo_isVirtualWorkStation(ctx, field)
+			case "isWindows":
+				return ec.fieldContext_DistroInfo_isWindows(ctx, field)
+			case "user":
+				return ec.fieldContext_DistroInfo_user(ctx, field)
+			case "workDir":
+				return ec.fieldContext_DistroInfo_workDir(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DistroInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_distroId(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_distroId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Host().DistroID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_distroId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_elapsed(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_elapsed(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Host().Elapsed(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_elapsed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_events(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_events(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Host().Events(rctx, obj, fc.Args["opts"].(HostEventsInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*HostEvents)
+	fc.Result = res
+	return ec.marshalNHostEvents2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐHostEvents(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "count":
+				return ec.fieldContext_HostEvents_count(ctx, field)
+			case "eventLogEntries":
+				return ec.fieldContext_HostEvents_eventLogEntries(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HostEvents", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Host_events_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_eventTypes(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_eventTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Host().EventTypes(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNHostEventType2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_eventTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type HostEventType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_expiration(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_expiration(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Expiration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_expiration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_hostUrl(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_hostUrl(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HostURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_hostUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_homeVolume(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_homeVolume(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Host().HomeVolume(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APIVolume)
+	fc.Result = res
+	return ec.marshalOVolume2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolume(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_homeVolume(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Volume_id(ctx, field)
+			case "availabilityZone":
+				return ec.fieldContext_Volume_availabilityZone(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Volume_createdBy(ctx, field)
+			case "creationTime":
+				return ec.fieldContext_Volume_creationTime(ctx, field)
+			case "deviceName":
+				return ec.fieldContext_Volume_deviceName(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Volume_displayName(ctx, field)
+			case "expiration":
+				return ec.fieldContext_Volume_expiration(ctx, field)
+			case "homeVolume":
+				return ec.fieldContext_Volume_homeVolume(ctx, field)
+			case "host":
+				return ec.fieldContext_Volume_host(ctx, field)
+			case "hostID":
+				return ec.fieldContext_Volume_hostID(ctx, field)
+			case "migrating":
+				return ec.fieldContext_Volume_migrating(ctx, field)
+			case "noExpiration":
+				return ec.fieldContext_Volume_noExpiration(ctx, field)
+			case "size":
+				return ec.fieldContext_Volume_size(ctx, field)
+			case "type":
+				return ec.fieldContext_Volume_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Volume", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_homeVolumeID(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_homeVolumeID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HomeVolumeID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_homeVolumeID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_instanceType(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_instanceType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InstanceType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_instanceType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_instanceTags(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_instanceTags(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InstanceTags, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]host.Tag)
+	fc.Result = res
+	return ec.marshalNInstanceTag2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋhostᚐTagᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_instanceTags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "canBeModified":
+				return ec.fieldContext_InstanceTag_canBeModified(ctx, field)
+			case "key":
+				return ec.fieldContext_InstanceTag_key(ctx, field)
+			case "value":
+				return ec.fieldContext_InstanceTag_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type InstanceTag", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_lastCommunicationTime(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_lastCommunicationTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastCommunicationTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_lastCommunicationTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_noExpiration(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_noExpiration(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NoExpiration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_noExpiration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_persistentDnsName(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_persistentDnsName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PersistentDNSName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_elapsed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Host_persistentDnsName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Host",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_events(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_events(ctx, field)
+func (ec *executionContext) _Host_provider(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_provider(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25429,7 +27388,7 @@ func (ec *executionContext) _Host_events(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Host().Events(rctx, obj, fc.Args["opts"].(HostEventsInput))
+		return obj.Provider, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25441,43 +27400,134 @@ func (ec *executionContext) _Host_events(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*HostEvents)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNHostEvents2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐHostEvents(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Host_provider(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Host",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_runningTask(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_runningTask(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RunningTask, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(model.TaskInfo)
+	fc.Result = res
+	return ec.marshalOTaskInfo2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐTaskInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_runningTask(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "count":
-				return ec.fieldContext_HostEvents_count(ctx, field)
-			case "eventLogEntries":
-				return ec.fieldContext_HostEvents_eventLogEntries(ctx, field)
+			case "id":
+				return ec.fieldContext_TaskInfo_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TaskInfo_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type HostEvents", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TaskInfo", field.Name)
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_sleepSchedule(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_sleepSchedule(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Host_events_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Host().SleepSchedule(rctx, obj)
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*host.SleepScheduleInfo)
+	fc.Result = res
+	return ec.marshalOSleepSchedule2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋhostᚐSleepScheduleInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_sleepSchedule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "dailyStartTime":
+				return ec.fieldContext_SleepSchedule_dailyStartTime(ctx, field)
+			case "dailyStopTime":
+				return ec.fieldContext_SleepSchedule_dailyStopTime(ctx, field)
+			case "nextStartTime":
+				return ec.fieldContext_SleepSchedule_nextStartTime(ctx, field)
+			case "nextStopTime":
+				return ec.fieldContext_SleepSchedule_nextStopTime(ctx, field)
+			case "permanentlyExempt":
+				return ec.fieldContext_SleepSchedule_permanentlyExempt(ctx, field)
+			case "shouldKeepOff":
+				return ec.fieldContext_SleepSchedule_shouldKeepOff(ctx, field)
+			case "timeZone":
+				return ec.fieldContext_SleepSchedule_timeZone(ctx, field)
+			case "temporarilyExemptUntil":
+				return ec.fieldContext_SleepSchedule_temporarilyExemptUntil(ctx, field)
+			case "wholeWeekdaysOff":
+				return ec.fieldContext_SleepSchedule_wholeWeekdaysOff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SleepSchedule", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_eventTypes(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_eventTypes(ctx, field)
+func (ec *executionContext) _Host_startedBy(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_startedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25490,7 +27540,7 @@ func (ec *executionContext) _Host_eventTypes(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Host().EventTypes(rctx, obj)
+		return obj.StartedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25502,26 +27552,26 @@ func (ec *executionContext) _Host_eventTypes(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNHostEventType2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_eventTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Host_startedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Host",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type HostEventType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_expiration(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_expiration(ctx, field)
+func (ec *executionContext) _Host_status(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25534,35 +27584,38 @@ func (ec *executionContext) _Host_expiration(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Expiration, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_expiration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Host_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Host",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_hostUrl(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_hostUrl(ctx, field)
+func (ec *executionContext) _Host_tag(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_tag(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25575,7 +27628,7 @@ func (ec *executionContext) _Host_hostUrl(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HostURL, nil
+		return obj.Tag, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25592,7 +27645,7 @@ func (ec *executionContext) _Host_hostUrl(ctx context.Context, field graphql.Col
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_hostUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Host_tag(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Host",
 		Field:      field,
@@ -25605,8 +27658,8 @@ func (ec *executionContext) fieldContext_Host_hostUrl(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_homeVolume(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_homeVolume(ctx, field)
+func (ec *executionContext) _Host_totalIdleTime(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_totalIdleTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25619,7 +27672,7 @@ func (ec *executionContext) _Host_homeVolume(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Host().HomeVolume(rctx, obj)
+		return obj.TotalIdleTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25628,12 +27681,138 @@ func (ec *executionContext) _Host_homeVolume(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.APIVolume)
+	res := resTmp.(model.APIDuration)
 	fc.Result = res
-	return ec.marshalOVolume2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolume(ctx, field.Selections, res)
+	return ec.marshalODuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_homeVolume(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Host_totalIdleTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_uptime(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_uptime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Host().Uptime(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_uptime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_user(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_user(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.User, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Host_volumes(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_volumes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Host().Volumes(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APIVolume)
+	fc.Result = res
+	return ec.marshalNVolume2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolumeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_volumes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Host",
 		Field:      field,
@@ -25676,8 +27855,8 @@ func (ec *executionContext) fieldContext_Host_homeVolume(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_homeVolumeID(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_homeVolumeID(ctx, field)
+func (ec *executionContext) _HostAllocatorSettings_acceptableHostIdleTime(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostAllocatorSettings_acceptableHostIdleTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25690,35 +27869,38 @@ func (ec *executionContext) _Host_homeVolumeID(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HomeVolumeID, nil
+		return obj.AcceptableHostIdleTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.APIDuration)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_homeVolumeID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostAllocatorSettings_acceptableHostIdleTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostAllocatorSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Duration does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_instanceType(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_instanceType(ctx, field)
+func (ec *executionContext) _HostAllocatorSettings_feedbackRule(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostAllocatorSettings_feedbackRule(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25731,35 +27913,82 @@ func (ec *executionContext) _Host_instanceType(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InstanceType, nil
+		return ec.resolvers.HostAllocatorSettings().FeedbackRule(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(FeedbackRule)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFeedbackRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐFeedbackRule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_instanceType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostAllocatorSettings_feedbackRule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostAllocatorSettings",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FeedbackRule does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HostAllocatorSettings_futureHostFraction(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostAllocatorSettings_futureHostFraction(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FutureHostFraction, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HostAllocatorSettings_futureHostFraction(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HostAllocatorSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_instanceTags(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_instanceTags(ctx, field)
+func (ec *executionContext) _HostAllocatorSettings_hostsOverallocatedRule(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostAllocatorSettings_hostsOverallocatedRule(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25772,7 +28001,7 @@ func (ec *executionContext) _Host_instanceTags(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InstanceTags, nil
+		return ec.resolvers.HostAllocatorSettings().HostsOverallocatedRule(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25784,34 +28013,70 @@ func (ec *executionContext) _Host_instanceTags(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]host.Tag)
+	res := resTmp.(OverallocatedRule)
 	fc.Result = res
-	return ec.marshalNInstanceTag2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋhostᚐTagᚄ(ctx, field.Selections, res)
+	return ec.marshalNOverallocatedRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐOverallocatedRule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_instanceTags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostAllocatorSettings_hostsOverallocatedRule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostAllocatorSettings",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type OverallocatedRule does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HostAllocatorSettings_maximumHosts(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostAllocatorSettings_maximumHosts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaximumHosts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HostAllocatorSettings_maximumHosts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HostAllocatorSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "canBeModified":
-				return ec.fieldContext_InstanceTag_canBeModified(ctx, field)
-			case "key":
-				return ec.fieldContext_InstanceTag_key(ctx, field)
-			case "value":
-				return ec.fieldContext_InstanceTag_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type InstanceTag", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_lastCommunicationTime(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_lastCommunicationTime(ctx, field)
+func (ec *executionContext) _HostAllocatorSettings_minimumHosts(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostAllocatorSettings_minimumHosts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25824,35 +28089,38 @@ func (ec *executionContext) _Host_lastCommunicationTime(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastCommunicationTime, nil
+		return obj.MinimumHosts, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_lastCommunicationTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostAllocatorSettings_minimumHosts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostAllocatorSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_noExpiration(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_noExpiration(ctx, field)
+func (ec *executionContext) _HostAllocatorSettings_roundingRule(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostAllocatorSettings_roundingRule(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25865,7 +28133,7 @@ func (ec *executionContext) _Host_noExpiration(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NoExpiration, nil
+		return ec.resolvers.HostAllocatorSettings().RoundingRule(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25877,26 +28145,26 @@ func (ec *executionContext) _Host_noExpiration(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(RoundingRule)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNRoundingRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRoundingRule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_noExpiration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostAllocatorSettings_roundingRule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostAllocatorSettings",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type RoundingRule does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_persistentDnsName(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_persistentDnsName(ctx, field)
+func (ec *executionContext) _HostAllocatorSettings_version(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostAllocatorSettings_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25909,7 +28177,7 @@ func (ec *executionContext) _Host_persistentDnsName(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PersistentDNSName, nil
+		return ec.resolvers.HostAllocatorSettings().Version(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25921,26 +28189,26 @@ func (ec *executionContext) _Host_persistentDnsName(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(HostAllocatorVersion)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNHostAllocatorVersion2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐHostAllocatorVersion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_persistentDnsName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostAllocatorSettings_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostAllocatorSettings",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type HostAllocatorVersion does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_provider(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_provider(ctx, field)
+func (ec *executionContext) _HostEventLogData_agentBuild(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_agentBuild(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25953,7 +28221,7 @@ func (ec *executionContext) _Host_provider(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Provider, nil
+		return obj.AgentBuild, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -25970,9 +28238,9 @@ func (ec *executionContext) _Host_provider(ctx context.Context, field graphql.Co
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_provider(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_agentBuild(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -25983,8 +28251,8 @@ func (ec *executionContext) fieldContext_Host_provider(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_runningTask(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_runningTask(ctx, field)
+func (ec *executionContext) _HostEventLogData_agentRevision(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_agentRevision(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25997,41 +28265,38 @@ func (ec *executionContext) _Host_runningTask(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RunningTask, nil
+		return obj.AgentRevision, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TaskInfo)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOTaskInfo2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐTaskInfo(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_runningTask(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_agentRevision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_TaskInfo_id(ctx, field)
-			case "name":
-				return ec.fieldContext_TaskInfo_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TaskInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_sleepSchedule(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_sleepSchedule(ctx, field)
+func (ec *executionContext) _HostEventLogData_duration(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_duration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26044,55 +28309,38 @@ func (ec *executionContext) _Host_sleepSchedule(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Host().SleepSchedule(rctx, obj)
+		return obj.Duration, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*host.SleepScheduleInfo)
+	res := resTmp.(model.APIDuration)
 	fc.Result = res
-	return ec.marshalOSleepSchedule2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋhostᚐSleepScheduleInfo(ctx, field.Selections, res)
+	return ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_sleepSchedule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "dailyStartTime":
-				return ec.fieldContext_SleepSchedule_dailyStartTime(ctx, field)
-			case "dailyStopTime":
-				return ec.fieldContext_SleepSchedule_dailyStopTime(ctx, field)
-			case "nextStartTime":
-				return ec.fieldContext_SleepSchedule_nextStartTime(ctx, field)
-			case "nextStopTime":
-				return ec.fieldContext_SleepSchedule_nextStopTime(ctx, field)
-			case "permanentlyExempt":
-				return ec.fieldContext_SleepSchedule_permanentlyExempt(ctx, field)
-			case "shouldKeepOff":
-				return ec.fieldContext_SleepSchedule_shouldKeepOff(ctx, field)
-			case "timeZone":
-				return ec.fieldContext_SleepSchedule_timeZone(ctx, field)
-			case "temporarilyExemptUntil":
-				return ec.fieldContext_SleepSchedule_temporarilyExemptUntil(ctx, field)
-			case "wholeWeekdaysOff":
-				return ec.fieldContext_SleepSchedule_wholeWeekdaysOff(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SleepSchedule", field.Name)
+			return nil, errors.New("field of type Duration does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_startedBy(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_startedBy(ctx, field)
+func (ec *executionContext) _HostEventLogData_execution(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_execution(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26105,7 +28353,7 @@ func (ec *executionContext) _Host_startedBy(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StartedBy, nil
+		return obj.Execution, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26122,9 +28370,9 @@ func (ec *executionContext) _Host_startedBy(ctx context.Context, field graphql.C
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_startedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_execution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -26135,8 +28383,8 @@ func (ec *executionContext) fieldContext_Host_startedBy(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_status(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_status(ctx, field)
+func (ec *executionContext) _HostEventLogData_hostname(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_hostname(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26149,7 +28397,7 @@ func (ec *executionContext) _Host_status(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Hostname, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26166,9 +28414,9 @@ func (ec *executionContext) _Host_status(ctx context.Context, field graphql.Coll
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_hostname(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -26179,8 +28427,8 @@ func (ec *executionContext) fieldContext_Host_status(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_tag(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_tag(ctx, field)
+func (ec *executionContext) _HostEventLogData_jasperRevision(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_jasperRevision(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26193,7 +28441,7 @@ func (ec *executionContext) _Host_tag(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tag, nil
+		return obj.JasperRevision, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26210,9 +28458,9 @@ func (ec *executionContext) _Host_tag(ctx context.Context, field graphql.Collect
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_tag(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_jasperRevision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -26223,8 +28471,8 @@ func (ec *executionContext) fieldContext_Host_tag(_ context.Context, field graph
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_totalIdleTime(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_totalIdleTime(ctx, field)
+func (ec *executionContext) _HostEventLogData_logs(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_logs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26237,35 +28485,38 @@ func (ec *executionContext) _Host_totalIdleTime(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalIdleTime, nil
+		return obj.Logs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIDuration)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalODuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_totalIdleTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_logs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Duration does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_uptime(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_uptime(ctx, field)
+func (ec *executionContext) _HostEventLogData_monitorOp(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_monitorOp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26278,35 +28529,38 @@ func (ec *executionContext) _Host_uptime(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Host().Uptime(rctx, obj)
+		return obj.MonitorOp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_uptime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_monitorOp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_user(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_user(ctx, field)
+func (ec *executionContext) _HostEventLogData_newStatus(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_newStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26319,23 +28573,26 @@ func (ec *executionContext) _Host_user(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.NewStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_newStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -26346,8 +28603,8 @@ func (ec *executionContext) fieldContext_Host_user(_ context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _Host_volumes(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Host_volumes(ctx, field)
+func (ec *executionContext) _HostEventLogData_oldStatus(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_oldStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26360,7 +28617,7 @@ func (ec *executionContext) _Host_volumes(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Host().Volumes(rctx, obj)
+		return obj.OldStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26372,56 +28629,26 @@ func (ec *executionContext) _Host_volumes(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APIVolume)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNVolume2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolumeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Host_volumes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_oldStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Host",
+		Object:     "HostEventLogData",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Volume_id(ctx, field)
-			case "availabilityZone":
-				return ec.fieldContext_Volume_availabilityZone(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Volume_createdBy(ctx, field)
-			case "creationTime":
-				return ec.fieldContext_Volume_creationTime(ctx, field)
-			case "deviceName":
-				return ec.fieldContext_Volume_deviceName(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Volume_displayName(ctx, field)
-			case "expiration":
-				return ec.fieldContext_Volume_expiration(ctx, field)
-			case "homeVolume":
-				return ec.fieldContext_Volume_homeVolume(ctx, field)
-			case "host":
-				return ec.fieldContext_Volume_host(ctx, field)
-			case "hostID":
-				return ec.fieldContext_Volume_hostID(ctx, field)
-			case "migrating":
-				return ec.fieldContext_Volume_migrating(ctx, field)
-			case "noExpiration":
-				return ec.fieldContext_Volume_noExpiration(ctx, field)
-			case "size":
-				return ec.fieldContext_Volume_size(ctx, field)
-			case "type":
-				return ec.fieldContext_Volume_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Volume", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostAllocatorSettings_acceptableHostIdleTime(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostAllocatorSettings_acceptableHostIdleTime(ctx, field)
+func (ec *executionContext) _HostEventLogData_provisioningMethod(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_provisioningMethod(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26434,7 +28661,7 @@ func (ec *executionContext) _HostAllocatorSettings_acceptableHostIdleTime(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AcceptableHostIdleTime, nil
+		return obj.ProvisioningMethod, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26446,26 +28673,26 @@ func (ec *executionContext) _HostAllocatorSettings_acceptableHostIdleTime(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIDuration)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostAllocatorSettings_acceptableHostIdleTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_provisioningMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostAllocatorSettings",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Duration does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostAllocatorSettings_feedbackRule(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostAllocatorSettings_feedbackRule(ctx, field)
+func (ec *executionContext) _HostEventLogData_successful(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_successful(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26478,7 +28705,7 @@ func (ec *executionContext) _HostAllocatorSettings_feedbackRule(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.HostAllocatorSettings().FeedbackRule(rctx, obj)
+		return obj.Successful, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26490,26 +28717,26 @@ func (ec *executionContext) _HostAllocatorSettings_feedbackRule(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(FeedbackRule)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNFeedbackRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐFeedbackRule(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostAllocatorSettings_feedbackRule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_successful(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostAllocatorSettings",
+		Object:     "HostEventLogData",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type FeedbackRule does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostAllocatorSettings_futureHostFraction(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostAllocatorSettings_futureHostFraction(ctx, field)
+func (ec *executionContext) _HostEventLogData_taskId(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_taskId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26522,7 +28749,7 @@ func (ec *executionContext) _HostAllocatorSettings_futureHostFraction(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FutureHostFraction, nil
+		return obj.TaskId, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26534,26 +28761,26 @@ func (ec *executionContext) _HostAllocatorSettings_futureHostFraction(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostAllocatorSettings_futureHostFraction(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostAllocatorSettings",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostAllocatorSettings_hostsOverallocatedRule(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostAllocatorSettings_hostsOverallocatedRule(ctx, field)
+func (ec *executionContext) _HostEventLogData_taskPid(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_taskPid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26566,7 +28793,7 @@ func (ec *executionContext) _HostAllocatorSettings_hostsOverallocatedRule(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.HostAllocatorSettings().HostsOverallocatedRule(rctx, obj)
+		return obj.TaskPid, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26578,26 +28805,26 @@ func (ec *executionContext) _HostAllocatorSettings_hostsOverallocatedRule(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(OverallocatedRule)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNOverallocatedRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐOverallocatedRule(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostAllocatorSettings_hostsOverallocatedRule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_taskPid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostAllocatorSettings",
+		Object:     "HostEventLogData",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type OverallocatedRule does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostAllocatorSettings_maximumHosts(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostAllocatorSettings_maximumHosts(ctx, field)
+func (ec *executionContext) _HostEventLogData_taskStatus(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_taskStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26610,7 +28837,7 @@ func (ec *executionContext) _HostAllocatorSettings_maximumHosts(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaximumHosts, nil
+		return obj.TaskStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26622,26 +28849,26 @@ func (ec *executionContext) _HostAllocatorSettings_maximumHosts(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostAllocatorSettings_maximumHosts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_taskStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostAllocatorSettings",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostAllocatorSettings_minimumHosts(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostAllocatorSettings_minimumHosts(ctx, field)
+func (ec *executionContext) _HostEventLogData_user(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogData_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26654,7 +28881,7 @@ func (ec *executionContext) _HostAllocatorSettings_minimumHosts(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MinimumHosts, nil
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26666,26 +28893,26 @@ func (ec *executionContext) _HostAllocatorSettings_minimumHosts(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostAllocatorSettings_minimumHosts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogData_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostAllocatorSettings",
+		Object:     "HostEventLogData",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostAllocatorSettings_roundingRule(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostAllocatorSettings_roundingRule(ctx, field)
+func (ec *executionContext) _HostEventLogEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogEntry_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26698,7 +28925,7 @@ func (ec *executionContext) _HostAllocatorSettings_roundingRule(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.HostAllocatorSettings().RoundingRule(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26710,26 +28937,26 @@ func (ec *executionContext) _HostAllocatorSettings_roundingRule(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(RoundingRule)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNRoundingRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRoundingRule(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostAllocatorSettings_roundingRule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogEntry_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostAllocatorSettings",
+		Object:     "HostEventLogEntry",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RoundingRule does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostAllocatorSettings_version(ctx context.Context, field graphql.CollectedField, obj *model.APIHostAllocatorSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostAllocatorSettings_version(ctx, field)
+func (ec *executionContext) _HostEventLogEntry_data(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogEntry_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26742,7 +28969,7 @@ func (ec *executionContext) _HostAllocatorSettings_version(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.HostAllocatorSettings().Version(rctx, obj)
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26754,26 +28981,60 @@ func (ec *executionContext) _HostAllocatorSettings_version(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(HostAllocatorVersion)
+	res := resTmp.(*model.HostAPIEventData)
 	fc.Result = res
-	return ec.marshalNHostAllocatorVersion2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐHostAllocatorVersion(ctx, field.Selections, res)
+	return ec.marshalNHostEventLogData2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐHostAPIEventData(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostAllocatorSettings_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogEntry_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostAllocatorSettings",
+		Object:     "HostEventLogEntry",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type HostAllocatorVersion does not have child fields")
+			switch field.Name {
+			case "agentBuild":
+				return ec.fieldContext_HostEventLogData_agentBuild(ctx, field)
+			case "agentRevision":
+				return ec.fieldContext_HostEventLogData_agentRevision(ctx, field)
+			case "duration":
+				return ec.fieldContext_HostEventLogData_duration(ctx, field)
+			case "execution":
+				return ec.fieldContext_HostEventLogData_execution(ctx, field)
+			case "hostname":
+				return ec.fieldContext_HostEventLogData_hostname(ctx, field)
+			case "jasperRevision":
+				return ec.fieldContext_HostEventLogData_jasperRevision(ctx, field)
+			case "logs":
+				return ec.fieldContext_HostEventLogData_logs(ctx, field)
+			case "monitorOp":
+				return ec.fieldContext_HostEventLogData_monitorOp(ctx, field)
+			case "newStatus":
+				return ec.fieldContext_HostEventLogData_newStatus(ctx, field)
+			case "oldStatus":
+				return ec.fieldContext_HostEventLogData_oldStatus(ctx, field)
+			case "provisioningMethod":
+				return ec.fieldContext_HostEventLogData_provisioningMethod(ctx, field)
+			case "successful":
+				return ec.fieldContext_HostEventLogData_successful(ctx, field)
+			case "taskId":
+				return ec.fieldContext_HostEventLogData_taskId(ctx, field)
+			case "taskPid":
+				return ec.fieldContext_HostEventLogData_taskPid(ctx, field)
+			case "taskStatus":
+				return ec.fieldContext_HostEventLogData_taskStatus(ctx, field)
+			case "user":
+				return ec.fieldContext_HostEventLogData_user(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HostEventLogData", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_agentBuild(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_agentBuild(ctx, field)
+func (ec *executionContext) _HostEventLogEntry_eventType(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogEntry_eventType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26786,38 +29047,35 @@ func (ec *executionContext) _HostEventLogData_agentBuild(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AgentBuild, nil
+		return obj.EventType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOHostEventType2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_agentBuild(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogEntry_eventType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostEventLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type HostEventType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_agentRevision(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_agentRevision(ctx, field)
+func (ec *executionContext) _HostEventLogEntry_processedAt(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogEntry_processedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26830,7 +29088,7 @@ func (ec *executionContext) _HostEventLogData_agentRevision(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AgentRevision, nil
+		return obj.ProcessedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26842,26 +29100,26 @@ func (ec *executionContext) _HostEventLogData_agentRevision(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_agentRevision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogEntry_processedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostEventLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_duration(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_duration(ctx, field)
+func (ec *executionContext) _HostEventLogEntry_resourceId(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogEntry_resourceId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26874,7 +29132,7 @@ func (ec *executionContext) _HostEventLogData_duration(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Duration, nil
+		return obj.ResourceId, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26886,26 +29144,26 @@ func (ec *executionContext) _HostEventLogData_duration(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.APIDuration)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogEntry_resourceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostEventLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Duration does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_execution(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_execution(ctx, field)
+func (ec *executionContext) _HostEventLogEntry_resourceType(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogEntry_resourceType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26918,7 +29176,7 @@ func (ec *executionContext) _HostEventLogData_execution(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Execution, nil
+		return obj.ResourceType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -26935,9 +29193,9 @@ func (ec *executionContext) _HostEventLogData_execution(ctx context.Context, fie
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_execution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogEntry_resourceType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostEventLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -26948,8 +29206,8 @@ func (ec *executionContext) fieldContext_HostEventLogData_execution(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_hostname(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_hostname(ctx, field)
+func (ec *executionContext) _HostEventLogEntry_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEventLogEntry_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -26962,38 +29220,35 @@ func (ec *executionContext) _HostEventLogData_hostname(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hostname, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_hostname(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEventLogEntry_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostEventLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_jasperRevision(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_jasperRevision(ctx, field)
+func (ec *executionContext) _HostEvents_count(ctx context.Context, field graphql.CollectedField, obj *HostEvents) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEvents_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27006,7 +29261,7 @@ func (ec *executionContext) _HostEventLogData_jasperRevision(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.JasperRevision, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27018,26 +29273,26 @@ func (ec *executionContext) _HostEventLogData_jasperRevision(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_jasperRevision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEvents_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostEvents",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_logs(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_logs(ctx, field)
+func (ec *executionContext) _HostEvents_eventLogEntries(ctx context.Context, field graphql.CollectedField, obj *HostEvents) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostEvents_eventLogEntries(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27050,7 +29305,7 @@ func (ec *executionContext) _HostEventLogData_logs(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Logs, nil
+		return obj.EventLogEntries, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27062,26 +29317,42 @@ func (ec *executionContext) _HostEventLogData_logs(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.HostAPIEventLogEntry)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNHostEventLogEntry2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐHostAPIEventLogEntryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_logs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostEvents_eventLogEntries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostEvents",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_HostEventLogEntry_id(ctx, field)
+			case "data":
+				return ec.fieldContext_HostEventLogEntry_data(ctx, field)
+			case "eventType":
+				return ec.fieldContext_HostEventLogEntry_eventType(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_HostEventLogEntry_processedAt(ctx, field)
+			case "resourceId":
+				return ec.fieldContext_HostEventLogEntry_resourceId(ctx, field)
+			case "resourceType":
+				return ec.fieldContext_HostEventLogEntry_resourceType(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_HostEventLogEntry_timestamp(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HostEventLogEntry", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_monitorOp(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_monitorOp(ctx, field)
+func (ec *executionContext) _HostsResponse_filteredHostsCount(ctx context.Context, field graphql.CollectedField, obj *HostsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostsResponse_filteredHostsCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27094,38 +29365,35 @@ func (ec *executionContext) _HostEventLogData_monitorOp(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MonitorOp, nil
+		return obj.FilteredHostsCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_monitorOp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostsResponse_filteredHostsCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_newStatus(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_newStatus(ctx, field)
+func (ec *executionContext) _HostsResponse_hosts(ctx context.Context, field graphql.CollectedField, obj *HostsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostsResponse_hosts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27138,7 +29406,7 @@ func (ec *executionContext) _HostEventLogData_newStatus(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NewStatus, nil
+		return obj.Hosts, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27150,26 +29418,84 @@ func (ec *executionContext) _HostEventLogData_newStatus(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.APIHost)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNHost2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIHostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_newStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostsResponse_hosts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Host_id(ctx, field)
+			case "availabilityZone":
+				return ec.fieldContext_Host_availabilityZone(ctx, field)
+			case "ami":
+				return ec.fieldContext_Host_ami(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Host_displayName(ctx, field)
+			case "distro":
+				return ec.fieldContext_Host_distro(ctx, field)
+			case "distroId":
+				return ec.fieldContext_Host_distroId(ctx, field)
+			case "elapsed":
+				return ec.fieldContext_Host_elapsed(ctx, field)
+			case "events":
+				return ec.fieldContext_Host_events(ctx, field)
+			case "eventTypes":
+				return ec.fieldContext_Host_eventTypes(ctx, field)
+			case "expiration":
+				return ec.fieldContext_Host_expiration(ctx, field)
+			case "hostUrl":
+				return ec.fieldContext_Host_hostUrl(ctx, field)
+			case "homeVolume":
+				return ec.fieldContext_Host_homeVolume(ctx, field)
+			case "homeVolumeID":
+				return ec.fieldContext_Host_homeVolumeID(ctx, field)
+			case "instanceType":
+				return ec.fieldContext_Host_instanceType(ctx, field)
+			case "instanceTags":
+				return ec.fieldContext_Host_instanceTags(ctx, field)
+			case "lastCommunicationTime":
+				return ec.fieldContext_Host_lastCommunicationTime(ctx, field)
+			case "noExpiration":
+				return ec.fieldContext_Host_noExpiration(ctx, field)
+			case "persistentDnsName":
+				return ec.fieldContext_Host_persistentDnsName(ctx, field)
+			case "provider":
+				return ec.fieldContext_Host_provider(ctx, field)
+			case "runningTask":
+				return ec.fieldContext_Host_runningTask(ctx, field)
+			case "sleepSchedule":
+				return ec.fieldContext_Host_sleepSchedule(ctx, field)
+			case "startedBy":
+				return ec.fieldContext_Host_startedBy(ctx, field)
+			case "status":
+				return ec.fieldContext_Host_status(ctx, field)
+			case "tag":
+				return ec.fieldContext_Host_tag(ctx, field)
+			case "totalIdleTime":
+				return ec.fieldContext_Host_totalIdleTime(ctx, field)
+			case "uptime":
+				return ec.fieldContext_Host_uptime(ctx, field)
+			case "user":
+				return ec.fieldContext_Host_user(ctx, field)
+			case "volumes":
+				return ec.fieldContext_Host_volumes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Host", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_oldStatus(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_oldStatus(ctx, field)
+func (ec *executionContext) _HostsResponse_totalHostsCount(ctx context.Context, field graphql.CollectedField, obj *HostsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HostsResponse_totalHostsCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27182,7 +29508,7 @@ func (ec *executionContext) _HostEventLogData_oldStatus(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OldStatus, nil
+		return obj.TotalHostsCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27194,26 +29520,26 @@ func (ec *executionContext) _HostEventLogData_oldStatus(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_oldStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HostsResponse_totalHostsCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "HostsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_provisioningMethod(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_provisioningMethod(ctx, field)
+func (ec *executionContext) _IceCreamSettings_configPath(ctx context.Context, field graphql.CollectedField, obj *model.APIIceCreamSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IceCreamSettings_configPath(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27226,7 +29552,7 @@ func (ec *executionContext) _HostEventLogData_provisioningMethod(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProvisioningMethod, nil
+		return obj.ConfigPath, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27243,9 +29569,9 @@ func (ec *executionContext) _HostEventLogData_provisioningMethod(ctx context.Con
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_provisioningMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IceCreamSettings_configPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "IceCreamSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -27256,8 +29582,8 @@ func (ec *executionContext) fieldContext_HostEventLogData_provisioningMethod(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_successful(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_successful(ctx, field)
+func (ec *executionContext) _IceCreamSettings_schedulerHost(ctx context.Context, field graphql.CollectedField, obj *model.APIIceCreamSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IceCreamSettings_schedulerHost(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27270,7 +29596,7 @@ func (ec *executionContext) _HostEventLogData_successful(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Successful, nil
+		return obj.SchedulerHost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27282,26 +29608,26 @@ func (ec *executionContext) _HostEventLogData_successful(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_successful(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IceCreamSettings_schedulerHost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "IceCreamSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_taskId(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_taskId(ctx, field)
+func (ec *executionContext) _Image_id(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27314,7 +29640,7 @@ func (ec *executionContext) _HostEventLogData_taskId(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskId, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27331,9 +29657,9 @@ func (ec *executionContext) _HostEventLogData_taskId(ctx context.Context, field
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -27344,8 +29670,8 @@ func (ec *executionContext) fieldContext_HostEventLogData_taskId(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_taskPid(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_taskPid(ctx, field)
+func (ec *executionContext) _Image_ami(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_ami(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27358,7 +29684,7 @@ func (ec *executionContext) _HostEventLogData_taskPid(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskPid, nil
+		return obj.AMI, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27375,9 +29701,9 @@ func (ec *executionContext) _HostEventLogData_taskPid(ctx context.Context, field
 	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_taskPid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_ami(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -27388,8 +29714,8 @@ func (ec *executionContext) fieldContext_HostEventLogData_taskPid(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_taskStatus(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_taskStatus(ctx, field)
+func (ec *executionContext) _Image_distros(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_distros(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27402,7 +29728,7 @@ func (ec *executionContext) _HostEventLogData_taskStatus(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskStatus, nil
+		return ec.resolvers.Image().Distros(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27414,26 +29740,94 @@ func (ec *executionContext) _HostEventLogData_taskStatus(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.APIDistro)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNDistro2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDistroᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_taskStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_distros(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "Image",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "adminOnly":
+				return ec.fieldContext_Distro_adminOnly(ctx, field)
+			case "aliases":
+				return ec.fieldContext_Distro_aliases(ctx, field)
+			case "arch":
+				return ec.fieldContext_Distro_arch(ctx, field)
+			case "authorizedKeysFile":
+				return ec.fieldContext_Distro_authorizedKeysFile(ctx, field)
+			case "bootstrapSettings":
+				return ec.fieldContext_Distro_bootstrapSettings(ctx, field)
+			case "containerPool":
+				return ec.fieldContext_Distro_containerPool(ctx, field)
+			case "disabled":
+				return ec.fieldContext_Distro_disabled(ctx, field)
+			case "disableShallowClone":
+				return ec.fieldContext_Distro_disableShallowClone(ctx, field)
+			case "dispatcherSettings":
+				return ec.fieldContext_Distro_dispatcherSettings(ctx, field)
+			case "execUser":
+				return ec.fieldContext_Distro_execUser(ctx, field)
+			case "expansions":
+				return ec.fieldContext_Distro_expansions(ctx, field)
+			case "finderSettings":
+				return ec.fieldContext_Distro_finderSettings(ctx, field)
+			case "homeVolumeSettings":
+				return ec.fieldContext_Distro_homeVolumeSettings(ctx, field)
+			case "hostAllocatorSettings":
+				return ec.fieldContext_Distro_hostAllocatorSettings(ctx, field)
+			case "iceCreamSettings":
+				return ec.fieldContext_Distro_iceCreamSettings(ctx, field)
+			case "imageId":
+				return ec.fieldContext_Distro_imageId(ctx, field)
+			case "isCluster":
+				return ec.fieldContext_Distro_isCluster(ctx, field)
+			case "isVirtualWorkStation":
+				return ec.fieldContext_Distro_isVirtualWorkStation(ctx, field)
+			case "mountpoints":
+				return ec.fieldContext_Distro_mountpoints(ctx, field)
+			case "name":
+				return ec.fieldContext_Distro_name(ctx, field)
+			case "note":
+				return ec.fieldContext_Distro_note(ctx, field)
+			case "plannerSettings":
+				return ec.fieldContext_Distro_plannerSettings(ctx, field)
+			case "provider":
+				return ec.fieldContext_Distro_provider(ctx, field)
+			case "providerSettingsList":
+				return ec.fieldContext_Distro_providerSettingsList(ctx, field)
+			case "setup":
+				return ec.fieldContext_Distro_setup(ctx, field)
+			case "setupAsSudo":
+				return ec.fieldContext_Distro_setupAsSudo(ctx, field)
+			case "singleTaskDistro":
+				return ec.fieldContext_Distro_singleTaskDistro(ctx, field)
+			case "sshOptions":
+				return ec.fieldContext_Distro_sshOptions(ctx, field)
+			case "user":
+				return ec.fieldContext_Distro_user(ctx, field)
+			case "userSpawnAllowed":
+				return ec.fieldContext_Distro_userSpawnAllowed(ctx, field)
+			case "validProjects":
+				return ec.fieldContext_Distro_validProjects(ctx, field)
+			case "warningNote":
+				return ec.fieldContext_Distro_warningNote(ctx, field)
+			case "workDir":
+				return ec.fieldContext_Distro_workDir(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Distro", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogData_user(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventData) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogData_user(ctx, field)
+func (ec *executionContext) _Image_events(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_events(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27446,7 +29840,7 @@ func (ec *executionContext) _HostEventLogData_user(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return ec.resolvers.Image().Events(rctx, obj, fc.Args["limit"].(int), fc.Args["page"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27458,26 +29852,43 @@ func (ec *executionContext) _HostEventLogData_user(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*ImageEventsPayload)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNImageEventsPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐImageEventsPayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogData_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogData",
+		Object:     "Image",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "count":
+				return ec.fieldContext_ImageEventsPayload_count(ctx, field)
+			case "eventLogEntries":
+				return ec.fieldContext_ImageEventsPayload_eventLogEntries(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageEventsPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Image_events_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogEntry_id(ctx, field)
+func (ec *executionContext) _Image_lastDeployed(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_lastDeployed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27490,7 +29901,7 @@ func (ec *executionContext) _HostEventLogEntry_id(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.LastDeployed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27502,26 +29913,26 @@ func (ec *executionContext) _HostEventLogEntry_id(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogEntry_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_lastDeployed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogEntry",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogEntry_data(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogEntry_data(ctx, field)
+func (ec *executionContext) _Image_latestTask(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_latestTask(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27534,72 +29945,189 @@ func (ec *executionContext) _HostEventLogEntry_data(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return ec.resolvers.Image().LatestTask(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.HostAPIEventData)
+	res := resTmp.(*model.APITask)
 	fc.Result = res
-	return ec.marshalNHostEventLogData2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐHostAPIEventData(ctx, field.Selections, res)
+	return ec.marshalOTask2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITask(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogEntry_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_latestTask(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogEntry",
+		Object:     "Image",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "agentBuild":
-				return ec.fieldContext_HostEventLogData_agentBuild(ctx, field)
-			case "agentRevision":
-				return ec.fieldContext_HostEventLogData_agentRevision(ctx, field)
-			case "duration":
-				return ec.fieldContext_HostEventLogData_duration(ctx, field)
+			case "id":
+				return ec.fieldContext_Task_id(ctx, field)
+			case "aborted":
+				return ec.fieldContext_Task_aborted(ctx, field)
+			case "abortInfo":
+				return ec.fieldContext_Task_abortInfo(ctx, field)
+			case "activated":
+				return ec.fieldContext_Task_activated(ctx, field)
+			case "activatedBy":
+				return ec.fieldContext_Task_activatedBy(ctx, field)
+			case "activatedTime":
+				return ec.fieldContext_Task_activatedTime(ctx, field)
+			case "ami":
+				return ec.fieldContext_Task_ami(ctx, field)
+			case "annotation":
+				return ec.fieldContext_Task_annotation(ctx, field)
+			case "baseStatus":
+				return ec.fieldContext_Task_baseStatus(ctx, field)
+			case "baseTask":
+				return ec.fieldContext_Task_baseTask(ctx, field)
+			case "blocked":
+				return ec.fieldContext_Task_blocked(ctx, field)
+			case "buildId":
+				return ec.fieldContext_Task_buildId(ctx, field)
+			case "buildVariant":
+				return ec.fieldContext_Task_buildVariant(ctx, field)
+			case "buildVariantDisplayName":
+				return ec.fieldContext_Task_buildVariantDisplayName(ctx, field)
+			case "canAbort":
+				return ec.fieldContext_Task_canAbort(ctx, field)
+			case "canDisable":
+				return ec.fieldContext_Task_canDisable(ctx, field)
+			case "canModifyAnnotation":
+				return ec.fieldContext_Task_canModifyAnnotation(ctx, field)
+			case "canOverrideDependencies":
+				return ec.fieldContext_Task_canOverrideDependencies(ctx, field)
+			case "canRestart":
+				return ec.fieldContext_Task_canRestart(ctx, field)
+			case "canSchedule":
+				return ec.fieldContext_Task_canSchedule(ctx, field)
+			case "canSetPriority":
+				return ec.fieldContext_Task_canSetPriority(ctx, field)
+			case "canUnschedule":
+				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
+			case "containerAllocatedTime":
+				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
+			case "createTime":
+				return ec.fieldContext_Task_createTime(ctx, field)
+			case "dependsOn":
+				return ec.fieldContext_Task_dependsOn(ctx, field)
+			case "details":
+				return ec.fieldContext_Task_details(ctx, field)
+			case "dispatchTime":
+				return ec.fieldContext_Task_dispatchTime(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Task_displayName(ctx, field)
+			case "displayStatus":
+				return ec.fieldContext_Task_displayStatus(ctx, field)
+			case "displayOnly":
+				return ec.fieldContext_Task_displayOnly(ctx, field)
+			case "displayTask":
+				return ec.fieldContext_Task_displayTask(ctx, field)
+			case "distroId":
+				return ec.fieldContext_Task_distroId(ctx, field)
+			case "estimatedStart":
+				return ec.fieldContext_Task_estimatedStart(ctx, field)
 			case "execution":
-				return ec.fieldContext_HostEventLogData_execution(ctx, field)
-			case "hostname":
-				return ec.fieldContext_HostEventLogData_hostname(ctx, field)
-			case "jasperRevision":
-				return ec.fieldContext_HostEventLogData_jasperRevision(ctx, field)
+				return ec.fieldContext_Task_execution(ctx, field)
+			case "executionTasks":
+				return ec.fieldContext_Task_executionTasks(ctx, field)
+			case "executionTasksFull":
+				return ec.fieldContext_Task_executionTasksFull(ctx, field)
+			case "expectedDuration":
+				return ec.fieldContext_Task_expectedDuration(ctx, field)
+			case "failedTestCount":
+				return ec.fieldContext_Task_failedTestCount(ctx, field)
+			case "finishTime":
+				return ec.fieldContext_Task_finishTime(ctx, field)
+			case "files":
+				return ec.fieldContext_Task_files(ctx, field)
+			case "generatedBy":
+				return ec.fieldContext_Task_generatedBy(ctx, field)
+			case "generatedByName":
+				return ec.fieldContext_Task_generatedByName(ctx, field)
+			case "generateTask":
+				return ec.fieldContext_Task_generateTask(ctx, field)
+			case "hasCedarResults":
+				return ec.fieldContext_Task_hasCedarResults(ctx, field)
+			case "hostId":
+				return ec.fieldContext_Task_hostId(ctx, field)
+			case "imageId":
+				return ec.fieldContext_Task_imageId(ctx, field)
+			case "ingestTime":
+				return ec.fieldContext_Task_ingestTime(ctx, field)
+			case "isPerfPluginEnabled":
+				return ec.fieldContext_Task_isPerfPluginEnabled(ctx, field)
+			case "latestExecution":
+				return ec.fieldContext_Task_latestExecution(ctx, field)
 			case "logs":
-				return ec.fieldContext_HostEventLogData_logs(ctx, field)
-			case "monitorOp":
-				return ec.fieldContext_HostEventLogData_monitorOp(ctx, field)
-			case "newStatus":
-				return ec.fieldContext_HostEventLogData_newStatus(ctx, field)
-			case "oldStatus":
-				return ec.fieldContext_HostEventLogData_oldStatus(ctx, field)
-			case "provisioningMethod":
-				return ec.fieldContext_HostEventLogData_provisioningMethod(ctx, field)
-			case "successful":
-				return ec.fieldContext_HostEventLogData_successful(ctx, field)
-			case "taskId":
-				return ec.fieldContext_HostEventLogData_taskId(ctx, field)
-			case "taskPid":
-				return ec.fieldContext_HostEventLogData_taskPid(ctx, field)
-			case "taskStatus":
-				return ec.fieldContext_HostEventLogData_taskStatus(ctx, field)
-			case "user":
-				return ec.fieldContext_HostEventLogData_user(ctx, field)
+				return ec.fieldContext_Task_logs(ctx, field)
+			case "minQueuePosition":
+				return ec.fieldContext_Task_minQueuePosition(ctx, field)
+			case "order":
+				return ec.fieldContext_Task_order(ctx, field)
+			case "patch":
+				return ec.fieldContext_Task_patch(ctx, field)
+			case "patchNumber":
+				return ec.fieldContext_Task_patchNumber(ctx, field)
+			case "pod":
+				return ec.fieldContext_Task_pod(ctx, field)
+			case "priority":
+				return ec.fieldContext_Task_priority(ctx, field)
+			case "project":
+				return ec.fieldContext_Task_project(ctx, field)
+			case "projectId":
+				return ec.fieldContext_Task_projectId(ctx, field)
+			case "projectIdentifier":
+				return ec.fieldContext_Task_projectIdentifier(ctx, field)
+			case "requester":
+				return ec.fieldContext_Task_requester(ctx, field)
+			case "resetWhenFinished":
+				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "revision":
+				return ec.fieldContext_Task_revision(ctx, field)
+			case "scheduledTime":
+				return ec.fieldContext_Task_scheduledTime(ctx, field)
+			case "spawnHostLink":
+				return ec.fieldContext_Task_spawnHostLink(ctx, field)
+			case "startTime":
+				return ec.fieldContext_Task_startTime(ctx, field)
+			case "status":
+				return ec.fieldContext_Task_status(ctx, field)
+			case "tags":
+				return ec.fieldContext_Task_tags(ctx, field)
+			case "taskGroup":
+				return ec.fieldContext_Task_taskGroup(ctx, field)
+			case "taskGroupMaxHosts":
+				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
+			case "taskLogs":
+				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "tests":
+				return ec.fieldContext_Task_tests(ctx, field)
+			case "timeTaken":
+				return ec.fieldContext_Task_timeTaken(ctx, field)
+			case "totalTestCount":
+				return ec.fieldContext_Task_totalTestCount(ctx, field)
+			case "versionMetadata":
+				return ec.fieldContext_Task_versionMetadata(ctx, field)
+			case "stepbackInfo":
+				return ec.fieldContext_Task_stepbackInfo(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type HostEventLogData", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Task", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogEntry_eventType(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogEntry_eventType(ctx, field)
+func (ec *executionContext) _Image_operatingSystem(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_operatingSystem(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27612,35 +30140,57 @@ func (ec *executionContext) _HostEventLogEntry_eventType(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventType, nil
+		return ec.resolvers.Image().OperatingSystem(rctx, obj, fc.Args["opts"].(thirdparty.OSInfoFilterOptions))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*ImageOperatingSystemPayload)
 	fc.Result = res
-	return ec.marshalOHostEventType2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNImageOperatingSystemPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐImageOperatingSystemPayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogEntry_eventType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_operatingSystem(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogEntry",
+		Object:     "Image",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type HostEventType does not have child fields")
+			switch field.Name {
+			case "data":
+				return ec.fieldContext_ImageOperatingSystemPayload_data(ctx, field)
+			case "filteredCount":
+				return ec.fieldContext_ImageOperatingSystemPayload_filteredCount(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_ImageOperatingSystemPayload_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageOperatingSystemPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Image_operatingSystem_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogEntry_processedAt(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogEntry_processedAt(ctx, field)
+func (ec *executionContext) _Image_packages(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_packages(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27653,7 +30203,7 @@ func (ec *executionContext) _HostEventLogEntry_processedAt(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProcessedAt, nil
+		return ec.resolvers.Image().Packages(rctx, obj, fc.Args["opts"].(thirdparty.PackageFilterOptions))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27665,26 +30215,45 @@ func (ec *executionContext) _HostEventLogEntry_processedAt(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*ImagePackagesPayload)
 	fc.Result = res
-	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNImagePackagesPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐImagePackagesPayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogEntry_processedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_packages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogEntry",
+		Object:     "Image",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "data":
+				return ec.fieldContext_ImagePackagesPayload_data(ctx, field)
+			case "filteredCount":
+				return ec.fieldContext_ImagePackagesPayload_filteredCount(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_ImagePackagesPayload_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImagePackagesPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Image_packages_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogEntry_resourceId(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogEntry_resourceId(ctx, field)
+func (ec *executionContext) _Image_toolchains(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_toolchains(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27697,7 +30266,7 @@ func (ec *executionContext) _HostEventLogEntry_resourceId(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResourceId, nil
+		return ec.resolvers.Image().Toolchains(rctx, obj, fc.Args["opts"].(thirdparty.ToolchainFilterOptions))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27709,26 +30278,45 @@ func (ec *executionContext) _HostEventLogEntry_resourceId(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*ImageToolchainsPayload)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNImageToolchainsPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐImageToolchainsPayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogEntry_resourceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_toolchains(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogEntry",
+		Object:     "Image",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "data":
+				return ec.fieldContext_ImageToolchainsPayload_data(ctx, field)
+			case "filteredCount":
+				return ec.fieldContext_ImageToolchainsPayload_filteredCount(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_ImageToolchainsPayload_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageToolchainsPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Image_toolchains_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogEntry_resourceType(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogEntry_resourceType(ctx, field)
+func (ec *executionContext) _ImageEvent_entries(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEvent_entries(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27741,7 +30329,7 @@ func (ec *executionContext) _HostEventLogEntry_resourceType(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResourceType, nil
+		return obj.Entries, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27753,26 +30341,38 @@ func (ec *executionContext) _HostEventLogEntry_resourceType(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]model.APIImageEventEntry)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNImageEventEntry2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIImageEventEntryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogEntry_resourceType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEvent_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogEntry",
+		Object:     "ImageEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_ImageEventEntry_name(ctx, field)
+			case "after":
+				return ec.fieldContext_ImageEventEntry_after(ctx, field)
+			case "before":
+				return ec.fieldContext_ImageEventEntry_before(ctx, field)
+			case "type":
+				return ec.fieldContext_ImageEventEntry_type(ctx, field)
+			case "action":
+				return ec.fieldContext_ImageEventEntry_action(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageEventEntry", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEventLogEntry_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.HostAPIEventLogEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEventLogEntry_timestamp(ctx, field)
+func (ec *executionContext) _ImageEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEvent_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27792,16 +30392,19 @@ func (ec *executionContext) _HostEventLogEntry_timestamp(ctx context.Context, fi
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEventLogEntry_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEvent_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEventLogEntry",
+		Object:     "ImageEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -27812,8 +30415,8 @@ func (ec *executionContext) fieldContext_HostEventLogEntry_timestamp(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEvents_count(ctx context.Context, field graphql.CollectedField, obj *HostEvents) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEvents_count(ctx, field)
+func (ec *executionContext) _ImageEvent_amiBefore(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEvent_amiBefore(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27826,38 +30429,35 @@ func (ec *executionContext) _HostEvents_count(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.AMIBefore, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEvents_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEvent_amiBefore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEvents",
+		Object:     "ImageEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostEvents_eventLogEntries(ctx context.Context, field graphql.CollectedField, obj *HostEvents) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostEvents_eventLogEntries(ctx, field)
+func (ec *executionContext) _ImageEvent_amiAfter(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEvent_amiAfter(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27870,7 +30470,7 @@ func (ec *executionContext) _HostEvents_eventLogEntries(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventLogEntries, nil
+		return obj.AMIAfter, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27882,42 +30482,26 @@ func (ec *executionContext) _HostEvents_eventLogEntries(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.HostAPIEventLogEntry)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNHostEventLogEntry2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐHostAPIEventLogEntryᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostEvents_eventLogEntries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEvent_amiAfter(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostEvents",
+		Object:     "ImageEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_HostEventLogEntry_id(ctx, field)
-			case "data":
-				return ec.fieldContext_HostEventLogEntry_data(ctx, field)
-			case "eventType":
-				return ec.fieldContext_HostEventLogEntry_eventType(ctx, field)
-			case "processedAt":
-				return ec.fieldContext_HostEventLogEntry_processedAt(ctx, field)
-			case "resourceId":
-				return ec.fieldContext_HostEventLogEntry_resourceId(ctx, field)
-			case "resourceType":
-				return ec.fieldContext_HostEventLogEntry_resourceType(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_HostEventLogEntry_timestamp(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type HostEventLogEntry", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostsResponse_filteredHostsCount(ctx context.Context, field graphql.CollectedField, obj *HostsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostsResponse_filteredHostsCount(ctx, field)
+func (ec *executionContext) _ImageEventEntry_name(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEventEntry_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27930,35 +30514,38 @@ func (ec *executionContext) _HostsResponse_filteredHostsCount(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FilteredHostsCount, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostsResponse_filteredHostsCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEventEntry_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostsResponse",
+		Object:     "ImageEventEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostsResponse_hosts(ctx context.Context, field graphql.CollectedField, obj *HostsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostsResponse_hosts(ctx, field)
+func (ec *executionContext) _ImageEventEntry_after(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEventEntry_after(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -27971,7 +30558,7 @@ func (ec *executionContext) _HostsResponse_hosts(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hosts, nil
+		return obj.After, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -27983,84 +30570,26 @@ func (ec *executionContext) _HostsResponse_hosts(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APIHost)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNHost2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIHostᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostsResponse_hosts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEventEntry_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostsResponse",
+		Object:     "ImageEventEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Host_id(ctx, field)
-			case "availabilityZone":
-				return ec.fieldContext_Host_availabilityZone(ctx, field)
-			case "ami":
-				return ec.fieldContext_Host_ami(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Host_displayName(ctx, field)
-			case "distro":
-				return ec.fieldContext_Host_distro(ctx, field)
-			case "distroId":
-				return ec.fieldContext_Host_distroId(ctx, field)
-			case "elapsed":
-				return ec.fieldContext_Host_elapsed(ctx, field)
-			case "events":
-				return ec.fieldContext_Host_events(ctx, field)
-			case "eventTypes":
-				return ec.fieldContext_Host_eventTypes(ctx, field)
-			case "expiration":
-				return ec.fieldContext_Host_expiration(ctx, field)
-			case "hostUrl":
-				return ec.fieldContext_Host_hostUrl(ctx, field)
-			case "homeVolume":
-				return ec.fieldContext_Host_homeVolume(ctx, field)
-			case "homeVolumeID":
-				return ec.fieldContext_Host_homeVolumeID(ctx, field)
-			case "instanceType":
-				return ec.fieldContext_Host_instanceType(ctx, field)
-			case "instanceTags":
-				return ec.fieldContext_Host_instanceTags(ctx, field)
-			case "lastCommunicationTime":
-				return ec.fieldContext_Host_lastCommunicationTime(ctx, field)
-			case "noExpiration":
-				return ec.fieldContext_Host_noExpiration(ctx, field)
-			case "persistentDnsName":
-				return ec.fieldContext_Host_persistentDnsName(ctx, field)
-			case "provider":
-				return ec.fieldContext_Host_provider(ctx, field)
-			case "runningTask":
-				return ec.fieldContext_Host_runningTask(ctx, field)
-			case "sleepSchedule":
-				return ec.fieldContext_Host_sleepSchedule(ctx, field)
-			case "startedBy":
-				return ec.fieldContext_Host_startedBy(ctx, field)
-			case "status":
-				return ec.fieldContext_Host_status(ctx, field)
-			case "tag":
-				return ec.fieldContext_Host_tag(ctx, field)
-			case "totalIdleTime":
-				return ec.fieldContext_Host_totalIdleTime(ctx, field)
-			case "uptime":
-				return ec.fieldContext_Host_uptime(ctx, field)
-			case "user":
-				return ec.fieldContext_Host_user(ctx, field)
-			case "volumes":
-				return ec.fieldContext_Host_volumes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Host", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HostsResponse_totalHostsCount(ctx context.Context, field graphql.CollectedField, obj *HostsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HostsResponse_totalHostsCount(ctx, field)
+func (ec *executionContext) _ImageEventEntry_before(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEventEntry_before(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28073,7 +30602,7 @@ func (ec *executionContext) _HostsResponse_totalHostsCount(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalHostsCount, nil
+		return obj.Before, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28085,26 +30614,26 @@ func (ec *executionContext) _HostsResponse_totalHostsCount(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HostsResponse_totalHostsCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEventEntry_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HostsResponse",
+		Object:     "ImageEventEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IceCreamSettings_configPath(ctx context.Context, field graphql.CollectedField, obj *model.APIIceCreamSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IceCreamSettings_configPath(ctx, field)
+func (ec *executionContext) _ImageEventEntry_type(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEventEntry_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28117,7 +30646,7 @@ func (ec *executionContext) _IceCreamSettings_configPath(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ConfigPath, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28129,26 +30658,26 @@ func (ec *executionContext) _IceCreamSettings_configPath(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(thirdparty.ImageEventType)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNImageEventType2githubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐImageEventType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IceCreamSettings_configPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEventEntry_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IceCreamSettings",
+		Object:     "ImageEventEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ImageEventType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IceCreamSettings_schedulerHost(ctx context.Context, field graphql.CollectedField, obj *model.APIIceCreamSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IceCreamSettings_schedulerHost(ctx, field)
+func (ec *executionContext) _ImageEventEntry_action(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEventEntry_action(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28161,7 +30690,7 @@ func (ec *executionContext) _IceCreamSettings_schedulerHost(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SchedulerHost, nil
+		return obj.Action, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28173,26 +30702,26 @@ func (ec *executionContext) _IceCreamSettings_schedulerHost(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(thirdparty.ImageEventEntryAction)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNImageEventEntryAction2githubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐImageEventEntryAction(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IceCreamSettings_schedulerHost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEventEntry_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IceCreamSettings",
+		Object:     "ImageEventEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ImageEventEntryAction does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_id(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_id(ctx, field)
+func (ec *executionContext) _ImageEventsPayload_count(ctx context.Context, field graphql.CollectedField, obj *ImageEventsPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEventsPayload_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28205,7 +30734,7 @@ func (ec *executionContext) _Image_id(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28217,26 +30746,26 @@ func (ec *executionContext) _Image_id(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEventsPayload_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "ImageEventsPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_ami(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_ami(ctx, field)
+func (ec *executionContext) _ImageEventsPayload_eventLogEntries(ctx context.Context, field graphql.CollectedField, obj *ImageEventsPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageEventsPayload_eventLogEntries(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28249,7 +30778,7 @@ func (ec *executionContext) _Image_ami(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AMI, nil
+		return obj.EventLogEntries, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28261,26 +30790,36 @@ func (ec *executionContext) _Image_ami(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.APIImageEvent)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNImageEvent2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIImageEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_ami(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageEventsPayload_eventLogEntries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "ImageEventsPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "entries":
+				return ec.fieldContext_ImageEvent_entries(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_ImageEvent_timestamp(ctx, field)
+			case "amiBefore":
+				return ec.fieldContext_ImageEvent_amiBefore(ctx, field)
+			case "amiAfter":
+				return ec.fieldContext_ImageEvent_amiAfter(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageEvent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_distros(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_distros(ctx, field)
+func (ec *executionContext) _ImageOperatingSystemPayload_data(ctx context.Context, field graphql.CollectedField, obj *ImageOperatingSystemPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageOperatingSystemPayload_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28293,7 +30832,7 @@ func (ec *executionContext) _Image_distros(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Image().Distros(rctx, obj)
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28305,94 +30844,32 @@ func (ec *executionContext) _Image_distros(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APIDistro)
+	res := resTmp.([]*model.APIOSInfo)
 	fc.Result = res
-	return ec.marshalNDistro2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDistroᚄ(ctx, field.Selections, res)
+	return ec.marshalNOSInfo2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIOSInfoᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_distros(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageOperatingSystemPayload_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "ImageOperatingSystemPayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "adminOnly":
-				return ec.fieldContext_Distro_adminOnly(ctx, field)
-			case "aliases":
-				return ec.fieldContext_Distro_aliases(ctx, field)
-			case "arch":
-				return ec.fieldContext_Distro_arch(ctx, field)
-			case "authorizedKeysFile":
-				return ec.fieldContext_Distro_authorizedKeysFile(ctx, field)
-			case "bootstrapSettings":
-				return ec.fieldContext_Distro_bootstrapSettings(ctx, field)
-			case "containerPool":
-				return ec.fieldContext_Distro_containerPool(ctx, field)
-			case "disabled":
-				return ec.fieldContext_Distro_disabled(ctx, field)
-			case "disableShallowClone":
-				return ec.fieldContext_Distro_disableShallowClone(ctx, field)
-			case "dispatcherSettings":
-				return ec.fieldContext_Distro_dispatcherSettings(ctx, field)
-			case "execUser":
-				return ec.fieldContext_Distro_execUser(ctx, field)
-			case "expansions":
-				return ec.fieldContext_Distro_expansions(ctx, field)
-			case "finderSettings":
-				return ec.fieldContext_Distro_finderSettings(ctx, field)
-			case "homeVolumeSettings":
-				return ec.fieldContext_Distro_homeVolumeSettings(ctx, field)
-			case "hostAllocatorSettings":
-				return ec.fieldContext_Distro_hostAllocatorSettings(ctx, field)
-			case "iceCreamSettings":
-				return ec.fieldContext_Distro_iceCreamSettings(ctx, field)
-			case "imageId":
-				return ec.fieldContext_Distro_imageId(ctx, field)
-			case "isCluster":
-				return ec.fieldContext_Distro_isCluster(ctx, field)
-			case "isVirtualWorkStation":
-				return ec.fieldContext_Distro_isVirtualWorkStation(ctx, field)
-			case "mountpoints":
-				return ec.fieldContext_Distro_mountpoints(ctx, field)
-			case "name":
-				return ec.fieldContext_Distro_name(ctx, field)
-			case "note":
-				return ec.fieldContext_Distro_note(ctx, field)
-			case "plannerSettings":
-				return ec.fieldContext_Distro_plannerSettings(ctx, field)
-			case "provider":
-				return ec.fieldContext_Distro_provider(ctx, field)
-			case "providerSettingsList":
-				return ec.fieldContext_Distro_providerSettingsList(ctx, field)
-			case "setup":
-				return ec.fieldContext_Distro_setup(ctx, field)
-			case "setupAsSudo":
-				return ec.fieldContext_Distro_setupAsSudo(ctx, field)
-			case "singleTaskDistro":
-				return ec.fieldContext_Distro_singleTaskDistro(ctx, field)
-			case "sshOptions":
-				return ec.fieldContext_Distro_sshOptions(ctx, field)
-			case "user":
-				return ec.fieldContext_Distro_user(ctx, field)
-			case "userSpawnAllowed":
-				return ec.fieldContext_Distro_userSpawnAllowed(ctx, field)
-			case "validProjects":
-				return ec.fieldContext_Distro_validProjects(ctx, field)
-			case "warningNote":
-				return ec.fieldContext_Distro_warningNote(ctx, field)
-			case "workDir":
-				return ec.fieldContext_Distro_workDir(ctx, field)
+			case "name":
+				return ec.fieldContext_OSInfo_name(ctx, field)
+			case "version":
+				return ec.fieldContext_OSInfo_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Distro", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type OSInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_events(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_events(ctx, field)
+func (ec *executionContext) _ImageOperatingSystemPayload_filteredCount(ctx context.Context, field graphql.CollectedField, obj *ImageOperatingSystemPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageOperatingSystemPayload_filteredCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28405,7 +30882,7 @@ func (ec *executionContext) _Image_events(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Image().Events(rctx, obj, fc.Args["limit"].(int), fc.Args["page"].(int))
+		return obj.FilteredCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28417,43 +30894,26 @@ func (ec *executionContext) _Image_events(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*ImageEventsPayload)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNImageEventsPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐImageEventsPayload(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageOperatingSystemPayload_filteredCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "ImageOperatingSystemPayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "count":
-				return ec.fieldContext_ImageEventsPayload_count(ctx, field)
-			case "eventLogEntries":
-				return ec.fieldContext_ImageEventsPayload_eventLogEntries(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ImageEventsPayload", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Image_events_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_lastDeployed(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_lastDeployed(ctx, field)
+func (ec *executionContext) _ImageOperatingSystemPayload_totalCount(ctx context.Context, field graphql.CollectedField, obj *ImageOperatingSystemPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageOperatingSystemPayload_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28466,7 +30926,7 @@ func (ec *executionContext) _Image_lastDeployed(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastDeployed, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28478,26 +30938,26 @@ func (ec *executionContext) _Image_lastDeployed(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_lastDeployed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageOperatingSystemPayload_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "ImageOperatingSystemPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_latestTask(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_latestTask(ctx, field)
+func (ec *executionContext) _ImagePackagesPayload_data(ctx context.Context, field graphql.CollectedField, obj *ImagePackagesPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImagePackagesPayload_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28510,187 +30970,46 @@ func (ec *executionContext) _Image_latestTask(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Image().LatestTask(rctx, obj)
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.APITask)
+	res := resTmp.([]*model.APIPackage)
 	fc.Result = res
-	return ec.marshalOTask2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITask(ctx, field.Selections, res)
+	return ec.marshalNPackage2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPackageᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_latestTask(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImagePackagesPayload_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "ImagePackagesPayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Task_id(ctx, field)
-			case "aborted":
-				return ec.fieldContext_Task_aborted(ctx, field)
-			case "abortInfo":
-				return ec.fieldContext_Task_abortInfo(ctx, field)
-			case "activated":
-				return ec.fieldContext_Task_activated(ctx, field)
-			case "activatedBy":
-				return ec.fieldContext_Task_activatedBy(ctx, field)
-			case "activatedTime":
-				return ec.fieldContext_Task_activatedTime(ctx, field)
-			case "ami":
-				return ec.fieldContext_Task_ami(ctx, field)
-			case "annotation":
-				return ec.fieldContext_Task_annotation(ctx, field)
-			case "baseStatus":
-				return ec.fieldContext_Task_baseStatus(ctx, field)
-			case "baseTask":
-				return ec.fieldContext_Task_baseTask(ctx, field)
-			case "blocked":
-				return ec.fieldContext_Task_blocked(ctx, field)
-			case "buildId":
-				return ec.fieldContext_Task_buildId(ctx, field)
-			case "buildVariant":
-				return ec.fieldContext_Task_buildVariant(ctx, field)
-			case "buildVariantDisplayName":
-				return ec.fieldContext_Task_buildVariantDisplayName(ctx, field)
-			case "canAbort":
-				return ec.fieldContext_Task_canAbort(ctx, field)
-			case "canDisable":
-				return ec.fieldContext_Task_canDisable(ctx, field)
-			case "canModifyAnnotation":
-				return ec.fieldContext_Task_canModifyAnnotation(ctx, field)
-			case "canOverrideDependencies":
-				return ec.fieldContext_Task_canOverrideDependencies(ctx, field)
-			case "canRestart":
-				return ec.fieldContext_Task_canRestart(ctx, field)
-			case "canSchedule":
-				return ec.fieldContext_Task_canSchedule(ctx, field)
-			case "canSetPriority":
-				return ec.fieldContext_Task_canSetPriority(ctx, field)
-			case "canUnschedule":
-				return ec.fieldContext_Task_canUnschedule(ctx, field)
-			case "containerAllocatedTime":
-				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
-			case "createTime":
-				return ec.fieldContext_Task_createTime(ctx, field)
-			case "dependsOn":
-				return ec.fieldContext_Task_dependsOn(ctx, field)
-			case "details":
-				return ec.fieldContext_Task_details(ctx, field)
-			case "dispatchTime":
-				return ec.fieldContext_Task_dispatchTime(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Task_displayName(ctx, field)
-			case "displayStatus":
-				return ec.fieldContext_Task_displayStatus(ctx, field)
-			case "displayOnly":
-				return ec.fieldContext_Task_displayOnly(ctx, field)
-			case "displayTask":
-				return ec.fieldContext_Task_displayTask(ctx, field)
-			case "distroId":
-				return ec.fieldContext_Task_distroId(ctx, field)
-			case "estimatedStart":
-				return ec.fieldContext_Task_estimatedStart(ctx, field)
-			case "execution":
-				return ec.fieldContext_Task_execution(ctx, field)
-			case "executionTasks":
-				return ec.fieldContext_Task_executionTasks(ctx, field)
-			case "executionTasksFull":
-				return ec.fieldContext_Task_executionTasksFull(ctx, field)
-			case "expectedDuration":
-				return ec.fieldContext_Task_expectedDuration(ctx, field)
-			case "failedTestCount":
-				return ec.fieldContext_Task_failedTestCount(ctx, field)
-			case "finishTime":
-				return ec.fieldContext_Task_finishTime(ctx, field)
-			case "files":
-				return ec.fieldContext_Task_files(ctx, field)
-			case "generatedBy":
-				return ec.fieldContext_Task_generatedBy(ctx, field)
-			case "generatedByName":
-				return ec.fieldContext_Task_generatedByName(ctx, field)
-			case "generateTask":
-				return ec.fieldContext_Task_generateTask(ctx, field)
-			case "hasCedarResults":
-				return ec.fieldContext_Task_hasCedarResults(ctx, field)
-			case "hostId":
-				return ec.fieldContext_Task_hostId(ctx, field)
-			case "imageId":
-				return ec.fieldContext_Task_imageId(ctx, field)
-			case "ingestTime":
-				return ec.fieldContext_Task_ingestTime(ctx, field)
-			case "isPerfPluginEnabled":
-				return ec.fieldContext_Task_isPerfPluginEnabled(ctx, field)
-			case "latestExecution":
-				return ec.fieldContext_Task_latestExecution(ctx, field)
-			case "logs":
-				return ec.fieldContext_Task_logs(ctx, field)
-			case "minQueuePosition":
-				return ec.fieldContext_Task_minQueuePosition(ctx, field)
-			case "order":
-				return ec.fieldContext_Task_order(ctx, field)
-			case "patch":
-				return ec.fieldContext_Task_patch(ctx, field)
-			case "patchNumber":
-				return ec.fieldContext_Task_patchNumber(ctx, field)
-			case "pod":
-				return ec.fieldContext_Task_pod(ctx, field)
-			case "priority":
-				return ec.fieldContext_Task_priority(ctx, field)
-			case "project":
-				return ec.fieldContext_Task_project(ctx, field)
-			case "projectId":
-				return ec.fieldContext_Task_projectId(ctx, field)
-			case "projectIdentifier":
-				return ec.fieldContext_Task_projectIdentifier(ctx, field)
-			case "requester":
-				return ec.fieldContext_Task_requester(ctx, field)
-			case "resetWhenFinished":
-				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
-			case "revision":
-				return ec.fieldContext_Task_revision(ctx, field)
-			case "scheduledTime":
-				return ec.fieldContext_Task_scheduledTime(ctx, field)
-			case "spawnHostLink":
-				return ec.fieldContext_Task_spawnHostLink(ctx, field)
-			case "startTime":
-				return ec.fieldContext_Task_startTime(ctx, field)
-			case "status":
-				return ec.fieldContext_Task_status(ctx, field)
-			case "tags":
-				return ec.fieldContext_Task_tags(ctx, field)
-			case "taskGroup":
-				return ec.fieldContext_Task_taskGroup(ctx, field)
-			case "taskGroupMaxHosts":
-				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
-			case "taskLogs":
-				return ec.fieldContext_Task_taskLogs(ctx, field)
-			case "tests":
-				return ec.fieldContext_Task_tests(ctx, field)
-			case "timeTaken":
-				return ec.fieldContext_Task_timeTaken(ctx, field)
-			case "totalTestCount":
-				return ec.fieldContext_Task_totalTestCount(ctx, field)
-			case "versionMetadata":
-				return ec.fieldContext_Task_versionMetadata(ctx, field)
-			case "stepbackInfo":
-				return ec.fieldContext_Task_stepbackInfo(ctx, field)
+			case "name":
+				return ec.fieldContext_Package_name(ctx, field)
+			case "manager":
+				return ec.fieldContext_Package_manager(ctx, field)
+			case "version":
+				return ec.fieldContext_Package_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Task", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Package", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_operatingSystem(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_operatingSystem(ctx, field)
+func (ec *executionContext) _ImagePackagesPayload_filteredCount(ctx context.Context, field graphql.CollectedField, obj *ImagePackagesPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImagePackagesPayload_filteredCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28703,7 +31022,7 @@ func (ec *executionContext) _Image_operatingSystem(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Image().OperatingSystem(rctx, obj, fc.Args["opts"].(thirdparty.OSInfoFilterOptions))
+		return obj.FilteredCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28715,45 +31034,26 @@ func (ec *executionContext) _Image_operatingSystem(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*ImageOperatingSystemPayload)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNImageOperatingSystemPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐImageOperatingSystemPayload(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_operatingSystem(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImagePackagesPayload_filteredCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "ImagePackagesPayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "data":
-				return ec.fieldContext_ImageOperatingSystemPayload_data(ctx, field)
-			case "filteredCount":
-				return ec.fieldContext_ImageOperatingSystemPayload_filteredCount(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_ImageOperatingSystemPayload_totalCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ImageOperatingSystemPayload", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Image_operatingSystem_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_packages(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_packages(ctx, field)
+func (ec *executionContext) _ImagePackagesPayload_totalCount(ctx context.Context, field graphql.CollectedField, obj *ImagePackagesPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImagePackagesPayload_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28766,7 +31066,7 @@ func (ec *executionContext) _Image_packages(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Image().Packages(rctx, obj, fc.Args["opts"].(thirdparty.PackageFilterOptions))
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28778,45 +31078,26 @@ func (ec *executionContext) _Image_packages(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*ImagePackagesPayload)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNImagePackagesPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐImagePackagesPayload(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_packages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImagePackagesPayload_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "ImagePackagesPayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "data":
-				return ec.fieldContext_ImagePackagesPayload_data(ctx, field)
-			case "filteredCount":
-				return ec.fieldContext_ImagePackagesPayload_filteredCount(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_ImagePackagesPayload_totalCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ImagePackagesPayload", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Image_packages_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_toolchains(ctx context.Context, field graphql.CollectedField, obj *model.APIImage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_toolchains(ctx, field)
+func (ec *executionContext) _ImageToolchainsPayload_data(ctx context.Context, field graphql.CollectedField, obj *ImageToolchainsPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageToolchainsPayload_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28829,7 +31110,7 @@ func (ec *executionContext) _Image_toolchains(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Image().Toolchains(rctx, obj, fc.Args["opts"].(thirdparty.ToolchainFilterOptions))
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28841,45 +31122,78 @@ func (ec *executionContext) _Image_toolchains(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*ImageToolchainsPayload)
+	res := resTmp.([]*model.APIToolchain)
 	fc.Result = res
-	return ec.marshalNImageToolchainsPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐImageToolchainsPayload(ctx, field.Selections, res)
+	return ec.marshalNToolchain2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIToolchainᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_toolchains(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageToolchainsPayload_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "ImageToolchainsPayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "data":
-				return ec.fieldContext_ImageToolchainsPayload_data(ctx, field)
-			case "filteredCount":
-				return ec.fieldContext_ImageToolchainsPayload_filteredCount(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_ImageToolchainsPayload_totalCount(ctx, field)
+			case "name":
+				return ec.fieldContext_Toolchain_name(ctx, field)
+			case "path":
+				return ec.fieldContext_Toolchain_path(ctx, field)
+			case "version":
+				return ec.fieldContext_Toolchain_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ImageToolchainsPayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Toolchain", field.Name)
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImageToolchainsPayload_filteredCount(ctx context.Context, field graphql.CollectedField, obj *ImageToolchainsPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageToolchainsPayload_filteredCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Image_toolchains_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FilteredCount, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ImageToolchainsPayload_filteredCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImageToolchainsPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEvent_entries(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEvent_entries(ctx, field)
+func (ec *executionContext) _ImageToolchainsPayload_totalCount(ctx context.Context, field graphql.CollectedField, obj *ImageToolchainsPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageToolchainsPayload_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28892,7 +31206,7 @@ func (ec *executionContext) _ImageEvent_entries(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Entries, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28904,38 +31218,26 @@ func (ec *executionContext) _ImageEvent_entries(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.APIImageEventEntry)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNImageEventEntry2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIImageEventEntryᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEvent_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageToolchainsPayload_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEvent",
+		Object:     "ImageToolchainsPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_ImageEventEntry_name(ctx, field)
-			case "after":
-				return ec.fieldContext_ImageEventEntry_after(ctx, field)
-			case "before":
-				return ec.fieldContext_ImageEventEntry_before(ctx, field)
-			case "type":
-				return ec.fieldContext_ImageEventEntry_type(ctx, field)
-			case "action":
-				return ec.fieldContext_ImageEventEntry_action(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ImageEventEntry", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEvent_timestamp(ctx, field)
+func (ec *executionContext) _InstanceTag_canBeModified(ctx context.Context, field graphql.CollectedField, obj *host.Tag) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InstanceTag_canBeModified(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28948,7 +31250,7 @@ func (ec *executionContext) _ImageEvent_timestamp(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Timestamp, nil
+		return obj.CanBeModified, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -28960,26 +31262,26 @@ func (ec *executionContext) _ImageEvent_timestamp(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEvent_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_InstanceTag_canBeModified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEvent",
+		Object:     "InstanceTag",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEvent_amiBefore(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEvent_amiBefore(ctx, field)
+func (ec *executionContext) _InstanceTag_key(ctx context.Context, field graphql.CollectedField, obj *host.Tag) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InstanceTag_key(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -28992,23 +31294,26 @@ func (ec *executionContext) _ImageEvent_amiBefore(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AMIBefore, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEvent_amiBefore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_InstanceTag_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEvent",
+		Object:     "InstanceTag",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -29019,8 +31324,8 @@ func (ec *executionContext) fieldContext_ImageEvent_amiBefore(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEvent_amiAfter(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEvent_amiAfter(ctx, field)
+func (ec *executionContext) _InstanceTag_value(ctx context.Context, field graphql.CollectedField, obj *host.Tag) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InstanceTag_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29033,7 +31338,7 @@ func (ec *executionContext) _ImageEvent_amiAfter(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AMIAfter, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29045,14 +31350,14 @@ func (ec *executionContext) _ImageEvent_amiAfter(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEvent_amiAfter(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_InstanceTag_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEvent",
+		Object:     "InstanceTag",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -29063,8 +31368,8 @@ func (ec *executionContext) fieldContext_ImageEvent_amiAfter(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEventEntry_name(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEventEntry_name(ctx, field)
+func (ec *executionContext) _IssueLink_confidenceScore(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IssueLink_confidenceScore(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29077,26 +31382,64 @@ func (ec *executionContext) _ImageEventEntry_name(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ConfidenceScore, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_IssueLink_confidenceScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IssueLink",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IssueLink_issueKey(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IssueLink_issueKey(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IssueKey, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEventEntry_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IssueLink_issueKey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEventEntry",
+		Object:     "IssueLink",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -29107,8 +31450,8 @@ func (ec *executionContext) fieldContext_ImageEventEntry_name(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEventEntry_after(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEventEntry_after(ctx, field)
+func (ec *executionContext) _IssueLink_jiraTicket(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IssueLink_jiraTicket(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29121,38 +31464,41 @@ func (ec *executionContext) _ImageEventEntry_after(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.After, nil
+		return ec.resolvers.IssueLink().JiraTicket(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*thirdparty.JiraTicket)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOJiraTicket2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐJiraTicket(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEventEntry_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IssueLink_jiraTicket(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEventEntry",
+		Object:     "IssueLink",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "fields":
+				return ec.fieldContext_JiraTicket_fields(ctx, field)
+			case "key":
+				return ec.fieldContext_JiraTicket_key(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type JiraTicket", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEventEntry_before(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEventEntry_before(ctx, field)
+func (ec *executionContext) _IssueLink_source(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IssueLink_source(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29165,38 +31511,43 @@ func (ec *executionContext) _ImageEventEntry_before(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Before, nil
+		return obj.Source, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.APISource)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOSource2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEventEntry_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IssueLink_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEventEntry",
+		Object:     "IssueLink",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "author":
+				return ec.fieldContext_Source_author(ctx, field)
+			case "requester":
+				return ec.fieldContext_Source_requester(ctx, field)
+			case "time":
+				return ec.fieldContext_Source_time(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEventEntry_type(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEventEntry_type(ctx, field)
+func (ec *executionContext) _IssueLink_url(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IssueLink_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29209,38 +31560,35 @@ func (ec *executionContext) _ImageEventEntry_type(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.URL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(thirdparty.ImageEventType)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNImageEventType2githubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐImageEventType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEventEntry_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IssueLink_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEventEntry",
+		Object:     "IssueLink",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ImageEventType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEventEntry_action(ctx context.Context, field graphql.CollectedField, obj *model.APIImageEventEntry) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEventEntry_action(ctx, field)
+func (ec *executionContext) _JiraConfig_email(ctx context.Context, field graphql.CollectedField, obj *model.APIJiraConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraConfig_email(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29253,38 +31601,35 @@ func (ec *executionContext) _ImageEventEntry_action(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Action, nil
+		return obj.Email, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(thirdparty.ImageEventEntryAction)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNImageEventEntryAction2githubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐImageEventEntryAction(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEventEntry_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraConfig_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEventEntry",
+		Object:     "JiraConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ImageEventEntryAction does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEventsPayload_count(ctx context.Context, field graphql.CollectedField, obj *ImageEventsPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEventsPayload_count(ctx, field)
+func (ec *executionContext) _JiraConfig_host(ctx context.Context, field graphql.CollectedField, obj *model.APIJiraConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraConfig_host(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29297,38 +31642,35 @@ func (ec *executionContext) _ImageEventsPayload_count(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.Host, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEventsPayload_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraConfig_host(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEventsPayload",
+		Object:     "JiraConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageEventsPayload_eventLogEntries(ctx context.Context, field graphql.CollectedField, obj *ImageEventsPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageEventsPayload_eventLogEntries(ctx, field)
+func (ec *executionContext) _JiraIssueSubscriber_issueType(ctx context.Context, field graphql.CollectedField, obj *model.APIJIRAIssueSubscriber) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueSubscriber_issueType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29341,7 +31683,7 @@ func (ec *executionContext) _ImageEventsPayload_eventLogEntries(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventLogEntries, nil
+		return obj.IssueType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29353,36 +31695,26 @@ func (ec *executionContext) _ImageEventsPayload_eventLogEntries(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APIImageEvent)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNImageEvent2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIImageEventᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageEventsPayload_eventLogEntries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueSubscriber_issueType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageEventsPayload",
+		Object:     "JiraIssueSubscriber",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "entries":
-				return ec.fieldContext_ImageEvent_entries(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_ImageEvent_timestamp(ctx, field)
-			case "amiBefore":
-				return ec.fieldContext_ImageEvent_amiBefore(ctx, field)
-			case "amiAfter":
-				return ec.fieldContext_ImageEvent_amiAfter(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ImageEvent", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageOperatingSystemPayload_data(ctx context.Context, field graphql.CollectedField, obj *ImageOperatingSystemPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageOperatingSystemPayload_data(ctx, field)
+func (ec *executionContext) _JiraIssueSubscriber_project(ctx context.Context, field graphql.CollectedField, obj *model.APIJIRAIssueSubscriber) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueSubscriber_project(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29395,7 +31727,7 @@ func (ec *executionContext) _ImageOperatingSystemPayload_data(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return obj.Project, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29407,32 +31739,26 @@ func (ec *executionContext) _ImageOperatingSystemPayload_data(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APIOSInfo)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNOSInfo2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIOSInfoᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageOperatingSystemPayload_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueSubscriber_project(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageOperatingSystemPayload",
+		Object:     "JiraIssueSubscriber",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_OSInfo_name(ctx, field)
-			case "version":
-				return ec.fieldContext_OSInfo_version(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OSInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageOperatingSystemPayload_filteredCount(ctx context.Context, field graphql.CollectedField, obj *ImageOperatingSystemPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageOperatingSystemPayload_filteredCount(ctx, field)
+func (ec *executionContext) _JiraStatus_id(ctx context.Context, field graphql.CollectedField, obj *thirdparty.JiraStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraStatus_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29445,7 +31771,7 @@ func (ec *executionContext) _ImageOperatingSystemPayload_filteredCount(ctx conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FilteredCount, nil
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29457,26 +31783,26 @@ func (ec *executionContext) _ImageOperatingSystemPayload_filteredCount(ctx conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageOperatingSystemPayload_filteredCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraStatus_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageOperatingSystemPayload",
+		Object:     "JiraStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageOperatingSystemPayload_totalCount(ctx context.Context, field graphql.CollectedField, obj *ImageOperatingSystemPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageOperatingSystemPayload_totalCount(ctx, field)
+func (ec *executionContext) _JiraStatus_name(ctx context.Context, field graphql.CollectedField, obj *thirdparty.JiraStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraStatus_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29489,7 +31815,7 @@ func (ec *executionContext) _ImageOperatingSystemPayload_totalCount(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29501,26 +31827,26 @@ func (ec *executionContext) _ImageOperatingSystemPayload_totalCount(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageOperatingSystemPayload_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraStatus_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageOperatingSystemPayload",
+		Object:     "JiraStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImagePackagesPayload_data(ctx context.Context, field graphql.CollectedField, obj *ImagePackagesPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImagePackagesPayload_data(ctx, field)
+func (ec *executionContext) _JiraTicket_fields(ctx context.Context, field graphql.CollectedField, obj *thirdparty.JiraTicket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraTicket_fields(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29533,7 +31859,7 @@ func (ec *executionContext) _ImagePackagesPayload_data(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return obj.Fields, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29545,34 +31871,42 @@ func (ec *executionContext) _ImagePackagesPayload_data(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APIPackage)
+	res := resTmp.(*thirdparty.TicketFields)
 	fc.Result = res
-	return ec.marshalNPackage2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPackageᚄ(ctx, field.Selections, res)
+	return ec.marshalNTicketFields2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐTicketFields(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImagePackagesPayload_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraTicket_fields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImagePackagesPayload",
+		Object:     "JiraTicket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_Package_name(ctx, field)
-			case "manager":
-				return ec.fieldContext_Package_manager(ctx, field)
-			case "version":
-				return ec.fieldContext_Package_version(ctx, field)
+			case "assignedTeam":
+				return ec.fieldContext_TicketFields_assignedTeam(ctx, field)
+			case "assigneeDisplayName":
+				return ec.fieldContext_TicketFields_assigneeDisplayName(ctx, field)
+			case "created":
+				return ec.fieldContext_TicketFields_created(ctx, field)
+			case "resolutionName":
+				return ec.fieldContext_TicketFields_resolutionName(ctx, field)
+			case "status":
+				return ec.fieldContext_TicketFields_status(ctx, field)
+			case "summary":
+				return ec.fieldContext_TicketFields_summary(ctx, field)
+			case "updated":
+				return ec.fieldContext_TicketFields_updated(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Package", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TicketFields", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImagePackagesPayload_filteredCount(ctx context.Context, field graphql.CollectedField, obj *ImagePackagesPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImagePackagesPayload_filteredCount(ctx, field)
+func (ec *executionContext) _JiraTicket_key(ctx context.Context, field graphql.CollectedField, obj *thirdparty.JiraTicket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraTicket_key(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29585,7 +31919,7 @@ func (ec *executionContext) _ImagePackagesPayload_filteredCount(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FilteredCount, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29597,26 +31931,26 @@ func (ec *executionContext) _ImagePackagesPayload_filteredCount(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImagePackagesPayload_filteredCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraTicket_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImagePackagesPayload",
+		Object:     "JiraTicket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImagePackagesPayload_totalCount(ctx context.Context, field graphql.CollectedField, obj *ImagePackagesPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImagePackagesPayload_totalCount(ctx, field)
+func (ec *executionContext) _LogMessage_message(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogMessage_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29629,38 +31963,35 @@ func (ec *executionContext) _ImagePackagesPayload_totalCount(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImagePackagesPayload_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogMessage_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImagePackagesPayload",
+		Object:     "LogMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageToolchainsPayload_data(ctx context.Context, field graphql.CollectedField, obj *ImageToolchainsPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageToolchainsPayload_data(ctx, field)
+func (ec *executionContext) _LogMessage_severity(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogMessage_severity(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29673,46 +32004,35 @@ func (ec *executionContext) _ImageToolchainsPayload_data(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return obj.Severity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APIToolchain)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNToolchain2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIToolchainᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageToolchainsPayload_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogMessage_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageToolchainsPayload",
+		Object:     "LogMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_Toolchain_name(ctx, field)
-			case "path":
-				return ec.fieldContext_Toolchain_path(ctx, field)
-			case "version":
-				return ec.fieldContext_Toolchain_version(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Toolchain", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageToolchainsPayload_filteredCount(ctx context.Context, field graphql.CollectedField, obj *ImageToolchainsPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageToolchainsPayload_filteredCount(ctx, field)
+func (ec *executionContext) _LogMessage_timestamp(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogMessage_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29725,38 +32045,35 @@ func (ec *executionContext) _ImageToolchainsPayload_filteredCount(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FilteredCount, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageToolchainsPayload_filteredCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogMessage_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageToolchainsPayload",
+		Object:     "LogMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageToolchainsPayload_totalCount(ctx context.Context, field graphql.CollectedField, obj *ImageToolchainsPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageToolchainsPayload_totalCount(ctx, field)
+func (ec *executionContext) _LogMessage_type(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogMessage_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29769,38 +32086,35 @@ func (ec *executionContext) _ImageToolchainsPayload_totalCount(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageToolchainsPayload_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogMessage_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageToolchainsPayload",
+		Object:     "LogMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _InstanceTag_canBeModified(ctx context.Context, field graphql.CollectedField, obj *host.Tag) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_InstanceTag_canBeModified(ctx, field)
+func (ec *executionContext) _LogMessage_version(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogMessage_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29813,38 +32127,35 @@ func (ec *executionContext) _InstanceTag_canBeModified(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CanBeModified, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_InstanceTag_canBeModified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogMessage_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "InstanceTag",
+		Object:     "LogMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _InstanceTag_key(ctx context.Context, field graphql.CollectedField, obj *host.Tag) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_InstanceTag_key(ctx, field)
+func (ec *executionContext) _LogSearchMatch_execution(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchMatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchMatch_execution(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29857,7 +32168,7 @@ func (ec *executionContext) _InstanceTag_key(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Execution, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29869,26 +32180,26 @@ func (ec *executionContext) _InstanceTag_key(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_InstanceTag_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchMatch_execution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "InstanceTag",
+		Object:     "LogSearchMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _InstanceTag_value(ctx context.Context, field graphql.CollectedField, obj *host.Tag) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_InstanceTag_value(ctx, field)
+func (ec *executionContext) _LogSearchMatch_lineNumber(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchMatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchMatch_lineNumber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29901,7 +32212,7 @@ func (ec *executionContext) _InstanceTag_value(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.LineNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29913,26 +32224,26 @@ func (ec *executionContext) _InstanceTag_value(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_InstanceTag_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchMatch_lineNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "InstanceTag",
+		Object:     "LogSearchMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IssueLink_confidenceScore(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IssueLink_confidenceScore(ctx, field)
+func (ec *executionContext) _LogSearchMatch_logType(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchMatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchMatch_logType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29945,7 +32256,7 @@ func (ec *executionContext) _IssueLink_confidenceScore(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ConfidenceScore, nil
+		return obj.LogType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -29954,26 +32265,26 @@ func (ec *executionContext) _IssueLink_confidenceScore(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IssueLink_confidenceScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchMatch_logType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IssueLink",
+		Object:     "LogSearchMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IssueLink_issueKey(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IssueLink_issueKey(ctx, field)
+func (ec *executionContext) _LogSearchMatch_message(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchMatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchMatch_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -29986,7 +32297,7 @@ func (ec *executionContext) _IssueLink_issueKey(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IssueKey, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30000,9 +32311,9 @@ func (ec *executionContext) _IssueLink_issueKey(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IssueLink_issueKey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchMatch_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IssueLink",
+		Object:     "LogSearchMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -30013,8 +32324,8 @@ func (ec *executionContext) fieldContext_IssueLink_issueKey(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _IssueLink_jiraTicket(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IssueLink_jiraTicket(ctx, field)
+func (ec *executionContext) _LogSearchMatch_severity(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchMatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchMatch_severity(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30027,7 +32338,7 @@ func (ec *executionContext) _IssueLink_jiraTicket(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.IssueLink().JiraTicket(rctx, obj)
+		return obj.Severity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30036,32 +32347,26 @@ func (ec *executionContext) _IssueLink_jiraTicket(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*thirdparty.JiraTicket)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOJiraTicket2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐJiraTicket(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IssueLink_jiraTicket(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchMatch_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IssueLink",
+		Object:     "LogSearchMatch",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "fields":
-				return ec.fieldContext_JiraTicket_fields(ctx, field)
-			case "key":
-				return ec.fieldContext_JiraTicket_key(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type JiraTicket", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IssueLink_source(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IssueLink_source(ctx, field)
+func (ec *executionContext) _LogSearchMatch_taskId(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchMatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchMatch_taskId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30074,43 +32379,38 @@ func (ec *executionContext) _IssueLink_source(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Source, nil
+		return obj.TaskID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.APISource)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOSource2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISource(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IssueLink_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchMatch_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IssueLink",
+		Object:     "LogSearchMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "author":
-				return ec.fieldContext_Source_author(ctx, field)
-			case "requester":
-				return ec.fieldContext_Source_requester(ctx, field)
-			case "time":
-				return ec.fieldContext_Source_time(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IssueLink_url(ctx context.Context, field graphql.CollectedField, obj *model.APIIssueLink) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IssueLink_url(ctx, field)
+func (ec *executionContext) _LogSearchMatch_testLogPath(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchMatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchMatch_testLogPath(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30123,7 +32423,7 @@ func (ec *executionContext) _IssueLink_url(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return obj.TestLogPath, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30137,9 +32437,9 @@ func (ec *executionContext) _IssueLink_url(ctx context.Context, field graphql.Co
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IssueLink_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchMatch_testLogPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IssueLink",
+		Object:     "LogSearchMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -30150,8 +32450,8 @@ func (ec *executionContext) fieldContext_IssueLink_url(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraConfig_email(ctx context.Context, field graphql.CollectedField, obj *model.APIJiraConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraConfig_email(ctx, field)
+func (ec *executionContext) _LogSearchMatch_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchMatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchMatch_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30164,7 +32464,7 @@ func (ec *executionContext) _JiraConfig_email(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Email, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30173,26 +32473,26 @@ func (ec *executionContext) _JiraConfig_email(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraConfig_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchMatch_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraConfig",
+		Object:     "LogSearchMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraConfig_host(ctx context.Context, field graphql.CollectedField, obj *model.APIJiraConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraConfig_host(ctx, field)
+func (ec *executionContext) _LogSection_id(ctx context.Context, field graphql.CollectedField, obj *model.APILogSection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSection_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30205,23 +32505,26 @@ func (ec *executionContext) _JiraConfig_host(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Host, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraConfig_host(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSection_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraConfig",
+		Object:     "LogSection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -30232,8 +32535,8 @@ func (ec *executionContext) fieldContext_JiraConfig_host(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueSubscriber_issueType(ctx context.Context, field graphql.CollectedField, obj *model.APIJIRAIssueSubscriber) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueSubscriber_issueType(ctx, field)
+func (ec *executionContext) _LogSection_children(ctx context.Context, field graphql.CollectedField, obj *model.APILogSection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSection_children(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30246,7 +32549,7 @@ func (ec *executionContext) _JiraIssueSubscriber_issueType(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IssueType, nil
+		return obj.Children, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30258,26 +32561,44 @@ func (ec *executionContext) _JiraIssueSubscriber_issueType(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]model.APILogSection)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLogSection2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSectionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueSubscriber_issueType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSection_children(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueSubscriber",
+		Object:     "LogSection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LogSection_id(ctx, field)
+			case "children":
+				return ec.fieldContext_LogSection_children(ctx, field)
+			case "duration":
+				return ec.fieldContext_LogSection_duration(ctx, field)
+			case "end":
+				return ec.fieldContext_LogSection_end(ctx, field)
+			case "name":
+				return ec.fieldContext_LogSection_name(ctx, field)
+			case "start":
+				return ec.fieldContext_LogSection_start(ctx, field)
+			case "status":
+				return ec.fieldContext_LogSection_status(ctx, field)
+			case "type":
+				return ec.fieldContext_LogSection_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogSection", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueSubscriber_project(ctx context.Context, field graphql.CollectedField, obj *model.APIJIRAIssueSubscriber) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueSubscriber_project(ctx, field)
+func (ec *executionContext) _LogSection_duration(ctx context.Context, field graphql.CollectedField, obj *model.APILogSection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSection_duration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30290,7 +32611,7 @@ func (ec *executionContext) _JiraIssueSubscriber_project(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Project, nil
+		return obj.Duration, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30302,26 +32623,26 @@ func (ec *executionContext) _JiraIssueSubscriber_project(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.APIDuration)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueSubscriber_project(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSection_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueSubscriber",
+		Object:     "LogSection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Duration does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraStatus_id(ctx context.Context, field graphql.CollectedField, obj *thirdparty.JiraStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraStatus_id(ctx, field)
+func (ec *executionContext) _LogSection_end(ctx context.Context, field graphql.CollectedField, obj *model.APILogSection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSection_end(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30334,38 +32655,35 @@ func (ec *executionContext) _JiraStatus_id(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Id, nil
+		return obj.End, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraStatus_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSection_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraStatus",
+		Object:     "LogSection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraStatus_name(ctx context.Context, field graphql.CollectedField, obj *thirdparty.JiraStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraStatus_name(ctx, field)
+func (ec *executionContext) _LogSection_name(ctx context.Context, field graphql.CollectedField, obj *model.APILogSection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSection_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30390,14 +32708,14 @@ func (ec *executionContext) _JiraStatus_name(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraStatus_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSection_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraStatus",
+		Object:     "LogSection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -30408,8 +32726,8 @@ func (ec *executionContext) fieldContext_JiraStatus_name(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraTicket_fields(ctx context.Context, field graphql.CollectedField, obj *thirdparty.JiraTicket) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraTicket_fields(ctx, field)
+func (ec *executionContext) _LogSection_start(ctx context.Context, field graphql.CollectedField, obj *model.APILogSection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSection_start(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30422,7 +32740,7 @@ func (ec *executionContext) _JiraTicket_fields(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields, nil
+		return obj.Start, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30434,42 +32752,26 @@ func (ec *executionContext) _JiraTicket_fields(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*thirdparty.TicketFields)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNTicketFields2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐTicketFields(ctx, field.Selections, res)
+	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraTicket_fields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSection_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraTicket",
+		Object:     "LogSection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "assignedTeam":
-				return ec.fieldContext_TicketFields_assignedTeam(ctx, field)
-			case "assigneeDisplayName":
-				return ec.fieldContext_TicketFields_assigneeDisplayName(ctx, field)
-			case "created":
-				return ec.fieldContext_TicketFields_created(ctx, field)
-			case "resolutionName":
-				return ec.fieldContext_TicketFields_resolutionName(ctx, field)
-			case "status":
-				return ec.fieldContext_TicketFields_status(ctx, field)
-			case "summary":
-				return ec.fieldContext_TicketFields_summary(ctx, field)
-			case "updated":
-				return ec.fieldContext_TicketFields_updated(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TicketFields", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraTicket_key(ctx context.Context, field graphql.CollectedField, obj *thirdparty.JiraTicket) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraTicket_key(ctx, field)
+func (ec *executionContext) _LogSection_status(ctx context.Context, field graphql.CollectedField, obj *model.APILogSection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSection_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30482,7 +32784,7 @@ func (ec *executionContext) _JiraTicket_key(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30494,96 +32796,14 @@ func (ec *executionContext) _JiraTicket_key(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_JiraTicket_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "JiraTicket",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LogMessage_message(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogMessage_message(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_LogMessage_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "LogMessage",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LogMessage_severity(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogMessage_severity(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Severity, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogMessage_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSection_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogMessage",
+		Object:     "LogSection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -30594,49 +32814,8 @@ func (ec *executionContext) fieldContext_LogMessage_severity(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _LogMessage_timestamp(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogMessage_timestamp(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Timestamp, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_LogMessage_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "LogMessage",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LogMessage_type(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogMessage_type(ctx, field)
+func (ec *executionContext) _LogSection_type(ctx context.Context, field graphql.CollectedField, obj *model.APILogSection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSection_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30656,62 +32835,24 @@ func (ec *executionContext) _LogMessage_type(ctx context.Context, field graphql.
 		return graphql.Null
 	}
 	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_LogMessage_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "LogMessage",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LogMessage_version(ctx context.Context, field graphql.CollectedField, obj *apimodels.LogMessage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogMessage_version(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogMessage_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSection_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogMessage",
+		Object:     "LogSection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
@@ -31080,6 +33221,8 @@ func (ec *executionContext) fieldContext_LogkeeperBuild_task(_ context.Context,
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -35539,6 +37682,8 @@ func (ec *executionContext) fieldContext_Mutation_abortTask(ctx context.Context,
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -35746,6 +37891,8 @@ func (ec *executionContext) fieldContext_Mutation_overrideTaskDependencies(ctx c
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -35953,6 +38100,8 @@ func (ec *executionContext) fieldContext_Mutation_restartTask(ctx context.Contex
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -36160,6 +38309,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleTasks(ctx context.Cont
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -36367,6 +38518,8 @@ func (ec *executionContext) fieldContext_Mutation_setTaskPriority(ctx context.Co
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -36574,6 +38727,8 @@ func (ec *executionContext) fieldContext_Mutation_unscheduleTask(ctx context.Con
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -37730,6 +39885,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleUndispatchedBaseTasks(
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -42941,6 +45098,8 @@ func (ec *executionContext) fieldContext_Pod_task(_ context.Context, field graph
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -43482,6 +45641,8 @@ func (ec *executionContext) fieldContext_PodEventLogData_task(_ context.Context,
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -50171,6 +52332,79 @@ func (ec *executionContext) fieldContext_Query_projectSettings(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_projectCost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_projectCost(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProjectCost(rctx, fc.Args["projectIdentifier"].(string), fc.Args["startDate"].(*time.Time), fc.Args["endDate"].(*time.Time))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.APICostReport)
+	fc.Result = res
+	return ec.marshalNCostReport2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostReport(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_projectCost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "byBuildVariant":
+				return ec.fieldContext_CostReport_byBuildVariant(ctx, field)
+			case "byDay":
+				return ec.fieldContext_CostReport_byDay(ctx, field)
+			case "byUser":
+				return ec.fieldContext_CostReport_byUser(ctx, field)
+			case "endDate":
+				return ec.fieldContext_CostReport_endDate(ctx, field)
+			case "spawnHostCost":
+				return ec.fieldContext_CostReport_spawnHostCost(ctx, field)
+			case "startDate":
+				return ec.fieldContext_CostReport_startDate(ctx, field)
+			case "taskCost":
+				return ec.fieldContext_CostReport_taskCost(ctx, field)
+			case "totalCost":
+				return ec.fieldContext_CostReport_totalCost(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CostReport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_projectCost_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_repoEvents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_repoEvents(ctx, field)
 	if err != nil {
@@ -50735,6 +52969,8 @@ func (ec *executionContext) fieldContext_Query_task(ctx context.Context, field g
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -50942,6 +53178,8 @@ func (ec *executionContext) fieldContext_Query_taskAllExecutions(ctx context.Con
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -51306,6 +53544,79 @@ func (ec *executionContext) fieldContext_Query_userConfig(_ context.Context, fie
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_logSearch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_logSearch(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().LogSearch(rctx, fc.Args["opts"].(LogSearchInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APILogSearchMatch)
+	fc.Result = res
+	return ec.marshalNLogSearchMatch2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSearchMatchᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_logSearch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "execution":
+				return ec.fieldContext_LogSearchMatch_execution(ctx, field)
+			case "lineNumber":
+				return ec.fieldContext_LogSearchMatch_lineNumber(ctx, field)
+			case "logType":
+				return ec.fieldContext_LogSearchMatch_logType(ctx, field)
+			case "message":
+				return ec.fieldContext_LogSearchMatch_message(ctx, field)
+			case "severity":
+				return ec.fieldContext_LogSearchMatch_severity(ctx, field)
+			case "taskId":
+				return ec.fieldContext_LogSearchMatch_taskId(ctx, field)
+			case "testLogPath":
+				return ec.fieldContext_LogSearchMatch_testLogPath(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_LogSearchMatch_timestamp(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogSearchMatch", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_logSearch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_buildVariantsForTaskName(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_buildVariantsForTaskName(ctx, field)
 	if err != nil {
@@ -57832,6 +60143,8 @@ func (ec *executionContext) fieldContext_Task_baseTask(_ context.Context, field
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -58470,6 +60783,70 @@ func (ec *executionContext) fieldContext_Task_canUnschedule(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _Task_commandProfiles(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Task_commandProfiles(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Task().CommandProfiles(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APICommandProfile)
+	fc.Result = res
+	return ec.marshalNCommandProfile2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICommandProfileᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Task_commandProfiles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Task",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "block":
+				return ec.fieldContext_CommandProfile_block(ctx, field)
+			case "command":
+				return ec.fieldContext_CommandProfile_command(ctx, field)
+			case "cpuSeconds":
+				return ec.fieldContext_CommandProfile_cpuSeconds(ctx, field)
+			case "diskReadBytes":
+				return ec.fieldContext_CommandProfile_diskReadBytes(ctx, field)
+			case "diskWriteBytes":
+				return ec.fieldContext_CommandProfile_diskWriteBytes(ctx, field)
+			case "duration":
+				return ec.fieldContext_CommandProfile_duration(ctx, field)
+			case "endTime":
+				return ec.fieldContext_CommandProfile_endTime(ctx, field)
+			case "peakRssBytes":
+				return ec.fieldContext_CommandProfile_peakRssBytes(ctx, field)
+			case "startTime":
+				return ec.fieldContext_CommandProfile_startTime(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CommandProfile", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Task_containerAllocatedTime(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 	if err != nil {
@@ -58916,6 +61293,8 @@ func (ec *executionContext) fieldContext_Task_displayTask(_ context.Context, fie
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -59279,6 +61658,8 @@ func (ec *executionContext) fieldContext_Task_executionTasksFull(_ context.Conte
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -61032,6 +63413,8 @@ func (ec *executionContext) fieldContext_Task_taskLogs(_ context.Context, field
 				return ec.fieldContext_TaskLogs_eventLogs(ctx, field)
 			case "execution":
 				return ec.fieldContext_TaskLogs_execution(ctx, field)
+			case "sections":
+				return ec.fieldContext_TaskLogs_sections(ctx, field)
 			case "systemLogs":
 				return ec.fieldContext_TaskLogs_systemLogs(ctx, field)
 			case "taskId":
@@ -63363,6 +65746,68 @@ func (ec *executionContext) fieldContext_TaskLogs_execution(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _TaskLogs_sections(ctx context.Context, field graphql.CollectedField, obj *TaskLogs) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskLogs_sections(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.TaskLogs().Sections(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APILogSection)
+	fc.Result = res
+	return ec.marshalNLogSection2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSectionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskLogs_sections(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskLogs",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LogSection_id(ctx, field)
+			case "children":
+				return ec.fieldContext_LogSection_children(ctx, field)
+			case "duration":
+				return ec.fieldContext_LogSection_duration(ctx, field)
+			case "end":
+				return ec.fieldContext_LogSection_end(ctx, field)
+			case "name":
+				return ec.fieldContext_LogSection_name(ctx, field)
+			case "start":
+				return ec.fieldContext_LogSection_start(ctx, field)
+			case "status":
+				return ec.fieldContext_LogSection_status(ctx, field)
+			case "type":
+				return ec.fieldContext_LogSection_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogSection", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TaskLogs_systemLogs(ctx context.Context, field graphql.CollectedField, obj *TaskLogs) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TaskLogs_systemLogs(ctx, field)
 	if err != nil {
@@ -66802,6 +69247,8 @@ func (ec *executionContext) fieldContext_UpstreamProject_task(_ context.Context,
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -70848,6 +73295,8 @@ func (ec *executionContext) fieldContext_VersionTasks_data(_ context.Context, fi
 				return ec.fieldContext_Task_canSetPriority(ctx, field)
 			case "canUnschedule":
 				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "commandProfiles":
+				return ec.fieldContext_Task_commandProfiles(ctx, field)
 			case "containerAllocatedTime":
 				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
 			case "createTime":
@@ -77648,6 +80097,190 @@ func (ec *executionContext) unmarshalInputJiraIssueSubscriberInput(ctx context.C
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLogSearchInput(ctx context.Context, obj any) (LogSearchInput, error) {
+	var it LogSearchInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["caseSensitive"]; !present {
+		asMap["caseSensitive"] = false
+	}
+	if _, present := asMap["days"]; !present {
+		asMap["days"] = 1
+	}
+	if _, present := asMap["exactMatch"]; !present {
+		asMap["exactMatch"] = true
+	}
+	if _, present := asMap["limit"]; !present {
+		asMap["limit"] = 100
+	}
+
+	fieldsInOrder := [...]string{"buildVariant", "caseSensitive", "days", "exactMatch", "execution", "expression", "limit", "projectIdentifier", "taskId", "versionId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "buildVariant":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("buildVariant"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BuildVariant = data
+		case "caseSensitive":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("caseSensitive"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CaseSensitive = data
+		case "days":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("days"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Days = data
+		case "exactMatch":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exactMatch"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExactMatch = data
+		case "execution":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("execution"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Execution = data
+		case "expression":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expression"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Expression = data
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "projectIdentifier":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectIdentifier"))
+			directive0 := func(ctx context.Context) (any, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+
+			directive1 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
+				if err != nil {
+					var zeroVal *string
+					return zeroVal, err
+				}
+				access, err := ec.unmarshalNAccessLevel2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐAccessLevel(ctx, "VIEW")
+				if err != nil {
+					var zeroVal *string
+					return zeroVal, err
+				}
+				if ec.directives.RequireProjectAccess == nil {
+					var zeroVal *string
+					return zeroVal, errors.New("directive requireProjectAccess is not implemented")
+				}
+				return ec.directives.RequireProjectAccess(ctx, obj, directive0, permission, access)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.ProjectIdentifier = data
+			} else if tmp == nil {
+				it.ProjectIdentifier = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "taskId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("taskId"))
+			directive0 := func(ctx context.Context) (any, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+
+			directive1 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
+				if err != nil {
+					var zeroVal *string
+					return zeroVal, err
+				}
+				access, err := ec.unmarshalNAccessLevel2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐAccessLevel(ctx, "VIEW")
+				if err != nil {
+					var zeroVal *string
+					return zeroVal, err
+				}
+				if ec.directives.RequireProjectAccess == nil {
+					var zeroVal *string
+					return zeroVal, errors.New("directive requireProjectAccess is not implemented")
+				}
+				return ec.directives.RequireProjectAccess(ctx, obj, directive0, permission, access)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.TaskID = data
+			} else if tmp == nil {
+				it.TaskID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "versionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("versionId"))
+			directive0 := func(ctx context.Context) (any, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+
+			directive1 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
+				if err != nil {
+					var zeroVal *string
+					return zeroVal, err
+				}
+				access, err := ec.unmarshalNAccessLevel2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐAccessLevel(ctx, "VIEW")
+				if err != nil {
+					var zeroVal *string
+					return zeroVal, err
+				}
+				if ec.directives.RequireProjectAccess == nil {
+					var zeroVal *string
+					return zeroVal, errors.New("directive requireProjectAccess is not implemented")
+				}
+				return ec.directives.RequireProjectAccess(ctx, obj, directive0, permission, access)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.VersionID = data
+			} else if tmp == nil {
+				it.VersionID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputMainlineCommitsOptions(ctx context.Context, obj any) (MainlineCommitsOptions, error) {
 	var it MainlineCommitsOptions
 	asMap := map[string]any{}
@@ -82201,80 +84834,150 @@ func (ec *executionContext) _ClientBinary(ctx context.Context, sel ast.Selection
 			out.Values[i] = ec._ClientBinary_os(ctx, field, obj)
 		case "url":
 			out.Values[i] = ec._ClientBinary_url(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var clientConfigImplementors = []string{"ClientConfig"}
-
-func (ec *executionContext) _ClientConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APIClientConfig) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, clientConfigImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ClientConfig")
-		case "clientBinaries":
-			out.Values[i] = ec._ClientConfig_clientBinaries(ctx, field, obj)
-		case "latestRevision":
-			out.Values[i] = ec._ClientConfig_latestRevision(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var cloudProviderConfigImplementors = []string{"CloudProviderConfig"}
-
-func (ec *executionContext) _CloudProviderConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APICloudProviders) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cloudProviderConfigImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CloudProviderConfig")
-		case "aws":
-			out.Values[i] = ec._CloudProviderConfig_aws(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var clientConfigImplementors = []string{"ClientConfig"}
+
+func (ec *executionContext) _ClientConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APIClientConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, clientConfigImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ClientConfig")
+		case "clientBinaries":
+			out.Values[i] = ec._ClientConfig_clientBinaries(ctx, field, obj)
+		case "latestRevision":
+			out.Values[i] = ec._ClientConfig_latestRevision(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var cloudProviderConfigImplementors = []string{"CloudProviderConfig"}
+
+func (ec *executionContext) _CloudProviderConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APICloudProviders) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cloudProviderConfigImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CloudProviderConfig")
+		case "aws":
+			out.Values[i] = ec._CloudProviderConfig_aws(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var commandProfileImplementors = []string{"CommandProfile"}
+
+func (ec *executionContext) _CommandProfile(ctx context.Context, sel ast.SelectionSet, obj *model.APICommandProfile) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, commandProfileImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CommandProfile")
+		case "block":
+			out.Values[i] = ec._CommandProfile_block(ctx, field, obj)
+		case "command":
+			out.Values[i] = ec._CommandProfile_command(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cpuSeconds":
+			out.Values[i] = ec._CommandProfile_cpuSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "diskReadBytes":
+			out.Values[i] = ec._CommandProfile_diskReadBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "diskWriteBytes":
+			out.Values[i] = ec._CommandProfile_diskWriteBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "duration":
+			out.Values[i] = ec._CommandProfile_duration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endTime":
+			out.Values[i] = ec._CommandProfile_endTime(ctx, field, obj)
+		case "peakRssBytes":
+			out.Values[i] = ec._CommandProfile_peakRssBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startTime":
+			out.Values[i] = ec._CommandProfile_startTime(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -82517,6 +85220,129 @@ func (ec *executionContext) _ContainerResources(ctx context.Context, sel ast.Sel
 	return out
 }
 
+var costBreakdownImplementors = []string{"CostBreakdown"}
+
+func (ec *executionContext) _CostBreakdown(ctx context.Context, sel ast.SelectionSet, obj *model.APICostBreakdown) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, costBreakdownImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CostBreakdown")
+		case "cost":
+			out.Values[i] = ec._CostBreakdown_cost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._CostBreakdown_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "runtime":
+			out.Values[i] = ec._CostBreakdown_runtime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var costReportImplementors = []string{"CostReport"}
+
+func (ec *executionContext) _CostReport(ctx context.Context, sel ast.SelectionSet, obj *model.APICostReport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, costReportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CostReport")
+		case "byBuildVariant":
+			out.Values[i] = ec._CostReport_byBuildVariant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "byDay":
+			out.Values[i] = ec._CostReport_byDay(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "byUser":
+			out.Values[i] = ec._CostReport_byUser(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endDate":
+			out.Values[i] = ec._CostReport_endDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "spawnHostCost":
+			out.Values[i] = ec._CostReport_spawnHostCost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startDate":
+			out.Values[i] = ec._CostReport_startDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskCost":
+			out.Values[i] = ec._CostReport_taskCost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalCost":
+			out.Values[i] = ec._CostReport_totalCost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var deleteDistroPayloadImplementors = []string{"DeleteDistroPayload"}
 
 func (ec *executionContext) _DeleteDistroPayload(ctx context.Context, sel ast.SelectionSet, obj *DeleteDistroPayload) graphql.Marshaler {
@@ -83441,6 +86267,11 @@ func (ec *executionContext) _File(ctx context.Context, sel ast.SelectionSet, obj
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("File")
+		case "expired":
+			out.Values[i] = ec._File_expired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "link":
 			out.Values[i] = ec._File_link(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -86026,27 +88857,157 @@ func (ec *executionContext) _JiraTicket(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var logMessageImplementors = []string{"LogMessage"}
+var logMessageImplementors = []string{"LogMessage"}
+
+func (ec *executionContext) _LogMessage(ctx context.Context, sel ast.SelectionSet, obj *apimodels.LogMessage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logMessageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LogMessage")
+		case "message":
+			out.Values[i] = ec._LogMessage_message(ctx, field, obj)
+		case "severity":
+			out.Values[i] = ec._LogMessage_severity(ctx, field, obj)
+		case "timestamp":
+			out.Values[i] = ec._LogMessage_timestamp(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec._LogMessage_type(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._LogMessage_version(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var logSearchMatchImplementors = []string{"LogSearchMatch"}
+
+func (ec *executionContext) _LogSearchMatch(ctx context.Context, sel ast.SelectionSet, obj *model.APILogSearchMatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logSearchMatchImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LogSearchMatch")
+		case "execution":
+			out.Values[i] = ec._LogSearchMatch_execution(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lineNumber":
+			out.Values[i] = ec._LogSearchMatch_lineNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "logType":
+			out.Values[i] = ec._LogSearchMatch_logType(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._LogSearchMatch_message(ctx, field, obj)
+		case "severity":
+			out.Values[i] = ec._LogSearchMatch_severity(ctx, field, obj)
+		case "taskId":
+			out.Values[i] = ec._LogSearchMatch_taskId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "testLogPath":
+			out.Values[i] = ec._LogSearchMatch_testLogPath(ctx, field, obj)
+		case "timestamp":
+			out.Values[i] = ec._LogSearchMatch_timestamp(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var logSectionImplementors = []string{"LogSection"}
 
-func (ec *executionContext) _LogMessage(ctx context.Context, sel ast.SelectionSet, obj *apimodels.LogMessage) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, logMessageImplementors)
+func (ec *executionContext) _LogSection(ctx context.Context, sel ast.SelectionSet, obj *model.APILogSection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logSectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LogMessage")
-		case "message":
-			out.Values[i] = ec._LogMessage_message(ctx, field, obj)
-		case "severity":
-			out.Values[i] = ec._LogMessage_severity(ctx, field, obj)
-		case "timestamp":
-			out.Values[i] = ec._LogMessage_timestamp(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("LogSection")
+		case "id":
+			out.Values[i] = ec._LogSection_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "children":
+			out.Values[i] = ec._LogSection_children(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "duration":
+			out.Values[i] = ec._LogSection_duration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "end":
+			out.Values[i] = ec._LogSection_end(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._LogSection_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "start":
+			out.Values[i] = ec._LogSection_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._LogSection_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "type":
-			out.Values[i] = ec._LogMessage_type(ctx, field, obj)
-		case "version":
-			out.Values[i] = ec._LogMessage_version(ctx, field, obj)
+			out.Values[i] = ec._LogSection_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -90462,6 +93423,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "projectCost":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_projectCost(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "repoEvents":
 			field := field
@@ -90739,6 +93722,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "logSearch":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_logSearch(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "buildVariantsForTaskName":
 			field := field
@@ -92325,56 +95330,197 @@ func (ec *executionContext) _SubscriberWrapper(ctx context.Context, sel ast.Sele
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "type":
-			out.Values[i] = ec._SubscriberWrapper_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var taskImplementors = []string{"Task"}
-
-func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj *model.APITask) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, taskImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Task")
-		case "id":
-			out.Values[i] = ec._Task_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "aborted":
-			out.Values[i] = ec._Task_aborted(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "abortInfo":
+		case "type":
+			out.Values[i] = ec._SubscriberWrapper_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var taskImplementors = []string{"Task"}
+
+func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj *model.APITask) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taskImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Task")
+		case "id":
+			out.Values[i] = ec._Task_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "aborted":
+			out.Values[i] = ec._Task_aborted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "abortInfo":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Task_abortInfo(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "activated":
+			out.Values[i] = ec._Task_activated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "activatedBy":
+			out.Values[i] = ec._Task_activatedBy(ctx, field, obj)
+		case "activatedTime":
+			out.Values[i] = ec._Task_activatedTime(ctx, field, obj)
+		case "ami":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Task_ami(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "annotation":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Task_annotation(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "baseStatus":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Task_baseStatus(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "baseTask":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92383,7 +95529,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_abortInfo(ctx, field, obj)
+				res = ec._Task_baseTask(ctx, field, obj)
 				return res
 			}
 
@@ -92407,82 +95553,22 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "activated":
-			out.Values[i] = ec._Task_activated(ctx, field, obj)
+		case "blocked":
+			out.Values[i] = ec._Task_blocked(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "activatedBy":
-			out.Values[i] = ec._Task_activatedBy(ctx, field, obj)
-		case "activatedTime":
-			out.Values[i] = ec._Task_activatedTime(ctx, field, obj)
-		case "ami":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Task_ami(ctx, field, obj)
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "annotation":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Task_annotation(ctx, field, obj)
-				return res
+		case "buildId":
+			out.Values[i] = ec._Task_buildId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+		case "buildVariant":
+			out.Values[i] = ec._Task_buildVariant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "baseStatus":
+		case "buildVariantDisplayName":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92491,7 +95577,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_baseStatus(ctx, field, obj)
+				res = ec._Task_buildVariantDisplayName(ctx, field, obj)
 				return res
 			}
 
@@ -92515,64 +95601,19 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "baseTask":
+		case "canAbort":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_baseTask(ctx, field, obj)
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
+				res = ec._Task_canAbort(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
 				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "blocked":
-			out.Values[i] = ec._Task_blocked(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "buildId":
-			out.Values[i] = ec._Task_buildId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "buildVariant":
-			out.Values[i] = ec._Task_buildVariant(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "buildVariantDisplayName":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Task_buildVariantDisplayName(ctx, field, obj)
 				return res
 			}
 
@@ -92596,7 +95637,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "canAbort":
+		case "canDisable":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92605,7 +95646,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_canAbort(ctx, field, obj)
+				res = ec._Task_canDisable(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -92632,7 +95673,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "canDisable":
+		case "canModifyAnnotation":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92641,7 +95682,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_canDisable(ctx, field, obj)
+				res = ec._Task_canModifyAnnotation(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -92668,7 +95709,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "canModifyAnnotation":
+		case "canOverrideDependencies":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92677,7 +95718,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_canModifyAnnotation(ctx, field, obj)
+				res = ec._Task_canOverrideDependencies(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -92704,7 +95745,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "canOverrideDependencies":
+		case "canRestart":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92713,7 +95754,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_canOverrideDependencies(ctx, field, obj)
+				res = ec._Task_canRestart(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -92740,7 +95781,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "canRestart":
+		case "canSchedule":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92749,7 +95790,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_canRestart(ctx, field, obj)
+				res = ec._Task_canSchedule(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -92776,7 +95817,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "canSchedule":
+		case "canSetPriority":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92785,7 +95826,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_canSchedule(ctx, field, obj)
+				res = ec._Task_canSetPriority(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -92812,7 +95853,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "canSetPriority":
+		case "canUnschedule":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92821,7 +95862,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_canSetPriority(ctx, field, obj)
+				res = ec._Task_canUnschedule(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -92848,7 +95889,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "canUnschedule":
+		case "commandProfiles":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -92857,7 +95898,7 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Task_canUnschedule(ctx, field, obj)
+				res = ec._Task_commandProfiles(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -94283,7 +97324,48 @@ func (ec *executionContext) _TaskLogs(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "eventLogs":
+		case "eventLogs":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TaskLogs_eventLogs(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "execution":
+			out.Values[i] = ec._TaskLogs_execution(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "sections":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -94292,7 +97374,7 @@ func (ec *executionContext) _TaskLogs(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._TaskLogs_eventLogs(ctx, field, obj)
+				res = ec._TaskLogs_sections(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -94319,11 +97401,6 @@ func (ec *executionContext) _TaskLogs(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "execution":
-			out.Values[i] = ec._TaskLogs_execution(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
 		case "systemLogs":
 			field := field
 
@@ -97867,6 +100944,60 @@ func (ec *executionContext) marshalNClientBinary2githubᚗcomᚋevergreenᚑci
 	return ec._ClientBinary(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalNCommandProfile2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICommandProfileᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APICommandProfile) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCommandProfile2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICommandProfile(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCommandProfile2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICommandProfile(ctx context.Context, sel ast.SelectionSet, v *model.APICommandProfile) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CommandProfile(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNCommitQueueParams2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICommitQueueParams(ctx context.Context, sel ast.SelectionSet, v model.APICommitQueueParams) graphql.Marshaler {
 	return ec._CommitQueueParams(ctx, sel, &v)
 }
@@ -97948,6 +101079,68 @@ func (ec *executionContext) unmarshalNCopyProjectInput2githubᚗcomᚋevergreen
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNCostBreakdown2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBreakdown(ctx context.Context, sel ast.SelectionSet, v model.APICostBreakdown) graphql.Marshaler {
+	return ec._CostBreakdown(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCostBreakdown2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBreakdownᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APICostBreakdown) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCostBreakdown2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBreakdown(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCostReport2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostReport(ctx context.Context, sel ast.SelectionSet, v model.APICostReport) graphql.Marshaler {
+	return ec._CostReport(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCostReport2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostReport(ctx context.Context, sel ast.SelectionSet, v *model.APICostReport) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CostReport(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNCreateDistroInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐCreateDistroInput(ctx context.Context, v any) (CreateDistroInput, error) {
 	res, err := ec.unmarshalInputCreateDistroInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -99759,6 +102952,167 @@ func (ec *executionContext) marshalNLogMessage2ᚖgithubᚗcomᚋevergreenᚑci
 	return ec._LogMessage(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNLogSearchInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐLogSearchInput(ctx context.Context, v any) (LogSearchInput, error) {
+	res, err := ec.unmarshalInputLogSearchInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLogSearchMatch2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSearchMatchᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APILogSearchMatch) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLogSearchMatch2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSearchMatch(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLogSearchMatch2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSearchMatch(ctx context.Context, sel ast.SelectionSet, v *model.APILogSearchMatch) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LogSearchMatch(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNLogSection2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSection(ctx context.Context, sel ast.SelectionSet, v model.APILogSection) graphql.Marshaler {
+	return ec._LogSection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLogSection2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSectionᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APILogSection) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLogSection2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSection(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLogSection2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSectionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APILogSection) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLogSection2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSection(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLogSection2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSection(ctx context.Context, sel ast.SelectionSet, v *model.APILogSection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LogSection(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNLogkeeperBuild2githubᚗcomᚋevergreenᚑciᚋplankᚐBuild(ctx context.Context, sel ast.SelectionSet, v plank.Build) graphql.Marshaler {
 	return ec._LogkeeperBuild(ctx, sel, &v)
 }
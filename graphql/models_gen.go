@@ -224,6 +224,21 @@ type ImageToolchainsPayload struct {
 	TotalCount    int                   `json:"totalCount"`
 }
 
+// LogSearchInput is the input to the logSearch query.
+// Exactly one of taskId, versionId, or projectIdentifier must be specified to select the tasks whose logs are searched.
+type LogSearchInput struct {
+	BuildVariant      *string `json:"buildVariant,omitempty"`
+	CaseSensitive     *bool   `json:"caseSensitive,omitempty"`
+	Days              *int    `json:"days,omitempty"`
+	ExactMatch        *bool   `json:"exactMatch,omitempty"`
+	Execution         *int    `json:"execution,omitempty"`
+	Expression        string  `json:"expression"`
+	Limit             *int    `json:"limit,omitempty"`
+	ProjectIdentifier *string `json:"projectIdentifier,omitempty"`
+	TaskID            *string `json:"taskId,omitempty"`
+	VersionID         *string `json:"versionId,omitempty"`
+}
+
 type MainlineCommitVersion struct {
 	RolledUpVersions []*model.APIVersion `json:"rolledUpVersions,omitempty"`
 	Version          *model.APIVersion   `json:"version,omitempty"`
@@ -484,6 +499,7 @@ type TaskLogs struct {
 	AllLogs    []*apimodels.LogMessage       `json:"allLogs"`
 	EventLogs  []*model.TaskAPIEventLogEntry `json:"eventLogs"`
 	Execution  int                           `json:"execution"`
+	Sections   []*model.APILogSection        `json:"sections"`
 	SystemLogs []*apimodels.LogMessage       `json:"systemLogs"`
 	TaskID     string                        `json:"taskId"`
 	TaskLogs   []*apimodels.LogMessage       `json:"taskLogs"`
@@ -1429,6 +1445,7 @@ const (
 	ProviderDocker      Provider = "DOCKER"
 	ProviderEc2Fleet    Provider = "EC2_FLEET"
 	ProviderEc2OnDemand Provider = "EC2_ON_DEMAND"
+	ProviderLibvirt     Provider = "LIBVIRT"
 	ProviderStatic      Provider = "STATIC"
 )
 
@@ -1436,12 +1453,13 @@ var AllProvider = []Provider{
 	ProviderDocker,
 	ProviderEc2Fleet,
 	ProviderEc2OnDemand,
+	ProviderLibvirt,
 	ProviderStatic,
 }
 
 func (e Provider) IsValid() bool {
 	switch e {
-	case ProviderDocker, ProviderEc2Fleet, ProviderEc2OnDemand, ProviderStatic:
+	case ProviderDocker, ProviderEc2Fleet, ProviderEc2OnDemand, ProviderLibvirt, ProviderStatic:
 		return true
 	}
 	return false
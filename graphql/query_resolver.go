@@ -11,15 +11,18 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/cost"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/parsley"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/taskoutput"
 	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/plank"
 	"github.com/evergreen-ci/utility"
@@ -500,6 +503,38 @@ func (r *queryResolver) ProjectSettings(ctx context.Context, projectIdentifier s
 	return res, nil
 }
 
+// ProjectCost is the resolver for the projectCost field.
+func (r *queryResolver) ProjectCost(ctx context.Context, projectIdentifier string, startDate *time.Time, endDate *time.Time) (*restModel.APICostReport, error) {
+	projectID, err := model.GetIdForProject(ctx, projectIdentifier)
+	if err != nil {
+		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("project '%s' not found", projectIdentifier))
+	}
+
+	end := utility.GetUTCDay(time.Now())
+	if endDate != nil {
+		end = utility.GetUTCDay(*endDate)
+	}
+	start := end.AddDate(0, 0, 1-cost.DefaultReportNumDays)
+	if startDate != nil {
+		start = utility.GetUTCDay(*startDate)
+	}
+	if start.After(end) {
+		return nil, InputValidationError.Send(ctx, "start date cannot be after end date")
+	}
+	if end.Sub(start) >= cost.MaxReportNumDays*24*time.Hour {
+		return nil, InputValidationError.Send(ctx, fmt.Sprintf("cost report cannot cover more than %d days", cost.MaxReportNumDays))
+	}
+
+	costs, err := cost.FindProjectDailyCosts(ctx, evergreen.GetEnvironment(), projectID, start, end)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding costs for project '%s': %s", projectIdentifier, err.Error()))
+	}
+	report := &restModel.APICostReport{}
+	report.BuildFromService(*cost.NewReport(costs, start, end))
+
+	return report, nil
+}
+
 // RepoEvents is the resolver for the repoEvents field.
 func (r *queryResolver) RepoEvents(ctx context.Context, repoID string, limit *int, before *time.Time) (*ProjectEvents, error) {
 	timestamp := time.Now()
@@ -749,6 +784,47 @@ func (r *queryResolver) UserConfig(ctx context.Context) (*UserConfig, error) {
 	return config, nil
 }
 
+// LogSearch is the resolver for the logSearch field.
+func (r *queryResolver) LogSearch(ctx context.Context, opts LogSearchInput) ([]*restModel.APILogSearchMatch, error) {
+	limit := utility.FromIntPtr(opts.Limit)
+	if limit <= 0 || limit > maxLogSearchLimit {
+		return nil, InputValidationError.Send(ctx, fmt.Sprintf("limit must be between 1 and %d", maxLogSearchLimit))
+	}
+	tasks, err := getLogSearchTasks(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := task.NewLogSearchIterator(ctx, tasks, taskoutput.LogSearchOptions{
+		Filter: parsley.Filter{
+			Expression:    opts.Expression,
+			CaseSensitive: utility.FromBoolPtr(opts.CaseSensitive),
+			ExactMatch:    utility.FromBoolTPtr(opts.ExactMatch),
+		},
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, InputValidationError.Send(ctx, fmt.Sprintf("invalid log search: %s", err.Error()))
+	}
+	defer func() {
+		grip.Error(message.WrapError(it.Close(), message.Fields{
+			"message": "closing log search iterator",
+		}))
+	}()
+
+	matches := []*restModel.APILogSearchMatch{}
+	for it.Next() {
+		match := &restModel.APILogSearchMatch{}
+		match.BuildFromService(it.Item())
+		matches = append(matches, match)
+	}
+	if err = it.Err(); err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("searching logs: %s", err.Error()))
+	}
+
+	return matches, nil
+}
+
 // BuildVariantsForTaskName is the resolver for the buildVariantsForTaskName field.
 func (r *queryResolver) BuildVariantsForTaskName(ctx context.Context, projectIdentifier string, taskName string) ([]*task.BuildVariantTuple, error) {
 	pid, err := model.GetIdForProject(ctx, projectIdentifier)
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/taskoutput"
 )
@@ -48,6 +50,33 @@ func (r *taskLogsResolver) EventLogs(ctx context.Context, obj *TaskLogs) ([]*res
 	return apiEventLogPointers, nil
 }
 
+// Sections is the resolver for the sections field.
+func (r *taskLogsResolver) Sections(ctx context.Context, obj *TaskLogs) ([]*restModel.APILogSection, error) {
+	dbTask, err := task.FindOneIdAndExecution(ctx, obj.TaskID, obj.Execution)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding task '%s': %s", obj.TaskID, err.Error()))
+	}
+	if dbTask == nil {
+		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("task '%s' not found", obj.TaskID))
+	}
+	if evergreen.IsUnstartedTaskStatus(dbTask.Status) || dbTask.DisplayOnly {
+		return []*restModel.APILogSection{}, nil
+	}
+
+	roots, err := dbTask.GetLogSections(ctx)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting log sections for task '%s': %s", dbTask.Id, err.Error()))
+	}
+
+	sections := []*restModel.APILogSection{}
+	for _, root := range roots {
+		section := &restModel.APILogSection{}
+		section.BuildFromService(*root)
+		sections = append(sections, section)
+	}
+	return sections, nil
+}
+
 // SystemLogs is the resolver for the systemLogs field.
 func (r *taskLogsResolver) SystemLogs(ctx context.Context, obj *TaskLogs) ([]*apimodels.LogMessage, error) {
 	return getTaskLogs(ctx, obj, taskoutput.TaskLogTypeSystem)
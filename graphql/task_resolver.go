@@ -244,6 +244,21 @@ func (r *taskResolver) CanUnschedule(ctx context.Context, obj *restModel.APITask
 	return (obj.Activated && *obj.Status == evergreen.TaskUndispatched && obj.ParentTaskId == ""), nil
 }
 
+// CommandProfiles is the resolver for the commandProfiles field.
+func (r *taskResolver) CommandProfiles(ctx context.Context, obj *restModel.APITask) ([]*restModel.APICommandProfile, error) {
+	profiles, err := task.FindCommandProfiles(ctx, utility.FromStringPtr(obj.Id), obj.Execution)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding command profiles for task '%s': %s", utility.FromStringPtr(obj.Id), err.Error()))
+	}
+	apiProfiles := []*restModel.APICommandProfile{}
+	for _, profile := range profiles {
+		apiProfile := &restModel.APICommandProfile{}
+		apiProfile.BuildFromService(profile)
+		apiProfiles = append(apiProfiles, apiProfile)
+	}
+	return apiProfiles, nil
+}
+
 // DependsOn is the resolver for the dependsOn field.
 func (r *taskResolver) DependsOn(ctx context.Context, obj *restModel.APITask) ([]*Dependency, error) {
 	dependencies := []*Dependency{}
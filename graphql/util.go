@@ -44,8 +44,78 @@ import (
 const (
 	minRevisionLength = 7
 	gitHashLength     = 40 // A git hash contains 40 characters.
+	maxLogSearchLimit = 1000
+	maxLogSearchDays  = 30
 )
 
+// getLogSearchTasks returns the tasks whose logs should be searched for the
+// given log search input, which must specify exactly one of a task, version, or
+// project.
+func getLogSearchTasks(ctx context.Context, opts LogSearchInput) ([]task.Task, error) {
+	numScopes := 0
+	for _, scope := range []*string{opts.TaskID, opts.VersionID, opts.ProjectIdentifier} {
+		if utility.FromStringPtr(scope) != "" {
+			numScopes++
+		}
+	}
+	if numScopes != 1 {
+		return nil, InputValidationError.Send(ctx, "must specify exactly one of taskId, versionId, or projectIdentifier")
+	}
+
+	switch {
+	case opts.TaskID != nil && *opts.TaskID != "":
+		taskID := utility.FromStringPtr(opts.TaskID)
+		t, err := task.FindByIdExecution(ctx, taskID, opts.Execution)
+		if err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("fetching task '%s': %s", taskID, err.Error()))
+		}
+		if t == nil {
+			return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("task '%s' not found", taskID))
+		}
+		if !t.DisplayOnly {
+			return []task.Task{*t}, nil
+		}
+		execTasks, err := task.FindByExecutionTasksAndMaxExecution(ctx, t.ExecutionTasks, t.Execution)
+		if err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("fetching execution tasks for display task '%s': %s", taskID, err.Error()))
+		}
+		return execTasks, nil
+	case opts.VersionID != nil && *opts.VersionID != "":
+		versionID := utility.FromStringPtr(opts.VersionID)
+		v, err := model.VersionFindOneId(ctx, versionID)
+		if err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("fetching version '%s': %s", versionID, err.Error()))
+		}
+		if v == nil {
+			return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("version '%s' not found", versionID))
+		}
+		tasks, err := task.FindLogSearchTasksByVersion(ctx, versionID)
+		if err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("fetching tasks for version '%s': %s", versionID, err.Error()))
+		}
+		return tasks, nil
+	default:
+		projectIdentifier := utility.FromStringPtr(opts.ProjectIdentifier)
+		buildVariant := utility.FromStringPtr(opts.BuildVariant)
+		if buildVariant == "" {
+			return nil, InputValidationError.Send(ctx, "must specify a build variant when searching the logs of a project")
+		}
+		days := utility.FromIntPtr(opts.Days)
+		if days <= 0 || days > maxLogSearchDays {
+			return nil, InputValidationError.Send(ctx, fmt.Sprintf("days must be between 1 and %d", maxLogSearchDays))
+		}
+		projectID, err := model.GetIdForProject(ctx, projectIdentifier)
+		if err != nil {
+			return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("project '%s' not found", projectIdentifier))
+		}
+		tasks, err := task.FindLogSearchTasksByVariant(ctx, projectID, buildVariant, time.Now().AddDate(0, 0, -days))
+		if err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("fetching tasks for build variant '%s' in project '%s': %s", buildVariant, projectIdentifier, err.Error()))
+		}
+		return tasks, nil
+	}
+}
+
 // getGroupedFiles returns the files of a Task inside a GroupedFile struct
 func getGroupedFiles(ctx context.Context, name string, taskID string, execution int) (*GroupedFiles, error) {
 	taskFiles, err := artifact.GetAllArtifacts(ctx, []artifact.TaskIDAndExecution{{TaskID: taskID, Execution: execution}})
@@ -413,7 +483,7 @@ func mapHTTPStatusToGqlError(ctx context.Context, httpStatus int, err error) *gq
 		return InternalServerError.Send(ctx, err.Error())
 	case http.StatusNotFound:
 		return ResourceNotFound.Send(ctx, err.Error())
-	case http.StatusUnauthorized:
+	case http.StatusUnauthorized, http.StatusForbidden:
 		return Forbidden.Send(ctx, err.Error())
 	case http.StatusBadRequest:
 		return InputValidationError.Send(ctx, err.Error())
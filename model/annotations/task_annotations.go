@@ -145,6 +145,26 @@ func AddSuspectedIssueToAnnotation(taskId string, execution int, issue IssueLink
 	return errors.Wrapf(err, "adding task annotation suspected issue for task '%s'", taskId)
 }
 
+// AddSuspectedCulpritToAnnotation adds the commit found to have caused the
+// task to fail as a suspected issue, attributed to the commit's author.
+func AddSuspectedCulpritToAnnotation(ctx context.Context, taskId string, execution int, issue IssueLink, author string) error {
+	issue.Source = &Source{
+		Author:    author,
+		Time:      time.Now(),
+		Requester: CulpritFinderRequester,
+	}
+
+	_, err := db.UpsertContext(
+		ctx,
+		Collection,
+		ByTaskIdAndExecution(taskId, execution),
+		bson.M{
+			"$push": bson.M{SuspectedIssuesKey: issue},
+		},
+	)
+	return errors.Wrapf(err, "adding suspected culprit to annotation for task '%s'", taskId)
+}
+
 func RemoveSuspectedIssueFromAnnotation(ctx context.Context, taskId string, execution int, issue IssueLink) error {
 	return db.UpdateContext(
 		ctx,
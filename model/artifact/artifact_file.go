@@ -65,8 +65,15 @@ type File struct {
 	Bucket string `json:"bucket,omitempty" bson:"bucket,omitempty"`
 	// FileKey is the path to the file in the bucket.
 	FileKey string `json:"filekey,omitempty" bson:"filekey,omitempty"`
+	// Region is the AWS region of the bucket in which the file is stored.
+	Region string `json:"region,omitempty" bson:"region,omitempty"`
 	// ContentType is the content type of the file.
 	ContentType string `json:"content_type" bson:"content_type"`
+	// Expired indicates that the file was deleted by the project's artifact
+	// retention policy, so its link no longer works.
+	Expired bool `json:"expired,omitempty" bson:"expired,omitempty"`
+	// ExpiredAt is the time at which the file was deleted.
+	ExpiredAt time.Time `json:"expired_at,omitempty" bson:"expired_at,omitempty"`
 }
 
 func (f *File) validate() error {
@@ -84,7 +91,8 @@ func (f *File) validate() error {
 }
 
 // StripHiddenFiles is a helper for only showing users the files they are
-// allowed to see. It also pre-signs file URLs.
+// allowed to see. It also pre-signs file URLs and clears the links of expired
+// files.
 func StripHiddenFiles(ctx context.Context, files []File, hasUser bool) ([]File, error) {
 	publicFiles := []File{}
 	for _, file := range files {
@@ -93,6 +101,9 @@ func StripHiddenFiles(ctx context.Context, files []File, hasUser bool) ([]File,
 			continue
 		case (file.Visibility == Private || file.Visibility == Signed) && !hasUser:
 			continue
+		case file.Expired:
+			file.Link = ""
+			publicFiles = append(publicFiles, file)
 		case file.Visibility == Signed && hasUser:
 			link, err := presignFile(ctx, file)
 			if err != nil {
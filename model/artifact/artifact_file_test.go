@@ -2,6 +2,7 @@ package artifact
 
 import (
 	"testing"
+	"time"
 
 	"github.com/evergreen-ci/evergreen/db"
 	_ "github.com/evergreen-ci/evergreen/testutil"
@@ -222,3 +223,49 @@ func (s *TestArtifactFileSuite) TestEscapeFiles() {
 	s.Equal("https://notacat%230.png", escapedFiles[1].Link)
 
 }
+
+func (s *TestArtifactFileSuite) TestMarkFileExpired() {
+	entry, err := FindOne(s.T().Context(), ByTaskId("task1"))
+	s.Require().NoError(err)
+	s.Require().NotNil(entry)
+
+	expiredAt := time.Now().Round(time.Millisecond)
+	s.Require().NoError(entry.MarkFileExpired(s.T().Context(), "cat_pix", "http://placekitten.com/800/600", expiredAt))
+	s.True(entry.Files[0].Expired)
+	s.False(entry.Files[1].Expired)
+
+	entryFromDb, err := FindOne(s.T().Context(), ByTaskId("task1"))
+	s.Require().NoError(err)
+	s.Require().NotNil(entryFromDb)
+	s.Require().Len(entryFromDb.Files, 2)
+	s.True(entryFromDb.Files[0].Expired)
+	s.True(expiredAt.Equal(entryFromDb.Files[0].ExpiredAt))
+	s.False(entryFromDb.Files[1].Expired)
+}
+
+func (s *TestArtifactFileSuite) TestStripHiddenFilesClearsExpiredLinks() {
+	files := []File{
+		{
+			Name:       "expired",
+			Link:       "https://bucket.s3.amazonaws.com/expired.tgz",
+			Visibility: Signed,
+			Bucket:     "bucket",
+			FileKey:    "expired.tgz",
+			Expired:    true,
+		},
+		// Expired files should still respect their visibility.
+		{
+			Name:       "hidden",
+			Link:       "https://bucket.s3.amazonaws.com/hidden.tgz",
+			Visibility: None,
+			Expired:    true,
+		},
+	}
+
+	stripped, err := StripHiddenFiles(s.T().Context(), files, true)
+	s.Require().NoError(err)
+	s.Require().Len(stripped, 1)
+	s.Equal("expired", stripped[0].Name)
+	s.True(stripped[0].Expired)
+	s.Empty(stripped[0].Link)
+}
@@ -2,11 +2,15 @@ package artifact
 
 import (
 	"context"
+	"time"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/mongodb/anser/bsonutil"
 	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 var (
@@ -21,6 +25,10 @@ var (
 	LinkKey        = bsonutil.MustHaveTag(File{}, "Link")
 	ContentTypeKey = bsonutil.MustHaveTag(File{}, "ContentType")
 	AWSSecretKey   = bsonutil.MustHaveTag(File{}, "AWSSecret")
+	BucketKey      = bsonutil.MustHaveTag(File{}, "Bucket")
+	FileKeyKey     = bsonutil.MustHaveTag(File{}, "FileKey")
+	ExpiredKey     = bsonutil.MustHaveTag(File{}, "Expired")
+	ExpiredAtKey   = bsonutil.MustHaveTag(File{}, "ExpiredAt")
 )
 
 type TaskIDAndExecution struct {
@@ -91,6 +99,19 @@ func BySecret(secret string) db.Q {
 	})
 }
 
+// UnexpiredFilesForTasks returns a filter for the given tasks' entries that
+// have at least one file that has not expired.
+func UnexpiredFilesForTasks(taskIDs []string) bson.M {
+	return bson.M{
+		TaskIdKey: bson.M{"$in": taskIDs},
+		FilesKey: bson.M{
+			"$elemMatch": bson.M{
+				ExpiredKey: bson.M{"$ne": true},
+			},
+		},
+	}
+}
+
 // === DB Logic ===
 
 // Upsert updates the files entry in the db if an entry already exists,
@@ -111,7 +132,8 @@ func (e Entry) Upsert() error {
 				},
 			},
 			"$setOnInsert": bson.M{
-				ExecutionKey: e.Execution,
+				ExecutionKey:  e.Execution,
+				CreateTimeKey: e.CreateTime,
 			},
 		},
 	)
@@ -134,3 +156,66 @@ func FindAll(ctx context.Context, query db.Q) ([]Entry, error) {
 	err := db.FindAllQContext(ctx, Collection, query, &entries)
 	return entries, err
 }
+
+// HasOtherUnexpiredReferences returns whether an entry other than this one has
+// an unexpired file stored at the given bucket and key. Files can share a key
+// when, for example, several tasks upload to the same remote path.
+func (e *Entry) HasOtherUnexpiredReferences(ctx context.Context, bucket, fileKey string) (bool, error) {
+	count, err := evergreen.GetEnvironment().DB().Collection(Collection).CountDocuments(ctx,
+		bson.M{
+			FilesKey: bson.M{
+				"$elemMatch": bson.M{
+					BucketKey:  bucket,
+					FileKeyKey: fileKey,
+					ExpiredKey: bson.M{"$ne": true},
+				},
+			},
+			"$nor": []bson.M{{
+				TaskIdKey:    e.TaskId,
+				ExecutionKey: e.Execution,
+			}},
+		},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return false, errors.Wrapf(err, "counting other references to file '%s' in bucket '%s'", fileKey, bucket)
+	}
+	return count > 0, nil
+}
+
+// MarkFileExpired marks the entry's files with the given name and link as
+// expired.
+func (e *Entry) MarkFileExpired(ctx context.Context, name, link string, expiredAt time.Time) error {
+	_, err := evergreen.GetEnvironment().DB().Collection(Collection).UpdateOne(ctx,
+		bson.M{
+			TaskIdKey:    e.TaskId,
+			TaskNameKey:  e.TaskDisplayName,
+			BuildIdKey:   e.BuildId,
+			ExecutionKey: e.Execution,
+		},
+		bson.M{
+			"$set": bson.M{
+				bsonutil.GetDottedKeyName(FilesKey, "$[file]", ExpiredKey):   true,
+				bsonutil.GetDottedKeyName(FilesKey, "$[file]", ExpiredAtKey): expiredAt,
+			},
+		},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{
+			bson.M{
+				bsonutil.GetDottedKeyName("file", NameKey): name,
+				bsonutil.GetDottedKeyName("file", LinkKey): link,
+			},
+		}}),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "marking file '%s' for task '%s' expired", name, e.TaskId)
+	}
+
+	for i := range e.Files {
+		if e.Files[i].Name == name && e.Files[i].Link == link {
+			e.Files[i].Expired = true
+			e.Files[i].ExpiredAt = expiredAt
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package artifact
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RetentionPolicyCollection is the name of the collection containing the
+// per-project artifact retention policies.
+const RetentionPolicyCollection = "artifact_retention_policies"
+
+// RetentionPolicy is a project's set of rules for expiring the artifacts
+// uploaded by its tasks. Rules are evaluated in order and the first rule that
+// matches an artifact determines when it expires. Artifacts that do not match
+// any rule are kept indefinitely.
+type RetentionPolicy struct {
+	ProjectID string          `bson:"_id" json:"project_id"`
+	Rules     []RetentionRule `bson:"rules,omitempty" json:"rules,omitempty"`
+}
+
+// RetentionRule determines when the artifacts that match it expire.
+type RetentionRule struct {
+	// NamePattern is a glob matched against the artifact's name. If empty, the
+	// rule matches artifacts with any name.
+	NamePattern string `bson:"name_pattern,omitempty" json:"name_pattern,omitempty"`
+	// Requesters are the requesters of the tasks whose artifacts the rule
+	// matches. If empty, the rule matches artifacts from any requester.
+	Requesters []string `bson:"requesters,omitempty" json:"requesters,omitempty"`
+	// MaxAgeDays is the number of days after which matching artifacts expire.
+	MaxAgeDays int `bson:"max_age_days" json:"max_age_days"`
+	// KeepLastMainline is the number of most recent mainline runs of each
+	// task whose artifacts are kept regardless of their age.
+	KeepLastMainline int `bson:"keep_last_mainline,omitempty" json:"keep_last_mainline,omitempty"`
+}
+
+var (
+	retentionPolicyProjectIDKey = bsonutil.MustHaveTag(RetentionPolicy{}, "ProjectID")
+	retentionPolicyRulesKey     = bsonutil.MustHaveTag(RetentionPolicy{}, "Rules")
+)
+
+// Validate checks that the rule is valid.
+func (r *RetentionRule) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	if r.NamePattern != "" {
+		_, err := path.Match(r.NamePattern, "")
+		catcher.Wrapf(err, "invalid name pattern '%s'", r.NamePattern)
+	}
+	for _, requester := range r.Requesters {
+		catcher.ErrorfWhen(!utility.StringSliceContains(evergreen.AllRequesterTypes, requester), "invalid requester '%s'", requester)
+	}
+	catcher.NewWhen(r.MaxAgeDays <= 0, "max age must be positive")
+	catcher.NewWhen(r.KeepLastMainline < 0, "number of mainline runs to keep cannot be negative")
+
+	return catcher.Resolve()
+}
+
+// Matches returns whether the rule applies to an artifact with the given name
+// uploaded by a task with the given requester.
+func (r *RetentionRule) Matches(name, requester string) bool {
+	if len(r.Requesters) > 0 && !utility.StringSliceContains(r.Requesters, requester) {
+		return false
+	}
+	if r.NamePattern == "" {
+		return true
+	}
+	matched, err := path.Match(r.NamePattern, name)
+	return err == nil && matched
+}
+
+// MaxAge returns the age after which matching artifacts expire.
+func (r *RetentionRule) MaxAge() time.Duration {
+	return time.Duration(r.MaxAgeDays) * 24 * time.Hour
+}
+
+// Validate checks that all of the policy's rules are valid.
+func (p *RetentionPolicy) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	for i := range p.Rules {
+		catcher.Wrapf(p.Rules[i].Validate(), "rule %d", i)
+	}
+
+	return catcher.Resolve()
+}
+
+// FindRule returns the first rule that matches an artifact with the given name
+// uploaded by a task with the given requester, or nil if no rule matches.
+func (p *RetentionPolicy) FindRule(name, requester string) *RetentionRule {
+	for i := range p.Rules {
+		if p.Rules[i].Matches(name, requester) {
+			return &p.Rules[i]
+		}
+	}
+	return nil
+}
+
+// MinMaxAge returns the shortest age after which any of the policy's rules
+// expires artifacts, or 0 if the policy has no rules.
+func (p *RetentionPolicy) MinMaxAge() time.Duration {
+	var minAge time.Duration
+	for i := range p.Rules {
+		if age := p.Rules[i].MaxAge(); minAge == 0 || age < minAge {
+			minAge = age
+		}
+	}
+	return minAge
+}
+
+// FindRetentionPolicy returns the project's artifact retention policy. If the
+// project has no policy, an empty policy that keeps all artifacts is returned.
+func FindRetentionPolicy(ctx context.Context, env evergreen.Environment, projectID string) (*RetentionPolicy, error) {
+	p := &RetentionPolicy{}
+	err := env.DB().Collection(RetentionPolicyCollection).FindOne(ctx, bson.M{retentionPolicyProjectIDKey: projectID}).Decode(p)
+	if err == mongo.ErrNoDocuments {
+		return &RetentionPolicy{ProjectID: projectID}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding artifact retention policy for project '%s'", projectID)
+	}
+
+	return p, nil
+}
+
+// FindAllRetentionPolicies returns the artifact retention policies of all
+// projects that have at least one retention rule.
+func FindAllRetentionPolicies(ctx context.Context, env evergreen.Environment) ([]RetentionPolicy, error) {
+	cur, err := env.DB().Collection(RetentionPolicyCollection).Find(ctx, bson.M{bsonutil.GetDottedKeyName(retentionPolicyRulesKey, "0"): bson.M{"$exists": true}})
+	if err != nil {
+		return nil, errors.Wrap(err, "finding artifact retention policies")
+	}
+	policies := []RetentionPolicy{}
+	if err = cur.All(ctx, &policies); err != nil {
+		return nil, errors.Wrap(err, "decoding artifact retention policies")
+	}
+
+	return policies, nil
+}
+
+// SetRetentionPolicy replaces the project's artifact retention policy.
+func SetRetentionPolicy(ctx context.Context, env evergreen.Environment, p RetentionPolicy) error {
+	if err := p.Validate(); err != nil {
+		return errors.Wrap(err, "invalid artifact retention policy")
+	}
+
+	_, err := env.DB().Collection(RetentionPolicyCollection).ReplaceOne(ctx,
+		bson.M{retentionPolicyProjectIDKey: p.ProjectID},
+		p,
+		options.Replace().SetUpsert(true),
+	)
+
+	return errors.Wrapf(err, "setting artifact retention policy for project '%s'", p.ProjectID)
+}
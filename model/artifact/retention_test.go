@@ -0,0 +1,78 @@
+package artifact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionRule(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, (&RetentionRule{NamePattern: "*.tgz", Requesters: []string{evergreen.PatchVersionRequester}, MaxAgeDays: 7, KeepLastMainline: 3}).Validate())
+		assert.Error(t, (&RetentionRule{}).Validate(), "max age is required")
+		assert.Error(t, (&RetentionRule{NamePattern: "[", MaxAgeDays: 7}).Validate())
+		assert.Error(t, (&RetentionRule{Requesters: []string{"patch"}, MaxAgeDays: 7}).Validate(), "requesters must be internal requester types")
+		assert.Error(t, (&RetentionRule{MaxAgeDays: 7, KeepLastMainline: -1}).Validate())
+	})
+	t.Run("Matches", func(t *testing.T) {
+		r := RetentionRule{NamePattern: "*.tgz", Requesters: []string{evergreen.PatchVersionRequester}, MaxAgeDays: 7}
+		assert.True(t, r.Matches("dist.tgz", evergreen.PatchVersionRequester))
+		assert.False(t, r.Matches("dist.zip", evergreen.PatchVersionRequester))
+		assert.False(t, r.Matches("dist.tgz", evergreen.RepotrackerVersionRequester))
+		assert.True(t, (&RetentionRule{MaxAgeDays: 7}).Matches("anything", evergreen.GitTagRequester))
+	})
+}
+
+func TestRetentionPolicy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+	require.NoError(t, db.Clear(RetentionPolicyCollection))
+	defer func() {
+		assert.NoError(t, db.Clear(RetentionPolicyCollection))
+	}()
+
+	t.Run("FindRuleReturnsFirstMatchingRule", func(t *testing.T) {
+		p := RetentionPolicy{Rules: []RetentionRule{
+			{NamePattern: "*.log", MaxAgeDays: 1},
+			{MaxAgeDays: 30},
+			{MaxAgeDays: 7},
+		}}
+		rule := p.FindRule("out.log", evergreen.PatchVersionRequester)
+		require.NotZero(t, rule)
+		assert.Equal(t, 1, rule.MaxAgeDays)
+		rule = p.FindRule("dist.tgz", evergreen.PatchVersionRequester)
+		require.NotZero(t, rule)
+		assert.Equal(t, 30, rule.MaxAgeDays)
+		assert.Nil(t, (&RetentionPolicy{}).FindRule("dist.tgz", evergreen.PatchVersionRequester))
+	})
+	t.Run("FindReturnsEmptyPolicyForProjectWithoutPolicy", func(t *testing.T) {
+		p, err := FindRetentionPolicy(ctx, env, "nonexistent")
+		require.NoError(t, err)
+		require.NotZero(t, p)
+		assert.Equal(t, "nonexistent", p.ProjectID)
+		assert.Empty(t, p.Rules)
+	})
+	t.Run("SetAndFind", func(t *testing.T) {
+		p := RetentionPolicy{ProjectID: "project", Rules: []RetentionRule{{NamePattern: "*.tgz", MaxAgeDays: 7}}}
+		require.NoError(t, SetRetentionPolicy(ctx, env, p))
+		require.NoError(t, SetRetentionPolicy(ctx, env, RetentionPolicy{ProjectID: "empty"}))
+
+		found, err := FindRetentionPolicy(ctx, env, "project")
+		require.NoError(t, err)
+		assert.Equal(t, p, *found)
+
+		all, err := FindAllRetentionPolicies(ctx, env)
+		require.NoError(t, err)
+		require.Len(t, all, 1, "policies without rules should not be returned")
+		assert.Equal(t, "project", all[0].ProjectID)
+	})
+	t.Run("SetRejectsInvalidPolicy", func(t *testing.T) {
+		assert.Error(t, SetRetentionPolicy(ctx, env, RetentionPolicy{ProjectID: "project", Rules: []RetentionRule{{}}}))
+	})
+}
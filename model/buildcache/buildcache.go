@@ -0,0 +1,214 @@
+package buildcache
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Collection is the collection of build cache entries.
+const Collection = "build_cache_entries"
+
+// Entry is an archive saved to the build cache by a task. Entries are
+// immutable: once a key is saved in a project, saving it again has no effect
+// until the entry expires.
+type Entry struct {
+	ID        string `bson:"_id" json:"id"`
+	ProjectID string `bson:"project_id" json:"project_id"`
+	Key       string `bson:"key" json:"key"`
+	// SHA256 is the hash of the archive's contents. Archives are stored by
+	// their hash, so entries with identical contents share an archive.
+	SHA256    string `bson:"sha256" json:"sha256"`
+	SizeBytes int64  `bson:"size_bytes" json:"size_bytes"`
+	// TaskID is the task that saved the entry.
+	TaskID string `bson:"task_id" json:"task_id"`
+	// Patch is whether a patch task saved the entry. Patch tasks' entries are
+	// kept separate from the project's other entries so that changes being
+	// tested in a patch can't affect what mainline tasks restore.
+	Patch          bool      `bson:"patch,omitempty" json:"patch,omitempty"`
+	CreatedAt      time.Time `bson:"created_at" json:"created_at"`
+	LastAccessedAt time.Time `bson:"last_accessed_at" json:"last_accessed_at"`
+}
+
+var (
+	IDKey             = bsonutil.MustHaveTag(Entry{}, "ID")
+	ProjectIDKey      = bsonutil.MustHaveTag(Entry{}, "ProjectID")
+	KeyKey            = bsonutil.MustHaveTag(Entry{}, "Key")
+	SHA256Key         = bsonutil.MustHaveTag(Entry{}, "SHA256")
+	SizeBytesKey      = bsonutil.MustHaveTag(Entry{}, "SizeBytes")
+	TaskIDKey         = bsonutil.MustHaveTag(Entry{}, "TaskID")
+	PatchKey          = bsonutil.MustHaveTag(Entry{}, "Patch")
+	CreatedAtKey      = bsonutil.MustHaveTag(Entry{}, "CreatedAt")
+	LastAccessedAtKey = bsonutil.MustHaveTag(Entry{}, "LastAccessedAt")
+)
+
+// entryID returns the ID of the entry with the given key in the project.
+// Entries that patch tasks save have separate IDs from the project's other
+// entries, so a patch can never take a key that mainline tasks use.
+func entryID(projectID, key string, patch bool) string {
+	if patch {
+		return fmt.Sprintf("patch:%s/%s", projectID, key)
+	}
+	return fmt.Sprintf("%s/%s", projectID, key)
+}
+
+// Save saves the entry if the project doesn't already have an entry with the
+// same key saved by the same kind of task. It returns whether the entry was
+// saved.
+func Save(ctx context.Context, e Entry) (bool, error) {
+	now := time.Now()
+	e.ID = entryID(e.ProjectID, e.Key, e.Patch)
+	changeInfo, err := db.UpsertContext(ctx, Collection, bson.M{IDKey: e.ID}, bson.M{
+		"$setOnInsert": bson.M{
+			ProjectIDKey:      e.ProjectID,
+			KeyKey:            e.Key,
+			SHA256Key:         e.SHA256,
+			SizeBytesKey:      e.SizeBytes,
+			TaskIDKey:         e.TaskID,
+			PatchKey:          e.Patch,
+			CreatedAtKey:      now,
+			LastAccessedAtKey: now,
+		},
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "saving build cache entry '%s' for project '%s'", e.Key, e.ProjectID)
+	}
+	return changeInfo != nil && changeInfo.UpsertedId != nil, nil
+}
+
+// FindOne returns the entry with the given key in the project that a
+// mainline task saved.
+func FindOne(ctx context.Context, projectID, key string) (*Entry, error) {
+	return findOneByID(ctx, projectID, key, false)
+}
+
+func findOneByID(ctx context.Context, projectID, key string, patch bool) (*Entry, error) {
+	e := &Entry{}
+	err := db.FindOneQContext(ctx, Collection, db.Query(bson.M{IDKey: entryID(projectID, key, patch)}), e)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding build cache entry '%s' for project '%s'", key, projectID)
+	}
+	return e, nil
+}
+
+// findLatestWithPrefix returns the most recently saved entry in the project
+// whose key starts with the prefix. Entries saved by patch tasks are only
+// included if patch is true.
+func findLatestWithPrefix(ctx context.Context, projectID, prefix string, patch bool) (*Entry, error) {
+	e := &Entry{}
+	query := bson.M{
+		ProjectIDKey: projectID,
+		KeyKey:       bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)},
+	}
+	if !patch {
+		query[PatchKey] = bson.M{"$ne": true}
+	}
+	q := db.Query(query).Sort([]string{"-" + CreatedAtKey})
+	err := db.FindOneQContext(ctx, Collection, q, e)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding build cache entry with prefix '%s' for project '%s'", prefix, projectID)
+	}
+	return e, nil
+}
+
+// FindForRestore returns the entry in the project to restore for the given
+// key. If there's no entry with the exact key, it falls back to the most
+// recently saved entry matching the first of the prefixes that matches any
+// entries. Mainline tasks only restore entries that mainline tasks saved,
+// while patch tasks prefer their own entries but can also restore mainline
+// ones. The returned entry is marked as accessed, so it's the last to be
+// expired.
+func FindForRestore(ctx context.Context, projectID, key string, prefixes []string, patch bool) (*Entry, error) {
+	var e *Entry
+	var err error
+	if patch {
+		if e, err = findOneByID(ctx, projectID, key, true); err != nil {
+			return nil, err
+		}
+	}
+	if e == nil {
+		if e, err = FindOne(ctx, projectID, key); err != nil {
+			return nil, err
+		}
+	}
+	for _, prefix := range prefixes {
+		if e != nil {
+			break
+		}
+		if e, err = findLatestWithPrefix(ctx, projectID, prefix, patch); err != nil {
+			return nil, err
+		}
+	}
+	if e == nil {
+		return nil, nil
+	}
+
+	e.LastAccessedAt = time.Now()
+	if err := db.UpdateContext(ctx, Collection, bson.M{IDKey: e.ID}, bson.M{"$set": bson.M{LastAccessedAtKey: e.LastAccessedAt}}); err != nil {
+		return nil, errors.Wrapf(err, "marking build cache entry '%s' accessed", e.ID)
+	}
+	return e, nil
+}
+
+// FindByProjectLeastRecentlyUsed returns all of the project's entries,
+// sorted from least to most recently accessed.
+func FindByProjectLeastRecentlyUsed(ctx context.Context, projectID string) ([]Entry, error) {
+	entries := []Entry{}
+	q := db.Query(bson.M{ProjectIDKey: projectID}).Sort([]string{LastAccessedAtKey})
+	if err := db.FindAllQContext(ctx, Collection, q, &entries); err != nil {
+		return nil, errors.Wrapf(err, "finding build cache entries for project '%s'", projectID)
+	}
+	return entries, nil
+}
+
+// ProjectSize is the total size of a project's entries.
+type ProjectSize struct {
+	ProjectID string `bson:"_id"`
+	SizeBytes int64  `bson:"size_bytes"`
+}
+
+// FindProjectsLargerThan returns the total size of the entries of each
+// project whose entries are larger than the given size in total.
+func FindProjectsLargerThan(ctx context.Context, sizeBytes int64) ([]ProjectSize, error) {
+	pipeline := []bson.M{
+		{"$group": bson.M{
+			"_id":        "$" + ProjectIDKey,
+			"size_bytes": bson.M{"$sum": "$" + SizeBytesKey},
+		}},
+		{"$match": bson.M{"size_bytes": bson.M{"$gt": sizeBytes}}},
+	}
+	sizes := []ProjectSize{}
+	if err := db.AggregateContext(ctx, Collection, pipeline, &sizes); err != nil {
+		return nil, errors.Wrap(err, "aggregating build cache sizes by project")
+	}
+	return sizes, nil
+}
+
+// Remove removes the entry. It returns whether any other entries in the
+// project share the entry's archive.
+func Remove(ctx context.Context, e Entry) (bool, error) {
+	if err := db.Remove(ctx, Collection, bson.M{IDKey: e.ID}); err != nil && !adb.ResultsNotFound(err) {
+		return false, errors.Wrapf(err, "removing build cache entry '%s'", e.ID)
+	}
+	count, err := db.CountContext(ctx, Collection, bson.M{
+		ProjectIDKey: e.ProjectID,
+		SHA256Key:    e.SHA256,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "counting build cache entries with sha256 '%s'", e.SHA256)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,180 @@
+package buildcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	testutil.Setup()
+}
+
+func TestSave(t *testing.T) {
+	ctx := t.Context()
+	require.NoError(t, db.ClearCollections(Collection))
+
+	saved, err := Save(ctx, Entry{ProjectID: "p1", Key: "deps-abc", SHA256: "hash1", SizeBytes: 10, TaskID: "t1"})
+	require.NoError(t, err)
+	assert.True(t, saved)
+
+	saved, err = Save(ctx, Entry{ProjectID: "p1", Key: "deps-abc", SHA256: "hash2", SizeBytes: 20, TaskID: "t2"})
+	require.NoError(t, err)
+	assert.False(t, saved, "existing key should not be overwritten")
+
+	e, err := FindOne(ctx, "p1", "deps-abc")
+	require.NoError(t, err)
+	require.NotZero(t, e)
+	assert.Equal(t, "hash1", e.SHA256)
+	assert.Equal(t, "t1", e.TaskID)
+
+	e, err = FindOne(ctx, "p2", "deps-abc")
+	require.NoError(t, err)
+	assert.Zero(t, e, "keys should be scoped to the project")
+}
+
+func TestFindForRestore(t *testing.T) {
+	ctx := t.Context()
+	now := time.Now().Round(time.Millisecond)
+	setup := func(t *testing.T) {
+		require.NoError(t, db.ClearCollections(Collection))
+		for i, key := range []string{"deps-linux-1", "deps-linux-2", "deps-windows-1"} {
+			require.NoError(t, db.Insert(Collection, Entry{
+				ID:             entryID("p1", key, false),
+				ProjectID:      "p1",
+				Key:            key,
+				SHA256:         key,
+				CreatedAt:      now.Add(time.Duration(i) * time.Minute),
+				LastAccessedAt: now.Add(-time.Hour),
+			}))
+		}
+	}
+
+	for tName, tCase := range map[string]struct {
+		key         string
+		prefixes    []string
+		expectedKey string
+	}{
+		"ExactKey": {
+			key:         "deps-linux-1",
+			prefixes:    []string{"deps-"},
+			expectedKey: "deps-linux-1",
+		},
+		"MostRecentMatchingPrefix": {
+			key:         "deps-linux-3",
+			prefixes:    []string{"deps-linux-"},
+			expectedKey: "deps-linux-2",
+		},
+		"FirstMatchingPrefix": {
+			key:         "deps-macos-1",
+			prefixes:    []string{"deps-macos-", "deps-windows-", "deps-"},
+			expectedKey: "deps-windows-1",
+		},
+		"PrefixIsNotARegex": {
+			key:      "deps-macos-1",
+			prefixes: []string{"deps-.*-1"},
+		},
+		"NoMatch": {
+			key: "other",
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			setup(t)
+			e, err := FindForRestore(ctx, "p1", tCase.key, tCase.prefixes, false)
+			require.NoError(t, err)
+			if tCase.expectedKey == "" {
+				assert.Zero(t, e)
+				return
+			}
+			require.NotZero(t, e)
+			assert.Equal(t, tCase.expectedKey, e.Key)
+
+			dbEntry, err := FindOne(ctx, "p1", tCase.expectedKey)
+			require.NoError(t, err)
+			require.NotZero(t, dbEntry)
+			assert.True(t, dbEntry.LastAccessedAt.After(now), "restored entry should be marked accessed")
+		})
+	}
+}
+
+func TestFindForRestorePatchEntries(t *testing.T) {
+	ctx := t.Context()
+	require.NoError(t, db.ClearCollections(Collection))
+
+	saved, err := Save(ctx, Entry{ProjectID: "p1", Key: "deps-1", SHA256: "mainline", TaskID: "t1"})
+	require.NoError(t, err)
+	require.True(t, saved)
+	saved, err = Save(ctx, Entry{ProjectID: "p1", Key: "deps-1", SHA256: "patch", TaskID: "t2", Patch: true})
+	require.NoError(t, err)
+	require.True(t, saved, "patch entries should not conflict with mainline entries")
+	saved, err = Save(ctx, Entry{ProjectID: "p1", Key: "deps-2", SHA256: "patch", TaskID: "t2", Patch: true})
+	require.NoError(t, err)
+	require.True(t, saved)
+
+	e, err := FindForRestore(ctx, "p1", "deps-1", nil, false)
+	require.NoError(t, err)
+	require.NotZero(t, e)
+	assert.Equal(t, "mainline", e.SHA256)
+
+	e, err = FindForRestore(ctx, "p1", "deps-2", []string{"deps-"}, false)
+	require.NoError(t, err)
+	require.NotZero(t, e)
+	assert.Equal(t, "deps-1", e.Key, "mainline tasks should not restore entries saved by patches")
+	assert.Equal(t, "mainline", e.SHA256)
+
+	e, err = FindForRestore(ctx, "p1", "deps-1", nil, true)
+	require.NoError(t, err)
+	require.NotZero(t, e)
+	assert.Equal(t, "patch", e.SHA256, "patch tasks should prefer entries saved by patches")
+
+	e, err = FindForRestore(ctx, "p1", "deps-20", []string{"deps-2"}, true)
+	require.NoError(t, err)
+	require.NotZero(t, e)
+	assert.Equal(t, "deps-2", e.Key)
+}
+
+func TestExpiration(t *testing.T) {
+	ctx := t.Context()
+	require.NoError(t, db.ClearCollections(Collection))
+	now := time.Now().Round(time.Millisecond)
+	for i, e := range []Entry{
+		{ProjectID: "p1", Key: "a", SHA256: "shared", SizeBytes: 30},
+		{ProjectID: "p1", Key: "b", SHA256: "shared", SizeBytes: 30},
+		{ProjectID: "p1", Key: "c", SHA256: "unique", SizeBytes: 50},
+		{ProjectID: "p2", Key: "a", SHA256: "shared", SizeBytes: 10},
+	} {
+		e.ID = entryID(e.ProjectID, e.Key, e.Patch)
+		e.LastAccessedAt = now.Add(-time.Duration(i) * time.Minute)
+		require.NoError(t, db.Insert(Collection, e))
+	}
+
+	sizes, err := FindProjectsLargerThan(ctx, 50)
+	require.NoError(t, err)
+	require.Len(t, sizes, 1)
+	assert.Equal(t, "p1", sizes[0].ProjectID)
+	assert.EqualValues(t, 110, sizes[0].SizeBytes)
+
+	entries, err := FindByProjectLeastRecentlyUsed(ctx, "p1")
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "c", entries[0].Key)
+	assert.Equal(t, "b", entries[1].Key)
+	assert.Equal(t, "a", entries[2].Key)
+
+	referenced, err := Remove(ctx, entries[0])
+	require.NoError(t, err)
+	assert.False(t, referenced)
+
+	referenced, err = Remove(ctx, entries[1])
+	require.NoError(t, err)
+	assert.True(t, referenced, "archive should still be referenced by another entry")
+
+	count, err := db.CountContext(ctx, Collection, bson.M{ProjectIDKey: "p1"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
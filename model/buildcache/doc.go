@@ -0,0 +1,3 @@
+// Package buildcache models the archives that tasks save to and restore from
+// a project's build cache.
+package buildcache
@@ -0,0 +1,170 @@
+package checkpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Collection is the collection of task checkpoints.
+const Collection = "task_checkpoints"
+
+// Checkpoint is the latest checkpoint that a task saved. Each task only has
+// one checkpoint, which is replaced whenever the task saves a new one.
+type Checkpoint struct {
+	// ID is the ID of the task that saved the checkpoint.
+	ID        string `bson:"_id" json:"id"`
+	ProjectID string `bson:"project_id" json:"project_id"`
+	// Execution is the task execution that the checkpoint belongs to, which
+	// is the execution that saved it or the latest execution that resumed
+	// from it.
+	Execution int    `bson:"execution" json:"execution"`
+	Step      string `bson:"step" json:"step"`
+	// Command is the full display name of the command that saved the
+	// checkpoint.
+	Command string `bson:"command" json:"command"`
+	// Directory is the directory that the snapshot contains, relative to
+	// the task's working directory.
+	Directory string `bson:"directory" json:"directory"`
+	// SHA256 is the hash of the snapshot archive.
+	SHA256     string            `bson:"sha256" json:"sha256"`
+	SizeBytes  int64             `bson:"size_bytes" json:"size_bytes"`
+	Expansions map[string]string `bson:"expansions,omitempty" json:"expansions,omitempty"`
+	CreatedAt  time.Time         `bson:"created_at" json:"created_at"`
+}
+
+var (
+	IDKey         = bsonutil.MustHaveTag(Checkpoint{}, "ID")
+	ProjectIDKey  = bsonutil.MustHaveTag(Checkpoint{}, "ProjectID")
+	ExecutionKey  = bsonutil.MustHaveTag(Checkpoint{}, "Execution")
+	StepKey       = bsonutil.MustHaveTag(Checkpoint{}, "Step")
+	CommandKey    = bsonutil.MustHaveTag(Checkpoint{}, "Command")
+	DirectoryKey  = bsonutil.MustHaveTag(Checkpoint{}, "Directory")
+	SHA256Key     = bsonutil.MustHaveTag(Checkpoint{}, "SHA256")
+	SizeBytesKey  = bsonutil.MustHaveTag(Checkpoint{}, "SizeBytes")
+	ExpansionsKey = bsonutil.MustHaveTag(Checkpoint{}, "Expansions")
+	CreatedAtKey  = bsonutil.MustHaveTag(Checkpoint{}, "CreatedAt")
+)
+
+// Save replaces the task's checkpoint with the given one. It returns the
+// checkpoint that was replaced, if any.
+func Save(ctx context.Context, c Checkpoint) (*Checkpoint, error) {
+	prev, err := FindOne(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.CreatedAt = time.Now()
+	if _, err := db.UpsertContext(ctx, Collection, bson.M{IDKey: c.ID}, bson.M{
+		"$set": bson.M{
+			ProjectIDKey:  c.ProjectID,
+			ExecutionKey:  c.Execution,
+			StepKey:       c.Step,
+			CommandKey:    c.Command,
+			DirectoryKey:  c.Directory,
+			SHA256Key:     c.SHA256,
+			SizeBytesKey:  c.SizeBytes,
+			ExpansionsKey: c.Expansions,
+			CreatedAtKey:  c.CreatedAt,
+		},
+	}); err != nil {
+		return nil, errors.Wrapf(err, "saving checkpoint '%s' for task '%s'", c.Step, c.ID)
+	}
+
+	return prev, nil
+}
+
+// FindOne returns the checkpoint that the task saved, if any.
+func FindOne(ctx context.Context, taskID string) (*Checkpoint, error) {
+	c := &Checkpoint{}
+	err := db.FindOneQContext(ctx, Collection, db.Query(bson.M{IDKey: taskID}), c)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding checkpoint for task '%s'", taskID)
+	}
+	return c, nil
+}
+
+// FindResumable returns the checkpoint that the given task execution can
+// resume from. A task can only resume from a checkpoint saved by its
+// previous execution, and only if that execution system failed because its
+// host was lost or it stopped sending heartbeats. Executions that ended for
+// any other reason (e.g. the task failed or a user restarted it) start over.
+func FindResumable(ctx context.Context, t *task.Task) (*Checkpoint, error) {
+	if t.Execution == 0 {
+		return nil, nil
+	}
+	c, err := FindOne(ctx, t.Id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil || c.Execution != t.Execution-1 {
+		return nil, nil
+	}
+
+	prev, err := task.FindOneOldByIdAndExecution(ctx, t.Id, c.Execution)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding task '%s' execution %d", t.Id, c.Execution)
+	}
+	if prev == nil || !lostHost(prev.Details.Type, prev.Details.Description) {
+		return nil, nil
+	}
+
+	return c, nil
+}
+
+// CarryForward moves the checkpoint to the given task execution after that
+// execution resumes from it, so that if the execution's host is also lost
+// before it saves a new checkpoint, the next execution can still resume.
+func (c *Checkpoint) CarryForward(ctx context.Context, execution int) error {
+	err := db.UpdateContext(ctx, Collection, bson.M{
+		IDKey:        c.ID,
+		ExecutionKey: c.Execution,
+	}, bson.M{
+		"$set": bson.M{ExecutionKey: execution},
+	})
+	if adb.ResultsNotFound(err) {
+		// The task already replaced the checkpoint with a newer one.
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "carrying checkpoint '%s' for task '%s' forward to execution %d", c.Step, c.ID, execution)
+	}
+	c.Execution = execution
+	return nil
+}
+
+// lostHost returns whether a task execution with the given end details
+// ended because its host was lost.
+func lostHost(failureType, description string) bool {
+	return failureType == evergreen.CommandTypeSystem &&
+		utility.StringSliceContains([]string{evergreen.TaskDescriptionStranded, evergreen.TaskDescriptionHeartbeat}, description)
+}
+
+// FindCreatedBefore returns the checkpoints saved before the given time.
+func FindCreatedBefore(ctx context.Context, ts time.Time) ([]Checkpoint, error) {
+	checkpoints := []Checkpoint{}
+	q := db.Query(bson.M{CreatedAtKey: bson.M{"$lt": ts}})
+	if err := db.FindAllQContext(ctx, Collection, q, &checkpoints); err != nil {
+		return nil, errors.Wrapf(err, "finding checkpoints saved before %s", ts)
+	}
+	return checkpoints, nil
+}
+
+// Remove removes the task's checkpoint.
+func Remove(ctx context.Context, taskID string) error {
+	if err := db.Remove(ctx, Collection, bson.M{IDKey: taskID}); err != nil && !adb.ResultsNotFound(err) {
+		return errors.Wrapf(err, "removing checkpoint for task '%s'", taskID)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package checkpoint
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	testutil.Setup()
+}
+
+func TestSave(t *testing.T) {
+	ctx := t.Context()
+	require.NoError(t, db.ClearCollections(Collection))
+
+	replaced, err := Save(ctx, Checkpoint{ID: "t1", ProjectID: "p1", Step: "compile", Command: "'checkpoint.save' (step 2 of 4)", SHA256: "hash1"})
+	require.NoError(t, err)
+	assert.Zero(t, replaced)
+
+	replaced, err = Save(ctx, Checkpoint{ID: "t1", ProjectID: "p1", Step: "test", Command: "'checkpoint.save' (step 4 of 4)", SHA256: "hash2", Expansions: map[string]string{"version": "1.0"}})
+	require.NoError(t, err)
+	require.NotZero(t, replaced)
+	assert.Equal(t, "compile", replaced.Step)
+	assert.Equal(t, "hash1", replaced.SHA256)
+
+	c, err := FindOne(ctx, "t1")
+	require.NoError(t, err)
+	require.NotZero(t, c)
+	assert.Equal(t, "test", c.Step)
+	assert.Equal(t, "hash2", c.SHA256)
+	assert.Equal(t, map[string]string{"version": "1.0"}, c.Expansions)
+	assert.False(t, c.CreatedAt.IsZero())
+
+	c, err = FindOne(ctx, "t2")
+	require.NoError(t, err)
+	assert.Zero(t, c)
+}
+
+func TestFindResumable(t *testing.T) {
+	ctx := t.Context()
+	setup := func(t *testing.T, prevDetails apimodels.TaskEndDetail) {
+		require.NoError(t, db.ClearCollections(Collection, task.OldCollection))
+		_, err := Save(ctx, Checkpoint{ID: "t1", ProjectID: "p1", Execution: 0, Step: "compile", Command: "'checkpoint.save'", SHA256: "hash"})
+		require.NoError(t, err)
+		require.NoError(t, db.Insert(task.OldCollection, task.Task{
+			Id:        "t1_0",
+			OldTaskId: "t1",
+			Execution: 0,
+			Status:    evergreen.TaskFailed,
+			Details:   prevDetails,
+		}))
+	}
+
+	for tName, tCase := range map[string]struct {
+		prevDetails apimodels.TaskEndDetail
+		execution   int
+		resumable   bool
+	}{
+		"ResumesAfterStrandedHost": {
+			prevDetails: task.GetSystemFailureDetails(evergreen.TaskDescriptionStranded),
+			execution:   1,
+			resumable:   true,
+		},
+		"ResumesAfterHeartbeatTimeout": {
+			prevDetails: task.GetSystemFailureDetails(evergreen.TaskDescriptionHeartbeat),
+			execution:   1,
+			resumable:   true,
+		},
+		"StartsOverAfterTaskFailure": {
+			prevDetails: apimodels.TaskEndDetail{Status: evergreen.TaskFailed, Type: evergreen.CommandTypeTest},
+			execution:   1,
+		},
+		"StartsOverAfterOtherSystemFailure": {
+			prevDetails: task.GetSystemFailureDetails(evergreen.TaskDescriptionAborted),
+			execution:   1,
+		},
+		"StartsOverForFirstExecution": {
+			prevDetails: task.GetSystemFailureDetails(evergreen.TaskDescriptionStranded),
+			execution:   0,
+		},
+		"StartsOverWhenCheckpointIsFromOlderExecution": {
+			prevDetails: task.GetSystemFailureDetails(evergreen.TaskDescriptionStranded),
+			execution:   2,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			setup(t, tCase.prevDetails)
+			c, err := FindResumable(ctx, &task.Task{Id: "t1", Execution: tCase.execution})
+			require.NoError(t, err)
+			if tCase.resumable {
+				require.NotZero(t, c)
+				assert.Equal(t, "compile", c.Step)
+			} else {
+				assert.Zero(t, c)
+			}
+		})
+	}
+}
+
+func TestCarryForward(t *testing.T) {
+	ctx := t.Context()
+	require.NoError(t, db.ClearCollections(Collection))
+	_, err := Save(ctx, Checkpoint{ID: "t1", ProjectID: "p1", Execution: 0, Step: "compile", Command: "'checkpoint.save'", SHA256: "hash"})
+	require.NoError(t, err)
+
+	c, err := FindOne(ctx, "t1")
+	require.NoError(t, err)
+	require.NotZero(t, c)
+	require.NoError(t, c.CarryForward(ctx, 1))
+	assert.Equal(t, 1, c.Execution)
+
+	c, err = FindOne(ctx, "t1")
+	require.NoError(t, err)
+	require.NotZero(t, c)
+	assert.Equal(t, 1, c.Execution)
+	assert.Equal(t, "compile", c.Step)
+
+	stale := *c
+	stale.Execution = 0
+	assert.NoError(t, stale.CarryForward(ctx, 2), "carrying forward a checkpoint that was already replaced should no-op")
+	c, err = FindOne(ctx, "t1")
+	require.NoError(t, err)
+	require.NotZero(t, c)
+	assert.Equal(t, 1, c.Execution)
+}
@@ -0,0 +1,3 @@
+// Package checkpoint models the checkpoints that long-running tasks save so
+// that a later execution can resume from them if the task's host is lost.
+package checkpoint
@@ -0,0 +1,121 @@
+package cost
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BudgetCollection is the name of the collection containing the per-project
+// monthly budgets.
+const BudgetCollection = "project_cost_budgets"
+
+// ProjectBudget is a project's monthly budget for the cost of running its
+// tasks. A limit of 0 means the project has no such limit.
+type ProjectBudget struct {
+	ProjectID string `bson:"_id" json:"project_id"`
+	// SoftLimit is the monthly cost in dollars above which the project is
+	// warned that it is over budget.
+	SoftLimit float64 `bson:"soft_limit,omitempty" json:"soft_limit,omitempty"`
+	// HardLimit is the monthly cost in dollars above which the project's
+	// patches cannot be scheduled.
+	HardLimit float64 `bson:"hard_limit,omitempty" json:"hard_limit,omitempty"`
+}
+
+var (
+	budgetProjectIDKey = bsonutil.MustHaveTag(ProjectBudget{}, "ProjectID")
+)
+
+// Validate checks that the budget's limits are valid.
+func (b *ProjectBudget) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(b.SoftLimit < 0, "soft limit cannot be negative")
+	catcher.NewWhen(b.HardLimit < 0, "hard limit cannot be negative")
+	catcher.NewWhen(b.SoftLimit > 0 && b.HardLimit > 0 && b.SoftLimit > b.HardLimit, "soft limit cannot exceed hard limit")
+
+	return catcher.Resolve()
+}
+
+// HasLimits returns whether the budget has a soft or hard limit.
+func (b *ProjectBudget) HasLimits() bool {
+	return b.SoftLimit > 0 || b.HardLimit > 0
+}
+
+// FindBudget returns the project's budget. If the project has no budget, an
+// empty budget without any limits is returned.
+func FindBudget(ctx context.Context, env evergreen.Environment, projectID string) (*ProjectBudget, error) {
+	b := &ProjectBudget{}
+	err := env.DB().Collection(BudgetCollection).FindOne(ctx, bson.M{budgetProjectIDKey: projectID}).Decode(b)
+	if err == mongo.ErrNoDocuments {
+		return &ProjectBudget{ProjectID: projectID}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding cost budget for project '%s'", projectID)
+	}
+
+	return b, nil
+}
+
+// SetBudget replaces the project's budget.
+func SetBudget(ctx context.Context, env evergreen.Environment, b ProjectBudget) error {
+	if err := b.Validate(); err != nil {
+		return errors.Wrap(err, "invalid budget")
+	}
+
+	_, err := env.DB().Collection(BudgetCollection).ReplaceOne(ctx,
+		bson.M{budgetProjectIDKey: b.ProjectID},
+		b,
+		options.Replace().SetUpsert(true),
+	)
+
+	return errors.Wrapf(err, "setting cost budget for project '%s'", b.ProjectID)
+}
+
+// BudgetStatus is a project's spending relative to its monthly budget.
+type BudgetStatus struct {
+	ProjectBudget
+	// MonthStart is the start of the current UTC month.
+	MonthStart time.Time `json:"month_start"`
+	// MonthToDateCost is the cost of the project's tasks so far this month.
+	// It only includes costs that have already been rolled up.
+	MonthToDateCost float64 `json:"month_to_date_cost"`
+}
+
+// SoftLimitExceeded returns whether the project's spending this month has
+// exceeded its soft limit.
+func (s *BudgetStatus) SoftLimitExceeded() bool {
+	return s.SoftLimit > 0 && s.MonthToDateCost >= s.SoftLimit
+}
+
+// HardLimitExceeded returns whether the project's spending this month has
+// exceeded its hard limit.
+func (s *BudgetStatus) HardLimitExceeded() bool {
+	return s.HardLimit > 0 && s.MonthToDateCost >= s.HardLimit
+}
+
+// GetBudgetStatus returns the project's spending in the UTC month containing
+// the given time relative to the project's budget.
+func GetBudgetStatus(ctx context.Context, env evergreen.Environment, budget ProjectBudget, now time.Time) (*BudgetStatus, error) {
+	now = now.UTC()
+	status := &BudgetStatus{
+		ProjectBudget: budget,
+		MonthStart:    time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	costs, err := FindProjectDailyCosts(ctx, env, budget.ProjectID, status.MonthStart, now)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding costs for project '%s'", budget.ProjectID)
+	}
+	for _, c := range costs {
+		status.MonthToDateCost += c.Cost
+	}
+
+	return status, nil
+}
@@ -0,0 +1,90 @@
+package cost
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectBudgetValidate(t *testing.T) {
+	assert.NoError(t, (&ProjectBudget{}).Validate())
+	assert.NoError(t, (&ProjectBudget{SoftLimit: 5, HardLimit: 10}).Validate())
+	assert.NoError(t, (&ProjectBudget{SoftLimit: 50}).Validate())
+	assert.Error(t, (&ProjectBudget{SoftLimit: 10, HardLimit: 5}).Validate())
+	assert.Error(t, (&ProjectBudget{SoftLimit: -1}).Validate())
+	assert.Error(t, (&ProjectBudget{HardLimit: -1}).Validate())
+}
+
+func TestBudgets(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(DailyCostCollection, BudgetCollection))
+	}
+	clearAll()
+	defer clearAll()
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	saveTaskCost := func(t *testing.T, date time.Time, projectID string, hours int) {
+		rollup := NewRollup(date)
+		rollup.AddTask(&task.Task{Project: projectID, StartTime: date, FinishTime: date.Add(time.Duration(hours) * time.Hour)}, "user", 1)
+		require.NoError(t, rollup.Save(ctx, env))
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"FindBudgetReturnsEmptyBudget": func(t *testing.T) {
+			budget, err := FindBudget(ctx, env, "project")
+			require.NoError(t, err)
+			assert.Equal(t, "project", budget.ProjectID)
+			assert.False(t, budget.HasLimits())
+		},
+		"SetBudgetFailsWithInvalidLimits": func(t *testing.T) {
+			assert.Error(t, SetBudget(ctx, env, ProjectBudget{ProjectID: "project", SoftLimit: 10, HardLimit: 5}))
+			assert.Error(t, SetBudget(ctx, env, ProjectBudget{ProjectID: "project", HardLimit: -1}))
+		},
+		"BudgetStatusIncludesOnlyThisMonth": func(t *testing.T) {
+			require.NoError(t, SetBudget(ctx, env, ProjectBudget{ProjectID: "project", SoftLimit: 2, HardLimit: 4}))
+			saveTaskCost(t, monthStart, "project", 3)
+			saveTaskCost(t, monthStart.Add(-24*time.Hour), "project", 10)
+
+			budget, err := FindBudget(ctx, env, "project")
+			require.NoError(t, err)
+			status, err := GetBudgetStatus(ctx, env, *budget, now)
+			require.NoError(t, err)
+			assert.Equal(t, 3.0, status.MonthToDateCost)
+			assert.True(t, status.SoftLimitExceeded())
+			assert.False(t, status.HardLimitExceeded())
+		},
+		"BudgetStatusExceedsHardLimit": func(t *testing.T) {
+			saveTaskCost(t, monthStart, "project", 5)
+
+			status, err := GetBudgetStatus(ctx, env, ProjectBudget{ProjectID: "project", SoftLimit: 2, HardLimit: 4}, now)
+			require.NoError(t, err)
+			assert.True(t, status.SoftLimitExceeded())
+			assert.True(t, status.HardLimitExceeded())
+		},
+		"BudgetStatusWithoutLimitsIsNeverExceeded": func(t *testing.T) {
+			saveTaskCost(t, monthStart, "project", 5)
+
+			status, err := GetBudgetStatus(ctx, env, ProjectBudget{ProjectID: "project"}, now)
+			require.NoError(t, err)
+			assert.Equal(t, 5.0, status.MonthToDateCost)
+			assert.False(t, status.SoftLimitExceeded())
+			assert.False(t, status.HardLimitExceeded())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			tCase(t)
+		})
+	}
+}
@@ -0,0 +1,387 @@
+// Package cost attributes the cost of the compute used by tasks and spawn
+// hosts to projects, build variants and users, and enforces per-project
+// monthly budgets.
+package cost
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/pod"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DailyCostCollection is the name of the collection containing the daily
+// cost rollups.
+const DailyCostCollection = "daily_costs"
+
+const (
+	// CostTypeTask is the cost of running tasks.
+	CostTypeTask = "task"
+	// CostTypeSpawnHost is the cost of running spawn hosts.
+	CostTypeSpawnHost = "spawn_host"
+)
+
+const (
+	// DefaultReportNumDays is the number of days included in a cost report
+	// if no start date is given.
+	DefaultReportNumDays = 30
+	// MaxReportNumDays is the maximum number of days that can be included in
+	// a cost report.
+	MaxReportNumDays = 366
+)
+
+const (
+	cpuUnitsPerVCPU = 1024
+	mbPerGB         = 1024
+)
+
+// HostHourlyRate returns the hourly rate of the host. Only EC2 hosts are
+// charged for their runtime, so all other hosts have a rate of 0.
+func HostHourlyRate(conf evergreen.CostConfig, h *host.Host) float64 {
+	if h == nil || !evergreen.IsEc2Provider(h.Provider) {
+		return 0
+	}
+
+	return conf.HourlyRate(hostInstanceType(h), isSpotHost(h))
+}
+
+// hostInstanceType returns the host's EC2 instance type.
+func hostInstanceType(h *host.Host) string {
+	if h.InstanceType != "" {
+		return h.InstanceType
+	}
+	if len(h.Distro.ProviderSettingsList) == 0 {
+		return ""
+	}
+	instanceType, _ := h.Distro.ProviderSettingsList[0].Lookup("instance_type").StringValueOK()
+
+	return instanceType
+}
+
+// isSpotHost returns whether the host is a spot instance. Fleet hosts are spot
+// instances unless their distro is configured to use on-demand instances.
+func isSpotHost(h *host.Host) bool {
+	if h.Provider != evergreen.ProviderNameEc2Fleet {
+		return false
+	}
+	if len(h.Distro.ProviderSettingsList) == 0 {
+		return true
+	}
+	useOnDemand, _ := h.Distro.ProviderSettingsList[0].RecursiveLookup("fleet_options", "use_on_demand").BooleanOK()
+
+	return !useOnDemand
+}
+
+// PodHourlyRate returns the hourly rate of the pod based on the CPU and
+// memory allocated to its task container.
+func PodHourlyRate(conf evergreen.CostConfig, p *pod.Pod) float64 {
+	if p == nil {
+		return 0
+	}
+	vCPUs := float64(p.TaskContainerCreationOpts.CPU) / cpuUnitsPerVCPU
+	memoryGB := float64(p.TaskContainerCreationOpts.MemoryMB) / mbPerGB
+
+	return vCPUs*conf.PodVCPUHourlyRate + memoryGB*conf.PodMemoryGBHourlyRate
+}
+
+// Cost returns the cost of running for the given duration at the given hourly
+// rate.
+func Cost(runtime time.Duration, hourlyRate float64) float64 {
+	if runtime <= 0 {
+		return 0
+	}
+
+	return runtime.Hours() * hourlyRate
+}
+
+// DailyCost is the cost of the compute used in a single UTC day, attributed
+// to a project, build variant and user.
+type DailyCost struct {
+	ID DailyCostID `bson:"_id" json:"id"`
+	// Cost is the cost in dollars.
+	Cost float64 `bson:"cost" json:"cost"`
+	// Runtime is the total compute time.
+	Runtime time.Duration `bson:"runtime" json:"runtime"`
+	// NumTasks is the number of tasks that finished running, for task
+	// costs.
+	NumTasks int `bson:"num_tasks,omitempty" json:"num_tasks,omitempty"`
+	// NumHosts is the number of spawn hosts that were running, for spawn
+	// host costs.
+	NumHosts int `bson:"num_hosts,omitempty" json:"num_hosts,omitempty"`
+}
+
+// DailyCostID identifies what a daily cost is attributed to.
+type DailyCostID struct {
+	// Date is the start of the UTC day.
+	Date time.Time `bson:"date" json:"date"`
+	// Type is the type of compute, either CostTypeTask or
+	// CostTypeSpawnHost.
+	Type string `bson:"type" json:"type"`
+	// ProjectID is the ID of the tasks' project.
+	ProjectID string `bson:"project,omitempty" json:"project,omitempty"`
+	// BuildVariant is the tasks' build variant.
+	BuildVariant string `bson:"variant,omitempty" json:"variant,omitempty"`
+	// Requester is the tasks' requester.
+	Requester string `bson:"requester,omitempty" json:"requester,omitempty"`
+	// User is the author of patch tasks or the owner of spawn hosts.
+	User string `bson:"user,omitempty" json:"user,omitempty"`
+}
+
+var (
+	dailyCostIDKey = bsonutil.MustHaveTag(DailyCost{}, "ID")
+
+	dailyCostIDDateKey      = bsonutil.MustHaveTag(DailyCostID{}, "Date")
+	dailyCostIDProjectIDKey = bsonutil.MustHaveTag(DailyCostID{}, "ProjectID")
+	dailyCostIDUserKey      = bsonutil.MustHaveTag(DailyCostID{}, "User")
+)
+
+// Rollup accumulates the costs of a single UTC day.
+type Rollup struct {
+	date  time.Time
+	costs map[DailyCostID]*DailyCost
+}
+
+// NewRollup returns a new rollup for the UTC day containing the given time.
+func NewRollup(date time.Time) *Rollup {
+	return &Rollup{
+		date:  utility.GetUTCDay(date),
+		costs: map[DailyCostID]*DailyCost{},
+	}
+}
+
+// Date returns the start of the rollup's UTC day.
+func (r *Rollup) Date() time.Time {
+	return r.date
+}
+
+// AddTask attributes the cost of running the task at the given hourly rate to
+// the task's project, build variant and requester and to the given user.
+func (r *Rollup) AddTask(t *task.Task, user string, hourlyRate float64) {
+	runtime := t.FinishTime.Sub(t.StartTime)
+	if utility.IsZeroTime(t.StartTime) || runtime < 0 {
+		runtime = 0
+	}
+
+	c := r.get(DailyCostID{
+		Date:         r.date,
+		Type:         CostTypeTask,
+		ProjectID:    t.Project,
+		BuildVariant: t.BuildVariant,
+		Requester:    t.Requester,
+		User:         user,
+	})
+	c.Cost += Cost(runtime, hourlyRate)
+	c.Runtime += runtime
+	c.NumTasks++
+}
+
+// AddSpawnHost attributes the cost of running the spawn host at the given
+// hourly rate during the rollup's day to the host's owner. Spawn hosts are
+// charged from when they started until they terminated, including any time
+// spent stopped.
+func (r *Rollup) AddSpawnHost(h *host.Host, hourlyRate float64, now time.Time) {
+	start := h.StartTime
+	if start.Before(r.date) {
+		start = r.date
+	}
+	end := h.TerminationTime
+	if utility.IsZeroTime(end) || end.After(now) {
+		end = now
+	}
+	if dayEnd := r.date.Add(24 * time.Hour); end.After(dayEnd) {
+		end = dayEnd
+	}
+	runtime := end.Sub(start)
+	if utility.IsZeroTime(h.StartTime) || runtime <= 0 {
+		return
+	}
+
+	c := r.get(DailyCostID{
+		Date: r.date,
+		Type: CostTypeSpawnHost,
+		User: h.StartedBy,
+	})
+	c.Cost += Cost(runtime, hourlyRate)
+	c.Runtime += runtime
+	c.NumHosts++
+}
+
+func (r *Rollup) get(id DailyCostID) *DailyCost {
+	c, ok := r.costs[id]
+	if !ok {
+		c = &DailyCost{ID: id}
+		r.costs[id] = c
+	}
+
+	return c
+}
+
+// Costs returns the rollup's accumulated costs.
+func (r *Rollup) Costs() []DailyCost {
+	costs := make([]DailyCost, 0, len(r.costs))
+	for _, c := range r.costs {
+		costs = append(costs, *c)
+	}
+	sort.Slice(costs, func(i, j int) bool {
+		return costs[i].Cost > costs[j].Cost
+	})
+
+	return costs
+}
+
+// Save replaces all of the stored costs for the rollup's day with the
+// rollup's accumulated costs.
+func (r *Rollup) Save(ctx context.Context, env evergreen.Environment) error {
+	coll := env.DB().Collection(DailyCostCollection)
+	if _, err := coll.DeleteMany(ctx, bson.M{bsonutil.GetDottedKeyName(dailyCostIDKey, dailyCostIDDateKey): r.date}); err != nil {
+		return errors.Wrapf(err, "deleting existing costs for %s", r.date.Format(time.DateOnly))
+	}
+
+	costs := r.Costs()
+	if len(costs) == 0 {
+		return nil
+	}
+	docs := make([]any, 0, len(costs))
+	for _, c := range costs {
+		docs = append(docs, c)
+	}
+	if _, err := coll.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false)); err != nil {
+		return errors.Wrapf(err, "inserting costs for %s", r.date.Format(time.DateOnly))
+	}
+
+	return nil
+}
+
+// FindProjectDailyCosts returns the project's task costs for the UTC days
+// between the start and end dates, inclusive.
+func FindProjectDailyCosts(ctx context.Context, env evergreen.Environment, projectID string, startDate, endDate time.Time) ([]DailyCost, error) {
+	return findDailyCosts(ctx, env, bson.M{
+		bsonutil.GetDottedKeyName(dailyCostIDKey, dailyCostIDProjectIDKey): projectID,
+	}, startDate, endDate)
+}
+
+// FindUserDailyCosts returns the user's patch task and spawn host costs for
+// the UTC days between the start and end dates, inclusive.
+func FindUserDailyCosts(ctx context.Context, env evergreen.Environment, user string, startDate, endDate time.Time) ([]DailyCost, error) {
+	return findDailyCosts(ctx, env, bson.M{
+		bsonutil.GetDottedKeyName(dailyCostIDKey, dailyCostIDUserKey): user,
+	}, startDate, endDate)
+}
+
+func findDailyCosts(ctx context.Context, env evergreen.Environment, filter bson.M, startDate, endDate time.Time) ([]DailyCost, error) {
+	filter[bsonutil.GetDottedKeyName(dailyCostIDKey, dailyCostIDDateKey)] = bson.M{
+		"$gte": utility.GetUTCDay(startDate),
+		"$lte": utility.GetUTCDay(endDate),
+	}
+	cur, err := env.DB().Collection(DailyCostCollection).Find(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding daily costs")
+	}
+	var costs []DailyCost
+	if err = cur.All(ctx, &costs); err != nil {
+		return nil, errors.Wrap(err, "decoding daily costs")
+	}
+
+	return costs, nil
+}
+
+// Breakdown is the portion of a report's cost attributed to a single
+// project, build variant, user or day.
+type Breakdown struct {
+	Name    string        `json:"name"`
+	Cost    float64       `json:"cost"`
+	Runtime time.Duration `json:"runtime"`
+}
+
+// Report summarizes daily costs.
+type Report struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	// TotalCost is the total cost in dollars.
+	TotalCost float64 `json:"total_cost"`
+	// TaskCost is the cost of running tasks.
+	TaskCost float64 `json:"task_cost"`
+	// SpawnHostCost is the cost of running spawn hosts.
+	SpawnHostCost float64 `json:"spawn_host_cost"`
+	// ByDay breaks the cost down by UTC day, named by date, oldest first.
+	ByDay []Breakdown `json:"by_day"`
+	// ByProject breaks the task cost down by project ID, most expensive
+	// first.
+	ByProject []Breakdown `json:"by_project"`
+	// ByBuildVariant breaks the task cost down by build variant, most
+	// expensive first.
+	ByBuildVariant []Breakdown `json:"by_build_variant"`
+	// ByUser breaks the cost down by patch author and spawn host owner, most
+	// expensive first.
+	ByUser []Breakdown `json:"by_user"`
+}
+
+// NewReport summarizes the daily costs between the start and end dates.
+func NewReport(costs []DailyCost, startDate, endDate time.Time) *Report {
+	report := &Report{
+		StartDate: utility.GetUTCDay(startDate),
+		EndDate:   utility.GetUTCDay(endDate),
+	}
+	byDay := map[string]*Breakdown{}
+	byProject := map[string]*Breakdown{}
+	byBuildVariant := map[string]*Breakdown{}
+	byUser := map[string]*Breakdown{}
+	add := func(breakdowns map[string]*Breakdown, name string, c DailyCost) {
+		if name == "" {
+			return
+		}
+		b, ok := breakdowns[name]
+		if !ok {
+			b = &Breakdown{Name: name}
+			breakdowns[name] = b
+		}
+		b.Cost += c.Cost
+		b.Runtime += c.Runtime
+	}
+
+	for _, c := range costs {
+		report.TotalCost += c.Cost
+		switch c.ID.Type {
+		case CostTypeTask:
+			report.TaskCost += c.Cost
+		case CostTypeSpawnHost:
+			report.SpawnHostCost += c.Cost
+		}
+		add(byDay, c.ID.Date.UTC().Format(time.DateOnly), c)
+		add(byProject, c.ID.ProjectID, c)
+		add(byBuildVariant, c.ID.BuildVariant, c)
+		add(byUser, c.ID.User, c)
+	}
+
+	report.ByDay = sortedBreakdowns(byDay, func(a, b Breakdown) bool { return a.Name < b.Name })
+	byCost := func(a, b Breakdown) bool {
+		if a.Cost != b.Cost {
+			return a.Cost > b.Cost
+		}
+		return a.Name < b.Name
+	}
+	report.ByProject = sortedBreakdowns(byProject, byCost)
+	report.ByBuildVariant = sortedBreakdowns(byBuildVariant, byCost)
+	report.ByUser = sortedBreakdowns(byUser, byCost)
+
+	return report
+}
+
+func sortedBreakdowns(breakdowns map[string]*Breakdown, less func(a, b Breakdown) bool) []Breakdown {
+	sorted := make([]Breakdown, 0, len(breakdowns))
+	for _, b := range breakdowns {
+		sorted = append(sorted, *b)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+
+	return sorted
+}
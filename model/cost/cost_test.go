@@ -0,0 +1,187 @@
+package cost
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/birch"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/pod"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHourlyRates(t *testing.T) {
+	conf := evergreen.CostConfig{
+		InstanceTypeRates: []evergreen.InstanceTypeRate{
+			{InstanceType: "m5.xlarge", OnDemandHourlyRate: 0.2, SpotHourlyRate: 0.08},
+		},
+		DefaultHourlyRate:     0.1,
+		PodVCPUHourlyRate:     0.04,
+		PodMemoryGBHourlyRate: 0.004,
+	}
+	makeHost := func(provider string, settings ...*birch.Element) *host.Host {
+		return &host.Host{
+			Provider: provider,
+			Distro: distro.Distro{
+				ProviderSettingsList: []*birch.Document{birch.NewDocument(settings...)},
+			},
+		}
+	}
+
+	t.Run("OnDemandHost", func(t *testing.T) {
+		h := makeHost(evergreen.ProviderNameEc2OnDemand, birch.EC.String("instance_type", "m5.xlarge"))
+		assert.Equal(t, 0.2, HostHourlyRate(conf, h))
+	})
+	t.Run("SpotFleetHost", func(t *testing.T) {
+		h := makeHost(evergreen.ProviderNameEc2Fleet, birch.EC.String("instance_type", "m5.xlarge"))
+		assert.Equal(t, 0.08, HostHourlyRate(conf, h))
+	})
+	t.Run("OnDemandFleetHost", func(t *testing.T) {
+		h := makeHost(evergreen.ProviderNameEc2Fleet,
+			birch.EC.String("instance_type", "m5.xlarge"),
+			birch.EC.SubDocumentFromElements("fleet_options", birch.EC.Boolean("use_on_demand", true)),
+		)
+		assert.Equal(t, 0.2, HostHourlyRate(conf, h))
+	})
+	t.Run("HostInstanceTypeTakesPrecedenceOverDistro", func(t *testing.T) {
+		h := makeHost(evergreen.ProviderNameEc2OnDemand, birch.EC.String("instance_type", "c5.large"))
+		h.InstanceType = "m5.xlarge"
+		assert.Equal(t, 0.2, HostHourlyRate(conf, h))
+	})
+	t.Run("UnknownInstanceTypeUsesDefaultRate", func(t *testing.T) {
+		h := makeHost(evergreen.ProviderNameEc2OnDemand, birch.EC.String("instance_type", "c5.large"))
+		assert.Equal(t, 0.1, HostHourlyRate(conf, h))
+	})
+	t.Run("NonEC2HostIsFree", func(t *testing.T) {
+		assert.Zero(t, HostHourlyRate(conf, makeHost(evergreen.ProviderNameStatic)))
+	})
+	t.Run("Pod", func(t *testing.T) {
+		p := &pod.Pod{TaskContainerCreationOpts: pod.TaskContainerCreationOptions{CPU: 2048, MemoryMB: 4096}}
+		assert.InDelta(t, 2*0.04+4*0.004, PodHourlyRate(conf, p), 1e-9)
+	})
+}
+
+func TestRollup(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("AttributesTasks", func(t *testing.T) {
+		rollup := NewRollup(date.Add(5 * time.Hour))
+		tsk := task.Task{
+			Project:      "project",
+			BuildVariant: "variant",
+			Requester:    evergreen.PatchVersionRequester,
+			StartTime:    date.Add(time.Hour),
+			FinishTime:   date.Add(3 * time.Hour),
+		}
+		rollup.AddTask(&tsk, "user", 1.5)
+		rollup.AddTask(&tsk, "user", 1.5)
+
+		costs := rollup.Costs()
+		require.Len(t, costs, 1)
+		assert.Equal(t, DailyCostID{
+			Date:         date,
+			Type:         CostTypeTask,
+			ProjectID:    "project",
+			BuildVariant: "variant",
+			Requester:    evergreen.PatchVersionRequester,
+			User:         "user",
+		}, costs[0].ID)
+		assert.Equal(t, 6.0, costs[0].Cost)
+		assert.Equal(t, 4*time.Hour, costs[0].Runtime)
+		assert.Equal(t, 2, costs[0].NumTasks)
+	})
+	t.Run("ChargesSpawnHostsOnlyForTheDay", func(t *testing.T) {
+		rollup := NewRollup(date)
+		rollup.AddSpawnHost(&host.Host{StartedBy: "user", StartTime: date.Add(-48 * time.Hour)}, 1, date.Add(72*time.Hour))
+		rollup.AddSpawnHost(&host.Host{StartedBy: "user", StartTime: date.Add(20 * time.Hour), TerminationTime: date.Add(22 * time.Hour)}, 1, date.Add(72*time.Hour))
+		rollup.AddSpawnHost(&host.Host{StartedBy: "user", StartTime: date.Add(-2 * time.Hour), TerminationTime: date.Add(-time.Hour)}, 1, date.Add(72*time.Hour))
+
+		costs := rollup.Costs()
+		require.Len(t, costs, 1)
+		assert.Equal(t, CostTypeSpawnHost, costs[0].ID.Type)
+		assert.Equal(t, "user", costs[0].ID.User)
+		assert.Equal(t, 26.0, costs[0].Cost)
+		assert.Equal(t, 2, costs[0].NumHosts)
+	})
+	t.Run("ChargesRunningSpawnHostsUntilNow", func(t *testing.T) {
+		rollup := NewRollup(date)
+		rollup.AddSpawnHost(&host.Host{StartedBy: "user", StartTime: date.Add(time.Hour)}, 1, date.Add(3*time.Hour))
+
+		costs := rollup.Costs()
+		require.Len(t, costs, 1)
+		assert.Equal(t, 2.0, costs[0].Cost)
+	})
+}
+
+func TestNewReport(t *testing.T) {
+	day0 := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	day1 := day0.Add(24 * time.Hour)
+	report := NewReport([]DailyCost{
+		{ID: DailyCostID{Date: day0, Type: CostTypeTask, ProjectID: "project", BuildVariant: "bv0", User: "user0"}, Cost: 1, Runtime: time.Hour},
+		{ID: DailyCostID{Date: day1, Type: CostTypeTask, ProjectID: "project", BuildVariant: "bv1"}, Cost: 3, Runtime: 3 * time.Hour},
+		{ID: DailyCostID{Date: day1, Type: CostTypeSpawnHost, User: "user1"}, Cost: 2, Runtime: 2 * time.Hour},
+	}, day0, day1)
+
+	assert.Equal(t, 6.0, report.TotalCost)
+	assert.Equal(t, 4.0, report.TaskCost)
+	assert.Equal(t, 2.0, report.SpawnHostCost)
+	assert.Equal(t, []Breakdown{{Name: "2024-03-10", Cost: 1, Runtime: time.Hour}, {Name: "2024-03-11", Cost: 5, Runtime: 5 * time.Hour}}, report.ByDay)
+	assert.Equal(t, []Breakdown{{Name: "project", Cost: 4, Runtime: 4 * time.Hour}}, report.ByProject)
+	assert.Equal(t, []Breakdown{{Name: "bv1", Cost: 3, Runtime: 3 * time.Hour}, {Name: "bv0", Cost: 1, Runtime: time.Hour}}, report.ByBuildVariant)
+	assert.Equal(t, []Breakdown{{Name: "user1", Cost: 2, Runtime: 2 * time.Hour}, {Name: "user0", Cost: 1, Runtime: time.Hour}}, report.ByUser)
+}
+
+func TestDailyCosts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(DailyCostCollection))
+	}
+	clearAll()
+	defer clearAll()
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	saveTaskCost := func(t *testing.T, date time.Time, projectID string, hours int) {
+		rollup := NewRollup(date)
+		rollup.AddTask(&task.Task{Project: projectID, StartTime: date, FinishTime: date.Add(time.Duration(hours) * time.Hour)}, "user", 1)
+		require.NoError(t, rollup.Save(ctx, env))
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"SaveReplacesExistingCostsForTheDay": func(t *testing.T) {
+			saveTaskCost(t, monthStart, "project", 1)
+			saveTaskCost(t, monthStart, "project", 2)
+
+			costs, err := FindProjectDailyCosts(ctx, env, "project", monthStart, monthStart)
+			require.NoError(t, err)
+			require.Len(t, costs, 1)
+			assert.Equal(t, 2.0, costs[0].Cost)
+		},
+		"FindsCostsByProjectAndUser": func(t *testing.T) {
+			saveTaskCost(t, monthStart, "project", 1)
+
+			costs, err := FindProjectDailyCosts(ctx, env, "other_project", monthStart, monthStart)
+			require.NoError(t, err)
+			assert.Empty(t, costs)
+
+			costs, err = FindUserDailyCosts(ctx, env, "user", monthStart, monthStart)
+			require.NoError(t, err)
+			assert.Len(t, costs, 1)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			tCase(t)
+		})
+	}
+}
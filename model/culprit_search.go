@@ -0,0 +1,436 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/annotations"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CulpritSearchCollection is the collection of searches for the commits that
+// caused mainline tasks to fail.
+const CulpritSearchCollection = "culprit_searches"
+
+const (
+	// CulpritSearchRunning means the search is waiting for a task it
+	// activated to finish.
+	CulpritSearchRunning = "running"
+	// CulpritSearchFound means the search found the first failing commit.
+	CulpritSearchFound = "found"
+	// CulpritSearchInconclusive means the search stopped before it could
+	// find the first failing commit.
+	CulpritSearchInconclusive = "inconclusive"
+)
+
+const (
+	// maxCulpritSearchDuration is how long a search can run before it gives
+	// up waiting for the tasks it activated.
+	maxCulpritSearchDuration = 7 * 24 * time.Hour
+	// maxCulpritSearchLookback is the maximum number of earlier completed
+	// tasks to check for one that passes when starting a search for a test.
+	maxCulpritSearchLookback = 50
+)
+
+// CulpritSearch is a search for the commit that caused a mainline task, or a
+// single test in it, to start failing. The search bisects the commits between
+// the last passing and the first known failing task, activating the task at
+// the midpoint each time until the two are adjacent.
+type CulpritSearch struct {
+	ID string `bson:"_id" json:"id"`
+	// TaskID and Execution are the failing task that the search started
+	// from.
+	TaskID       string `bson:"task_id" json:"task_id"`
+	Execution    int    `bson:"execution" json:"execution"`
+	ProjectID    string `bson:"project_id" json:"project_id"`
+	BuildVariant string `bson:"build_variant" json:"build_variant"`
+	DisplayName  string `bson:"display_name" json:"display_name"`
+	// TestName is the test to find the culprit for. If it's empty, the
+	// search is for the task as a whole.
+	TestName string `bson:"test_name,omitempty" json:"test_name,omitempty"`
+	Status   string `bson:"status" json:"status"`
+	// Reason explains why an inconclusive search stopped.
+	Reason string `bson:"reason,omitempty" json:"reason,omitempty"`
+	// LastPassingTaskID and LastFailingTaskID bound the commits that may have
+	// caused the failure.
+	LastPassingTaskID string `bson:"last_passing_task_id" json:"last_passing_task_id"`
+	LastFailingTaskID string `bson:"last_failing_task_id" json:"last_failing_task_id"`
+	// CurrentTaskID is the task the search is waiting on.
+	CurrentTaskID string `bson:"current_task_id,omitempty" json:"current_task_id,omitempty"`
+	// ActivatedTaskIDs are the tasks that the search activated.
+	ActivatedTaskIDs []string `bson:"activated_task_ids,omitempty" json:"activated_task_ids,omitempty"`
+	// CulpritTaskID is the first failing task, which ran on the commit that
+	// caused the failure.
+	CulpritTaskID string    `bson:"culprit_task_id,omitempty" json:"culprit_task_id,omitempty"`
+	CreatedBy     string    `bson:"created_by" json:"created_by"`
+	CreatedAt     time.Time `bson:"created_at" json:"created_at"`
+	FinishedAt    time.Time `bson:"finished_at,omitempty" json:"finished_at,omitempty"`
+}
+
+var (
+	CulpritSearchIDKey                = bsonutil.MustHaveTag(CulpritSearch{}, "ID")
+	CulpritSearchTaskIDKey            = bsonutil.MustHaveTag(CulpritSearch{}, "TaskID")
+	CulpritSearchExecutionKey         = bsonutil.MustHaveTag(CulpritSearch{}, "Execution")
+	CulpritSearchTestNameKey          = bsonutil.MustHaveTag(CulpritSearch{}, "TestName")
+	CulpritSearchStatusKey            = bsonutil.MustHaveTag(CulpritSearch{}, "Status")
+	CulpritSearchReasonKey            = bsonutil.MustHaveTag(CulpritSearch{}, "Reason")
+	CulpritSearchLastPassingTaskIDKey = bsonutil.MustHaveTag(CulpritSearch{}, "LastPassingTaskID")
+	CulpritSearchLastFailingTaskIDKey = bsonutil.MustHaveTag(CulpritSearch{}, "LastFailingTaskID")
+	CulpritSearchCurrentTaskIDKey     = bsonutil.MustHaveTag(CulpritSearch{}, "CurrentTaskID")
+	CulpritSearchActivatedTaskIDsKey  = bsonutil.MustHaveTag(CulpritSearch{}, "ActivatedTaskIDs")
+	CulpritSearchCulpritTaskIDKey     = bsonutil.MustHaveTag(CulpritSearch{}, "CulpritTaskID")
+	CulpritSearchCreatedAtKey         = bsonutil.MustHaveTag(CulpritSearch{}, "CreatedAt")
+	CulpritSearchFinishedAtKey        = bsonutil.MustHaveTag(CulpritSearch{}, "FinishedAt")
+)
+
+// StartCulpritSearch starts a search for the commit that caused the task, or
+// the given test in it, to fail. If there's already a search running for the
+// same task and test, that search is returned instead.
+func StartCulpritSearch(ctx context.Context, env evergreen.Environment, t *task.Task, testName, caller string) (*CulpritSearch, error) {
+	if !utility.StringSliceContains(evergreen.SystemVersionRequesterTypes, t.Requester) {
+		return nil, errors.Errorf("task '%s' is not a mainline task", t.Id)
+	}
+	if !t.IsFinished() {
+		return nil, errors.Errorf("task '%s' has not finished", t.Id)
+	}
+	existing, err := findOneCulpritSearch(ctx, bson.M{
+		CulpritSearchTaskIDKey:    t.Id,
+		CulpritSearchExecutionKey: t.Execution,
+		CulpritSearchTestNameKey:  testName,
+		CulpritSearchStatusKey:    CulpritSearchRunning,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	s := &CulpritSearch{
+		ID:           mgobson.NewObjectId().Hex(),
+		TaskID:       t.Id,
+		Execution:    t.Execution,
+		ProjectID:    t.Project,
+		BuildVariant: t.BuildVariant,
+		DisplayName:  t.DisplayName,
+		TestName:     testName,
+		Status:       CulpritSearchRunning,
+		CreatedBy:    caller,
+		CreatedAt:    time.Now(),
+	}
+	failing, inconclusiveReason, err := s.isFailing(ctx, env, t)
+	if err != nil {
+		return nil, err
+	}
+	if inconclusiveReason != "" {
+		return nil, errors.Errorf("cannot search for the culprit of task '%s' because it %s", t.Id, inconclusiveReason)
+	}
+	if !failing {
+		if testName != "" {
+			return nil, errors.Errorf("test '%s' did not fail in task '%s'", testName, t.Id)
+		}
+		return nil, errors.Errorf("task '%s' did not fail", t.Id)
+	}
+	lastPassing, err := s.findLastPassingTask(ctx, env, t)
+	if err != nil {
+		return nil, err
+	}
+	if lastPassing == nil {
+		return nil, errors.Errorf("no earlier passing task found for task '%s'", t.Id)
+	}
+	s.LastPassingTaskID = lastPassing.Id
+	s.LastFailingTaskID = t.Id
+
+	if err = db.Insert(CulpritSearchCollection, s); err != nil {
+		return nil, errors.Wrapf(err, "inserting culprit search for task '%s'", t.Id)
+	}
+	grip.Info(message.Fields{
+		"message":         "started culprit search",
+		"search_id":       s.ID,
+		"task_id":         t.Id,
+		"test_name":       testName,
+		"last_passing_id": lastPassing.Id,
+		"gap":             t.RevisionOrderNumber - lastPassing.RevisionOrderNumber,
+		"project_id":      t.Project,
+		"caller":          caller,
+	})
+	return s, nil
+}
+
+// findLastPassingTask returns the most recent task before t in which the
+// search's task or test passed.
+func (s *CulpritSearch) findLastPassingTask(ctx context.Context, env evergreen.Environment, t *task.Task) (*task.Task, error) {
+	if s.TestName == "" {
+		prev, err := t.PreviousCompletedTask(ctx, t.Project, []string{evergreen.TaskSucceeded})
+		return prev, errors.Wrapf(err, "finding previous successful task for task '%s'", t.Id)
+	}
+
+	prev := t
+	for i := 0; i < maxCulpritSearchLookback; i++ {
+		var err error
+		prev, err = prev.PreviousCompletedTask(ctx, t.Project, []string{evergreen.TaskSucceeded, evergreen.TaskFailed})
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding previous completed task for task '%s'", t.Id)
+		}
+		if prev == nil {
+			return nil, nil
+		}
+		failing, inconclusiveReason, err := s.isFailing(ctx, env, prev)
+		if err != nil {
+			return nil, err
+		}
+		if inconclusiveReason == "" && !failing {
+			return prev, nil
+		}
+	}
+	return nil, nil
+}
+
+// isFailing returns whether the finished task exhibits the failure the search
+// is looking for. If the task's result can't show whether it does, such as when
+// the task hit a system failure before it could run the test, it instead
+// returns the reason why the result is inconclusive.
+func (s *CulpritSearch) isFailing(ctx context.Context, env evergreen.Environment, t *task.Task) (failing bool, inconclusiveReason string, err error) {
+	switch t.Details.Type {
+	case evergreen.CommandTypeSystem:
+		return false, "had a system failure", nil
+	case evergreen.CommandTypeSetup:
+		return false, "had a setup failure", nil
+	}
+	if s.TestName == "" {
+		return t.Status != evergreen.TaskSucceeded, "", nil
+	}
+
+	testName := "^" + regexp.QuoteMeta(s.TestName) + "$"
+	results, err := t.GetTestResults(ctx, env, &testresult.FilterOptions{
+		TestName: testName,
+		Statuses: []string{evergreen.TestFailedStatus},
+		Limit:    1,
+	})
+	if err != nil {
+		return false, "", errors.Wrapf(err, "getting test results for task '%s'", t.Id)
+	}
+	if len(results.Results) > 0 {
+		return true, "", nil
+	}
+	results, err = t.GetTestResults(ctx, env, &testresult.FilterOptions{
+		TestName: testName,
+		Limit:    1,
+	})
+	if err != nil {
+		return false, "", errors.Wrapf(err, "getting test results for task '%s'", t.Id)
+	}
+	if len(results.Results) == 0 {
+		return false, fmt.Sprintf("has no results for test '%s'", s.TestName), nil
+	}
+	return false, "", nil
+}
+
+// Advance moves the search forward once the task it's waiting on finishes,
+// activating the next task to bisect the remaining commits or recording the
+// culprit once it's found.
+func (s *CulpritSearch) Advance(ctx context.Context, env evergreen.Environment) error {
+	if s.Status != CulpritSearchRunning {
+		return nil
+	}
+	if time.Since(s.CreatedAt) > maxCulpritSearchDuration {
+		return s.stop(ctx, "timed out waiting for activated tasks to finish")
+	}
+
+	for {
+		if s.CurrentTaskID != "" {
+			current, err := task.FindOneId(ctx, s.CurrentTaskID)
+			if err != nil {
+				return errors.Wrapf(err, "finding task '%s'", s.CurrentTaskID)
+			}
+			if current == nil {
+				return s.stop(ctx, fmt.Sprintf("task '%s' no longer exists", s.CurrentTaskID))
+			}
+			if !current.IsFinished() {
+				if !current.Activated {
+					return s.stop(ctx, fmt.Sprintf("task '%s' was deactivated", current.Id))
+				}
+				return s.save(ctx)
+			}
+			failing, inconclusiveReason, err := s.isFailing(ctx, env, current)
+			if err != nil {
+				return err
+			}
+			if inconclusiveReason != "" {
+				return s.stop(ctx, fmt.Sprintf("task '%s' %s", current.Id, inconclusiveReason))
+			}
+			if failing {
+				s.LastFailingTaskID = current.Id
+			} else {
+				s.LastPassingTaskID = current.Id
+			}
+			s.CurrentTaskID = ""
+		}
+
+		next, err := task.ByBeforeMidwayTaskFromIds(ctx, s.LastFailingTaskID, s.LastPassingTaskID)
+		if err != nil {
+			return errors.Wrap(err, "finding midway task")
+		}
+		if next.Id == s.LastPassingTaskID {
+			return s.finish(ctx, env)
+		}
+		s.CurrentTaskID = next.Id
+		if next.IsFinished() {
+			// The task already ran, so there's nothing to wait for.
+			continue
+		}
+		if !next.Activated {
+			if err = SetActiveState(ctx, evergreen.CulpritFinderTaskActivator, true, *next); err != nil {
+				return errors.Wrapf(err, "activating task '%s'", next.Id)
+			}
+			s.ActivatedTaskIDs = append(s.ActivatedTaskIDs, next.Id)
+			grip.Info(message.Fields{
+				"message":         "culprit search activated task",
+				"search_id":       s.ID,
+				"task_id":         s.TaskID,
+				"activated_id":    next.Id,
+				"last_passing_id": s.LastPassingTaskID,
+				"last_failing_id": s.LastFailingTaskID,
+				"project_id":      s.ProjectID,
+			})
+		}
+		return s.save(ctx)
+	}
+}
+
+// finish records that the last failing task is the culprit, adds it to the
+// original task's annotation as a suspected issue, and logs an event so that
+// subscribers are notified.
+func (s *CulpritSearch) finish(ctx context.Context, env evergreen.Environment) error {
+	s.Status = CulpritSearchFound
+	s.CulpritTaskID = s.LastFailingTaskID
+	s.FinishedAt = time.Now()
+	if err := s.save(ctx); err != nil {
+		return err
+	}
+
+	culprit, err := task.FindOneId(ctx, s.CulpritTaskID)
+	if err != nil {
+		return errors.Wrapf(err, "finding culprit task '%s'", s.CulpritTaskID)
+	}
+	if culprit == nil {
+		return errors.Errorf("culprit task '%s' not found", s.CulpritTaskID)
+	}
+	v, err := VersionFindOneId(ctx, culprit.Version)
+	if err != nil {
+		return errors.Wrapf(err, "finding version '%s'", culprit.Version)
+	}
+	if v == nil {
+		return errors.Errorf("version '%s' not found", culprit.Version)
+	}
+
+	issue := annotations.IssueLink{
+		URL:      fmt.Sprintf("%s/version/%s", env.Settings().Ui.Url, v.Id),
+		IssueKey: fmt.Sprintf("Suspected culprit %s by %s", shortRevision(v.Revision), v.Author),
+	}
+	if err = annotations.AddSuspectedCulpritToAnnotation(ctx, s.TaskID, s.Execution, issue, v.Author); err != nil {
+		return err
+	}
+	event.LogTaskCulpritFound(s.TaskID, s.Execution, s.CulpritTaskID, s.TestName)
+
+	grip.Info(message.Fields{
+		"message":       "culprit search found culprit",
+		"search_id":     s.ID,
+		"task_id":       s.TaskID,
+		"test_name":     s.TestName,
+		"culprit_id":    s.CulpritTaskID,
+		"revision":      v.Revision,
+		"author":        v.Author,
+		"num_activated": len(s.ActivatedTaskIDs),
+		"duration_secs": s.FinishedAt.Sub(s.CreatedAt).Seconds(),
+		"project_id":    s.ProjectID,
+	})
+	return nil
+}
+
+// shortRevision returns the abbreviated form of the commit hash.
+func shortRevision(revision string) string {
+	if len(revision) > 7 {
+		return revision[:7]
+	}
+	return revision
+}
+
+// stop marks the search inconclusive for the given reason.
+func (s *CulpritSearch) stop(ctx context.Context, reason string) error {
+	s.Status = CulpritSearchInconclusive
+	s.Reason = reason
+	s.FinishedAt = time.Now()
+	grip.Info(message.Fields{
+		"message":    "culprit search was inconclusive",
+		"search_id":  s.ID,
+		"task_id":    s.TaskID,
+		"reason":     reason,
+		"project_id": s.ProjectID,
+	})
+	return s.save(ctx)
+}
+
+func (s *CulpritSearch) save(ctx context.Context) error {
+	err := db.UpdateContext(ctx, CulpritSearchCollection,
+		bson.M{CulpritSearchIDKey: s.ID},
+		bson.M{"$set": bson.M{
+			CulpritSearchStatusKey:            s.Status,
+			CulpritSearchReasonKey:            s.Reason,
+			CulpritSearchLastPassingTaskIDKey: s.LastPassingTaskID,
+			CulpritSearchLastFailingTaskIDKey: s.LastFailingTaskID,
+			CulpritSearchCurrentTaskIDKey:     s.CurrentTaskID,
+			CulpritSearchActivatedTaskIDsKey:  s.ActivatedTaskIDs,
+			CulpritSearchCulpritTaskIDKey:     s.CulpritTaskID,
+			CulpritSearchFinishedAtKey:        s.FinishedAt,
+		}},
+	)
+	return errors.Wrapf(err, "saving culprit search '%s'", s.ID)
+}
+
+func findOneCulpritSearch(ctx context.Context, query bson.M) (*CulpritSearch, error) {
+	s := &CulpritSearch{}
+	err := db.FindOneQContext(ctx, CulpritSearchCollection, db.Query(query), s)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "finding culprit search")
+	}
+	return s, nil
+}
+
+// FindCulpritSearchesByTask returns the searches started from any execution
+// of the task, newest first.
+func FindCulpritSearchesByTask(ctx context.Context, taskID string) ([]CulpritSearch, error) {
+	searches := []CulpritSearch{}
+	q := db.Query(bson.M{CulpritSearchTaskIDKey: taskID}).Sort([]string{"-" + CulpritSearchCreatedAtKey})
+	if err := db.FindAllQContext(ctx, CulpritSearchCollection, q, &searches); err != nil {
+		return nil, errors.Wrapf(err, "finding culprit searches for task '%s'", taskID)
+	}
+	return searches, nil
+}
+
+// FindRunningCulpritSearches returns all searches that haven't finished.
+func FindRunningCulpritSearches(ctx context.Context) ([]CulpritSearch, error) {
+	searches := []CulpritSearch{}
+	q := db.Query(bson.M{CulpritSearchStatusKey: CulpritSearchRunning}).Sort([]string{CulpritSearchCreatedAtKey})
+	if err := db.FindAllQContext(ctx, CulpritSearchCollection, q, &searches); err != nil {
+		return nil, errors.Wrap(err, "finding running culprit searches")
+	}
+	return searches, nil
+}
@@ -0,0 +1,252 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/annotations"
+	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestCulpritSearch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	collections := []string{CulpritSearchCollection, task.Collection, build.Collection, VersionCollection, annotations.Collection, event.EventCollection}
+	defer func() {
+		assert.NoError(t, db.ClearCollections(collections...))
+	}()
+
+	// setTaskStatus finishes the task with the given status.
+	setTaskStatus := func(t *testing.T, id, status string) {
+		require.NoError(t, task.UpdateOne(ctx, bson.M{task.IdKey: id}, bson.M{"$set": bson.M{task.StatusKey: status}}))
+	}
+	// advance advances the search and checks which task it's waiting on.
+	advance := func(t *testing.T, s *CulpritSearch, expectedTaskID string) {
+		require.NoError(t, s.Advance(ctx, env))
+		assert.Equal(t, expectedTaskID, s.CurrentTaskID)
+		if expectedTaskID == "" {
+			return
+		}
+		current, err := task.FindOneId(ctx, expectedTaskID)
+		require.NoError(t, err)
+		require.NotNil(t, current)
+		assert.True(t, current.Activated)
+		assert.Equal(t, evergreen.CulpritFinderTaskActivator, current.ActivatedBy)
+	}
+
+	// Task data for tests is:
+	// ('-' is failed, '?' is undispatched, '+' is succeeded).
+	// t1  t2  t3  t4  t5  t6  t7  t8  t9  t10
+	// +   ?   ?   ?   ?   ?   ?   ?   ?   -
+	for tName, tCase := range map[string]func(t *testing.T, t10 *task.Task){
+		"FindsCulprit": func(t *testing.T, t10 *task.Task) {
+			s, err := StartCulpritSearch(ctx, env, t10, "", "me")
+			require.NoError(t, err)
+			assert.Equal(t, CulpritSearchRunning, s.Status)
+			assert.Equal(t, "t1", s.LastPassingTaskID)
+			assert.Equal(t, "t10", s.LastFailingTaskID)
+
+			advance(t, s, "t5")
+			advance(t, s, "t5")
+
+			setTaskStatus(t, "t5", evergreen.TaskFailed)
+			advance(t, s, "t3")
+
+			setTaskStatus(t, "t3", evergreen.TaskSucceeded)
+			advance(t, s, "t4")
+
+			setTaskStatus(t, "t4", evergreen.TaskFailed)
+			advance(t, s, "")
+
+			dbSearch, err := findOneCulpritSearch(ctx, bson.M{CulpritSearchIDKey: s.ID})
+			require.NoError(t, err)
+			require.NotNil(t, dbSearch)
+			assert.Equal(t, CulpritSearchFound, dbSearch.Status)
+			assert.Equal(t, "t4", dbSearch.CulpritTaskID)
+			assert.ElementsMatch(t, []string{"t5", "t3", "t4"}, dbSearch.ActivatedTaskIDs)
+			assert.False(t, dbSearch.FinishedAt.IsZero())
+
+			annotation, err := annotations.FindOneByTaskIdAndExecution(ctx, "t10", 0)
+			require.NoError(t, err)
+			require.NotNil(t, annotation)
+			require.Len(t, annotation.SuspectedIssues, 1)
+			assert.Equal(t, "Suspected culprit 4444444 by author4", annotation.SuspectedIssues[0].IssueKey)
+			assert.Contains(t, annotation.SuspectedIssues[0].URL, "/version/v4")
+			require.NotNil(t, annotation.SuspectedIssues[0].Source)
+			assert.Equal(t, annotations.CulpritFinderRequester, annotation.SuspectedIssues[0].Source.Requester)
+
+			events, err := event.Find(event.TaskEventsForId("t10"))
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, event.TaskCulpritFound, events[0].EventType)
+			data, ok := events[0].Data.(*event.TaskEventData)
+			require.True(t, ok)
+			assert.Equal(t, "t4", data.CulpritTaskID)
+		},
+		"SkipsTasksThatAlreadyRan": func(t *testing.T, t10 *task.Task) {
+			setTaskStatus(t, "t5", evergreen.TaskSucceeded)
+			setTaskStatus(t, "t7", evergreen.TaskSucceeded)
+			s, err := StartCulpritSearch(ctx, env, t10, "", "me")
+			require.NoError(t, err)
+
+			advance(t, s, "t8")
+			dbSearch, err := findOneCulpritSearch(ctx, bson.M{CulpritSearchIDKey: s.ID})
+			require.NoError(t, err)
+			require.NotNil(t, dbSearch)
+			assert.Equal(t, "t7", dbSearch.LastPassingTaskID)
+			assert.Equal(t, []string{"t8"}, dbSearch.ActivatedTaskIDs)
+		},
+		"FindsCulpritWithoutActivatingTasksWhenAdjacent": func(t *testing.T, t10 *task.Task) {
+			for i := 1; i <= 9; i++ {
+				setTaskStatus(t, fmt.Sprintf("t%d", i), evergreen.TaskSucceeded)
+			}
+			s, err := StartCulpritSearch(ctx, env, t10, "", "me")
+			require.NoError(t, err)
+			assert.Equal(t, "t9", s.LastPassingTaskID)
+
+			advance(t, s, "")
+			assert.Equal(t, CulpritSearchFound, s.Status)
+			assert.Equal(t, "t10", s.CulpritTaskID)
+			assert.Empty(t, s.ActivatedTaskIDs)
+		},
+		"ReusesRunningSearch": func(t *testing.T, t10 *task.Task) {
+			s0, err := StartCulpritSearch(ctx, env, t10, "", "me")
+			require.NoError(t, err)
+			s1, err := StartCulpritSearch(ctx, env, t10, "", "someone_else")
+			require.NoError(t, err)
+			assert.Equal(t, s0.ID, s1.ID)
+
+			searches, err := FindCulpritSearchesByTask(ctx, "t10")
+			require.NoError(t, err)
+			assert.Len(t, searches, 1)
+		},
+		"StopsWhenTaskIsDeactivated": func(t *testing.T, t10 *task.Task) {
+			s, err := StartCulpritSearch(ctx, env, t10, "", "me")
+			require.NoError(t, err)
+			advance(t, s, "t5")
+
+			require.NoError(t, task.UpdateOne(ctx, bson.M{task.IdKey: "t5"}, bson.M{"$set": bson.M{task.ActivatedKey: false}}))
+			require.NoError(t, s.Advance(ctx, env))
+			assert.Equal(t, CulpritSearchInconclusive, s.Status)
+			assert.NotEmpty(t, s.Reason)
+
+			running, err := FindRunningCulpritSearches(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, running)
+		},
+		"StopsWhenMidpointHasSystemFailure": func(t *testing.T, t10 *task.Task) {
+			s, err := StartCulpritSearch(ctx, env, t10, "", "me")
+			require.NoError(t, err)
+			advance(t, s, "t5")
+
+			require.NoError(t, task.UpdateOne(ctx, bson.M{task.IdKey: "t5"}, bson.M{"$set": bson.M{
+				task.StatusKey: evergreen.TaskFailed,
+				bsonutil.GetDottedKeyName(task.DetailsKey, task.TaskEndDetailType): evergreen.CommandTypeSystem,
+			}}))
+			require.NoError(t, s.Advance(ctx, env))
+			assert.Equal(t, CulpritSearchInconclusive, s.Status)
+			assert.Contains(t, s.Reason, "system failure")
+			assert.Equal(t, "t10", s.LastFailingTaskID, "system failure should not be treated as the failure being searched for")
+			assert.Empty(t, s.CulpritTaskID)
+		},
+		"StopsWhenMidpointHasNoTestResults": func(t *testing.T, t10 *task.Task) {
+			s := &CulpritSearch{
+				ID:                "search",
+				TaskID:            t10.Id,
+				ProjectID:         t10.Project,
+				TestName:          "test",
+				Status:            CulpritSearchRunning,
+				LastPassingTaskID: "t1",
+				LastFailingTaskID: "t10",
+				CurrentTaskID:     "t5",
+				CreatedAt:         time.Now(),
+			}
+			require.NoError(t, db.Insert(CulpritSearchCollection, s))
+			setTaskStatus(t, "t5", evergreen.TaskFailed)
+
+			require.NoError(t, s.Advance(ctx, env))
+			assert.Equal(t, CulpritSearchInconclusive, s.Status)
+			assert.Contains(t, s.Reason, "no results for test 'test'")
+			assert.Equal(t, "t1", s.LastPassingTaskID, "missing test results should not be treated as a passing test")
+
+			dbSearch, err := findOneCulpritSearch(ctx, bson.M{CulpritSearchIDKey: s.ID})
+			require.NoError(t, err)
+			require.NotNil(t, dbSearch)
+			assert.Equal(t, CulpritSearchInconclusive, dbSearch.Status)
+		},
+		"FailsForTaskThatDidNotFail": func(t *testing.T, t10 *task.Task) {
+			t1, err := task.FindOneId(ctx, "t1")
+			require.NoError(t, err)
+			require.NotNil(t, t1)
+			_, err = StartCulpritSearch(ctx, env, t1, "", "me")
+			assert.Error(t, err)
+		},
+		"FailsForPatchTask": func(t *testing.T, t10 *task.Task) {
+			t10.Requester = evergreen.PatchVersionRequester
+			_, err := StartCulpritSearch(ctx, env, t10, "", "me")
+			assert.Error(t, err)
+		},
+		"FailsWithoutEarlierPassingTask": func(t *testing.T, t10 *task.Task) {
+			setTaskStatus(t, "t1", evergreen.TaskFailed)
+			_, err := StartCulpritSearch(ctx, env, t10, "", "me")
+			assert.Error(t, err)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(collections...))
+			for i := 1; i <= 10; i++ {
+				v := Version{
+					Id:        fmt.Sprintf("v%d", i),
+					Revision:  fmt.Sprintf("%d%d%d%d%d%d%d%d", i, i, i, i, i, i, i, i),
+					Author:    fmt.Sprintf("author%d", i),
+					Requester: evergreen.RepotrackerVersionRequester,
+				}
+				require.NoError(t, v.Insert())
+				b := build.Build{
+					Id:           fmt.Sprintf("b%d", i),
+					BuildVariant: "bv",
+					Version:      v.Id,
+				}
+				require.NoError(t, b.Insert())
+				tsk := task.Task{
+					Id:                  fmt.Sprintf("t%d", i),
+					BuildId:             b.Id,
+					Version:             v.Id,
+					Status:              evergreen.TaskUndispatched,
+					BuildVariant:        "bv",
+					DisplayName:         "task",
+					Project:             "proj",
+					RevisionOrderNumber: i,
+					Requester:           evergreen.RepotrackerVersionRequester,
+				}
+				switch i {
+				case 1:
+					tsk.Status = evergreen.TaskSucceeded
+					tsk.Activated = true
+				case 10:
+					tsk.Status = evergreen.TaskFailed
+					tsk.Activated = true
+				}
+				require.NoError(t, tsk.Insert())
+			}
+			t10, err := task.FindOneId(ctx, "t10")
+			require.NoError(t, err)
+			require.NotNil(t, t10)
+
+			tCase(t, t10)
+		})
+	}
+}
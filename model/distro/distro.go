@@ -491,6 +491,9 @@ func (d *Distro) GetAuthorizedKeysFile() string {
 // IsParent returns whether the distro is the parent distro for any container pool
 func (d *Distro) IsParent(s *evergreen.Settings) bool {
 	for _, p := range s.ContainerPools.Pools {
+		if p.IsKubernetes() {
+			continue
+		}
 		if d.Id == p.Distro {
 			return true
 		}
@@ -507,6 +510,8 @@ func (d *Distro) GetImageID() (string, error) {
 		key = "ami"
 	case evergreen.ProviderNameDocker, evergreen.ProviderNameDockerMock:
 		key = "image_url"
+	case evergreen.ProviderNameLibvirt:
+		key = "base_image"
 	case evergreen.ProviderNameMock, evergreen.ProviderNameStatic:
 		return "", nil
 	default:
@@ -544,6 +549,11 @@ func ValidateContainerPoolDistros(ctx context.Context, s *evergreen.Settings) er
 	catcher := grip.NewSimpleCatcher()
 
 	for _, pool := range s.ContainerPools.Pools {
+		if pool.IsKubernetes() {
+			// Kubernetes pools run pods in a cluster rather than on
+			// parent hosts.
+			continue
+		}
 		d, err := FindOneId(ctx, pool.Distro)
 		if err != nil {
 			catcher.Add(fmt.Errorf("error finding distro for container pool '%s'", pool.Id))
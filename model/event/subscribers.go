@@ -10,16 +10,17 @@ import (
 )
 
 const (
-	GithubPullRequestSubscriberType = "github_pull_request"
-	GithubCheckSubscriberType       = "github_check"
-	GithubMergeSubscriberType       = "github_merge"
-	JIRAIssueSubscriberType         = "jira-issue"
-	JIRACommentSubscriberType       = "jira-comment"
-	EvergreenWebhookSubscriberType  = "evergreen-webhook"
-	EmailSubscriberType             = "email"
-	SlackSubscriberType             = "slack"
-	SubscriberTypeNone              = "none"
-	RunChildPatchSubscriberType     = "run-child-patch"
+	GithubPullRequestSubscriberType  = "github_pull_request"
+	GithubCheckSubscriberType        = "github_check"
+	GithubMergeSubscriberType        = "github_merge"
+	GitLabMergeRequestSubscriberType = "gitlab_merge_request"
+	JIRAIssueSubscriberType          = "jira-issue"
+	JIRACommentSubscriberType        = "jira-comment"
+	EvergreenWebhookSubscriberType   = "evergreen-webhook"
+	EmailSubscriberType              = "email"
+	SlackSubscriberType              = "slack"
+	SubscriberTypeNone               = "none"
+	RunChildPatchSubscriberType      = "run-child-patch"
 
 	webhookRetryLimit    = 10
 	webhookMinDelayLimit = 10000
@@ -30,6 +31,7 @@ var SubscriberTypes = []string{
 	GithubPullRequestSubscriberType,
 	GithubCheckSubscriberType,
 	GithubMergeSubscriberType,
+	GitLabMergeRequestSubscriberType,
 	JIRAIssueSubscriberType,
 	JIRACommentSubscriberType,
 	EvergreenWebhookSubscriberType,
@@ -69,6 +71,8 @@ func (s *Subscriber) SetBSON(raw mgobson.Raw) error {
 		s.Target = &GithubCheckSubscriber{}
 	case GithubMergeSubscriberType:
 		s.Target = &GithubMergeSubscriber{}
+	case GitLabMergeRequestSubscriberType:
+		s.Target = &GitLabMergeRequestSubscriber{}
 	case EvergreenWebhookSubscriberType:
 		s.Target = &WebhookSubscriber{}
 	case JIRAIssueSubscriberType:
@@ -208,6 +212,21 @@ type GithubMergeSubscriber struct {
 	Ref   string `bson:"ref"`
 }
 
+// GitLabMergeRequestSubscriber reports statuses for a GitLab merge request's
+// head commit.
+type GitLabMergeRequestSubscriber struct {
+	// ProjectID is the ID of the Evergreen project whose GitLab settings are
+	// used to report the status.
+	ProjectID   string `bson:"project_id"`
+	ProjectPath string `bson:"project_path"`
+	IID         int    `bson:"iid"`
+	Ref         string `bson:"ref"`
+}
+
+func (s *GitLabMergeRequestSubscriber) String() string {
+	return fmt.Sprintf("%s-%d-%s", s.ProjectPath, s.IID, s.Ref)
+}
+
 type ChildPatchSubscriber struct {
 	ParentStatus string `bson:"parent_status"`
 	ChildPatchId string `bson:"child_patch_id"`
@@ -246,6 +265,13 @@ func NewGithubMergeAPISubscriber(s GithubMergeSubscriber) Subscriber {
 	}
 }
 
+func NewGitLabMergeRequestSubscriber(s GitLabMergeRequestSubscriber) Subscriber {
+	return Subscriber{
+		Type:   GitLabMergeRequestSubscriberType,
+		Target: s,
+	}
+}
+
 func NewEmailSubscriber(t string) Subscriber {
 	return Subscriber{
 		Type:   EmailSubscriberType,
@@ -91,6 +91,7 @@ const (
 	TriggerTaskFirstFailureInVersion = "first-failure-in-version"
 	TriggerTaskStarted               = "task-started"
 	TriggerSpawnHostIdle             = "spawn-host-idle"
+	TriggerTaskCulpritFound          = "culprit-found"
 )
 
 type Subscription struct {
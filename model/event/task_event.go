@@ -15,6 +15,7 @@ func init() {
 	registry.AllowSubscription(ResourceTypeTask, TaskStarted)
 	registry.AllowSubscription(ResourceTypeTask, TaskFinished)
 	registry.AllowSubscription(ResourceTypeTask, TaskBlocked)
+	registry.AllowSubscription(ResourceTypeTask, TaskCulpritFound)
 }
 
 const (
@@ -37,6 +38,7 @@ const (
 	TaskJiraAlertCreated       = "TASK_JIRA_ALERT_CREATED"
 	TaskDependenciesOverridden = "TASK_DEPENDENCIES_OVERRIDDEN"
 	MergeTaskUnscheduled       = "MERGE_TASK_UNSCHEDULED"
+	TaskCulpritFound           = "TASK_CULPRIT_FOUND"
 )
 
 // implements Data
@@ -51,6 +53,11 @@ type TaskEventData struct {
 
 	Timestamp time.Time `bson:"ts,omitempty" json:"timestamp,omitempty"`
 	Priority  int64     `bson:"pri,omitempty" json:"priority,omitempty"`
+
+	// CulpritTaskID is the first failing task found by a culprit search, and
+	// TestName is the test the search was for, if any.
+	CulpritTaskID string `bson:"culprit_task_id,omitempty" json:"culprit_task_id,omitempty"`
+	TestName      string `bson:"test_name,omitempty" json:"test_name,omitempty"`
 }
 
 func logTaskEvent(taskId string, eventType string, eventData TaskEventData) {
@@ -135,6 +142,12 @@ func LogTaskStarted(taskId string, execution int) {
 	logTaskEvent(taskId, TaskStarted, TaskEventData{Execution: execution, Status: evergreen.TaskStarted})
 }
 
+// LogTaskCulpritFound logs an event indicating that a search for the commit
+// that caused the task to fail found the given culprit task.
+func LogTaskCulpritFound(taskId string, execution int, culpritTaskId, testName string) {
+	logTaskEvent(taskId, TaskCulpritFound, TaskEventData{Execution: execution, CulpritTaskID: culpritTaskId, TestName: testName})
+}
+
 // LogTaskFinished logs an event indicating that the task has finished.
 func LogTaskFinished(taskId string, execution int, status string) {
 	logTaskEvent(taskId, TaskFinished, TaskEventData{Execution: execution, Status: status})
@@ -1173,6 +1173,7 @@ func createOneTask(ctx context.Context, id string, creationInfo TaskCreationInfo
 	projectTask := creationInfo.Project.FindProjectTask(buildVarTask.Name)
 	if projectTask != nil {
 		t.MustHaveResults = utility.FromBoolPtr(projectTask.MustHaveResults)
+		t.Cacheable = projectTask.CacheKey != nil
 	}
 
 	t.ExecutionPlatform = shouldRunOnContainer(buildVarTask.RunOn, creationInfo.BuildVariant.RunOn, creationInfo.Project.Containers)
@@ -1278,6 +1279,7 @@ func getContainerOptions(creationInfo TaskCreationInfo, container string) (*task
 			OS:             c.System.OperatingSystem,
 			Arch:           c.System.CPUArchitecture,
 			WindowsVersion: c.System.WindowsVersion,
+			Pool:           c.Pool,
 		}
 
 		if c.Resources != nil {
@@ -7,6 +7,18 @@ chunks.
 */
 package log
 
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mongodb/grip/level"
+	"github.com/pkg/errors"
+)
+
 // chunkInfo represents a log chunk file's metadata that enables optimized
 // fetching of log files stored as a set of chunks in pail-backed bucket
 // storage.
@@ -24,3 +36,175 @@ type chunkGroup struct {
 	name   string
 	chunks []chunkInfo
 }
+
+// newChunkGroupsIterator returns a LogIterator that merges the logs
+// represented by the given groups of chunks. The iterator options supply how
+// chunks are read; the chunks and line parser are set for each group.
+func newChunkGroupsIterator(ctx context.Context, getOpts GetOptions, groups []chunkGroup, firstStart, firstEnd int64, opts chunkIteratorOptions) (LogIterator, error) {
+	start, end := getOpts.Start, getOpts.End
+	if getOpts.DefaultTimeRangeOfFirstLog && len(getOpts.LogNames) > 1 {
+		if start == nil {
+			start = &firstStart
+		}
+		if end == nil {
+			end = &firstEnd
+		}
+	}
+
+	var its []LogIterator
+	for _, group := range groups {
+		groupOpts := opts
+		groupOpts.chunks = group.chunks
+		groupOpts.parser = getLineParser(group.name)
+		groupOpts.start = start
+		groupOpts.end = end
+		groupOpts.lineLimit = getOpts.LineLimit
+		groupOpts.tailN = getOpts.TailN
+		its = append(its, newChunkIterator(ctx, groupOpts))
+	}
+
+	if len(its) == 1 {
+		return its[0], nil
+	}
+
+	it := newMergingIterator(getOpts.LineLimit, its...)
+	if getOpts.TailN > 0 {
+		return newTailIterator(it, getOpts.TailN)
+	}
+	return it, nil
+}
+
+// groupLogChunks sorts each log's chunks for iterating and returns them as
+// chunk groups in the given log name order, along with the time range of the
+// first specified log.
+func groupLogChunks(logNames, orderedLogNames []string, logChunks map[string][]chunkInfo) ([]chunkGroup, int64, int64) {
+	var start, end int64
+	for name, chunks := range logChunks {
+		// Sort each set of chunks by start order for log iterating and
+		// find the first specified log's time range.
+		sort.Slice(chunks, func(i, j int) bool {
+			switch {
+			case chunks[i].sequence != chunks[j].sequence:
+				return chunks[i].sequence < chunks[j].sequence
+			case chunks[i].start != chunks[j].start:
+				return chunks[i].start < chunks[j].start
+			default:
+				return chunks[i].upload < chunks[j].upload
+			}
+		})
+		if strings.HasPrefix(name, logNames[0]) {
+			if start == 0 || (start > 0 && start > chunks[0].start) {
+				start = chunks[0].start
+			}
+			if end < chunks[len(chunks)-1].end {
+				end = chunks[len(chunks)-1].end
+			}
+		}
+	}
+
+	chunkGroups := make([]chunkGroup, 0, len(logNames))
+	for _, name := range orderedLogNames {
+		chunkGroups = append(chunkGroups, chunkGroup{
+			name:   name,
+			chunks: logChunks[name],
+		})
+	}
+
+	return chunkGroups, start, end
+}
+
+// createChunkKey returns a storage key that encodes the given log chunk
+// information.
+//
+// The chunk key is encoded with the chunk info metadata to optimize storage
+// and lookup performance.
+func createChunkKey(sequence int, start, end int64, numLines int) string {
+	return fmt.Sprintf("%d_%d_%d_%d_%d", sequence, start, end, numLines, time.Now().UnixNano())
+}
+
+// parseChunkKey returns the chunk info encoded in the given key.
+func parseChunkKey(prefix, key string) (chunkInfo, error) {
+	parsedKey := strings.Split(key, "_")
+	if len(parsedKey) < 3 || len(parsedKey) > 5 {
+		return chunkInfo{}, errors.New("invalid key format")
+	}
+
+	var (
+		sequence, idxOffset int
+		err                 error
+	)
+	if len(parsedKey) == 5 {
+		sequence, err = strconv.Atoi(parsedKey[0])
+		if err != nil {
+			return chunkInfo{}, errors.Wrap(err, "parsing sequence")
+		}
+		idxOffset = 1
+	}
+	start, err := strconv.ParseInt(parsedKey[idxOffset+0], 10, 64)
+	if err != nil {
+		return chunkInfo{}, errors.Wrap(err, "parsing start time")
+	}
+	end, err := strconv.ParseInt(parsedKey[idxOffset+1], 10, 64)
+	if err != nil {
+		return chunkInfo{}, errors.Wrap(err, "parsing end time")
+	}
+	numLines, err := strconv.Atoi(parsedKey[idxOffset+2])
+	if err != nil {
+		return chunkInfo{}, errors.Wrap(err, "parsing num lines")
+	}
+	var upload int64
+	if len(parsedKey) == 4 {
+		upload, err = strconv.ParseInt(parsedKey[idxOffset+3], 10, 64)
+		if err != nil {
+			return chunkInfo{}, errors.Wrap(err, "parsing upload time")
+		}
+	}
+
+	return chunkInfo{
+		key:      prefix + "/" + key,
+		sequence: sequence,
+		start:    start,
+		end:      end,
+		numLines: numLines,
+		upload:   upload,
+	}, nil
+}
+
+// formatRawLine formats a log line for storage.
+func formatRawLine(line LogLine) string {
+	if line.Data == "" {
+		line.Data = "\n"
+	} else if line.Data[len(line.Data)-1] != '\n' {
+		line.Data += "\n"
+	}
+
+	return fmt.Sprintf("%d %d %s", line.Priority, line.Timestamp, line.Data)
+}
+
+// getLineParser returns a function that parses a raw line into the service
+// representation of a log line.
+func getLineParser(logName string) LineParser {
+	return func(data string) (LogLine, error) {
+		lineParts := strings.SplitN(data, " ", 3)
+		if len(lineParts) != 3 {
+			return LogLine{}, errors.New("malformed log line")
+		}
+
+		priority, err := strconv.ParseInt(strings.TrimSpace(lineParts[0]), 10, 16)
+		if err != nil {
+			return LogLine{}, err
+		}
+
+		ts, err := strconv.ParseInt(lineParts[1], 10, 64)
+		if err != nil {
+			return LogLine{}, err
+		}
+
+		return LogLine{
+			LogName:   logName,
+			Priority:  level.Priority(priority),
+			Timestamp: ts,
+			Data:      strings.TrimSuffix(lineParts[2], "\n"),
+		}, nil
+	}
+}
@@ -33,8 +33,16 @@ type chunkIteratorOptions struct {
 	end       *int64
 	lineLimit int
 	tailN     int
+	// openChunk, if set, is used instead of the bucket to open chunks for
+	// reading.
+	openChunk chunkOpener
 }
 
+// chunkOpener opens a chunk for reading and may seek past up to skipLines
+// lines at the beginning of the chunk, as well as any lines before start, if
+// set. It returns the number of lines actually skipped.
+type chunkOpener func(ctx context.Context, chunk chunkInfo, skipLines int, start *int64) (io.ReadCloser, int, error)
+
 // newChunkIterator returns a LogIterator that iterates over lines of a log
 // stored as a set of chunks in pail-backed bucket storage.
 func newChunkIterator(ctx context.Context, opts chunkIteratorOptions) *chunkIterator {
@@ -55,7 +63,7 @@ func newChunkIterator(ctx context.Context, opts chunkIteratorOptions) *chunkIter
 		next:       make(chan *chunkReader, 1),
 		catcher:    grip.NewBasicCatcher(),
 	}
-	go it.worker(ctx)
+	go it.worker(ctx, lineOffset)
 
 	return it
 }
@@ -81,6 +89,8 @@ func (it *chunkIterator) Next() bool {
 				it.exhausted = !it.catcher.HasErrors()
 				return false
 			}
+			it.chunkLineCount = it.reader.skippedLines
+			it.lineOffset -= min(it.lineOffset, it.reader.skippedLines)
 		}
 
 		data, err := it.reader.ReadString('\n')
@@ -131,21 +141,26 @@ func (it *chunkIterator) Next() bool {
 	return true
 }
 
-func (it *chunkIterator) worker(ctx context.Context) {
+func (it *chunkIterator) worker(ctx context.Context, lineOffset int) {
 	defer func() {
 		it.catcher.Add(recovery.HandlePanicWithError(recover(), nil, "log chunk iterator worker"))
 		close(it.next)
 	}()
 
-	for _, chunk := range it.opts.chunks {
-		r, err := it.opts.bucket.Get(ctx, chunk.key)
+	for i, chunk := range it.opts.chunks {
+		// The line offset only ever applies to the first chunk.
+		var skipLines int
+		if i == 0 {
+			skipLines = lineOffset
+		}
+		r, skippedLines, err := it.openChunk(ctx, chunk, skipLines)
 		if err != nil {
-			it.catcher.Wrap(err, "getting chunk from bucket")
+			it.catcher.Wrap(err, "getting chunk")
 			return
 		}
 
 		select {
-		case it.next <- newChunkReader(r, chunk.numLines):
+		case it.next <- newChunkReader(r, chunk.numLines, skippedLines):
 		case <-ctx.Done():
 			it.catcher.Add(ctx.Err())
 			return
@@ -153,6 +168,23 @@ func (it *chunkIterator) worker(ctx context.Context) {
 	}
 }
 
+// openChunk opens the chunk for reading, seeking past lines that the iterator
+// would otherwise skip if the chunk opener supports it.
+func (it *chunkIterator) openChunk(ctx context.Context, chunk chunkInfo, skipLines int) (io.ReadCloser, int, error) {
+	if it.opts.openChunk == nil {
+		r, err := it.opts.bucket.Get(ctx, chunk.key)
+		return r, 0, errors.Wrap(err, "getting chunk from bucket")
+	}
+
+	// Lines before the start time still count towards the line limit, so
+	// they can only be skipped when there is no limit.
+	var start *int64
+	if it.opts.lineLimit <= 0 {
+		start = it.opts.start
+	}
+	return it.opts.openChunk(ctx, chunk, skipLines, start)
+}
+
 func (it *chunkIterator) Exhausted() bool { return it.exhausted }
 
 func (it *chunkIterator) Err() error { return it.catcher.Resolve() }
@@ -204,16 +236,18 @@ func filterChunksByLimit(chunks []chunkInfo, limit int) []chunkInfo {
 }
 
 type chunkReader struct {
-	numLines int
+	numLines     int
+	skippedLines int
 
 	*bufio.Reader
 	io.ReadCloser
 }
 
-func newChunkReader(r io.ReadCloser, numLines int) *chunkReader {
+func newChunkReader(r io.ReadCloser, numLines, skippedLines int) *chunkReader {
 	return &chunkReader{
-		numLines:   numLines,
-		Reader:     bufio.NewReader(r),
-		ReadCloser: r,
+		numLines:     numLines,
+		skippedLines: skippedLines,
+		Reader:       bufio.NewReader(r),
+		ReadCloser:   r,
 	}
 }
@@ -14,3 +14,16 @@ func (*emptyIterator) Exhausted() bool { return true }
 func (*emptyIterator) Err() error { return nil }
 
 func (*emptyIterator) Close() error { return nil }
+
+type emptySearchIterator struct{}
+
+// EmptySearchIterator returns a convenience search iterator with no matches.
+func EmptySearchIterator() *emptySearchIterator { return &emptySearchIterator{} }
+
+func (*emptySearchIterator) Next() bool { return false }
+
+func (*emptySearchIterator) Item() SearchMatch { return SearchMatch{} }
+
+func (*emptySearchIterator) Err() error { return nil }
+
+func (*emptySearchIterator) Close() error { return nil }
@@ -23,6 +23,21 @@ type LogIterator interface {
 	Close() error
 }
 
+// SearchIterator is an interface that enables iterating over the lines of
+// Evergreen logs that match a search.
+type SearchIterator interface {
+	// Next returns true if the iterator has not yet been exhausted or
+	// closed, false otherwise.
+	Next() bool
+	// Item returns the current matching log line held by the iterator.
+	Item() SearchMatch
+	// Err returns any errors that are captured by the iterator.
+	Err() error
+	// Close closes the iterator. This function should be called once the
+	// iterator is no longer needed.
+	Close() error
+}
+
 // newTailIterator converts a log iterator into a basic iterator that reads the
 // the last N lines of the merged logs.
 //
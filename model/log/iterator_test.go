@@ -488,8 +488,6 @@ func TestNewTailIterator(t *testing.T) {
 // generateTestLog is a convenience function to generate random logs with 100
 // character long lines of the given size and chunk size in the given bucket.
 func generateTestLog(ctx context.Context, bucket pail.Bucket, size, chunkSize int) ([]chunkInfo, []LogLine, LineParser, error) {
-	service := &logServiceV0{}
-
 	lines := make([]LogLine, size)
 	numChunks := size / chunkSize
 	if numChunks == 0 || size%chunkSize > 0 {
@@ -515,14 +513,14 @@ func generateTestLog(ctx context.Context, bucket pail.Bucket, size, chunkSize in
 				Timestamp: ts,
 				Data:      line,
 			}
-			rawLines += service.formatRawLine(lines[lineNum])
+			rawLines += formatRawLine(lines[lineNum])
 			ts += int64(time.Millisecond)
 			lineCount++
 		}
 
 		chunks[i].end = ts - int64(time.Millisecond)
 		chunks[i].numLines = lineCount
-		chunks[i].key = logName + "/" + service.createChunkKey(0, chunks[i].start, chunks[i].end, chunks[i].numLines)
+		chunks[i].key = logName + "/" + createChunkKey(0, chunks[i].start, chunks[i].end, chunks[i].numLines)
 
 		if bucket != nil {
 			if err := bucket.Put(ctx, chunks[i].key, strings.NewReader(rawLines)); err != nil {
@@ -533,7 +531,7 @@ func generateTestLog(ctx context.Context, bucket pail.Bucket, size, chunkSize in
 		ts += int64(time.Hour)
 	}
 
-	return chunks, lines, service.getParser(logName), nil
+	return chunks, lines, getLineParser(logName), nil
 }
 
 var seededRand *rand.Rand = rand.New(rand.NewSource(time.Now().UnixNano()))
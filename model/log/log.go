@@ -11,3 +11,10 @@ type LogLine struct {
 	Timestamp int64
 	Data      string
 }
+
+// SearchMatch represents a line in an Evergreen log that matches a search.
+type SearchMatch struct {
+	LogLine
+	// LineNumber is the zero-based number of the line in its log.
+	LineNumber int
+}
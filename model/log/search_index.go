@@ -0,0 +1,353 @@
+package log
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/evergreen-ci/evergreen/model/parsley"
+	"github.com/pkg/errors"
+)
+
+const (
+	// searchIndexPrefix is the storage key prefix of log chunk search
+	// indexes. Each chunk's search index is stored at the chunk's key
+	// with this prefix.
+	searchIndexPrefix = "_search_index"
+	// minSearchTokenLength is the minimum length, in runes, of the tokens
+	// added to search indexes. Shorter tokens are too common to narrow
+	// down a search meaningfully.
+	minSearchTokenLength = 3
+)
+
+// searchIndex is an inverted index of the tokens in a log chunk, used to find
+// the lines that may match a search without reading the whole chunk.
+//
+// Tokens are maximal runs of ASCII letters, digits, underscores, and non-ASCII
+// runes, case folded so that the index serves both case sensitive and case
+// insensitive searches.
+type searchIndex struct {
+	// Tokens maps each token in the chunk to the ascending zero-based
+	// numbers of the lines in the chunk that contain it.
+	Tokens map[string][]int `json:"tokens"`
+}
+
+// newSearchIndex returns the search index for a log chunk with the given
+// lines.
+func newSearchIndex(lines []LogLine) searchIndex {
+	idx := searchIndex{Tokens: map[string][]int{}}
+	for i, line := range lines {
+		for _, token := range searchTokens(line.Data) {
+			postings := idx.Tokens[token]
+			if n := len(postings); n > 0 && postings[n-1] == i {
+				continue
+			}
+			idx.Tokens[token] = append(postings, i)
+		}
+	}
+
+	return idx
+}
+
+// candidates returns the numbers of the lines in the chunk that contain a
+// token satisfying each of the terms. Lines that are not candidates cannot
+// match a search with the given terms.
+func (idx searchIndex) candidates(terms []searchTerm) map[int]bool {
+	var lines map[int]bool
+	for _, term := range terms {
+		termLines := map[int]bool{}
+		for token, postings := range idx.Tokens {
+			if !term.matches(token) {
+				continue
+			}
+			for _, lineNum := range postings {
+				if lines == nil || lines[lineNum] {
+					termLines[lineNum] = true
+				}
+			}
+		}
+
+		lines = termLines
+		if len(lines) == 0 {
+			break
+		}
+	}
+
+	return lines
+}
+
+// searchIndexKey returns the storage key of the search index of the log chunk
+// with the given key.
+func searchIndexKey(chunkKey string) string {
+	return searchIndexPrefix + "/" + chunkKey
+}
+
+// searchTokens returns the distinct search tokens in the given data.
+func searchTokens(data string) []string {
+	var (
+		tokens []string
+		token  []rune
+	)
+	seen := map[string]bool{}
+	addToken := func() {
+		if len(token) >= minSearchTokenLength {
+			if t := string(token); !seen[t] {
+				seen[t] = true
+				tokens = append(tokens, t)
+			}
+		}
+		token = token[:0]
+	}
+	for _, r := range data {
+		if !isSearchWordRune(r) {
+			addToken()
+			continue
+		}
+		token = append(token, foldRune(r))
+	}
+	addToken()
+
+	return tokens
+}
+
+// isSearchWordRune returns whether the rune is part of a search token. Every
+// non-ASCII rune is part of a token so that no rune that a case insensitive
+// regular expression may fold an ASCII letter into is mistaken for a token
+// boundary.
+func isSearchWordRune(r rune) bool {
+	return r >= utf8.RuneSelf || r == '_' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
+
+// foldRune returns the canonical case folded form of the rune, which is the
+// smallest rune that is equivalent to it under simple Unicode case folding.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		return r
+	}
+
+	folded := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < folded {
+			folded = f
+		}
+	}
+
+	return folded
+}
+
+// searchTerm is a case folded string that a token of every matching line must
+// contain.
+type searchTerm struct {
+	text string
+	// prefix is whether the text must be at the start of the token.
+	prefix bool
+	// suffix is whether the text must be at the end of the token.
+	suffix bool
+}
+
+func (t searchTerm) matches(token string) bool {
+	switch {
+	case t.prefix && t.suffix:
+		return token == t.text
+	case t.prefix:
+		return strings.HasPrefix(token, t.text)
+	case t.suffix:
+		return strings.HasSuffix(token, t.text)
+	default:
+		return strings.Contains(token, t.text)
+	}
+}
+
+// requiredSearchTerms returns the search terms that every line matching the
+// parsed regular expression must satisfy. The terms are conservative: a line
+// satisfying all of them may still not match, but a line that does not
+// satisfy all of them never matches.
+func requiredSearchTerms(re *syntax.Regexp) []searchTerm {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return literalSearchTerms(re.Rune, false, false)
+	case syntax.OpConcat:
+		return concatSearchTerms(re.Sub)
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredSearchTerms(re.Sub[0])
+	case syntax.OpRepeat:
+		if re.Min > 0 {
+			return requiredSearchTerms(re.Sub[0])
+		}
+	case syntax.OpAlternate:
+		terms := requiredSearchTerms(re.Sub[0])
+		for _, sub := range re.Sub[1:] {
+			terms = intersectSearchTerms(terms, requiredSearchTerms(sub))
+		}
+		return terms
+	}
+
+	return nil
+}
+
+// concatSearchTerms returns the search terms for a concatenation of regular
+// expressions. Consecutive literals are combined so that the tokens spanning
+// them are found, and the tokens at the edges of the combined literals are
+// only required to be at the start or end of a token if the literals are
+// adjacent to a token boundary.
+func concatSearchTerms(subs []*syntax.Regexp) []searchTerm {
+	var (
+		terms   []searchTerm
+		literal []rune
+		bounded bool
+	)
+	addLiteral := func(boundedAfter bool) {
+		terms = append(terms, literalSearchTerms(literal, bounded, boundedAfter)...)
+		literal = nil
+	}
+	for _, sub := range subs {
+		if sub.Op == syntax.OpLiteral {
+			literal = append(literal, sub.Rune...)
+			continue
+		}
+
+		if isSearchBoundary(sub) {
+			addLiteral(true)
+			bounded = true
+			continue
+		}
+
+		addLiteral(false)
+		terms = append(terms, requiredSearchTerms(sub)...)
+		bounded = false
+	}
+	addLiteral(false)
+
+	return terms
+}
+
+// literalSearchTerms returns the search terms for the tokens in a literal. A
+// token inside the literal is only known to be a whole token of a matching
+// line if it is delimited within the literal or the literal is adjacent to a
+// token boundary.
+func literalSearchTerms(literal []rune, boundedBefore, boundedAfter bool) []searchTerm {
+	var terms []searchTerm
+	for start := 0; start < len(literal); {
+		if !isSearchWordRune(literal[start]) {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(literal) && isSearchWordRune(literal[end]) {
+			end++
+		}
+		if end-start >= minSearchTokenLength {
+			text := make([]rune, 0, end-start)
+			for _, r := range literal[start:end] {
+				text = append(text, foldRune(r))
+			}
+			terms = append(terms, searchTerm{
+				text:   string(text),
+				prefix: start > 0 || boundedBefore,
+				suffix: end < len(literal) || boundedAfter,
+			})
+		}
+		start = end
+	}
+
+	return terms
+}
+
+// isSearchBoundary returns whether the regular expression always matches at a
+// token boundary, either because it is a line boundary or because it only
+// matches runes that are not part of tokens.
+func isSearchBoundary(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+		return true
+	case syntax.OpCharClass:
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			lo, hi := re.Rune[i], re.Rune[i+1]
+			if hi >= utf8.RuneSelf {
+				return false
+			}
+			for _, word := range [][2]rune{{'0', '9'}, {'A', 'Z'}, {'_', '_'}, {'a', 'z'}} {
+				if lo <= word[1] && word[0] <= hi {
+					return false
+				}
+			}
+		}
+		return len(re.Rune) > 0
+	case syntax.OpPlus:
+		return re.Sub[0].Op == syntax.OpCharClass && isSearchBoundary(re.Sub[0])
+	case syntax.OpRepeat:
+		return re.Min > 0 && re.Sub[0].Op == syntax.OpCharClass && isSearchBoundary(re.Sub[0])
+	default:
+		return false
+	}
+}
+
+// intersectSearchTerms returns the search terms satisfied by every line that
+// satisfies either of the given sets of terms.
+func intersectSearchTerms(a, b []searchTerm) []searchTerm {
+	var terms []searchTerm
+	for _, term := range a {
+		for _, other := range b {
+			if term.text == other.text {
+				terms = append(terms, searchTerm{
+					text:   term.text,
+					prefix: term.prefix && other.prefix,
+					suffix: term.suffix && other.suffix,
+				})
+				break
+			}
+		}
+	}
+
+	return terms
+}
+
+// searchMatcher matches log lines against a Parsley filter.
+type searchMatcher struct {
+	re      *regexp.Regexp
+	inverse bool
+	// terms are the search terms that every matching line must satisfy.
+	terms []searchTerm
+}
+
+func newSearchMatcher(filter parsley.Filter) (*searchMatcher, error) {
+	if err := parsley.ValidateFilters([]parsley.Filter{filter}); err != nil {
+		return nil, errors.Wrap(err, "invalid filter")
+	}
+
+	expr := filter.Expression
+	if !filter.CaseSensitive {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "compiling filter expression '%s'", filter.Expression)
+	}
+
+	m := &searchMatcher{
+		re:      re,
+		inverse: !filter.ExactMatch,
+	}
+	// Lines that do not match an inverse filter need not contain any
+	// particular token, so the search index can only narrow down exact
+	// match searches.
+	if filter.ExactMatch {
+		parsed, err := syntax.Parse(expr, syntax.Perl)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing filter expression '%s'", filter.Expression)
+		}
+		m.terms = requiredSearchTerms(parsed)
+	}
+
+	return m, nil
+}
+
+func (m *searchMatcher) match(data string) bool {
+	return m.re.MatchString(data) != m.inverse
+}
@@ -0,0 +1,171 @@
+package log
+
+import (
+	"regexp/syntax"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/parsley"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchTokens(t *testing.T) {
+	assert.Equal(t, []string{"ERROR", "CONNECTING", "HOST_1"}, searchTokens("[error] connecting to Host_1: ok"))
+	assert.Equal(t, []string{"KELVIN"}, searchTokens("\u212Aelvin"), "non-ASCII runes should be case folded")
+	assert.Equal(t, []string{"ABC"}, searchTokens("abc ABC aBc"), "tokens should be distinct")
+	assert.Empty(t, searchTokens("a bc !!"))
+}
+
+func TestNewSearchIndex(t *testing.T) {
+	idx := newSearchIndex([]LogLine{
+		{Data: "test failed: connection refused"},
+		{Data: "retrying connection connection"},
+		{Data: "ok"},
+		{Data: "test passed"},
+	})
+	assert.Equal(t, map[string][]int{
+		"TEST":       {0, 3},
+		"FAILED":     {0},
+		"CONNECTION": {0, 1},
+		"REFUSED":    {0},
+		"RETRYING":   {1},
+		"PASSED":     {3},
+	}, idx.Tokens)
+
+	t.Run("Candidates", func(t *testing.T) {
+		for _, test := range []struct {
+			name     string
+			expr     string
+			expected map[int]bool
+		}{
+			{name: "SingleToken", expr: `\bconnection\b`, expected: map[int]bool{0: true, 1: true}},
+			{name: "MultipleTokens", expr: "test failed", expected: map[int]bool{0: true}},
+			{name: "Substring", expr: "nnect", expected: map[int]bool{0: true, 1: true}},
+			{name: "Prefix", expr: " tes", expected: map[int]bool{0: true, 3: true}},
+			{name: "NoMatch", expr: "timeout", expected: map[int]bool{}},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				re, err := syntax.Parse(test.expr, syntax.Perl)
+				require.NoError(t, err)
+				terms := requiredSearchTerms(re)
+				require.NotEmpty(t, terms)
+				assert.Equal(t, test.expected, idx.candidates(terms))
+			})
+		}
+	})
+}
+
+func TestRequiredSearchTerms(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		expr     string
+		expected []searchTerm
+	}{
+		{
+			name:     "Literal",
+			expr:     "segfault",
+			expected: []searchTerm{{text: "SEGFAULT"}},
+		},
+		{
+			name: "LiteralWithSeparators",
+			expr: "failed to connect",
+			expected: []searchTerm{
+				{text: "FAILED", suffix: true},
+				{text: "CONNECT", prefix: true},
+			},
+		},
+		{
+			name:     "ShortTokensIgnored",
+			expr:     "go to it",
+			expected: nil,
+		},
+		{
+			name:     "Anchors",
+			expr:     "^panic$",
+			expected: []searchTerm{{text: "PANIC", prefix: true, suffix: true}},
+		},
+		{
+			name: "NonWordClassesAreBoundaries",
+			expr: `\s+error:\d+`,
+			expected: []searchTerm{
+				{text: "ERROR", prefix: true, suffix: true},
+			},
+		},
+		{
+			name:     "WordBoundaryIsNotATokenBoundary",
+			expr:     `\berror\b`,
+			expected: []searchTerm{{text: "ERROR"}},
+		},
+		{
+			name: "WildcardsSeparateLiterals",
+			expr: "timeout.*after 30s",
+			expected: []searchTerm{
+				{text: "TIMEOUT"},
+				{text: "AFTER", suffix: true},
+				{text: "30S", prefix: true},
+			},
+		},
+		{
+			name:     "OptionalSubexpressionsIgnored",
+			expr:     "(fatal )?error",
+			expected: []searchTerm{{text: "ERROR"}},
+		},
+		{
+			name:     "RequiredRepetitions",
+			expr:     "(retry){2,}",
+			expected: []searchTerm{{text: "RETRY"}},
+		},
+		{
+			name:     "AlternationIntersects",
+			expr:     "(fatal error|error)",
+			expected: []searchTerm{{text: "ERROR"}},
+		},
+		{
+			name:     "AlternationWithoutCommonTerms",
+			expr:     "fatal|panic",
+			expected: nil,
+		},
+		{
+			name:     "CaseInsensitive",
+			expr:     "(?i)Error",
+			expected: []searchTerm{{text: "ERROR"}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			re, err := syntax.Parse(test.expr, syntax.Perl)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, requiredSearchTerms(re))
+		})
+	}
+}
+
+func TestSearchMatcher(t *testing.T) {
+	t.Run("CaseInsensitiveByDefault", func(t *testing.T) {
+		m, err := newSearchMatcher(parsley.Filter{Expression: "error", ExactMatch: true})
+		require.NoError(t, err)
+		assert.True(t, m.match("ERROR: something went wrong"))
+		assert.False(t, m.match("all good"))
+		assert.NotEmpty(t, m.terms)
+	})
+	t.Run("CaseSensitive", func(t *testing.T) {
+		m, err := newSearchMatcher(parsley.Filter{Expression: "error", CaseSensitive: true, ExactMatch: true})
+		require.NoError(t, err)
+		assert.True(t, m.match("error: something went wrong"))
+		assert.False(t, m.match("ERROR: something went wrong"))
+	})
+	t.Run("InverseMatch", func(t *testing.T) {
+		m, err := newSearchMatcher(parsley.Filter{Expression: "error"})
+		require.NoError(t, err)
+		assert.False(t, m.match("error: something went wrong"))
+		assert.True(t, m.match("all good"))
+		assert.Empty(t, m.terms, "inverse matches cannot use the search index")
+	})
+	t.Run("FailsWithInvalidExpression", func(t *testing.T) {
+		_, err := newSearchMatcher(parsley.Filter{Expression: "(unclosed", ExactMatch: true})
+		assert.Error(t, err)
+	})
+	t.Run("FailsWithEmptyExpression", func(t *testing.T) {
+		_, err := newSearchMatcher(parsley.Filter{ExactMatch: true})
+		assert.Error(t, err)
+	})
+}
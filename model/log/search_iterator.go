@@ -0,0 +1,239 @@
+package log
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+type chunkSearchIterator struct {
+	ctx     context.Context
+	opts    chunkSearchIteratorOptions
+	catcher grip.Catcher
+
+	groupIdx int
+	chunkIdx int
+	// logLineCount is the number of lines in the current log before the
+	// next chunk.
+	logLineCount int
+
+	reader         *bufio.Reader
+	closer         io.Closer
+	parser         LineParser
+	chunkOffset    int
+	chunkLineCount int
+	candidates     map[int]bool
+
+	matchCount int
+	item       SearchMatch
+	exhausted  bool
+	closed     bool
+}
+
+type chunkSearchIteratorOptions struct {
+	groups  []chunkGroup
+	matcher *searchMatcher
+	start   *int64
+	end     *int64
+	limit   int
+	// openChunk opens the given chunk for reading.
+	openChunk func(context.Context, chunkInfo) (io.ReadCloser, error)
+	// openIndex opens the search index of the given chunk for reading. It
+	// returns a nil reader if the chunk does not have a search index.
+	openIndex func(context.Context, chunkInfo) (io.ReadCloser, error)
+}
+
+// newChunkSearchIterator returns a SearchIterator that searches the logs
+// represented by the given groups of chunks, one log at a time. Chunks are
+// skipped when their search index shows that none of their lines can match.
+func newChunkSearchIterator(ctx context.Context, opts chunkSearchIteratorOptions) *chunkSearchIterator {
+	return &chunkSearchIterator{
+		ctx:     ctx,
+		opts:    opts,
+		catcher: grip.NewBasicCatcher(),
+	}
+}
+
+func (it *chunkSearchIterator) Next() bool {
+	if it.closed || it.exhausted || it.catcher.HasErrors() {
+		return false
+	}
+	if it.opts.limit > 0 && it.matchCount == it.opts.limit {
+		it.exhausted = true
+		return false
+	}
+
+	for {
+		if it.reader == nil {
+			ok, err := it.openNextChunk()
+			if err != nil {
+				it.catcher.Add(err)
+				return false
+			}
+			if !ok {
+				it.exhausted = true
+				return false
+			}
+		}
+
+		// Once every candidate line in the chunk has been checked, the
+		// rest of the chunk can be skipped.
+		var (
+			data string
+			err  error
+		)
+		if it.candidates == nil || len(it.candidates) > 0 {
+			data, err = it.reader.ReadString('\n')
+		} else {
+			err = io.EOF
+		}
+		if err == io.EOF {
+			if err = it.closeChunk(); err != nil {
+				it.catcher.Add(err)
+				return false
+			}
+			continue
+		}
+		if err != nil {
+			it.catcher.Wrap(err, "getting next line")
+			return false
+		}
+
+		lineNum := it.chunkLineCount
+		it.chunkLineCount++
+		if it.candidates != nil {
+			if !it.candidates[lineNum] {
+				continue
+			}
+			delete(it.candidates, lineNum)
+		}
+
+		item, err := it.parser(data)
+		if err != nil {
+			it.catcher.Wrap(err, "parsing log line")
+			return false
+		}
+		if (it.opts.end != nil && item.Timestamp > utility.FromInt64Ptr(it.opts.end)) || item.Timestamp < utility.FromInt64Ptr(it.opts.start) {
+			continue
+		}
+		if !it.opts.matcher.match(item.Data) {
+			continue
+		}
+
+		it.item = SearchMatch{
+			LogLine:    item,
+			LineNumber: it.chunkOffset + lineNum,
+		}
+		it.matchCount++
+
+		return true
+	}
+}
+
+// openNextChunk opens the next chunk that may contain matching lines. It
+// returns false if there are no more chunks to search.
+func (it *chunkSearchIterator) openNextChunk() (bool, error) {
+	for it.groupIdx < len(it.opts.groups) {
+		group := it.opts.groups[it.groupIdx]
+		if it.chunkIdx == len(group.chunks) {
+			it.groupIdx++
+			it.chunkIdx = 0
+			it.logLineCount = 0
+			continue
+		}
+
+		chunk := group.chunks[it.chunkIdx]
+		chunkOffset := it.logLineCount
+		it.chunkIdx++
+		it.logLineCount += chunk.numLines
+
+		if (it.opts.end != nil && utility.FromInt64Ptr(it.opts.end) < chunk.start) || utility.FromInt64Ptr(it.opts.start) > chunk.end {
+			continue
+		}
+
+		candidates, err := it.getCandidates(chunk)
+		if err != nil {
+			return false, errors.Wrapf(err, "getting search candidates for chunk '%s'", chunk.key)
+		}
+		if candidates != nil && len(candidates) == 0 {
+			continue
+		}
+
+		r, err := it.opts.openChunk(it.ctx, chunk)
+		if err != nil {
+			return false, errors.Wrapf(err, "getting chunk '%s'", chunk.key)
+		}
+
+		it.reader = bufio.NewReader(r)
+		it.closer = r
+		it.parser = getLineParser(group.name)
+		it.chunkOffset = chunkOffset
+		it.chunkLineCount = 0
+		it.candidates = candidates
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// getCandidates returns the numbers of the lines in the chunk that may match
+// the search according to the chunk's search index. It returns nil if every
+// line in the chunk must be checked.
+func (it *chunkSearchIterator) getCandidates(chunk chunkInfo) (map[int]bool, error) {
+	if len(it.opts.matcher.terms) == 0 {
+		return nil, nil
+	}
+
+	r, err := it.opts.openIndex(it.ctx, chunk)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting search index")
+	}
+	if r == nil {
+		return nil, nil
+	}
+	defer r.Close()
+
+	var idx searchIndex
+	if err = json.NewDecoder(r).Decode(&idx); err != nil {
+		return nil, errors.Wrap(err, "decoding search index")
+	}
+
+	candidates := idx.candidates(it.opts.matcher.terms)
+	if candidates == nil {
+		candidates = map[int]bool{}
+	}
+
+	return candidates, nil
+}
+
+func (it *chunkSearchIterator) closeChunk() error {
+	err := it.closer.Close()
+	it.reader = nil
+	it.closer = nil
+	it.candidates = nil
+
+	return errors.Wrap(err, "closing chunk")
+}
+
+func (it *chunkSearchIterator) Item() SearchMatch { return it.item }
+
+func (it *chunkSearchIterator) Err() error { return it.catcher.Resolve() }
+
+func (it *chunkSearchIterator) Close() error {
+	if it.closed {
+		return nil
+	}
+	it.closed = true
+
+	if it.reader != nil {
+		return it.closeChunk()
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package log
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// sectionsPrefix is the storage key prefix of log sections. The sections of
+// each log are stored in batches under the log's name with this prefix.
+const sectionsPrefix = "_sections"
+
+// SectionType represents the kind of output that a log section contains.
+type SectionType string
+
+const (
+	// SectionTypeBlock is a section containing the output of a block of
+	// commands, such as pre or post.
+	SectionTypeBlock SectionType = "block"
+	// SectionTypeFunction is a section containing the output of the
+	// commands in a function.
+	SectionTypeFunction SectionType = "function"
+	// SectionTypeCommand is a section containing the output of a single
+	// command.
+	SectionTypeCommand SectionType = "command"
+	// SectionTypeUser is a section that the task marked itself.
+	SectionTypeUser SectionType = "user"
+)
+
+// SectionStatus represents the outcome of a log section.
+type SectionStatus string
+
+const (
+	// SectionStatusRunning indicates that the section has not ended yet.
+	SectionStatusRunning SectionStatus = "running"
+	// SectionStatusSucceeded indicates that the section ended successfully.
+	SectionStatusSucceeded SectionStatus = "success"
+	// SectionStatusFailed indicates that the section ended with an error.
+	SectionStatusFailed SectionStatus = "failed"
+)
+
+// Validate checks that the section status is recognized.
+func (s SectionStatus) Validate() error {
+	switch s {
+	case SectionStatusRunning, SectionStatusSucceeded, SectionStatusFailed:
+		return nil
+	default:
+		return errors.Errorf("unrecognized section status '%s'", s)
+	}
+}
+
+// Section represents a named span of time in an Evergreen log, such as the
+// time a command was running. The log lines in a section are the lines with
+// timestamps between the section's start and end.
+type Section struct {
+	// ID uniquely identifies the section within its log.
+	ID string `json:"id"`
+	// ParentID is the ID of the section that this section is nested in, if
+	// any.
+	ParentID string        `json:"parent_id,omitempty"`
+	Name     string        `json:"name"`
+	Type     SectionType   `json:"type"`
+	Status   SectionStatus `json:"status"`
+	// Start is the time the section began, represented as a Unix timestamp
+	// in nanoseconds.
+	Start int64 `json:"start"`
+	// End is the time the section ended, represented as a Unix timestamp in
+	// nanoseconds. It is zero if the section has not ended.
+	End int64 `json:"end,omitempty"`
+}
+
+// Duration returns how long the section ran for, or zero if it has not ended.
+func (s Section) Duration() time.Duration {
+	if s.End == 0 || s.End < s.Start {
+		return 0
+	}
+
+	return time.Duration(s.End - s.Start)
+}
+
+// SectionNode is a log section along with the sections nested in it.
+type SectionNode struct {
+	Section
+	Children []*SectionNode
+}
+
+// NewSectionTree arranges the given sections into trees by their parents. The
+// roots and the children of each node are ordered by start time. Sections
+// whose parent is missing are treated as roots.
+func NewSectionTree(sections []Section) []*SectionNode {
+	nodes := make(map[string]*SectionNode, len(sections))
+	for _, section := range sections {
+		nodes[section.ID] = &SectionNode{Section: section}
+	}
+
+	var roots []*SectionNode
+	for _, section := range sections {
+		node := nodes[section.ID]
+		if parent, ok := nodes[section.ParentID]; ok && section.ParentID != section.ID {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		roots = append(roots, node)
+	}
+
+	sortSectionNodes(roots)
+
+	return roots
+}
+
+func sortSectionNodes(nodes []*SectionNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Start < nodes[j].Start
+	})
+	for _, node := range nodes {
+		sortSectionNodes(node.Children)
+	}
+}
+
+// createSectionsKey returns the storage key, relative to the log's sections,
+// of a batch of section records.
+func createSectionsKey(sequence int, sections []Section) string {
+	start, end := sections[0].Start, sections[0].Start
+	for _, section := range sections {
+		if section.Start < start {
+			start = section.Start
+		}
+		if section.End > end {
+			end = section.End
+		}
+	}
+
+	return createChunkKey(sequence, start, end, len(sections))
+}
+
+// sectionsKey returns the storage key prefix of the sections of the log with
+// the given name.
+func sectionsKey(logName string) string {
+	return sectionsPrefix + "/" + logName
+}
+
+// mergeSectionBatches returns the sections recorded in the given batches of
+// section records, which must be ordered by the time they were appended.
+// Records for a section replace any earlier records for it.
+func mergeSectionBatches(batches [][]Section) []Section {
+	var (
+		sections []Section
+		idx      = map[string]int{}
+	)
+	for _, batch := range batches {
+		for _, section := range batch {
+			if i, ok := idx[section.ID]; ok {
+				sections[i] = section
+				continue
+			}
+			idx[section.ID] = len(sections)
+			sections = append(sections, section)
+		}
+	}
+
+	sort.SliceStable(sections, func(i, j int) bool {
+		return sections[i].Start < sections[j].Start
+	})
+
+	return sections
+}
+
+// sortSectionBatches orders batches of section records by their sequence,
+// which is the order they were appended.
+func sortSectionBatches(chunks []chunkInfo) {
+	sort.SliceStable(chunks, func(i, j int) bool {
+		return chunks[i].sequence < chunks[j].sequence
+	})
+}
+
+func marshalSectionBatch(sections []Section) ([]byte, error) {
+	for _, section := range sections {
+		if section.ID == "" {
+			return nil, errors.New("section must have an ID")
+		}
+		if err := section.Status.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid status for section '%s'", section.ID)
+		}
+	}
+
+	data, err := json.Marshal(sections)
+	return data, errors.Wrap(err, "marshalling sections")
+}
+
+func readSectionBatch(r io.ReadCloser) ([]Section, error) {
+	defer r.Close()
+
+	var sections []Section
+	if err := json.NewDecoder(r).Decode(&sections); err != nil {
+		return nil, errors.Wrap(err, "decoding sections")
+	}
+
+	return sections, nil
+}
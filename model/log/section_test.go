@@ -0,0 +1,38 @@
+package log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSectionTree(t *testing.T) {
+	sections := []Section{
+		{ID: "cmd1", ParentID: "main", Name: "shell.exec", Start: 4, End: 6},
+		{ID: "main", Name: "main", Start: 3, End: 9},
+		{ID: "user", ParentID: "cmd0", Name: "compile", Start: 3},
+		{ID: "pre", Name: "pre", Start: 0, End: 2},
+		{ID: "cmd0", ParentID: "main", Name: "subprocess.exec", Start: 3, End: 4},
+		{ID: "orphan", ParentID: "missing", Name: "orphan", Start: 1},
+	}
+
+	roots := NewSectionTree(sections)
+	require.Len(t, roots, 3)
+	assert.Equal(t, "pre", roots[0].ID)
+	assert.Equal(t, "orphan", roots[1].ID, "sections with a missing parent should be roots")
+	assert.Empty(t, roots[1].Children)
+
+	mainBlock := roots[2]
+	assert.Equal(t, "main", mainBlock.ID)
+	assert.Equal(t, 6*time.Nanosecond, mainBlock.Duration())
+	require.Len(t, mainBlock.Children, 2)
+	assert.Equal(t, "cmd0", mainBlock.Children[0].ID)
+	assert.Equal(t, "cmd1", mainBlock.Children[1].ID)
+	require.Len(t, mainBlock.Children[0].Children, 1)
+	assert.Equal(t, "user", mainBlock.Children[0].Children[0].ID)
+	assert.Zero(t, mainBlock.Children[0].Children[0].Duration(), "unfinished sections should not have a duration")
+
+	assert.Empty(t, NewSectionTree(nil))
+}
@@ -0,0 +1,447 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jpillora/longestcommon"
+	"github.com/pkg/errors"
+)
+
+const (
+	// fsIndexDirName is the name of the directory, relative to the root of
+	// the filesystem log service, containing the log indexes.
+	fsIndexDirName = ".index"
+	// fsIndexFileName is the name of the index file for each log.
+	fsIndexFileName = "index.jsonl"
+	// defaultFSSeekInterval is the default number of lines between each
+	// seek point recorded in a chunk's index entry.
+	defaultFSSeekInterval = 1000
+)
+
+// logServiceFS implements a local filesystem-backed log service for
+// Evergreen. Chunks are stored as files using the same key layout as the V0
+// service, including the chunks' search indexes, so a log directory can be
+// copied to or from a bucket used by the V0 service.
+//
+// Each log also has an index file listing its chunks in the order they were
+// appended, along with periodic seek points into each chunk. Reading a log
+// only requires reading the indexes of the matching logs rather than listing
+// every chunk, and time range and tail filters can seek directly to the
+// relevant line of a chunk rather than reading it from the beginning.
+type logServiceFS struct {
+	root         string
+	seekInterval int
+}
+
+// fsChunkIndexEntry is a single line in a log's index file representing one
+// chunk of the log.
+type fsChunkIndexEntry struct {
+	// Key is the chunk's key, relative to the log's directory.
+	Key string `json:"key"`
+	// SeekPoints are the positions of periodic lines in the chunk, ordered
+	// by line number.
+	SeekPoints []fsSeekPoint `json:"seek_points,omitempty"`
+}
+
+// fsSeekPoint is the position of a line in a chunk.
+type fsSeekPoint struct {
+	// Line is the line's zero-based line number within the chunk.
+	Line int `json:"line"`
+	// Offset is the line's byte offset within the chunk.
+	Offset int64 `json:"offset"`
+	// MaxPrecedingTimestamp is the latest timestamp of all the lines before
+	// this line in the chunk.
+	MaxPrecedingTimestamp int64 `json:"max_preceding_ts"`
+}
+
+// NewLogServiceFS returns a new Evergreen log service that stores logs in the
+// given directory on the local filesystem.
+func NewLogServiceFS(root string) *logServiceFS {
+	return &logServiceFS{
+		root:         root,
+		seekInterval: defaultFSSeekInterval,
+	}
+}
+
+func (s *logServiceFS) Get(ctx context.Context, getOpts GetOptions) (LogIterator, error) {
+	if len(getOpts.LogNames) == 0 {
+		return nil, errors.New("must specify at least one log name")
+	}
+
+	allLogChunks, seekPoints, firstStart, firstEnd, err := s.getLogChunks(getOpts.LogNames)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting log chunks")
+	}
+
+	return newChunkGroupsIterator(ctx, getOpts, allLogChunks, firstStart, firstEnd, chunkIteratorOptions{
+		openChunk: s.makeChunkOpener(seekPoints),
+	})
+}
+
+func (s *logServiceFS) Append(ctx context.Context, logName string, sequence int, lines []LogLine) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	if err := s.validateLogName(logName); err != nil {
+		return err
+	}
+
+	var (
+		rawLines bytes.Buffer
+		maxTS    int64
+	)
+	entry := fsChunkIndexEntry{Key: createChunkKey(sequence, lines[0].Timestamp, lines[len(lines)-1].Timestamp, len(lines))}
+	for i, line := range lines {
+		if i > 0 && i%s.seekInterval == 0 {
+			entry.SeekPoints = append(entry.SeekPoints, fsSeekPoint{
+				Line:                  i,
+				Offset:                int64(rawLines.Len()),
+				MaxPrecedingTimestamp: maxTS,
+			})
+		}
+		if i == 0 || line.Timestamp > maxTS {
+			maxTS = line.Timestamp
+		}
+		rawLines.WriteString(formatRawLine(line))
+	}
+
+	// As with the V0 service, the search index is written before the chunk
+	// so that a failure never leaves behind a chunk without its index.
+	idx, err := json.Marshal(newSearchIndex(lines))
+	if err != nil {
+		return errors.Wrap(err, "marshalling search index")
+	}
+	indexPath := s.searchIndexPath(logName + "/" + entry.Key)
+	if err = writeFileAtomic(filepath.Dir(indexPath), filepath.Base(indexPath), idx); err != nil {
+		return errors.Wrap(err, "writing search index")
+	}
+
+	logDir := filepath.Join(s.root, filepath.FromSlash(logName))
+	if err = writeFileAtomic(logDir, entry.Key, rawLines.Bytes()); err != nil {
+		return errors.Wrap(err, "writing log chunk")
+	}
+
+	// The chunk must be written before it is added to the index so that
+	// readers never find an index entry without its chunk.
+	return errors.Wrap(s.appendIndexEntry(logName, entry), "adding log chunk to index")
+}
+
+func (s *logServiceFS) Search(ctx context.Context, searchOpts SearchOptions) (SearchIterator, error) {
+	if len(searchOpts.LogNames) == 0 {
+		return nil, errors.New("must specify at least one log name")
+	}
+
+	matcher, err := newSearchMatcher(searchOpts.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	allLogChunks, _, _, _, err := s.getLogChunks(searchOpts.LogNames)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting log chunks")
+	}
+
+	return newChunkSearchIterator(ctx, chunkSearchIteratorOptions{
+		groups:  allLogChunks,
+		matcher: matcher,
+		start:   searchOpts.Start,
+		end:     searchOpts.End,
+		limit:   searchOpts.Limit,
+		openChunk: func(_ context.Context, chunk chunkInfo) (io.ReadCloser, error) {
+			return os.Open(s.chunkPath(chunk.key))
+		},
+		openIndex: func(_ context.Context, chunk chunkInfo) (io.ReadCloser, error) {
+			f, err := os.Open(s.searchIndexPath(chunk.key))
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, nil
+			}
+			return f, err
+		},
+	}), nil
+}
+
+func (s *logServiceFS) AppendSections(ctx context.Context, logName string, sequence int, sections []Section) error {
+	if len(sections) == 0 {
+		return nil
+	}
+	if err := s.validateLogName(logName); err != nil {
+		return err
+	}
+
+	data, err := marshalSectionBatch(sections)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(writeFileAtomic(s.sectionsDir(logName), createSectionsKey(sequence, sections), data), "writing sections")
+}
+
+func (s *logServiceFS) GetSections(ctx context.Context, logName string) ([]Section, error) {
+	if err := s.validateLogName(logName); err != nil {
+		return nil, err
+	}
+
+	dir := s.sectionsDir(logName)
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "reading sections directory")
+	}
+
+	var chunks []chunkInfo
+	for _, entry := range entries {
+		// Skip the sections of nested logs and any temporary files
+		// left behind by interrupted writes.
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		chunk, err := parseChunkKey(dir, entry.Name())
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing sections key '%s'", entry.Name())
+		}
+		chunks = append(chunks, chunk)
+	}
+	sortSectionBatches(chunks)
+
+	batches := make([][]Section, 0, len(chunks))
+	for _, chunk := range chunks {
+		f, err := os.Open(chunk.key)
+		if err != nil {
+			return nil, errors.Wrap(err, "opening sections file")
+		}
+		batch, err := readSectionBatch(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading sections file '%s'", chunk.key)
+		}
+		batches = append(batches, batch)
+	}
+
+	return mergeSectionBatches(batches), nil
+}
+
+// validateLogName checks that the log name is a relative path that stays
+// within the root directory and does not conflict with the indexes.
+func (s *logServiceFS) validateLogName(logName string) error {
+	localName := filepath.FromSlash(logName)
+	if !filepath.IsLocal(localName) {
+		return errors.Errorf("log name '%s' must be a relative path within the log directory", logName)
+	}
+	if dir := strings.SplitN(logName, "/", 2)[0]; dir == fsIndexDirName || dir == searchIndexPrefix || dir == sectionsPrefix {
+		return errors.Errorf("log name '%s' cannot begin with reserved directory '%s'", logName, dir)
+	}
+
+	return nil
+}
+
+func (s *logServiceFS) indexPath(logName string) string {
+	return filepath.Join(s.root, fsIndexDirName, filepath.FromSlash(logName), fsIndexFileName)
+}
+
+func (s *logServiceFS) chunkPath(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
+
+func (s *logServiceFS) searchIndexPath(chunkKey string) string {
+	return filepath.Join(s.root, filepath.FromSlash(searchIndexKey(chunkKey)))
+}
+
+func (s *logServiceFS) sectionsDir(logName string) string {
+	return filepath.Join(s.root, filepath.FromSlash(sectionsKey(logName)))
+}
+
+// appendIndexEntry appends the chunk's entry to the log's index file.
+func (s *logServiceFS) appendIndexEntry(logName string, entry fsChunkIndexEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "marshalling index entry")
+	}
+	data = append(data, '\n')
+
+	indexPath := s.indexPath(logName)
+	if err = os.MkdirAll(filepath.Dir(indexPath), 0755); err != nil {
+		return errors.Wrap(err, "creating index directory")
+	}
+	f, err := os.OpenFile(indexPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return errors.Wrap(err, "opening index file")
+	}
+	// The entry is written with a single append so that concurrent appends,
+	// even from different service instances, do not interleave.
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "writing index entry")
+	}
+
+	return errors.Wrap(f.Close(), "closing index file")
+}
+
+// getLogChunks maps each logical log to its chunks using the index files of
+// the logs matching the given prefixes. It also returns the seek points of
+// each chunk by chunk key.
+func (s *logServiceFS) getLogChunks(logNames []string) ([]chunkGroup, map[string][]fsSeekPoint, int64, int64, error) {
+	match := func(key string) bool {
+		for _, name := range logNames {
+			if strings.HasPrefix(key, name) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// To avoid walking every index, start from the deepest directory that
+	// contains the LCP of the given log names.
+	indexRoot := filepath.Join(s.root, fsIndexDirName)
+	walkRoot := filepath.Join(indexRoot, filepath.FromSlash(path.Dir(longestcommon.Prefix(logNames))))
+
+	var orderedLogNames []string
+	logChunks := map[string][]chunkInfo{}
+	seekPoints := map[string][]fsSeekPoint{}
+	err := filepath.WalkDir(walkRoot, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || d.Name() != fsIndexFileName {
+			return nil
+		}
+
+		relDir, err := filepath.Rel(indexRoot, filepath.Dir(p))
+		if err != nil {
+			return errors.Wrapf(err, "getting log name of index file '%s'", p)
+		}
+		logName := filepath.ToSlash(relDir)
+
+		entries, err := readIndexFile(p)
+		if err != nil {
+			return errors.Wrapf(err, "reading index for log '%s'", logName)
+		}
+		for _, entry := range entries {
+			if !match(logName + "/" + entry.Key) {
+				continue
+			}
+
+			chunk, err := parseChunkKey(logName, entry.Key)
+			if err != nil {
+				return errors.Wrapf(err, "parsing chunk key '%s'", entry.Key)
+			}
+
+			if _, ok := logChunks[logName]; !ok {
+				orderedLogNames = append(orderedLogNames, logName)
+			}
+			logChunks[logName] = append(logChunks[logName], chunk)
+			seekPoints[chunk.key] = entry.SeekPoints
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, 0, 0, errors.Wrap(err, "walking log indexes")
+	}
+
+	// Walking the directory returns the log names in lexical order, which
+	// ensures a deterministic merge order.
+	chunkGroups, start, end := groupLogChunks(logNames, orderedLogNames, logChunks)
+
+	return chunkGroups, seekPoints, start, end, nil
+}
+
+// makeChunkOpener returns a chunk opener that seeks to the furthest seek point
+// in the chunk that does not pass any line that needs to be read.
+func (s *logServiceFS) makeChunkOpener(seekPoints map[string][]fsSeekPoint) chunkOpener {
+	return func(_ context.Context, chunk chunkInfo, skipLines int, start *int64) (io.ReadCloser, int, error) {
+		f, err := os.Open(s.chunkPath(chunk.key))
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "opening chunk '%s'", chunk.key)
+		}
+
+		var target fsSeekPoint
+		for _, point := range seekPoints[chunk.key] {
+			canSkipByLine := point.Line <= skipLines
+			canSkipByTime := start != nil && point.MaxPrecedingTimestamp < *start
+			if !canSkipByLine && !canSkipByTime {
+				break
+			}
+			target = point
+		}
+		if target.Offset == 0 {
+			return f, 0, nil
+		}
+
+		if _, err = f.Seek(target.Offset, io.SeekStart); err != nil {
+			_ = f.Close()
+			return nil, 0, errors.Wrapf(err, "seeking to line %d of chunk '%s'", target.Line, chunk.key)
+		}
+
+		return f, target.Line, nil
+	}
+}
+
+// readIndexFile reads the chunk entries from a log's index file. An incomplete
+// final entry, which can be left behind if the process is interrupted while
+// appending to the index, is ignored since its chunk was not fully added.
+func readIndexFile(fileName string) ([]fsChunkIndexEntry, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading index file")
+	}
+
+	rawEntries := strings.Split(string(data), "\n")
+	entries := make([]fsChunkIndexEntry, 0, len(rawEntries))
+	for i, rawEntry := range rawEntries {
+		if rawEntry == "" {
+			continue
+		}
+
+		var entry fsChunkIndexEntry
+		if err = json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+			if i == len(rawEntries)-1 {
+				break
+			}
+			return nil, errors.Wrapf(err, "unmarshalling index entry %d", i)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// writeFileAtomic writes the data to the named file in the given directory such
+// that readers never observe a partially written file.
+func writeFileAtomic(dir, name string, data []byte) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrapf(err, "creating directory '%s'", dir)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+name+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary file")
+	}
+	defer func() {
+		// This is a no-op if the file was already renamed.
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(err, "writing temporary file")
+	}
+	if err = tmp.Close(); err != nil {
+		return errors.Wrap(err, "closing temporary file")
+	}
+
+	return errors.Wrap(os.Rename(tmp.Name(), filepath.Join(dir, name)), "renaming temporary file")
+}
@@ -0,0 +1,237 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogServiceFS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const (
+		logName      = "project/task/0/task_logs/task"
+		numLines     = 95
+		seekInterval = 10
+	)
+	ts := time.Now().UnixNano()
+	makeLines := func(offset int) []LogLine {
+		lines := make([]LogLine, numLines)
+		for i := range lines {
+			lines[i] = LogLine{
+				LogName:   logName,
+				Priority:  level.Info,
+				Timestamp: ts + int64(offset+i),
+				Data:      fmt.Sprintf("line %d", offset+i),
+			}
+		}
+		return lines
+	}
+	setup := func(t *testing.T) (*logServiceFS, []LogLine) {
+		svc := NewLogServiceFS(t.TempDir())
+		svc.seekInterval = seekInterval
+
+		first := makeLines(0)
+		second := makeLines(numLines)
+		require.NoError(t, svc.Append(ctx, logName, 0, first))
+		require.NoError(t, svc.Append(ctx, logName, 1, second))
+
+		return svc, append(first, second...)
+	}
+
+	t.Run("WritesIndexWithSeekPoints", func(t *testing.T) {
+		svc, _ := setup(t)
+
+		entries, err := readIndexFile(svc.indexPath(logName))
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		for _, entry := range entries {
+			assert.FileExists(t, svc.chunkPath(logName+"/"+entry.Key))
+			require.Len(t, entry.SeekPoints, (numLines-1)/seekInterval)
+			for i, point := range entry.SeekPoints {
+				assert.Equal(t, (i+1)*seekInterval, point.Line)
+			}
+		}
+	})
+	t.Run("SeeksToLine", func(t *testing.T) {
+		svc, _ := setup(t)
+		allLogChunks, seekPoints, _, _, err := svc.getLogChunks([]string{logName})
+		require.NoError(t, err)
+		require.Len(t, allLogChunks, 1)
+		require.Len(t, allLogChunks[0].chunks, 2)
+		chunk := allLogChunks[0].chunks[0]
+		open := svc.makeChunkOpener(seekPoints)
+
+		for _, test := range []struct {
+			name          string
+			skipLines     int
+			start         *int64
+			expectedSkips int
+		}{
+			{
+				name: "NoSkip",
+			},
+			{
+				name:          "BetweenSeekPoints",
+				skipLines:     25,
+				expectedSkips: 20,
+			},
+			{
+				name:          "PastLastSeekPoint",
+				skipLines:     numLines,
+				expectedSkips: 90,
+			},
+			{
+				name:          "StartTime",
+				start:         utility.ToInt64Ptr(ts + 42),
+				expectedSkips: 40,
+			},
+			{
+				name:          "StartTimeAfterLineOffset",
+				skipLines:     15,
+				start:         utility.ToInt64Ptr(ts + 31),
+				expectedSkips: 30,
+			},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				r, skipped, err := open(ctx, chunk, test.skipLines, test.start)
+				require.NoError(t, err)
+				defer r.Close()
+				assert.Equal(t, test.expectedSkips, skipped)
+
+				data, err := io.ReadAll(r)
+				require.NoError(t, err)
+				assert.True(t, strings.HasPrefix(string(data), formatRawLine(makeLines(0)[test.expectedSkips])))
+			})
+		}
+	})
+	t.Run("Get", func(t *testing.T) {
+		svc, lines := setup(t)
+
+		for _, test := range []struct {
+			name          string
+			opts          GetOptions
+			expectedLines []LogLine
+		}{
+			{
+				name:          "All",
+				opts:          GetOptions{LogNames: []string{logName}},
+				expectedLines: lines,
+			},
+			{
+				name: "Start",
+				opts: GetOptions{
+					LogNames: []string{logName},
+					Start:    utility.ToInt64Ptr(ts + 57),
+				},
+				expectedLines: lines[57:],
+			},
+			{
+				name: "StartInSecondChunk",
+				opts: GetOptions{
+					LogNames: []string{logName},
+					Start:    utility.ToInt64Ptr(ts + 133),
+				},
+				expectedLines: lines[133:],
+			},
+			{
+				name: "StartAndLineLimit",
+				opts: GetOptions{
+					LogNames:  []string{logName},
+					Start:     utility.ToInt64Ptr(ts + 57),
+					LineLimit: 70,
+				},
+				expectedLines: lines[57:70],
+			},
+			{
+				name: "TailN",
+				opts: GetOptions{
+					LogNames: []string{logName},
+					TailN:    23,
+				},
+				expectedLines: lines[len(lines)-23:],
+			},
+			{
+				name: "TailNAcrossChunks",
+				opts: GetOptions{
+					LogNames: []string{logName},
+					TailN:    numLines + 17,
+				},
+				expectedLines: lines[numLines-17:],
+			},
+			{
+				name: "StartAndTailN",
+				opts: GetOptions{
+					LogNames: []string{logName},
+					Start:    utility.ToInt64Ptr(ts + 150),
+					TailN:    55,
+				},
+				expectedLines: lines[150:],
+			},
+			{
+				name: "StartAndEnd",
+				opts: GetOptions{
+					LogNames: []string{logName},
+					Start:    utility.ToInt64Ptr(ts + 81),
+					End:      utility.ToInt64Ptr(ts + 102),
+				},
+				expectedLines: lines[81:103],
+			},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				assert.Equal(t, test.expectedLines, readLogLines(t, svc, ctx, test.opts))
+			})
+		}
+	})
+	t.Run("IgnoresIncompleteIndexEntry", func(t *testing.T) {
+		svc, lines := setup(t)
+
+		f, err := os.OpenFile(svc.indexPath(logName), os.O_WRONLY|os.O_APPEND, 0644)
+		require.NoError(t, err)
+		_, err = f.WriteString(`{"key":"2_`)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		assert.Equal(t, lines, readLogLines(t, svc, ctx, GetOptions{LogNames: []string{logName}}))
+	})
+	t.Run("FailsWithCorruptIndexEntry", func(t *testing.T) {
+		svc, _ := setup(t)
+
+		data, err := os.ReadFile(svc.indexPath(logName))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(svc.indexPath(logName), append([]byte("not json\n"), data...), 0644))
+
+		_, err = svc.Get(ctx, GetOptions{LogNames: []string{logName}})
+		assert.Error(t, err)
+	})
+	t.Run("RejectsInvalidLogNames", func(t *testing.T) {
+		svc := NewLogServiceFS(t.TempDir())
+		lines := makeLines(0)
+
+		for _, name := range []string{
+			"../outside",
+			"/absolute",
+			"",
+			fsIndexDirName,
+			fsIndexDirName + "/log",
+		} {
+			assert.Error(t, svc.Append(ctx, name, 0, lines), name)
+		}
+		assert.NoError(t, svc.Append(ctx, "not"+fsIndexDirName, 0, lines))
+	})
+	t.Run("NoLogs", func(t *testing.T) {
+		svc := NewLogServiceFS(filepath.Join(t.TempDir(), "nonexistent"))
+		assert.Empty(t, readLogLines(t, svc, ctx, GetOptions{LogNames: []string{logName}}))
+	})
+}
@@ -2,6 +2,8 @@ package log
 
 import (
 	"context"
+
+	"github.com/evergreen-ci/evergreen/model/parsley"
 )
 
 // LogService is a simple abstraction bridging the logical representation of an
@@ -15,6 +17,16 @@ type LogService interface {
 	Get(context.Context, GetOptions) (LogIterator, error)
 	// Append appends given lines to the specified log and sequence chunk.
 	Append(context.Context, string, int, []LogLine) error
+	// Search returns an iterator over the log lines matching the given
+	// options.
+	Search(context.Context, SearchOptions) (SearchIterator, error)
+	// AppendSections appends the given section records to the specified
+	// log and sequence. Records for a section replace the records for it
+	// appended with a lower sequence.
+	AppendSections(context.Context, string, int, []Section) error
+	// GetSections returns the sections of the specified log, ordered by
+	// start time.
+	GetSections(context.Context, string) ([]Section, error)
 }
 
 // GetOptions represents the arguments for fetching Evergreen logs.
@@ -45,6 +57,33 @@ type GetOptions struct {
 	TailN int
 }
 
+// SearchOptions represents the arguments for searching Evergreen logs.
+type SearchOptions struct {
+	// LogNames are the names of the logs to search, prefixes may be
+	// specified. At least one name must be specified.
+	//
+	// Matching lines are returned log by log, ordered by log name and then
+	// by line number.
+	LogNames []string
+	// Filter is the Parsley filter that lines must satisfy to match. The
+	// expression is a regular expression that is case insensitive unless
+	// CaseSensitive is set. Lines match if they match the expression when
+	// ExactMatch is set, and if they do not match the expression
+	// otherwise.
+	Filter parsley.Filter
+	// Start is the start time (inclusive) of the time range filter,
+	// represented as a Unix timestamp in nanoseconds. Defaults to
+	// unbounded.
+	Start *int64
+	// End is the end time (inclusive) of the time range filter,
+	// represented as a Unix timestamp in nanoseconds. Defaults to
+	// unbounded.
+	End *int64
+	// Limit limits the number of matching lines returned. Ignored if less
+	// than or equal to 0.
+	Limit int
+}
+
 // LineParser functions parse a raw log line into the service representation of
 // a log line for uniform ingestion of logs.
 // Parsers need not set the log name or, in most cases, the priority.
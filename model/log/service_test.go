@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/evergreen-ci/evergreen/model/parsley"
 	"github.com/evergreen-ci/pail"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip/level"
@@ -32,6 +33,17 @@ func TestLogService(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Filesystem",
+			constructor: func(t *testing.T) LogService {
+				svc := NewLogServiceFS(t.TempDir())
+				// Use a small seek interval so that reads exercise
+				// seeking within chunks.
+				svc.seekInterval = 1
+
+				return svc
+			},
+		},
 	} {
 		t.Run(impl.name, func(t *testing.T) {
 			svc := impl.constructor(t)
@@ -369,6 +381,156 @@ func TestLogService(t *testing.T) {
 					})
 				}
 			})
+			t.Run("Search", func(t *testing.T) {
+				ts := time.Now().UnixNano()
+				log0 := "search/0.log"
+				lines0 := []LogLine{
+					{LogName: log0, Priority: level.Info, Timestamp: ts, Data: "starting tests"},
+					{LogName: log0, Priority: level.Error, Timestamp: ts + 1, Data: "ERROR: connection refused"},
+					{LogName: log0, Priority: level.Info, Timestamp: ts + 2, Data: "retrying"},
+				}
+				require.NoError(t, svc.Append(ctx, log0, 0, lines0[:2]))
+				require.NoError(t, svc.Append(ctx, log0, 0, lines0[2:]))
+				log1 := "search/1.log"
+				lines1 := []LogLine{
+					{LogName: log1, Priority: level.Info, Timestamp: ts + 3, Data: "tests passed"},
+					{LogName: log1, Priority: level.Error, Timestamp: ts + 4, Data: "error: connection reset by peer"},
+				}
+				require.NoError(t, svc.Append(ctx, log1, 0, lines1))
+
+				for _, test := range []struct {
+					name            string
+					opts            SearchOptions
+					expectedMatches []SearchMatch
+				}{
+					{
+						name: "LogDNE",
+						opts: SearchOptions{
+							LogNames: []string{"DNE"},
+							Filter:   parsley.Filter{Expression: "error", ExactMatch: true},
+						},
+					},
+					{
+						name: "CaseInsensitive",
+						opts: SearchOptions{
+							LogNames: []string{"search"},
+							Filter:   parsley.Filter{Expression: "error: connection", ExactMatch: true},
+						},
+						expectedMatches: []SearchMatch{
+							{LogLine: lines0[1], LineNumber: 1},
+							{LogLine: lines1[1], LineNumber: 1},
+						},
+					},
+					{
+						name: "CaseSensitive",
+						opts: SearchOptions{
+							LogNames: []string{"search"},
+							Filter:   parsley.Filter{Expression: "error: connection", CaseSensitive: true, ExactMatch: true},
+						},
+						expectedMatches: []SearchMatch{{LogLine: lines1[1], LineNumber: 1}},
+					},
+					{
+						name: "RegularExpression",
+						opts: SearchOptions{
+							LogNames: []string{"search"},
+							Filter:   parsley.Filter{Expression: "^(starting|retry)", ExactMatch: true},
+						},
+						expectedMatches: []SearchMatch{
+							{LogLine: lines0[0], LineNumber: 0},
+							{LogLine: lines0[2], LineNumber: 2},
+						},
+					},
+					{
+						name: "InverseMatch",
+						opts: SearchOptions{
+							LogNames: []string{log0},
+							Filter:   parsley.Filter{Expression: "error"},
+						},
+						expectedMatches: []SearchMatch{
+							{LogLine: lines0[0], LineNumber: 0},
+							{LogLine: lines0[2], LineNumber: 2},
+						},
+					},
+					{
+						name: "TimeRange",
+						opts: SearchOptions{
+							LogNames: []string{"search"},
+							Filter:   parsley.Filter{Expression: "connection", ExactMatch: true},
+							Start:    utility.ToInt64Ptr(ts + 2),
+							End:      utility.ToInt64Ptr(ts + 4),
+						},
+						expectedMatches: []SearchMatch{{LogLine: lines1[1], LineNumber: 1}},
+					},
+					{
+						name: "Limit",
+						opts: SearchOptions{
+							LogNames: []string{"search"},
+							Filter:   parsley.Filter{Expression: "connection", ExactMatch: true},
+							Limit:    1,
+						},
+						expectedMatches: []SearchMatch{{LogLine: lines0[1], LineNumber: 1}},
+					},
+					{
+						name: "NoMatches",
+						opts: SearchOptions{
+							LogNames: []string{"search"},
+							Filter:   parsley.Filter{Expression: "timeout", ExactMatch: true},
+						},
+					},
+				} {
+					t.Run(test.name, func(t *testing.T) {
+						it, err := svc.Search(ctx, test.opts)
+						require.NoError(t, err)
+
+						var matches []SearchMatch
+						for it.Next() {
+							matches = append(matches, it.Item())
+						}
+						require.NoError(t, it.Err())
+						assert.NoError(t, it.Close())
+						assert.Equal(t, test.expectedMatches, matches)
+					})
+				}
+				t.Run("FailsWithInvalidFilter", func(t *testing.T) {
+					_, err := svc.Search(ctx, SearchOptions{
+						LogNames: []string{"search"},
+						Filter:   parsley.Filter{Expression: "(", ExactMatch: true},
+					})
+					assert.Error(t, err)
+				})
+			})
+			t.Run("Sections", func(t *testing.T) {
+				ts := time.Now().UnixNano()
+				logName := "sections/task_logs"
+				block := Section{ID: "0", Name: "pre", Type: SectionTypeBlock, Status: SectionStatusRunning, Start: ts}
+				cmd := Section{ID: "1", ParentID: "0", Name: "shell.exec", Type: SectionTypeCommand, Status: SectionStatusRunning, Start: ts + 1}
+				require.NoError(t, svc.AppendSections(ctx, logName, 0, []Section{block}))
+				require.NoError(t, svc.AppendSections(ctx, logName, 1, []Section{cmd}))
+
+				finishedCmd := cmd
+				finishedCmd.Status = SectionStatusFailed
+				finishedCmd.End = ts + 5
+				finishedBlock := block
+				finishedBlock.Status = SectionStatusFailed
+				finishedBlock.End = ts + 6
+				require.NoError(t, svc.AppendSections(ctx, logName, 2, []Section{finishedCmd, finishedBlock}))
+				require.NoError(t, svc.AppendSections(ctx, logName+"/task", 0, []Section{{ID: "other", Status: SectionStatusRunning, Start: ts}}))
+				require.NoError(t, svc.AppendSections(ctx, logName, 3, nil))
+
+				sections, err := svc.GetSections(ctx, logName)
+				require.NoError(t, err)
+				assert.Equal(t, []Section{finishedBlock, finishedCmd}, sections)
+
+				t.Run("NoSections", func(t *testing.T) {
+					sections, err := svc.GetSections(ctx, "sections/none")
+					require.NoError(t, err)
+					assert.Empty(t, sections)
+				})
+				t.Run("FailsWithInvalidSection", func(t *testing.T) {
+					assert.Error(t, svc.AppendSections(ctx, logName, 4, []Section{{Status: SectionStatusRunning}}))
+					assert.Error(t, svc.AppendSections(ctx, logName, 4, []Section{{ID: "2", Status: "invalid"}}))
+				})
+			})
 		})
 	}
 }
@@ -83,6 +83,9 @@ func (n *Notification) SenderKey() (evergreen.SenderKey, error) {
 	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
 		return evergreen.SenderGithubStatus, nil
 
+	case event.GitLabMergeRequestSubscriberType:
+		return evergreen.SenderGitLabStatus, nil
+
 	default:
 		return evergreen.SenderEmail, errors.Errorf("unknown type '%s'", n.Subscriber.Type)
 	}
@@ -212,6 +215,20 @@ func (n *Notification) Composer(ctx context.Context) (message.Composer, error) {
 		payload.Ref = sub.Ref
 		return message.NewGithubStatusMessageWithRepo(level.Notice, *payload), nil
 
+	case event.GitLabMergeRequestSubscriberType:
+		sub := n.Subscriber.Target.(*event.GitLabMergeRequestSubscriber)
+		payload, ok := n.Payload.(*message.GithubStatus)
+		if !ok || payload == nil {
+			return nil, errors.New("gitlab-merge-request payload is invalid")
+		}
+		// GitLab statuses use the same fields as GitHub statuses, so the
+		// GitHub status message is reused to describe the status.
+		if idx := strings.LastIndex(sub.ProjectPath, "/"); idx > 0 {
+			payload.Owner, payload.Repo = sub.ProjectPath[:idx], sub.ProjectPath[idx+1:]
+		}
+		payload.Ref = sub.Ref
+		return message.NewGithubStatusMessageWithRepo(level.Notice, *payload), nil
+
 	default:
 		return nil, errors.Errorf("unknown type '%s'", n.Subscriber.Type)
 	}
@@ -299,6 +316,7 @@ type NotificationStats struct {
 	Slack             int `json:"slack" bson:"slack" yaml:"slack"`
 	GithubCheck       int `json:"github_check" bson:"github_check" yaml:"github_check"`
 	GithubMerge       int `json:"github_merge" bson:"github_merge" yaml:"github_merge"`
+	GitLabMR          int `json:"gitlab_merge_request" bson:"gitlab_merge_request" yaml:"gitlab_merge_request"`
 }
 
 func CollectUnsentNotificationStats() (*NotificationStats, error) {
@@ -343,6 +361,9 @@ func CollectUnsentNotificationStats() (*NotificationStats, error) {
 		case event.GithubMergeSubscriberType:
 			nStats.GithubMerge = data.Count
 
+		case event.GitLabMergeRequestSubscriberType:
+			nStats.GitLabMR = data.Count
+
 		case event.JIRAIssueSubscriberType:
 			nStats.JIRAIssue = data.Count
 
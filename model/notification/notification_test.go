@@ -384,7 +384,8 @@ func (s *notificationSuite) TestCollectUnsentNotificationStats() {
 	types := []string{event.GithubPullRequestSubscriberType, event.EmailSubscriberType,
 		event.SlackSubscriberType, event.EvergreenWebhookSubscriberType,
 		event.JIRACommentSubscriberType, event.JIRAIssueSubscriberType,
-		event.GithubCheckSubscriberType, event.GithubMergeSubscriberType}
+		event.GithubCheckSubscriberType, event.GithubMergeSubscriberType,
+		event.GitLabMergeRequestSubscriberType}
 
 	n := []Notification{}
 	// add one of every notification, unsent
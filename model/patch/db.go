@@ -47,6 +47,7 @@ var (
 	AliasKey                = bsonutil.MustHaveTag(Patch{}, "Alias")
 	githubMergeDataKey      = bsonutil.MustHaveTag(Patch{}, "GithubMergeData")
 	githubPatchDataKey      = bsonutil.MustHaveTag(Patch{}, "GithubPatchData")
+	gitLabMRDataKey         = bsonutil.MustHaveTag(Patch{}, "GitLabMRData")
 	MergePatchKey           = bsonutil.MustHaveTag(Patch{}, "MergePatch")
 	TriggersKey             = bsonutil.MustHaveTag(Patch{}, "Triggers")
 	HiddenKey               = bsonutil.MustHaveTag(Patch{}, "Hidden")
@@ -367,6 +368,18 @@ func ByGithubPRAndCreatedBefore(t time.Time, owner, repo string, prNumber int) d
 	})
 }
 
+// ByGitLabMergeRequestAndCreatedBefore returns a query for the patches created
+// before the given time for the GitLab merge request.
+func ByGitLabMergeRequestAndCreatedBefore(t time.Time, projectPath string, iid int) db.Q {
+	return db.Query(bson.M{
+		CreateTimeKey: bson.M{
+			"$lt": t,
+		},
+		bsonutil.GetDottedKeyName(gitLabMRDataKey, thirdparty.GitLabMergeRequestProjectPathKey): projectPath,
+		bsonutil.GetDottedKeyName(gitLabMRDataKey, thirdparty.GitLabMergeRequestIIDKey):         iid,
+	})
+}
+
 // ConsolidatePatchesForUser updates all patches authored by oldAuthor to be authored by newAuthor,
 // and if any patches have been authored by the new author already, update the patch numbers to come after the new author.
 func ConsolidatePatchesForUser(ctx context.Context, oldAuthor string, newUsr *user.DBUser) error {
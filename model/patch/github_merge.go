@@ -63,6 +63,10 @@ type githubMergeIntent struct {
 
 	// Repo is the GitHub repository name
 	Repo string `bson:"repo"`
+
+	// BatchID is the merge queue batch that this intent tests, if the merge
+	// group is part of a batch.
+	BatchID string `bson:"batch_id,omitempty"`
 }
 
 // NewGithubIntent creates an Intent from a google/go-github MergeGroup.
@@ -125,6 +129,30 @@ func NewGithubMergeIntent(msgDeliveryID string, caller string, mg *github.MergeG
 	}, nil
 }
 
+// NewGithubMergeBatchIntent creates an intent to test the head of the merge
+// group at the given index in the batch, which includes the changes of every
+// merge group before it in the batch.
+func NewGithubMergeBatchIntent(b *MergeQueueBatch, groupIndex int) (Intent, error) {
+	if groupIndex < 0 || groupIndex >= len(b.Groups) {
+		return nil, errors.Errorf("merge group index %d is out of range for merge queue batch '%s'", groupIndex, b.ID)
+	}
+	group := b.Groups[groupIndex]
+	id := mgobson.NewObjectId().Hex()
+	return &githubMergeIntent{
+		DocumentID: id,
+		MsgID:      id,
+		IntentType: GithubMergeIntentType,
+		CalledBy:   AutomatedCaller,
+		Org:        b.Org,
+		Repo:       b.Repo,
+		HeadRef:    group.HeadRef,
+		HeadSHA:    group.HeadSHA,
+		HeadCommit: group.HeadCommit,
+		BaseSHA:    group.BaseSHA,
+		BatchID:    b.ID,
+	}, nil
+}
+
 // SetProcessed should be called by an amboy queue after creating a patch from an intent.
 func (g *githubMergeIntent) SetProcessed(ctx context.Context) error {
 	g.Processed = true
@@ -222,6 +250,7 @@ func (g *githubMergeIntent) NewPatch() *Patch {
 			HeadBranch: headBranch,
 			HeadSHA:    g.HeadSHA,
 			HeadCommit: g.HeadCommit,
+			BatchID:    g.BatchID,
 		},
 	}
 	return patchDoc
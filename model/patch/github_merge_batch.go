@@ -0,0 +1,396 @@
+package patch
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MergeQueueBatchCollection is the collection of batches of GitHub merge
+// groups that are tested together.
+const MergeQueueBatchCollection = "merge_queue_batches"
+
+const (
+	// MergeQueueBisectPolicyBinary bisects a failing batch to find the first
+	// merge group that fails. This is the default.
+	MergeQueueBisectPolicyBinary = "binary"
+	// MergeQueueBisectPolicyNone fails every merge group in a failing batch
+	// without trying to find the merge group that caused the failure.
+	MergeQueueBisectPolicyNone = "none"
+)
+
+// MergeQueueBisectPolicies are the valid merge queue bisect policies. The
+// empty policy is the same as MergeQueueBisectPolicyBinary.
+var MergeQueueBisectPolicies = []string{"", MergeQueueBisectPolicyBinary, MergeQueueBisectPolicyNone}
+
+const (
+	// MergeQueueBatchCollecting means the batch is waiting for more merge
+	// groups before it's tested.
+	MergeQueueBatchCollecting = "collecting"
+	// MergeQueueBatchTesting means the batch is being tested and no more merge
+	// groups can be added to it.
+	MergeQueueBatchTesting = "testing"
+	// MergeQueueBatchFinished means every merge group in the batch has a
+	// result.
+	MergeQueueBatchFinished = "finished"
+)
+
+// MergeQueueBatch is a batch of GitHub merge groups for the same branch that
+// are tested together. GitHub builds each merge group on top of the ones
+// queued before it, so testing the head of the last merge group tests the
+// changes of the whole batch. If that fails, the batch is bisected by testing
+// the heads of earlier merge groups until the first failing merge group is
+// found.
+type MergeQueueBatch struct {
+	ID         string `bson:"_id" json:"id"`
+	ProjectID  string `bson:"project_id" json:"project_id"`
+	Org        string `bson:"org" json:"org"`
+	Repo       string `bson:"repo" json:"repo"`
+	BaseBranch string `bson:"base_branch" json:"base_branch"`
+	Status     string `bson:"status" json:"status"`
+	// BatchSize is the number of merge groups at which the batch is full.
+	BatchSize int `bson:"batch_size" json:"batch_size"`
+	// Bisect is whether to bisect the batch if it fails.
+	Bisect    bool      `bson:"bisect" json:"bisect"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	// StartAfter is the time after which the batch is tested even if it's not
+	// full.
+	StartAfter time.Time `bson:"start_after" json:"start_after"`
+	// Groups are the merge groups in the batch, in the order they were
+	// queued.
+	Groups []MergeQueueGroup `bson:"groups" json:"groups"`
+	// Runs are the patches that have tested the batch, in the order they were
+	// created.
+	Runs []MergeQueueBatchRun `bson:"runs,omitempty" json:"runs,omitempty"`
+}
+
+// MergeQueueGroupRequeued is the status of a merge group that was queued
+// after the merge group that caused its batch to fail. Since it includes the
+// failing changes, its result isn't reported to GitHub; GitHub instead
+// re-queues its PR once the failing PR is removed from the queue.
+const MergeQueueGroupRequeued = "requeued"
+
+// MergeQueueGroup is a single GitHub merge group in a batch.
+type MergeQueueGroup struct {
+	MsgID      string `bson:"msg_id" json:"msg_id"`
+	HeadRef    string `bson:"head_ref" json:"head_ref"`
+	HeadSHA    string `bson:"head_sha" json:"head_sha"`
+	HeadCommit string `bson:"head_commit,omitempty" json:"head_commit,omitempty"`
+	BaseSHA    string `bson:"base_sha" json:"base_sha"`
+	// Status is the result of the merge group, which is empty until it's
+	// known. It's MergeQueueGroupRequeued if the merge group was queued after
+	// the one that caused the batch to fail.
+	Status string `bson:"status,omitempty" json:"status,omitempty"`
+	// PatchID is the patch whose result determined the merge group's status.
+	PatchID string `bson:"patch_id,omitempty" json:"patch_id,omitempty"`
+}
+
+// MergeQueueBatchRun is a patch that tests the head of one of the merge
+// groups in a batch, which includes the changes of every merge group before
+// it.
+type MergeQueueBatchRun struct {
+	PatchID    string `bson:"patch_id" json:"patch_id"`
+	GroupIndex int    `bson:"group_index" json:"group_index"`
+	// Status is the result of the patch, which is empty until the patch
+	// finishes.
+	Status string `bson:"status,omitempty" json:"status,omitempty"`
+}
+
+var (
+	MergeQueueBatchIDKey         = bsonutil.MustHaveTag(MergeQueueBatch{}, "ID")
+	MergeQueueBatchProjectIDKey  = bsonutil.MustHaveTag(MergeQueueBatch{}, "ProjectID")
+	MergeQueueBatchOrgKey        = bsonutil.MustHaveTag(MergeQueueBatch{}, "Org")
+	MergeQueueBatchRepoKey       = bsonutil.MustHaveTag(MergeQueueBatch{}, "Repo")
+	MergeQueueBatchBaseBranchKey = bsonutil.MustHaveTag(MergeQueueBatch{}, "BaseBranch")
+	MergeQueueBatchStatusKey     = bsonutil.MustHaveTag(MergeQueueBatch{}, "Status")
+	MergeQueueBatchBatchSizeKey  = bsonutil.MustHaveTag(MergeQueueBatch{}, "BatchSize")
+	MergeQueueBatchBisectKey     = bsonutil.MustHaveTag(MergeQueueBatch{}, "Bisect")
+	MergeQueueBatchCreatedAtKey  = bsonutil.MustHaveTag(MergeQueueBatch{}, "CreatedAt")
+	MergeQueueBatchStartAfterKey = bsonutil.MustHaveTag(MergeQueueBatch{}, "StartAfter")
+	MergeQueueBatchGroupsKey     = bsonutil.MustHaveTag(MergeQueueBatch{}, "Groups")
+	MergeQueueBatchRunsKey       = bsonutil.MustHaveTag(MergeQueueBatch{}, "Runs")
+
+	mergeQueueRunPatchIDKey = bsonutil.MustHaveTag(MergeQueueBatchRun{}, "PatchID")
+	mergeQueueRunStatusKey  = bsonutil.MustHaveTag(MergeQueueBatchRun{}, "Status")
+)
+
+// MergeQueueBatchOptions are the settings for a new merge queue batch.
+type MergeQueueBatchOptions struct {
+	ProjectID  string
+	Org        string
+	Repo       string
+	BaseBranch string
+	BatchSize  int
+	Bisect     bool
+	Wait       time.Duration
+}
+
+// AddToMergeQueueBatch adds the merge group to the project's batch for the
+// branch that's still collecting merge groups, creating a new batch if there
+// isn't one with room left. It returns the batch with the merge group added.
+func AddToMergeQueueBatch(ctx context.Context, opts MergeQueueBatchOptions, group MergeQueueGroup) (*MergeQueueBatch, error) {
+	if opts.BatchSize < 1 {
+		return nil, errors.New("batch size must be positive")
+	}
+	now := time.Now()
+	res := evergreen.GetEnvironment().DB().Collection(MergeQueueBatchCollection).FindOneAndUpdate(ctx,
+		bson.M{
+			MergeQueueBatchProjectIDKey:  opts.ProjectID,
+			MergeQueueBatchBaseBranchKey: opts.BaseBranch,
+			MergeQueueBatchStatusKey:     MergeQueueBatchCollecting,
+			// The batch must not already have BatchSize groups.
+			bsonutil.GetDottedKeyName(MergeQueueBatchGroupsKey, strconv.Itoa(opts.BatchSize-1)): bson.M{"$exists": false},
+		},
+		bson.M{
+			"$push": bson.M{MergeQueueBatchGroupsKey: group},
+			"$setOnInsert": bson.M{
+				MergeQueueBatchIDKey:         mgobson.NewObjectId().Hex(),
+				MergeQueueBatchOrgKey:        opts.Org,
+				MergeQueueBatchRepoKey:       opts.Repo,
+				MergeQueueBatchBatchSizeKey:  opts.BatchSize,
+				MergeQueueBatchBisectKey:     opts.Bisect,
+				MergeQueueBatchCreatedAtKey:  now,
+				MergeQueueBatchStartAfterKey: now.Add(opts.Wait),
+			},
+		},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	)
+	if err := res.Err(); err != nil {
+		return nil, errors.Wrapf(err, "adding merge group '%s' to merge queue batch for project '%s'", group.HeadSHA, opts.ProjectID)
+	}
+	b := &MergeQueueBatch{}
+	if err := res.Decode(b); err != nil {
+		return nil, errors.Wrap(err, "decoding merge queue batch")
+	}
+	return b, nil
+}
+
+// FindMergeQueueBatch returns the batch with the given ID.
+func FindMergeQueueBatch(ctx context.Context, id string) (*MergeQueueBatch, error) {
+	b := &MergeQueueBatch{}
+	err := db.FindOneQContext(ctx, MergeQueueBatchCollection, db.Query(bson.M{MergeQueueBatchIDKey: id}), b)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding merge queue batch '%s'", id)
+	}
+	return b, nil
+}
+
+// FindMergeQueueBatchesByStatus returns all batches with the given status,
+// oldest first.
+func FindMergeQueueBatchesByStatus(ctx context.Context, status string) ([]MergeQueueBatch, error) {
+	batches := []MergeQueueBatch{}
+	q := db.Query(bson.M{MergeQueueBatchStatusKey: status}).Sort([]string{MergeQueueBatchCreatedAtKey})
+	if err := db.FindAllQContext(ctx, MergeQueueBatchCollection, q, &batches); err != nil {
+		return nil, errors.Wrapf(err, "finding merge queue batches with status '%s'", status)
+	}
+	return batches, nil
+}
+
+// IsReadyToTest returns whether the batch has finished collecting merge
+// groups.
+func (b *MergeQueueBatch) IsReadyToTest(now time.Time) bool {
+	return b.Status == MergeQueueBatchCollecting && (len(b.Groups) >= b.BatchSize || !now.Before(b.StartAfter))
+}
+
+// StartTesting stops the batch from collecting more merge groups. It returns
+// whether this call started testing the batch, which is false if it was
+// already started.
+func (b *MergeQueueBatch) StartTesting(ctx context.Context) (bool, error) {
+	err := db.UpdateContext(ctx, MergeQueueBatchCollection,
+		bson.M{
+			MergeQueueBatchIDKey:     b.ID,
+			MergeQueueBatchStatusKey: MergeQueueBatchCollecting,
+		},
+		bson.M{"$set": bson.M{MergeQueueBatchStatusKey: MergeQueueBatchTesting}},
+	)
+	if adb.ResultsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "starting merge queue batch '%s'", b.ID)
+	}
+	// Groups may have been added since the batch was read.
+	updated, err := FindMergeQueueBatch(ctx, b.ID)
+	if err != nil {
+		return false, err
+	}
+	if updated == nil {
+		return false, errors.Errorf("merge queue batch '%s' not found", b.ID)
+	}
+	*b = *updated
+	return true, nil
+}
+
+// AddRun records a new patch testing the head of the merge group at the given
+// index.
+func (b *MergeQueueBatch) AddRun(ctx context.Context, patchID string, groupIndex int) error {
+	if groupIndex < 0 || groupIndex >= len(b.Groups) {
+		return errors.Errorf("merge group index %d is out of range for merge queue batch '%s'", groupIndex, b.ID)
+	}
+	run := MergeQueueBatchRun{PatchID: patchID, GroupIndex: groupIndex}
+	if err := db.UpdateContext(ctx, MergeQueueBatchCollection,
+		bson.M{MergeQueueBatchIDKey: b.ID},
+		bson.M{"$push": bson.M{MergeQueueBatchRunsKey: run}},
+	); err != nil {
+		return errors.Wrapf(err, "adding run to merge queue batch '%s'", b.ID)
+	}
+	b.Runs = append(b.Runs, run)
+	return nil
+}
+
+// SetMergeQueueBatchRunFailed marks the batch's run for the patch as failed,
+// which is used when the patch could not be created.
+func SetMergeQueueBatchRunFailed(ctx context.Context, batchID, patchID string) error {
+	err := db.UpdateContext(ctx, MergeQueueBatchCollection,
+		bson.M{
+			MergeQueueBatchIDKey: batchID,
+			bsonutil.GetDottedKeyName(MergeQueueBatchRunsKey, mergeQueueRunPatchIDKey): patchID,
+		},
+		bson.M{"$set": bson.M{
+			bsonutil.GetDottedKeyName(MergeQueueBatchRunsKey, "$", mergeQueueRunStatusKey): evergreen.VersionFailed,
+		}},
+	)
+	return errors.Wrapf(err, "marking run for patch '%s' failed in merge queue batch '%s'", patchID, batchID)
+}
+
+// CurrentRun returns the most recent run of the batch, or nil if the batch
+// hasn't been tested yet.
+func (b *MergeQueueBatch) CurrentRun() *MergeQueueBatchRun {
+	if len(b.Runs) == 0 {
+		return nil
+	}
+	return &b.Runs[len(b.Runs)-1]
+}
+
+// bisectState returns the number of merge groups at the front of the batch
+// that are known to pass and the index of the first merge group known to fail,
+// which is -1 if no merge group is known to fail.
+func (b *MergeQueueBatch) bisectState() (numPassed, failing int) {
+	failing = -1
+	for _, run := range b.Runs {
+		switch run.Status {
+		case evergreen.VersionSucceeded:
+			numPassed = max(numPassed, run.GroupIndex+1)
+		case evergreen.VersionFailed:
+			if failing == -1 || run.GroupIndex < failing {
+				failing = run.GroupIndex
+			}
+		}
+	}
+	return numPassed, failing
+}
+
+// NextGroupToTest returns the index of the merge group whose head should be
+// tested next, or -1 if the result of every merge group is known. It must
+// only be called when no run is in progress.
+func (b *MergeQueueBatch) NextGroupToTest() int {
+	numPassed, failing := b.bisectState()
+	if failing == -1 {
+		if numPassed >= len(b.Groups) {
+			return -1
+		}
+		return len(b.Groups) - 1
+	}
+	if !b.Bisect || numPassed >= failing {
+		return -1
+	}
+	// The first failing merge group is between numPassed and failing, so
+	// split that range in half.
+	return numPassed + (failing-numPassed-1)/2
+}
+
+// ResolveGroups sets the status of every merge group whose result is known
+// from the finished runs and returns the indexes of the newly-resolved merge
+// groups whose results should be reported to GitHub. Once bisection finds the
+// merge group that caused the batch to fail, only that merge group fails; the
+// merge groups after it are marked requeued and are not reported, since GitHub
+// rebuilds them without the failing changes.
+func (b *MergeQueueBatch) ResolveGroups() []int {
+	numPassed, failing := b.bisectState()
+	done := b.NextGroupToTest() == -1
+	culprit := b.Culprit()
+	resolved := []int{}
+	for i := range b.Groups {
+		if b.Groups[i].Status != "" {
+			continue
+		}
+		switch {
+		case i < numPassed:
+			b.Groups[i].Status = evergreen.VersionSucceeded
+			b.Groups[i].PatchID = b.runPatchID(evergreen.VersionSucceeded, func(idx int) bool { return idx >= i })
+		case !done || failing == -1:
+			continue
+		case culprit != -1 && i > culprit:
+			b.Groups[i].Status = MergeQueueGroupRequeued
+			continue
+		default:
+			// Without bisection, the merge group that caused the failure
+			// isn't known, so every merge group from the first failing
+			// one onward fails.
+			b.Groups[i].Status = evergreen.VersionFailed
+			b.Groups[i].PatchID = b.runPatchID(evergreen.VersionFailed, func(idx int) bool { return idx == failing })
+		}
+		resolved = append(resolved, i)
+	}
+	return resolved
+}
+
+// runPatchID returns the patch of the earliest run with the given status
+// whose merge group index matches.
+func (b *MergeQueueBatch) runPatchID(status string, matches func(int) bool) string {
+	for _, run := range b.Runs {
+		if run.Status == status && matches(run.GroupIndex) {
+			return run.PatchID
+		}
+	}
+	return ""
+}
+
+// Culprit returns the index of the merge group that was found to have caused
+// the batch to fail, or -1 if it's not known.
+func (b *MergeQueueBatch) Culprit() int {
+	numPassed, failing := b.bisectState()
+	if failing != -1 && numPassed == failing {
+		return failing
+	}
+	return -1
+}
+
+// SaveProgress saves the batch's runs and merge group results, marking the
+// batch finished if every merge group has a result.
+func (b *MergeQueueBatch) SaveProgress(ctx context.Context) error {
+	if b.isResolved() {
+		b.Status = MergeQueueBatchFinished
+	}
+	err := db.UpdateContext(ctx, MergeQueueBatchCollection,
+		bson.M{MergeQueueBatchIDKey: b.ID},
+		bson.M{"$set": bson.M{
+			MergeQueueBatchStatusKey: b.Status,
+			MergeQueueBatchGroupsKey: b.Groups,
+			MergeQueueBatchRunsKey:   b.Runs,
+		}},
+	)
+	return errors.Wrapf(err, "saving merge queue batch '%s'", b.ID)
+}
+
+// isResolved returns whether every merge group in the batch has a result.
+func (b *MergeQueueBatch) isResolved() bool {
+	for _, g := range b.Groups {
+		if g.Status == "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,247 @@
+package patch
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeMergeQueueBatch(numGroups int, bisect bool) *MergeQueueBatch {
+	b := &MergeQueueBatch{
+		ID:        "batch",
+		Status:    MergeQueueBatchTesting,
+		BatchSize: numGroups,
+		Bisect:    bisect,
+	}
+	for i := 0; i < numGroups; i++ {
+		b.Groups = append(b.Groups, MergeQueueGroup{HeadSHA: fmt.Sprintf("sha%d", i)})
+	}
+	return b
+}
+
+// runMergeQueueBatch simulates testing the batch until every merge group has
+// a result, where merge groups from the first failing one onward fail. It
+// returns the merge group indexes that were tested, in order.
+func runMergeQueueBatch(t *testing.T, b *MergeQueueBatch, firstFailing int) []int {
+	tested := []int{}
+	for next := b.NextGroupToTest(); next != -1; next = b.NextGroupToTest() {
+		require.Less(t, len(tested), len(b.Groups)+1, "bisection should not test more merge groups than there are in the batch")
+		status := evergreen.VersionSucceeded
+		if firstFailing != -1 && next >= firstFailing {
+			status = evergreen.VersionFailed
+		}
+		b.Runs = append(b.Runs, MergeQueueBatchRun{
+			PatchID:    fmt.Sprintf("patch%d", len(b.Runs)),
+			GroupIndex: next,
+			Status:     status,
+		})
+		tested = append(tested, next)
+		b.ResolveGroups()
+	}
+	return tested
+}
+
+func TestMergeQueueBatchBisection(t *testing.T) {
+	t.Run("TestsLastGroupFirst", func(t *testing.T) {
+		b := makeMergeQueueBatch(4, true)
+		assert.Equal(t, 3, b.NextGroupToTest())
+	})
+	t.Run("SucceedsEveryGroupWhenBatchSucceeds", func(t *testing.T) {
+		b := makeMergeQueueBatch(4, true)
+		assert.Equal(t, []int{3}, runMergeQueueBatch(t, b, -1))
+		for _, g := range b.Groups {
+			assert.Equal(t, evergreen.VersionSucceeded, g.Status)
+			assert.Equal(t, "patch0", g.PatchID)
+		}
+		assert.Equal(t, -1, b.Culprit())
+	})
+	t.Run("FindsEachPossibleCulprit", func(t *testing.T) {
+		for numGroups := 1; numGroups <= 9; numGroups++ {
+			for culprit := 0; culprit < numGroups; culprit++ {
+				b := makeMergeQueueBatch(numGroups, true)
+				runMergeQueueBatch(t, b, culprit)
+				assert.Equal(t, culprit, b.Culprit(), "batch of %d", numGroups)
+				for i, g := range b.Groups {
+					switch {
+					case i < culprit:
+						assert.Equal(t, evergreen.VersionSucceeded, g.Status)
+						assert.NotEmpty(t, g.PatchID)
+					case i == culprit:
+						assert.Equal(t, evergreen.VersionFailed, g.Status)
+						assert.NotEmpty(t, g.PatchID)
+					default:
+						assert.Equal(t, MergeQueueGroupRequeued, g.Status, "merge groups after the culprit should not fail")
+						assert.Empty(t, g.PatchID)
+					}
+				}
+				assert.True(t, b.isResolved())
+			}
+		}
+	})
+	t.Run("ReportsOnlyGroupsUpToCulprit", func(t *testing.T) {
+		b := makeMergeQueueBatch(4, true)
+		b.Runs = []MergeQueueBatchRun{
+			{PatchID: "p0", GroupIndex: 3, Status: evergreen.VersionFailed},
+			{PatchID: "p1", GroupIndex: 1, Status: evergreen.VersionFailed},
+		}
+		assert.Empty(t, b.ResolveGroups())
+		b.Runs = append(b.Runs, MergeQueueBatchRun{PatchID: "p2", GroupIndex: 0, Status: evergreen.VersionSucceeded})
+		assert.Equal(t, []int{0, 1}, b.ResolveGroups())
+		assert.Equal(t, 1, b.Culprit())
+		assert.Equal(t, evergreen.VersionFailed, b.Groups[1].Status)
+		assert.Equal(t, "p1", b.Groups[1].PatchID)
+		assert.Equal(t, MergeQueueGroupRequeued, b.Groups[2].Status)
+		assert.Equal(t, MergeQueueGroupRequeued, b.Groups[3].Status)
+		assert.True(t, b.isResolved())
+	})
+	t.Run("BisectsInLogarithmicRuns", func(t *testing.T) {
+		b := makeMergeQueueBatch(8, true)
+		tested := runMergeQueueBatch(t, b, 5)
+		assert.Equal(t, []int{7, 3, 5, 4}, tested)
+	})
+	t.Run("FailsEveryGroupWithoutBisecting", func(t *testing.T) {
+		b := makeMergeQueueBatch(4, false)
+		assert.Equal(t, []int{3}, runMergeQueueBatch(t, b, 2))
+		for _, g := range b.Groups {
+			assert.Equal(t, evergreen.VersionFailed, g.Status)
+		}
+		assert.Equal(t, -1, b.Culprit())
+	})
+	t.Run("ResolvesPassingGroupsBeforeCulpritIsFound", func(t *testing.T) {
+		b := makeMergeQueueBatch(8, true)
+		b.Runs = []MergeQueueBatchRun{
+			{PatchID: "p0", GroupIndex: 7, Status: evergreen.VersionFailed},
+			{PatchID: "p1", GroupIndex: 3, Status: evergreen.VersionSucceeded},
+		}
+		assert.Equal(t, []int{0, 1, 2, 3}, b.ResolveGroups())
+		assert.Equal(t, 5, b.NextGroupToTest())
+		assert.Empty(t, b.Groups[4].Status)
+		assert.Empty(t, b.ResolveGroups(), "already resolved groups should not be returned again")
+	})
+}
+
+func TestMergeQueueBatchDB(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer func() {
+		assert.NoError(t, db.Clear(MergeQueueBatchCollection))
+	}()
+
+	opts := MergeQueueBatchOptions{
+		ProjectID:  "project",
+		Org:        "evergreen-ci",
+		Repo:       "evergreen",
+		BaseBranch: "main",
+		BatchSize:  2,
+		Bisect:     true,
+		Wait:       time.Hour,
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"AddsGroupsUntilBatchIsFull": func(t *testing.T) {
+			b0, err := AddToMergeQueueBatch(ctx, opts, MergeQueueGroup{HeadSHA: "sha0"})
+			require.NoError(t, err)
+			assert.Equal(t, MergeQueueBatchCollecting, b0.Status)
+			assert.Equal(t, "project", b0.ProjectID)
+			assert.Equal(t, "evergreen-ci", b0.Org)
+			assert.True(t, b0.Bisect)
+			assert.False(t, b0.IsReadyToTest(time.Now()))
+
+			b1, err := AddToMergeQueueBatch(ctx, opts, MergeQueueGroup{HeadSHA: "sha1"})
+			require.NoError(t, err)
+			assert.Equal(t, b0.ID, b1.ID)
+			require.Len(t, b1.Groups, 2)
+			assert.Equal(t, "sha1", b1.Groups[1].HeadSHA)
+			assert.True(t, b1.IsReadyToTest(time.Now()))
+
+			b2, err := AddToMergeQueueBatch(ctx, opts, MergeQueueGroup{HeadSHA: "sha2"})
+			require.NoError(t, err)
+			assert.NotEqual(t, b0.ID, b2.ID)
+			assert.Len(t, b2.Groups, 1)
+		},
+		"SeparatesBatchesByBranch": func(t *testing.T) {
+			b0, err := AddToMergeQueueBatch(ctx, opts, MergeQueueGroup{HeadSHA: "sha0"})
+			require.NoError(t, err)
+			otherOpts := opts
+			otherOpts.BaseBranch = "other"
+			b1, err := AddToMergeQueueBatch(ctx, otherOpts, MergeQueueGroup{HeadSHA: "sha1"})
+			require.NoError(t, err)
+			assert.NotEqual(t, b0.ID, b1.ID)
+		},
+		"IsReadyToTestAfterWait": func(t *testing.T) {
+			b, err := AddToMergeQueueBatch(ctx, opts, MergeQueueGroup{HeadSHA: "sha0"})
+			require.NoError(t, err)
+			assert.True(t, b.IsReadyToTest(time.Now().Add(2*time.Hour)))
+		},
+		"StartTestingStopsCollecting": func(t *testing.T) {
+			b, err := AddToMergeQueueBatch(ctx, opts, MergeQueueGroup{HeadSHA: "sha0"})
+			require.NoError(t, err)
+
+			started, err := b.StartTesting(ctx)
+			require.NoError(t, err)
+			assert.True(t, started)
+			assert.Equal(t, MergeQueueBatchTesting, b.Status)
+
+			started, err = b.StartTesting(ctx)
+			require.NoError(t, err)
+			assert.False(t, started, "batch should only be started once")
+
+			next, err := AddToMergeQueueBatch(ctx, opts, MergeQueueGroup{HeadSHA: "sha1"})
+			require.NoError(t, err)
+			assert.NotEqual(t, b.ID, next.ID)
+		},
+		"RecordsRunsAndProgress": func(t *testing.T) {
+			b, err := AddToMergeQueueBatch(ctx, opts, MergeQueueGroup{HeadSHA: "sha0"})
+			require.NoError(t, err)
+			b, err = AddToMergeQueueBatch(ctx, opts, MergeQueueGroup{HeadSHA: "sha1"})
+			require.NoError(t, err)
+			_, err = b.StartTesting(ctx)
+			require.NoError(t, err)
+
+			assert.Error(t, b.AddRun(ctx, "patch0", 2))
+			require.NoError(t, b.AddRun(ctx, "patch0", 1))
+			require.NoError(t, SetMergeQueueBatchRunFailed(ctx, b.ID, "patch0"))
+
+			dbBatch, err := FindMergeQueueBatch(ctx, b.ID)
+			require.NoError(t, err)
+			require.NotNil(t, dbBatch)
+			require.NotNil(t, dbBatch.CurrentRun())
+			assert.Equal(t, evergreen.VersionFailed, dbBatch.CurrentRun().Status)
+
+			assert.Empty(t, dbBatch.ResolveGroups())
+			require.NoError(t, dbBatch.SaveProgress(ctx))
+			assert.Equal(t, MergeQueueBatchTesting, dbBatch.Status)
+			assert.Equal(t, 0, dbBatch.NextGroupToTest())
+
+			require.NoError(t, dbBatch.AddRun(ctx, "patch1", 0))
+			dbBatch.CurrentRun().Status = evergreen.VersionSucceeded
+			assert.Equal(t, []int{0, 1}, dbBatch.ResolveGroups())
+			require.NoError(t, dbBatch.SaveProgress(ctx))
+
+			dbBatch, err = FindMergeQueueBatch(ctx, b.ID)
+			require.NoError(t, err)
+			require.NotNil(t, dbBatch)
+			assert.Equal(t, MergeQueueBatchFinished, dbBatch.Status)
+			assert.Equal(t, evergreen.VersionSucceeded, dbBatch.Groups[0].Status)
+			assert.Equal(t, "patch1", dbBatch.Groups[0].PatchID)
+			assert.Equal(t, evergreen.VersionFailed, dbBatch.Groups[1].Status)
+			assert.Equal(t, "patch0", dbBatch.Groups[1].PatchID)
+			assert.Equal(t, 1, dbBatch.Culprit())
+
+			testing, err := FindMergeQueueBatchesByStatus(ctx, MergeQueueBatchTesting)
+			require.NoError(t, err)
+			assert.Empty(t, testing)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.Clear(MergeQueueBatchCollection))
+			tCase(t)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package patch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// GitLabMergeRequestIntentType is an intent to create a patch for a GitLab
+	// merge request.
+	GitLabMergeRequestIntentType = "gitlab_merge_request"
+)
+
+// gitLabMergeRequestIntent represents an intent to create a patch build as a
+// result of a GitLab merge request webhook. These intents are processed
+// asynchronously by an amboy queue.
+type gitLabMergeRequestIntent struct {
+	// DocumentID is created by the driver and has no special meaning to the application
+	DocumentID string `bson:"_id"`
+
+	// MsgID is the GUID provided by GitLab (X-Gitlab-Event-UUID) for the event
+	MsgID string `bson:"msg_id"`
+
+	// CreatedAt is the time that this intent was stored in the database
+	CreatedAt time.Time `bson:"created_at"`
+
+	// Processed indicates whether a patch intent has been processed by the amboy queue.
+	Processed bool `bson:"processed"`
+
+	// ProcessedAt is the time that this intent was processed
+	ProcessedAt time.Time `bson:"processed_at"`
+
+	// IntentType indicates the type of the patch intent, i.e., GitLabMergeRequestIntentType
+	IntentType string `bson:"intent_type"`
+
+	// CalledBy indicates whether the intent was created automatically by Evergreen or by a user.
+	CalledBy string `bson:"called_by"`
+
+	// MergeRequest is the merge request to create the patch for.
+	MergeRequest thirdparty.GitLabMergeRequest `bson:"merge_request"`
+}
+
+// NewGitLabMergeRequestIntent creates an Intent for a GitLab merge request, or
+// returns an error if the merge request is missing information needed to
+// create a patch.
+func NewGitLabMergeRequestIntent(msgDeliveryID, caller string, mr thirdparty.GitLabMergeRequest) (Intent, error) {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(msgDeliveryID == "", "message ID cannot be empty")
+	catcher.NewWhen(caller == "", "empty caller errors")
+	catcher.NewWhen(mr.ProjectPath == "", "merge request project path cannot be empty")
+	catcher.NewWhen(mr.IID == 0, "merge request IID cannot be empty")
+	catcher.NewWhen(mr.TargetBranch == "", "merge request target branch cannot be empty")
+	catcher.NewWhen(mr.HeadHash == "", "head hash cannot be empty")
+	catcher.NewWhen(mr.BaseHash == "", "base hash cannot be empty")
+	if catcher.HasErrors() {
+		return nil, catcher.Resolve()
+	}
+
+	grip.Info(message.Fields{
+		"message":       "creating new intent for GitLab merge request",
+		"msg_id":        msgDeliveryID,
+		"intent_type":   GitLabMergeRequestIntentType,
+		"project_path":  mr.ProjectPath,
+		"iid":           mr.IID,
+		"target_branch": mr.TargetBranch,
+		"head_hash":     mr.HeadHash,
+		"base_hash":     mr.BaseHash,
+		"called_by":     caller,
+	})
+	return &gitLabMergeRequestIntent{
+		DocumentID:   msgDeliveryID,
+		MsgID:        msgDeliveryID,
+		IntentType:   GitLabMergeRequestIntentType,
+		CalledBy:     caller,
+		MergeRequest: mr,
+	}, nil
+}
+
+// SetProcessed should be called by an amboy queue after creating a patch from an intent.
+func (g *gitLabMergeRequestIntent) SetProcessed(ctx context.Context) error {
+	g.Processed = true
+	g.ProcessedAt = time.Now().UTC().Round(time.Millisecond)
+	return updateOneIntent(
+		ctx,
+		bson.M{documentIDKey: g.DocumentID},
+		bson.M{"$set": bson.M{
+			processedKey:   g.Processed,
+			processedAtKey: g.ProcessedAt,
+		}},
+	)
+}
+
+// IsProcessed returns whether the intent has been processed.
+func (g *gitLabMergeRequestIntent) IsProcessed() bool {
+	return g.Processed
+}
+
+// GetType returns the patch intent, i.e., GitLabMergeRequestIntentType
+func (g *gitLabMergeRequestIntent) GetType() string {
+	return g.IntentType
+}
+
+// Insert inserts a patch intent in the database.
+func (g *gitLabMergeRequestIntent) Insert() error {
+	g.CreatedAt = time.Now().UTC().Round(time.Millisecond)
+	err := db.Insert(IntentCollection, g)
+	if err != nil {
+		g.CreatedAt = time.Time{}
+		return err
+	}
+
+	return nil
+}
+
+// ID returns the GitLab event GUID, which is also the document ID.
+func (g *gitLabMergeRequestIntent) ID() string {
+	return g.MsgID
+}
+
+// ShouldFinalizePatch returns true, since merge request patches are scheduled
+// as soon as they're created.
+func (g *gitLabMergeRequestIntent) ShouldFinalizePatch() bool {
+	return true
+}
+
+// RepeatPreviousPatchDefinition does not apply to GitLab merge requests.
+func (g *gitLabMergeRequestIntent) RepeatPreviousPatchDefinition() (string, bool) {
+	return "", false
+}
+
+// RepeatFailedTasksAndVariants does not apply to GitLab merge requests.
+func (g *gitLabMergeRequestIntent) RepeatFailedTasksAndVariants() (string, bool) {
+	return "", false
+}
+
+// RequesterIdentity returns the requester, i.e., PatchVersionRequester.
+func (g *gitLabMergeRequestIntent) RequesterIdentity() string {
+	return evergreen.PatchVersionRequester
+}
+
+// GetCalledBy returns who created the intent, e.g., patch.AutomatedCaller
+func (g *gitLabMergeRequestIntent) GetCalledBy() string {
+	return g.CalledBy
+}
+
+// NewPatch creates a patch document from a GitLab merge request intent.
+func (g *gitLabMergeRequestIntent) NewPatch() *Patch {
+	mr := g.MergeRequest
+	return &Patch{
+		Id:           mgobson.NewObjectId(),
+		Alias:        g.GetAlias(),
+		Description:  fmt.Sprintf("'%s' merge request !%d by %s: %s (%s)", mr.ProjectPath, mr.IID, mr.Author, mr.Title, mr.URL),
+		Author:       evergreen.GitLabPatchUser,
+		Status:       evergreen.VersionCreated,
+		CreateTime:   g.CreatedAt,
+		Githash:      mr.BaseHash,
+		GitLabMRData: mr,
+	}
+}
+
+// GetAlias returns the alias whose variants and tasks merge request patches
+// run, which is the same alias that GitHub pull requests use.
+func (g *gitLabMergeRequestIntent) GetAlias() string {
+	return evergreen.GithubPRAlias
+}
@@ -0,0 +1,85 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestGitLabMergeRequestIntent(t *testing.T) {
+	defer func() {
+		require.NoError(t, db.Clear(IntentCollection))
+	}()
+	for tName, tCase := range map[string]func(t *testing.T, mr thirdparty.GitLabMergeRequest){
+		"EmptyMessageDeliveryIDErrors": func(t *testing.T, mr thirdparty.GitLabMergeRequest) {
+			intent, err := NewGitLabMergeRequestIntent("", AutomatedCaller, mr)
+			assert.Nil(t, intent)
+			assert.Error(t, err)
+		},
+		"MissingIIDErrors": func(t *testing.T, mr thirdparty.GitLabMergeRequest) {
+			mr.IID = 0
+			intent, err := NewGitLabMergeRequestIntent("abc123", AutomatedCaller, mr)
+			assert.Nil(t, intent)
+			assert.Error(t, err)
+		},
+		"MissingBaseHashErrors": func(t *testing.T, mr thirdparty.GitLabMergeRequest) {
+			mr.BaseHash = ""
+			intent, err := NewGitLabMergeRequestIntent("abc123", AutomatedCaller, mr)
+			assert.Nil(t, intent)
+			assert.Error(t, err)
+		},
+		"RoundTrip": func(t *testing.T, mr thirdparty.GitLabMergeRequest) {
+			intent, err := NewGitLabMergeRequestIntent("abc123", AutomatedCaller, mr)
+			require.NoError(t, err)
+			require.NoError(t, intent.Insert())
+
+			found, err := FindIntent(t.Context(), "abc123", GitLabMergeRequestIntentType)
+			require.NoError(t, err)
+			require.NotNil(t, found)
+			assert.Equal(t, GitLabMergeRequestIntentType, found.GetType())
+			assert.False(t, found.IsProcessed())
+
+			require.NoError(t, found.SetProcessed(t.Context()))
+			intents := []gitLabMergeRequestIntent{}
+			require.NoError(t, db.FindAllQContext(t.Context(), IntentCollection, db.Query(bson.M{}), &intents))
+			require.Len(t, intents, 1)
+			assert.True(t, intents[0].IsProcessed())
+			assert.Equal(t, mr, intents[0].MergeRequest)
+		},
+		"NewPatch": func(t *testing.T, mr thirdparty.GitLabMergeRequest) {
+			intent, err := NewGitLabMergeRequestIntent("abc123", AutomatedCaller, mr)
+			require.NoError(t, err)
+			assert.True(t, intent.ShouldFinalizePatch())
+			assert.Equal(t, evergreen.PatchVersionRequester, intent.RequesterIdentity())
+
+			p := intent.NewPatch()
+			assert.Equal(t, evergreen.GitLabPatchUser, p.Author)
+			assert.Equal(t, evergreen.GithubPRAlias, p.Alias)
+			assert.Equal(t, mr.BaseHash, p.Githash)
+			assert.Equal(t, mr, p.GitLabMRData)
+			assert.True(t, p.IsGitLabMergeRequestPatch())
+			assert.False(t, p.IsGithubPRPatch())
+			assert.Equal(t, "'group/repo' merge request !5 by octocat: add feature (https://gitlab.com/group/repo/-/merge_requests/5)", p.Description)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.Clear(IntentCollection))
+			tCase(t, thirdparty.GitLabMergeRequest{
+				ProjectPath:  "group/repo",
+				IID:          5,
+				Title:        "add feature",
+				URL:          "https://gitlab.com/group/repo/-/merge_requests/5",
+				TargetBranch: "main",
+				SourceBranch: "feature",
+				HeadHash:     "head",
+				BaseHash:     "base",
+				Author:       "octocat",
+			})
+		})
+	}
+}
@@ -153,6 +153,7 @@ type Patch struct {
 	MergePatch           string                               `bson:"merge_patch"`
 	GithubPatchData      thirdparty.GithubPatch               `bson:"github_patch_data,omitempty"`
 	GithubMergeData      thirdparty.GithubMergeGroup          `bson:"github_merge_data,omitempty"`
+	GitLabMRData         thirdparty.GitLabMergeRequest        `bson:"gitlab_mr_data,omitempty"`
 	GitInfo              *GitMetadata                         `bson:"git_info,omitempty"`
 	// DisplayNewUI is only used when roundtripping the patch via the CLI
 	DisplayNewUI bool `bson:"display_new_ui,omitempty"`
@@ -666,6 +667,12 @@ func (p *Patch) IsGithubPRPatch() bool {
 	return p.GithubPatchData.HeadOwner != ""
 }
 
+// IsGitLabMergeRequestPatch returns true if the patch was created from a
+// GitLab merge request.
+func (p *Patch) IsGitLabMergeRequestPatch() bool {
+	return p.GitLabMRData.IID != 0
+}
+
 // IsMergeQueuePatch returns true if the the patch is part of GitHub's merge queue.
 func (p *Patch) IsMergeQueuePatch() bool {
 	return p.Alias == evergreen.CommitQueueAlias || p.GithubMergeData.HeadSHA != ""
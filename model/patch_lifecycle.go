@@ -11,10 +11,12 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/cost"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testselection"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/utility"
@@ -533,6 +535,121 @@ func parseRenamedOrCopiedFile(patchContents, filename string) string {
 	return ""
 }
 
+// CostLimitExceededError is returned when a patch cannot be finalized because
+// its project has exceeded its monthly hard cost limit.
+type CostLimitExceededError struct {
+	Project         string
+	MonthToDateCost float64
+	HardLimit       float64
+}
+
+func (e CostLimitExceededError) Error() string {
+	return fmt.Sprintf("project '%s' has spent $%.2f this month, which exceeds its monthly hard cost limit of $%.2f, so patches cannot be scheduled until next month", e.Project, e.MonthToDateCost, e.HardLimit)
+}
+
+// IsCostLimitExceeded returns whether the error is because a patch's project
+// has exceeded its monthly hard cost limit.
+func IsCostLimitExceeded(err error) bool {
+	_, ok := errors.Cause(err).(CostLimitExceededError)
+	return ok
+}
+
+// checkPatchCostBudget returns a CostLimitExceededError if the patch's project
+// has exceeded its monthly hard cost limit. Exceeding the soft limit only logs
+// a warning.
+func checkPatchCostBudget(ctx context.Context, p *patch.Patch) error {
+	env := evergreen.GetEnvironment()
+	budget, err := cost.FindBudget(ctx, env, p.Project)
+	if err != nil {
+		return errors.Wrapf(err, "finding cost budget for project '%s'", p.Project)
+	}
+	if !budget.HasLimits() {
+		return nil
+	}
+
+	status, err := cost.GetBudgetStatus(ctx, env, *budget, time.Now())
+	if err != nil {
+		return errors.Wrapf(err, "getting cost budget status for project '%s'", p.Project)
+	}
+	if status.HardLimitExceeded() {
+		return CostLimitExceededError{
+			Project:         p.Project,
+			MonthToDateCost: status.MonthToDateCost,
+			HardLimit:       status.HardLimit,
+		}
+	}
+	grip.WarningWhen(status.SoftLimitExceeded(), message.Fields{
+		"message":            "project has exceeded its monthly soft cost limit",
+		"project":            p.Project,
+		"patch":              p.Id.Hex(),
+		"month_to_date_cost": status.MonthToDateCost,
+		"soft_limit":         status.SoftLimit,
+	})
+
+	return nil
+}
+
+// filterPatchTasksByChangedFiles removes the tasks that don't watch any of the
+// files changed by the patch and records them as skipped in the patch version.
+// Display tasks are removed once none of their execution tasks remain. Only
+// patches that are created automatically (i.e. PR and merge queue patches) are
+// filtered; tasks that a user explicitly picked for a patch always run.
+func filterPatchTasksByChangedFiles(project *Project, p *patch.Patch, patchVersion *Version, tasks TaskVariantPairs) (TaskVariantPairs, error) {
+	if !evergreen.IsGitHubPatchRequester(patchVersion.Requester) && !p.IsGitLabMergeRequestPatch() {
+		return tasks, nil
+	}
+	changedFiles := p.FilesChanged()
+	if len(changedFiles) == 0 || !project.HasPathFilters() {
+		return tasks, nil
+	}
+	execTasks, skipped, err := project.FilterTVPairsByChangedFiles(tasks.ExecTasks, changedFiles, patchVersion.Requester)
+	if err != nil {
+		return tasks, err
+	}
+	if len(skipped) == 0 {
+		return tasks, nil
+	}
+	patchVersion.PathSkippedTasks = skipped
+	if len(execTasks) == 0 {
+		return TaskVariantPairs{}, nil
+	}
+
+	remaining := map[TVPair]bool{}
+	for _, pair := range execTasks {
+		remaining[pair] = true
+	}
+	displayTasks := TVPairSet{}
+	for _, dt := range tasks.DisplayTasks {
+		bv := project.FindBuildVariant(dt.Variant)
+		if bv == nil {
+			continue
+		}
+		displayTask := bv.GetDisplayTask(dt.TaskName)
+		if displayTask == nil {
+			continue
+		}
+		for _, et := range displayTask.ExecTasks {
+			if remaining[TVPair{Variant: dt.Variant, TaskName: et}] {
+				displayTasks = append(displayTasks, dt)
+				break
+			}
+		}
+	}
+
+	return TaskVariantPairs{ExecTasks: execTasks, DisplayTasks: displayTasks}, nil
+}
+
+// finishPathSkippedPatchVersion marks a patch and its version as succeeded
+// when none of the patch's tasks watch the files it changed. The version has
+// no builds whose statuses would otherwise finish it.
+func finishPathSkippedPatchVersion(ctx context.Context, p *patch.Patch, patchVersion *Version) error {
+	event.LogVersionStateChangeEvent(patchVersion.Id, evergreen.VersionSucceeded)
+	if err := patchVersion.MarkFinished(ctx, evergreen.VersionSucceeded, time.Now()); err != nil {
+		return errors.Wrapf(err, "marking version '%s' as finished", patchVersion.Id)
+	}
+	return errors.Wrapf(UpdatePatchStatus(ctx, p, evergreen.VersionSucceeded), "marking patch '%s' as finished", p.Id.Hex())
+}
+
 // FinalizePatch finalizes a patch:
 // Patches a remote project's configuration file if needed.
 // Creates a version for this patch and links it.
@@ -546,6 +663,9 @@ func FinalizePatch(ctx context.Context, p *patch.Patch, requester string) (*Vers
 	if projectRef == nil {
 		return nil, errors.Errorf("project '%s' not found", p.Project)
 	}
+	if err = checkPatchCostBudget(ctx, p); err != nil {
+		return nil, err
+	}
 
 	settings, err := evergreen.GetConfig(ctx)
 	if err != nil {
@@ -655,6 +775,10 @@ func FinalizePatch(ctx context.Context, p *patch.Patch, requester string) (*Vers
 		}
 		return nil, errors.New("cannot finalize patch with no tasks")
 	}
+	tasks, err = filterPatchTasksByChangedFiles(project, p, patchVersion, tasks)
+	if err != nil {
+		return nil, errors.Wrapf(err, "filtering tasks by files changed in patch '%s'", p.Id.Hex())
+	}
 	taskIds, err := NewTaskIdConfig(project, patchVersion, tasks, projectRef.Identifier)
 	if err != nil {
 		return nil, errors.Wrap(err, "creating patch's task ID table")
@@ -684,6 +808,14 @@ func FinalizePatch(ctx context.Context, p *patch.Patch, requester string) (*Vers
 			displayNames = append(displayNames, dt.Name)
 		}
 		taskNames := tasks.ExecTasks.TaskNames(vt.Variant)
+		if len(patchVersion.PathSkippedTasks) > 0 {
+			displayNames = tasks.DisplayTasks.TaskNames(vt.Variant)
+			if len(taskNames) == 0 && len(displayNames) == 0 {
+				// Creating the build with no task names would create all of
+				// its tasks.
+				continue
+			}
+		}
 
 		buildCreationArgs := TaskCreationInfo{
 			Project:          creationInfo.Project,
@@ -783,6 +915,27 @@ func FinalizePatch(ctx context.Context, p *patch.Patch, requester string) (*Vers
 	if err != nil {
 		return nil, errors.Wrap(err, "finalizing patch")
 	}
+	if len(tasksToInsert) == 0 && len(patchVersion.PathSkippedTasks) > 0 {
+		if err = finishPathSkippedPatchVersion(ctx, p, patchVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	if projectRef.IsTestSelectionEnabled() {
+		taskNames := make([]string, 0, len(tasksToInsert))
+		for _, t := range tasksToInsert {
+			taskNames = append(taskNames, t.DisplayName)
+		}
+		// Recommended tests are a best effort, so failing to rank the tests
+		// should not prevent the patch from running.
+		grip.Warning(message.WrapError(testselection.SaveRecommendedTests(ctx, evergreen.GetEnvironment(), patchVersion.Id, projectRef.Id, taskNames, p.FilesChanged()), message.Fields{
+			"message": "could not save recommended tests for patch",
+			"op":      "finalize patch",
+			"patch":   p.Id.Hex(),
+			"version": patchVersion.Id,
+			"project": projectRef.Id,
+		}))
+	}
 
 	if p.IsParent() {
 		// finalize child patches or subscribe on parent outcome based on parentStatus
@@ -996,3 +1149,35 @@ func AbortPatchesWithGithubPatchData(ctx context.Context, createdBefore time.Tim
 
 	return errors.Wrap(catcher.Resolve(), "aborting patches")
 }
+
+// AbortPatchesWithGitLabMergeRequestData aborts the finalized patches for the
+// GitLab merge request that were created before the given time, which are
+// superseded by newPatch.
+func AbortPatchesWithGitLabMergeRequestData(ctx context.Context, createdBefore time.Time, newPatch, projectPath string, iid int) error {
+	patches, err := patch.Find(patch.ByGitLabMergeRequestAndCreatedBefore(createdBefore, projectPath, iid))
+	if err != nil {
+		return errors.Wrap(err, "fetching initial patch")
+	}
+
+	catcher := grip.NewSimpleCatcher()
+	for _, p := range patches {
+		if p.Version == "" { // Skip anything unfinalized
+			continue
+		}
+
+		err = CancelPatch(ctx, &p, task.AbortInfo{User: evergreen.GitLabPatchUser, NewVersion: newPatch})
+		grip.Error(message.WrapError(err, message.Fields{
+			"source":         "gitlab hook",
+			"created_before": createdBefore.String(),
+			"project_path":   projectPath,
+			"message":        "aborting patch's version",
+			"patch_id":       p.Id.Hex(),
+			"merge_request":  p.GitLabMRData.IID,
+			"project":        p.Project,
+			"version":        p.Version,
+		}))
+		catcher.Add(err)
+	}
+
+	return errors.Wrap(catcher.Resolve(), "aborting patches")
+}
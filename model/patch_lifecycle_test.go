@@ -14,6 +14,7 @@ import (
 	"github.com/evergreen-ci/evergreen/db"
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/cost"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	"github.com/evergreen-ci/evergreen/model/patch"
@@ -426,6 +427,81 @@ func TestFinalizePatch(t *testing.T) {
 				assert.True(t, tsk.IsEssentialToSucceed, "tasks automatically selected when a GitHub PR patch is finalized should be essential to succeed")
 			}
 		},
+		"CLIPatchIgnoresPathFilters": func(t *testing.T, p *patch.Patch, patchConfig *PatchConfig) {
+			for i := range patchConfig.PatchedParserProject.BuildVariants {
+				patchConfig.PatchedParserProject.BuildVariants[i].Paths = []string{"src/storage/**"}
+			}
+			patchConfig.PatchedParserProject.Id = p.Id.Hex()
+			require.NoError(t, patchConfig.PatchedParserProject.Insert())
+			p.ProjectStorageMethod = evergreen.ProjectStorageMethodDB
+			require.NoError(t, p.Insert())
+
+			version, err := FinalizePatch(ctx, p, evergreen.PatchVersionRequester)
+			require.NoError(t, err)
+			require.NotNil(t, version)
+			assert.Empty(t, version.PathSkippedTasks)
+
+			tasks, err := task.Find(ctx, bson.M{})
+			require.NoError(t, err)
+			assert.Len(t, tasks, 2, "tasks explicitly selected for a CLI patch should run regardless of the changed files")
+		},
+		"GitHubPRPatchWithoutWatchedFilesFinishesWithoutTasks": func(t *testing.T, p *patch.Patch, patchConfig *PatchConfig) {
+			for i := range patchConfig.PatchedParserProject.BuildVariants {
+				patchConfig.PatchedParserProject.BuildVariants[i].Paths = []string{"src/storage/**"}
+			}
+			patchConfig.PatchedParserProject.Id = p.Id.Hex()
+			require.NoError(t, patchConfig.PatchedParserProject.Insert())
+			p.ProjectStorageMethod = evergreen.ProjectStorageMethodDB
+			require.NoError(t, p.Insert())
+
+			version, err := FinalizePatch(ctx, p, evergreen.GithubPRRequester)
+			require.NoError(t, err)
+			require.NotNil(t, version)
+			assert.Len(t, version.PathSkippedTasks, 2)
+			assert.Empty(t, version.BuildIds)
+
+			dbVersion, err := VersionFindOneId(ctx, version.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbVersion)
+			assert.Equal(t, evergreen.VersionSucceeded, dbVersion.Status)
+
+			dbPatch, err := patch.FindOneId(t.Context(), p.Id.Hex())
+			require.NoError(t, err)
+			require.NotZero(t, dbPatch)
+			assert.True(t, dbPatch.Activated)
+			assert.Equal(t, evergreen.VersionSucceeded, dbPatch.Status)
+
+			tasks, err := task.Find(ctx, bson.M{})
+			require.NoError(t, err)
+			assert.Empty(t, tasks)
+		},
+		"FailsWhenProjectExceedsHardCostLimit": func(t *testing.T, p *patch.Patch, patchConfig *PatchConfig) {
+			require.NoError(t, db.ClearCollections(cost.DailyCostCollection, cost.BudgetCollection))
+			defer func() {
+				assert.NoError(t, db.ClearCollections(cost.DailyCostCollection, cost.BudgetCollection))
+			}()
+			env := evergreen.GetEnvironment()
+			require.NoError(t, cost.SetBudget(ctx, env, cost.ProjectBudget{ProjectID: p.Project, SoftLimit: 1, HardLimit: 2}))
+			now := time.Now()
+			rollup := cost.NewRollup(now)
+			rollup.AddTask(&task.Task{Project: p.Project, StartTime: rollup.Date(), FinishTime: rollup.Date().Add(time.Minute)}, "", 180)
+			require.NoError(t, rollup.Save(ctx, env))
+
+			patchConfig.PatchedParserProject.Id = p.Id.Hex()
+			require.NoError(t, patchConfig.PatchedParserProject.Insert())
+			p.ProjectStorageMethod = evergreen.ProjectStorageMethodDB
+			require.NoError(t, p.Insert())
+
+			_, err := FinalizePatch(ctx, p, evergreen.PatchVersionRequester)
+			require.Error(t, err)
+			assert.True(t, IsCostLimitExceeded(err))
+			assert.Contains(t, err.Error(), "exceeds its monthly hard cost limit")
+
+			dbPatch, err := patch.FindOneId(t.Context(), p.Id.Hex())
+			require.NoError(t, err)
+			require.NotZero(t, dbPatch)
+			assert.False(t, dbPatch.Activated)
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			p := resetPatchSetup(ctx, t, remotePath)
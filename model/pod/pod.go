@@ -44,6 +44,17 @@ type Pod struct {
 	// AgentVersion is the version of the agent running on this pod if it's a
 	// pod that runs tasks.
 	AgentVersion string `bson:"agent_version,omitempty" json:"agent_version,omitempty"`
+	// Provider is the container orchestration service that runs the pod. If
+	// unset, the pod runs in ECS.
+	Provider evergreen.PodProvider `bson:"provider,omitempty" json:"provider,omitempty"`
+	// ContainerPool is the ID of the container pool that the pod belongs to,
+	// if any.
+	ContainerPool string `bson:"container_pool,omitempty" json:"container_pool,omitempty"`
+}
+
+// IsKubernetes returns whether the pod runs in Kubernetes.
+func (p *Pod) IsKubernetes() bool {
+	return p.Provider == evergreen.PodProviderKubernetes
 }
 
 // TaskIntentPodOptions represents options to create an intent pod that runs
@@ -66,6 +77,13 @@ type TaskIntentPodOptions struct {
 	WorkingDir          string
 	PodSecretExternalID string
 	PodSecretValue      string
+
+	// Provider is the container orchestration service that should run the
+	// pod. If unspecified, it defaults to ECS.
+	Provider evergreen.PodProvider
+	// ContainerPool is the ID of the container pool that should run the pod.
+	// This is required for pods that run in Kubernetes.
+	ContainerPool string
 }
 
 // Validate checks that the options to create a task intent pod are valid and
@@ -86,6 +104,8 @@ func (o *TaskIntentPodOptions) Validate(ecsConf evergreen.ECSConfig) error {
 	catcher.NewWhen(o.WorkingDir == "", "missing working directory")
 	catcher.NewWhen(o.PodSecretExternalID == "", "missing pod secret external ID")
 	catcher.NewWhen(o.PodSecretValue == "", "missing pod secret value")
+	catcher.Wrap(o.Provider.Validate(), "invalid provider")
+	catcher.NewWhen(o.Provider == evergreen.PodProviderKubernetes && o.ContainerPool == "", "must specify a container pool for a Kubernetes pod")
 
 	if catcher.HasErrors() {
 		return catcher.Resolve()
@@ -142,7 +162,13 @@ func NewTaskIntentPod(ecsConf evergreen.ECSConfig, opts TaskIntentPodOptions) (*
 		TimeInfo: TimeInfo{
 			Initializing: time.Now(),
 		},
-		Family: containerOpts.GetFamily(ecsConf),
+		Provider:      opts.Provider,
+		ContainerPool: opts.ContainerPool,
+	}
+	// Only ECS pods run from a pod definition. Kubernetes pods are created
+	// directly from their container options.
+	if !p.IsKubernetes() {
+		p.Family = containerOpts.GetFamily(ecsConf)
 	}
 
 	return &p, nil
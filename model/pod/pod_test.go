@@ -259,6 +259,25 @@ func TestNewTaskIntentPod(t *testing.T) {
 		assert.Equal(t, opts.PodSecretExternalID, s.ExternalID)
 		assert.Equal(t, opts.PodSecretValue, s.Value)
 	})
+	t.Run("KubernetesPodDoesNotUsePodDefinition", func(t *testing.T) {
+		opts := makeValidOpts()
+		opts.Provider = evergreen.PodProviderKubernetes
+		opts.ContainerPool = "pool"
+
+		p, err := NewTaskIntentPod(evergreen.ECSConfig{}, opts)
+		require.NoError(t, err)
+		assert.True(t, p.IsKubernetes())
+		assert.Equal(t, "pool", p.ContainerPool)
+		assert.Zero(t, p.Family)
+	})
+	t.Run("FailsWithKubernetesProviderAndNoContainerPool", func(t *testing.T) {
+		opts := makeValidOpts()
+		opts.Provider = evergreen.PodProviderKubernetes
+
+		p, err := NewTaskIntentPod(evergreen.ECSConfig{}, opts)
+		assert.Error(t, err)
+		assert.Zero(t, p)
+	})
 	t.Run("SetsDefaultID", func(t *testing.T) {
 		opts := makeValidOpts()
 		opts.ID = ""
@@ -18,6 +18,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testselection"
 	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/utility"
@@ -138,6 +139,12 @@ type BuildVariantTaskUnit struct {
 	Activate *bool `yaml:"activate,omitempty" bson:"activate,omitempty"`
 	// CreateCheckRun will create a check run on GitHub if set.
 	CreateCheckRun *CheckRun `yaml:"create_check_run,omitempty" bson:"create_check_run,omitempty"`
+
+	// Paths and IgnorePaths are the gitignore-style patterns of the files
+	// that the task watches, resolved from the project task or else the build
+	// variant. They are not settable on the build variant task itself.
+	Paths       []string `yaml:"-" bson:"-"`
+	IgnorePaths []string `yaml:"-" bson:"-"`
 }
 
 func (b BuildVariant) Get(name string) (BuildVariantTaskUnit, error) {
@@ -214,6 +221,9 @@ func (bvt *BuildVariantTaskUnit) Populate(pt ProjectTask, bv BuildVariant) {
 	if bvt.Stepback == nil {
 		bvt.Stepback = pt.Stepback
 	}
+	if len(bvt.Paths) == 0 && len(bvt.IgnorePaths) == 0 {
+		bvt.Paths, bvt.IgnorePaths = pt.Paths, pt.IgnorePaths
+	}
 
 	// Build variant level settings are lower priority than project task level
 	// settings.
@@ -235,6 +245,9 @@ func (bvt *BuildVariantTaskUnit) Populate(pt ProjectTask, bv BuildVariant) {
 	if bvt.Disable == nil {
 		bvt.Disable = bv.Disable
 	}
+	if len(bvt.Paths) == 0 && len(bvt.IgnorePaths) == 0 {
+		bvt.Paths, bvt.IgnorePaths = bv.Paths, bv.IgnorePaths
+	}
 }
 
 // BuildVariantsByName represents a slice of project config build variants that
@@ -399,6 +412,15 @@ type BuildVariant struct {
 	// DeactivatePrevious indicates if previous mainline tasks should be deactivated in case of success.
 	DeactivatePrevious *bool `yaml:"deactivate_previous,omitempty" bson:"deactivate_previous,omitempty"`
 
+	// Paths are gitignore-style patterns of the files that tasks in the build
+	// variant watch. If set, the tasks only run in versions that change at
+	// least one matching file that isn't matched by IgnorePaths.
+	Paths []string `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	// IgnorePaths are gitignore-style patterns of the files that tasks in the
+	// build variant don't watch. The tasks don't run in versions that only
+	// change matching files.
+	IgnorePaths []string `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
+
 	// the default distros.  will be used to run a task if no distro field is
 	// provided for the task
 	RunOn []string `yaml:"run_on,omitempty" bson:"run_on"`
@@ -435,6 +457,7 @@ type Container struct {
 	Credential string              `yaml:"credential,omitempty" bson:"credential"`
 	Resources  *ContainerResources `yaml:"resources,omitempty" bson:"resources"`
 	System     ContainerSystem     `yaml:"system,omitempty" bson:"system"`
+	Pool       string              `yaml:"pool,omitempty" bson:"pool"`
 }
 
 // ContainerSystem specifies the architecture and OS for the running container to use.
@@ -526,6 +549,12 @@ type PluginCommandConf struct {
 	// TimeoutSecs indicates the maximum duration the command is allowed to run for.
 	TimeoutSecs int `yaml:"timeout_secs,omitempty" bson:"timeout_secs,omitempty"`
 
+	// If is a condition that must be met for the command or function to run.
+	// It's evaluated by the agent against the task's expansions and the
+	// outcomes of the commands that ran before it (e.g. "${is_patch} == true"
+	// or "failure()").
+	If string `yaml:"if,omitempty" bson:"if,omitempty"`
+
 	// Params is used to define params in the yaml and parser project,
 	// but is not stored in the DB (instead see ParamsYAML).
 	Params map[string]any `yaml:"params,omitempty" bson:"-"`
@@ -539,6 +568,11 @@ type PluginCommandConf struct {
 	// RetryOnFailure indicates whether the task should be retried if this command fails.
 	RetryOnFailure bool `yaml:"retry_on_failure,omitempty" bson:"retry_on_failure,omitempty"`
 
+	// Retry indicates that the agent should run the command again if it
+	// fails. For a function call, it applies to each command in the function
+	// that does not have its own retry policy.
+	Retry *CommandRetryPolicy `yaml:"retry,omitempty" bson:"retry,omitempty"`
+
 	// FailureMetadataTags are user-defined tags which are not used directly by
 	// Evergreen but can be used to allow users to set additional metadata about
 	// the command/function if it fails.
@@ -547,6 +581,59 @@ type PluginCommandConf struct {
 	FailureMetadataTags []string `yaml:"failure_metadata_tags,omitempty" bson:"failure_metadata_tags,omitempty"`
 }
 
+const (
+	// MaxCommandRetryAttempts is the maximum number of times a command can
+	// run under a retry policy.
+	MaxCommandRetryAttempts = 10
+	// MaxCommandRetryBackoff is the longest that the agent waits between
+	// attempts of a command.
+	MaxCommandRetryBackoff = 5 * time.Minute
+)
+
+// CommandRetryPolicy configures the agent to run a command again when it
+// fails rather than failing the command right away. If both OnExitCodes and
+// OnOutputRegex are set, a failed attempt is retried if it matches either.
+type CommandRetryPolicy struct {
+	// Attempts is the maximum number of times to run the command, including
+	// the first attempt.
+	Attempts int `yaml:"attempts,omitempty" bson:"attempts,omitempty"`
+	// BackoffSecs is the number of seconds to wait before the first retry.
+	// The wait doubles after each later attempt, up to
+	// MaxCommandRetryBackoff.
+	BackoffSecs int `yaml:"backoff,omitempty" bson:"backoff,omitempty"`
+	// OnExitCodes, if set, limits retries to attempts where the command's
+	// process exited with one of these exit codes.
+	OnExitCodes []int `yaml:"on_exit_codes,omitempty" bson:"on_exit_codes,omitempty"`
+	// OnOutputRegex, if set, limits retries to attempts where the command
+	// logged output matching this regular expression.
+	OnOutputRegex string `yaml:"on_output_regex,omitempty" bson:"on_output_regex,omitempty"`
+}
+
+// Validate checks that the retry policy's settings are valid.
+func (p *CommandRetryPolicy) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(p.Attempts < 1 || p.Attempts > MaxCommandRetryAttempts, "retry attempts must be between 1 and %d", MaxCommandRetryAttempts)
+	catcher.ErrorfWhen(p.BackoffSecs < 0 || time.Duration(p.BackoffSecs)*time.Second > MaxCommandRetryBackoff, "retry backoff must be between 0 and %d seconds", int(MaxCommandRetryBackoff.Seconds()))
+	for _, code := range p.OnExitCodes {
+		catcher.ErrorfWhen(code == 0, "cannot retry on exit code 0 because it indicates success")
+	}
+	if p.OnOutputRegex != "" {
+		_, err := regexp.Compile(p.OnOutputRegex)
+		catcher.Wrapf(err, "invalid retry output regex '%s'", p.OnOutputRegex)
+	}
+	return catcher.Resolve()
+}
+
+// BackoffDelay returns how long to wait before running the command again
+// after the given failed attempt, starting from 1.
+func (p *CommandRetryPolicy) BackoffDelay(attempt int) time.Duration {
+	delay := time.Duration(p.BackoffSecs) * time.Second
+	for i := 1; i < attempt && delay < MaxCommandRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxCommandRetryBackoff)
+}
+
 func (c *PluginCommandConf) resolveParams() error {
 	out := map[string]any{}
 	if c == nil {
@@ -563,17 +650,19 @@ func (c *PluginCommandConf) resolveParams() error {
 
 func (c *PluginCommandConf) UnmarshalYAML(unmarshal func(any) error) error {
 	temp := struct {
-		Function            string            `yaml:"func,omitempty" bson:"func,omitempty"`
-		Type                string            `yaml:"type,omitempty" bson:"type,omitempty"`
-		DisplayName         string            `yaml:"display_name,omitempty" bson:"display_name,omitempty"`
-		Command             string            `yaml:"command,omitempty" bson:"command,omitempty"`
-		Variants            []string          `yaml:"variants,omitempty" bson:"variants,omitempty"`
-		TimeoutSecs         int               `yaml:"timeout_secs,omitempty" bson:"timeout_secs,omitempty"`
-		Params              map[string]any    `yaml:"params,omitempty" bson:"params,omitempty"`
-		ParamsYAML          string            `yaml:"params_yaml,omitempty" bson:"params_yaml,omitempty"`
-		Vars                map[string]string `yaml:"vars,omitempty" bson:"vars,omitempty"`
-		RetryOnFailure      bool              `yaml:"retry_on_failure,omitempty" bson:"retry_on_failure,omitempty"`
-		FailureMetadataTags []string          `yaml:"failure_metadata_tags,omitempty" bson:"failure_metadata_tags,omitempty"`
+		Function            string              `yaml:"func,omitempty" bson:"func,omitempty"`
+		Type                string              `yaml:"type,omitempty" bson:"type,omitempty"`
+		DisplayName         string              `yaml:"display_name,omitempty" bson:"display_name,omitempty"`
+		Command             string              `yaml:"command,omitempty" bson:"command,omitempty"`
+		Variants            []string            `yaml:"variants,omitempty" bson:"variants,omitempty"`
+		TimeoutSecs         int                 `yaml:"timeout_secs,omitempty" bson:"timeout_secs,omitempty"`
+		If                  string              `yaml:"if,omitempty" bson:"if,omitempty"`
+		Params              map[string]any      `yaml:"params,omitempty" bson:"params,omitempty"`
+		ParamsYAML          string              `yaml:"params_yaml,omitempty" bson:"params_yaml,omitempty"`
+		Vars                map[string]string   `yaml:"vars,omitempty" bson:"vars,omitempty"`
+		RetryOnFailure      bool                `yaml:"retry_on_failure,omitempty" bson:"retry_on_failure,omitempty"`
+		Retry               *CommandRetryPolicy `yaml:"retry,omitempty" bson:"retry,omitempty"`
+		FailureMetadataTags []string            `yaml:"failure_metadata_tags,omitempty" bson:"failure_metadata_tags,omitempty"`
 	}{}
 
 	if err := unmarshal(&temp); err != nil {
@@ -585,10 +674,12 @@ func (c *PluginCommandConf) UnmarshalYAML(unmarshal func(any) error) error {
 	c.Command = temp.Command
 	c.Variants = temp.Variants
 	c.TimeoutSecs = temp.TimeoutSecs
+	c.If = temp.If
 	c.Vars = temp.Vars
 	c.ParamsYAML = temp.ParamsYAML
 	c.Params = temp.Params
 	c.RetryOnFailure = temp.RetryOnFailure
+	c.Retry = temp.Retry
 	c.FailureMetadataTags = temp.FailureMetadataTags
 	return c.unmarshalParams()
 }
@@ -735,6 +826,13 @@ type ProjectTask struct {
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
 	Stepback          *bool                     `yaml:"stepback,omitempty" bson:"stepback,omitempty"`
 	MustHaveResults   *bool                     `yaml:"must_have_test_results,omitempty" bson:"must_have_test_results,omitempty"`
+	// Paths and IgnorePaths are gitignore-style patterns of the files the
+	// task watches. They take precedence over the build variant's paths.
+	Paths       []string `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths []string `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
+	// CacheKey, if set, opts the task into reusing the results of a previous
+	// successful task with the same inputs instead of running again.
+	CacheKey *TaskCacheKey `yaml:"cache_key,omitempty" bson:"cache_key,omitempty"`
 }
 
 const (
@@ -1123,6 +1221,19 @@ func PopulateExpansions(ctx context.Context, t *task.Task, h *host.Host, appToke
 		expansions.Put("revision_order_id", fmt.Sprintf("%s_%d", v.Author, v.RevisionOrderNumber))
 		expansions.Put("alias", p.Alias)
 
+		// Recommended tests are ranked once when the patch is finalized, and
+		// only if the project has test selection enabled. They are a best
+		// effort, so failing to look them up should not prevent the task from
+		// running.
+		var recommendedTests []string
+		recommendedTests, err = testselection.FindRecommendedTests(ctx, evergreen.GetEnvironment(), t.Version, t.DisplayName)
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "could not get recommended tests for task",
+			"task":    t.Id,
+			"project": t.Project,
+		}))
+		expansions.Put("recommended_tests", strings.Join(recommendedTests, " "))
+
 		if v.Requester == evergreen.GithubPRRequester {
 			expansions.Put("github_pr_number", fmt.Sprintf("%d", p.GithubPatchData.PRNumber))
 			expansions.Put("github_author", p.GithubPatchData.Author)
@@ -1371,7 +1482,9 @@ func (p *Project) FindTaskForVariant(task, variant string) *BuildVariantTaskUnit
 				if t == task {
 					// task group tasks need to be repopulated from the task list
 					// Note that the build variant task unit retains the task
-					// group's name.
+					// group's name. The group's paths are the build variant's,
+					// which the task's own paths take precedence over.
+					bvt.Paths, bvt.IgnorePaths = nil, nil
 					bvt.Populate(*p.FindProjectTask(task), *bv)
 					return &bvt
 				}
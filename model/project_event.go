@@ -129,6 +129,23 @@ func (e *ProjectChangeEvent) RedactSecrets() {
 	isGHAppKeyModified := !bytes.Equal(e.Before.GitHubAppAuth.PrivateKey, e.After.GitHubAppAuth.PrivateKey)
 	e.Before.GitHubAppAuth = getRedactedGitHubAppCopy(e.Before.GitHubAppAuth, isGHAppKeyModified, evergreen.RedactedBeforeValue)
 	e.After.GitHubAppAuth = getRedactedGitHubAppCopy(e.After.GitHubAppAuth, isGHAppKeyModified, evergreen.RedactedAfterValue)
+
+	beforeGitLab, afterGitLab := e.Before.ProjectRef.GitLab, e.After.ProjectRef.GitLab
+	isGitLabTokenModified := beforeGitLab.Token != afterGitLab.Token
+	e.Before.ProjectRef.GitLab.Token = getRedactedSecret(beforeGitLab.Token, isGitLabTokenModified, evergreen.RedactedBeforeValue)
+	e.After.ProjectRef.GitLab.Token = getRedactedSecret(afterGitLab.Token, isGitLabTokenModified, evergreen.RedactedAfterValue)
+	isGitLabWebhookSecretModified := beforeGitLab.WebhookSecret != afterGitLab.WebhookSecret
+	e.Before.ProjectRef.GitLab.WebhookSecret = getRedactedSecret(beforeGitLab.WebhookSecret, isGitLabWebhookSecretModified, evergreen.RedactedBeforeValue)
+	e.After.ProjectRef.GitLab.WebhookSecret = getRedactedSecret(afterGitLab.WebhookSecret, isGitLabWebhookSecretModified, evergreen.RedactedAfterValue)
+}
+
+// getRedactedSecret returns the redacted placeholder for a secret that is set
+// and was modified, or the empty string if the secret was not modified.
+func getRedactedSecret(secret string, isModified bool, placeholder string) string {
+	if secret != "" && isModified {
+		return placeholder
+	}
+	return ""
 }
 
 // getModifiedProjectVars returns the set of project variables in the change
@@ -172,6 +172,33 @@ func (s *ProjectEventSuite) TestModifyProjectEventRedactsAllVars() {
 	s.Equal(after.Subscriptions, eventData.After.Subscriptions)
 }
 
+func (s *ProjectEventSuite) TestModifyProjectEventRedactsGitLabSecrets() {
+	before := getMockProjectSettings()
+	before.ProjectRef.GitLab = GitLabSettings{Owner: "group", Repo: "repo", Token: "old_token", WebhookSecret: "webhook_secret"}
+	after := getMockProjectSettings()
+	after.ProjectRef.GitLab = GitLabSettings{Owner: "group", Repo: "repo", Token: "new_token", WebhookSecret: "webhook_secret"}
+
+	s.NoError(LogProjectModified(projectId, username, &before, &after))
+
+	projectEvents, err := MostRecentProjectEvents(projectId, 5)
+	s.NoError(err)
+	s.Require().Len(projectEvents, 1)
+
+	s.Require().NotNil(projectEvents[0].Data)
+	eventData, ok := projectEvents[0].Data.(*ProjectChangeEvent)
+	s.Require().True(ok)
+
+	s.Equal("group", eventData.Before.ProjectRef.GitLab.Owner)
+	s.Equal(evergreen.RedactedBeforeValue, eventData.Before.ProjectRef.GitLab.Token, "modified token should be redacted")
+	s.Empty(eventData.Before.ProjectRef.GitLab.WebhookSecret, "unmodified webhook secret should be empty")
+	s.Equal("group", eventData.After.ProjectRef.GitLab.Owner)
+	s.Equal(evergreen.RedactedAfterValue, eventData.After.ProjectRef.GitLab.Token, "modified token should be redacted")
+	s.Empty(eventData.After.ProjectRef.GitLab.WebhookSecret, "unmodified webhook secret should be empty")
+
+	s.Equal("old_token", before.ProjectRef.GitLab.Token, "original settings should not be modified")
+	s.Equal("new_token", after.ProjectRef.GitLab.Token, "original settings should not be modified")
+}
+
 func (s *ProjectEventSuite) TestModifyProjectNonEvent() {
 	before := getMockProjectSettings()
 	after := getMockProjectSettings()
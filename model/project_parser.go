@@ -104,6 +104,7 @@ type ParserProject struct {
 	Functions          map[string]*YAMLCommandSet `yaml:"functions,omitempty" bson:"functions,omitempty"`
 	TaskGroups         []parserTaskGroup          `yaml:"task_groups,omitempty" bson:"task_groups,omitempty"`
 	Tasks              []parserTask               `yaml:"tasks,omitempty" bson:"tasks,omitempty"`
+	TaskTemplates      []parserTaskTemplate       `yaml:"task_templates,omitempty" bson:"task_templates,omitempty"`
 	ExecTimeoutSecs    *int                       `yaml:"exec_timeout_secs,omitempty" bson:"exec_timeout_secs,omitempty"`
 	TimeoutSecs        *int                       `yaml:"timeout_secs,omitempty" bson:"timeout_secs,omitempty"`
 	CreateTime         time.Time                  `yaml:"create_time,omitempty" bson:"create_time,omitempty"`
@@ -153,6 +154,14 @@ type parserTask struct {
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
 	Stepback          *bool                     `yaml:"stepback,omitempty" bson:"stepback,omitempty"`
 	MustHaveResults   *bool                     `yaml:"must_have_test_results,omitempty" bson:"must_have_test_results,omitempty"`
+	Paths             parserStringSlice         `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths       parserStringSlice         `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
+	CacheKey          *TaskCacheKey             `yaml:"cache_key,omitempty" bson:"cache_key,omitempty"`
+	// Template is the name of the task template that defines the task's
+	// commands, tags and dependencies.
+	Template string `yaml:"template,omitempty" bson:"template,omitempty"`
+	// TemplateArgs are the arguments for the task template's parameters.
+	TemplateArgs map[string]string `yaml:"args,omitempty" bson:"args,omitempty"`
 }
 
 func (pp *ParserProject) Insert() error {
@@ -171,6 +180,13 @@ func (pp *ParserProject) MarshalYAML() (any, error) {
 			}
 		}
 	}
+	for i, tt := range pp.TaskTemplates {
+		for j := range tt.Commands {
+			if err := pp.TaskTemplates[i].Commands[j].resolveParams(); err != nil {
+				return nil, errors.Wrapf(err, "marshalling command '%s' for task template", pp.TaskTemplates[i].Commands[j].GetDisplayName())
+			}
+		}
+	}
 
 	// returning a pointer causes MarshalYAML to get stuck in infinite recursion
 	return *pp, nil
@@ -244,6 +260,16 @@ func (pd *parserDependency) UnmarshalYAML(unmarshal func(any) error) error {
 type parserInclude struct {
 	FileName string `yaml:"filename,omitempty" bson:"filename,omitempty"`
 	Module   string `yaml:"module,omitempty" bson:"module,omitempty"`
+	// Repo is the GitHub repository ("owner/repo") to include the file from,
+	// at the pinned tag or commit Ref.
+	Repo string `yaml:"repo,omitempty" bson:"repo,omitempty"`
+	Ref  string `yaml:"ref,omitempty" bson:"ref,omitempty"`
+	// URL is the HTTPS URL to download the included file from, which must
+	// have the given SHA256.
+	URL string `yaml:"url,omitempty" bson:"url,omitempty"`
+	// SHA256 is the expected SHA-256 hash of the file's contents. It's
+	// required for URLs and optional for repositories.
+	SHA256 string `yaml:"sha256,omitempty" bson:"sha256,omitempty"`
 }
 
 // TaskSelector handles the selection of specific task/variant combinations
@@ -341,6 +367,8 @@ type parserBV struct {
 	AllowForGitTag    *bool                     `yaml:"allow_for_git_tag,omitempty" bson:"allow_for_git_tag,omitempty"`
 	GitTagOnly        *bool                     `yaml:"git_tag_only,omitempty" bson:"git_tag_only,omitempty"`
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
+	Paths             parserStringSlice         `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths       parserStringSlice         `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 
 	// internal matrix stuff
 	MatrixId  string      `yaml:"matrix_id,omitempty" bson:"matrix_id,omitempty"`
@@ -410,6 +438,8 @@ func (pbv *parserBV) canMerge() bool {
 		pbv.AllowForGitTag == nil &&
 		pbv.GitTagOnly == nil &&
 		len(pbv.AllowedRequesters) == 0 &&
+		pbv.Paths == nil &&
+		pbv.IgnorePaths == nil &&
 		pbv.MatrixId == "" &&
 		pbv.MatrixVal == nil &&
 		pbv.Matrix == nil &&
@@ -650,7 +680,9 @@ func processIntermediateProjectIncludes(ctx context.Context, identifier string,
 		ReferencePatchID:    projectOpts.ReferencePatchID,
 		ReferenceManifestID: projectOpts.ReferenceManifestID,
 	}
-	localOpts.UpdateReadFileFrom(include.FileName)
+	if !include.isRemote() {
+		localOpts.UpdateReadFileFrom(include.FileName)
+	}
 
 	var yaml []byte
 	var err error
@@ -659,8 +691,13 @@ func processIntermediateProjectIncludes(ctx context.Context, identifier string,
 		"remote_path": localOpts.RemotePath,
 		"read_from":   localOpts.ReadFileFrom,
 		"module":      include.Module,
+		"repo":        include.Repo,
+		"url":         include.URL,
 	})
-	if include.Module != "" {
+	if include.isRemote() {
+		yaml, err = retrieveRemoteInclude(ctx, *localOpts, include)
+		err = errors.Wrapf(err, "%s: retrieving remote include '%s'", LoadProjectError, include.name())
+	} else if include.Module != "" {
 		yaml, err = retrieveFileForModule(ctx, *localOpts, intermediateProject.Modules, include)
 		err = errors.Wrapf(err, "%s: retrieving file for module '%s'", LoadProjectError, include.Module)
 	} else {
@@ -669,7 +706,7 @@ func processIntermediateProjectIncludes(ctx context.Context, identifier string,
 	}
 	outputYAMLs <- yamlTuple{
 		yaml: yaml,
-		name: include.FileName,
+		name: include.name(),
 		err:  err,
 	}
 }
@@ -746,17 +783,17 @@ func LoadProjectInto(ctx context.Context, data []byte, opts *GetProjectOpts, ide
 
 		// We promise to iterate over includes in the order they are defined.
 		for _, path := range intermediateProject.Include {
-			if _, ok := yamlMap[path.FileName]; !ok {
-				return intermediateProject, errors.WithStack(errors.Errorf("yaml was nil in map for %s, but it never should be", path.FileName))
+			if _, ok := yamlMap[path.name()]; !ok {
+				return intermediateProject, errors.WithStack(errors.Errorf("yaml was nil in map for %s, but it never should be", path.name()))
 			}
-			add, err := createIntermediateProject(yamlMap[path.FileName], opts.UnmarshalStrict)
+			add, err := createIntermediateProject(yamlMap[path.name()], opts.UnmarshalStrict)
 			if err != nil {
 				// Return intermediateProject even if we run into issues to show merge progress.
-				return intermediateProject, errors.Wrapf(err, "%s: loading file '%s'", LoadProjectError, path.FileName)
+				return intermediateProject, errors.Wrapf(err, "%s: loading file '%s'", LoadProjectError, path.name())
 			}
 			if err = intermediateProject.mergeMultipleParserProjects(add); err != nil {
 				// Return intermediateProject even if we run into issues to show merge progress.
-				return intermediateProject, errors.Wrapf(err, "%s: merging file '%s'", LoadProjectError, path.FileName)
+				return intermediateProject, errors.Wrapf(err, "%s: merging file '%s'", LoadProjectError, path.name())
 			}
 		}
 	}
@@ -842,6 +879,20 @@ func retrieveFile(ctx context.Context, opts GetProjectOpts) ([]byte, error) {
 		}
 		return fileContents, nil
 	default:
+		if opts.Ref.GitLab.IsEnabled() {
+			fileContents, err := opts.Ref.GitLab.Client().GetFile(ctx, opts.Ref.GitLab.ProjectPath(), opts.RemotePath, opts.Revision)
+			if err != nil {
+				return nil, errors.Wrapf(err, "fetching project file for project '%s' at revision '%s'", opts.Identifier, opts.Revision)
+			}
+			return fileContents, nil
+		}
+		if opts.Ref.GitRemote.IsEnabled() {
+			fileContents, err := opts.Ref.GitRemote.Mirror().GetFile(ctx, opts.RemotePath, opts.Revision)
+			if err != nil {
+				return nil, errors.Wrapf(err, "fetching project file for project '%s' at revision '%s'", opts.Identifier, opts.Revision)
+			}
+			return fileContents, nil
+		}
 		configFile, err := thirdparty.GetGithubFile(ctx, opts.Ref.Owner, opts.Ref.Repo, opts.RemotePath, opts.Revision)
 		if err != nil {
 			return nil, errors.Wrapf(err, "fetching project file for project '%s' at revision '%s'", opts.Identifier, opts.Revision)
@@ -929,6 +980,22 @@ func getFileForPatchDiff(ctx context.Context, opts GetProjectOpts) ([]byte, erro
 	if opts.Ref == nil {
 		return nil, errors.New("project not passed in")
 	}
+	if opts.Ref.GitLab.IsEnabled() {
+		projectFileBytes, err := opts.Ref.GitLab.Client().GetFile(ctx, opts.Ref.GitLab.ProjectPath(), opts.RemotePath, opts.Revision)
+		if err != nil && !(opts.PatchOpts.patch.ConfigChanged(opts.RemotePath) && thirdparty.IsGitLabNotFoundError(err)) {
+			return nil, errors.Wrapf(err, "getting GitLab file at '%s'@%s: %s", opts.Ref.GitLab.ProjectPath(),
+				opts.RemotePath, opts.Revision)
+		}
+		return projectFileBytes, nil
+	}
+	if opts.Ref.GitRemote.IsEnabled() {
+		projectFileBytes, err := opts.Ref.GitRemote.Mirror().GetFile(ctx, opts.RemotePath, opts.Revision)
+		if err != nil && !(opts.PatchOpts.patch.ConfigChanged(opts.RemotePath) && thirdparty.IsGitMirrorFileNotFoundError(err)) {
+			return nil, errors.Wrapf(err, "getting file '%s' at revision '%s' from git remote '%s'",
+				opts.RemotePath, opts.Revision, opts.Ref.GitRemote.URL)
+		}
+		return projectFileBytes, nil
+	}
 	var projectFileBytes []byte
 	githubFile, err := thirdparty.GetGithubFile(ctx, opts.Ref.Owner,
 		opts.Ref.Repo, opts.RemotePath, opts.Revision)
@@ -1044,16 +1111,18 @@ func TranslateProject(pp *ParserProject) (*Project, error) {
 		NumIncludes:        len(pp.Include),
 	}
 	catcher := grip.NewBasicCatcher()
-	tse := NewParserTaskSelectorEvaluator(pp.Tasks)
+	tasks, errs := instantiateTaskTemplates(pp.TaskTemplates, pp.Tasks)
+	catcher.Extend(errs)
+	tse := NewParserTaskSelectorEvaluator(tasks)
 	tgse := newTaskGroupSelectorEvaluator(pp.TaskGroups)
 	ase := NewAxisSelectorEvaluator(pp.Axes)
 	buildVariants, errs := GetVariantsWithMatrices(ase, pp.Axes, pp.BuildVariants)
 	catcher.Extend(errs)
 	vse := NewVariantSelectorEvaluator(buildVariants, ase)
-	proj.Tasks, proj.TaskGroups, errs = evaluateTaskUnits(tse, tgse, vse, pp.Tasks, pp.TaskGroups)
+	proj.Tasks, proj.TaskGroups, errs = evaluateTaskUnits(tse, tgse, vse, tasks, pp.TaskGroups)
 	catcher.Extend(errs)
 
-	proj.BuildVariants, errs = evaluateBuildVariants(tse, tgse, vse, buildVariants, pp.Tasks, proj.TaskGroups)
+	proj.BuildVariants, errs = evaluateBuildVariants(tse, tgse, vse, buildVariants, tasks, proj.TaskGroups)
 	catcher.Extend(errs)
 	return proj, errors.Wrap(catcher.Resolve(), TranslateProjectError)
 }
@@ -1133,6 +1202,9 @@ func evaluateTaskUnits(tse *taskSelectorEvaluator, tgse *tagSelectorEvaluator, v
 			GitTagOnly:      pt.GitTagOnly,
 			Stepback:        pt.Stepback,
 			MustHaveResults: pt.MustHaveResults,
+			Paths:           pt.Paths,
+			IgnorePaths:     pt.IgnorePaths,
+			CacheKey:        pt.CacheKey,
 		}
 		if strings.Contains(strings.TrimSpace(pt.Name), " ") {
 			evalErrs = append(evalErrs, errors.Errorf("spaces are not allowed in task names ('%s')", pt.Name))
@@ -1210,6 +1282,8 @@ func evaluateBuildVariants(tse *taskSelectorEvaluator, tgse *tagSelectorEvaluato
 			DeactivatePrevious: pbv.DeactivatePrevious,
 			RunOn:              pbv.RunOn,
 			Tags:               pbv.Tags,
+			Paths:              pbv.Paths,
+			IgnorePaths:        pbv.IgnorePaths,
 		}
 		bv.AllowedRequesters = pbv.AllowedRequesters
 		bv.Tasks, unmatchedSelectors, unmatchedCriteria, errs = evaluateBVTasks(tse, tgse, vse, pbv, tasks)
@@ -1495,6 +1569,7 @@ func getParserBuildVariantTaskUnit(name string, pt parserTask, bvt parserBVTaskU
 	if len(res.RunOn) == 0 {
 		res.RunOn = pt.RunOn
 	}
+	res.Paths, res.IgnorePaths = pt.Paths, pt.IgnorePaths
 
 	// Build variant level settings are lower priority than project task level
 	// settings.
@@ -1517,6 +1592,9 @@ func getParserBuildVariantTaskUnit(name string, pt parserTask, bvt parserBVTaskU
 	if res.Disable == nil {
 		res.Disable = bv.Disable
 	}
+	if len(res.Paths) == 0 && len(res.IgnorePaths) == 0 {
+		res.Paths, res.IgnorePaths = bv.Paths, bv.IgnorePaths
+	}
 
 	return res
 }
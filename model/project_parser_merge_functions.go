@@ -12,7 +12,7 @@ const MergeProjectConfigError = "error merging project configs"
 
 // mergeUnorderedUnique merges fields that are lists where the order doesn't matter.
 // These fields can be defined throughout multiple yamls but cannot contain duplicate keys.
-// These fields are: [task, task group, parameter, module, function, container]
+// These fields are: [task, task group, task template, parameter, module, function, container]
 func (pp *ParserProject) mergeUnorderedUnique(toMerge *ParserProject) error {
 	catcher := grip.NewBasicCatcher()
 
@@ -42,6 +42,19 @@ func (pp *ParserProject) mergeUnorderedUnique(toMerge *ParserProject) error {
 		taskGroupNameExist[taskGroup.Name] = true
 	}
 
+	taskTemplateNameExist := map[string]bool{}
+	for _, taskTemplate := range pp.TaskTemplates {
+		taskTemplateNameExist[taskTemplate.Name] = true
+	}
+	for _, taskTemplate := range toMerge.TaskTemplates {
+		if _, ok := taskTemplateNameExist[taskTemplate.Name]; ok {
+			catcher.Errorf("task template '%s' has been declared already", taskTemplate.Name)
+			continue
+		}
+		pp.TaskTemplates = append(pp.TaskTemplates, taskTemplate)
+		taskTemplateNameExist[taskTemplate.Name] = true
+	}
+
 	parameterKeyExist := map[string]bool{}
 	for _, parameter := range pp.Parameters {
 		parameterKeyExist[parameter.Key] = true
@@ -0,0 +1,322 @@
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ParserProjectIncludesCollection stores the contents of remote includes so
+// that they only have to be fetched once.
+const ParserProjectIncludesCollection = "parser_project_includes"
+
+const (
+	// maxRemoteIncludeSize is the largest remote include that will be
+	// downloaded.
+	maxRemoteIncludeSize = 16 * 1024 * 1024
+	// remoteIncludeTimeout is how long downloading a remote include from a
+	// URL can take.
+	remoteIncludeTimeout = time.Minute
+)
+
+// remoteInclude is the cached contents of a remote include. Only includes
+// whose contents can never change are cached (see parserInclude.isCacheable),
+// so they can be reused by every project that includes them.
+type remoteInclude struct {
+	// Key identifies the include's source (see parserInclude.cacheKey).
+	Key       string    `bson:"_id"`
+	SHA256    string    `bson:"sha256"`
+	Content   []byte    `bson:"content"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+var (
+	remoteIncludeKeyKey     = bsonutil.MustHaveTag(remoteInclude{}, "Key")
+	remoteIncludeSHA256Key  = bsonutil.MustHaveTag(remoteInclude{}, "SHA256")
+	remoteIncludeContentKey = bsonutil.MustHaveTag(remoteInclude{}, "Content")
+	remoteIncludeCreatedKey = bsonutil.MustHaveTag(remoteInclude{}, "CreatedAt")
+)
+
+// commitHashRegex matches a full Git commit hash.
+var commitHashRegex = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// isRemote returns whether the include is fetched from outside of the
+// project's own repository and modules.
+func (i parserInclude) isRemote() bool {
+	return i.URL != "" || i.Repo != ""
+}
+
+// name returns a name that identifies the include in errors and logs.
+func (i parserInclude) name() string {
+	switch {
+	case i.URL != "":
+		return i.URL
+	case i.Repo != "":
+		return fmt.Sprintf("%s@%s:%s", i.Repo, i.Ref, i.FileName)
+	default:
+		return i.FileName
+	}
+}
+
+// cacheKey returns the key that the include's contents are cached under.
+func (i parserInclude) cacheKey() string {
+	if i.URL != "" {
+		return fmt.Sprintf("url:%s:%s", i.URL, i.SHA256)
+	}
+	return fmt.Sprintf("repo:%s@%s:%s", i.Repo, i.Ref, i.FileName)
+}
+
+// isCacheable returns whether the remote include's contents can never change,
+// which is the case if it's pinned to a sha256 or to a commit hash. Includes
+// at a tag or branch are fetched every time, since the ref can move.
+func (i parserInclude) isCacheable() bool {
+	return i.SHA256 != "" || commitHashRegex.MatchString(i.Ref)
+}
+
+// validateRemote checks that a remote include is pinned to a specific version.
+func (i parserInclude) validateRemote() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(i.Module != "", "cannot include a file from both a module and a remote source")
+	catcher.NewWhen(i.URL != "" && i.Repo != "", "cannot include a file from both a URL and a repository")
+	if i.URL != "" {
+		u, err := url.Parse(i.URL)
+		catcher.Wrapf(err, "parsing URL '%s'", i.URL)
+		catcher.ErrorfWhen(err == nil && u.Scheme != "https", "URL '%s' must use HTTPS", i.URL)
+		catcher.NewWhen(i.FileName != "", "cannot specify a filename for an include from a URL")
+		catcher.ErrorfWhen(i.SHA256 == "", "include from URL '%s' must specify its sha256", i.URL)
+	}
+	if i.Repo != "" {
+		owner, repo, ok := strings.Cut(i.Repo, "/")
+		catcher.ErrorfWhen(!ok || owner == "" || repo == "" || strings.Contains(repo, "/"), "repository '%s' must be in the format 'owner/repo'", i.Repo)
+		catcher.ErrorfWhen(i.Ref == "", "include from repository '%s' must specify the tag or commit hash to include it at", i.Repo)
+		catcher.ErrorfWhen(i.FileName == "", "include from repository '%s' must specify a filename", i.Repo)
+	}
+	if i.SHA256 != "" {
+		hash, err := hex.DecodeString(i.SHA256)
+		catcher.ErrorfWhen(err != nil || len(hash) != sha256.Size, "sha256 '%s' is not a valid SHA-256 hash", i.SHA256)
+	}
+	return catcher.Resolve()
+}
+
+// verify checks that the contents match the include's sha256, if it has one.
+func (i parserInclude) verify(content []byte) error {
+	if i.SHA256 == "" {
+		return nil
+	}
+	sum := sha256.Sum256(content)
+	if actual := hex.EncodeToString(sum[:]); !strings.EqualFold(actual, i.SHA256) {
+		return errors.Errorf("contents have sha256 '%s' but expected '%s'", actual, i.SHA256)
+	}
+	return nil
+}
+
+// retrieveRemoteInclude returns the contents of an include from a URL or
+// another repository. The contents are cached after they're first fetched, so
+// later loads of any project with the same include don't need to fetch it
+// again.
+func retrieveRemoteInclude(ctx context.Context, opts GetProjectOpts, include parserInclude) ([]byte, error) {
+	if err := include.validateRemote(); err != nil {
+		return nil, errors.Wrap(err, "invalid remote include")
+	}
+
+	// The cache is only available when loading the project in the app
+	// server.
+	useCache := opts.ReadFileFrom != ReadFromLocal && include.isCacheable()
+	if useCache {
+		cached, err := findRemoteInclude(ctx, include.cacheKey())
+		if err != nil {
+			return nil, errors.Wrap(err, "finding cached remote include")
+		}
+		if cached != nil && include.verify(cached.Content) == nil {
+			return cached.Content, nil
+		}
+	}
+
+	var content []byte
+	var err error
+	if include.URL != "" {
+		content, err = fetchIncludeFromURL(ctx, opts, include.URL)
+	} else {
+		content, err = fetchIncludeFromRepo(ctx, opts, include)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := include.verify(content); err != nil {
+		return nil, errors.Wrapf(err, "verifying include '%s'", include.name())
+	}
+
+	if useCache {
+		sum := sha256.Sum256(content)
+		// Failing to cache the include only means it'll be fetched again next
+		// time, so it shouldn't prevent the project from loading.
+		grip.Error(message.WrapError(upsertRemoteInclude(ctx, remoteInclude{
+			Key:       include.cacheKey(),
+			SHA256:    hex.EncodeToString(sum[:]),
+			Content:   content,
+			CreatedAt: time.Now(),
+		}), message.Fields{
+			"message": "could not cache remote include",
+			"include": include.name(),
+		}))
+	}
+
+	return content, nil
+}
+
+// fetchIncludeFromURL downloads an include from a URL. When loading the project
+// in the app server, the URL's host must be one of the admin-configured
+// allowed hosts. The include can never be downloaded from a loopback,
+// link-local, or private address, even if an allowed host resolves to one.
+func fetchIncludeFromURL(ctx context.Context, opts GetProjectOpts, includeURL string) ([]byte, error) {
+	checkHost := func(*url.URL) error { return nil }
+	if opts.ReadFileFrom != ReadFromLocal {
+		settings, err := evergreen.GetConfig(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting admin settings")
+		}
+		allowedHosts := settings.TaskLimits.RemoteIncludeAllowedHosts
+		checkHost = func(u *url.URL) error { return checkRemoteIncludeHost(u, allowedHosts) }
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, includeURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request")
+	}
+	if err = checkHost(req.URL); err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		// Check the address after DNS resolution so that a host can't
+		// resolve to an internal address.
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return checkRemoteIncludeAddress(address)
+		},
+	}
+	client := &http.Client{
+		Timeout:   remoteIncludeTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("too many redirects")
+			}
+			return checkHost(req.URL)
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "downloading include from URL '%s'", includeURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("downloading include from URL '%s' returned status %d", includeURL, resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteIncludeSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading include from URL '%s'", includeURL)
+	}
+	if len(content) > maxRemoteIncludeSize {
+		return nil, errors.Errorf("include from URL '%s' is larger than the maximum size of %d bytes", includeURL, maxRemoteIncludeSize)
+	}
+	return content, nil
+}
+
+// checkRemoteIncludeHost checks that the URL's host is one of the allowed
+// hosts for remote includes.
+func checkRemoteIncludeHost(u *url.URL, allowedHosts []string) error {
+	if u.Scheme != "https" {
+		return errors.Errorf("URL '%s' must use HTTPS", u.Redacted())
+	}
+	for _, host := range allowedHosts {
+		if strings.EqualFold(host, u.Hostname()) {
+			return nil
+		}
+	}
+	return errors.Errorf("host '%s' is not an allowed host for remote includes", u.Hostname())
+}
+
+// checkRemoteIncludeAddress checks that the resolved address to download a
+// remote include from is not an internal address.
+func checkRemoteIncludeAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.Wrapf(err, "parsing address '%s'", address)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("address '%s' is not an IP address", address)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() || ip.IsMulticast() {
+		return errors.Errorf("cannot download remote include from internal address '%s'", ip)
+	}
+	return nil
+}
+
+func fetchIncludeFromRepo(ctx context.Context, opts GetProjectOpts, include parserInclude) ([]byte, error) {
+	// When loading a local project, the repository must be checked out
+	// locally, like a module.
+	if path, ok := opts.LocalModules[include.Repo]; ok {
+		content, err := os.ReadFile(filepath.Join(path, include.FileName))
+		return content, errors.Wrapf(err, "reading include '%s' from local repository", include.name())
+	} else if opts.ReadFileFrom == ReadFromLocal {
+		return nil, errors.Errorf("local path for repository '%s' is unspecified", include.Repo)
+	}
+
+	owner, repo, _ := strings.Cut(include.Repo, "/")
+	file, err := thirdparty.GetGithubFile(ctx, owner, repo, include.FileName, include.Ref)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fetching include '%s'", include.name())
+	}
+	content, err := base64.StdEncoding.DecodeString(utility.FromStringPtr(file.Content))
+	if err != nil {
+		return nil, errors.Wrapf(err, "decoding include '%s'", include.name())
+	}
+	return content, nil
+}
+
+func findRemoteInclude(ctx context.Context, key string) (*remoteInclude, error) {
+	include := &remoteInclude{}
+	err := db.FindOneQContext(ctx, ParserProjectIncludesCollection, db.Query(bson.M{remoteIncludeKeyKey: key}), include)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return include, err
+}
+
+func upsertRemoteInclude(ctx context.Context, include remoteInclude) error {
+	_, err := db.UpsertContext(ctx, ParserProjectIncludesCollection, bson.M{remoteIncludeKeyKey: include.Key}, bson.M{
+		"$set": bson.M{
+			remoteIncludeSHA256Key:  include.SHA256,
+			remoteIncludeContentKey: include.Content,
+			remoteIncludeCreatedKey: include.CreatedAt,
+		},
+	})
+	return err
+}
@@ -0,0 +1,278 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sharedFunctionsYAML = `
+functions:
+  shared_setup:
+    command: shell.exec
+    params:
+      script: echo setup
+`
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestRemoteIncludeValidation(t *testing.T) {
+	hash := sha256Hex(sharedFunctionsYAML)
+	for tName, tCase := range map[string]struct {
+		include parserInclude
+		isValid bool
+	}{
+		"URLWithSHA256": {
+			include: parserInclude{URL: "https://example.com/functions.yml", SHA256: hash},
+			isValid: true,
+		},
+		"URLWithoutSHA256": {
+			include: parserInclude{URL: "https://example.com/functions.yml"},
+		},
+		"InsecureURL": {
+			include: parserInclude{URL: "http://example.com/functions.yml", SHA256: hash},
+		},
+		"URLWithFilename": {
+			include: parserInclude{URL: "https://example.com/functions.yml", SHA256: hash, FileName: "functions.yml"},
+		},
+		"InvalidSHA256": {
+			include: parserInclude{URL: "https://example.com/functions.yml", SHA256: "abc"},
+		},
+		"RepoWithRef": {
+			include: parserInclude{Repo: "evergreen-ci/shared", Ref: "v1.2.0", FileName: "functions.yml"},
+			isValid: true,
+		},
+		"RepoWithoutRef": {
+			include: parserInclude{Repo: "evergreen-ci/shared", FileName: "functions.yml"},
+		},
+		"RepoWithoutOwner": {
+			include: parserInclude{Repo: "shared", Ref: "v1.2.0", FileName: "functions.yml"},
+		},
+		"RepoWithoutFilename": {
+			include: parserInclude{Repo: "evergreen-ci/shared", Ref: "v1.2.0"},
+		},
+		"RepoAndModule": {
+			include: parserInclude{Repo: "evergreen-ci/shared", Ref: "v1.2.0", FileName: "functions.yml", Module: "shared"},
+		},
+		"RepoAndURL": {
+			include: parserInclude{Repo: "evergreen-ci/shared", Ref: "v1.2.0", FileName: "functions.yml", URL: "https://example.com/functions.yml", SHA256: hash},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.True(t, tCase.include.isRemote())
+			err := tCase.include.validateRemote()
+			if tCase.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRemoteIncludeVerify(t *testing.T) {
+	include := parserInclude{URL: "https://example.com/functions.yml", SHA256: sha256Hex(sharedFunctionsYAML)}
+	assert.NoError(t, include.verify([]byte(sharedFunctionsYAML)))
+	assert.Error(t, include.verify([]byte("tampered")))
+
+	include.SHA256 = ""
+	assert.NoError(t, include.verify([]byte("anything")), "includes without a sha256 should not be verified")
+}
+
+func TestCheckRemoteIncludeHost(t *testing.T) {
+	allowedHosts := []string{"configs.example.com"}
+	for tName, tCase := range map[string]struct {
+		url     string
+		isValid bool
+	}{
+		"AllowedHost": {
+			url:     "https://configs.example.com/functions.yml",
+			isValid: true,
+		},
+		"AllowedHostIsCaseInsensitive": {
+			url:     "https://Configs.Example.com/functions.yml",
+			isValid: true,
+		},
+		"AllowedHostWithPort": {
+			url:     "https://configs.example.com:8443/functions.yml",
+			isValid: true,
+		},
+		"OtherHost": {
+			url: "https://example.com/functions.yml",
+		},
+		"SubdomainOfAllowedHost": {
+			url: "https://evil.configs.example.com/functions.yml",
+		},
+		"InsecureAllowedHost": {
+			url: "http://configs.example.com/functions.yml",
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			u, err := url.Parse(tCase.url)
+			require.NoError(t, err)
+			err = checkRemoteIncludeHost(u, allowedHosts)
+			if tCase.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+	t.Run("NoAllowedHosts", func(t *testing.T) {
+		u, err := url.Parse("https://configs.example.com/functions.yml")
+		require.NoError(t, err)
+		assert.Error(t, checkRemoteIncludeHost(u, nil))
+	})
+}
+
+func TestCheckRemoteIncludeAddress(t *testing.T) {
+	for address, isValid := range map[string]bool{
+		"93.184.216.34:443":    true,
+		"[2606:4700::1]:443":   true,
+		"127.0.0.1:443":        false,
+		"[::1]:443":            false,
+		"10.1.2.3:443":         false,
+		"172.16.0.1:443":       false,
+		"192.168.1.1:443":      false,
+		"169.254.169.254:80":   false,
+		"[fe80::1]:443":        false,
+		"[fd00::1]:443":        false,
+		"0.0.0.0:443":          false,
+		"configs.example.com:": false,
+	} {
+		t.Run(address, func(t *testing.T) {
+			err := checkRemoteIncludeAddress(address)
+			if isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestFetchIncludeFromURLRejectsInternalAddress(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(sharedFunctionsYAML))
+	}))
+	defer srv.Close()
+
+	_, err := fetchIncludeFromURL(t.Context(), GetProjectOpts{ReadFileFrom: ReadFromLocal}, srv.URL+"/functions.yml")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "internal address")
+}
+
+func TestRetrieveRemoteInclude(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("ReturnsCachedContents", func(t *testing.T) {
+		require.NoError(t, db.ClearCollections(ParserProjectIncludesCollection))
+		include := parserInclude{URL: "https://example.com/functions.yml", SHA256: sha256Hex(sharedFunctionsYAML)}
+		require.NoError(t, upsertRemoteInclude(ctx, remoteInclude{
+			Key:       include.cacheKey(),
+			SHA256:    include.SHA256,
+			Content:   []byte(sharedFunctionsYAML),
+			CreatedAt: time.Now(),
+		}))
+
+		content, err := retrieveRemoteInclude(ctx, GetProjectOpts{ReadFileFrom: ReadFromGithub}, include)
+		require.NoError(t, err)
+		assert.Equal(t, sharedFunctionsYAML, string(content))
+	})
+	t.Run("CachesRepoIncludeFromLocalPath", func(t *testing.T) {
+		require.NoError(t, db.ClearCollections(ParserProjectIncludesCollection))
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "functions.yml"), []byte(sharedFunctionsYAML), 0644))
+		include := parserInclude{Repo: "evergreen-ci/shared", Ref: "0123456789abcdef0123456789abcdef01234567", FileName: "functions.yml"}
+		opts := GetProjectOpts{
+			ReadFileFrom: ReadFromGithub,
+			LocalModules: map[string]string{"evergreen-ci/shared": dir},
+		}
+
+		content, err := retrieveRemoteInclude(ctx, opts, include)
+		require.NoError(t, err)
+		assert.Equal(t, sharedFunctionsYAML, string(content))
+
+		cached, err := findRemoteInclude(ctx, include.cacheKey())
+		require.NoError(t, err)
+		require.NotZero(t, cached)
+		assert.Equal(t, sharedFunctionsYAML, string(cached.Content))
+		assert.Equal(t, sha256Hex(sharedFunctionsYAML), cached.SHA256)
+	})
+	t.Run("DoesNotCacheRepoIncludeAtMovableRef", func(t *testing.T) {
+		require.NoError(t, db.ClearCollections(ParserProjectIncludesCollection))
+		dir := t.TempDir()
+		path := filepath.Join(dir, "functions.yml")
+		require.NoError(t, os.WriteFile(path, []byte(sharedFunctionsYAML), 0644))
+		include := parserInclude{Repo: "evergreen-ci/shared", Ref: "main", FileName: "functions.yml"}
+		assert.False(t, include.isCacheable())
+		opts := GetProjectOpts{
+			ReadFileFrom: ReadFromGithub,
+			LocalModules: map[string]string{"evergreen-ci/shared": dir},
+		}
+
+		content, err := retrieveRemoteInclude(ctx, opts, include)
+		require.NoError(t, err)
+		assert.Equal(t, sharedFunctionsYAML, string(content))
+		cached, err := findRemoteInclude(ctx, include.cacheKey())
+		require.NoError(t, err)
+		assert.Nil(t, cached)
+
+		const updated = "functions: {}"
+		require.NoError(t, os.WriteFile(path, []byte(updated), 0644))
+		content, err = retrieveRemoteInclude(ctx, opts, include)
+		require.NoError(t, err)
+		assert.Equal(t, updated, string(content), "include at a movable ref should be fetched again")
+	})
+	t.Run("MismatchedSHA256Errors", func(t *testing.T) {
+		require.NoError(t, db.ClearCollections(ParserProjectIncludesCollection))
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "functions.yml"), []byte("tampered"), 0644))
+		include := parserInclude{Repo: "evergreen-ci/shared", Ref: "v1.2.0", FileName: "functions.yml", SHA256: sha256Hex(sharedFunctionsYAML)}
+		opts := GetProjectOpts{
+			ReadFileFrom: ReadFromLocal,
+			LocalModules: map[string]string{"evergreen-ci/shared": dir},
+		}
+
+		_, err := retrieveRemoteInclude(ctx, opts, include)
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadProjectIntoWithRemoteInclude(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "functions.yml"), []byte(sharedFunctionsYAML), 0644))
+	yml := `
+include:
+  - repo: evergreen-ci/shared
+    ref: v1.2.0
+    filename: functions.yml
+tasks:
+  - name: compile
+    commands:
+      - func: shared_setup
+`
+	opts := &GetProjectOpts{
+		ReadFileFrom: ReadFromLocal,
+		LocalModules: map[string]string{"evergreen-ci/shared": dir},
+	}
+	proj := &Project{}
+	pp, err := LoadProjectInto(t.Context(), []byte(yml), opts, "id", proj)
+	require.NoError(t, err)
+	require.NotNil(t, pp)
+	assert.Contains(t, proj.Functions, "shared_setup")
+	assert.Empty(t, pp.Include)
+}
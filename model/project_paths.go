@@ -0,0 +1,134 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+// PathSkippedTask is a task that was not created for a version because the
+// version didn't change any of the files that the task watches.
+type PathSkippedTask struct {
+	Variant string `bson:"variant" json:"variant"`
+	Task    string `bson:"task" json:"task"`
+	// Reason explains why the task was skipped.
+	Reason string `bson:"reason" json:"reason"`
+}
+
+// HasPathFilters returns whether any task or build variant in the project
+// watches specific paths.
+func (p *Project) HasPathFilters() bool {
+	for _, t := range p.Tasks {
+		if len(t.Paths) > 0 || len(t.IgnorePaths) > 0 {
+			return true
+		}
+	}
+	for _, bv := range p.BuildVariants {
+		if len(bv.Paths) > 0 || len(bv.IgnorePaths) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// SkipOnChangedFiles returns whether the task should be skipped because none
+// of the changed files are watched by it and, if so, the reason why. A file is
+// watched if it matches the task's paths (or the task has no paths) and does
+// not match its ignore paths. Tasks that don't watch specific paths are never
+// skipped, nor are any tasks if the changed files are unknown.
+func (bvt *BuildVariantTaskUnit) SkipOnChangedFiles(changedFiles []string) (bool, string) {
+	if len(changedFiles) == 0 || (len(bvt.Paths) == 0 && len(bvt.IgnorePaths) == 0) {
+		return false, ""
+	}
+
+	// CompileIgnoreLines has a silly API: it always returns a nil error.
+	watched := ignore.CompileIgnoreLines(bvt.Paths...)
+	ignored := ignore.CompileIgnoreLines(bvt.IgnorePaths...)
+	for _, file := range changedFiles {
+		if len(bvt.Paths) > 0 && !watched.MatchesPath(file) {
+			continue
+		}
+		if len(bvt.IgnorePaths) > 0 && ignored.MatchesPath(file) {
+			continue
+		}
+		return false, ""
+	}
+
+	switch {
+	case len(bvt.IgnorePaths) == 0:
+		return true, fmt.Sprintf("no changed files match paths '%s'", strings.Join(bvt.Paths, "', '"))
+	case len(bvt.Paths) == 0:
+		return true, fmt.Sprintf("all changed files match ignore paths '%s'", strings.Join(bvt.IgnorePaths, "', '"))
+	default:
+		return true, fmt.Sprintf("no changed files match paths '%s' without matching ignore paths '%s'",
+			strings.Join(bvt.Paths, "', '"), strings.Join(bvt.IgnorePaths, "', '"))
+	}
+}
+
+// TVPairsForRequester returns every task/variant pair in the project that
+// can run for the given requester, expanding task groups into their tasks.
+func (p *Project) TVPairsForRequester(requester string) TVPairSet {
+	pairs := TVPairSet{}
+	for _, bvt := range p.FindAllBuildVariantTasks() {
+		if bvt.IsDisabled() || bvt.SkipOnRequester(requester) {
+			continue
+		}
+		pairs = append(pairs, TVPair{Variant: bvt.Variant, TaskName: bvt.Name})
+	}
+	return pairs
+}
+
+// FilterTVPairsByChangedFiles removes the pairs whose tasks don't watch any of
+// the changed files, returning the remaining pairs and the skipped tasks. The
+// dependencies of the remaining pairs are included even if they don't watch
+// any of the changed files themselves, so that the tasks that depend on them
+// can still run.
+func (p *Project) FilterTVPairsByChangedFiles(pairs TVPairSet, changedFiles []string, requester string) (TVPairSet, []PathSkippedTask, error) {
+	taskUnits := map[TVPair]BuildVariantTaskUnit{}
+	for _, bvt := range p.FindAllBuildVariantTasks() {
+		taskUnits[TVPair{Variant: bvt.Variant, TaskName: bvt.Name}] = bvt
+	}
+
+	toKeep := TVPairSet{}
+	skipReasons := map[TVPair]string{}
+	for _, pair := range pairs {
+		bvt, ok := taskUnits[pair]
+		if !ok {
+			toKeep = append(toKeep, pair)
+			continue
+		}
+		if skip, reason := bvt.SkipOnChangedFiles(changedFiles); skip {
+			skipReasons[pair] = reason
+			continue
+		}
+		toKeep = append(toKeep, pair)
+	}
+	if len(skipReasons) == 0 {
+		return pairs, nil, nil
+	}
+
+	if len(toKeep) > 0 {
+		withDeps, err := IncludeDependencies(p, toKeep, requester, nil)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "including dependencies of tasks that watch the changed files")
+		}
+		toKeep = withDeps
+		for _, pair := range toKeep {
+			delete(skipReasons, pair)
+		}
+	}
+
+	skipped := []PathSkippedTask{}
+	for _, pair := range pairs {
+		if reason, ok := skipReasons[pair]; ok {
+			skipped = append(skipped, PathSkippedTask{
+				Variant: pair.Variant,
+				Task:    pair.TaskName,
+				Reason:  reason,
+			})
+		}
+	}
+	return toKeep, skipped, nil
+}
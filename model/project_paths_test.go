@@ -0,0 +1,136 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkipOnChangedFiles(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		bvt          BuildVariantTaskUnit
+		changedFiles []string
+		skip         bool
+	}{
+		"NoPathsNeverSkips": {
+			changedFiles: []string{"README.md"},
+		},
+		"UnknownChangedFilesNeverSkip": {
+			bvt: BuildVariantTaskUnit{Paths: []string{"src/**"}},
+		},
+		"MatchingPathRuns": {
+			bvt:          BuildVariantTaskUnit{Paths: []string{"src/storage/**"}},
+			changedFiles: []string{"README.md", "src/storage/engine.go"},
+		},
+		"NoMatchingPathSkips": {
+			bvt:          BuildVariantTaskUnit{Paths: []string{"src/storage/**"}},
+			changedFiles: []string{"README.md", "src/network/conn.go"},
+			skip:         true,
+		},
+		"OnlyIgnoredPathsSkips": {
+			bvt:          BuildVariantTaskUnit{IgnorePaths: []string{"*.md", "docs/**"}},
+			changedFiles: []string{"README.md", "docs/index.html"},
+			skip:         true,
+		},
+		"UnignoredPathRuns": {
+			bvt:          BuildVariantTaskUnit{IgnorePaths: []string{"*.md"}},
+			changedFiles: []string{"README.md", "main.go"},
+		},
+		"IgnorePathsExcludeMatchingPaths": {
+			bvt:          BuildVariantTaskUnit{Paths: []string{"src/**"}, IgnorePaths: []string{"*_test.go"}},
+			changedFiles: []string{"src/storage/engine_test.go"},
+			skip:         true,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			skip, reason := tCase.bvt.SkipOnChangedFiles(tCase.changedFiles)
+			assert.Equal(t, tCase.skip, skip)
+			if tCase.skip {
+				assert.NotEmpty(t, reason)
+			} else {
+				assert.Empty(t, reason)
+			}
+		})
+	}
+}
+
+func TestFilterTVPairsByChangedFiles(t *testing.T) {
+	const yml = `
+tasks:
+  - name: compile
+    paths: ["src/core/**"]
+  - name: storage_test
+    paths: ["src/storage/**"]
+    depends_on:
+      - name: compile
+  - name: docs
+    ignore_paths: ["src/**"]
+  - name: lint
+  - name: grouped
+    paths: ["tools/**"]
+task_groups:
+  - name: tg
+    tasks: [grouped]
+buildvariants:
+  - name: bv
+    paths: ["src/**"]
+    tasks: [compile, storage_test, docs, lint, tg]
+  - name: unfiltered
+    tasks: [compile, docs]
+`
+	pp, err := createIntermediateProject([]byte(yml), false)
+	require.NoError(t, err)
+	p, err := TranslateProject(pp)
+	require.NoError(t, err)
+	require.True(t, p.HasPathFilters())
+
+	t.Run("ResolvesPathsFromTaskThenBuildVariant", func(t *testing.T) {
+		bvt := p.FindTaskForVariant("lint", "bv")
+		require.NotNil(t, bvt)
+		assert.Equal(t, []string{"src/**"}, bvt.Paths)
+
+		bvt = p.FindTaskForVariant("compile", "bv")
+		require.NotNil(t, bvt)
+		assert.Equal(t, []string{"src/core/**"}, bvt.Paths)
+
+		bvt = p.FindTaskForVariant("grouped", "bv")
+		require.NotNil(t, bvt)
+		assert.Equal(t, []string{"tools/**"}, bvt.Paths)
+	})
+	t.Run("KeepsDependenciesOfWatchingTasks", func(t *testing.T) {
+		pairs := p.TVPairsForRequester(evergreen.RepotrackerVersionRequester)
+		require.Len(t, pairs, 7)
+
+		filtered, skipped, err := p.FilterTVPairsByChangedFiles(pairs, []string{"src/storage/engine.go"}, evergreen.RepotrackerVersionRequester)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, TVPairSet{
+			{Variant: "bv", TaskName: "compile"},
+			{Variant: "bv", TaskName: "storage_test"},
+			{Variant: "bv", TaskName: "lint"},
+		}, filtered)
+		require.Len(t, skipped, 4)
+		assert.Equal(t, PathSkippedTask{Variant: "bv", Task: "docs", Reason: "all changed files match ignore paths 'src/**'"}, skipped[0])
+		assert.Equal(t, PathSkippedTask{Variant: "bv", Task: "grouped", Reason: "no changed files match paths 'tools/**'"}, skipped[1])
+		assert.Equal(t, PathSkippedTask{Variant: "unfiltered", Task: "compile", Reason: "no changed files match paths 'src/core/**'"}, skipped[2])
+		assert.Equal(t, "docs", skipped[3].Task)
+	})
+	t.Run("SkipsEveryTaskWithoutWatchedFiles", func(t *testing.T) {
+		pairs := TVPairSet{
+			{Variant: "bv", TaskName: "compile"},
+			{Variant: "bv", TaskName: "storage_test"},
+		}
+		filtered, skipped, err := p.FilterTVPairsByChangedFiles(pairs, []string{"src/network/conn.go"}, evergreen.RepotrackerVersionRequester)
+		require.NoError(t, err)
+		assert.Empty(t, filtered)
+		assert.Len(t, skipped, 2)
+	})
+	t.Run("NoSkippedTasksReturnsPairsUnchanged", func(t *testing.T) {
+		pairs := TVPairSet{{Variant: "bv", TaskName: "lint"}}
+		filtered, skipped, err := p.FilterTVPairsByChangedFiles(pairs, []string{"src/core/main.go"}, evergreen.RepotrackerVersionRequester)
+		require.NoError(t, err)
+		assert.Equal(t, pairs, filtered)
+		assert.Empty(t, skipped)
+	})
+}
@@ -74,6 +74,12 @@ type ProjectRef struct {
 	PatchTriggerAliases []patch.PatchTriggerDefinition `bson:"patch_trigger_aliases" json:"patch_trigger_aliases"`
 	// all PatchTriggerAliases applied to github patch intents
 	GithubTriggerAliases []string `bson:"github_trigger_aliases" json:"github_trigger_aliases"`
+	// GitLab contains the settings for projects whose repository is hosted in
+	// GitLab rather than GitHub.
+	GitLab GitLabSettings `bson:"gitlab,omitempty" json:"gitlab,omitempty" yaml:"gitlab,omitempty"`
+	// GitRemote contains the settings for projects whose repository is
+	// tracked directly from a git remote rather than through a hosting API.
+	GitRemote GitRemoteSettings `bson:"git_remote,omitempty" json:"git_remote,omitempty" yaml:"git_remote,omitempty"`
 	// OldestAllowedMergeBase is the commit hash of the oldest merge base on the target branch
 	// that PR patches can be created from.
 	OldestAllowedMergeBase string                    `bson:"oldest_allowed_merge_base" json:"oldest_allowed_merge_base"`
@@ -102,6 +108,11 @@ type ProjectRef struct {
 	// Disable task stats caching for this project.
 	DisabledStatsCache *bool `bson:"disabled_stats_cache,omitempty" json:"disabled_stats_cache,omitempty"`
 
+	// TestSelectionEnabled enables Evergreen's built-in test selection, which
+	// learns from the project's patches and recommends the tests most likely
+	// to fail in new patches.
+	TestSelectionEnabled *bool `bson:"test_selection_enabled,omitempty" json:"test_selection_enabled,omitempty"`
+
 	// List of commands
 	// Lacks omitempty so that SetupCommands can be identified as either [] or nil in a ProjectSettingsEvent
 	WorkstationConfig WorkstationConfig `bson:"workstation_config" json:"workstation_config"`
@@ -343,8 +354,26 @@ type CommitQueueParams struct {
 	Enabled     *bool  `bson:"enabled" json:"enabled" yaml:"enabled"`
 	MergeMethod string `bson:"merge_method" json:"merge_method" yaml:"merge_method"`
 	Message     string `bson:"message,omitempty" json:"message,omitempty" yaml:"message"`
+	// MergeQueueBatchSize is the maximum number of GitHub merge groups to test
+	// together in a single version. Batching is disabled if it's less than 2.
+	MergeQueueBatchSize int `bson:"merge_queue_batch_size,omitempty" json:"merge_queue_batch_size,omitempty" yaml:"merge_queue_batch_size,omitempty"`
+	// MergeQueueBatchWaitSecs is how long to wait for a batch to fill up before
+	// testing it anyway.
+	MergeQueueBatchWaitSecs int `bson:"merge_queue_batch_wait_secs,omitempty" json:"merge_queue_batch_wait_secs,omitempty" yaml:"merge_queue_batch_wait_secs,omitempty"`
+	// MergeQueueBisectPolicy is how to find the merge groups responsible for a
+	// failing batch. Defaults to binary bisection.
+	MergeQueueBisectPolicy string `bson:"merge_queue_bisect_policy,omitempty" json:"merge_queue_bisect_policy,omitempty" yaml:"merge_queue_bisect_policy,omitempty"`
 }
 
+const (
+	// maxMergeQueueBatchSize is the largest number of merge groups that can
+	// be tested in a single batch.
+	maxMergeQueueBatchSize = 32
+	// defaultMergeQueueBatchWait is how long to wait for a merge queue batch
+	// to fill up if the project doesn't specify a wait time.
+	defaultMergeQueueBatchWait = 5 * time.Minute
+)
+
 // RepositoryErrorDetails indicates whether or not there is an invalid revision and if there is one,
 // what the guessed merge base revision is.
 type RepositoryErrorDetails struct {
@@ -470,6 +499,7 @@ var (
 	ProjectRefHiddenKey                             = bsonutil.MustHaveTag(ProjectRef{}, "Hidden")
 	ProjectRefRepotrackerErrorKey                   = bsonutil.MustHaveTag(ProjectRef{}, "RepotrackerError")
 	ProjectRefDisabledStatsCacheKey                 = bsonutil.MustHaveTag(ProjectRef{}, "DisabledStatsCache")
+	projectRefTestSelectionEnabledKey               = bsonutil.MustHaveTag(ProjectRef{}, "TestSelectionEnabled")
 	ProjectRefAdminsKey                             = bsonutil.MustHaveTag(ProjectRef{}, "Admins")
 	ProjectRefGitTagAuthorizedUsersKey              = bsonutil.MustHaveTag(ProjectRef{}, "GitTagAuthorizedUsers")
 	ProjectRefGitTagAuthorizedTeamsKey              = bsonutil.MustHaveTag(ProjectRef{}, "GitTagAuthorizedTeams")
@@ -492,6 +522,8 @@ var (
 	projectRefGithubTriggerAliasesKey               = bsonutil.MustHaveTag(ProjectRef{}, "GithubTriggerAliases")
 	projectRefPeriodicBuildsKey                     = bsonutil.MustHaveTag(ProjectRef{}, "PeriodicBuilds")
 	projectRefOldestAllowedMergeBaseKey             = bsonutil.MustHaveTag(ProjectRef{}, "OldestAllowedMergeBase")
+	projectRefGitLabKey                             = bsonutil.MustHaveTag(ProjectRef{}, "GitLab")
+	projectRefGitRemoteKey                          = bsonutil.MustHaveTag(ProjectRef{}, "GitRemote")
 	projectRefWorkstationConfigKey                  = bsonutil.MustHaveTag(ProjectRef{}, "WorkstationConfig")
 	projectRefTaskAnnotationSettingsKey             = bsonutil.MustHaveTag(ProjectRef{}, "TaskAnnotationSettings")
 	projectRefBuildBaronSettingsKey                 = bsonutil.MustHaveTag(ProjectRef{}, "BuildBaronSettings")
@@ -585,6 +617,10 @@ func (p *ProjectRef) IsStatsCacheDisabled() bool {
 	return utility.FromBoolPtr(p.DisabledStatsCache)
 }
 
+func (p *ProjectRef) IsTestSelectionEnabled() bool {
+	return utility.FromBoolPtr(p.TestSelectionEnabled)
+}
+
 func (p *ProjectRef) IsHidden() bool {
 	return utility.FromBoolPtr(p.Hidden)
 }
@@ -609,6 +645,38 @@ func (p *CommitQueueParams) IsEnabled() bool {
 	return utility.FromBoolPtr(p.Enabled)
 }
 
+// ShouldBatchMergeQueue returns whether GitHub merge groups should be tested
+// in batches.
+func (p *CommitQueueParams) ShouldBatchMergeQueue() bool {
+	return p.MergeQueueBatchSize > 1
+}
+
+// ShouldBisectMergeQueue returns whether failing merge queue batches should be
+// bisected to find the merge groups that caused the failure.
+func (p *CommitQueueParams) ShouldBisectMergeQueue() bool {
+	return p.MergeQueueBisectPolicy != patch.MergeQueueBisectPolicyNone
+}
+
+// MergeQueueBatchWait returns how long to wait for a merge queue batch to fill
+// up before testing it.
+func (p *CommitQueueParams) MergeQueueBatchWait() time.Duration {
+	if p.MergeQueueBatchWaitSecs <= 0 {
+		return defaultMergeQueueBatchWait
+	}
+	return time.Duration(p.MergeQueueBatchWaitSecs) * time.Second
+}
+
+// ValidateMergeQueueBatching checks that the merge queue batching settings
+// are valid.
+func (p *CommitQueueParams) ValidateMergeQueueBatching() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(p.MergeQueueBatchSize < 0, "merge queue batch size cannot be negative")
+	catcher.ErrorfWhen(p.MergeQueueBatchSize > maxMergeQueueBatchSize, "merge queue batch size cannot be greater than %d", maxMergeQueueBatchSize)
+	catcher.ErrorfWhen(p.MergeQueueBatchWaitSecs < 0, "merge queue batch wait time cannot be negative")
+	catcher.ErrorfWhen(!utility.StringSliceContains(patch.MergeQueueBisectPolicies, p.MergeQueueBisectPolicy), "invalid merge queue bisect policy '%s'", p.MergeQueueBisectPolicy)
+	return catcher.Resolve()
+}
+
 func (c *WorkstationConfig) ShouldGitClone() bool {
 	return utility.FromBoolPtr(c.GitClone)
 }
@@ -2202,6 +2270,9 @@ func SaveProjectPageForSection(ctx context.Context, projectId string, p *Project
 			projectRefRepotrackerDisabledKey:   p.RepotrackerDisabled,
 			projectRefPatchingDisabledKey:      p.PatchingDisabled,
 			ProjectRefDisabledStatsCacheKey:    p.DisabledStatsCache,
+			projectRefTestSelectionEnabledKey:  p.TestSelectionEnabled,
+			projectRefGitLabKey:                p.GitLab,
+			projectRefGitRemoteKey:             p.GitRemote,
 		}
 		// Unlike other fields, this will only be set if we're actually modifying it since it's used by the backend.
 		if p.TracksPushEvents != nil {
@@ -2776,10 +2847,31 @@ func (p *ProjectRef) ValidateOwnerAndRepo(validOrgs []string) error {
 	if p.Owner == "" || p.Repo == "" {
 		return errors.New("no owner/repo specified")
 	}
+	// The allowed GitHub organizations don't apply to projects that aren't
+	// in GitHub.
+	if !p.IsHostedInGitHub() {
+		return nil
+	}
 
 	return validateOwner(p.Owner, validOrgs)
 }
 
+// ValidateRepositoryHosting checks that the settings for projects that aren't
+// in GitHub are valid and that at most one of them is set.
+func (p *ProjectRef) ValidateRepositoryHosting() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(p.GitLab.Validate(), "invalid GitLab settings")
+	catcher.Wrap(p.GitRemote.Validate(), "invalid git remote settings")
+	catcher.NewWhen(p.GitLab.IsEnabled() && p.GitRemote.IsEnabled(), "cannot track a project from both GitLab and a git remote")
+	return catcher.Resolve()
+}
+
+// IsHostedInGitHub returns whether the project's repository is tracked through
+// GitHub, as opposed to GitLab or a plain git remote.
+func (p *ProjectRef) IsHostedInGitHub() bool {
+	return !p.GitLab.IsEnabled() && !p.GitRemote.IsEnabled()
+}
+
 func validateOwner(owner string, validOrgs []string) error {
 	if len(validOrgs) > 0 && !utility.StringSliceContains(validOrgs, owner) {
 		return errors.New("owner not authorized")
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/pkg/errors"
+)
+
+// GitRemoteSettings are the settings for a project whose repository is
+// tracked directly from a git remote (e.g. a Gerrit, cgit or internal git
+// server) instead of through a hosting service's API. Commits, changed files
+// and config files are read from a mirror clone of the remote.
+type GitRemoteSettings struct {
+	// URL is the URL of the git remote. It must be an https:// or ssh:// URL.
+	URL string `bson:"url,omitempty" json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+// IsEnabled returns whether the project's repository is tracked from a git
+// remote.
+func (s *GitRemoteSettings) IsEnabled() bool {
+	return s.URL != ""
+}
+
+// Mirror returns the mirror clone of the git remote.
+func (s *GitRemoteSettings) Mirror() *thirdparty.GitMirror {
+	return thirdparty.NewGitMirror(s.URL)
+}
+
+// Validate checks that the git remote settings are valid. Settings that are
+// entirely unset are valid. Only remote https:// and ssh:// URLs are allowed,
+// since the app server clones the remote; local paths and file:// URLs would
+// expose repositories on the app server's disk.
+func (s *GitRemoteSettings) Validate() error {
+	if !s.IsEnabled() {
+		return nil
+	}
+	if strings.HasPrefix(s.URL, "-") {
+		return errors.Errorf("git remote URL '%s' cannot begin with '-'", s.URL)
+	}
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		return errors.Wrapf(err, "parsing git remote URL '%s'", s.URL)
+	}
+	switch u.Scheme {
+	case "https", "ssh":
+	default:
+		return errors.Errorf("unsupported git remote scheme '%s', must be 'https' or 'ssh'", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return errors.Errorf("git remote URL '%s' must have a host", s.URL)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitRemoteSettingsValidate(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		url   string
+		valid bool
+	}{
+		"EmptySettingsAreValid":   {valid: true},
+		"HTTPSURLIsValid":         {url: "https://git.example.com/repo.git", valid: true},
+		"SSHURLIsValid":           {url: "ssh://git@git.example.com:29418/repo.git", valid: true},
+		"OptionIsInvalid":         {url: "--upload-pack=touch /tmp/pwned"},
+		"SCPLikeRemoteIsInvalid":  {url: "git@git.example.com:repo.git"},
+		"LocalPathIsInvalid":      {url: "/var/lib/repo.git"},
+		"FileURLIsInvalid":        {url: "file:///var/lib/repo.git"},
+		"GitURLIsInvalid":         {url: "git://git.example.com/repo.git"},
+		"URLWithoutHostIsInvalid": {url: "https:///repo.git"},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			err := (&GitRemoteSettings{URL: tCase.url}).Validate()
+			if tCase.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// GitLabSettings are the settings for a project whose repository is hosted in
+// GitLab. If they are set, the project's commits are tracked, its config is
+// read and its merge request patches are created from GitLab rather than from
+// GitHub.
+type GitLabSettings struct {
+	// URL is the base URL of the GitLab instance. If empty, the hosted GitLab
+	// service is used.
+	URL string `bson:"url,omitempty" json:"url,omitempty" yaml:"url,omitempty"`
+	// Owner is the group (including any subgroups) or user that the GitLab
+	// project belongs to.
+	Owner string `bson:"owner,omitempty" json:"owner,omitempty" yaml:"owner,omitempty"`
+	// Repo is the name of the GitLab project.
+	Repo string `bson:"repo,omitempty" json:"repo,omitempty" yaml:"repo,omitempty"`
+	// Token is the access token used to read the repository and report
+	// statuses. It needs the api scope.
+	Token string `bson:"token,omitempty" json:"token,omitempty" yaml:"token,omitempty"`
+	// WebhookSecret is the secret token configured on the GitLab project's
+	// webhook, which is used to verify incoming webhooks.
+	WebhookSecret string `bson:"webhook_secret,omitempty" json:"webhook_secret,omitempty" yaml:"webhook_secret,omitempty"`
+}
+
+var (
+	gitLabSettingsOwnerKey = bsonutil.MustHaveTag(GitLabSettings{}, "Owner")
+	gitLabSettingsRepoKey  = bsonutil.MustHaveTag(GitLabSettings{}, "Repo")
+)
+
+// IsEnabled returns whether the project's repository is hosted in GitLab.
+func (s *GitLabSettings) IsEnabled() bool {
+	return s.Owner != "" && s.Repo != ""
+}
+
+// ProjectPath returns the GitLab project's path with its namespace.
+func (s *GitLabSettings) ProjectPath() string {
+	return s.Owner + "/" + s.Repo
+}
+
+// Host returns the host name of the GitLab instance.
+func (s *GitLabSettings) Host() string {
+	if s.URL == "" {
+		return strings.TrimPrefix(thirdparty.DefaultGitLabURL, "https://")
+	}
+	u, err := url.Parse(s.URL)
+	if err != nil || u.Host == "" {
+		return s.URL
+	}
+	return u.Host
+}
+
+// Client returns a client for the GitLab instance that is authenticated with
+// the project's token.
+func (s *GitLabSettings) Client() *thirdparty.GitLabClient {
+	return thirdparty.NewGitLabClient(s.URL, s.Token)
+}
+
+// Validate checks that the GitLab settings are valid. Settings that are
+// entirely unset are valid.
+func (s *GitLabSettings) Validate() error {
+	if *s == (GitLabSettings{}) {
+		return nil
+	}
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(s.Owner == "", "GitLab owner must be specified")
+	catcher.NewWhen(s.Repo == "", "GitLab repo must be specified")
+	catcher.NewWhen(strings.Contains(s.Repo, "/"), "GitLab repo cannot contain a slash")
+	catcher.NewWhen(s.Token == "", "GitLab token must be specified")
+	if s.URL != "" {
+		u, err := url.Parse(s.URL)
+		catcher.Wrapf(err, "invalid GitLab URL '%s'", s.URL)
+		catcher.ErrorfWhen(err == nil && (u.Scheme == "" || u.Host == ""), "GitLab URL '%s' must be absolute", s.URL)
+	}
+
+	return catcher.Resolve()
+}
+
+// Unredact replaces secrets that were redacted when the settings were
+// returned to the user with their original values, so that saving settings
+// that were read back does not overwrite the secrets.
+func (s *GitLabSettings) Unredact(original GitLabSettings) {
+	if s.Token == evergreen.RedactedValue {
+		s.Token = original.Token
+	}
+	if s.WebhookSecret == evergreen.RedactedValue {
+		s.WebhookSecret = original.WebhookSecret
+	}
+}
+
+// SplitGitLabProjectPath splits a GitLab project path into the owner, which
+// may include subgroups, and the repo.
+func SplitGitLabProjectPath(projectPath string) (string, string, error) {
+	idx := strings.LastIndex(projectPath, "/")
+	if idx <= 0 || idx == len(projectPath)-1 {
+		return "", "", errors.Errorf("invalid GitLab project path '%s'", projectPath)
+	}
+	return projectPath[:idx], projectPath[idx+1:], nil
+}
+
+// FindMergedEnabledProjectRefsByGitLabProjectPath returns all enabled project
+// refs that track the GitLab project with the given path, with repo ref
+// information merged.
+func FindMergedEnabledProjectRefsByGitLabProjectPath(ctx context.Context, projectPath string) ([]ProjectRef, error) {
+	owner, repo, err := SplitGitLabProjectPath(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	return findProjectRefsQ(ctx, bson.M{
+		bsonutil.GetDottedKeyName(projectRefGitLabKey, gitLabSettingsOwnerKey): owner,
+		bsonutil.GetDottedKeyName(projectRefGitLabKey, gitLabSettingsRepoKey):  repo,
+		ProjectRefEnabledKey: true,
+	}, true)
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitLabSettingsValidate(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		settings GitLabSettings
+		valid    bool
+	}{
+		"EmptySettingsAreValid": {valid: true},
+		"CompleteSettingsAreValid": {
+			settings: GitLabSettings{URL: "https://gitlab.example.com", Owner: "group/subgroup", Repo: "repo", Token: "token"},
+			valid:    true,
+		},
+		"MissingTokenIsInvalid": {
+			settings: GitLabSettings{Owner: "group", Repo: "repo"},
+		},
+		"RepoWithSlashIsInvalid": {
+			settings: GitLabSettings{Owner: "group", Repo: "sub/repo", Token: "token"},
+		},
+		"RelativeURLIsInvalid": {
+			settings: GitLabSettings{URL: "gitlab.example.com", Owner: "group", Repo: "repo", Token: "token"},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			err := tCase.settings.Validate()
+			if tCase.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGitLabSettingsHost(t *testing.T) {
+	assert.Equal(t, "gitlab.com", (&GitLabSettings{}).Host())
+	assert.Equal(t, "gitlab.example.com", (&GitLabSettings{URL: "https://gitlab.example.com/"}).Host())
+}
+
+func TestGitLabSettingsUnredact(t *testing.T) {
+	original := GitLabSettings{Owner: "group", Repo: "repo", Token: "token", WebhookSecret: "secret"}
+	settings := GitLabSettings{Owner: "group", Repo: "repo", Token: evergreen.RedactedValue, WebhookSecret: "new_secret"}
+	settings.Unredact(original)
+	assert.Equal(t, "token", settings.Token)
+	assert.Equal(t, "new_secret", settings.WebhookSecret)
+}
+
+func TestSplitGitLabProjectPath(t *testing.T) {
+	owner, repo, err := SplitGitLabProjectPath("group/subgroup/repo")
+	require.NoError(t, err)
+	assert.Equal(t, "group/subgroup", owner)
+	assert.Equal(t, "repo", repo)
+
+	for _, path := range []string{"", "repo", "/repo", "group/"} {
+		_, _, err = SplitGitLabProjectPath(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestFindMergedEnabledProjectRefsByGitLabProjectPath(t *testing.T) {
+	require.NoError(t, db.ClearCollections(ProjectRefCollection, RepoRefCollection))
+	for _, pRef := range []ProjectRef{
+		{Id: "enabled", Enabled: true, GitLab: GitLabSettings{Owner: "group/subgroup", Repo: "repo"}},
+		{Id: "disabled", GitLab: GitLabSettings{Owner: "group/subgroup", Repo: "repo"}},
+		{Id: "other", Enabled: true, GitLab: GitLabSettings{Owner: "group", Repo: "repo"}},
+		{Id: "github", Enabled: true, Owner: "group/subgroup", Repo: "repo"},
+	} {
+		require.NoError(t, pRef.Insert())
+	}
+
+	pRefs, err := FindMergedEnabledProjectRefsByGitLabProjectPath(t.Context(), "group/subgroup/repo")
+	require.NoError(t, err)
+	require.Len(t, pRefs, 1)
+	assert.Equal(t, "enabled", pRefs[0].Id)
+}
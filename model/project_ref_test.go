@@ -2600,6 +2600,64 @@ func TestValidatePeriodicBuildDefinition(t *testing.T) {
 	}
 }
 
+func TestValidateMergeQueueBatching(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		params      CommitQueueParams
+		shouldError bool
+	}{
+		"NoBatching": {
+			params: CommitQueueParams{},
+		},
+		"BatchingWithDefaults": {
+			params: CommitQueueParams{MergeQueueBatchSize: 4},
+		},
+		"BatchingWithAllSettings": {
+			params: CommitQueueParams{
+				MergeQueueBatchSize:     8,
+				MergeQueueBatchWaitSecs: 60,
+				MergeQueueBisectPolicy:  patch.MergeQueueBisectPolicyNone,
+			},
+		},
+		"NegativeBatchSize": {
+			params:      CommitQueueParams{MergeQueueBatchSize: -1},
+			shouldError: true,
+		},
+		"BatchSizeTooLarge": {
+			params:      CommitQueueParams{MergeQueueBatchSize: maxMergeQueueBatchSize + 1},
+			shouldError: true,
+		},
+		"NegativeWait": {
+			params:      CommitQueueParams{MergeQueueBatchSize: 2, MergeQueueBatchWaitSecs: -1},
+			shouldError: true,
+		},
+		"InvalidBisectPolicy": {
+			params:      CommitQueueParams{MergeQueueBatchSize: 2, MergeQueueBisectPolicy: "linear"},
+			shouldError: true,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			err := tCase.params.ValidateMergeQueueBatching()
+			if tCase.shouldError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("Defaults", func(t *testing.T) {
+		params := CommitQueueParams{MergeQueueBatchSize: 1}
+		assert.False(t, params.ShouldBatchMergeQueue())
+		assert.True(t, params.ShouldBisectMergeQueue())
+		assert.Equal(t, defaultMergeQueueBatchWait, params.MergeQueueBatchWait())
+
+		params = CommitQueueParams{MergeQueueBatchSize: 2, MergeQueueBatchWaitSecs: 30, MergeQueueBisectPolicy: patch.MergeQueueBisectPolicyNone}
+		assert.True(t, params.ShouldBatchMergeQueue())
+		assert.False(t, params.ShouldBisectMergeQueue())
+		assert.Equal(t, 30*time.Second, params.MergeQueueBatchWait())
+	})
+}
+
 func TestContainerSecretValidate(t *testing.T) {
 	t.Run("FailsWithInvalidSecretType", func(t *testing.T) {
 		cs := ContainerSecret{
@@ -0,0 +1,329 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// Task template code
+//
+// Task templates are reusable task definitions. A template declares the
+// parameters it accepts, along with the commands, tags and dependencies of the
+// tasks that use it. A task instantiates a template with "template: <name>"
+// and passes arguments for the template's parameters in "args". Parameters are
+// referenced within the template as ${<parameter>}; any other ${...} is left
+// as-is so that it can be expanded as usual when the task runs.
+//
+// Templates are instantiated into regular tasks when the project is
+// translated, so everything after translation only sees plain tasks.
+
+const (
+	taskTemplateParameterString = "string"
+	taskTemplateParameterInt    = "int"
+	taskTemplateParameterBool   = "bool"
+)
+
+var validTaskTemplateParameterTypes = []string{"", taskTemplateParameterString, taskTemplateParameterInt, taskTemplateParameterBool}
+
+// taskTemplateParameterRegex matches a reference to a template parameter,
+// capturing the parameter name.
+var taskTemplateParameterRegex = regexp.MustCompile(`\$\{([^}|]+)\}`)
+
+// parserTaskTemplate is a reusable task definition that tasks can instantiate
+// with their own arguments.
+type parserTaskTemplate struct {
+	Name       string                  `yaml:"name,omitempty" bson:"name,omitempty"`
+	Parameters []taskTemplateParameter `yaml:"parameters,omitempty" bson:"parameters,omitempty"`
+	Commands   []PluginCommandConf     `yaml:"commands,omitempty" bson:"commands,omitempty"`
+	Tags       parserStringSlice       `yaml:"tags,omitempty" bson:"tags,omitempty"`
+	DependsOn  parserDependencies      `yaml:"depends_on,omitempty" bson:"depends_on,omitempty"`
+}
+
+// taskTemplateParameter is a parameter that a task template accepts.
+type taskTemplateParameter struct {
+	Name string `yaml:"name,omitempty" bson:"name,omitempty"`
+	// Type is the type of the parameter's value, which is one of "string",
+	// "int" or "bool". If not specified, it defaults to "string".
+	Type string `yaml:"type,omitempty" bson:"type,omitempty"`
+	// Default is the value of the parameter if the task doesn't pass an
+	// argument for it. Parameters without a default are required.
+	Default     *string `yaml:"default,omitempty" bson:"default,omitempty"`
+	Description string  `yaml:"description,omitempty" bson:"description,omitempty"`
+}
+
+// typedValue converts the value of the parameter into the parameter's type.
+func (p taskTemplateParameter) typedValue(value string) (any, error) {
+	switch p.Type {
+	case "", taskTemplateParameterString:
+		return value, nil
+	case taskTemplateParameterInt:
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, errors.Errorf("value '%s' for parameter '%s' is not an int", value, p.Name)
+		}
+		return i, nil
+	case taskTemplateParameterBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Errorf("value '%s' for parameter '%s' is not a bool", value, p.Name)
+		}
+		return b, nil
+	default:
+		return nil, errors.Errorf("parameter '%s' has invalid type '%s'", p.Name, p.Type)
+	}
+}
+
+// instantiateTaskTemplates returns the tasks with every task that uses a
+// template replaced by the template's definition, filled in with the task's
+// arguments. Tasks that don't use a template are returned unchanged.
+func instantiateTaskTemplates(templates []parserTaskTemplate, tasks []parserTask) ([]parserTask, []error) {
+	var errs []error
+	templatesByName := map[string]parserTaskTemplate{}
+	for _, tt := range templates {
+		if _, ok := templatesByName[tt.Name]; ok {
+			errs = append(errs, errors.Errorf("task template '%s' is defined multiple times", tt.Name))
+			continue
+		}
+		templatesByName[tt.Name] = tt
+		errs = append(errs, tt.validateParameters()...)
+	}
+	if len(errs) > 0 {
+		return tasks, errs
+	}
+
+	out := make([]parserTask, 0, len(tasks))
+	for _, pt := range tasks {
+		if pt.Template == "" {
+			if len(pt.TemplateArgs) > 0 {
+				errs = append(errs, errors.Errorf("task '%s' has template args but does not use a template", pt.Name))
+			}
+			out = append(out, pt)
+			continue
+		}
+		tt, ok := templatesByName[pt.Template]
+		if !ok {
+			errs = append(errs, errors.Errorf("task '%s' uses undefined task template '%s'", pt.Name, pt.Template))
+			continue
+		}
+		instantiated, err := tt.instantiate(pt)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "task '%s' using task template '%s'", pt.Name, pt.Template))
+			continue
+		}
+		out = append(out, instantiated)
+	}
+	return out, errs
+}
+
+// validateParameters checks that the template's parameters are well-formed.
+func (tt *parserTaskTemplate) validateParameters() []error {
+	var errs []error
+	if tt.Name == "" {
+		errs = append(errs, errors.New("task template must have a name"))
+	}
+	seen := map[string]bool{}
+	for _, p := range tt.Parameters {
+		if p.Name == "" {
+			errs = append(errs, errors.Errorf("task template '%s' has a parameter without a name", tt.Name))
+			continue
+		}
+		if seen[p.Name] {
+			errs = append(errs, errors.Errorf("task template '%s' declares parameter '%s' multiple times", tt.Name, p.Name))
+		}
+		seen[p.Name] = true
+		if !utility.StringSliceContains(validTaskTemplateParameterTypes, p.Type) {
+			errs = append(errs, errors.Errorf("task template '%s' parameter '%s' has invalid type '%s'", tt.Name, p.Name, p.Type))
+			continue
+		}
+		if p.Default != nil {
+			if _, err := p.typedValue(*p.Default); err != nil {
+				errs = append(errs, errors.Wrapf(err, "task template '%s' default", tt.Name))
+			}
+		}
+	}
+	return errs
+}
+
+// instantiate returns the task with the template's definition filled in with
+// the task's arguments.
+func (tt *parserTaskTemplate) instantiate(pt parserTask) (parserTask, error) {
+	if len(pt.Commands) > 0 {
+		return parserTask{}, errors.New("cannot define commands in a task that uses a template")
+	}
+
+	args, err := tt.resolveArgs(pt.TemplateArgs)
+	if err != nil {
+		return parserTask{}, err
+	}
+	s := taskTemplateSubstituter{args: args}
+
+	for _, cmd := range tt.Commands {
+		instantiated, err := s.command(cmd)
+		if err != nil {
+			return parserTask{}, errors.Wrapf(err, "instantiating command '%s'", cmd.GetDisplayName())
+		}
+		pt.Commands = append(pt.Commands, instantiated)
+	}
+
+	tags := make([]string, 0, len(tt.Tags)+len(pt.Tags))
+	for _, tag := range tt.Tags {
+		tags = append(tags, s.string(tag))
+	}
+	pt.Tags = utility.UniqueStrings(append(tags, pt.Tags...))
+
+	dependsOn := make(parserDependencies, 0, len(tt.DependsOn)+len(pt.DependsOn))
+	for _, d := range tt.DependsOn {
+		d.Status = s.string(d.Status)
+		d.TaskSelector.Name = s.string(d.TaskSelector.Name)
+		if d.TaskSelector.Variant != nil && d.TaskSelector.Variant.StringSelector != "" {
+			d.TaskSelector.Variant = &variantSelector{StringSelector: s.string(d.TaskSelector.Variant.StringSelector)}
+		}
+		dependsOn = append(dependsOn, d)
+	}
+	pt.DependsOn = append(dependsOn, pt.DependsOn...)
+
+	return pt, nil
+}
+
+// resolveArgs returns the typed value of each of the template's parameters,
+// using the defaults for the parameters without arguments.
+func (tt *parserTaskTemplate) resolveArgs(rawArgs map[string]string) (map[string]any, error) {
+	params := map[string]taskTemplateParameter{}
+	for _, p := range tt.Parameters {
+		params[p.Name] = p
+	}
+
+	var errs []string
+	var unknownArgs []string
+	for name := range rawArgs {
+		if _, ok := params[name]; !ok {
+			unknownArgs = append(unknownArgs, name)
+		}
+	}
+	sort.Strings(unknownArgs)
+	for _, name := range unknownArgs {
+		errs = append(errs, fmt.Sprintf("template has no parameter '%s'", name))
+	}
+
+	args := map[string]any{}
+	for _, p := range tt.Parameters {
+		raw, ok := rawArgs[p.Name]
+		if !ok {
+			if p.Default == nil {
+				errs = append(errs, fmt.Sprintf("missing argument for required parameter '%s'", p.Name))
+				continue
+			}
+			raw = *p.Default
+		}
+		value, err := p.typedValue(raw)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		args[p.Name] = value
+	}
+	if len(errs) > 0 {
+		return nil, errors.Errorf("invalid template args: %s", strings.Join(errs, "; "))
+	}
+	return args, nil
+}
+
+// taskTemplateSubstituter replaces references to template parameters with
+// their arguments.
+type taskTemplateSubstituter struct {
+	args map[string]any
+}
+
+// string replaces all the parameter references in the string.
+func (s taskTemplateSubstituter) string(in string) string {
+	return taskTemplateParameterRegex.ReplaceAllStringFunc(in, func(match string) string {
+		name := taskTemplateParameterRegex.FindStringSubmatch(match)[1]
+		value, ok := s.args[name]
+		if !ok {
+			return match
+		}
+		return fmt.Sprint(value)
+	})
+}
+
+// value replaces all the parameter references in an arbitrary command
+// parameter value. A string that only references a single parameter is
+// replaced by the argument's typed value.
+func (s taskTemplateSubstituter) value(in any) any {
+	switch v := in.(type) {
+	case string:
+		if m := taskTemplateParameterRegex.FindStringSubmatch(v); m != nil && m[0] == v {
+			if value, ok := s.args[m[1]]; ok {
+				return value
+			}
+		}
+		return s.string(v)
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, val := range v {
+			out[s.string(key)] = s.value(val)
+		}
+		return out
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, val := range v {
+			out = append(out, s.value(val))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// command returns a copy of the command with all of the parameter references
+// replaced.
+func (s taskTemplateSubstituter) command(cmd PluginCommandConf) (PluginCommandConf, error) {
+	if err := cmd.resolveParams(); err != nil {
+		return PluginCommandConf{}, err
+	}
+
+	cmd.Function = s.string(cmd.Function)
+	cmd.Command = s.string(cmd.Command)
+	cmd.DisplayName = s.string(cmd.DisplayName)
+	cmd.Type = s.string(cmd.Type)
+	cmd.If = s.string(cmd.If)
+	if cmd.Retry != nil {
+		retry := *cmd.Retry
+		retry.OnOutputRegex = s.string(retry.OnOutputRegex)
+		cmd.Retry = &retry
+	}
+
+	if len(cmd.Variants) > 0 {
+		variants := make([]string, 0, len(cmd.Variants))
+		for _, v := range cmd.Variants {
+			variants = append(variants, s.string(v))
+		}
+		cmd.Variants = variants
+	}
+
+	if cmd.Vars != nil {
+		vars := make(map[string]string, len(cmd.Vars))
+		for key, val := range cmd.Vars {
+			vars[s.string(key)] = s.string(val)
+		}
+		cmd.Vars = vars
+	}
+
+	if cmd.Params != nil {
+		cmd.Params = s.value(cmd.Params).(map[string]any)
+		// The params must be marshalled again so that the stored params
+		// reflect the arguments.
+		cmd.ParamsYAML = ""
+		if err := cmd.unmarshalParams(); err != nil {
+			return PluginCommandConf{}, err
+		}
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,154 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateProjectWithTaskTemplates(t *testing.T) {
+	const templates = `
+task_templates:
+  - name: unit_test
+    parameters:
+      - name: suite
+      - name: jobs
+        type: int
+        default: 4
+      - name: verbose
+        type: bool
+        default: false
+    tags: ["unit", "${suite}"]
+    depends_on:
+      - name: compile_${suite}
+    commands:
+      - func: setup
+      - command: shell.exec
+        display_name: run ${suite}
+        params:
+          working_dir: ${workdir}/src
+          script: ./run_tests.sh --suite=${suite} --jobs=${jobs}
+          verbose: ${verbose}
+functions:
+  setup:
+    command: shell.exec
+    params:
+      script: echo setup
+`
+	t.Run("InstantiatesTemplate", func(t *testing.T) {
+		yml := templates + `
+tasks:
+  - name: compile_storage
+  - name: storage_test
+    template: unit_test
+    args:
+      suite: storage
+      verbose: true
+    tags: ["slow"]
+    exec_timeout_secs: 60
+buildvariants:
+  - name: bv
+    tasks: [compile_storage, storage_test]
+`
+		pp, err := createIntermediateProject([]byte(yml), true)
+		require.NoError(t, err)
+		p, err := TranslateProject(pp)
+		require.NoError(t, err)
+
+		task := p.FindProjectTask("storage_test")
+		require.NotNil(t, task)
+		assert.Equal(t, 60, task.ExecTimeoutSecs)
+		assert.ElementsMatch(t, []string{"unit", "storage", "slow"}, task.Tags)
+		require.Len(t, task.DependsOn, 1)
+		assert.Equal(t, "compile_storage", task.DependsOn[0].Name)
+
+		require.Len(t, task.Commands, 2)
+		assert.Equal(t, "setup", task.Commands[0].Function)
+		cmd := task.Commands[1]
+		assert.Equal(t, "run storage", cmd.DisplayName)
+		assert.Equal(t, "${workdir}/src", cmd.Params["working_dir"], "expansions that aren't template parameters should be left as-is")
+		assert.Equal(t, "./run_tests.sh --suite=storage --jobs=4", cmd.Params["script"])
+		assert.Equal(t, true, cmd.Params["verbose"], "a value that only references a parameter should have the parameter's type")
+		assert.Contains(t, cmd.ParamsYAML, "suite=storage")
+
+		assert.Empty(t, pp.Tasks[1].Commands, "parser project should not be modified")
+	})
+	for tName, tCase := range map[string]struct {
+		tasks       string
+		errContains string
+	}{
+		"MissingRequiredParameter": {
+			tasks: `
+  - name: storage_test
+    template: unit_test`,
+			errContains: "missing argument for required parameter 'suite'",
+		},
+		"ExtraParameter": {
+			tasks: `
+  - name: storage_test
+    template: unit_test
+    args:
+      suite: storage
+      shards: 3`,
+			errContains: "template has no parameter 'shards'",
+		},
+		"WrongParameterType": {
+			tasks: `
+  - name: storage_test
+    template: unit_test
+    args:
+      suite: storage
+      jobs: many`,
+			errContains: "value 'many' for parameter 'jobs' is not an int",
+		},
+		"UndefinedTemplate": {
+			tasks: `
+  - name: storage_test
+    template: integration_test`,
+			errContains: "task 'storage_test' uses undefined task template 'integration_test'",
+		},
+		"TaskDefinesCommands": {
+			tasks: `
+  - name: storage_test
+    template: unit_test
+    args:
+      suite: storage
+    commands:
+      - func: setup`,
+			errContains: "cannot define commands in a task that uses a template",
+		},
+		"ArgsWithoutTemplate": {
+			tasks: `
+  - name: storage_test
+    args:
+      suite: storage`,
+			errContains: "task 'storage_test' has template args but does not use a template",
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			pp, err := createIntermediateProject([]byte(templates+"tasks:"+tCase.tasks), true)
+			require.NoError(t, err)
+			_, err = TranslateProject(pp)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tCase.errContains)
+		})
+	}
+	t.Run("InvalidParameterType", func(t *testing.T) {
+		yml := `
+task_templates:
+  - name: unit_test
+    parameters:
+      - name: suite
+        type: list
+tasks:
+  - name: storage_test
+    template: unit_test
+`
+		pp, err := createIntermediateProject([]byte(yml), true)
+		require.NoError(t, err)
+		_, err = TranslateProject(pp)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "parameter 'suite' has invalid type 'list'")
+	})
+}
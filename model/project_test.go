@@ -2695,3 +2695,38 @@ patch_aliases:
 		s.NotEqual("other-variant", pair.Variant)
 	}
 }
+
+func TestCommandRetryPolicy(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, (&CommandRetryPolicy{Attempts: 3, BackoffSecs: 10, OnExitCodes: []int{1, 128}, OnOutputRegex: "connection (reset|refused)"}).Validate())
+		assert.NoError(t, (&CommandRetryPolicy{Attempts: MaxCommandRetryAttempts}).Validate())
+		assert.Error(t, (&CommandRetryPolicy{}).Validate(), "should require at least one attempt")
+		assert.Error(t, (&CommandRetryPolicy{Attempts: MaxCommandRetryAttempts + 1}).Validate())
+		assert.Error(t, (&CommandRetryPolicy{Attempts: 2, BackoffSecs: -1}).Validate())
+		assert.Error(t, (&CommandRetryPolicy{Attempts: 2, BackoffSecs: int(MaxCommandRetryBackoff.Seconds()) + 1}).Validate())
+		assert.Error(t, (&CommandRetryPolicy{Attempts: 2, OnExitCodes: []int{0}}).Validate(), "should not retry on success")
+		assert.Error(t, (&CommandRetryPolicy{Attempts: 2, OnOutputRegex: "("}).Validate())
+	})
+	t.Run("BackoffDelay", func(t *testing.T) {
+		p := CommandRetryPolicy{Attempts: 10, BackoffSecs: 30}
+		assert.Equal(t, 30*time.Second, p.BackoffDelay(1))
+		assert.Equal(t, 60*time.Second, p.BackoffDelay(2))
+		assert.Equal(t, 120*time.Second, p.BackoffDelay(3))
+		assert.Equal(t, MaxCommandRetryBackoff, p.BackoffDelay(9))
+		assert.Zero(t, (&CommandRetryPolicy{Attempts: 2}).BackoffDelay(1))
+	})
+	t.Run("UnmarshalYAML", func(t *testing.T) {
+		var cmd PluginCommandConf
+		require.NoError(t, yaml.Unmarshal([]byte(`
+command: s3.get
+retry:
+  attempts: 4
+  backoff: 15
+  on_output_regex: "503 Service Unavailable"
+`), &cmd))
+		require.NotZero(t, cmd.Retry)
+		assert.Equal(t, 4, cmd.Retry.Attempts)
+		assert.Equal(t, 15, cmd.Retry.BackoffSecs)
+		assert.Equal(t, "503 Service Unavailable", cmd.Retry.OnOutputRegex)
+	})
+}
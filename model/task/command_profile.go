@@ -0,0 +1,97 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CommandProfilesCollection is the collection of the command profiles that
+// tasks record. Profiles are stored separately from the task document so that
+// tasks that run many commands don't grow without bound.
+const CommandProfilesCollection = "task_command_profiles"
+
+// CommandProfile records when a command ran in a task and the resources that
+// the processes it started used while it was running.
+type CommandProfile struct {
+	// TaskID and Execution are the task execution that ran the command.
+	TaskID    string `bson:"task_id" json:"task_id,omitempty"`
+	Execution int    `bson:"execution" json:"execution,omitempty"`
+	// Command is the full display name of the command.
+	Command string `bson:"command" json:"command"`
+	// Block is the block of the task that the command ran in (e.g. pre), or
+	// empty if it ran in the main task block.
+	Block string    `bson:"block,omitempty" json:"block,omitempty"`
+	Start time.Time `bson:"start" json:"start"`
+	End   time.Time `bson:"end" json:"end"`
+	// PeakRSSBytes is the largest combined resident set size of the
+	// command's processes that was observed while it ran.
+	PeakRSSBytes int64 `bson:"peak_rss_bytes" json:"peak_rss_bytes"`
+	// CPUSeconds is the total user and system CPU time of the command's
+	// processes.
+	CPUSeconds float64 `bson:"cpu_seconds" json:"cpu_seconds"`
+	// DiskReadBytes and DiskWriteBytes are the total bytes that the
+	// command's processes read from and wrote to disk.
+	DiskReadBytes  int64 `bson:"disk_read_bytes" json:"disk_read_bytes"`
+	DiskWriteBytes int64 `bson:"disk_write_bytes" json:"disk_write_bytes"`
+}
+
+var (
+	CommandProfileTaskIDKey    = bsonutil.MustHaveTag(CommandProfile{}, "TaskID")
+	CommandProfileExecutionKey = bsonutil.MustHaveTag(CommandProfile{}, "Execution")
+	CommandProfileEndKey       = bsonutil.MustHaveTag(CommandProfile{}, "End")
+)
+
+// Duration returns how long the command ran for.
+func (p CommandProfile) Duration() time.Duration {
+	if p.End.Before(p.Start) {
+		return 0
+	}
+	return p.End.Sub(p.Start)
+}
+
+// Validate checks that the command profile is complete.
+func (p CommandProfile) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(p.Command == "", "command name must be specified")
+	catcher.NewWhen(utility.IsZeroTime(p.Start), "start time must be specified")
+	catcher.NewWhen(p.End.Before(p.Start), "end time cannot be before start time")
+	catcher.NewWhen(p.PeakRSSBytes < 0, "peak RSS cannot be negative")
+	catcher.NewWhen(p.CPUSeconds < 0, "CPU time cannot be negative")
+	catcher.NewWhen(p.DiskReadBytes < 0 || p.DiskWriteBytes < 0, "disk I/O cannot be negative")
+	return catcher.Resolve()
+}
+
+// AddCommandProfile records the profile of a command that finished running in
+// the task's current execution.
+func (t *Task) AddCommandProfile(ctx context.Context, profile CommandProfile) error {
+	if err := profile.Validate(); err != nil {
+		return errors.Wrap(err, "invalid command profile")
+	}
+	profile.TaskID = t.Id
+	profile.Execution = t.Execution
+	if err := db.Insert(CommandProfilesCollection, profile); err != nil {
+		return errors.Wrapf(err, "adding profile of command '%s'", profile.Command)
+	}
+	return nil
+}
+
+// FindCommandProfiles returns the profiles of the commands that ran in the
+// given task execution, in the order they finished.
+func FindCommandProfiles(ctx context.Context, taskID string, execution int) ([]CommandProfile, error) {
+	profiles := []CommandProfile{}
+	q := db.Query(bson.M{
+		CommandProfileTaskIDKey:    taskID,
+		CommandProfileExecutionKey: execution,
+	}).Sort([]string{CommandProfileEndKey})
+	if err := db.FindAllQContext(ctx, CommandProfilesCollection, q, &profiles); err != nil {
+		return nil, errors.Wrapf(err, "finding command profiles for task '%s' execution %d", taskID, execution)
+	}
+	return profiles, nil
+}
@@ -0,0 +1,85 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddCommandProfile(t *testing.T) {
+	start := time.Now().Round(time.Millisecond)
+	profile := CommandProfile{
+		Command:        "'shell.exec' in function 'compile'",
+		Block:          "main",
+		Start:          start,
+		End:            start.Add(time.Minute),
+		PeakRSSBytes:   1024,
+		CPUSeconds:     1.5,
+		DiskReadBytes:  2048,
+		DiskWriteBytes: 4096,
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, tsk *Task){
+		"StoresProfilesInOrderTheyFinished": func(t *testing.T, tsk *Task) {
+			next := profile
+			next.Command = "'attach.results'"
+			next.Start = profile.End
+			next.End = profile.End.Add(time.Second)
+			require.NoError(t, tsk.AddCommandProfile(t.Context(), next))
+			require.NoError(t, tsk.AddCommandProfile(t.Context(), profile))
+
+			profiles, err := FindCommandProfiles(t.Context(), tsk.Id, tsk.Execution)
+			require.NoError(t, err)
+			require.Len(t, profiles, 2)
+			assert.Equal(t, profile.Command, profiles[0].Command)
+			assert.Equal(t, tsk.Id, profiles[0].TaskID)
+			assert.Equal(t, tsk.Execution, profiles[0].Execution)
+			assert.Equal(t, profile.PeakRSSBytes, profiles[0].PeakRSSBytes)
+			assert.Equal(t, profile.CPUSeconds, profiles[0].CPUSeconds)
+			assert.Equal(t, time.Minute, profiles[0].Duration())
+			assert.Equal(t, next.Command, profiles[1].Command)
+		},
+		"SeparatesProfilesByExecution": func(t *testing.T, tsk *Task) {
+			require.NoError(t, tsk.AddCommandProfile(t.Context(), profile))
+			tsk.Execution++
+			next := profile
+			next.Command = "'attach.results'"
+			require.NoError(t, tsk.AddCommandProfile(t.Context(), next))
+
+			profiles, err := FindCommandProfiles(t.Context(), tsk.Id, 0)
+			require.NoError(t, err)
+			require.Len(t, profiles, 1)
+			assert.Equal(t, profile.Command, profiles[0].Command)
+
+			profiles, err = FindCommandProfiles(t.Context(), tsk.Id, 1)
+			require.NoError(t, err)
+			require.Len(t, profiles, 1)
+			assert.Equal(t, next.Command, profiles[0].Command)
+		},
+		"FailsWithInvalidProfile": func(t *testing.T, tsk *Task) {
+			invalid := profile
+			invalid.End = profile.Start.Add(-time.Second)
+			assert.Error(t, tsk.AddCommandProfile(t.Context(), invalid))
+
+			profiles, err := FindCommandProfiles(t.Context(), tsk.Id, tsk.Execution)
+			require.NoError(t, err)
+			assert.Empty(t, profiles)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(Collection, CommandProfilesCollection))
+
+			tsk := &Task{
+				Id:        "task_id",
+				Status:    evergreen.TaskStarted,
+				StartTime: time.Now(),
+			}
+			require.NoError(t, tsk.Insert())
+			tCase(t, tsk)
+		})
+	}
+}
@@ -124,6 +124,12 @@ var (
 	HasAnnotationsKey             = bsonutil.MustHaveTag(Task{}, "HasAnnotations")
 	NumNextTaskDispatchesKey      = bsonutil.MustHaveTag(Task{}, "NumNextTaskDispatches")
 	CachedProjectStorageMethodKey = bsonutil.MustHaveTag(Task{}, "CachedProjectStorageMethod")
+	CacheableKey                  = bsonutil.MustHaveTag(Task{}, "Cacheable")
+	CacheKeyKey                   = bsonutil.MustHaveTag(Task{}, "CacheKey")
+	CachedFromTaskIdKey           = bsonutil.MustHaveTag(Task{}, "CachedFromTaskId")
+	CachedFromExecutionKey        = bsonutil.MustHaveTag(Task{}, "CachedFromExecution")
+	ResumedFromStepKey            = bsonutil.MustHaveTag(Task{}, "ResumedFromStep")
+	ResumedFromExecutionKey       = bsonutil.MustHaveTag(Task{}, "ResumedFromExecution")
 )
 
 var (
@@ -1312,6 +1318,18 @@ func FindOneId(ctx context.Context, id string) (*Task, error) {
 	return task, errors.Wrap(err, "finding task by ID")
 }
 
+// FindSucceededByCacheKey returns the most recently finished successful task
+// in the project with the given cache key.
+func FindSucceededByCacheKey(ctx context.Context, project, cacheKey string) (*Task, error) {
+	query := db.Query(bson.M{
+		ProjectKey:  project,
+		CacheKeyKey: cacheKey,
+		StatusKey:   evergreen.TaskSucceeded,
+	}).Sort([]string{"-" + FinishTimeKey})
+	task, err := FindOne(ctx, query)
+	return task, errors.Wrap(err, "finding successful task by cache key")
+}
+
 // FindByIdExecution returns a single task with the given ID and execution. If
 // execution is nil, the latest execution is returned.
 func FindByIdExecution(ctx context.Context, id string, execution *int) (*Task, error) {
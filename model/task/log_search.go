@@ -0,0 +1,153 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// MaxLogSearchTasks is the maximum number of tasks whose logs are searched
+// when searching the logs of a build variant.
+const MaxLogSearchTasks = 1000
+
+// FindLogSearchTasksByVersion returns the tasks in the version whose logs can
+// be searched, sorted by build variant and display name.
+func FindLogSearchTasksByVersion(ctx context.Context, versionID string) ([]Task, error) {
+	return FindWithSort(ctx, ByVersion(versionID), []string{BuildVariantKey, DisplayNameKey})
+}
+
+// FindLogSearchTasksByVariant returns the most recent tasks, up to
+// MaxLogSearchTasks, created since the given time for mainline commits of the
+// project's build variant. Tasks are sorted by creation time, newest first.
+func FindLogSearchTasksByVariant(ctx context.Context, projectID, buildVariant string, since time.Time) ([]Task, error) {
+	query := db.Query(bson.M{
+		ProjectKey:      projectID,
+		BuildVariantKey: buildVariant,
+		RequesterKey:    bson.M{"$in": evergreen.SystemVersionRequesterTypes},
+		CreateTimeKey:   bson.M{"$gte": since},
+		StatusKey:       bson.M{"$ne": evergreen.TaskUndispatched},
+		DisplayOnlyKey:  bson.M{"$ne": true},
+	}).Sort([]string{"-" + CreateTimeKey, DisplayNameKey}).Limit(MaxLogSearchTasks)
+
+	return FindAll(ctx, query)
+}
+
+// LogSearchMatch is a line in a task's logs that matches a search.
+type LogSearchMatch struct {
+	taskoutput.LogSearchMatch
+	// TaskID is the ID of the task whose logs contain the line.
+	TaskID string
+	// Execution is the execution of the task whose logs contain the line.
+	Execution int
+}
+
+// LogSearchIterator iterates over the lines of the logs of a set of tasks that
+// match a search, one task at a time.
+type LogSearchIterator struct {
+	ctx     context.Context
+	tasks   []Task
+	opts    taskoutput.LogSearchOptions
+	catcher grip.Catcher
+
+	current    *taskoutput.LogSearchIterator
+	currentTsk *Task
+	matchCount int
+	item       LogSearchMatch
+	closed     bool
+}
+
+// NewLogSearchIterator returns an iterator over the lines of the given tasks'
+// logs that match the given options. The tasks' logs are searched in order,
+// and the search limit applies to the matches across all of the tasks.
+func NewLogSearchIterator(ctx context.Context, tasks []Task, searchOpts taskoutput.LogSearchOptions) (*LogSearchIterator, error) {
+	if err := searchOpts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid search options")
+	}
+
+	return &LogSearchIterator{
+		ctx:     ctx,
+		tasks:   tasks,
+		opts:    searchOpts,
+		catcher: grip.NewBasicCatcher(),
+	}, nil
+}
+
+// Next returns true if the iterator has another matching line, false
+// otherwise.
+func (it *LogSearchIterator) Next() bool {
+	if it.closed || it.catcher.HasErrors() {
+		return false
+	}
+
+	for {
+		if it.current == nil {
+			if len(it.tasks) == 0 {
+				return false
+			}
+
+			opts := it.opts
+			if opts.Limit > 0 {
+				if opts.Limit -= it.matchCount; opts.Limit <= 0 {
+					return false
+				}
+			}
+
+			it.currentTsk = &it.tasks[0]
+			it.tasks = it.tasks[1:]
+			current, err := it.currentTsk.SearchLogs(it.ctx, opts)
+			if err != nil {
+				it.catcher.Wrapf(err, "searching logs for task '%s'", it.currentTsk.Id)
+				return false
+			}
+			it.current = current
+		}
+
+		if it.current.Next() {
+			taskID := it.currentTsk.Id
+			if it.currentTsk.Archived {
+				taskID = it.currentTsk.OldTaskId
+			}
+			it.item = LogSearchMatch{
+				LogSearchMatch: it.current.Item(),
+				TaskID:         taskID,
+				Execution:      it.currentTsk.Execution,
+			}
+			it.matchCount++
+
+			return true
+		}
+
+		it.catcher.Wrapf(it.current.Err(), "searching logs for task '%s'", it.currentTsk.Id)
+		it.catcher.Add(it.current.Close())
+		it.current = nil
+		if it.catcher.HasErrors() {
+			return false
+		}
+	}
+}
+
+// Item returns the current matching line held by the iterator.
+func (it *LogSearchIterator) Item() LogSearchMatch { return it.item }
+
+// Err returns any errors captured by the iterator.
+func (it *LogSearchIterator) Err() error { return it.catcher.Resolve() }
+
+// Close closes the iterator.
+func (it *LogSearchIterator) Close() error {
+	if it.closed {
+		return nil
+	}
+	it.closed = true
+
+	if it.current != nil {
+		return it.current.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/parsley"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindLogSearchTasks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, db.ClearCollections(Collection))
+
+	now := time.Now()
+	tasks := []Task{
+		{Id: "t0", Version: "v0", Project: "p", BuildVariant: "bv1", DisplayName: "b", Requester: evergreen.RepotrackerVersionRequester, Status: evergreen.TaskFailed, CreateTime: now},
+		{Id: "t1", Version: "v0", Project: "p", BuildVariant: "bv0", DisplayName: "a", Requester: evergreen.RepotrackerVersionRequester, Status: evergreen.TaskSucceeded, CreateTime: now},
+		{Id: "dt", Version: "v0", Project: "p", BuildVariant: "bv0", DisplayName: "display", Requester: evergreen.RepotrackerVersionRequester, DisplayOnly: true, CreateTime: now},
+		{Id: "t2", Version: "v1", Project: "p", BuildVariant: "bv0", DisplayName: "a", Requester: evergreen.RepotrackerVersionRequester, Status: evergreen.TaskSucceeded, CreateTime: now.Add(-time.Hour)},
+		{Id: "t3", Version: "v2", Project: "p", BuildVariant: "bv0", DisplayName: "a", Requester: evergreen.RepotrackerVersionRequester, Status: evergreen.TaskSucceeded, CreateTime: now.Add(-72 * time.Hour)},
+		{Id: "t4", Version: "patch", Project: "p", BuildVariant: "bv0", DisplayName: "a", Requester: evergreen.PatchVersionRequester, Status: evergreen.TaskSucceeded, CreateTime: now},
+		{Id: "t5", Version: "v3", Project: "p", BuildVariant: "bv0", DisplayName: "a", Requester: evergreen.RepotrackerVersionRequester, Status: evergreen.TaskUndispatched, CreateTime: now},
+	}
+	for _, tsk := range tasks {
+		require.NoError(t, tsk.Insert())
+	}
+
+	t.Run("ByVersion", func(t *testing.T) {
+		found, err := FindLogSearchTasksByVersion(ctx, "v0")
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		assert.Equal(t, "t1", found[0].Id)
+		assert.Equal(t, "t0", found[1].Id)
+	})
+	t.Run("ByVariant", func(t *testing.T) {
+		found, err := FindLogSearchTasksByVariant(ctx, "p", "bv0", now.Add(-24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		assert.Equal(t, "t1", found[0].Id)
+		assert.Equal(t, "t2", found[1].Id)
+	})
+}
+
+func TestLogSearchIterator(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketConfig := evergreen.BucketConfig{Type: evergreen.BucketTypeFilesystem, Name: t.TempDir()}
+	output := &taskoutput.TaskOutput{
+		TaskLogs: taskoutput.TaskLogOutput{Version: 1, BucketConfig: bucketConfig},
+		TestLogs: taskoutput.TestLogOutput{Version: 1, BucketConfig: bucketConfig},
+	}
+	tasks := []Task{
+		{Id: "t0", Project: "p", Execution: 1, Status: evergreen.TaskFailed, TaskOutputInfo: output},
+		{Id: "t1", Project: "p", Status: evergreen.TaskUndispatched, TaskOutputInfo: output},
+		{Id: "t2", Project: "p", Status: evergreen.TaskFailed, TaskOutputInfo: output},
+	}
+	ts := time.Now().UnixNano()
+	for _, tsk := range tasks {
+		require.NoError(t, output.TaskLogs.Append(ctx, taskoutput.TaskOptions{ProjectID: tsk.Project, TaskID: tsk.Id, Execution: tsk.Execution}, taskoutput.TaskLogTypeTask, []log.LogLine{
+			{Priority: level.Info, Timestamp: ts, Data: "running " + tsk.Id},
+			{Priority: level.Error, Timestamp: ts + 1, Data: "segfault in " + tsk.Id},
+		}))
+	}
+	filter := parsley.Filter{Expression: "segfault", ExactMatch: true}
+
+	search := func(t *testing.T, opts taskoutput.LogSearchOptions) []LogSearchMatch {
+		it, err := NewLogSearchIterator(ctx, tasks, opts)
+		require.NoError(t, err)
+
+		var matches []LogSearchMatch
+		for it.Next() {
+			matches = append(matches, it.Item())
+		}
+		require.NoError(t, it.Err())
+		assert.NoError(t, it.Close())
+
+		return matches
+	}
+
+	t.Run("SearchesEachTask", func(t *testing.T) {
+		matches := search(t, taskoutput.LogSearchOptions{Filter: filter})
+		require.Len(t, matches, 2)
+		assert.Equal(t, "t0", matches[0].TaskID)
+		assert.Equal(t, 1, matches[0].Execution)
+		assert.Equal(t, "segfault in t0", matches[0].Data)
+		assert.Equal(t, 1, matches[0].LineNumber)
+		assert.Equal(t, taskoutput.TaskLogTypeTask, matches[0].LogType)
+		assert.Equal(t, "t2", matches[1].TaskID)
+		assert.Equal(t, 0, matches[1].Execution)
+	})
+	t.Run("LimitAcrossTasks", func(t *testing.T) {
+		matches := search(t, taskoutput.LogSearchOptions{Filter: filter, Limit: 1})
+		require.Len(t, matches, 1)
+		assert.Equal(t, "t0", matches[0].TaskID)
+	})
+	t.Run("FailsWithInvalidOptions", func(t *testing.T) {
+		_, err := NewLogSearchIterator(ctx, tasks, taskoutput.LogSearchOptions{})
+		assert.Error(t, err)
+	})
+}
@@ -337,6 +337,22 @@ type Task struct {
 	// CachedProjectStorageMethod is a cached value how the parser project for this task's version was
 	// stored at the time this task was created. If this is empty, the default storage method is StorageMethodDB.
 	CachedProjectStorageMethod evergreen.ParserProjectStorageMethod `bson:"cached_project_storage_method" json:"cached_project_storage_method,omitempty"`
+
+	// Cacheable indicates that the task can reuse the results of a previous
+	// successful task with the same cache key instead of running.
+	Cacheable bool `bson:"cacheable,omitempty" json:"cacheable,omitempty"`
+	// CacheKey is the hash of the task's inputs. It's computed when the task
+	// is ready to be scheduled, so it's empty until then.
+	CacheKey string `bson:"cache_key,omitempty" json:"cache_key,omitempty"`
+	// CachedFromTaskId and CachedFromExecution identify the task execution
+	// that this task reused the results of, if it succeeded from the cache.
+	CachedFromTaskId    string `bson:"cached_from_task_id,omitempty" json:"cached_from_task_id,omitempty"`
+	CachedFromExecution int    `bson:"cached_from_execution,omitempty" json:"cached_from_execution,omitempty"`
+	// ResumedFromStep and ResumedFromExecution identify the checkpoint that
+	// this execution resumed from, if the previous execution's host was lost
+	// after it saved a checkpoint.
+	ResumedFromStep      string `bson:"resumed_from_step,omitempty" json:"resumed_from_step,omitempty"`
+	ResumedFromExecution int    `bson:"resumed_from_execution,omitempty" json:"resumed_from_execution,omitempty"`
 }
 
 // GeneratedJSONFiles represent files used by a task for generate.tasks to update the project YAML.
@@ -413,6 +429,8 @@ type ContainerOptions struct {
 	OS             evergreen.ContainerOS    `bson:"os,omitempty" json:"os"`
 	Arch           evergreen.ContainerArch  `bson:"arch,omitempty" json:"arch"`
 	WindowsVersion evergreen.WindowsVersion `bson:"windows_version,omitempty" json:"windows_version"`
+	// Pool is the ID of the container pool that should run the container.
+	Pool string `bson:"pool,omitempty" json:"pool,omitempty"`
 }
 
 // IsZero implements the bsoncodec.Zeroer interface for the sake of defining the
@@ -1849,6 +1867,62 @@ func (t *Task) GetTestLogs(ctx context.Context, getOpts taskoutput.TestLogGetOpt
 	return output.TestLogs.Get(ctx, taskOpts, getOpts)
 }
 
+// SearchLogs returns the lines of the task's task and test logs that match the
+// given options.
+func (t *Task) SearchLogs(ctx context.Context, searchOpts taskoutput.LogSearchOptions) (*taskoutput.LogSearchIterator, error) {
+	if t.DisplayOnly {
+		return nil, errors.New("cannot search logs for a display task")
+	}
+
+	output, ok := t.getTaskOutputSafe()
+	if !ok {
+		// We know there task cannot have task output, likely because
+		// it has not run yet. Return an empty iterator.
+		return taskoutput.EmptyLogSearchIterator(), nil
+	}
+
+	taskID := t.Id
+	if t.Archived {
+		taskID = t.OldTaskId
+	}
+	taskOpts := taskoutput.TaskOptions{
+		ProjectID: t.Project,
+		TaskID:    taskID,
+		Execution: t.Execution,
+	}
+
+	return output.SearchLogs(ctx, taskOpts, searchOpts)
+}
+
+// GetLogSections returns the trees of sections in the task's task logs, which
+// group the log output by the blocks, functions and commands that produced it
+// along with any sections the task marked itself.
+func (t *Task) GetLogSections(ctx context.Context) ([]*log.SectionNode, error) {
+	if t.DisplayOnly {
+		return nil, errors.New("cannot get log sections for a display task")
+	}
+
+	output, ok := t.getTaskOutputSafe()
+	if !ok {
+		return nil, nil
+	}
+
+	taskID := t.Id
+	if t.Archived {
+		taskID = t.OldTaskId
+	}
+	sections, err := output.TaskLogs.GetSections(ctx, taskoutput.TaskOptions{
+		ProjectID: t.Project,
+		TaskID:    taskID,
+		Execution: t.Execution,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting task log sections")
+	}
+
+	return log.NewSectionTree(sections), nil
+}
+
 // SetResultsInfo sets the task's test results info.
 //
 // Note that if failedResults is false, ResultsFailed is not set. This is
@@ -1878,6 +1952,64 @@ func (t *Task) SetResultsInfo(ctx context.Context, service string, failedResults
 	return errors.WithStack(UpdateOne(ctx, ById(t.Id), bson.M{"$set": set}))
 }
 
+// SetCacheKey sets the hash of the task's inputs.
+func (t *Task) SetCacheKey(ctx context.Context, cacheKey string) error {
+	t.CacheKey = cacheKey
+	return UpdateOne(ctx, ById(t.Id), bson.M{"$set": bson.M{CacheKeyKey: cacheKey}})
+}
+
+// MarkUncacheable marks the task as unable to reuse the results of a previous
+// task, so that it runs as usual.
+func (t *Task) MarkUncacheable(ctx context.Context) error {
+	t.Cacheable = false
+	return UpdateOne(ctx, ById(t.Id), bson.M{"$unset": bson.M{CacheableKey: 1}})
+}
+
+// SetCachedFrom records that the task reused the results of the given
+// successful task rather than running. The task shares the original task's
+// test results, so it uses the same test results service. If the original
+// task was itself cached, the task links to the task that actually ran.
+func (t *Task) SetCachedFrom(ctx context.Context, original *Task, cacheKey string, startTime time.Time) error {
+	t.CacheKey = cacheKey
+	switch {
+	case original.CachedFromTaskId != "":
+		t.CachedFromTaskId = original.CachedFromTaskId
+		t.CachedFromExecution = original.CachedFromExecution
+	case original.Archived:
+		t.CachedFromTaskId = original.OldTaskId
+		t.CachedFromExecution = original.Execution
+	default:
+		t.CachedFromTaskId = original.Id
+		t.CachedFromExecution = original.Execution
+	}
+	t.ResultsService = original.ResultsService
+	t.HasCedarResults = original.HasCedarResults
+	t.StartTime = startTime
+	return UpdateOne(ctx, ById(t.Id), bson.M{"$set": bson.M{
+		CacheKeyKey:            cacheKey,
+		CachedFromTaskIdKey:    t.CachedFromTaskId,
+		CachedFromExecutionKey: t.CachedFromExecution,
+		ResultsServiceKey:      t.ResultsService,
+		HasCedarResultsKey:     t.HasCedarResults,
+		StartTimeKey:           startTime,
+	}})
+}
+
+// SetResumedFromCheckpoint records that the task execution resumed from the
+// checkpoint with the given step saved by the given execution, rather than
+// starting over.
+func (t *Task) SetResumedFromCheckpoint(ctx context.Context, step string, execution int) error {
+	if err := UpdateOne(ctx, ByIdAndExecution(t.Id, t.Execution), bson.M{"$set": bson.M{
+		ResumedFromStepKey:      step,
+		ResumedFromExecutionKey: execution,
+	}}); err != nil {
+		return errors.Wrapf(err, "marking task resumed from checkpoint '%s'", step)
+	}
+	t.ResumedFromStep = step
+	t.ResumedFromExecution = execution
+	return nil
+}
+
 // HasResults returns whether the task has test results or not.
 func (t *Task) HasResults(ctx context.Context) bool {
 	if t.DisplayOnly && len(t.ExecutionTasks) > 0 {
@@ -2525,6 +2657,10 @@ func resetTaskUpdate(t *Task, caller string) []bson.M {
 		t.CanReset = false
 		t.IsAutomaticRestart = false
 		t.HasAnnotations = false
+		t.CachedFromTaskId = ""
+		t.CachedFromExecution = 0
+		t.ResumedFromStep = ""
+		t.ResumedFromExecution = 0
 		t.DisplayStatusCache = t.DetermineDisplayStatus()
 	}
 	update := []bson.M{
@@ -2563,6 +2699,10 @@ func resetTaskUpdate(t *Task, caller string) []bson.M {
 				OverrideDependenciesKey,
 				CanResetKey,
 				HasAnnotationsKey,
+				CachedFromTaskIdKey,
+				CachedFromExecutionKey,
+				ResumedFromStepKey,
+				ResumedFromExecutionKey,
 			},
 		},
 		addDisplayStatusCache,
@@ -3274,38 +3414,42 @@ func (t *Task) CreateTestResultsTaskOptions(ctx context.Context) ([]testresult.T
 		} else {
 			query := ByIds(t.ExecutionTasks)
 			query["$or"] = hasResults
-			execTasksWithResults, err = FindWithFields(ctx, query, ExecutionKey, ResultsServiceKey, HasCedarResultsKey)
+			execTasksWithResults, err = FindWithFields(ctx, query, ExecutionKey, ResultsServiceKey, HasCedarResultsKey, CachedFromTaskIdKey, CachedFromExecutionKey)
 		}
 		if err != nil {
 			return nil, errors.Wrap(err, "getting execution tasks for display task")
 		}
 
 		for _, execTask := range execTasksWithResults {
-			taskID := execTask.Id
-			if execTask.Archived {
-				taskID = execTask.OldTaskId
-			}
-			taskOpts = append(taskOpts, testresult.TaskOptions{
-				TaskID:         taskID,
-				Execution:      execTask.Execution,
-				ResultsService: execTask.ResultsService,
-			})
+			taskOpts = append(taskOpts, execTask.testResultsTaskOptions())
 		}
 	} else if t.HasResults(ctx) {
-		taskID := t.Id
-		if t.Archived {
-			taskID = t.OldTaskId
-		}
-		taskOpts = append(taskOpts, testresult.TaskOptions{
-			TaskID:         taskID,
-			Execution:      t.Execution,
-			ResultsService: t.ResultsService,
-		})
+		taskOpts = append(taskOpts, t.testResultsTaskOptions())
 	}
 
 	return taskOpts, nil
 }
 
+// testResultsTaskOptions returns the options for fetching the test results of
+// a single task. Tasks that succeeded from the cache share the test results
+// of the task they were cached from.
+func (t *Task) testResultsTaskOptions() testresult.TaskOptions {
+	taskID := t.Id
+	execution := t.Execution
+	if t.Archived {
+		taskID = t.OldTaskId
+	}
+	if t.CachedFromTaskId != "" {
+		taskID = t.CachedFromTaskId
+		execution = t.CachedFromExecution
+	}
+	return testresult.TaskOptions{
+		TaskID:         taskID,
+		Execution:      execution,
+		ResultsService: t.ResultsService,
+	}
+}
+
 // SetResetWhenFinished requests that a display task or single-host task group
 // reset itself when finished. Will mark itself as system failed.
 func (t *Task) SetResetWhenFinished(ctx context.Context, caller string) error {
@@ -0,0 +1,329 @@
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	ignore "github.com/sabhiram/go-gitignore"
+	"gopkg.in/yaml.v3"
+)
+
+// TaskCacheKey declares the inputs of a task. A task with a cache key reuses
+// the results of a previous successful task in the same project with the same
+// inputs instead of running again.
+type TaskCacheKey struct {
+	// Inputs are gitignore-style patterns of the files in the project's
+	// repository that the task depends on.
+	Inputs []string `yaml:"inputs,omitempty" bson:"inputs,omitempty"`
+	// Expansions are the names of the expansions that the task depends on.
+	Expansions []string `yaml:"expansions,omitempty" bson:"expansions,omitempty"`
+}
+
+// taskCacheInputs are everything that a task's cache key is computed from.
+type taskCacheInputs struct {
+	// definition is the serialized definition of the task.
+	definition []byte
+	// files maps each input file to the hash of its contents.
+	files map[string]string
+	// expansions maps each input expansion to its value.
+	expansions map[string]string
+	// patchID is the patch that modifies the input files, if any. Patch
+	// contents aren't part of the repository, so the patch is used to
+	// identify them instead.
+	patchID string
+}
+
+// key returns the hash of the inputs.
+func (in taskCacheInputs) key() string {
+	h := sha256.New()
+	writeSection := func(name string, values map[string]string) {
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		_, _ = fmt.Fprintf(h, "%s:%d\n", name, len(keys))
+		for _, k := range keys {
+			_, _ = fmt.Fprintf(h, "%q=%q\n", k, values[k])
+		}
+	}
+
+	_, _ = fmt.Fprintf(h, "definition:%d\n", len(in.definition))
+	_, _ = h.Write(in.definition)
+	writeSection("files", in.files)
+	writeSection("expansions", in.expansions)
+	_, _ = io.WriteString(h, "patch:"+in.patchID)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// taskCacheDefinition is the part of the project configuration that defines
+// what a task runs.
+type taskCacheDefinition struct {
+	Variant   string                     `yaml:"variant"`
+	Task      ProjectTask                `yaml:"task"`
+	Functions map[string]*YAMLCommandSet `yaml:"functions,omitempty"`
+}
+
+// getTaskCacheDefinition returns the serialized definition of the task in the
+// given build variant, including the functions that it calls.
+func getTaskCacheDefinition(p *Project, pt *ProjectTask, variant string) ([]byte, error) {
+	def := taskCacheDefinition{
+		Variant:   variant,
+		Task:      *pt,
+		Functions: map[string]*YAMLCommandSet{},
+	}
+	for _, cmd := range pt.Commands {
+		if cmd.Function == "" {
+			continue
+		}
+		def.Functions[cmd.Function] = p.Functions[cmd.Function]
+	}
+	out, err := yaml.Marshal(def)
+	return out, errors.Wrap(err, "marshalling task definition")
+}
+
+// taskCacheVersionInfo is the information shared by all the tasks in a
+// version that's needed to compute their cache keys.
+type taskCacheVersionInfo struct {
+	project      *Project
+	tree         map[string]string
+	patchID      string
+	patchedFiles []string
+	vars         map[string]string
+}
+
+// getTaskCacheVersionInfo returns the information about the task's version
+// needed to compute the cache keys of its tasks.
+func getTaskCacheVersionInfo(ctx context.Context, settings *evergreen.Settings, t *task.Task) (*taskCacheVersionInfo, error) {
+	v, err := VersionFindOneId(ctx, t.Version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding version '%s'", t.Version)
+	}
+	if v == nil {
+		return nil, errors.Errorf("version '%s' not found", t.Version)
+	}
+	project, _, err := FindAndTranslateProjectForVersion(ctx, settings, v, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting project for version '%s'", t.Version)
+	}
+	pRef, err := FindMergedProjectRef(ctx, t.Project, t.Version, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding project ref '%s'", t.Project)
+	}
+	if pRef == nil {
+		return nil, errors.Errorf("project ref '%s' not found", t.Project)
+	}
+
+	entries, err := thirdparty.GetGithubTree(ctx, pRef.Owner, pRef.Repo, t.Revision)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting repository tree at revision '%s'", t.Revision)
+	}
+	tree := map[string]string{}
+	for _, entry := range entries {
+		if entry.GetType() == "blob" {
+			tree[entry.GetPath()] = entry.GetSHA()
+		}
+	}
+
+	info := &taskCacheVersionInfo{
+		project: project,
+		tree:    tree,
+	}
+
+	if evergreen.IsPatchRequester(t.Requester) {
+		p, err := patch.FindOneId(ctx, t.Version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding patch '%s'", t.Version)
+		}
+		if p == nil {
+			return nil, errors.Errorf("patch '%s' not found", t.Version)
+		}
+		info.patchID = t.Version
+		for _, part := range p.Patches {
+			if part.ModuleName != "" {
+				continue
+			}
+			for _, summary := range part.PatchSet.Summary {
+				info.patchedFiles = append(info.patchedFiles, summary.Name)
+			}
+		}
+	}
+
+	vars, err := FindOneProjectVars(ctx, t.Project)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding project vars for project '%s'", t.Project)
+	}
+	if vars != nil {
+		info.vars = vars.Vars
+	}
+
+	return info, nil
+}
+
+// getTaskCacheKey returns the cache key for the task.
+func (info *taskCacheVersionInfo) getTaskCacheKey(t *task.Task) (string, error) {
+	pt := info.project.FindProjectTask(t.DisplayName)
+	if pt == nil {
+		return "", errors.Errorf("task '%s' not found in project", t.DisplayName)
+	}
+	if pt.CacheKey == nil {
+		return "", errors.Errorf("task '%s' does not have a cache key", t.DisplayName)
+	}
+	bv := info.project.FindBuildVariant(t.BuildVariant)
+	if bv == nil {
+		return "", errors.Errorf("build variant '%s' not found in project", t.BuildVariant)
+	}
+
+	definition, err := getTaskCacheDefinition(info.project, pt, t.BuildVariant)
+	if err != nil {
+		return "", err
+	}
+	in := taskCacheInputs{
+		definition: definition,
+		files:      map[string]string{},
+		expansions: map[string]string{},
+	}
+	if len(pt.CacheKey.Inputs) > 0 {
+		// CompileIgnoreLines has a silly API: it always returns a nil error.
+		matcher := ignore.CompileIgnoreLines(pt.CacheKey.Inputs...)
+		for path, hash := range info.tree {
+			if matcher.MatchesPath(path) {
+				in.files[path] = hash
+			}
+		}
+		for _, path := range info.patchedFiles {
+			if matcher.MatchesPath(path) {
+				in.patchID = info.patchID
+				break
+			}
+		}
+	}
+	for _, name := range pt.CacheKey.Expansions {
+		if val, ok := bv.Expansions[name]; ok {
+			in.expansions[name] = val
+		} else if val, ok := info.vars[name]; ok {
+			in.expansions[name] = val
+		}
+	}
+
+	return in.key(), nil
+}
+
+// SkipCachedTasks finishes the tasks that can reuse the results of a previous
+// successful task with the same cache key and returns the remaining tasks,
+// which still have to run. Tasks whose cache key can't be determined run as
+// usual.
+func SkipCachedTasks(ctx context.Context, settings *evergreen.Settings, tasks []task.Task) ([]task.Task, error) {
+	catcher := grip.NewBasicCatcher()
+	versionInfo := map[string]*taskCacheVersionInfo{}
+	remaining := make([]task.Task, 0, len(tasks))
+	for i := range tasks {
+		t := &tasks[i]
+		if !t.Cacheable || t.CacheKey != "" || t.DisplayOnly || t.IsPartOfSingleHostTaskGroup() {
+			remaining = append(remaining, *t)
+			continue
+		}
+
+		cached, err := checkTaskCache(ctx, settings, versionInfo, t)
+		if err != nil {
+			catcher.Wrapf(err, "checking cache for task '%s'", t.Id)
+		}
+		if !cached {
+			remaining = append(remaining, *t)
+		}
+	}
+	return remaining, catcher.Resolve()
+}
+
+// checkTaskCache computes the cache key of the task and, if a previous task
+// with the same key succeeded, finishes the task with that task's results. It
+// returns whether the task was finished.
+func checkTaskCache(ctx context.Context, settings *evergreen.Settings, versionInfo map[string]*taskCacheVersionInfo, t *task.Task) (bool, error) {
+	info, ok := versionInfo[t.Version]
+	if !ok {
+		var err error
+		info, err = getTaskCacheVersionInfo(ctx, settings, t)
+		grip.Info(message.WrapError(err, message.Fields{
+			"message": "could not get version information to compute task cache keys, tasks will run as usual",
+			"version": t.Version,
+		}))
+		versionInfo[t.Version] = info
+	}
+	if info == nil {
+		return false, errors.Wrap(t.MarkUncacheable(ctx), "marking task uncacheable")
+	}
+
+	key, err := info.getTaskCacheKey(t)
+	if err != nil {
+		grip.Info(message.WrapError(err, message.Fields{
+			"message": "could not compute task cache key, task will run as usual",
+			"task_id": t.Id,
+		}))
+		return false, errors.Wrap(t.MarkUncacheable(ctx), "marking task uncacheable")
+	}
+
+	original, err := task.FindSucceededByCacheKey(ctx, t.Project, key)
+	if err != nil {
+		return false, err
+	}
+	if original == nil || original.Id == t.Id {
+		return false, errors.Wrap(t.SetCacheKey(ctx, key), "setting cache key")
+	}
+
+	if err := restoreTaskFromCache(ctx, settings, t, original, key); err != nil {
+		return false, errors.Wrapf(err, "reusing results of task '%s'", original.Id)
+	}
+	return true, nil
+}
+
+// restoreTaskFromCache finishes the task successfully with the artifacts and
+// test results of the original task.
+func restoreTaskFromCache(ctx context.Context, settings *evergreen.Settings, t, original *task.Task, key string) error {
+	entries, err := artifact.FindAll(ctx, artifact.ByTaskIdAndExecution(original.Id, original.Execution))
+	if err != nil {
+		return errors.Wrap(err, "finding original task's artifacts")
+	}
+	now := time.Now()
+	for _, entry := range entries {
+		entry.TaskId = t.Id
+		entry.TaskDisplayName = t.DisplayName
+		entry.BuildId = t.BuildId
+		entry.Execution = t.Execution
+		entry.CreateTime = now
+		if err := entry.Upsert(); err != nil {
+			return errors.Wrap(err, "copying artifacts")
+		}
+	}
+
+	if err := t.SetCachedFrom(ctx, original, key, now); err != nil {
+		return errors.Wrap(err, "setting original task")
+	}
+
+	grip.Info(message.Fields{
+		"message":          "task reused results of task with same cache key",
+		"task_id":          t.Id,
+		"cached_from_task": t.CachedFromTaskId,
+		"cache_key":        key,
+		"project":          t.Project,
+	})
+
+	return MarkEnd(ctx, settings, t, evergreen.TaskCacheActivator, now, &apimodels.TaskEndDetail{
+		Status:      evergreen.TaskSucceeded,
+		Description: evergreen.TaskDescriptionCached,
+	})
+}
@@ -0,0 +1,263 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const taskCacheProjectYAML = `
+functions:
+  setup:
+    command: shell.exec
+    params:
+      script: echo setup
+tasks:
+  - name: lint
+    cache_key:
+      inputs: ["*.go", "!*_test.go"]
+      expansions: [go_version]
+    commands:
+      - func: setup
+      - command: shell.exec
+        params:
+          script: make lint
+buildvariants:
+  - name: bv
+    expansions:
+      go_version: "1.24"
+    run_on: [d]
+    tasks: [lint]
+`
+
+func translateTaskCacheProject(t *testing.T, yml string) *Project {
+	pp, err := createIntermediateProject([]byte(yml), true)
+	require.NoError(t, err)
+	p, err := TranslateProject(pp)
+	require.NoError(t, err)
+	return p
+}
+
+func TestGetTaskCacheKey(t *testing.T) {
+	lint := &task.Task{Id: "lint", DisplayName: "lint", BuildVariant: "bv"}
+	newInfo := func(p *Project) *taskCacheVersionInfo {
+		return &taskCacheVersionInfo{
+			project: p,
+			tree: map[string]string{
+				"main.go":      "aaa",
+				"main_test.go": "bbb",
+				"README.md":    "ccc",
+			},
+			patchID: "patch",
+		}
+	}
+	baseInfo := newInfo(translateTaskCacheProject(t, taskCacheProjectYAML))
+	baseKey, err := baseInfo.getTaskCacheKey(lint)
+	require.NoError(t, err)
+	require.NotEmpty(t, baseKey)
+
+	sameKey, err := newInfo(translateTaskCacheProject(t, taskCacheProjectYAML)).getTaskCacheKey(lint)
+	require.NoError(t, err)
+	assert.Equal(t, baseKey, sameKey, "identical inputs should have the same key")
+
+	for tName, tCase := range map[string]struct {
+		modify      func(info *taskCacheVersionInfo)
+		shouldMatch bool
+	}{
+		"ChangedInputFile": {
+			modify: func(info *taskCacheVersionInfo) { info.tree["main.go"] = "ddd" },
+		},
+		"AddedInputFile": {
+			modify: func(info *taskCacheVersionInfo) { info.tree["util.go"] = "ddd" },
+		},
+		"ChangedFileThatIsNotAnInput": {
+			modify:      func(info *taskCacheVersionInfo) { info.tree["README.md"] = "ddd" },
+			shouldMatch: true,
+		},
+		"ChangedNegatedInputFile": {
+			modify:      func(info *taskCacheVersionInfo) { info.tree["main_test.go"] = "ddd" },
+			shouldMatch: true,
+		},
+		"ChangedExpansion": {
+			modify: func(info *taskCacheVersionInfo) {
+				info.project.FindBuildVariant("bv").Expansions["go_version"] = "1.25"
+			},
+		},
+		"ChangedUndeclaredExpansion": {
+			modify: func(info *taskCacheVersionInfo) {
+				info.project.FindBuildVariant("bv").Expansions["other"] = "value"
+			},
+			shouldMatch: true,
+		},
+		"ChangedFunction": {
+			modify: func(info *taskCacheVersionInfo) {
+				info.project.Functions["setup"] = &YAMLCommandSet{SingleCommand: &PluginCommandConf{
+					Command:    "shell.exec",
+					ParamsYAML: "script: echo changed\n",
+				}}
+			},
+		},
+		"PatchChangesInputFile": {
+			modify: func(info *taskCacheVersionInfo) { info.patchedFiles = []string{"main.go"} },
+		},
+		"PatchChangesFileThatIsNotAnInput": {
+			modify:      func(info *taskCacheVersionInfo) { info.patchedFiles = []string{"README.md"} },
+			shouldMatch: true,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			info := newInfo(translateTaskCacheProject(t, taskCacheProjectYAML))
+			tCase.modify(info)
+			key, err := info.getTaskCacheKey(lint)
+			require.NoError(t, err)
+			if tCase.shouldMatch {
+				assert.Equal(t, baseKey, key)
+			} else {
+				assert.NotEqual(t, baseKey, key)
+			}
+		})
+	}
+
+	t.Run("ExpansionFromProjectVars", func(t *testing.T) {
+		info := newInfo(translateTaskCacheProject(t, taskCacheProjectYAML))
+		delete(info.project.FindBuildVariant("bv").Expansions, "go_version")
+		info.vars = map[string]string{"go_version": "1.24"}
+		key, err := info.getTaskCacheKey(lint)
+		require.NoError(t, err)
+		assert.Equal(t, baseKey, key)
+	})
+	t.Run("TaskWithoutCacheKeyErrors", func(t *testing.T) {
+		info := newInfo(translateTaskCacheProject(t, taskCacheProjectYAML))
+		info.project.FindProjectTask("lint").CacheKey = nil
+		_, err := info.getTaskCacheKey(lint)
+		assert.Error(t, err)
+	})
+}
+
+func TestCheckTaskCache(t *testing.T) {
+	ctx := t.Context()
+	settings := testutil.TestConfig()
+	project := translateTaskCacheProject(t, taskCacheProjectYAML)
+
+	setup := func(t *testing.T) (*task.Task, *task.Task, map[string]*taskCacheVersionInfo) {
+		require.NoError(t, db.ClearCollections(task.Collection, build.Collection, VersionCollection, ProjectRefCollection, artifact.Collection))
+		require.NoError(t, (&ProjectRef{Id: "p1"}).Insert())
+		require.NoError(t, (&Version{Id: "v1", Identifier: "p1", Status: evergreen.VersionStarted}).Insert())
+		require.NoError(t, (&build.Build{Id: "b1", Version: "v1", Status: evergreen.BuildStarted}).Insert())
+
+		info := &taskCacheVersionInfo{
+			project: project,
+			tree:    map[string]string{"main.go": "aaa"},
+		}
+		key, err := info.getTaskCacheKey(&task.Task{DisplayName: "lint", BuildVariant: "bv"})
+		require.NoError(t, err)
+
+		original := &task.Task{
+			Id:             "original",
+			DisplayName:    "lint",
+			BuildVariant:   "bv",
+			Project:        "p1",
+			Version:        "v0",
+			BuildId:        "b0",
+			Execution:      1,
+			Status:         evergreen.TaskSucceeded,
+			Cacheable:      true,
+			CacheKey:       key,
+			ResultsService: "local",
+		}
+		require.NoError(t, original.Insert())
+		newTask := &task.Task{
+			Id:           "new",
+			DisplayName:  "lint",
+			BuildVariant: "bv",
+			Project:      "p1",
+			Version:      "v1",
+			BuildId:      "b1",
+			Status:       evergreen.TaskUndispatched,
+			Activated:    true,
+			Cacheable:    true,
+		}
+		require.NoError(t, newTask.Insert())
+
+		return original, newTask, map[string]*taskCacheVersionInfo{"v1": info}
+	}
+
+	t.Run("FinishesTaskWithSameKey", func(t *testing.T) {
+		original, newTask, versionInfo := setup(t)
+		require.NoError(t, artifact.Entry{
+			TaskId:    original.Id,
+			BuildId:   original.BuildId,
+			Execution: original.Execution,
+			Files:     []artifact.File{{Name: "report", Link: "https://example.com/report"}},
+		}.Upsert())
+
+		cached, err := checkTaskCache(ctx, settings, versionInfo, newTask)
+		require.NoError(t, err)
+		assert.True(t, cached)
+
+		dbTask, err := task.FindOneId(ctx, newTask.Id)
+		require.NoError(t, err)
+		require.NotZero(t, dbTask)
+		assert.Equal(t, evergreen.TaskSucceeded, dbTask.Status)
+		assert.Equal(t, evergreen.TaskDescriptionCached, dbTask.Details.Description)
+		assert.Equal(t, original.CacheKey, dbTask.CacheKey)
+		assert.Equal(t, original.Id, dbTask.CachedFromTaskId)
+		assert.Equal(t, original.Execution, dbTask.CachedFromExecution)
+
+		taskOpts, err := dbTask.CreateTestResultsTaskOptions(ctx)
+		require.NoError(t, err)
+		require.Len(t, taskOpts, 1)
+		assert.Equal(t, original.Id, taskOpts[0].TaskID, "test results should come from the original task")
+		assert.Equal(t, original.Execution, taskOpts[0].Execution)
+
+		entry, err := artifact.FindOne(ctx, artifact.ByTaskIdAndExecution(newTask.Id, newTask.Execution))
+		require.NoError(t, err)
+		require.NotZero(t, entry)
+		require.Len(t, entry.Files, 1)
+		assert.Equal(t, "report", entry.Files[0].Name)
+	})
+	t.Run("StoresKeyWithoutMatchingTask", func(t *testing.T) {
+		original, newTask, versionInfo := setup(t)
+		require.NoError(t, task.UpdateOne(ctx, task.ById(original.Id), bson.M{"$set": bson.M{task.StatusKey: evergreen.TaskFailed}}))
+
+		cached, err := checkTaskCache(ctx, settings, versionInfo, newTask)
+		require.NoError(t, err)
+		assert.False(t, cached)
+
+		dbTask, err := task.FindOneId(ctx, newTask.Id)
+		require.NoError(t, err)
+		require.NotZero(t, dbTask)
+		assert.Equal(t, evergreen.TaskUndispatched, dbTask.Status)
+		assert.Equal(t, original.CacheKey, dbTask.CacheKey)
+		assert.Empty(t, dbTask.CachedFromTaskId)
+	})
+	t.Run("SkipsTasksThatAlreadyHaveKey", func(t *testing.T) {
+		_, newTask, _ := setup(t)
+		newTask.CacheKey = "key"
+		remaining, err := SkipCachedTasks(ctx, settings, []task.Task{*newTask})
+		require.NoError(t, err)
+		require.Len(t, remaining, 1)
+		assert.Equal(t, newTask.Id, remaining[0].Id)
+	})
+	t.Run("MarksTaskUncacheableWithoutVersionInfo", func(t *testing.T) {
+		_, newTask, _ := setup(t)
+		cached, err := checkTaskCache(ctx, settings, map[string]*taskCacheVersionInfo{"v1": nil}, newTask)
+		require.NoError(t, err)
+		assert.False(t, cached)
+
+		dbTask, err := task.FindOneId(ctx, newTask.Id)
+		require.NoError(t, err)
+		require.NotZero(t, dbTask)
+		assert.False(t, dbTask.Cacheable)
+		assert.Empty(t, dbTask.CacheKey)
+		assert.Equal(t, evergreen.TaskUndispatched, dbTask.Status)
+	})
+}
@@ -933,7 +933,8 @@ func attemptStepbackAndDeactivatePrevious(ctx context.Context, t *task.Task, sta
 	}
 
 	// Deactivate previous occurrences of the same task only if this one passed on mainline commits.
-	if t.Status == evergreen.TaskSucceeded && t.Requester == evergreen.RepotrackerVersionRequester && t.ActivatedBy != evergreen.StepbackTaskActivator {
+	if t.Status == evergreen.TaskSucceeded && t.Requester == evergreen.RepotrackerVersionRequester &&
+		t.ActivatedBy != evergreen.StepbackTaskActivator && t.ActivatedBy != evergreen.CulpritFinderTaskActivator {
 		shouldDeactivatePrevious := getDeactivatePrevious(t, pRef, project)
 		if shouldDeactivatePrevious {
 			grip.Error(message.WrapError(DeactivatePreviousTasks(ctx, t, caller), message.Fields{
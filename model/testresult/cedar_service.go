@@ -9,6 +9,7 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/timber"
 	"github.com/evergreen-ci/timber/testresults"
+	"github.com/evergreen-ci/utility"
 	"github.com/pkg/errors"
 )
 
@@ -30,6 +31,21 @@ func newCedarService(env evergreen.Environment) *cedarService {
 }
 
 func (s *cedarService) GetMergedTaskTestResults(ctx context.Context, taskOpts []TaskOptions, filterOpts *FilterOptions) (TaskTestResults, error) {
+	// Cedar does not support excluding tests by name, so fetch all of the
+	// matching results and exclude and paginate them here instead.
+	if filterOpts != nil && len(filterOpts.ExcludeTestNames) > 0 {
+		allOpts := *filterOpts
+		allOpts.ExcludeTestNames = nil
+		allOpts.Limit = 0
+		allOpts.Page = 0
+		testResults, err := s.GetMergedTaskTestResults(ctx, taskOpts, &allOpts)
+		if err != nil {
+			return TaskTestResults{}, err
+		}
+
+		return excludeAndPaginate(testResults, filterOpts), nil
+	}
+
 	data, status, err := testresults.Get(ctx, s.convertOpts(taskOpts, filterOpts))
 	if err != nil {
 		return TaskTestResults{}, errors.Wrap(err, "getting test results from Cedar")
@@ -112,6 +128,35 @@ func (s *cedarService) GetFailedTestSamples(ctx context.Context, taskOpts []Task
 	return samples, nil
 }
 
+// excludeAndPaginate removes the excluded tests from the filtered test results
+// and paginates the remaining results as specified by the filter options.
+func excludeAndPaginate(testResults TaskTestResults, filterOpts *FilterOptions) TaskTestResults {
+	var results []TestResult
+	for _, result := range testResults.Results {
+		if utility.StringSliceContains(filterOpts.ExcludeTestNames, result.GetDisplayTestName()) {
+			continue
+		}
+		results = append(results, result)
+	}
+
+	filteredCount := len(results)
+	if filterOpts.Limit > 0 {
+		offset := filterOpts.Limit * filterOpts.Page
+		end := offset + filterOpts.Limit
+		if offset > filteredCount {
+			offset = filteredCount
+		}
+		if end > filteredCount {
+			end = filteredCount
+		}
+		results = results[offset:end]
+	}
+	testResults.Results = results
+	testResults.Stats.FilteredCount = &filteredCount
+
+	return testResults
+}
+
 func (s *cedarService) convertOpts(taskOpts []TaskOptions, filterOpts *FilterOptions) testresults.GetOptions {
 	cedarTaskOpts := make([]testresults.TaskOptions, len(taskOpts))
 	for i, task := range taskOpts {
@@ -247,7 +247,7 @@ func (s *localService) validateFilterOptions(opts *FilterOptions) error {
 }
 
 func (s *localService) filterTestResults(results []TestResult, opts *FilterOptions) ([]TestResult, error) {
-	if opts.TestName == "" && len(opts.Statuses) == 0 && opts.GroupID == "" {
+	if opts.TestName == "" && len(opts.Statuses) == 0 && opts.GroupID == "" && len(opts.ExcludeTestNames) == 0 {
 		return results, nil
 	}
 
@@ -277,6 +277,9 @@ func (s *localService) filterTestResults(results []TestResult, opts *FilterOptio
 		if opts.GroupID != "" && opts.GroupID != result.GroupID {
 			continue
 		}
+		if utility.StringSliceContains(opts.ExcludeTestNames, result.GetDisplayTestName()) {
+			continue
+		}
 
 		filteredResults = append(filteredResults, result)
 	}
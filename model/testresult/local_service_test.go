@@ -395,6 +395,12 @@ func TestLocalFilterAndSortTestResults(t *testing.T) {
 			expectedResults: results[3:4],
 			expectedCount:   1,
 		},
+		{
+			name:            "ExcludeTestNamesFilter",
+			opts:            &FilterOptions{ExcludeTestNames: []string{"A test", "Display", "B"}},
+			expectedResults: results[3:4],
+			expectedCount:   1,
+		},
 		{
 			name: "SortByDurationASC",
 			opts: &FilterOptions{
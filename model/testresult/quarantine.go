@@ -0,0 +1,245 @@
+package testresult
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// QuarantineCollection is the name of the collection containing the
+// per-project lists of quarantined tests.
+const QuarantineCollection = "test_quarantines"
+
+const (
+	// DefaultFlakinessThreshold is the minimum flakiness score at which a
+	// test is automatically quarantined.
+	DefaultFlakinessThreshold = 0.2
+	// DefaultMinFlakyRuns is the minimum number of task runs in which a test
+	// must have flipped between passing and failing before it is
+	// automatically quarantined.
+	DefaultMinFlakyRuns = 2
+)
+
+// TestFlakiness describes how often a test flips between passing and failing
+// when the task running it is retried on the same revision.
+type TestFlakiness struct {
+	// TestName is the display name of the test.
+	TestName string `bson:"test_name" json:"test_name"`
+	// NumRuns is the number of task runs in which the test ran more than
+	// once, across executions or within a single execution.
+	NumRuns int `bson:"num_runs" json:"num_runs"`
+	// NumFlakyRuns is the number of task runs in which the test both passed
+	// and failed.
+	NumFlakyRuns int `bson:"num_flaky_runs" json:"num_flaky_runs"`
+	// Score is the fraction of the test's runs that were flaky, between 0
+	// and 1.
+	Score float64 `bson:"score" json:"score"`
+}
+
+// ComputeFlakiness scores the flakiness of the tests in the given task runs.
+// Each task run is the set of results, across all executions, of a single task
+// and therefore a single revision. A test is flaky in a run if it both passed
+// and failed in that run. Tests that never ran more than once in a run are not
+// scored. The returned scores are sorted by score, highest first, and then by
+// test name.
+func ComputeFlakiness(runs [][]TestResult) []TestFlakiness {
+	flakiness := map[string]*TestFlakiness{}
+	for _, run := range runs {
+		type testStatuses struct {
+			count  int
+			passed bool
+			failed bool
+		}
+		tests := map[string]*testStatuses{}
+		for _, result := range run {
+			name := result.GetDisplayTestName()
+			statuses, ok := tests[name]
+			if !ok {
+				statuses = &testStatuses{}
+				tests[name] = statuses
+			}
+			statuses.count++
+			switch result.Status {
+			case evergreen.TestSucceededStatus:
+				statuses.passed = true
+			case evergreen.TestFailedStatus:
+				statuses.failed = true
+			}
+		}
+
+		for name, statuses := range tests {
+			if statuses.count < 2 {
+				continue
+			}
+
+			test, ok := flakiness[name]
+			if !ok {
+				test = &TestFlakiness{TestName: name}
+				flakiness[name] = test
+			}
+			test.NumRuns++
+			if statuses.passed && statuses.failed {
+				test.NumFlakyRuns++
+			}
+		}
+	}
+
+	scores := make([]TestFlakiness, 0, len(flakiness))
+	for _, test := range flakiness {
+		test.Score = float64(test.NumFlakyRuns) / float64(test.NumRuns)
+		scores = append(scores, *test)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].TestName < scores[j].TestName
+	})
+
+	return scores
+}
+
+// TestQuarantine is the list of a project's quarantined tests. Quarantined
+// tests are tests that are known to be flaky and whose failures should not
+// alert anyone.
+type TestQuarantine struct {
+	ProjectID string `bson:"_id" json:"project_id"`
+	// Detected contains the tests automatically quarantined by the most
+	// recent flakiness analysis.
+	Detected []TestFlakiness `bson:"detected,omitempty" json:"detected,omitempty"`
+	// LastAnalyzed is the time of the most recent flakiness analysis.
+	LastAnalyzed time.Time `bson:"last_analyzed,omitempty" json:"last_analyzed,omitempty"`
+	// Manual contains the tests quarantined by project admins, regardless
+	// of their flakiness.
+	Manual []string `bson:"manual,omitempty" json:"manual,omitempty"`
+	// Exempt contains the tests project admins have removed from the
+	// quarantine, which are never automatically quarantined.
+	Exempt []string `bson:"exempt,omitempty" json:"exempt,omitempty"`
+}
+
+var (
+	quarantineProjectIDKey    = bsonutil.MustHaveTag(TestQuarantine{}, "ProjectID")
+	quarantineDetectedKey     = bsonutil.MustHaveTag(TestQuarantine{}, "Detected")
+	quarantineLastAnalyzedKey = bsonutil.MustHaveTag(TestQuarantine{}, "LastAnalyzed")
+	quarantineManualKey       = bsonutil.MustHaveTag(TestQuarantine{}, "Manual")
+	quarantineExemptKey       = bsonutil.MustHaveTag(TestQuarantine{}, "Exempt")
+)
+
+// TestNames returns the sorted display names of the quarantined tests.
+func (q *TestQuarantine) TestNames() []string {
+	if q == nil {
+		return nil
+	}
+
+	names := append([]string{}, q.Manual...)
+	for _, test := range q.Detected {
+		if !utility.StringSliceContains(q.Exempt, test.TestName) && !utility.StringSliceContains(names, test.TestName) {
+			names = append(names, test.TestName)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// IsQuarantined returns whether the test with the given display name is
+// quarantined.
+func (q *TestQuarantine) IsQuarantined(testName string) bool {
+	return utility.StringSliceContains(q.TestNames(), testName)
+}
+
+// FindQuarantine returns the project's list of quarantined tests. If the
+// project has never had a test quarantined, an empty list is returned.
+func FindQuarantine(ctx context.Context, env evergreen.Environment, projectID string) (*TestQuarantine, error) {
+	q := &TestQuarantine{}
+	err := env.DB().Collection(QuarantineCollection).FindOne(ctx, bson.M{quarantineProjectIDKey: projectID}).Decode(q)
+	if err == mongo.ErrNoDocuments {
+		return &TestQuarantine{ProjectID: projectID}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding test quarantine for project '%s'", projectID)
+	}
+
+	return q, nil
+}
+
+// FindQuarantinedTestNames returns the display names of the project's
+// quarantined tests.
+func FindQuarantinedTestNames(ctx context.Context, env evergreen.Environment, projectID string) ([]string, error) {
+	q, err := FindQuarantine(ctx, env, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.TestNames(), nil
+}
+
+// SetDetectedFlakyTests replaces the project's automatically quarantined tests
+// with the given scored tests that meet the flakiness threshold and minimum
+// number of flaky runs.
+func SetDetectedFlakyTests(ctx context.Context, env evergreen.Environment, projectID string, scores []TestFlakiness, threshold float64, minFlakyRuns int) error {
+	detected := []TestFlakiness{}
+	for _, score := range scores {
+		if score.Score >= threshold && score.NumFlakyRuns >= minFlakyRuns {
+			detected = append(detected, score)
+		}
+	}
+
+	_, err := env.DB().Collection(QuarantineCollection).UpdateOne(ctx,
+		bson.M{quarantineProjectIDKey: projectID},
+		bson.M{"$set": bson.M{
+			quarantineDetectedKey:     detected,
+			quarantineLastAnalyzedKey: time.Now(),
+		}},
+		options.Update().SetUpsert(true),
+	)
+
+	return errors.Wrapf(err, "setting detected flaky tests for project '%s'", projectID)
+}
+
+// QuarantineTests manually quarantines the tests with the given display names
+// in the project.
+func QuarantineTests(ctx context.Context, env evergreen.Environment, projectID string, testNames []string) error {
+	if len(testNames) == 0 {
+		return nil
+	}
+
+	_, err := env.DB().Collection(QuarantineCollection).UpdateOne(ctx,
+		bson.M{quarantineProjectIDKey: projectID},
+		bson.M{
+			"$addToSet": bson.M{quarantineManualKey: bson.M{"$each": testNames}},
+			"$pull":     bson.M{quarantineExemptKey: bson.M{"$in": testNames}},
+		},
+		options.Update().SetUpsert(true),
+	)
+
+	return errors.Wrapf(err, "quarantining tests in project '%s'", projectID)
+}
+
+// UnquarantineTests removes the tests with the given display names from the
+// project's quarantine and exempts them from being automatically quarantined
+// again.
+func UnquarantineTests(ctx context.Context, env evergreen.Environment, projectID string, testNames []string) error {
+	if len(testNames) == 0 {
+		return nil
+	}
+
+	_, err := env.DB().Collection(QuarantineCollection).UpdateOne(ctx,
+		bson.M{quarantineProjectIDKey: projectID},
+		bson.M{
+			"$addToSet": bson.M{quarantineExemptKey: bson.M{"$each": testNames}},
+			"$pull":     bson.M{quarantineManualKey: bson.M{"$in": testNames}},
+		},
+		options.Update().SetUpsert(true),
+	)
+
+	return errors.Wrapf(err, "unquarantining tests in project '%s'", projectID)
+}
@@ -0,0 +1,147 @@
+package testresult
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeFlakiness(t *testing.T) {
+	pass := func(name string, execution int) TestResult {
+		return TestResult{TestName: name, Execution: execution, Status: evergreen.TestSucceededStatus}
+	}
+	fail := func(name string, execution int) TestResult {
+		return TestResult{TestName: name, Execution: execution, Status: evergreen.TestFailedStatus}
+	}
+
+	t.Run("NoRuns", func(t *testing.T) {
+		assert.Empty(t, ComputeFlakiness(nil))
+	})
+	t.Run("SingleExecutionsAreNotScored", func(t *testing.T) {
+		assert.Empty(t, ComputeFlakiness([][]TestResult{
+			{pass("a", 0), fail("b", 0)},
+			{fail("a", 0), pass("b", 0)},
+		}))
+	})
+	t.Run("FlipsAcrossExecutions", func(t *testing.T) {
+		scores := ComputeFlakiness([][]TestResult{
+			{fail("a", 0), pass("a", 1), fail("b", 0), fail("b", 1)},
+			{pass("a", 0), pass("a", 1), pass("b", 0), pass("b", 1)},
+			{fail("a", 0), fail("a", 1), pass("a", 2), pass("c", 0)},
+		})
+		require.Len(t, scores, 2)
+		assert.Equal(t, TestFlakiness{TestName: "a", NumRuns: 3, NumFlakyRuns: 2, Score: 2.0 / 3}, scores[0])
+		assert.Equal(t, TestFlakiness{TestName: "b", NumRuns: 2, NumFlakyRuns: 0, Score: 0}, scores[1])
+	})
+	t.Run("FlipsWithinExecution", func(t *testing.T) {
+		scores := ComputeFlakiness([][]TestResult{
+			{fail("a", 0), pass("a", 0)},
+		})
+		require.Len(t, scores, 1)
+		assert.Equal(t, 1.0, scores[0].Score)
+	})
+	t.Run("UsesDisplayTestName", func(t *testing.T) {
+		first := fail("a", 0)
+		first.DisplayTestName = "display"
+		second := pass("b", 1)
+		second.DisplayTestName = "display"
+		scores := ComputeFlakiness([][]TestResult{{first, second}})
+		require.Len(t, scores, 1)
+		assert.Equal(t, "display", scores[0].TestName)
+		assert.Equal(t, 1, scores[0].NumFlakyRuns)
+	})
+}
+
+func TestTestQuarantine(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearQuarantines := func() {
+		require.NoError(t, env.DB().Collection(QuarantineCollection).Drop(ctx))
+	}
+	clearQuarantines()
+	defer clearQuarantines()
+
+	for tName, testCase := range map[string]func(t *testing.T){
+		"FindReturnsEmptyQuarantine": func(t *testing.T) {
+			q, err := FindQuarantine(ctx, env, "project")
+			require.NoError(t, err)
+			require.NotNil(t, q)
+			assert.Equal(t, "project", q.ProjectID)
+			assert.Empty(t, q.TestNames())
+		},
+		"SetDetectedFlakyTestsAppliesThresholds": func(t *testing.T) {
+			require.NoError(t, SetDetectedFlakyTests(ctx, env, "project", []TestFlakiness{
+				{TestName: "flaky", NumRuns: 4, NumFlakyRuns: 2, Score: 0.5},
+				{TestName: "too_few_flaky_runs", NumRuns: 1, NumFlakyRuns: 1, Score: 1},
+				{TestName: "stable", NumRuns: 100, NumFlakyRuns: 2, Score: 0.02},
+			}, 0.2, 2))
+
+			q, err := FindQuarantine(ctx, env, "project")
+			require.NoError(t, err)
+			require.Len(t, q.Detected, 1)
+			assert.Equal(t, "flaky", q.Detected[0].TestName)
+			assert.False(t, q.LastAnalyzed.IsZero())
+			assert.Equal(t, []string{"flaky"}, q.TestNames())
+			assert.True(t, q.IsQuarantined("flaky"))
+			assert.False(t, q.IsQuarantined("stable"))
+		},
+		"SetDetectedFlakyTestsReplacesPreviousDetections": func(t *testing.T) {
+			require.NoError(t, SetDetectedFlakyTests(ctx, env, "project", []TestFlakiness{{TestName: "old", NumFlakyRuns: 2, Score: 1}}, 0.2, 2))
+			require.NoError(t, SetDetectedFlakyTests(ctx, env, "project", []TestFlakiness{{TestName: "new", NumFlakyRuns: 2, Score: 1}}, 0.2, 2))
+
+			names, err := FindQuarantinedTestNames(ctx, env, "project")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"new"}, names)
+		},
+		"ManualQuarantineSurvivesDetection": func(t *testing.T) {
+			require.NoError(t, QuarantineTests(ctx, env, "project", []string{"manual"}))
+			require.NoError(t, SetDetectedFlakyTests(ctx, env, "project", []TestFlakiness{{TestName: "detected", NumFlakyRuns: 2, Score: 1}}, 0.2, 2))
+
+			names, err := FindQuarantinedTestNames(ctx, env, "project")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"detected", "manual"}, names)
+		},
+		"UnquarantineExemptsDetectedTests": func(t *testing.T) {
+			require.NoError(t, QuarantineTests(ctx, env, "project", []string{"manual"}))
+			require.NoError(t, SetDetectedFlakyTests(ctx, env, "project", []TestFlakiness{{TestName: "detected", NumFlakyRuns: 2, Score: 1}}, 0.2, 2))
+			require.NoError(t, UnquarantineTests(ctx, env, "project", []string{"manual", "detected"}))
+
+			q, err := FindQuarantine(ctx, env, "project")
+			require.NoError(t, err)
+			assert.Empty(t, q.TestNames())
+			assert.ElementsMatch(t, []string{"manual", "detected"}, q.Exempt)
+
+			require.NoError(t, SetDetectedFlakyTests(ctx, env, "project", []TestFlakiness{{TestName: "detected", NumFlakyRuns: 2, Score: 1}}, 0.2, 2))
+			names, err := FindQuarantinedTestNames(ctx, env, "project")
+			require.NoError(t, err)
+			assert.Empty(t, names)
+		},
+		"QuarantineRemovesExemption": func(t *testing.T) {
+			require.NoError(t, UnquarantineTests(ctx, env, "project", []string{"test"}))
+			require.NoError(t, QuarantineTests(ctx, env, "project", []string{"test"}))
+
+			q, err := FindQuarantine(ctx, env, "project")
+			require.NoError(t, err)
+			assert.Empty(t, q.Exempt)
+			assert.Equal(t, []string{"test"}, q.TestNames())
+		},
+		"QuarantinesArePerProject": func(t *testing.T) {
+			require.NoError(t, QuarantineTests(ctx, env, "project", []string{"test"}))
+
+			names, err := FindQuarantinedTestNames(ctx, env, "other_project")
+			require.NoError(t, err)
+			assert.Empty(t, names)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearQuarantines()
+			testCase(t)
+		})
+	}
+}
@@ -325,4 +325,8 @@ type FilterOptions struct {
 	Limit               int
 	Page                int
 	BaseTasks           []TaskOptions
+
+	// ExcludeTestNames contains the display names of tests, such as
+	// quarantined tests, to omit from the results.
+	ExcludeTestNames []string
 }
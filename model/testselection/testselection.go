@@ -0,0 +1,425 @@
+// Package testselection implements Evergreen's built-in test selection. It
+// learns which tests fail in a task when particular files are changed in a
+// patch and uses that history to rank the tests most likely to be affected by
+// a new patch's changes.
+package testselection
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// FileStatsCollection is the name of the collection containing the
+	// number of times each task has run with a file changed.
+	FileStatsCollection = "test_selection_file_stats"
+	// TestFailuresCollection is the name of the collection containing the
+	// number of times each test has failed in a task with a file changed.
+	TestFailuresCollection = "test_selection_test_failures"
+	// StatusCollection is the name of the collection containing the
+	// per-project learning progress.
+	StatusCollection = "test_selection_status"
+	// RecommendationsCollection is the name of the collection containing the
+	// tests recommended to each task in a patch.
+	RecommendationsCollection = "test_selection_recommendations"
+
+	// MaxChangedFiles is the maximum number of changed files a patch can
+	// have for its results to be learned from. Tests failing in very large
+	// patches say little about any one of the patch's files.
+	MaxChangedFiles = 100
+	// MaxRecommendedTests is the maximum number of tests recommended to a
+	// task when no candidate tests are given.
+	MaxRecommendedTests = 100
+)
+
+const (
+	// ModePrioritize returns every candidate test, with the recommended
+	// tests first.
+	ModePrioritize = "prioritize"
+	// ModeExclusive returns only the recommended tests, or every candidate
+	// test if there are no recommendations.
+	ModeExclusive = "exclusive"
+)
+
+// ValidModes are the valid test selection modes.
+var ValidModes = []string{ModePrioritize, ModeExclusive}
+
+// fileStats is the number of times a task has run in a patch that changed a
+// file.
+type fileStats struct {
+	ID          fileStatsID `bson:"_id"`
+	NumRuns     int         `bson:"num_runs"`
+	LastUpdated time.Time   `bson:"last_updated"`
+}
+
+type fileStatsID struct {
+	ProjectID string `bson:"project"`
+	TaskName  string `bson:"task_name"`
+	File      string `bson:"file"`
+}
+
+// testFailures is the number of times a test has failed in a task that ran in
+// a patch that changed a file.
+type testFailures struct {
+	ID          testFailuresID `bson:"_id"`
+	NumFailures int            `bson:"num_failures"`
+	LastUpdated time.Time      `bson:"last_updated"`
+}
+
+type testFailuresID struct {
+	ProjectID string `bson:"project"`
+	TaskName  string `bson:"task_name"`
+	File      string `bson:"file"`
+	TestName  string `bson:"test"`
+}
+
+var (
+	fileStatsIDKey          = bsonutil.MustHaveTag(fileStats{}, "ID")
+	fileStatsNumRunsKey     = bsonutil.MustHaveTag(fileStats{}, "NumRuns")
+	fileStatsLastUpdatedKey = bsonutil.MustHaveTag(fileStats{}, "LastUpdated")
+
+	testFailuresIDKey          = bsonutil.MustHaveTag(testFailures{}, "ID")
+	testFailuresNumFailuresKey = bsonutil.MustHaveTag(testFailures{}, "NumFailures")
+	testFailuresLastUpdatedKey = bsonutil.MustHaveTag(testFailures{}, "LastUpdated")
+
+	idTestNameKey = bsonutil.MustHaveTag(testFailuresID{}, "TestName")
+)
+
+// testFailuresIDUpperBound is the upper bound of the IDs of a file's test
+// failures. Its fields must be in the same order as testFailuresID's so that
+// it sorts after every test failure ID with the same project, task, and file.
+type testFailuresIDUpperBound struct {
+	ProjectID string           `bson:"project"`
+	TaskName  string           `bson:"task_name"`
+	File      string           `bson:"file"`
+	TestName  primitive.MaxKey `bson:"test"`
+}
+
+// TaskRun is the outcome of a single task run in a patch.
+type TaskRun struct {
+	ProjectID string
+	// TaskName is the display name of the task.
+	TaskName string
+	// ChangedFiles are the paths of the files changed by the task's patch.
+	ChangedFiles []string
+	// FailedTests are the display names of the task's failed tests.
+	FailedTests []string
+}
+
+// RecordTaskRun learns from the outcome of a task run. Runs in patches with no
+// changed files or more than MaxChangedFiles changed files are ignored.
+func RecordTaskRun(ctx context.Context, env evergreen.Environment, run TaskRun) error {
+	if len(run.ChangedFiles) == 0 || len(run.ChangedFiles) > MaxChangedFiles {
+		return nil
+	}
+
+	now := time.Now()
+	var fileModels, failureModels []mongo.WriteModel
+	for _, file := range utility.UniqueStrings(run.ChangedFiles) {
+		fileModels = append(fileModels, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{fileStatsIDKey: fileStatsID{ProjectID: run.ProjectID, TaskName: run.TaskName, File: file}}).
+			SetUpdate(bson.M{
+				"$inc": bson.M{fileStatsNumRunsKey: 1},
+				"$set": bson.M{fileStatsLastUpdatedKey: now},
+			}).
+			SetUpsert(true))
+		for _, testName := range utility.UniqueStrings(run.FailedTests) {
+			failureModels = append(failureModels, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{testFailuresIDKey: testFailuresID{ProjectID: run.ProjectID, TaskName: run.TaskName, File: file, TestName: testName}}).
+				SetUpdate(bson.M{
+					"$inc": bson.M{testFailuresNumFailuresKey: 1},
+					"$set": bson.M{testFailuresLastUpdatedKey: now},
+				}).
+				SetUpsert(true))
+		}
+	}
+
+	if _, err := env.DB().Collection(FileStatsCollection).BulkWrite(ctx, fileModels, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errors.Wrapf(err, "recording file stats for task '%s' in project '%s'", run.TaskName, run.ProjectID)
+	}
+	if len(failureModels) == 0 {
+		return nil
+	}
+	if _, err := env.DB().Collection(TestFailuresCollection).BulkWrite(ctx, failureModels, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errors.Wrapf(err, "recording test failures for task '%s' in project '%s'", run.TaskName, run.ProjectID)
+	}
+
+	return nil
+}
+
+// RankOptions are the options for ranking tests.
+type RankOptions struct {
+	ProjectID string
+	// TaskName is the display name of the task.
+	TaskName string
+	// ChangedFiles are the paths of the files changed by the patch.
+	ChangedFiles []string
+	// Tests are the display names of the candidate tests. If empty, only
+	// tests that have previously failed with the changed files are ranked.
+	Tests []string
+}
+
+// TestScore is a test's likelihood of being affected by a set of changed
+// files.
+type TestScore struct {
+	// TestName is the display name of the test.
+	TestName string `json:"test_name"`
+	// Score is the sum, over each changed file, of the fraction of the
+	// task's runs with that file changed in which the test failed. Tests
+	// that never failed with any of the changed files have a score of 0.
+	Score float64 `json:"score"`
+}
+
+// Rank returns the tests ranked by how likely they are to fail given the
+// changed files, most likely first. If candidate tests are given, all of them
+// are returned and tests with equal scores keep their given order.
+func Rank(ctx context.Context, env evergreen.Environment, opts RankOptions) ([]TestScore, error) {
+	if len(opts.ChangedFiles) == 0 {
+		return scoreTests(nil, nil, opts.Tests), nil
+	}
+
+	// Query by whole IDs rather than by the IDs' fields so that the queries
+	// can use the _id index.
+	var fileIDs []fileStatsID
+	for _, file := range utility.UniqueStrings(opts.ChangedFiles) {
+		fileIDs = append(fileIDs, fileStatsID{ProjectID: opts.ProjectID, TaskName: opts.TaskName, File: file})
+	}
+	cur, err := env.DB().Collection(FileStatsCollection).Find(ctx, bson.M{fileStatsIDKey: bson.M{"$in": fileIDs}})
+	if err != nil {
+		return nil, errors.Wrap(err, "finding file stats")
+	}
+	var stats []fileStats
+	if err = cur.All(ctx, &stats); err != nil {
+		return nil, errors.Wrap(err, "decoding file stats")
+	}
+	if len(stats) == 0 {
+		return scoreTests(nil, nil, opts.Tests), nil
+	}
+
+	// Every test failure ID for a file sorts after the file's stats ID, which
+	// has the same fields but no test.
+	fileRanges := make([]bson.M, 0, len(stats))
+	for _, s := range stats {
+		fileRanges = append(fileRanges, bson.M{testFailuresIDKey: bson.M{
+			"$gt": s.ID,
+			"$lt": testFailuresIDUpperBound{ProjectID: s.ID.ProjectID, TaskName: s.ID.TaskName, File: s.ID.File},
+		}})
+	}
+	failureFilter := bson.M{"$or": fileRanges}
+	if len(opts.Tests) > 0 {
+		failureFilter[bsonutil.GetDottedKeyName(testFailuresIDKey, idTestNameKey)] = bson.M{"$in": opts.Tests}
+	}
+	cur, err = env.DB().Collection(TestFailuresCollection).Find(ctx, failureFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding test failures")
+	}
+	var failures []testFailures
+	if err = cur.All(ctx, &failures); err != nil {
+		return nil, errors.Wrap(err, "decoding test failures")
+	}
+
+	return scoreTests(stats, failures, opts.Tests), nil
+}
+
+// scoreTests scores the tests using the given learned file stats and test
+// failures. If candidate tests are given, exactly those tests are scored;
+// otherwise, every test with a failure is scored.
+func scoreTests(stats []fileStats, failures []testFailures, tests []string) []TestScore {
+	fileRuns := map[string]int{}
+	for _, s := range stats {
+		fileRuns[s.ID.File] = s.NumRuns
+	}
+
+	scores := map[string]float64{}
+	for _, f := range failures {
+		numRuns := fileRuns[f.ID.File]
+		if numRuns == 0 {
+			continue
+		}
+		scores[f.ID.TestName] += float64(f.NumFailures) / float64(numRuns)
+	}
+
+	var ranked []TestScore
+	if len(tests) > 0 {
+		for _, testName := range utility.UniqueStrings(tests) {
+			ranked = append(ranked, TestScore{TestName: testName, Score: scores[testName]})
+		}
+		sort.SliceStable(ranked, func(i, j int) bool {
+			return ranked[i].Score > ranked[j].Score
+		})
+		return ranked
+	}
+
+	for testName, score := range scores {
+		ranked = append(ranked, TestScore{TestName: testName, Score: score})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].TestName < ranked[j].TestName
+	})
+
+	return ranked
+}
+
+// SelectTests returns the names of the ranked tests to run according to the
+// mode. An empty mode is the same as ModePrioritize.
+func SelectTests(scores []TestScore, mode string) []string {
+	var recommended, rest []string
+	for _, s := range scores {
+		if s.Score > 0 {
+			recommended = append(recommended, s.TestName)
+		} else {
+			rest = append(rest, s.TestName)
+		}
+	}
+
+	if mode == ModeExclusive && len(recommended) > 0 {
+		return recommended
+	}
+
+	return append(recommended, rest...)
+}
+
+// RecommendedTests returns the names of up to MaxRecommendedTests tests that
+// are most likely to fail given the changed files.
+func RecommendedTests(ctx context.Context, env evergreen.Environment, projectID, taskName string, changedFiles []string) ([]string, error) {
+	scores, err := Rank(ctx, env, RankOptions{
+		ProjectID:    projectID,
+		TaskName:     taskName,
+		ChangedFiles: changedFiles,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "ranking tests for task '%s' in project '%s'", taskName, projectID)
+	}
+	if len(scores) > MaxRecommendedTests {
+		scores = scores[:MaxRecommendedTests]
+	}
+
+	return SelectTests(scores, ModeExclusive), nil
+}
+
+// recommendation is the tests recommended to a task in a patch.
+type recommendation struct {
+	ID    recommendationID `bson:"_id"`
+	Tests []string         `bson:"tests"`
+}
+
+type recommendationID struct {
+	VersionID string `bson:"version"`
+	// TaskName is the display name of the task.
+	TaskName string `bson:"task_name"`
+}
+
+var recommendationIDKey = bsonutil.MustHaveTag(recommendation{}, "ID")
+
+// SaveRecommendedTests ranks the tests for each of the patch's tasks once and
+// stores the recommended tests so that they can be looked up when the tasks
+// run. Tasks without any recommended tests are not stored.
+func SaveRecommendedTests(ctx context.Context, env evergreen.Environment, versionID, projectID string, taskNames, changedFiles []string) error {
+	if len(changedFiles) == 0 || len(changedFiles) > MaxChangedFiles {
+		return nil
+	}
+
+	var models []mongo.WriteModel
+	for _, taskName := range utility.UniqueStrings(taskNames) {
+		tests, err := RecommendedTests(ctx, env, projectID, taskName, changedFiles)
+		if err != nil {
+			return errors.Wrapf(err, "getting recommended tests for version '%s'", versionID)
+		}
+		if len(tests) == 0 {
+			continue
+		}
+		rec := recommendation{
+			ID:    recommendationID{VersionID: versionID, TaskName: taskName},
+			Tests: tests,
+		}
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{recommendationIDKey: rec.ID}).
+			SetReplacement(rec).
+			SetUpsert(true))
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	_, err := env.DB().Collection(RecommendationsCollection).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return errors.Wrapf(err, "saving recommended tests for version '%s'", versionID)
+}
+
+// FindRecommendedTests returns the tests stored by SaveRecommendedTests for
+// the task in the patch, or nil if there are none.
+func FindRecommendedTests(ctx context.Context, env evergreen.Environment, versionID, taskName string) ([]string, error) {
+	rec := recommendation{}
+	err := env.DB().Collection(RecommendationsCollection).FindOne(ctx, bson.M{
+		recommendationIDKey: recommendationID{VersionID: versionID, TaskName: taskName},
+	}).Decode(&rec)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding recommended tests for task '%s' in version '%s'", taskName, versionID)
+	}
+
+	return rec.Tests, nil
+}
+
+// LearningStatus is a project's test selection learning progress.
+type LearningStatus struct {
+	ProjectID string `bson:"_id"`
+	// LearnedUntil is the finish time of the most recent task that has been
+	// learned from.
+	LearnedUntil time.Time `bson:"learned_until"`
+}
+
+var (
+	learningStatusProjectIDKey    = bsonutil.MustHaveTag(LearningStatus{}, "ProjectID")
+	learningStatusLearnedUntilKey = bsonutil.MustHaveTag(LearningStatus{}, "LearnedUntil")
+)
+
+// GetLearningStatus returns the project's learning progress. If the project
+// has never been learned from, the returned status has a zero LearnedUntil.
+func GetLearningStatus(ctx context.Context, env evergreen.Environment, projectID string) (*LearningStatus, error) {
+	status := &LearningStatus{}
+	err := env.DB().Collection(StatusCollection).FindOne(ctx, bson.M{learningStatusProjectIDKey: projectID}).Decode(status)
+	if err == mongo.ErrNoDocuments {
+		return &LearningStatus{ProjectID: projectID}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding test selection learning status for project '%s'", projectID)
+	}
+
+	return status, nil
+}
+
+// SetLearnedUntil records that the project's tasks that finished at or before
+// the given time have been learned from.
+func SetLearnedUntil(ctx context.Context, env evergreen.Environment, projectID string, learnedUntil time.Time) error {
+	_, err := env.DB().Collection(StatusCollection).UpdateOne(ctx,
+		bson.M{learningStatusProjectIDKey: projectID},
+		bson.M{"$set": bson.M{learningStatusLearnedUntilKey: learnedUntil}},
+		options.Update().SetUpsert(true),
+	)
+
+	return errors.Wrapf(err, "setting test selection learning status for project '%s'", projectID)
+}
+
+// ValidateMode returns an error if the mode is neither empty nor a valid mode.
+func ValidateMode(mode string) error {
+	if mode == "" || utility.StringSliceContains(ValidModes, mode) {
+		return nil
+	}
+
+	return errors.Errorf("invalid test selection mode '%s', must be one of: %v", mode, ValidModes)
+}
@@ -0,0 +1,127 @@
+package testselection
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectTests(t *testing.T) {
+	scores := []TestScore{
+		{TestName: "t0", Score: 1.5},
+		{TestName: "t1", Score: 0.5},
+		{TestName: "t2"},
+		{TestName: "t3"},
+	}
+
+	t.Run("PrioritizeReturnsAllTests", func(t *testing.T) {
+		assert.Equal(t, []string{"t0", "t1", "t2", "t3"}, SelectTests(scores, ModePrioritize))
+		assert.Equal(t, []string{"t0", "t1", "t2", "t3"}, SelectTests(scores, ""))
+	})
+	t.Run("ExclusiveReturnsOnlyRecommendedTests", func(t *testing.T) {
+		assert.Equal(t, []string{"t0", "t1"}, SelectTests(scores, ModeExclusive))
+	})
+	t.Run("ExclusiveReturnsAllTestsWithoutRecommendations", func(t *testing.T) {
+		assert.Equal(t, []string{"t2", "t3"}, SelectTests(scores[2:], ModeExclusive))
+	})
+}
+
+func TestRank(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(FileStatsCollection, TestFailuresCollection, StatusCollection, RecommendationsCollection))
+	}
+	clearAll()
+	defer clearAll()
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"ScoresTestsByFailureRateWithChangedFiles": func(t *testing.T) {
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go"}, FailedTests: []string{"test_a"}}))
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go", "b.go"}, FailedTests: []string{"test_a", "test_b"}}))
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"b.go"}}))
+
+			scores, err := Rank(ctx, env, RankOptions{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go", "b.go"}})
+			require.NoError(t, err)
+			require.Len(t, scores, 2)
+			assert.Equal(t, "test_a", scores[0].TestName)
+			assert.Equal(t, 1.5, scores[0].Score)
+			assert.Equal(t, "test_b", scores[1].TestName)
+			assert.Equal(t, 1.0, scores[1].Score)
+		},
+		"ReturnsAllCandidateTestsInStableOrder": func(t *testing.T) {
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go"}, FailedTests: []string{"test_c", "test_other"}}))
+
+			scores, err := Rank(ctx, env, RankOptions{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go"}, Tests: []string{"test_a", "test_b", "test_c"}})
+			require.NoError(t, err)
+			assert.Equal(t, []TestScore{{TestName: "test_c", Score: 1}, {TestName: "test_a"}, {TestName: "test_b"}}, scores)
+		},
+		"IgnoresOtherTasksAndProjects": func(t *testing.T) {
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "other_task", ChangedFiles: []string{"a.go"}, FailedTests: []string{"test_a"}}))
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "other_project", TaskName: "task", ChangedFiles: []string{"a.go"}, FailedTests: []string{"test_a"}}))
+
+			scores, err := Rank(ctx, env, RankOptions{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go"}})
+			require.NoError(t, err)
+			assert.Empty(t, scores)
+		},
+		"IgnoresRunsWithTooManyChangedFiles": func(t *testing.T) {
+			files := make([]string, MaxChangedFiles+1)
+			for i := range files {
+				files[i] = fmt.Sprintf("file%d.go", i)
+			}
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "task", ChangedFiles: files, FailedTests: []string{"test_a"}}))
+
+			scores, err := Rank(ctx, env, RankOptions{ProjectID: "project", TaskName: "task", ChangedFiles: files[:1]})
+			require.NoError(t, err)
+			assert.Empty(t, scores)
+		},
+		"IgnoresFilesThatArePrefixesOfChangedFiles": func(t *testing.T) {
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go"}, FailedTests: []string{"test_a"}}))
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go.orig"}, FailedTests: []string{"test_orig"}}))
+
+			scores, err := Rank(ctx, env, RankOptions{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go"}})
+			require.NoError(t, err)
+			assert.Equal(t, []TestScore{{TestName: "test_a", Score: 1}}, scores)
+		},
+		"SavesRecommendedTestsForEachTask": func(t *testing.T) {
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go"}, FailedTests: []string{"test_a"}}))
+			require.NoError(t, RecordTaskRun(ctx, env, TaskRun{ProjectID: "project", TaskName: "other_task", ChangedFiles: []string{"b.go"}, FailedTests: []string{"test_b"}}))
+
+			require.NoError(t, SaveRecommendedTests(ctx, env, "version", "project", []string{"task", "other_task", "task"}, []string{"a.go"}))
+
+			tests, err := FindRecommendedTests(ctx, env, "version", "task")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"test_a"}, tests)
+			tests, err = FindRecommendedTests(ctx, env, "version", "other_task")
+			require.NoError(t, err)
+			assert.Empty(t, tests)
+			tests, err = FindRecommendedTests(ctx, env, "other_version", "task")
+			require.NoError(t, err)
+			assert.Empty(t, tests)
+		},
+		"LearningStatusDefaultsToZero": func(t *testing.T) {
+			status, err := GetLearningStatus(ctx, env, "project")
+			require.NoError(t, err)
+			assert.True(t, status.LearnedUntil.IsZero())
+
+			learnedUntil := time.Now().Round(time.Millisecond)
+			require.NoError(t, SetLearnedUntil(ctx, env, "project", learnedUntil))
+			status, err = GetLearningStatus(ctx, env, "project")
+			require.NoError(t, err)
+			assert.True(t, learnedUntil.Equal(status.LearnedUntil))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			tCase(t)
+		})
+	}
+}
@@ -73,6 +73,10 @@ type Version struct {
 	Errors   []string `bson:"errors,omitempty" json:"errors,omitempty"`
 	Warnings []string `bson:"warnings,omitempty" json:"warnings,omitempty"`
 
+	// PathSkippedTasks are the tasks that were not created for this version
+	// because it didn't change any of the files they watch.
+	PathSkippedTasks []PathSkippedTask `bson:"path_skipped_tasks,omitempty" json:"path_skipped_tasks,omitempty"`
+
 	// AuthorID is an optional reference to the Evergreen user that authored
 	// this comment, if they can be identified
 	AuthorID string `bson:"author_id,omitempty" json:"author_id,omitempty"`
@@ -310,6 +314,9 @@ type VersionMetadata struct {
 	PeriodicBuildID     string
 	RemotePath          string
 	GitTag              GitTag
+	// ChangedFiles are the files changed by the revision, if known. They are
+	// used to skip tasks that don't watch any of the changed files.
+	ChangedFiles []string
 }
 
 var (
@@ -0,0 +1,154 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v3"
+)
+
+func RunLocal() cli.Command {
+	const (
+		taskFlagName           = "task"
+		variantFlagName        = "variant"
+		expansionFlagName      = "expansion"
+		expansionsFileFlagName = "expansions-file"
+		outputFlagName         = "output"
+	)
+
+	return cli.Command{
+		Name:  "run-local",
+		Usage: "run a single task from a project configuration on this machine without an Evergreen server",
+		Flags: addPathFlag(
+			cli.StringFlag{
+				Name:  joinFlagNames(taskFlagName, "t"),
+				Usage: "name of the task to run",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(variantFlagName, "v"),
+				Usage: "name of the build variant to run the task on",
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(expansionFlagName, "e"),
+				Usage: "specify an expansion as a KEY=VALUE pair, which overrides expansions set in the expansions file",
+			},
+			cli.StringFlag{
+				Name:  expansionsFileFlagName,
+				Usage: "path to a YAML file of expansions in KEY: VALUE format",
+			},
+			cli.StringFlag{
+				Name:  dirFlagName,
+				Usage: "directory to run the task in (default: a new temporary directory)",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(outputFlagName, "o"),
+				Usage: "directory to write task logs, test results and artifacts to (default: a new temporary directory)",
+			},
+		),
+		Before: mergeBeforeFuncs(
+			requirePathFlag,
+			requireStringFlag(taskFlagName),
+			requireStringFlag(variantFlagName),
+		),
+		Action: func(c *cli.Context) error {
+			path := c.String(pathFlagName)
+			if path == "" {
+				path = c.Args().Get(0)
+			}
+
+			expansions, err := getLocalExpansions(c.String(expansionsFileFlagName), c.StringSlice(expansionFlagName))
+			if err != nil {
+				return errors.Wrap(err, "getting expansions")
+			}
+
+			configBytes, err := os.ReadFile(path)
+			if err != nil {
+				return errors.Wrap(err, "reading project config")
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			p := &model.Project{}
+			opts := &model.GetProjectOpts{
+				ReadFileFrom: model.ReadFromLocal,
+			}
+			if _, err = model.LoadProjectInto(ctx, configBytes, opts, "", p); err != nil {
+				return errors.Wrap(err, "loading project")
+			}
+
+			workDir, err := getLocalRunDirectory(c.String(dirFlagName), "evergreen-task-")
+			if err != nil {
+				return errors.Wrap(err, "getting working directory")
+			}
+			outputDir, err := getLocalRunDirectory(c.String(outputFlagName), "evergreen-output-")
+			if err != nil {
+				return errors.Wrap(err, "getting output directory")
+			}
+
+			res, err := agent.RunLocalTask(ctx, agent.LocalTaskOptions{
+				Project:          p,
+				TaskName:         c.String(taskFlagName),
+				BuildVariant:     c.String(variantFlagName),
+				Expansions:       expansions,
+				WorkingDirectory: workDir,
+				OutputDirectory:  outputDir,
+			})
+			if err != nil {
+				return errors.Wrap(err, "running task")
+			}
+
+			fmt.Printf("Task finished with status '%s'.\n", res.Status)
+			if res.Details.FailingCommand != "" {
+				fmt.Printf("Failing command: %s\n", res.Details.FailingCommand)
+			}
+			fmt.Printf("Task directory: %s\n", res.WorkingDirectory)
+			fmt.Printf("Task output: %s\n", res.OutputDirectory)
+
+			if res.Status != evergreen.TaskSucceeded {
+				return errors.Errorf("task finished with status '%s'", res.Status)
+			}
+			return nil
+		},
+	}
+}
+
+// getLocalExpansions reads the expansions from the YAML expansions file, if
+// any, and then applies the expansions given as KEY=VALUE pairs on top of them.
+func getLocalExpansions(fileName string, pairs []string) (map[string]string, error) {
+	expansions := map[string]string{}
+	if fileName != "" {
+		data, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading expansions file '%s'", fileName)
+		}
+		if err = yaml.Unmarshal(data, &expansions); err != nil {
+			return nil, errors.Wrapf(err, "unmarshalling expansions file '%s'", fileName)
+		}
+	}
+
+	params, err := getParametersFromInput(pairs)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing expansions")
+	}
+	for _, param := range params {
+		expansions[param.Key] = param.Value
+	}
+
+	return expansions, nil
+}
+
+// getLocalRunDirectory returns the absolute path of the given directory, or a
+// new temporary directory with the given prefix if none is given.
+func getLocalRunDirectory(dir, tempPrefix string) (string, error) {
+	if dir == "" {
+		return os.MkdirTemp("", tempPrefix)
+	}
+	return filepath.Abs(dir)
+}
@@ -0,0 +1,34 @@
+package operations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLocalExpansions(t *testing.T) {
+	t.Run("ParsesPairs", func(t *testing.T) {
+		expansions, err := getLocalExpansions("", []string{"key1=value1", "key2=value=with=equals"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"key1": "value1", "key2": "value=with=equals"}, expansions)
+	})
+	t.Run("PairsOverrideFile", func(t *testing.T) {
+		fileName := filepath.Join(t.TempDir(), "expansions.yml")
+		require.NoError(t, os.WriteFile(fileName, []byte("key1: file_value1\nkey2: file_value2\n"), 0644))
+
+		expansions, err := getLocalExpansions(fileName, []string{"key2=value2"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"key1": "file_value1", "key2": "value2"}, expansions)
+	})
+	t.Run("FailsWithInvalidPair", func(t *testing.T) {
+		_, err := getLocalExpansions("", []string{"key1"})
+		assert.Error(t, err)
+	})
+	t.Run("FailsWithNonexistentFile", func(t *testing.T) {
+		_, err := getLocalExpansions(filepath.Join(t.TempDir(), "nonexistent.yml"), nil)
+		assert.Error(t, err)
+	})
+}
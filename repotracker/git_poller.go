@@ -0,0 +1,155 @@
+package repotracker
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// GitRepositoryPoller is a struct that implements the behavior required of a
+// RepoPoller for any git remote, using a mirror clone of the remote rather
+// than a hosting service's API.
+type GitRepositoryPoller struct {
+	ProjectRef *model.ProjectRef
+	mirror     *thirdparty.GitMirror
+}
+
+// NewGitRepositoryPoller constructs and returns a pointer to a
+// GitRepositoryPoller struct
+func NewGitRepositoryPoller(projectRef *model.ProjectRef) *GitRepositoryPoller {
+	return &GitRepositoryPoller{
+		ProjectRef: projectRef,
+		mirror:     projectRef.GitRemote.Mirror(),
+	}
+}
+
+// gitMirrorCommitToRevision converts a commit read from a mirror clone to
+// Evergreen's revision model.
+func gitMirrorCommitToRevision(commit thirdparty.GitMirrorCommit) model.Revision {
+	return model.Revision{
+		Author:          commit.AuthorName,
+		AuthorEmail:     commit.AuthorEmail,
+		RevisionMessage: commit.Message,
+		Revision:        commit.Hash,
+		CreateTime:      commit.CommitTime,
+	}
+}
+
+// branchRef returns the ref of the project's branch in the mirror.
+func (p *GitRepositoryPoller) branchRef() string {
+	return "refs/heads/" + p.ProjectRef.Branch
+}
+
+// GetRemoteConfig fetches the contents of the project's configuration file as
+// at a given revision from the mirror.
+func (p *GitRepositoryPoller) GetRemoteConfig(ctx context.Context, projectFileRevision string) (model.ProjectInfo, error) {
+	opts := model.GetProjectOpts{
+		Ref:        p.ProjectRef,
+		RemotePath: p.ProjectRef.RemotePath,
+		Revision:   projectFileRevision,
+	}
+	return model.GetProjectFromFile(ctx, opts)
+}
+
+// GetChangedFiles returns the files changed in the given revision.
+func (p *GitRepositoryPoller) GetChangedFiles(ctx context.Context, commitRevision string) ([]string, error) {
+	files, err := p.mirror.ChangedFiles(ctx, commitRevision)
+	return files, errors.Wrapf(err, "getting files changed in commit '%s'", commitRevision)
+}
+
+// GetRevisionsSince fetches all commits from the corresponding project ref's
+// branch that were made after 'revision', in order of most recent to least
+// recent. If it cannot find the revision within the maxRevisionsToSearch
+// limit, it will attempt to add the merge base between the most recent commit
+// and the given revision.
+func (p *GitRepositoryPoller) GetRevisionsSince(ctx context.Context, revision string, maxRevisionsToSearch int) ([]model.Revision, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	if err := p.mirror.Sync(ctx); err != nil {
+		return nil, errors.Wrapf(err, "syncing mirror for project ref '%s'", p.ProjectRef.Id)
+	}
+	commits, err := p.mirror.Log(ctx, p.branchRef(), maxRevisionsToSearch+1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting commits on branch '%s'", p.ProjectRef.Branch)
+	}
+
+	revisions := []model.Revision{}
+	for _, commit := range commits {
+		if commit.Hash == revision {
+			return revisions, nil
+		}
+		if len(revisions) >= maxRevisionsToSearch {
+			break
+		}
+		revisions = append(revisions, gitMirrorCommitToRevision(commit))
+	}
+
+	if len(revision) < 10 {
+		return nil, errors.Errorf("invalid revision '%s'", revision)
+	}
+
+	var baseRevision string
+	if len(commits) == 0 {
+		err = errors.New("no recent commit found")
+	} else if !p.mirror.HasRevision(ctx, revision) {
+		err = errors.Errorf("revision '%s' not found in remote", revision)
+	} else {
+		baseRevision, err = p.mirror.MergeBase(ctx, revision, commits[0].Hash)
+	}
+	if err != nil {
+		revisionDetails := &model.RepositoryErrorDetails{
+			Exists:          true,
+			InvalidRevision: revision[:10],
+		}
+		if err := p.ProjectRef.SetRepotrackerError(ctx, revisionDetails); err != nil {
+			return []model.Revision{}, errors.Wrap(err, "setting repotracker error")
+		}
+		return []model.Revision{}, errors.Wrapf(err, "unable to find a suggested merge base commit for revision '%s', must fix on projects settings page", revision)
+	}
+
+	commit, err := p.mirror.GetCommit(ctx, baseRevision)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting base commit '%s'", baseRevision)
+	}
+	revisions = append(revisions, gitMirrorCommitToRevision(*commit))
+
+	grip.Info(message.Fields{
+		"message":            "updating last repo revision for project",
+		"source":             "git poller",
+		"old_revision":       revision,
+		"new_revision":       baseRevision,
+		"project":            p.ProjectRef.Id,
+		"project_identifier": p.ProjectRef.Identifier,
+	})
+	if err = model.UpdateLastRevision(ctx, p.ProjectRef.Id, baseRevision); err != nil {
+		return nil, errors.Wrapf(err, "updating last revision to base revision '%s'", baseRevision)
+	}
+
+	return revisions, nil
+}
+
+// GetRecentRevisions fetches the most recent 'maxRevisions' revisions.
+func (p *GitRepositoryPoller) GetRecentRevisions(maxRevisions int) ([]model.Revision, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
+	defer cancel()
+
+	if err := p.mirror.Sync(ctx); err != nil {
+		return nil, errors.Wrapf(err, "syncing mirror for project ref '%s'", p.ProjectRef.Id)
+	}
+	commits, err := p.mirror.Log(ctx, p.branchRef(), maxRevisions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting commits on branch '%s'", p.ProjectRef.Branch)
+	}
+
+	revisions := make([]model.Revision, 0, len(commits))
+	for _, commit := range commits {
+		revisions = append(revisions, gitMirrorCommitToRevision(commit))
+	}
+	return revisions, nil
+}
@@ -0,0 +1,111 @@
+package repotracker
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gitInRepo runs a git command in the repo and returns its trimmed output.
+func gitInRepo(t *testing.T, repo string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repo
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Evergreen", "GIT_AUTHOR_EMAIL=evergreen@example.com",
+		"GIT_COMMITTER_NAME=Evergreen", "GIT_COMMITTER_EMAIL=evergreen@example.com",
+	)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+// commitToRepo writes and commits the file in the repo, returning the new
+// commit hash.
+func commitToRepo(t *testing.T, repo, name, contents string) string {
+	require.NoError(t, os.WriteFile(filepath.Join(repo, name), []byte(contents), 0644))
+	gitInRepo(t, repo, "add", name)
+	gitInRepo(t, repo, "commit", "-q", "-m", "update "+name)
+	return gitInRepo(t, repo, "rev-parse", "HEAD")
+}
+
+func TestGitRepositoryPoller(t *testing.T) {
+	ctx := t.Context()
+	thirdparty.GitMirrorDir = t.TempDir()
+
+	repo := t.TempDir()
+	gitInRepo(t, repo, "init", "-q", "-b", "main")
+	commits := []string{
+		commitToRepo(t, repo, "evergreen.yml", "tasks:\n  - name: compile\n"),
+		commitToRepo(t, repo, "a.txt", "a\n"),
+		commitToRepo(t, repo, "b.txt", "b\n"),
+		commitToRepo(t, repo, "c.txt", "c\n"),
+	}
+
+	pRef := &model.ProjectRef{
+		Id:         "project",
+		Branch:     "main",
+		RemotePath: "evergreen.yml",
+		GitRemote:  model.GitRemoteSettings{URL: "file://" + repo},
+	}
+	poller := NewGitRepositoryPoller(pRef)
+
+	t.Run("GetRecentRevisions", func(t *testing.T) {
+		revisions, err := poller.GetRecentRevisions(2)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, commits[3], revisions[0].Revision)
+		assert.Equal(t, "update c.txt", revisions[0].RevisionMessage)
+		assert.Equal(t, "Evergreen", revisions[0].Author)
+		assert.Equal(t, commits[2], revisions[1].Revision)
+	})
+	t.Run("GetRevisionsSince", func(t *testing.T) {
+		revisions, err := poller.GetRevisionsSince(ctx, commits[0], 10)
+		require.NoError(t, err)
+		require.Len(t, revisions, 3)
+		assert.Equal(t, commits[3], revisions[0].Revision)
+		assert.Equal(t, commits[1], revisions[2].Revision)
+	})
+	t.Run("GetRevisionsSinceLatest", func(t *testing.T) {
+		revisions, err := poller.GetRevisionsSince(ctx, commits[3], 10)
+		require.NoError(t, err)
+		assert.Empty(t, revisions)
+	})
+	t.Run("GetRevisionsSinceRewrittenRevisionUsesMergeBase", func(t *testing.T) {
+		gitInRepo(t, repo, "checkout", "-q", "-b", "rewritten", commits[1])
+		rewritten := commitToRepo(t, repo, "d.txt", "d\n")
+		gitInRepo(t, repo, "checkout", "-q", "main")
+
+		require.NoError(t, db.ClearCollections(model.RepositoriesCollection))
+		require.NoError(t, db.Insert(model.RepositoriesCollection, model.Repository{Project: "project", LastRevision: rewritten}))
+
+		revisions, err := poller.GetRevisionsSince(ctx, rewritten, 10)
+		require.NoError(t, err)
+		require.Len(t, revisions, 5)
+		assert.Equal(t, commits[1], revisions[4].Revision)
+
+		repository, err := model.FindRepository(ctx, "project")
+		require.NoError(t, err)
+		require.NotZero(t, repository)
+		assert.Equal(t, commits[1], repository.LastRevision)
+	})
+	t.Run("GetChangedFiles", func(t *testing.T) {
+		files, err := poller.GetChangedFiles(ctx, commits[2])
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b.txt"}, files)
+	})
+	t.Run("GetRemoteConfig", func(t *testing.T) {
+		info, err := poller.GetRemoteConfig(ctx, commits[3])
+		require.NoError(t, err)
+		require.NotNil(t, info.Project)
+		require.Len(t, info.Project.Tasks, 1)
+		assert.Equal(t, "compile", info.Project.Tasks[0].Name)
+	})
+}
@@ -0,0 +1,169 @@
+package repotracker
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// GitLabRepositoryPoller is a struct that implements GitLab specific behavior
+// required of a RepoPoller
+type GitLabRepositoryPoller struct {
+	ProjectRef *model.ProjectRef
+	client     *thirdparty.GitLabClient
+}
+
+// NewGitLabRepositoryPoller constructs and returns a pointer to a
+// GitLabRepositoryPoller struct
+func NewGitLabRepositoryPoller(projectRef *model.ProjectRef) *GitLabRepositoryPoller {
+	return &GitLabRepositoryPoller{
+		ProjectRef: projectRef,
+		client:     projectRef.GitLab.Client(),
+	}
+}
+
+// gitLabCommitToRevision converts a GitLab commit to Evergreen's revision
+// model.
+func gitLabCommitToRevision(commit thirdparty.GitLabCommit) model.Revision {
+	return model.Revision{
+		Author:          commit.AuthorName,
+		AuthorEmail:     commit.AuthorEmail,
+		RevisionMessage: commit.Message,
+		Revision:        commit.ID,
+		CreateTime:      commit.CommittedDate,
+	}
+}
+
+// GetRemoteConfig fetches the contents of a remote GitLab repository's
+// configuration data as at a given revision
+func (p *GitLabRepositoryPoller) GetRemoteConfig(ctx context.Context, projectFileRevision string) (model.ProjectInfo, error) {
+	opts := model.GetProjectOpts{
+		Ref:        p.ProjectRef,
+		RemotePath: p.ProjectRef.RemotePath,
+		Revision:   projectFileRevision,
+	}
+	return model.GetProjectFromFile(ctx, opts)
+}
+
+// GetChangedFiles returns the files changed in the given revision.
+func (p *GitLabRepositoryPoller) GetChangedFiles(ctx context.Context, commitRevision string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	files, err := p.client.GetCommitChangedFiles(ctx, p.ProjectRef.GitLab.ProjectPath(), commitRevision)
+	return files, errors.Wrapf(err, "loading commit '%s'", commitRevision)
+}
+
+// GetRevisionsSince fetches all commits from the corresponding project ref
+// that were made after 'revision', in order of most recent to least recent.
+// If it cannot find the revision within the maxRevisionsToSearch limit, it
+// will attempt to add the merge base between the most recent commit and the
+// given revision.
+func (p *GitLabRepositoryPoller) GetRevisionsSince(ctx context.Context, revision string, maxRevisionsToSearch int) ([]model.Revision, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	projectPath := p.ProjectRef.GitLab.ProjectPath()
+	var foundLatest bool
+	var firstCommit string
+	revisions := []model.Revision{}
+
+	for page := 1; page != 0 && len(revisions) < maxRevisionsToSearch; {
+		commits, nextPage, err := p.client.GetCommits(ctx, projectPath, p.ProjectRef.Branch, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, commit := range commits {
+			if len(revisions) >= maxRevisionsToSearch {
+				break
+			}
+			if commit.ID == "" {
+				return nil, errors.Errorf("GitLab returned commit history with missing information for project ref '%s'", p.ProjectRef.Id)
+			}
+			if firstCommit == "" {
+				firstCommit = commit.ID
+			}
+			if commit.ID == revision {
+				foundLatest = true
+				break
+			}
+			revisions = append(revisions, gitLabCommitToRevision(commit))
+		}
+		if foundLatest {
+			break
+		}
+		page = nextPage
+	}
+
+	if !foundLatest {
+		if len(revision) < 10 {
+			return nil, errors.Errorf("invalid revision '%s'", revision)
+		}
+
+		var err error
+		var baseRevision string
+		if firstCommit != "" {
+			baseRevision, err = p.client.GetMergeBase(ctx, projectPath, revision, firstCommit)
+		} else {
+			err = errors.New("no recent commit found")
+		}
+		if err != nil {
+			revisionDetails := &model.RepositoryErrorDetails{
+				Exists:          true,
+				InvalidRevision: revision[:10],
+			}
+			if err := p.ProjectRef.SetRepotrackerError(ctx, revisionDetails); err != nil {
+				return []model.Revision{}, errors.Wrap(err, "setting repotracker error")
+			}
+			return []model.Revision{}, errors.Wrapf(err, "unable to find a suggested merge base commit for revision '%s', must fix on projects settings page", revision)
+		}
+
+		commit, err := p.client.GetCommit(ctx, projectPath, baseRevision)
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading base commit '%s'", baseRevision)
+		}
+		revisions = append(revisions, gitLabCommitToRevision(*commit))
+
+		grip.Info(message.Fields{
+			"message":            "updating last repo revision for project",
+			"source":             "gitlab poller",
+			"old_revision":       revision,
+			"new_revision":       baseRevision,
+			"project":            p.ProjectRef.Id,
+			"project_identifier": p.ProjectRef.Identifier,
+		})
+		if err = model.UpdateLastRevision(ctx, p.ProjectRef.Id, baseRevision); err != nil {
+			return nil, errors.Wrapf(err, "updating last revision to base revision '%s'", baseRevision)
+		}
+	}
+
+	return revisions, nil
+}
+
+// GetRecentRevisions fetches the most recent 'maxRevisions' revisions.
+func (p *GitLabRepositoryPoller) GetRecentRevisions(maxRevisions int) ([]model.Revision, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	revisions := []model.Revision{}
+	for page := 1; page != 0 && len(revisions) < maxRevisions; {
+		commits, nextPage, err := p.client.GetCommits(ctx, p.ProjectRef.GitLab.ProjectPath(), p.ProjectRef.Branch, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, commit := range commits {
+			if len(revisions) == maxRevisions {
+				break
+			}
+			revisions = append(revisions, gitLabCommitToRevision(commit))
+		}
+		page = nextPage
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,109 @@
+package repotracker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeGitLabServer returns a fake GitLab server for the project
+// "group/repo" whose main branch has the commits c4 (newest) through c0
+// (oldest), returned two per page.
+func newFakeGitLabServer(t *testing.T) *httptest.Server {
+	pages := map[string]string{
+		"1": `[{"id": "c4444444444", "message": "fourth", "author_name": "a", "author_email": "a@example.com", "committed_date": "2024-01-04T00:00:00Z"}, {"id": "c3333333333", "message": "third", "committed_date": "2024-01-03T00:00:00Z"}]`,
+		"2": `[{"id": "c2222222222", "message": "second", "committed_date": "2024-01-02T00:00:00Z"}, {"id": "c1111111111", "message": "first", "committed_date": "2024-01-01T00:00:00Z"}]`,
+		"3": `[{"id": "c0000000000", "message": "initial", "committed_date": "2023-12-31T00:00:00Z"}]`,
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/group%2Frepo/repository/commits":
+			assert.Equal(t, "main", r.URL.Query().Get("ref_name"))
+			page := r.URL.Query().Get("page")
+			if page != "3" {
+				w.Header().Set("X-Next-Page", map[string]string{"1": "2", "2": "3"}[page])
+			}
+			fmt.Fprint(w, pages[page])
+		case "/api/v4/projects/group%2Frepo/repository/commits/c3333333333/diff":
+			fmt.Fprint(w, `[{"old_path": "a.go", "new_path": "a.go"}, {"old_path": "b.go", "new_path": "c.go", "renamed_file": true}]`)
+		case "/api/v4/projects/group%2Frepo/repository/merge_base":
+			assert.Equal(t, []string{"rewritten00", "c4444444444"}, r.URL.Query()["refs[]"])
+			fmt.Fprint(w, `{"id": "c1111111111"}`)
+		case "/api/v4/projects/group%2Frepo/repository/commits/c1111111111":
+			fmt.Fprint(w, `{"id": "c1111111111", "message": "first"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestGitLabRepositoryPoller(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeGitLabServer(t)
+	defer server.Close()
+
+	pRef := &model.ProjectRef{
+		Id:     "project",
+		Branch: "main",
+		GitLab: model.GitLabSettings{URL: server.URL, Owner: "group", Repo: "repo", Token: "token"},
+	}
+	poller := NewGitLabRepositoryPoller(pRef)
+
+	t.Run("GetRecentRevisions", func(t *testing.T) {
+		revisions, err := poller.GetRecentRevisions(3)
+		require.NoError(t, err)
+		require.Len(t, revisions, 3)
+		assert.Equal(t, "c4444444444", revisions[0].Revision)
+		assert.Equal(t, "a", revisions[0].Author)
+		assert.Equal(t, "a@example.com", revisions[0].AuthorEmail)
+		assert.Equal(t, "fourth", revisions[0].RevisionMessage)
+		assert.False(t, revisions[0].CreateTime.IsZero())
+		assert.Equal(t, "c2222222222", revisions[2].Revision)
+	})
+	t.Run("GetRevisionsSinceAcrossPages", func(t *testing.T) {
+		revisions, err := poller.GetRevisionsSince(ctx, "c1111111111", 10)
+		require.NoError(t, err)
+		require.Len(t, revisions, 3)
+		assert.Equal(t, "c4444444444", revisions[0].Revision)
+		assert.Equal(t, "c2222222222", revisions[2].Revision)
+	})
+	t.Run("GetRevisionsSinceLatest", func(t *testing.T) {
+		revisions, err := poller.GetRevisionsSince(ctx, "c4444444444", 10)
+		require.NoError(t, err)
+		assert.Empty(t, revisions)
+	})
+	t.Run("GetRevisionsSinceMissingRevisionUsesMergeBase", func(t *testing.T) {
+		require.NoError(t, db.ClearCollections(model.RepositoriesCollection))
+		require.NoError(t, db.Insert(model.RepositoriesCollection, model.Repository{Project: "project", LastRevision: "rewritten00"}))
+
+		revisions, err := poller.GetRevisionsSince(ctx, "rewritten00", 2)
+		require.NoError(t, err)
+		require.Len(t, revisions, 3)
+		assert.Equal(t, "c1111111111", revisions[2].Revision)
+
+		repo, err := model.FindRepository(ctx, "project")
+		require.NoError(t, err)
+		require.NotZero(t, repo)
+		assert.Equal(t, "c1111111111", repo.LastRevision)
+	})
+	t.Run("GetChangedFiles", func(t *testing.T) {
+		files, err := poller.GetChangedFiles(ctx, "c3333333333")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.go", "c.go"}, files)
+	})
+}
+
+func TestNewRepoPoller(t *testing.T) {
+	assert.IsType(t, &GithubRepositoryPoller{}, newRepoPoller(&model.ProjectRef{Owner: "owner", Repo: "repo"}))
+	assert.IsType(t, &GitLabRepositoryPoller{}, newRepoPoller(&model.ProjectRef{GitLab: model.GitLabSettings{Owner: "group", Repo: "repo"}}))
+	assert.IsType(t, &GitRepositoryPoller{}, newRepoPoller(&model.ProjectRef{GitRemote: model.GitRemoteSettings{URL: "https://git.example.com/repo.git"}}))
+}
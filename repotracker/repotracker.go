@@ -303,12 +303,12 @@ func (repoTracker *RepoTracker) StoreRevisions(ctx context.Context, revisions []
 
 		// "Ignore" a version if all changes are to ignored files
 		var ignore bool
-		if len(pInfo.Project.Ignore) > 0 {
-			var filenames []string
+		var filenames []string
+		if len(pInfo.Project.Ignore) > 0 || pInfo.Project.HasPathFilters() {
 			filenames, err = repoTracker.GetChangedFiles(ctx, revision)
 			if err != nil {
 				grip.Error(message.WrapError(err, message.Fields{
-					"message":            "error checking GitHub for ignored files",
+					"message":            "error getting changed files for ignored files and watched paths",
 					"runner":             RunnerName,
 					"project":            ref.Id,
 					"project_identifier": ref.Identifier,
@@ -322,7 +322,8 @@ func (repoTracker *RepoTracker) StoreRevisions(ctx context.Context, revisions []
 		}
 
 		metadata := model.VersionMetadata{
-			Revision: revisions[i],
+			Revision:     revisions[i],
+			ChangedFiles: filenames,
 		}
 		projectInfo := &model.ProjectInfo{
 			Ref:                 ref,
@@ -868,6 +869,13 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 		"project": projectInfo.Project.Identifier,
 		"version": v.Id,
 	}))
+	filteredByPaths, err := filterPairsByChangedFiles(v, projectInfo.Project, pairsToCreate, aliases, metadata.ChangedFiles)
+	if err != nil {
+		return errors.Wrap(err, "filtering tasks by changed files")
+	}
+	if filteredByPaths != nil {
+		pairsToCreate = filteredByPaths
+	}
 	batchTimeCatcher := grip.NewBasicCatcher()
 	debuggingData := map[string]string{}
 
@@ -885,6 +893,12 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 
 	for _, buildvariant := range projectInfo.Project.BuildVariants {
 		taskNames := pairsToCreate.TaskNames(buildvariant.Name)
+		if filteredByPaths != nil && len(taskNames) == 0 {
+			// Creating the build with no task names would create all of its
+			// tasks.
+			debuggingData[buildvariant.Name] = "no tasks watch the changed files"
+			continue
+		}
 		var aliasesMatchingVariant model.ProjectAliases
 		aliasesMatchingVariant, err = githubCheckAliases.AliasesMatchingVariant(buildvariant.Name, buildvariant.Tags)
 		grip.Error(message.WrapError(err, message.Fields{
@@ -1100,6 +1114,45 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 	return transactionWithRetries(ctx, v.Id, txFunc)
 }
 
+// filterPairsByChangedFiles removes the tasks that don't watch any of the
+// files changed by the version from the pairs to create and records them as
+// skipped in the version. If no filtering is necessary, it returns nil. If
+// every task would be skipped, the version is ignored instead.
+func filterPairsByChangedFiles(v *model.Version, project *model.Project, pairsToCreate model.TVPairSet, aliases model.ProjectAliases, changedFiles []string) (model.TVPairSet, error) {
+	if len(changedFiles) == 0 || v.Ignored || !project.HasPathFilters() {
+		return nil, nil
+	}
+
+	candidates := pairsToCreate
+	if len(aliases) == 0 {
+		// Without aliases, no pairs means that every task that can run is
+		// created.
+		candidates = project.TVPairsForRequester(v.Requester)
+	}
+	filtered, skipped, err := project.FilterTVPairsByChangedFiles(candidates, changedFiles, v.Requester)
+	if err != nil {
+		return nil, err
+	}
+	if len(skipped) == 0 {
+		return nil, nil
+	}
+	if len(filtered) == 0 {
+		grip.Info(message.Fields{
+			"message":   "ignoring version because no tasks watch the changed files",
+			"version":   v.Id,
+			"project":   v.Identifier,
+			"revision":  v.Revision,
+			"num_tasks": len(skipped),
+			"runner":    RunnerName,
+		})
+		v.Ignored = true
+		return nil, nil
+	}
+
+	v.PathSkippedTasks = skipped
+	return filtered, nil
+}
+
 // If we error in aborting transaction, we create a new session and start again.
 // If we abort successfully and the error is a transient transaction error, we retry using the same session.
 func transactionWithRetries(ctx context.Context, versionId string, sessionFunc func(sessCtx mongo.SessionContext) error) error {
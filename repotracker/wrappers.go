@@ -13,7 +13,8 @@ import (
 )
 
 const (
-	// the repotracker polls version control (github) for new commits
+	// the repotracker polls version control (GitHub, GitLab or a plain git
+	// remote) for new commits
 	RunnerName = "repotracker"
 
 	// githubAPILimitCeiling is arbitrary but corresponds to when we start logging errors in
@@ -25,12 +26,24 @@ func getTracker(conf *evergreen.Settings, project model.ProjectRef) (*RepoTracke
 	tracker := &RepoTracker{
 		Settings:   conf,
 		ProjectRef: &project,
-		RepoPoller: NewGithubRepositoryPoller(&project),
+		RepoPoller: newRepoPoller(&project),
 	}
 
 	return tracker, nil
 }
 
+// newRepoPoller returns the poller for the version control system that hosts
+// the project's repository.
+func newRepoPoller(project *model.ProjectRef) RepoPoller {
+	if project.GitLab.IsEnabled() {
+		return NewGitLabRepositoryPoller(project)
+	}
+	if project.GitRemote.IsEnabled() {
+		return NewGitRepositoryPoller(project)
+	}
+	return NewGithubRepositoryPoller(project)
+}
+
 func CollectRevisionsForProject(ctx context.Context, conf *evergreen.Settings, project model.ProjectRef) error {
 	if !project.Enabled || project.IsRepotrackerDisabled() {
 		return errors.Errorf("project disabled: %s", project.Id)
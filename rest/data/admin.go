@@ -53,6 +53,9 @@ func SetEvergreenSettings(ctx context.Context, changes *restModel.APIAdminSettin
 		return nil, errors.Wrap(err, "converting settings to service model")
 	}
 	newSettings := i.(evergreen.Settings)
+	// The Kubernetes tokens are redacted in the API model, so keep the
+	// original tokens unless new ones were given.
+	newSettings.ContainerPools.UnredactKubernetesTokens(oldSettings.ContainerPools)
 	if persist {
 		// We have to call Validate before we attempt to persist it because the
 		// evergreen.Settings internally calls ValidateAndDefault to set the
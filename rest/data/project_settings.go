@@ -244,6 +244,14 @@ func SaveProjectSettingsForSection(ctx context.Context, projectId string, change
 		if err = mergedSection.ValidateEnabledRepotracker(); err != nil {
 			return nil, err
 		}
+		// The GitLab secrets are redacted in the UI, so keep the original
+		// values unless they've been changed.
+		newProjectRef.GitLab.Unredact(before.ProjectRef.GitLab)
+		mergedSection.GitLab = newProjectRef.GitLab
+		mergedSection.GitRemote = newProjectRef.GitRemote
+		if err = mergedSection.ValidateRepositoryHosting(); err != nil {
+			return nil, err
+		}
 		// Validate owner/repo if the project is enabled or owner/repo is populated.
 		// This validation is cheap so it makes sense to be strict about this.
 		if mergedSection.Enabled || (mergedSection.Owner != "" && mergedSection.Repo != "") {
@@ -384,6 +392,9 @@ func SaveProjectSettingsForSection(ctx context.Context, projectId string, change
 		if err = mergedSection.ValidateGitHubPermissionGroupsByRequester(); err != nil {
 			return nil, err
 		}
+		if err = mergedSection.CommitQueue.ValidateMergeQueueBatching(); err != nil {
+			return nil, errors.Wrap(err, "invalid merge queue batching settings")
+		}
 		modified, err = updateAliasesForSection(ctx, projectId, changes.Aliases, before.Aliases, section)
 		catcher.Add(err)
 	case model.ProjectPagePatchAliasSection:
@@ -21,6 +21,7 @@ func NewConfigModel() *APIAdminSettings {
 		Buckets:             &APIBucketsConfig{},
 		Cedar:               &APICedarConfig{},
 		ContainerPools:      &APIContainerPoolsConfig{},
+		Cost:                &APICostConfig{},
 		Expansions:          map[string]string{},
 		HostInit:            &APIHostInitConfig{},
 		HostJasper:          &APIHostJasperConfig{},
@@ -67,6 +68,7 @@ type APIAdminSettings struct {
 	Cedar               *APICedarConfig               `json:"cedar,omitempty"`
 	ConfigDir           *string                       `json:"configdir,omitempty"`
 	ContainerPools      *APIContainerPoolsConfig      `json:"container_pools,omitempty"`
+	Cost                *APICostConfig                `json:"cost,omitempty"`
 	DomainName          *string                       `json:"domain_name,omitempty"`
 	Expansions          map[string]string             `json:"expansions,omitempty"`
 	GithubPRCreatorOrg  *string                       `json:"github_pr_creator_org,omitempty"`
@@ -620,6 +622,12 @@ type APIBucketsConfig struct {
 	ProjectToPrefixMappings []APIProjectToPrefixMapping `json:"project_to_prefix_mappings"`
 	ProjectToBucketMappings []APIProjectToBucketMapping `json:"project_to_bucket_mappings"`
 	Credentials             APIS3Credentials            `json:"credentials"`
+	BuildCache              APIBuildCacheConfig         `json:"build_cache"`
+}
+
+type APIBuildCacheConfig struct {
+	Bucket             APIBucketConfig `json:"bucket"`
+	ProjectSizeLimitMB int             `json:"project_size_limit_mb"`
 }
 
 type APIBucketConfig struct {
@@ -675,6 +683,11 @@ func (a *APIBucketsConfig) BuildFromService(h any) error {
 			bucketMappings = append(bucketMappings, apiMapping)
 		}
 		a.ProjectToBucketMappings = bucketMappings
+
+		a.BuildCache.Bucket.Name = utility.ToStringPtr(v.BuildCache.Bucket.Name)
+		a.BuildCache.Bucket.Type = utility.ToStringPtr(string(v.BuildCache.Bucket.Type))
+		a.BuildCache.Bucket.DBName = utility.ToStringPtr(v.BuildCache.Bucket.DBName)
+		a.BuildCache.ProjectSizeLimitMB = v.BuildCache.ProjectSizeLimitMB
 	default:
 		return errors.Errorf("programmatic error: expected bucket config but got type %T", h)
 	}
@@ -717,6 +730,14 @@ func (a *APIBucketsConfig) ToService() (any, error) {
 		ProjectToPrefixMappings: prefixMappings,
 		ProjectToBucketMappings: bucketMappings,
 		Credentials:             creds,
+		BuildCache: evergreen.BuildCacheConfig{
+			Bucket: evergreen.BucketConfig{
+				Name:   utility.FromStringPtr(a.BuildCache.Bucket.Name),
+				Type:   evergreen.BucketType(utility.FromStringPtr(a.BuildCache.Bucket.Type)),
+				DBName: utility.FromStringPtr(a.BuildCache.Bucket.DBName),
+			},
+			ProjectSizeLimitMB: a.BuildCache.ProjectSizeLimitMB,
+		},
 	}, nil
 }
 
@@ -1406,8 +1427,9 @@ func (a *APIProjectCreationConfig) ToService() (any, error) {
 }
 
 type APICloudProviders struct {
-	AWS    *APIAWSConfig    `json:"aws"`
-	Docker *APIDockerConfig `json:"docker"`
+	AWS     *APIAWSConfig     `json:"aws"`
+	Docker  *APIDockerConfig  `json:"docker"`
+	Libvirt *APILibvirtConfig `json:"libvirt"`
 }
 
 func (a *APICloudProviders) BuildFromService(h any) error {
@@ -1415,12 +1437,14 @@ func (a *APICloudProviders) BuildFromService(h any) error {
 	case evergreen.CloudProviders:
 		a.AWS = &APIAWSConfig{}
 		a.Docker = &APIDockerConfig{}
+		a.Libvirt = &APILibvirtConfig{}
 		if err := a.AWS.BuildFromService(v.AWS); err != nil {
 			return err
 		}
 		if err := a.Docker.BuildFromService(v.Docker); err != nil {
 			return err
 		}
+		a.Libvirt.BuildFromService(v.Libvirt)
 	default:
 		return errors.Errorf("programmatic error: expected cloud provider config but got type %T", h)
 	}
@@ -1436,9 +1460,14 @@ func (a *APICloudProviders) ToService() (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	var libvirt evergreen.LibvirtConfig
+	if a.Libvirt != nil {
+		libvirt = a.Libvirt.ToService()
+	}
 	return evergreen.CloudProviders{
-		AWS:    aws.(evergreen.AWSConfig),
-		Docker: docker.(evergreen.DockerConfig),
+		AWS:     aws.(evergreen.AWSConfig),
+		Docker:  docker.(evergreen.DockerConfig),
+		Libvirt: libvirt,
 	}, nil
 }
 
@@ -1479,10 +1508,12 @@ func (a *APIContainerPoolsConfig) ToService() (any, error) {
 }
 
 type APIContainerPool struct {
-	Distro        *string `json:"distro"`
-	Id            *string `json:"id"`
-	MaxContainers int     `json:"max_containers"`
-	Port          uint16  `json:"port"`
+	Distro        *string             `json:"distro"`
+	Id            *string             `json:"id"`
+	MaxContainers int                 `json:"max_containers"`
+	Port          uint16              `json:"port"`
+	Provider      *string             `json:"provider,omitempty"`
+	Kubernetes    APIKubernetesConfig `json:"kubernetes"`
 }
 
 func (a *APIContainerPool) BuildFromService(h any) error {
@@ -1492,6 +1523,8 @@ func (a *APIContainerPool) BuildFromService(h any) error {
 		a.Id = utility.ToStringPtr(v.Id)
 		a.MaxContainers = v.MaxContainers
 		a.Port = v.Port
+		a.Provider = utility.ToStringPtr(string(v.Provider))
+		a.Kubernetes.BuildFromService(v.Kubernetes)
 	default:
 		return errors.Errorf("programmatic error: expected container pool config but got type %T", h)
 	}
@@ -1504,9 +1537,95 @@ func (a *APIContainerPool) ToService() (any, error) {
 		Id:            utility.FromStringPtr(a.Id),
 		MaxContainers: a.MaxContainers,
 		Port:          a.Port,
+		Provider:      evergreen.PodProvider(utility.FromStringPtr(a.Provider)),
+		Kubernetes:    a.Kubernetes.ToService(),
 	}, nil
 }
 
+type APIKubernetesConfig struct {
+	APIServerURL *string `json:"api_server_url"`
+	Namespace    *string `json:"namespace"`
+	// Token is redacted when returned.
+	Token           *string `json:"token"`
+	CACert          *string `json:"ca_cert"`
+	ImagePullSecret *string `json:"image_pull_secret"`
+}
+
+func (a *APIKubernetesConfig) BuildFromService(conf evergreen.KubernetesConfig) {
+	a.APIServerURL = utility.ToStringPtr(conf.APIServerURL)
+	a.Namespace = utility.ToStringPtr(conf.Namespace)
+	a.Token = utility.ToStringPtr(redactIfSet(conf.Token))
+	a.CACert = utility.ToStringPtr(conf.CACert)
+	a.ImagePullSecret = utility.ToStringPtr(conf.ImagePullSecret)
+}
+
+func (a *APIKubernetesConfig) ToService() evergreen.KubernetesConfig {
+	return evergreen.KubernetesConfig{
+		APIServerURL:    utility.FromStringPtr(a.APIServerURL),
+		Namespace:       utility.FromStringPtr(a.Namespace),
+		Token:           utility.FromStringPtr(a.Token),
+		CACert:          utility.FromStringPtr(a.CACert),
+		ImagePullSecret: utility.FromStringPtr(a.ImagePullSecret),
+	}
+}
+
+type APICostConfig struct {
+	InstanceTypeRates     []APIInstanceTypeRate `json:"instance_type_rates"`
+	DefaultHourlyRate     float64               `json:"default_hourly_rate"`
+	PodVCPUHourlyRate     float64               `json:"pod_vcpu_hourly_rate"`
+	PodMemoryGBHourlyRate float64               `json:"pod_memory_gb_hourly_rate"`
+}
+
+func (a *APICostConfig) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case evergreen.CostConfig:
+		a.InstanceTypeRates = []APIInstanceTypeRate{}
+		for _, rate := range v.InstanceTypeRates {
+			apiRate := APIInstanceTypeRate{}
+			apiRate.BuildFromService(rate)
+			a.InstanceTypeRates = append(a.InstanceTypeRates, apiRate)
+		}
+		a.DefaultHourlyRate = v.DefaultHourlyRate
+		a.PodVCPUHourlyRate = v.PodVCPUHourlyRate
+		a.PodMemoryGBHourlyRate = v.PodMemoryGBHourlyRate
+	default:
+		return errors.Errorf("programmatic error: expected cost config but got type %T", h)
+	}
+	return nil
+}
+
+func (a *APICostConfig) ToService() (any, error) {
+	config := evergreen.CostConfig{
+		DefaultHourlyRate:     a.DefaultHourlyRate,
+		PodVCPUHourlyRate:     a.PodVCPUHourlyRate,
+		PodMemoryGBHourlyRate: a.PodMemoryGBHourlyRate,
+	}
+	for _, rate := range a.InstanceTypeRates {
+		config.InstanceTypeRates = append(config.InstanceTypeRates, rate.ToService())
+	}
+	return config, nil
+}
+
+type APIInstanceTypeRate struct {
+	InstanceType       *string `json:"instance_type"`
+	OnDemandHourlyRate float64 `json:"on_demand_hourly_rate"`
+	SpotHourlyRate     float64 `json:"spot_hourly_rate"`
+}
+
+func (a *APIInstanceTypeRate) BuildFromService(rate evergreen.InstanceTypeRate) {
+	a.InstanceType = utility.ToStringPtr(rate.InstanceType)
+	a.OnDemandHourlyRate = rate.OnDemandHourlyRate
+	a.SpotHourlyRate = rate.SpotHourlyRate
+}
+
+func (a *APIInstanceTypeRate) ToService() evergreen.InstanceTypeRate {
+	return evergreen.InstanceTypeRate{
+		InstanceType:       utility.FromStringPtr(a.InstanceType),
+		OnDemandHourlyRate: a.OnDemandHourlyRate,
+		SpotHourlyRate:     a.SpotHourlyRate,
+	}
+}
+
 type APIEC2Key struct {
 	Name   *string `json:"name"`
 	Region *string `json:"region"`
@@ -2033,6 +2152,23 @@ func (a *APIDockerConfig) ToService() (any, error) {
 	}, nil
 }
 
+type APILibvirtConfig struct {
+	URI         *string `json:"uri"`
+	StoragePool *string `json:"storage_pool"`
+}
+
+func (a *APILibvirtConfig) BuildFromService(conf evergreen.LibvirtConfig) {
+	a.URI = utility.ToStringPtr(conf.URI)
+	a.StoragePool = utility.ToStringPtr(conf.StoragePool)
+}
+
+func (a *APILibvirtConfig) ToService() evergreen.LibvirtConfig {
+	return evergreen.LibvirtConfig{
+		URI:         utility.FromStringPtr(a.URI),
+		StoragePool: utility.FromStringPtr(a.StoragePool),
+	}
+}
+
 type APIRepoTrackerConfig struct {
 	NumNewRepoRevisionsToFetch int `json:"revs_to_fetch"`
 	MaxRepoRevisionsToSearch   int `json:"max_revs_to_search"`
@@ -2949,6 +3085,9 @@ type APITaskLimitsConfig struct {
 	MaxTaskExecution *int `json:"max_task_execution"`
 	// MaxDailyAutomaticRestarts is the maximum number of times a project can automatically restart a task within a 24-hour period.
 	MaxDailyAutomaticRestarts *int `json:"max_daily_automatic_restarts"`
+	// RemoteIncludeAllowedHosts are the hosts that projects can include files
+	// from by URL.
+	RemoteIncludeAllowedHosts []string `json:"remote_include_allowed_hosts"`
 }
 
 func (c *APITaskLimitsConfig) BuildFromService(h any) error {
@@ -2966,6 +3105,7 @@ func (c *APITaskLimitsConfig) BuildFromService(h any) error {
 		c.MaxExecTimeoutSecs = utility.ToIntPtr(v.MaxExecTimeoutSecs)
 		c.MaxTaskExecution = utility.ToIntPtr(v.MaxTaskExecution)
 		c.MaxDailyAutomaticRestarts = utility.ToIntPtr(v.MaxDailyAutomaticRestarts)
+		c.RemoteIncludeAllowedHosts = v.RemoteIncludeAllowedHosts
 		return nil
 	default:
 		return errors.Errorf("programmatic error: expected task limits config but got type %T", h)
@@ -2986,6 +3126,7 @@ func (c *APITaskLimitsConfig) ToService() (any, error) {
 		MaxDegradedModeConcurrentLargeParserProjectTasks: utility.FromIntPtr(c.MaxDegradedModeConcurrentLargeParserProjectTasks),
 		MaxTaskExecution:                                 utility.FromIntPtr(c.MaxTaskExecution),
 		MaxDailyAutomaticRestarts:                        utility.FromIntPtr(c.MaxDailyAutomaticRestarts),
+		RemoteIncludeAllowedHosts:                        c.RemoteIncludeAllowedHosts,
 	}, nil
 }
 
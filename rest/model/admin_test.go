@@ -119,6 +119,12 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].Id, utility.FromStringPtr(apiSettings.ContainerPools.Pools[0].Id))
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].MaxContainers, apiSettings.ContainerPools.Pools[0].MaxContainers)
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].Port, apiSettings.ContainerPools.Pools[0].Port)
+	assert.EqualValues(testSettings.Cost.InstanceTypeRates[0].InstanceType, utility.FromStringPtr(apiSettings.Cost.InstanceTypeRates[0].InstanceType))
+	assert.EqualValues(testSettings.Cost.InstanceTypeRates[0].OnDemandHourlyRate, apiSettings.Cost.InstanceTypeRates[0].OnDemandHourlyRate)
+	assert.EqualValues(testSettings.Cost.InstanceTypeRates[0].SpotHourlyRate, apiSettings.Cost.InstanceTypeRates[0].SpotHourlyRate)
+	assert.EqualValues(testSettings.Cost.DefaultHourlyRate, apiSettings.Cost.DefaultHourlyRate)
+	assert.EqualValues(testSettings.Cost.PodVCPUHourlyRate, apiSettings.Cost.PodVCPUHourlyRate)
+	assert.EqualValues(testSettings.Cost.PodMemoryGBHourlyRate, apiSettings.Cost.PodMemoryGBHourlyRate)
 	assert.Equal(testSettings.HostJasper.BinaryName, utility.FromStringPtr(apiSettings.HostJasper.BinaryName))
 	assert.Equal(testSettings.HostJasper.DownloadFileName, utility.FromStringPtr(apiSettings.HostJasper.DownloadFileName))
 	assert.Equal(testSettings.HostJasper.Port, apiSettings.HostJasper.Port)
@@ -261,6 +267,7 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].Id, dbSettings.ContainerPools.Pools[0].Id)
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].MaxContainers, dbSettings.ContainerPools.Pools[0].MaxContainers)
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].Port, dbSettings.ContainerPools.Pools[0].Port)
+	assert.EqualValues(testSettings.Cost, dbSettings.Cost)
 	assert.EqualValues(testSettings.HostInit.HostThrottle, dbSettings.HostInit.HostThrottle)
 	assert.EqualValues(testSettings.Jira.BasicAuthConfig.Username, dbSettings.Jira.BasicAuthConfig.Username)
 	assert.EqualValues(testSettings.LoggerConfig.DefaultLevel, dbSettings.LoggerConfig.DefaultLevel)
@@ -477,6 +484,32 @@ func TestAPIJIRANotificationsConfig(t *testing.T) {
 	assert.Equal(api, newAPI)
 }
 
+func TestAPIContainerPool(t *testing.T) {
+	pool := evergreen.ContainerPool{
+		Id:       "pool",
+		Provider: evergreen.PodProviderKubernetes,
+		Kubernetes: evergreen.KubernetesConfig{
+			APIServerURL:    "https://kubernetes.example.com",
+			Namespace:       "evergreen",
+			Token:           "token",
+			CACert:          "ca_cert",
+			ImagePullSecret: "image_pull_secret",
+		},
+	}
+
+	apiPool := APIContainerPool{}
+	require.NoError(t, apiPool.BuildFromService(pool))
+	assert.Equal(t, string(evergreen.PodProviderKubernetes), utility.FromStringPtr(apiPool.Provider))
+	assert.Equal(t, "evergreen", utility.FromStringPtr(apiPool.Kubernetes.Namespace))
+	assert.Equal(t, evergreen.RedactedValue, utility.FromStringPtr(apiPool.Kubernetes.Token))
+
+	converted, err := apiPool.ToService()
+	require.NoError(t, err)
+	conf := evergreen.ContainerPoolsConfig{Pools: []evergreen.ContainerPool{converted.(evergreen.ContainerPool)}}
+	conf.UnredactKubernetesTokens(evergreen.ContainerPoolsConfig{Pools: []evergreen.ContainerPool{pool}})
+	assert.Equal(t, pool, conf.Pools[0])
+}
+
 func TestAPIOverride(t *testing.T) {
 	t.Run("MarshalJSON", func(t *testing.T) {
 		for name, testCase := range map[string]struct {
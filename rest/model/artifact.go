@@ -21,6 +21,9 @@ type APIFile struct {
 	// When true, these artifacts are excluded from reproduction
 	IgnoreForFetch bool    `json:"ignore_for_fetch"`
 	ContentType    *string `json:"content_type"`
+	// Expired is whether the file was deleted by the project's artifact
+	// retention policy. Expired files have no link.
+	Expired bool `json:"expired"`
 }
 
 type APIEntry struct {
@@ -37,7 +40,10 @@ func (f *APIFile) BuildFromService(file artifact.File) {
 	f.Link = utility.ToStringPtr(file.Link)
 	f.Visibility = utility.ToStringPtr(file.Visibility)
 	f.IgnoreForFetch = file.IgnoreForFetch
-
+	f.Expired = file.Expired
+	if file.Expired {
+		f.Link = utility.ToStringPtr("")
+	}
 }
 
 func (f *APIFile) GetLogURL(env evergreen.Environment, taskID string, execution int) {
@@ -70,6 +76,7 @@ func (f *APIFile) ToService() artifact.File {
 		Link:           utility.FromStringPtr(f.Link),
 		Visibility:     utility.FromStringPtr(f.Visibility),
 		IgnoreForFetch: f.IgnoreForFetch,
+		Expired:        f.Expired,
 	}
 }
 
@@ -98,3 +105,58 @@ func (e *APIEntry) ToService() artifact.Entry {
 
 	return entry
 }
+
+// APIArtifactRetentionPolicy is a project's set of rules for expiring the
+// artifacts uploaded by its tasks.
+type APIArtifactRetentionPolicy struct {
+	// ProjectID is the ID of the project.
+	ProjectID *string `json:"project_id"`
+	// Rules are evaluated in order and the first rule that matches an
+	// artifact determines when it expires. Artifacts that do not match any
+	// rule are kept indefinitely.
+	Rules []APIArtifactRetentionRule `json:"rules"`
+}
+
+// APIArtifactRetentionRule determines when the artifacts that match it expire.
+type APIArtifactRetentionRule struct {
+	// NamePattern is a glob matched against the artifact's name. If empty, the
+	// rule matches artifacts with any name.
+	NamePattern *string `json:"name_pattern"`
+	// Requesters are the requesters of the tasks whose artifacts the rule
+	// matches. If empty, the rule matches artifacts from any requester.
+	Requesters []string `json:"requesters"`
+	// MaxAgeDays is the number of days after which matching artifacts expire.
+	MaxAgeDays int `json:"max_age_days"`
+	// KeepLastMainline is the number of most recent mainline runs of each
+	// task whose artifacts are kept regardless of their age.
+	KeepLastMainline int `json:"keep_last_mainline"`
+}
+
+func (p *APIArtifactRetentionPolicy) BuildFromService(policy artifact.RetentionPolicy) {
+	p.ProjectID = utility.ToStringPtr(policy.ProjectID)
+	p.Rules = []APIArtifactRetentionRule{}
+	for _, rule := range policy.Rules {
+		p.Rules = append(p.Rules, APIArtifactRetentionRule{
+			NamePattern:      utility.ToStringPtr(rule.NamePattern),
+			Requesters:       rule.Requesters,
+			MaxAgeDays:       rule.MaxAgeDays,
+			KeepLastMainline: rule.KeepLastMainline,
+		})
+	}
+}
+
+func (p *APIArtifactRetentionPolicy) ToService() artifact.RetentionPolicy {
+	policy := artifact.RetentionPolicy{
+		ProjectID: utility.FromStringPtr(p.ProjectID),
+	}
+	for _, rule := range p.Rules {
+		policy.Rules = append(policy.Rules, artifact.RetentionRule{
+			NamePattern:      utility.FromStringPtr(rule.NamePattern),
+			Requesters:       rule.Requesters,
+			MaxAgeDays:       rule.MaxAgeDays,
+			KeepLastMainline: rule.KeepLastMainline,
+		})
+	}
+
+	return policy
+}
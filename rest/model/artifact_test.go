@@ -66,3 +66,26 @@ func TestAPIFileBuildFromService(t *testing.T) {
 	assert.Equal("https://localhost:4173/taskFile/t1/1/some%20complex%2Ffile%20name", utility.FromStringPtr(apiFile.URLParsley))
 
 }
+
+func TestAPIFileBuildFromServiceClearsExpiredLink(t *testing.T) {
+	apiFile := APIFile{}
+	apiFile.BuildFromService(artifact.File{Name: "file1", Link: "l1", Expired: true})
+	assert.True(t, apiFile.Expired)
+	assert.Empty(t, utility.FromStringPtr(apiFile.Link))
+}
+
+func TestAPIArtifactRetentionPolicy(t *testing.T) {
+	policy := artifact.RetentionPolicy{
+		ProjectID: "project",
+		Rules: []artifact.RetentionRule{
+			{NamePattern: "*.tgz", Requesters: []string{evergreen.PatchVersionRequester}, MaxAgeDays: 7},
+			{MaxAgeDays: 90, KeepLastMainline: 5},
+		},
+	}
+	apiPolicy := APIArtifactRetentionPolicy{}
+	apiPolicy.BuildFromService(policy)
+	assert.Equal(t, "project", utility.FromStringPtr(apiPolicy.ProjectID))
+	assert.Len(t, apiPolicy.Rules, 2)
+
+	assert.Equal(t, policy, apiPolicy.ToService())
+}
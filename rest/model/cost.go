@@ -0,0 +1,108 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/utility"
+)
+
+// APICostReport summarizes the cost of the compute used by tasks and spawn
+// hosts between two dates.
+type APICostReport struct {
+	// StartDate is the first UTC day included in the report.
+	StartDate *time.Time `json:"start_date"`
+	// EndDate is the last UTC day included in the report.
+	EndDate *time.Time `json:"end_date"`
+	// TotalCost is the total cost in dollars.
+	TotalCost float64 `json:"total_cost"`
+	// TaskCost is the cost in dollars of running tasks.
+	TaskCost float64 `json:"task_cost"`
+	// SpawnHostCost is the cost in dollars of running spawn hosts.
+	SpawnHostCost float64 `json:"spawn_host_cost"`
+	// ByDay breaks the cost down by UTC day, oldest first.
+	ByDay []APICostBreakdown `json:"by_day"`
+	// ByProject breaks the task cost down by project, most expensive first.
+	ByProject []APICostBreakdown `json:"by_project"`
+	// ByBuildVariant breaks the task cost down by build variant, most
+	// expensive first.
+	ByBuildVariant []APICostBreakdown `json:"by_build_variant"`
+	// ByUser breaks the cost down by patch author and spawn host owner, most
+	// expensive first.
+	ByUser []APICostBreakdown `json:"by_user"`
+}
+
+// APICostBreakdown is the portion of a cost report attributed to a single
+// day, project, build variant or user.
+type APICostBreakdown struct {
+	// Name is the date, project ID, build variant or user.
+	Name *string `json:"name"`
+	// Cost is the cost in dollars.
+	Cost float64 `json:"cost"`
+	// Runtime is the total compute time.
+	Runtime APIDuration `json:"runtime_ms"`
+}
+
+func (r *APICostReport) BuildFromService(report cost.Report) {
+	r.StartDate = utility.ToTimePtr(report.StartDate)
+	r.EndDate = utility.ToTimePtr(report.EndDate)
+	r.TotalCost = report.TotalCost
+	r.TaskCost = report.TaskCost
+	r.SpawnHostCost = report.SpawnHostCost
+	r.ByDay = buildAPICostBreakdowns(report.ByDay)
+	r.ByProject = buildAPICostBreakdowns(report.ByProject)
+	r.ByBuildVariant = buildAPICostBreakdowns(report.ByBuildVariant)
+	r.ByUser = buildAPICostBreakdowns(report.ByUser)
+}
+
+func buildAPICostBreakdowns(breakdowns []cost.Breakdown) []APICostBreakdown {
+	apiBreakdowns := make([]APICostBreakdown, 0, len(breakdowns))
+	for _, b := range breakdowns {
+		apiBreakdowns = append(apiBreakdowns, APICostBreakdown{
+			Name:    utility.ToStringPtr(b.Name),
+			Cost:    b.Cost,
+			Runtime: NewAPIDuration(b.Runtime),
+		})
+	}
+
+	return apiBreakdowns
+}
+
+// APIProjectCostBudget is a project's monthly cost budget. A limit of 0 means
+// the project has no such limit.
+type APIProjectCostBudget struct {
+	// ProjectID is the ID of the project.
+	ProjectID *string `json:"project_id"`
+	// SoftLimit is the monthly cost in dollars above which the project is
+	// warned that it is over budget.
+	SoftLimit float64 `json:"soft_limit"`
+	// HardLimit is the monthly cost in dollars above which the project's
+	// patches cannot be scheduled.
+	HardLimit float64 `json:"hard_limit"`
+	// MonthToDateCost is the cost in dollars of the project's tasks so far
+	// this month. This is ignored when setting the budget.
+	MonthToDateCost float64 `json:"month_to_date_cost"`
+	// SoftLimitExceeded is whether this month's cost has exceeded the soft
+	// limit. This is ignored when setting the budget.
+	SoftLimitExceeded bool `json:"soft_limit_exceeded"`
+	// HardLimitExceeded is whether this month's cost has exceeded the hard
+	// limit. This is ignored when setting the budget.
+	HardLimitExceeded bool `json:"hard_limit_exceeded"`
+}
+
+func (b *APIProjectCostBudget) BuildFromService(status cost.BudgetStatus) {
+	b.ProjectID = utility.ToStringPtr(status.ProjectID)
+	b.SoftLimit = status.SoftLimit
+	b.HardLimit = status.HardLimit
+	b.MonthToDateCost = status.MonthToDateCost
+	b.SoftLimitExceeded = status.SoftLimitExceeded()
+	b.HardLimitExceeded = status.HardLimitExceeded()
+}
+
+func (b *APIProjectCostBudget) ToService() cost.ProjectBudget {
+	return cost.ProjectBudget{
+		ProjectID: utility.FromStringPtr(b.ProjectID),
+		SoftLimit: b.SoftLimit,
+		HardLimit: b.HardLimit,
+	}
+}
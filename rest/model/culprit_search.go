@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/utility"
+)
+
+// APICulpritSearch is a search for the commit that caused a mainline task, or
+// a test in it, to start failing.
+type APICulpritSearch struct {
+	// ID is the unique identifier of the search.
+	ID *string `json:"id"`
+	// TaskID is the failing task the search started from.
+	TaskID *string `json:"task_id"`
+	// Execution is the execution of the failing task.
+	Execution int `json:"execution"`
+	// TestName is the test the search is for. If it's empty, the search is
+	// for the task as a whole.
+	TestName *string `json:"test_name"`
+	// Status is the status of the search, which is one of "running", "found"
+	// or "inconclusive".
+	Status *string `json:"status"`
+	// Reason explains why an inconclusive search stopped.
+	Reason *string `json:"reason"`
+	// LastPassingTaskID is the most recent task known to pass.
+	LastPassingTaskID *string `json:"last_passing_task_id"`
+	// LastFailingTaskID is the earliest task known to fail.
+	LastFailingTaskID *string `json:"last_failing_task_id"`
+	// CurrentTaskID is the task the search is waiting on.
+	CurrentTaskID *string `json:"current_task_id"`
+	// ActivatedTaskIDs are the tasks the search activated.
+	ActivatedTaskIDs []string `json:"activated_task_ids"`
+	// CulpritTaskID is the first failing task, which ran on the commit that
+	// caused the failure.
+	CulpritTaskID *string `json:"culprit_task_id"`
+	// CreatedBy is the user who started the search.
+	CreatedBy *string `json:"created_by"`
+	// CreatedAt is the time the search started.
+	CreatedAt *time.Time `json:"created_at"`
+	// FinishedAt is the time the search finished.
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+func (s *APICulpritSearch) BuildFromService(search model.CulpritSearch) {
+	s.ID = utility.ToStringPtr(search.ID)
+	s.TaskID = utility.ToStringPtr(search.TaskID)
+	s.Execution = search.Execution
+	s.TestName = utility.ToStringPtr(search.TestName)
+	s.Status = utility.ToStringPtr(search.Status)
+	s.Reason = utility.ToStringPtr(search.Reason)
+	s.LastPassingTaskID = utility.ToStringPtr(search.LastPassingTaskID)
+	s.LastFailingTaskID = utility.ToStringPtr(search.LastFailingTaskID)
+	s.CurrentTaskID = utility.ToStringPtr(search.CurrentTaskID)
+	s.ActivatedTaskIDs = append([]string{}, search.ActivatedTaskIDs...)
+	s.CulpritTaskID = utility.ToStringPtr(search.CulpritTaskID)
+	s.CreatedBy = utility.ToStringPtr(search.CreatedBy)
+	s.CreatedAt = utility.ToTimePtr(search.CreatedAt)
+	if !search.FinishedAt.IsZero() {
+		s.FinishedAt = utility.ToTimePtr(search.FinishedAt)
+	}
+}
+
+// APICulpritSearchOptions are the options for starting a culprit search.
+type APICulpritSearchOptions struct {
+	// TestName is the failing test to find the culprit for. If it's empty,
+	// the search is for the task as a whole.
+	TestName string `json:"test_name"`
+}
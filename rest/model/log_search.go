@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+)
+
+// APILogSearchMatch is a line in a task's logs that matches a log search.
+type APILogSearchMatch struct {
+	// TaskID is the ID of the task whose logs contain the line.
+	TaskID *string `json:"task_id"`
+	// Execution is the execution of the task whose logs contain the line.
+	Execution int `json:"execution"`
+	// LogType is the type of the task log containing the line, one of
+	// `agent_log`, `system_log`, or `task_log`. Omitted if the line belongs
+	// to a test log.
+	LogType *string `json:"log_type,omitempty"`
+	// TestLogPath is the path, relative to the task's test logs directory,
+	// of the test log containing the line. Omitted if the line belongs to a
+	// task log.
+	TestLogPath *string `json:"test_log_path,omitempty"`
+	// LineNumber is the zero-based number of the line in its log.
+	LineNumber int `json:"line_number"`
+	// Timestamp is the time at which the line was logged.
+	Timestamp *time.Time `json:"timestamp"`
+	// Severity is the severity of the line.
+	Severity *string `json:"severity"`
+	// Message is the content of the line.
+	Message *string `json:"message"`
+}
+
+func (m *APILogSearchMatch) BuildFromService(match task.LogSearchMatch) {
+	m.TaskID = utility.ToStringPtr(match.TaskID)
+	m.Execution = match.Execution
+	if match.LogType != "" {
+		m.LogType = utility.ToStringPtr(string(match.LogType))
+	}
+	if match.TestLogPath != "" {
+		m.TestLogPath = utility.ToStringPtr(match.TestLogPath)
+	}
+	m.LineNumber = match.LineNumber
+	m.Timestamp = utility.ToTimePtr(time.Unix(0, match.Timestamp))
+	m.Severity = utility.ToStringPtr(apimodels.GetSeverityMapping(match.Priority))
+	m.Message = utility.ToStringPtr(match.Data)
+}
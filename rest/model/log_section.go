@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/utility"
+)
+
+// APILogSection is a section of a task's logs, such as the output of a single
+// command, along with the sections nested in it.
+type APILogSection struct {
+	ID *string `json:"id"`
+	// Name is the name of the block, function or command that the section
+	// contains, or the name the task gave to a section it marked itself.
+	Name *string `json:"name"`
+	// Type is the kind of section, one of `block`, `function`, `command`,
+	// or `user`.
+	Type *string `json:"type"`
+	// Status is the outcome of the section, one of `running`, `success`, or
+	// `failed`.
+	Status *string `json:"status"`
+	// Start is the time the section began.
+	Start *time.Time `json:"start"`
+	// End is the time the section ended. Omitted if the section has not
+	// ended.
+	End *time.Time `json:"end,omitempty"`
+	// Duration is how long the section ran for, in milliseconds.
+	Duration APIDuration `json:"duration_ms"`
+	// Children are the sections nested in the section, ordered by start
+	// time.
+	Children []APILogSection `json:"children"`
+}
+
+func (s *APILogSection) BuildFromService(node log.SectionNode) {
+	s.ID = utility.ToStringPtr(node.ID)
+	s.Name = utility.ToStringPtr(node.Name)
+	s.Type = utility.ToStringPtr(string(node.Type))
+	s.Status = utility.ToStringPtr(string(node.Status))
+	s.Start = utility.ToTimePtr(time.Unix(0, node.Start))
+	if node.End != 0 {
+		s.End = utility.ToTimePtr(time.Unix(0, node.End))
+	}
+	s.Duration = NewAPIDuration(node.Duration())
+	s.Children = []APILogSection{}
+	for _, child := range node.Children {
+		apiChild := APILogSection{}
+		apiChild.BuildFromService(*child)
+		s.Children = append(s.Children, apiChild)
+	}
+}
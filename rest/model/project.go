@@ -266,12 +266,21 @@ type APICommitQueueParams struct {
 	MergeMethod *string `json:"merge_method"`
 	// Message to display when users interact with the commit queue.
 	Message *string `json:"message"`
+	// Maximum number of GitHub merge groups to test together in one version.
+	MergeQueueBatchSize *int `json:"merge_queue_batch_size"`
+	// Number of seconds to wait for a merge queue batch to fill up.
+	MergeQueueBatchWaitSecs *int `json:"merge_queue_batch_wait_secs"`
+	// Policy for finding the merge groups that broke a failing batch (binary or none).
+	MergeQueueBisectPolicy *string `json:"merge_queue_bisect_policy"`
 }
 
 func (cqParams *APICommitQueueParams) BuildFromService(params model.CommitQueueParams) {
 	cqParams.Enabled = utility.BoolPtrCopy(params.Enabled)
 	cqParams.MergeMethod = utility.ToStringPtr(params.MergeMethod)
 	cqParams.Message = utility.ToStringPtr(params.Message)
+	cqParams.MergeQueueBatchSize = utility.ToIntPtr(params.MergeQueueBatchSize)
+	cqParams.MergeQueueBatchWaitSecs = utility.ToIntPtr(params.MergeQueueBatchWaitSecs)
+	cqParams.MergeQueueBisectPolicy = utility.ToStringPtr(params.MergeQueueBisectPolicy)
 }
 
 func (cqParams *APICommitQueueParams) ToService() model.CommitQueueParams {
@@ -279,6 +288,9 @@ func (cqParams *APICommitQueueParams) ToService() model.CommitQueueParams {
 	serviceParams.Enabled = utility.BoolPtrCopy(cqParams.Enabled)
 	serviceParams.MergeMethod = utility.FromStringPtr(cqParams.MergeMethod)
 	serviceParams.Message = utility.FromStringPtr(cqParams.Message)
+	serviceParams.MergeQueueBatchSize = utility.FromIntPtr(cqParams.MergeQueueBatchSize)
+	serviceParams.MergeQueueBatchWaitSecs = utility.FromIntPtr(cqParams.MergeQueueBatchWaitSecs)
+	serviceParams.MergeQueueBisectPolicy = utility.FromStringPtr(cqParams.MergeQueueBisectPolicy)
 
 	return serviceParams
 }
@@ -321,6 +333,61 @@ func (bb *APIBuildBaronSettings) ToService() evergreen.BuildBaronSettings {
 	return buildBaron
 }
 
+type APIGitLabSettings struct {
+	// Base URL of the GitLab instance. Defaults to gitlab.com.
+	URL *string `json:"url"`
+	// GitLab group (including any subgroups) or user that owns the project.
+	Owner *string `json:"owner"`
+	// GitLab project name.
+	Repo *string `json:"repo"`
+	// Access token used to read the repository and report statuses. Redacted
+	// when returned.
+	Token *string `json:"token"`
+	// Secret token used to verify GitLab webhooks. Redacted when returned.
+	WebhookSecret *string `json:"webhook_secret"`
+}
+
+func (gl *APIGitLabSettings) BuildFromService(settings model.GitLabSettings) {
+	gl.URL = utility.ToStringPtr(settings.URL)
+	gl.Owner = utility.ToStringPtr(settings.Owner)
+	gl.Repo = utility.ToStringPtr(settings.Repo)
+	gl.Token = utility.ToStringPtr(redactIfSet(settings.Token))
+	gl.WebhookSecret = utility.ToStringPtr(redactIfSet(settings.WebhookSecret))
+}
+
+func (gl *APIGitLabSettings) ToService() model.GitLabSettings {
+	return model.GitLabSettings{
+		URL:           utility.FromStringPtr(gl.URL),
+		Owner:         utility.FromStringPtr(gl.Owner),
+		Repo:          utility.FromStringPtr(gl.Repo),
+		Token:         utility.FromStringPtr(gl.Token),
+		WebhookSecret: utility.FromStringPtr(gl.WebhookSecret),
+	}
+}
+
+type APIGitRemoteSettings struct {
+	// URL of a git remote to track the project's repository from instead of
+	// GitHub. Must be an https:// or ssh:// URL.
+	URL *string `json:"url"`
+}
+
+func (gr *APIGitRemoteSettings) BuildFromService(settings model.GitRemoteSettings) {
+	gr.URL = utility.ToStringPtr(settings.URL)
+}
+
+func (gr *APIGitRemoteSettings) ToService() model.GitRemoteSettings {
+	return model.GitRemoteSettings{
+		URL: utility.FromStringPtr(gr.URL),
+	}
+}
+
+func redactIfSet(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return evergreen.RedactedValue
+}
+
 type APITaskAnnotationSettings struct {
 	// Options for webhooks.
 	FileTicketWebhook APIWebHook `bson:"web_hook" json:"web_hook"`
@@ -614,6 +681,10 @@ type APIProjectRef struct {
 	RepoRefId *string `json:"repo_ref_id"`
 	// Options for commit queue.
 	CommitQueue APICommitQueueParams `json:"commit_queue"`
+	// Options for projects hosted in GitLab.
+	GitLab APIGitLabSettings `json:"gitlab"`
+	// Options for projects tracked from a plain git remote.
+	GitRemote APIGitRemoteSettings `json:"git_remote"`
 	// Options for task annotations.
 	TaskAnnotationSettings APITaskAnnotationSettings `json:"task_annotation_settings"`
 	// Options for Build Baron.
@@ -639,6 +710,8 @@ type APIProjectRef struct {
 	VersionControlEnabled *bool `json:"version_control_enabled"`
 	// Disable stats caching.
 	DisabledStatsCache *bool `json:"disabled_stats_cache"`
+	// Enable built-in test selection.
+	TestSelectionEnabled *bool `json:"test_selection_enabled"`
 	// Usernames of project admins. Can be null for some projects (EVG-6598).
 	Admins []*string `json:"admins"`
 	// Usernames of project admins to remove.
@@ -717,6 +790,8 @@ func (p *APIProjectRef) ToService() (*model.ProjectRef, error) {
 		GithubChecksEnabled:              utility.BoolPtrCopy(p.GithubChecksEnabled),
 		RepoRefId:                        utility.FromStringPtr(p.RepoRefId),
 		CommitQueue:                      p.CommitQueue.ToService(),
+		GitLab:                           p.GitLab.ToService(),
+		GitRemote:                        p.GitRemote.ToService(),
 		WorkstationConfig:                p.WorkstationConfig.ToService(),
 		BuildBaronSettings:               p.BuildBaronSettings.ToService(),
 		TaskAnnotationSettings:           p.TaskAnnotationSettings.ToService(),
@@ -729,6 +804,7 @@ func (p *APIProjectRef) ToService() (*model.ProjectRef, error) {
 		StepbackBisect:                   utility.BoolPtrCopy(p.StepbackBisect),
 		VersionControlEnabled:            utility.BoolPtrCopy(p.VersionControlEnabled),
 		DisabledStatsCache:               utility.BoolPtrCopy(p.DisabledStatsCache),
+		TestSelectionEnabled:             utility.BoolPtrCopy(p.TestSelectionEnabled),
 		NotifyOnBuildFailure:             utility.BoolPtrCopy(p.NotifyOnBuildFailure),
 		SpawnHostScriptPath:              utility.FromStringPtr(p.SpawnHostScriptPath),
 		OldestAllowedMergeBase:           utility.FromStringPtr(p.OldestAllowedMergeBase),
@@ -851,6 +927,7 @@ func (p *APIProjectRef) BuildPublicFields(ctx context.Context, projectRef model.
 	p.StepbackBisect = utility.BoolPtrCopy(projectRef.StepbackBisect)
 	p.VersionControlEnabled = utility.BoolPtrCopy(projectRef.VersionControlEnabled)
 	p.DisabledStatsCache = utility.BoolPtrCopy(projectRef.DisabledStatsCache)
+	p.TestSelectionEnabled = utility.BoolPtrCopy(projectRef.TestSelectionEnabled)
 	p.NotifyOnBuildFailure = utility.BoolPtrCopy(projectRef.NotifyOnBuildFailure)
 	p.SpawnHostScriptPath = utility.ToStringPtr(projectRef.SpawnHostScriptPath)
 	p.OldestAllowedMergeBase = utility.ToStringPtr(projectRef.OldestAllowedMergeBase)
@@ -868,6 +945,14 @@ func (p *APIProjectRef) BuildPublicFields(ctx context.Context, projectRef model.
 	cq.BuildFromService(projectRef.CommitQueue)
 	p.CommitQueue = cq
 
+	gitLab := APIGitLabSettings{}
+	gitLab.BuildFromService(projectRef.GitLab)
+	p.GitLab = gitLab
+
+	gitRemote := APIGitRemoteSettings{}
+	gitRemote.BuildFromService(projectRef.GitRemote)
+	p.GitRemote = gitRemote
+
 	buildbaronConfig := APIBuildBaronSettings{}
 	buildbaronConfig.BuildFromService(projectRef.BuildBaronSettings)
 	p.BuildBaronSettings = buildbaronConfig
@@ -41,6 +41,13 @@ type APIGithubMergeSubscriber struct {
 	Ref   *string `json:"ref" mapstructure:"ref"`
 }
 
+type APIGitLabMergeRequestSubscriber struct {
+	ProjectID   *string `json:"project_id" mapstructure:"project_id"`
+	ProjectPath *string `json:"project_path" mapstructure:"project_path"`
+	IID         int     `json:"iid" mapstructure:"iid"`
+	Ref         *string `json:"ref" mapstructure:"ref"`
+}
+
 type APIWebhookSubscriber struct {
 	URL        *string            `json:"url" mapstructure:"url"`
 	Secret     *string            `json:"secret" mapstructure:"secret"`
@@ -85,6 +92,14 @@ func (s *APISubscriber) BuildFromService(in event.Subscriber) error {
 		}
 		target = sub
 
+	case event.GitLabMergeRequestSubscriberType:
+		sub := APIGitLabMergeRequestSubscriber{}
+		err := sub.BuildFromService(in.Target)
+		if err != nil {
+			return err
+		}
+		target = sub
+
 	case event.EvergreenWebhookSubscriberType:
 		sub := APIWebhookSubscriber{}
 		err := sub.BuildFromService(in.Target)
@@ -152,6 +167,16 @@ func (s *APISubscriber) ToService() (event.Subscriber, error) {
 		}
 		target = apiModel.ToService()
 
+	case event.GitLabMergeRequestSubscriberType:
+		apiModel := APIGitLabMergeRequestSubscriber{}
+		if err = mapstructure.Decode(s.Target, &apiModel); err != nil {
+			return event.Subscriber{}, gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrap(err, "GitLab merge request subscriber target is malformed").Error(),
+			}
+		}
+		target = apiModel.ToService()
+
 	case event.EvergreenWebhookSubscriberType:
 		apiModel := APIWebhookSubscriber{}
 		if s.WebhookSubscriber != nil {
@@ -254,6 +279,35 @@ func (s *APIGithubMergeSubscriber) BuildFromService(h any) error {
 	return nil
 }
 
+func (s *APIGitLabMergeRequestSubscriber) ToService() event.GitLabMergeRequestSubscriber {
+	return event.GitLabMergeRequestSubscriber{
+		ProjectID:   utility.FromStringPtr(s.ProjectID),
+		ProjectPath: utility.FromStringPtr(s.ProjectPath),
+		IID:         s.IID,
+		Ref:         utility.FromStringPtr(s.Ref),
+	}
+}
+
+func (s *APIGitLabMergeRequestSubscriber) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case *event.GitLabMergeRequestSubscriber:
+		s.ProjectID = utility.ToStringPtr(v.ProjectID)
+		s.ProjectPath = utility.ToStringPtr(v.ProjectPath)
+		s.IID = v.IID
+		s.Ref = utility.ToStringPtr(v.Ref)
+	case event.GitLabMergeRequestSubscriber:
+		s.ProjectID = utility.ToStringPtr(v.ProjectID)
+		s.ProjectPath = utility.ToStringPtr(v.ProjectPath)
+		s.IID = v.IID
+		s.Ref = utility.ToStringPtr(v.Ref)
+
+	default:
+		return errors.Errorf("programmatic error: expected GitLab merge request subscriber but got type %T", h)
+	}
+
+	return nil
+}
+
 func (s *APIGithubPRSubscriber) ToService() event.GithubPullRequestSubscriber {
 	return event.GithubPullRequestSubscriber{
 		Owner:    utility.FromStringPtr(s.Owner),
@@ -125,6 +125,21 @@ type APITask struct {
 	MustHaveResults   bool            `json:"must_have_test_results"`
 	BaseTask          APIBaseTaskInfo `json:"base_task"`
 	ResetWhenFinished bool            `json:"reset_when_finished"`
+	// CacheKey is the hash of the task's inputs, if the task has a cache key.
+	CacheKey *string `json:"cache_key,omitempty"`
+	// CachedFromTaskId and CachedFromExecution identify the task execution
+	// whose results this task reused instead of running, if any.
+	CachedFromTaskId    *string `json:"cached_from_task_id,omitempty"`
+	CachedFromExecution int     `json:"cached_from_execution,omitempty"`
+	// ResumedFromStep and ResumedFromExecution identify the checkpoint that
+	// this execution resumed from after the previous execution's host was
+	// lost.
+	ResumedFromStep      *string `json:"resumed_from_step,omitempty"`
+	ResumedFromExecution int     `json:"resumed_from_execution,omitempty"`
+	// CommandProfiles is the timeline of the commands that ran in the task
+	// and the resources each one used, in the order they finished. It is
+	// only included when fetching a single task.
+	CommandProfiles []APICommandProfile `json:"command_profiles,omitempty"`
 	// These fields are used by graphql gen, but do not need to be exposed
 	// via Evergreen's user-facing API.
 	OverrideDependencies bool   `json:"-"`
@@ -148,6 +163,56 @@ type APIAbortInfo struct {
 	PRClosed   bool   `json:"pr_closed,omitempty"`
 }
 
+// APICommandProfile is when a command ran in a task and the resources that
+// the processes it started used while it was running.
+type APICommandProfile struct {
+	// The full display name of the command.
+	Command *string `json:"command"`
+	// The block of the task that the command ran in (e.g. pre or post).
+	// Omitted if the command ran in the main task block.
+	Block     *string    `json:"block,omitempty"`
+	StartTime *time.Time `json:"start_time"`
+	EndTime   *time.Time `json:"end_time"`
+	// How long the command ran for, in milliseconds.
+	Duration APIDuration `json:"duration_ms"`
+	// The largest combined resident set size of the command's processes.
+	PeakRSSBytes int64 `json:"peak_rss_bytes"`
+	// The total user and system CPU time of the command's processes.
+	CPUSeconds float64 `json:"cpu_seconds"`
+	// The total bytes that the command's processes read from and wrote to
+	// disk. These are zero on platforms that do not report disk I/O per
+	// process.
+	DiskReadBytes  int64 `json:"disk_read_bytes"`
+	DiskWriteBytes int64 `json:"disk_write_bytes"`
+}
+
+func (p *APICommandProfile) BuildFromService(profile task.CommandProfile) {
+	p.Command = utility.ToStringPtr(profile.Command)
+	if profile.Block != "" {
+		p.Block = utility.ToStringPtr(profile.Block)
+	}
+	p.StartTime = ToTimePtr(profile.Start)
+	p.EndTime = ToTimePtr(profile.End)
+	p.Duration = NewAPIDuration(profile.Duration())
+	p.PeakRSSBytes = profile.PeakRSSBytes
+	p.CPUSeconds = profile.CPUSeconds
+	p.DiskReadBytes = profile.DiskReadBytes
+	p.DiskWriteBytes = profile.DiskWriteBytes
+}
+
+func (p *APICommandProfile) ToService() task.CommandProfile {
+	return task.CommandProfile{
+		Command:        utility.FromStringPtr(p.Command),
+		Block:          utility.FromStringPtr(p.Block),
+		Start:          utility.FromTimePtr(p.StartTime),
+		End:            utility.FromTimePtr(p.EndTime),
+		PeakRSSBytes:   p.PeakRSSBytes,
+		CPUSeconds:     p.CPUSeconds,
+		DiskReadBytes:  p.DiskReadBytes,
+		DiskWriteBytes: p.DiskWriteBytes,
+	}
+}
+
 type LogLinks struct {
 	// Link to logs containing merged copy of all other logs
 	AllLogLink *string `json:"all_log"`
@@ -178,6 +243,9 @@ type ApiTaskEndDetail struct {
 	// OtherFailingCommands contain information about commands that failed but
 	// did not cause the task to fail.
 	OtherFailingCommands []APIFailingCommand `json:"other_failing_commands,omitempty"`
+	// RetriedCommands contain information about commands that were retried
+	// according to their retry policy.
+	RetriedCommands []APIRetriedCommand `json:"retried_commands,omitempty"`
 	// Whether this task ended in a timeout.
 	TimedOut    bool              `json:"timed_out"`
 	TimeoutType *string           `json:"timeout_type"`
@@ -205,6 +273,11 @@ func (at *ApiTaskEndDetail) BuildFromService(t apimodels.TaskEndDetail) error {
 		apiFailingCmd.BuildFromService(failingCmd)
 		at.OtherFailingCommands = append(at.OtherFailingCommands, apiFailingCmd)
 	}
+	for _, retriedCmd := range t.RetriedCommands {
+		var apiRetriedCmd APIRetriedCommand
+		apiRetriedCmd.BuildFromService(retriedCmd)
+		at.RetriedCommands = append(at.RetriedCommands, apiRetriedCmd)
+	}
 
 	apiOomTracker := APIOomTrackerInfo{}
 	apiOomTracker.BuildFromService(t.OOMTracker)
@@ -220,6 +293,10 @@ func (ad *ApiTaskEndDetail) ToService() apimodels.TaskEndDetail {
 	for _, failingCmd := range ad.OtherFailingCommands {
 		failingCmds = append(failingCmds, failingCmd.ToService())
 	}
+	var retriedCmds []apimodels.RetriedCommand
+	for _, retriedCmd := range ad.RetriedCommands {
+		retriedCmds = append(retriedCmds, retriedCmd.ToService())
+	}
 	return apimodels.TaskEndDetail{
 		Status:               utility.FromStringPtr(ad.Status),
 		Type:                 utility.FromStringPtr(ad.Type),
@@ -228,6 +305,7 @@ func (ad *ApiTaskEndDetail) ToService() apimodels.TaskEndDetail {
 		PostErrored:          ad.PostErrored,
 		FailureMetadataTags:  ad.FailureMetadataTags,
 		OtherFailingCommands: failingCmds,
+		RetriedCommands:      retriedCmds,
 		TimedOut:             ad.TimedOut,
 		TimeoutType:          utility.FromStringPtr(ad.TimeoutType),
 		OOMTracker:           ad.OOMTracker.ToService(),
@@ -257,6 +335,31 @@ func (afc *APIFailingCommand) ToService() apimodels.FailingCommand {
 	}
 }
 
+// APIRetriedCommand represents information about a command that was retried
+// according to its retry policy.
+type APIRetriedCommand struct {
+	// FullDisplayName is the full display name of the retried command.
+	FullDisplayName *string `json:"full_display_name,omitempty"`
+	// Attempts is the total number of times the command ran.
+	Attempts int `json:"attempts"`
+	// Succeeded is whether the last attempt of the command succeeded.
+	Succeeded bool `json:"succeeded"`
+}
+
+func (arc *APIRetriedCommand) BuildFromService(rc apimodels.RetriedCommand) {
+	arc.FullDisplayName = utility.ToStringPtr(rc.FullDisplayName)
+	arc.Attempts = rc.Attempts
+	arc.Succeeded = rc.Succeeded
+}
+
+func (arc *APIRetriedCommand) ToService() apimodels.RetriedCommand {
+	return apimodels.RetriedCommand{
+		FullDisplayName: utility.FromStringPtr(arc.FullDisplayName),
+		Attempts:        arc.Attempts,
+		Succeeded:       arc.Succeeded,
+	}
+}
+
 type APIOomTrackerInfo struct {
 	Detected bool  `json:"detected"`
 	Pids     []int `json:"pids"`
@@ -353,6 +456,11 @@ func (at *APITask) buildTask(t *task.Task) error {
 		ResultsFailed:               t.ResultsFailed,
 		MustHaveResults:             t.MustHaveResults,
 		ResetWhenFinished:           t.ResetWhenFinished,
+		CacheKey:                    utility.ToStringPtr(t.CacheKey),
+		CachedFromTaskId:            utility.ToStringPtr(t.CachedFromTaskId),
+		CachedFromExecution:         t.CachedFromExecution,
+		ResumedFromStep:             utility.ToStringPtr(t.ResumedFromStep),
+		ResumedFromExecution:        t.ResumedFromExecution,
 		ParentTaskId:                utility.FromStringPtr(t.DisplayTaskId),
 		AbortInfo: APIAbortInfo{
 			NewVersion: t.AbortInfo.NewVersion,
@@ -425,6 +533,7 @@ type APITaskArgs struct {
 	IncludeProjectIdentifier bool
 	IncludeAMI               bool
 	IncludeArtifacts         bool
+	IncludeCommandProfiles   bool
 	LogURL                   string
 	ParsleyLogURL            string
 }
@@ -473,6 +582,11 @@ func (at *APITask) BuildFromService(ctx context.Context, t *task.Task, args *API
 			return errors.Wrap(err, "getting artifacts")
 		}
 	}
+	if args.IncludeCommandProfiles {
+		if err := at.GetCommandProfiles(ctx); err != nil {
+			return errors.Wrap(err, "getting command profiles")
+		}
+	}
 	if args.IncludeProjectIdentifier {
 		at.GetProjectIdentifier(ctx)
 	}
@@ -480,6 +594,22 @@ func (at *APITask) BuildFromService(ctx context.Context, t *task.Task, args *API
 	return nil
 }
 
+// GetCommandProfiles loads the profiles of the commands that ran in the task
+// execution.
+func (at *APITask) GetCommandProfiles(ctx context.Context) error {
+	profiles, err := task.FindCommandProfiles(ctx, utility.FromStringPtr(at.Id), at.Execution)
+	if err != nil {
+		return err
+	}
+	at.CommandProfiles = make([]APICommandProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		apiProfile := APICommandProfile{}
+		apiProfile.BuildFromService(profile)
+		at.CommandProfiles = append(at.CommandProfiles, apiProfile)
+	}
+	return nil
+}
+
 func (at *APITask) GetAMI(ctx context.Context) error {
 	if at.AMI != nil {
 		return nil
@@ -556,6 +686,11 @@ func (at *APITask) ToService() (*task.Task, error) {
 		Details:              at.Details.ToService(),
 		Archived:             at.Archived,
 		OverrideDependencies: at.OverrideDependencies,
+		CacheKey:             utility.FromStringPtr(at.CacheKey),
+		CachedFromTaskId:     utility.FromStringPtr(at.CachedFromTaskId),
+		CachedFromExecution:  at.CachedFromExecution,
+		ResumedFromStep:      utility.FromStringPtr(at.ResumedFromStep),
+		ResumedFromExecution: at.ResumedFromExecution,
 	}
 
 	catcher := grip.NewBasicCatcher()
@@ -661,6 +796,7 @@ type APIContainerOptions struct {
 	OS             *string `json:"os,omitempty"`
 	Arch           *string `json:"arch,omitempty"`
 	WindowsVersion *string `json:"windows_version,omitempty"`
+	Pool           *string `json:"pool,omitempty"`
 }
 
 func (o *APIContainerOptions) BuildFromService(dbOpts task.ContainerOptions) {
@@ -671,6 +807,7 @@ func (o *APIContainerOptions) BuildFromService(dbOpts task.ContainerOptions) {
 	o.OS = utility.ToStringPtr(string(dbOpts.OS))
 	o.Arch = utility.ToStringPtr(string(dbOpts.Arch))
 	o.WindowsVersion = utility.ToStringPtr(string(dbOpts.WindowsVersion))
+	o.Pool = utility.ToStringPtr(dbOpts.Pool)
 }
 
 func (o *APIContainerOptions) ToService() task.ContainerOptions {
@@ -682,6 +819,7 @@ func (o *APIContainerOptions) ToService() task.ContainerOptions {
 		OS:             evergreen.ContainerOS(utility.FromStringPtr(o.OS)),
 		Arch:           evergreen.ContainerArch(utility.FromStringPtr(o.Arch)),
 		WindowsVersion: evergreen.WindowsVersion(utility.FromStringPtr(o.WindowsVersion)),
+		Pool:           utility.FromStringPtr(o.Pool),
 	}
 }
 
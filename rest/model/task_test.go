@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/utility"
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type taskCompare struct {
@@ -272,3 +275,50 @@ func TestTaskBuildFromService(t *testing.T) {
 		})
 	})
 }
+
+func TestAPICommandProfile(t *testing.T) {
+	start := time.Now().Round(time.Second)
+	profile := task.CommandProfile{
+		Command:        "'shell.exec' in function 'compile'",
+		Block:          "pre",
+		Start:          start,
+		End:            start.Add(90 * time.Second),
+		PeakRSSBytes:   512 * 1024 * 1024,
+		CPUSeconds:     42.5,
+		DiskReadBytes:  1024,
+		DiskWriteBytes: 2048,
+	}
+
+	t.Run("BuildsFromTaskProfiles", func(t *testing.T) {
+		require.NoError(t, db.ClearCollections(task.Collection, task.CommandProfilesCollection))
+		tsk := &task.Task{Id: "task_id", Execution: 1}
+		require.NoError(t, tsk.Insert())
+		require.NoError(t, tsk.AddCommandProfile(t.Context(), profile))
+		require.NoError(t, tsk.AddCommandProfile(t.Context(), task.CommandProfile{Command: "'attach.results'", Start: start, End: start.Add(2 * time.Minute)}))
+
+		apiTask := &APITask{}
+		require.NoError(t, apiTask.BuildFromService(t.Context(), tsk, nil))
+		assert.Empty(t, apiTask.CommandProfiles, "command profiles should only be loaded when requested")
+
+		require.NoError(t, apiTask.BuildFromService(t.Context(), tsk, &APITaskArgs{IncludeCommandProfiles: true}))
+		require.Len(t, apiTask.CommandProfiles, 2)
+
+		apiProfile := apiTask.CommandProfiles[0]
+		assert.Equal(t, profile.Command, utility.FromStringPtr(apiProfile.Command))
+		assert.Equal(t, profile.Block, utility.FromStringPtr(apiProfile.Block))
+		assert.Equal(t, start, utility.FromTimePtr(apiProfile.StartTime))
+		assert.Equal(t, start.Add(90*time.Second), utility.FromTimePtr(apiProfile.EndTime))
+		assert.Equal(t, 90*time.Second, apiProfile.Duration.ToDuration())
+		assert.Equal(t, profile.PeakRSSBytes, apiProfile.PeakRSSBytes)
+		assert.Equal(t, profile.CPUSeconds, apiProfile.CPUSeconds)
+		assert.Equal(t, profile.DiskReadBytes, apiProfile.DiskReadBytes)
+		assert.Equal(t, profile.DiskWriteBytes, apiProfile.DiskWriteBytes)
+
+		assert.Nil(t, apiTask.CommandProfiles[1].Block, "main task block should be omitted")
+	})
+	t.Run("RoundTrips", func(t *testing.T) {
+		apiProfile := APICommandProfile{}
+		apiProfile.BuildFromService(profile)
+		assert.Equal(t, profile, apiProfile.ToService())
+	})
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+)
+
+// APITestQuarantine is a project's list of quarantined tests. Failures of
+// quarantined tests do not trigger test regression notifications.
+type APITestQuarantine struct {
+	// ProjectID is the ID of the project.
+	ProjectID *string `json:"project_id"`
+	// QuarantinedTests are the display names of all of the project's
+	// quarantined tests.
+	QuarantinedTests []string `json:"quarantined_tests"`
+	// DetectedTests are the tests automatically quarantined for being flaky,
+	// along with their flakiness scores.
+	DetectedTests []APITestFlakiness `json:"detected_tests"`
+	// ManualTests are the tests quarantined by project admins.
+	ManualTests []string `json:"manual_tests"`
+	// ExemptTests are the tests project admins have removed from the
+	// quarantine, which are never automatically quarantined.
+	ExemptTests []string `json:"exempt_tests"`
+	// LastAnalyzed is the time the project's tests were last analyzed for
+	// flakiness.
+	LastAnalyzed *time.Time `json:"last_analyzed"`
+}
+
+// APITestFlakiness describes how often a test flips between passing and
+// failing when its task is retried on the same revision.
+type APITestFlakiness struct {
+	// TestName is the display name of the test.
+	TestName *string `json:"test_name"`
+	// NumRuns is the number of retried task runs in which the test ran more
+	// than once.
+	NumRuns int `json:"num_runs"`
+	// NumFlakyRuns is the number of task runs in which the test both passed
+	// and failed.
+	NumFlakyRuns int `json:"num_flaky_runs"`
+	// Score is the fraction of the test's runs that were flaky.
+	Score float64 `json:"score"`
+}
+
+func (q *APITestQuarantine) BuildFromService(quarantine testresult.TestQuarantine) {
+	q.ProjectID = utility.ToStringPtr(quarantine.ProjectID)
+	q.QuarantinedTests = quarantine.TestNames()
+	q.DetectedTests = []APITestFlakiness{}
+	for _, test := range quarantine.Detected {
+		q.DetectedTests = append(q.DetectedTests, APITestFlakiness{
+			TestName:     utility.ToStringPtr(test.TestName),
+			NumRuns:      test.NumRuns,
+			NumFlakyRuns: test.NumFlakyRuns,
+			Score:        test.Score,
+		})
+	}
+	q.ManualTests = append([]string{}, quarantine.Manual...)
+	q.ExemptTests = append([]string{}, quarantine.Exempt...)
+	if !quarantine.LastAnalyzed.IsZero() {
+		q.LastAnalyzed = utility.ToTimePtr(quarantine.LastAnalyzed)
+	}
+}
+
+// APITestQuarantineUpdate describes changes to a project's list of
+// quarantined tests.
+type APITestQuarantineUpdate struct {
+	// Quarantine contains the display names of tests to quarantine.
+	Quarantine []string `json:"quarantine"`
+	// Unquarantine contains the display names of tests to remove from the
+	// quarantine. These tests will not be automatically quarantined again
+	// unless they are explicitly quarantined.
+	Unquarantine []string `json:"unquarantine"`
+}
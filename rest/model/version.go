@@ -53,6 +53,8 @@ type APIVersion struct {
 	GitTags []APIGitTag `json:"git_tags"`
 	// Indicates if the version was ignored due to only making changes to ignored files.
 	Ignored *bool `json:"ignored"`
+	// Tasks that were not created because the version didn't change any of the files they watch.
+	PathSkippedTasks []APIPathSkippedTask `json:"path_skipped_tasks,omitempty"`
 }
 
 type APIGitTag struct {
@@ -60,6 +62,13 @@ type APIGitTag struct {
 	Pusher *string `json:"pusher"`
 }
 
+type APIPathSkippedTask struct {
+	BuildVariant *string `json:"build_variant"`
+	Task         *string `json:"task"`
+	// The reason the task was skipped.
+	Reason *string `json:"reason"`
+}
+
 type buildDetail struct {
 	BuildVariant *string `json:"build_variant"`
 	BuildId      *string `json:"build_id"`
@@ -107,6 +116,14 @@ func (apiVersion *APIVersion) BuildFromService(ctx context.Context, v model.Vers
 		})
 	}
 
+	for _, t := range v.PathSkippedTasks {
+		apiVersion.PathSkippedTasks = append(apiVersion.PathSkippedTasks, APIPathSkippedTask{
+			BuildVariant: utility.ToStringPtr(t.Variant),
+			Task:         utility.ToStringPtr(t.Task),
+			Reason:       utility.ToStringPtr(t.Reason),
+		})
+	}
+
 	for _, gt := range v.GitTags {
 		apiVersion.GitTags = append(apiVersion.GitTags, APIGitTag{
 			Pusher: utility.ToStringPtr(gt.Pusher),
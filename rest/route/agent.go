@@ -15,6 +15,8 @@ import (
 	"github.com/evergreen-ci/evergreen/cloud"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/buildcache"
+	"github.com/evergreen-ci/evergreen/model/checkpoint"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/githubapp"
 	"github.com/evergreen-ci/evergreen/model/host"
@@ -98,6 +100,9 @@ func (h *agentSetup) Run(ctx context.Context) gimlet.Responder {
 	if h.settings.Tracer.Enabled {
 		data.TraceCollectorEndpoint = h.settings.Tracer.CollectorEndpoint
 	}
+	if h.settings.Buckets.BuildCache.IsEnabled() {
+		data.BuildCacheBucket = h.settings.Buckets.BuildCache.Bucket
+	}
 
 	return gimlet.NewJSONResponse(data)
 }
@@ -1861,8 +1866,13 @@ func (h *awsS3Credentials) Run(ctx context.Context) gimlet.Responder {
 		})
 	}
 
-	sharedBucket := h.env.Settings().Buckets.SharedBucket
-	projectPrefix := fmt.Sprintf("arn:aws:s3:::%s/%s", sharedBucket, t.Project)
+	bucket := h.env.Settings().Buckets.SharedBucket
+	// The build cache stores each project's archives under the project's
+	// prefix in its own bucket.
+	if buildCache := h.env.Settings().Buckets.BuildCache; buildCache.IsEnabled() && h.body.Bucket == buildCache.Bucket.Name {
+		bucket = buildCache.Bucket.Name
+	}
+	projectPrefix := fmt.Sprintf("arn:aws:s3:::%s/%s", bucket, t.Project)
 	accessPaths := []string{
 		projectPrefix,
 		fmt.Sprintf("%s/*", projectPrefix),
@@ -1902,3 +1912,318 @@ func (h *awsS3Credentials) Run(ctx context.Context) gimlet.Responder {
 		Expiration:      creds.Expiration.String(),
 	})
 }
+
+// POST /rest/v2/task/{task_id}/build_cache/restore
+// This route is used by cache.restore to find the build cache entry to
+// restore for a task.
+type buildCacheRestore struct {
+	taskID string
+	body   apimodels.BuildCacheRestoreRequest
+}
+
+func makeBuildCacheRestore() gimlet.RouteHandler {
+	return &buildCacheRestore{}
+}
+
+func (h *buildCacheRestore) Factory() gimlet.RouteHandler {
+	return &buildCacheRestore{}
+}
+
+func (h *buildCacheRestore) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task_id")
+	}
+	if err := utility.ReadJSON(r.Body, &h.body); err != nil {
+		return errors.Wrapf(err, "reading build cache restore body for task '%s'", h.taskID)
+	}
+	return errors.Wrapf(h.body.Validate(), "validating build cache restore body for task '%s'", h.taskID)
+}
+
+func (h *buildCacheRestore) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	e, err := buildcache.FindForRestore(ctx, t.Project, h.body.Key, h.body.RestoreKeys, evergreen.IsPatchRequester(t.Requester))
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding build cache entry '%s' for task '%s'", h.body.Key, h.taskID))
+	}
+	resp := apimodels.BuildCacheRestoreResponse{}
+	if e != nil {
+		resp.Entry = &apimodels.BuildCacheEntry{
+			Key:       e.Key,
+			SHA256:    e.SHA256,
+			SizeBytes: e.SizeBytes,
+		}
+	}
+	return gimlet.NewJSONResponse(resp)
+}
+
+// POST /rest/v2/task/{task_id}/build_cache/save
+// This route is used by cache.save to record an archive that a task uploaded
+// to the build cache.
+type buildCacheSave struct {
+	taskID string
+	body   apimodels.BuildCacheEntry
+}
+
+func makeBuildCacheSave() gimlet.RouteHandler {
+	return &buildCacheSave{}
+}
+
+func (h *buildCacheSave) Factory() gimlet.RouteHandler {
+	return &buildCacheSave{}
+}
+
+func (h *buildCacheSave) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task_id")
+	}
+	if err := utility.ReadJSON(r.Body, &h.body); err != nil {
+		return errors.Wrapf(err, "reading build cache entry body for task '%s'", h.taskID)
+	}
+	return errors.Wrapf(h.body.Validate(), "validating build cache entry body for task '%s'", h.taskID)
+}
+
+func (h *buildCacheSave) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	if _, err = buildcache.Save(ctx, buildcache.Entry{
+		ProjectID: t.Project,
+		Key:       h.body.Key,
+		SHA256:    h.body.SHA256,
+		SizeBytes: h.body.SizeBytes,
+		TaskID:    t.Id,
+		Patch:     evergreen.IsPatchRequester(t.Requester),
+	}); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "saving build cache entry '%s' for task '%s'", h.body.Key, h.taskID))
+	}
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+// POST /rest/v2/task/{task_id}/command_profile
+// This route is used by the agent to record the resources that a command used
+// after it finishes running.
+type commandProfileAdd struct {
+	taskID string
+	body   task.CommandProfile
+}
+
+func makeCommandProfileAdd() gimlet.RouteHandler {
+	return &commandProfileAdd{}
+}
+
+func (h *commandProfileAdd) Factory() gimlet.RouteHandler {
+	return &commandProfileAdd{}
+}
+
+func (h *commandProfileAdd) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task_id")
+	}
+	if err := utility.ReadJSON(r.Body, &h.body); err != nil {
+		return errors.Wrapf(err, "reading command profile body for task '%s'", h.taskID)
+	}
+	return errors.Wrapf(h.body.Validate(), "validating command profile body for task '%s'", h.taskID)
+}
+
+func (h *commandProfileAdd) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	if err = t.AddCommandProfile(ctx, h.body); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "adding command profile for task '%s'", h.taskID))
+	}
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+// GET /rest/v2/task/{task_id}/checkpoint
+// This route is used by the agent to find the checkpoint that the task can
+// resume from, if any.
+type checkpointGet struct {
+	taskID string
+}
+
+func makeCheckpointGet() gimlet.RouteHandler {
+	return &checkpointGet{}
+}
+
+func (h *checkpointGet) Factory() gimlet.RouteHandler {
+	return &checkpointGet{}
+}
+
+func (h *checkpointGet) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task_id")
+	}
+	return nil
+}
+
+func (h *checkpointGet) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	c, err := checkpoint.FindResumable(ctx, t)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding resumable checkpoint for task '%s'", h.taskID))
+	}
+	return gimlet.NewJSONResponse(apimodels.TaskCheckpointResponse{Checkpoint: checkpointToAPI(c)})
+}
+
+// POST /rest/v2/task/{task_id}/checkpoint
+// This route is used by checkpoint.save to record a checkpoint whose
+// snapshot the task uploaded.
+type checkpointSave struct {
+	taskID string
+	body   apimodels.TaskCheckpoint
+}
+
+func makeCheckpointSave() gimlet.RouteHandler {
+	return &checkpointSave{}
+}
+
+func (h *checkpointSave) Factory() gimlet.RouteHandler {
+	return &checkpointSave{}
+}
+
+func (h *checkpointSave) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task_id")
+	}
+	if err := utility.ReadJSON(r.Body, &h.body); err != nil {
+		return errors.Wrapf(err, "reading checkpoint body for task '%s'", h.taskID)
+	}
+	return errors.Wrapf(h.body.Validate(), "validating checkpoint body for task '%s'", h.taskID)
+}
+
+func (h *checkpointSave) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	replaced, err := checkpoint.Save(ctx, checkpoint.Checkpoint{
+		ID:         t.Id,
+		ProjectID:  t.Project,
+		Execution:  t.Execution,
+		Step:       h.body.Step,
+		Command:    h.body.Command,
+		Directory:  h.body.Directory,
+		SHA256:     h.body.SHA256,
+		SizeBytes:  h.body.SizeBytes,
+		Expansions: h.body.Expansions,
+	})
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "saving checkpoint '%s' for task '%s'", h.body.Step, h.taskID))
+	}
+	return gimlet.NewJSONResponse(apimodels.TaskCheckpointSaveResponse{Replaced: checkpointToAPI(replaced)})
+}
+
+// POST /rest/v2/task/{task_id}/checkpoint/resume
+// This route is used by the agent to record that the task resumed from its
+// resumable checkpoint instead of starting over.
+type checkpointResume struct {
+	taskID string
+}
+
+func makeCheckpointResume() gimlet.RouteHandler {
+	return &checkpointResume{}
+}
+
+func (h *checkpointResume) Factory() gimlet.RouteHandler {
+	return &checkpointResume{}
+}
+
+func (h *checkpointResume) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task_id")
+	}
+	return nil
+}
+
+func (h *checkpointResume) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	c, err := checkpoint.FindResumable(ctx, t)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding resumable checkpoint for task '%s'", h.taskID))
+	}
+	if c == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("task '%s' execution %d has no checkpoint to resume from", h.taskID, t.Execution),
+		})
+	}
+
+	if err = t.SetResumedFromCheckpoint(ctx, c.Step, c.Execution); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "marking task '%s' resumed", h.taskID))
+	}
+	if err = c.CarryForward(ctx, t.Execution); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "carrying checkpoint forward for task '%s'", h.taskID))
+	}
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+// checkpointToAPI converts a stored checkpoint to the checkpoint sent to the
+// agent.
+func checkpointToAPI(c *checkpoint.Checkpoint) *apimodels.TaskCheckpoint {
+	if c == nil {
+		return nil
+	}
+	return &apimodels.TaskCheckpoint{
+		Step:       c.Step,
+		Command:    c.Command,
+		Execution:  c.Execution,
+		Directory:  c.Directory,
+		SHA256:     c.SHA256,
+		SizeBytes:  c.SizeBytes,
+		Expansions: c.Expansions,
+	}
+}
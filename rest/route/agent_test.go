@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,6 +18,8 @@ import (
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/mock"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/buildcache"
+	"github.com/evergreen-ci/evergreen/model/checkpoint"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/patch"
@@ -29,6 +32,7 @@ import (
 	"github.com/mongodb/grip/send"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 var (
@@ -1058,3 +1062,269 @@ func TestAWSS3(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildCacheRoutes(t *testing.T) {
+	taskID := "taskID"
+	hash := strings.Repeat("a", 64)
+	newRequest := func(t *testing.T, route string, body any) *http.Request {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		request, err := http.NewRequest(http.MethodPost, fmt.Sprintf(route, taskID), bytes.NewReader(b))
+		require.NoError(t, err)
+		return gimlet.SetURLVars(request, map[string]string{"task_id": taskID})
+	}
+	restore := func(ctx context.Context, t *testing.T, req apimodels.BuildCacheRestoreRequest) *apimodels.BuildCacheEntry {
+		handler, ok := makeBuildCacheRestore().(*buildCacheRestore)
+		require.True(t, ok)
+		require.NoError(t, handler.Parse(ctx, newRequest(t, "/task/%s/build_cache/restore", req)))
+		resp := handler.Run(ctx)
+		require.NotNil(t, resp)
+		require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+		data, ok := resp.Data().(apimodels.BuildCacheRestoreResponse)
+		require.True(t, ok)
+		return data.Entry
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T){
+		"SaveParseErrorsOnInvalidHash": func(ctx context.Context, t *testing.T) {
+			handler, ok := makeBuildCacheSave().(*buildCacheSave)
+			require.True(t, ok)
+			err := handler.Parse(ctx, newRequest(t, "/task/%s/build_cache/save", apimodels.BuildCacheEntry{Key: "key", SHA256: "abc"}))
+			assert.ErrorContains(t, err, "validating build cache entry body for task 'taskID'")
+		},
+		"RestoreParseErrorsOnEmptyKey": func(ctx context.Context, t *testing.T) {
+			handler, ok := makeBuildCacheRestore().(*buildCacheRestore)
+			require.True(t, ok)
+			err := handler.Parse(ctx, newRequest(t, "/task/%s/build_cache/restore", apimodels.BuildCacheRestoreRequest{}))
+			assert.ErrorContains(t, err, "validating build cache restore body for task 'taskID'")
+		},
+		"RestoreReturnsNoEntryWithoutMatch": func(ctx context.Context, t *testing.T) {
+			assert.Nil(t, restore(ctx, t, apimodels.BuildCacheRestoreRequest{Key: "deps-1", RestoreKeys: []string{"deps-"}}))
+		},
+		"RestoresSavedEntry": func(ctx context.Context, t *testing.T) {
+			handler, ok := makeBuildCacheSave().(*buildCacheSave)
+			require.True(t, ok)
+			require.NoError(t, handler.Parse(ctx, newRequest(t, "/task/%s/build_cache/save", apimodels.BuildCacheEntry{Key: "deps-1", SHA256: hash, SizeBytes: 100})))
+			resp := handler.Run(ctx)
+			require.NotNil(t, resp)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+
+			e, err := buildcache.FindOne(ctx, projectID, "deps-1")
+			require.NoError(t, err)
+			require.NotZero(t, e)
+			assert.Equal(t, taskID, e.TaskID)
+
+			entry := restore(ctx, t, apimodels.BuildCacheRestoreRequest{Key: "deps-2", RestoreKeys: []string{"deps-"}})
+			require.NotNil(t, entry)
+			assert.Equal(t, "deps-1", entry.Key)
+			assert.Equal(t, hash, entry.SHA256)
+			assert.EqualValues(t, 100, entry.SizeBytes)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection, buildcache.Collection))
+			require.NoError(t, (&task.Task{Id: taskID, Project: projectID}).Insert())
+			tCase(t.Context(), t)
+		})
+	}
+}
+
+func TestCommandProfileAdd(t *testing.T) {
+	taskID := "taskID"
+	start := time.Now().Round(time.Millisecond)
+	newRequest := func(t *testing.T, body any) *http.Request {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		request, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/task/%s/command_profile", taskID), bytes.NewReader(b))
+		require.NoError(t, err)
+		return gimlet.SetURLVars(request, map[string]string{"task_id": taskID})
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T){
+		"ParseErrorsOnMissingCommand": func(ctx context.Context, t *testing.T) {
+			handler, ok := makeCommandProfileAdd().(*commandProfileAdd)
+			require.True(t, ok)
+			err := handler.Parse(ctx, newRequest(t, task.CommandProfile{Start: start, End: start}))
+			assert.ErrorContains(t, err, "validating command profile body for task 'taskID'")
+		},
+		"AddsProfileToTask": func(ctx context.Context, t *testing.T) {
+			handler, ok := makeCommandProfileAdd().(*commandProfileAdd)
+			require.True(t, ok)
+			require.NoError(t, handler.Parse(ctx, newRequest(t, task.CommandProfile{
+				Command:      "'shell.exec'",
+				Start:        start,
+				End:          start.Add(time.Second),
+				PeakRSSBytes: 1024,
+				CPUSeconds:   0.5,
+			})))
+			resp := handler.Run(ctx)
+			require.NotNil(t, resp)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+
+			profiles, err := task.FindCommandProfiles(ctx, taskID, 0)
+			require.NoError(t, err)
+			require.Len(t, profiles, 1)
+			assert.Equal(t, "'shell.exec'", profiles[0].Command)
+			assert.EqualValues(t, 1024, profiles[0].PeakRSSBytes)
+			assert.Equal(t, 0.5, profiles[0].CPUSeconds)
+		},
+		"RunErrorsForNonexistentTask": func(ctx context.Context, t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection))
+			handler, ok := makeCommandProfileAdd().(*commandProfileAdd)
+			require.True(t, ok)
+			require.NoError(t, handler.Parse(ctx, newRequest(t, task.CommandProfile{Command: "'shell.exec'", Start: start, End: start})))
+			resp := handler.Run(ctx)
+			require.NotNil(t, resp)
+			assert.Equal(t, http.StatusNotFound, resp.Status())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection, task.CommandProfilesCollection))
+			require.NoError(t, (&task.Task{Id: taskID, Project: projectID, Status: evergreen.TaskStarted}).Insert())
+			tCase(t.Context(), t)
+		})
+	}
+}
+
+func TestCheckpointRoutes(t *testing.T) {
+	taskID := "taskID"
+	hash := strings.Repeat("a", 64)
+	newRequest := func(t *testing.T, method, route string, body any) *http.Request {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		request, err := http.NewRequest(method, fmt.Sprintf(route, taskID), bytes.NewReader(b))
+		require.NoError(t, err)
+		return gimlet.SetURLVars(request, map[string]string{"task_id": taskID})
+	}
+	save := func(ctx context.Context, t *testing.T, c apimodels.TaskCheckpoint) *apimodels.TaskCheckpoint {
+		handler, ok := makeCheckpointSave().(*checkpointSave)
+		require.True(t, ok)
+		require.NoError(t, handler.Parse(ctx, newRequest(t, http.MethodPost, "/task/%s/checkpoint", c)))
+		resp := handler.Run(ctx)
+		require.NotNil(t, resp)
+		require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+		data, ok := resp.Data().(apimodels.TaskCheckpointSaveResponse)
+		require.True(t, ok)
+		return data.Replaced
+	}
+	get := func(ctx context.Context, t *testing.T) *apimodels.TaskCheckpoint {
+		handler, ok := makeCheckpointGet().(*checkpointGet)
+		require.True(t, ok)
+		require.NoError(t, handler.Parse(ctx, newRequest(t, http.MethodGet, "/task/%s/checkpoint", nil)))
+		resp := handler.Run(ctx)
+		require.NotNil(t, resp)
+		require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+		data, ok := resp.Data().(apimodels.TaskCheckpointResponse)
+		require.True(t, ok)
+		return data.Checkpoint
+	}
+	// loseHost archives the task's current execution as if its host was
+	// lost and starts a new execution.
+	loseHost := func(ctx context.Context, t *testing.T) {
+		tsk, err := task.FindOneId(ctx, taskID)
+		require.NoError(t, err)
+		require.NotZero(t, tsk)
+		details := task.GetSystemFailureDetails(evergreen.TaskDescriptionStranded)
+		require.NoError(t, task.UpdateOne(ctx, task.ById(taskID), bson.M{"$set": bson.M{
+			task.StatusKey:  evergreen.TaskFailed,
+			task.DetailsKey: details,
+		}}))
+		tsk.Status = evergreen.TaskFailed
+		tsk.Details = details
+		require.NoError(t, tsk.Archive(ctx))
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T){
+		"SaveParseErrorsOnMissingStep": func(ctx context.Context, t *testing.T) {
+			handler, ok := makeCheckpointSave().(*checkpointSave)
+			require.True(t, ok)
+			err := handler.Parse(ctx, newRequest(t, http.MethodPost, "/task/%s/checkpoint", apimodels.TaskCheckpoint{Command: "'checkpoint.save'", SHA256: hash}))
+			assert.ErrorContains(t, err, "validating checkpoint body for task 'taskID'")
+		},
+		"SaveReturnsReplacedCheckpoint": func(ctx context.Context, t *testing.T) {
+			assert.Nil(t, save(ctx, t, apimodels.TaskCheckpoint{Step: "compile", Command: "'checkpoint.save' (step 2 of 4)", SHA256: hash}))
+			replaced := save(ctx, t, apimodels.TaskCheckpoint{Step: "test", Command: "'checkpoint.save' (step 4 of 4)", SHA256: strings.Repeat("b", 64)})
+			require.NotNil(t, replaced)
+			assert.Equal(t, "compile", replaced.Step)
+			assert.Equal(t, hash, replaced.SHA256)
+		},
+		"GetReturnsNoCheckpointForSameExecution": func(ctx context.Context, t *testing.T) {
+			save(ctx, t, apimodels.TaskCheckpoint{Step: "compile", Command: "'checkpoint.save'", SHA256: hash})
+			assert.Nil(t, get(ctx, t))
+		},
+		"ResumesFromCheckpointAfterHostIsLost": func(ctx context.Context, t *testing.T) {
+			save(ctx, t, apimodels.TaskCheckpoint{Step: "compile", Command: "'checkpoint.save'", SHA256: hash, Expansions: map[string]string{"version": "1.0"}})
+			loseHost(ctx, t)
+
+			c := get(ctx, t)
+			require.NotNil(t, c)
+			assert.Equal(t, "compile", c.Step)
+			assert.Equal(t, 0, c.Execution)
+			assert.Equal(t, map[string]string{"version": "1.0"}, c.Expansions)
+
+			handler, ok := makeCheckpointResume().(*checkpointResume)
+			require.True(t, ok)
+			require.NoError(t, handler.Parse(ctx, newRequest(t, http.MethodPost, "/task/%s/checkpoint/resume", nil)))
+			resp := handler.Run(ctx)
+			require.NotNil(t, resp)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+
+			tsk, err := task.FindOneId(ctx, taskID)
+			require.NoError(t, err)
+			require.NotZero(t, tsk)
+			assert.Equal(t, 1, tsk.Execution)
+			assert.Equal(t, "compile", tsk.ResumedFromStep)
+			assert.Equal(t, 0, tsk.ResumedFromExecution)
+		},
+		"ResumesAgainAfterResumedExecutionLosesHost": func(ctx context.Context, t *testing.T) {
+			save(ctx, t, apimodels.TaskCheckpoint{Step: "compile", Command: "'checkpoint.save'", SHA256: hash})
+			resume := func(t *testing.T) {
+				handler, ok := makeCheckpointResume().(*checkpointResume)
+				require.True(t, ok)
+				require.NoError(t, handler.Parse(ctx, newRequest(t, http.MethodPost, "/task/%s/checkpoint/resume", nil)))
+				resp := handler.Run(ctx)
+				require.NotNil(t, resp)
+				require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+			}
+			loseHost(ctx, t)
+			resume(t)
+			loseHost(ctx, t)
+
+			c := get(ctx, t)
+			require.NotNil(t, c)
+			assert.Equal(t, "compile", c.Step)
+			assert.Equal(t, 1, c.Execution)
+			resume(t)
+
+			tsk, err := task.FindOneId(ctx, taskID)
+			require.NoError(t, err)
+			require.NotZero(t, tsk)
+			assert.Equal(t, 2, tsk.Execution)
+			assert.Equal(t, "compile", tsk.ResumedFromStep)
+			assert.Equal(t, 1, tsk.ResumedFromExecution)
+		},
+		"ResumeErrorsWithoutCheckpoint": func(ctx context.Context, t *testing.T) {
+			handler, ok := makeCheckpointResume().(*checkpointResume)
+			require.True(t, ok)
+			require.NoError(t, handler.Parse(ctx, newRequest(t, http.MethodPost, "/task/%s/checkpoint/resume", nil)))
+			resp := handler.Run(ctx)
+			require.NotNil(t, resp)
+			assert.Equal(t, http.StatusBadRequest, resp.Status())
+		},
+		"GetErrorsForNonexistentTask": func(ctx context.Context, t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection))
+			handler, ok := makeCheckpointGet().(*checkpointGet)
+			require.True(t, ok)
+			require.NoError(t, handler.Parse(ctx, newRequest(t, http.MethodGet, "/task/%s/checkpoint", nil)))
+			resp := handler.Run(ctx)
+			require.NotNil(t, resp)
+			assert.Equal(t, http.StatusNotFound, resp.Status())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection, task.OldCollection, checkpoint.Collection))
+			require.NoError(t, (&task.Task{Id: taskID, Project: projectID, Status: evergreen.TaskStarted}).Insert())
+			tCase(t.Context(), t)
+		})
+	}
+}
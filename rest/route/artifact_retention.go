@@ -0,0 +1,112 @@
+package route
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// GET /projects/{project_id}/artifact_retention
+type projectArtifactRetentionGetHandler struct {
+	projectID string
+	env       evergreen.Environment
+}
+
+func makeGetProjectArtifactRetention(env evergreen.Environment) gimlet.RouteHandler {
+	return &projectArtifactRetentionGetHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a project's artifact retention policy
+//	@Description	Returns the rules that determine when the artifacts uploaded by a project's tasks expire. Expired artifacts are deleted from their bucket and shown as expired instead of linked to.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/artifact_retention [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string	true	"Project ID or identifier."
+//	@Success		200			{object}	model.APIArtifactRetentionPolicy
+func (h *projectArtifactRetentionGetHandler) Factory() gimlet.RouteHandler {
+	return &projectArtifactRetentionGetHandler{env: h.env}
+}
+
+func (h *projectArtifactRetentionGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	var err error
+	h.projectID, err = getCostProjectID(ctx, r)
+	return err
+}
+
+func (h *projectArtifactRetentionGetHandler) Run(ctx context.Context) gimlet.Responder {
+	policy, err := artifact.FindRetentionPolicy(ctx, h.env, h.projectID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	apiPolicy := &model.APIArtifactRetentionPolicy{}
+	apiPolicy.BuildFromService(*policy)
+
+	return gimlet.NewJSONResponse(apiPolicy)
+}
+
+// PUT /projects/{project_id}/artifact_retention
+type projectArtifactRetentionPutHandler struct {
+	policy artifact.RetentionPolicy
+	env    evergreen.Environment
+}
+
+func makePutProjectArtifactRetention(env evergreen.Environment) gimlet.RouteHandler {
+	return &projectArtifactRetentionPutHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Set a project's artifact retention policy
+//	@Description	Replaces the rules that determine when the artifacts uploaded by a project's tasks expire. Rules are evaluated in order and the first rule matching an artifact's name and its task's requester determines when it expires. Artifacts that do not match any rule are kept indefinitely. Returns the project's updated policy.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/artifact_retention [put]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string								true	"Project ID or identifier."
+//	@Param			{object}	body		model.APIArtifactRetentionPolicy	true	"The project's new retention rules."
+//	@Success		200			{object}	model.APIArtifactRetentionPolicy
+func (h *projectArtifactRetentionPutHandler) Factory() gimlet.RouteHandler {
+	return &projectArtifactRetentionPutHandler{env: h.env}
+}
+
+func (h *projectArtifactRetentionPutHandler) Parse(ctx context.Context, r *http.Request) error {
+	projectID, err := getCostProjectID(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	apiPolicy := model.APIArtifactRetentionPolicy{}
+	if err = utility.ReadJSON(r.Body, &apiPolicy); err != nil {
+		return errors.Wrap(err, "reading artifact retention policy from JSON request body")
+	}
+	h.policy = apiPolicy.ToService()
+	h.policy.ProjectID = projectID
+
+	if err = h.policy.Validate(); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid artifact retention policy").Error(),
+		}
+	}
+
+	return nil
+}
+
+func (h *projectArtifactRetentionPutHandler) Run(ctx context.Context) gimlet.Responder {
+	if err := artifact.SetRetentionPolicy(ctx, h.env, h.policy); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	apiPolicy := &model.APIArtifactRetentionPolicy{}
+	apiPolicy.BuildFromService(h.policy)
+
+	return gimlet.NewJSONResponse(apiPolicy)
+}
@@ -0,0 +1,91 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactRetentionHandlers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(dbModel.ProjectRefCollection, artifact.RetentionPolicyCollection))
+	}
+	clearAll()
+	defer clearAll()
+
+	makeRequest := func(t *testing.T, method string, body any) *http.Request {
+		var data []byte
+		if body != nil {
+			var err error
+			data, err = json.Marshal(body)
+			require.NoError(t, err)
+		}
+		req, err := http.NewRequest(method, "/projects/project_identifier/artifact_retention", bytes.NewBuffer(data))
+		require.NoError(t, err)
+
+		return gimlet.SetURLVars(req, map[string]string{"project_id": "project_identifier"})
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"GetReturnsEmptyPolicy": func(t *testing.T) {
+			rh := makeGetProjectArtifactRetention(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodGet, nil)))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiPolicy, ok := resp.Data().(*model.APIArtifactRetentionPolicy)
+			require.True(t, ok)
+			assert.Equal(t, "project_id", utility.FromStringPtr(apiPolicy.ProjectID))
+			assert.Empty(t, apiPolicy.Rules)
+		},
+		"PutSetsRules": func(t *testing.T) {
+			rh := makePutProjectArtifactRetention(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodPut, model.APIArtifactRetentionPolicy{
+				Rules: []model.APIArtifactRetentionRule{
+					{NamePattern: utility.ToStringPtr("*.tgz"), Requesters: []string{evergreen.PatchVersionRequester}, MaxAgeDays: 7},
+					{MaxAgeDays: 90, KeepLastMainline: 5},
+				},
+			})))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			policy, err := artifact.FindRetentionPolicy(ctx, env, "project_id")
+			require.NoError(t, err)
+			require.Len(t, policy.Rules, 2)
+			assert.Equal(t, "*.tgz", policy.Rules[0].NamePattern)
+			assert.Equal(t, 7, policy.Rules[0].MaxAgeDays)
+			assert.Equal(t, 5, policy.Rules[1].KeepLastMainline)
+		},
+		"PutFailsWithInvalidRule": func(t *testing.T) {
+			rh := makePutProjectArtifactRetention(env).Factory()
+			assert.Error(t, rh.Parse(ctx, makeRequest(t, http.MethodPut, model.APIArtifactRetentionPolicy{
+				Rules: []model.APIArtifactRetentionRule{{NamePattern: utility.ToStringPtr("*.tgz")}},
+			})))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			pRef := dbModel.ProjectRef{Id: "project_id", Identifier: "project_identifier"}
+			require.NoError(t, pRef.Insert())
+
+			tCase(t)
+		})
+	}
+}
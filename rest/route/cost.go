@@ -0,0 +1,286 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// parseCostReportDates parses the start and end dates of a cost report from
+// the query parameters. By default, the report ends today and covers the last
+// 30 days.
+func parseCostReportDates(vals url.Values) (time.Time, time.Time, error) {
+	endDate := utility.GetUTCDay(time.Now())
+	if dateStr := vals.Get("end_date"); dateStr != "" {
+		date, err := time.ParseInLocation(statsAPIDateFormat, dateStr, time.UTC)
+		if err != nil {
+			return time.Time{}, time.Time{}, gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrapf(err, "parsing end date in expected format (%s)", statsAPIDateFormat).Error(),
+			}
+		}
+		endDate = date
+	}
+	startDate := endDate.AddDate(0, 0, 1-cost.DefaultReportNumDays)
+	if dateStr := vals.Get("start_date"); dateStr != "" {
+		date, err := time.ParseInLocation(statsAPIDateFormat, dateStr, time.UTC)
+		if err != nil {
+			return time.Time{}, time.Time{}, gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrapf(err, "parsing start date in expected format (%s)", statsAPIDateFormat).Error(),
+			}
+		}
+		startDate = date
+	}
+
+	if startDate.After(endDate) {
+		return time.Time{}, time.Time{}, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "start date cannot be after end date",
+		}
+	}
+	if endDate.Sub(startDate) >= cost.MaxReportNumDays*24*time.Hour {
+		return time.Time{}, time.Time{}, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("cost report cannot cover more than %d days", cost.MaxReportNumDays),
+		}
+	}
+
+	return startDate, endDate, nil
+}
+
+// getCostProjectID returns the ID of the project in the request's URL.
+func getCostProjectID(ctx context.Context, r *http.Request) (string, error) {
+	projectID, err := dbModel.GetIdForProject(ctx, gimlet.GetVars(r)["project_id"])
+	if err != nil {
+		return "", gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrap(err, "getting ID for project").Error(),
+		}
+	}
+
+	return projectID, nil
+}
+
+// GET /projects/{project_id}/cost
+type projectCostGetHandler struct {
+	projectID string
+	startDate time.Time
+	endDate   time.Time
+	env       evergreen.Environment
+}
+
+func makeGetProjectCost(env evergreen.Environment) gimlet.RouteHandler {
+	return &projectCostGetHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a project's cost
+//	@Description	Returns the cost of running a project's tasks between two dates, broken down by day, build variant and patch author. Costs are rolled up hourly, so the current day's cost may be incomplete.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/cost [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string	true	"Project ID or identifier."
+//	@Param			start_date	query		string	false	"The first day to include, in the format YYYY-MM-DD. Defaults to 30 days before the end date."
+//	@Param			end_date	query		string	false	"The last day to include, in the format YYYY-MM-DD. Defaults to today."
+//	@Success		200			{object}	model.APICostReport
+func (h *projectCostGetHandler) Factory() gimlet.RouteHandler {
+	return &projectCostGetHandler{env: h.env}
+}
+
+func (h *projectCostGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	var err error
+	h.projectID, err = getCostProjectID(ctx, r)
+	if err != nil {
+		return err
+	}
+	h.startDate, h.endDate, err = parseCostReportDates(r.URL.Query())
+	return err
+}
+
+func (h *projectCostGetHandler) Run(ctx context.Context) gimlet.Responder {
+	costs, err := cost.FindProjectDailyCosts(ctx, h.env, h.projectID, h.startDate, h.endDate)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding costs for project '%s'", h.projectID))
+	}
+
+	apiReport := &model.APICostReport{}
+	apiReport.BuildFromService(*cost.NewReport(costs, h.startDate, h.endDate))
+
+	return gimlet.NewJSONResponse(apiReport)
+}
+
+// GET /users/{user_id}/cost
+type userCostGetHandler struct {
+	userID    string
+	startDate time.Time
+	endDate   time.Time
+	env       evergreen.Environment
+}
+
+func makeGetUserCost(env evergreen.Environment) gimlet.RouteHandler {
+	return &userCostGetHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a user's cost
+//	@Description	Returns the cost of a user's patch tasks and spawn hosts between two dates, broken down by day, project and build variant. Only admins can get the cost of other users.
+//	@Tags			users
+//	@Router			/users/{user_id}/cost [get]
+//	@Security		Api-User || Api-Key
+//	@Param			user_id		path		string	true	"User ID"
+//	@Param			start_date	query		string	false	"The first day to include, in the format YYYY-MM-DD. Defaults to 30 days before the end date."
+//	@Param			end_date	query		string	false	"The last day to include, in the format YYYY-MM-DD. Defaults to today."
+//	@Success		200			{object}	model.APICostReport
+func (h *userCostGetHandler) Factory() gimlet.RouteHandler {
+	return &userCostGetHandler{env: h.env}
+}
+
+func (h *userCostGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.userID = gimlet.GetVars(r)["user_id"]
+	u := MustHaveUser(ctx)
+	if h.userID != u.Username() && !u.HasPermission(gimlet.PermissionOpts{
+		Resource:      evergreen.SuperUserPermissionsID,
+		ResourceType:  evergreen.SuperUserResourceType,
+		Permission:    evergreen.PermissionAdminSettings,
+		RequiredLevel: evergreen.AdminSettingsEdit.Value,
+	}) {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Message:    "only admins can get the cost of other users",
+		}
+	}
+
+	var err error
+	h.startDate, h.endDate, err = parseCostReportDates(r.URL.Query())
+	return err
+}
+
+func (h *userCostGetHandler) Run(ctx context.Context) gimlet.Responder {
+	costs, err := cost.FindUserDailyCosts(ctx, h.env, h.userID, h.startDate, h.endDate)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding costs for user '%s'", h.userID))
+	}
+
+	apiReport := &model.APICostReport{}
+	apiReport.BuildFromService(*cost.NewReport(costs, h.startDate, h.endDate))
+
+	return gimlet.NewJSONResponse(apiReport)
+}
+
+// GET /projects/{project_id}/cost_budget
+type projectCostBudgetGetHandler struct {
+	projectID string
+	env       evergreen.Environment
+}
+
+func makeGetProjectCostBudget(env evergreen.Environment) gimlet.RouteHandler {
+	return &projectCostBudgetGetHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a project's cost budget
+//	@Description	Returns a project's monthly cost budget and its spending so far this month. Once a project exceeds its hard limit, its patches cannot be scheduled until the next month.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/cost_budget [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string	true	"Project ID or identifier."
+//	@Success		200			{object}	model.APIProjectCostBudget
+func (h *projectCostBudgetGetHandler) Factory() gimlet.RouteHandler {
+	return &projectCostBudgetGetHandler{env: h.env}
+}
+
+func (h *projectCostBudgetGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	var err error
+	h.projectID, err = getCostProjectID(ctx, r)
+	return err
+}
+
+func (h *projectCostBudgetGetHandler) Run(ctx context.Context) gimlet.Responder {
+	budget, err := cost.FindBudget(ctx, h.env, h.projectID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	return makeProjectCostBudgetResponse(ctx, h.env, *budget)
+}
+
+// PUT /projects/{project_id}/cost_budget
+type projectCostBudgetPutHandler struct {
+	budget cost.ProjectBudget
+	env    evergreen.Environment
+}
+
+func makePutProjectCostBudget(env evergreen.Environment) gimlet.RouteHandler {
+	return &projectCostBudgetPutHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Set a project's cost budget
+//	@Description	Sets a project's monthly soft and hard cost limits. Exceeding the soft limit logs a warning when patches are scheduled, while exceeding the hard limit prevents patches from being scheduled. A limit of 0 removes that limit. Returns the project's updated budget.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/cost_budget [put]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string						true	"Project ID or identifier."
+//	@Param			{object}	body		model.APIProjectCostBudget	true	"The project's new limits."
+//	@Success		200			{object}	model.APIProjectCostBudget
+func (h *projectCostBudgetPutHandler) Factory() gimlet.RouteHandler {
+	return &projectCostBudgetPutHandler{env: h.env}
+}
+
+func (h *projectCostBudgetPutHandler) Parse(ctx context.Context, r *http.Request) error {
+	projectID, err := getCostProjectID(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	apiBudget := model.APIProjectCostBudget{}
+	if err = utility.ReadJSON(r.Body, &apiBudget); err != nil {
+		return errors.Wrap(err, "reading cost budget from JSON request body")
+	}
+	h.budget = apiBudget.ToService()
+	h.budget.ProjectID = projectID
+
+	if err = h.budget.Validate(); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid cost budget").Error(),
+		}
+	}
+
+	return nil
+}
+
+func (h *projectCostBudgetPutHandler) Run(ctx context.Context) gimlet.Responder {
+	if err := cost.SetBudget(ctx, h.env, h.budget); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	return makeProjectCostBudgetResponse(ctx, h.env, h.budget)
+}
+
+func makeProjectCostBudgetResponse(ctx context.Context, env evergreen.Environment, budget cost.ProjectBudget) gimlet.Responder {
+	status, err := cost.GetBudgetStatus(ctx, env, budget, time.Now())
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	apiBudget := &model.APIProjectCostBudget{}
+	apiBudget.BuildFromService(*status)
+
+	return gimlet.NewJSONResponse(apiBudget)
+}
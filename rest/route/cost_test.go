@@ -0,0 +1,169 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostHandlers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+	ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "user"})
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(dbModel.ProjectRefCollection, cost.DailyCostCollection, cost.BudgetCollection))
+	}
+	clearAll()
+	defer clearAll()
+
+	today := utility.GetUTCDay(time.Now())
+	saveCosts := func(t *testing.T, date time.Time) {
+		rollup := cost.NewRollup(date)
+		rollup.AddTask(&task.Task{Project: "project_id", BuildVariant: "variant", StartTime: date, FinishTime: date.Add(2 * time.Hour)}, "user", 1)
+		rollup.AddSpawnHost(&host.Host{StartedBy: "user", StartTime: date}, 0.5, date.Add(2*time.Hour))
+		require.NoError(t, rollup.Save(ctx, env))
+	}
+	makeRequest := func(t *testing.T, method, url string, vars map[string]string, body any) *http.Request {
+		var data []byte
+		if body != nil {
+			var err error
+			data, err = json.Marshal(body)
+			require.NoError(t, err)
+		}
+		req, err := http.NewRequest(method, url, bytes.NewBuffer(data))
+		require.NoError(t, err)
+
+		return gimlet.SetURLVars(req, vars)
+	}
+	projectVars := map[string]string{"project_id": "project_identifier"}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"GetProjectCostReturnsTaskCosts": func(t *testing.T) {
+			saveCosts(t, today)
+			saveCosts(t, today.AddDate(0, 0, -1))
+			saveCosts(t, today.AddDate(0, 0, -cost.DefaultReportNumDays))
+
+			rh := makeGetProjectCost(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodGet, "/projects/project_identifier/cost", projectVars, nil)))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiReport, ok := resp.Data().(*model.APICostReport)
+			require.True(t, ok)
+			assert.Equal(t, 4.0, apiReport.TotalCost)
+			assert.Equal(t, 4.0, apiReport.TaskCost)
+			assert.Zero(t, apiReport.SpawnHostCost)
+			assert.Len(t, apiReport.ByDay, 2)
+			require.Len(t, apiReport.ByBuildVariant, 1)
+			assert.Equal(t, "variant", utility.FromStringPtr(apiReport.ByBuildVariant[0].Name))
+			assert.Equal(t, model.NewAPIDuration(4*time.Hour), apiReport.ByBuildVariant[0].Runtime)
+		},
+		"GetProjectCostRespectsDates": func(t *testing.T) {
+			saveCosts(t, today)
+			saveCosts(t, today.AddDate(0, 0, -1))
+
+			date := today.AddDate(0, 0, -1).Format(statsAPIDateFormat)
+			rh := makeGetProjectCost(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodGet, "/projects/project_identifier/cost?start_date="+date+"&end_date="+date, projectVars, nil)))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiReport, ok := resp.Data().(*model.APICostReport)
+			require.True(t, ok)
+			assert.Equal(t, 2.0, apiReport.TotalCost)
+		},
+		"GetProjectCostFailsWithInvalidDates": func(t *testing.T) {
+			for _, query := range []string{
+				"?start_date=yesterday",
+				"?start_date=2024-03-10&end_date=2024-03-09",
+				"?start_date=2023-01-01&end_date=2024-03-09",
+			} {
+				rh := makeGetProjectCost(env).Factory()
+				assert.Error(t, rh.Parse(ctx, makeRequest(t, http.MethodGet, "/projects/project_identifier/cost"+query, projectVars, nil)), query)
+			}
+		},
+		"GetUserCostReturnsTaskAndSpawnHostCosts": func(t *testing.T) {
+			saveCosts(t, today)
+
+			rh := makeGetUserCost(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodGet, "/users/user/cost", map[string]string{"user_id": "user"}, nil)))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiReport, ok := resp.Data().(*model.APICostReport)
+			require.True(t, ok)
+			assert.Equal(t, 3.0, apiReport.TotalCost)
+			assert.Equal(t, 2.0, apiReport.TaskCost)
+			assert.Equal(t, 1.0, apiReport.SpawnHostCost)
+			require.Len(t, apiReport.ByProject, 1)
+			assert.Equal(t, "project_id", utility.FromStringPtr(apiReport.ByProject[0].Name))
+		},
+		"GetProjectCostBudgetReturnsEmptyBudget": func(t *testing.T) {
+			rh := makeGetProjectCostBudget(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodGet, "/projects/project_identifier/cost_budget", projectVars, nil)))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiBudget, ok := resp.Data().(*model.APIProjectCostBudget)
+			require.True(t, ok)
+			assert.Equal(t, "project_id", utility.FromStringPtr(apiBudget.ProjectID))
+			assert.Zero(t, apiBudget.SoftLimit)
+			assert.Zero(t, apiBudget.HardLimit)
+			assert.False(t, apiBudget.HardLimitExceeded)
+		},
+		"PutProjectCostBudgetSetsLimits": func(t *testing.T) {
+			saveCosts(t, today)
+
+			rh := makePutProjectCostBudget(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodPut, "/projects/project_identifier/cost_budget", projectVars, model.APIProjectCostBudget{SoftLimit: 1, HardLimit: 10})))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiBudget, ok := resp.Data().(*model.APIProjectCostBudget)
+			require.True(t, ok)
+			assert.Equal(t, "project_id", utility.FromStringPtr(apiBudget.ProjectID))
+			assert.Equal(t, 2.0, apiBudget.MonthToDateCost)
+			assert.True(t, apiBudget.SoftLimitExceeded)
+			assert.False(t, apiBudget.HardLimitExceeded)
+
+			budget, err := cost.FindBudget(ctx, env, "project_id")
+			require.NoError(t, err)
+			assert.Equal(t, 1.0, budget.SoftLimit)
+			assert.Equal(t, 10.0, budget.HardLimit)
+		},
+		"PutProjectCostBudgetFailsWithInvalidLimits": func(t *testing.T) {
+			rh := makePutProjectCostBudget(env).Factory()
+			assert.Error(t, rh.Parse(ctx, makeRequest(t, http.MethodPut, "/projects/project_identifier/cost_budget", projectVars, model.APIProjectCostBudget{SoftLimit: 10, HardLimit: 1})))
+		},
+		"GetFailsForNonexistentProject": func(t *testing.T) {
+			rh := makeGetProjectCost(env).Factory()
+			assert.Error(t, rh.Parse(ctx, makeRequest(t, http.MethodGet, "/projects/nonexistent/cost", map[string]string{"project_id": "nonexistent"}, nil)))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			pRef := dbModel.ProjectRef{Id: "project_id", Identifier: "project_identifier"}
+			require.NoError(t, pRef.Insert())
+
+			tCase(t)
+		})
+	}
+}
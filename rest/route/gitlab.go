@@ -0,0 +1,248 @@
+package route
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/evergreen/units"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// gitlabWebhookTimeout is the maximum timeout for processing a GitLab webhook.
+const gitlabWebhookTimeout = 60 * time.Second
+
+type gitlabHookApi struct {
+	queue amboy.Queue
+
+	payload   []byte
+	eventType string
+	msgID     string
+	token     string
+}
+
+func makeGitLabHooksRoute(queue amboy.Queue) gimlet.RouteHandler {
+	return &gitlabHookApi{queue: queue}
+}
+
+func (gl *gitlabHookApi) Factory() gimlet.RouteHandler {
+	return &gitlabHookApi{queue: gl.queue}
+}
+
+func (gl *gitlabHookApi) Parse(ctx context.Context, r *http.Request) error {
+	gl.eventType = r.Header.Get(thirdparty.GitLabEventHeader)
+	gl.token = r.Header.Get(thirdparty.GitLabTokenHeader)
+	gl.msgID = r.Header.Get("X-Gitlab-Event-UUID")
+	if gl.msgID == "" {
+		gl.msgID = mgobson.NewObjectId().Hex()
+	}
+
+	if gl.queue == nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    "webhooks are not configured and therefore disabled",
+		}
+	}
+	if gl.token == "" {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "missing GitLab webhook token",
+		}
+	}
+
+	var err error
+	gl.payload, err = io.ReadAll(r.Body)
+	if err != nil {
+		return errors.Wrap(err, "reading webhook payload")
+	}
+
+	return nil
+}
+
+func (gl *gitlabHookApi) Run(_ context.Context) gimlet.Responder {
+	// As with GitHub webhooks, use a custom context so that the work is not
+	// aborted if GitLab closes the request early.
+	ctx, cancel := context.WithTimeout(context.Background(), gitlabWebhookTimeout)
+	defer cancel()
+
+	var err error
+	switch gl.eventType {
+	case thirdparty.GitLabMergeRequestHookEvent:
+		event := thirdparty.GitLabMergeRequestEvent{}
+		if err = json.Unmarshal(gl.payload, &event); err != nil {
+			return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "parsing merge request event"))
+		}
+		err = gl.handleMergeRequest(ctx, event)
+	case thirdparty.GitLabPushHookEvent:
+		event := thirdparty.GitLabPushEvent{}
+		if err = json.Unmarshal(gl.payload, &event); err != nil {
+			return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "parsing push event"))
+		}
+		err = gl.handlePush(ctx, event)
+	default:
+		grip.Debug(message.Fields{
+			"source":  "GitLab hook",
+			"msg_id":  gl.msgID,
+			"event":   gl.eventType,
+			"message": "ignoring unhandled event type",
+		})
+	}
+
+	if err != nil {
+		grip.Error(message.WrapError(err, message.Fields{
+			"source":  "GitLab hook",
+			"msg_id":  gl.msgID,
+			"event":   gl.eventType,
+			"message": "could not process GitLab webhook",
+		}))
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+// authorizedProjectRefs returns the project refs tracking the GitLab project
+// whose webhook secret matches the token sent with the webhook. It errors if
+// no project ref has a matching secret.
+func (gl *gitlabHookApi) authorizedProjectRefs(ctx context.Context, projectPath string) ([]model.ProjectRef, error) {
+	projectRefs, err := model.FindMergedEnabledProjectRefsByGitLabProjectPath(ctx, projectPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding project refs for GitLab project '%s'", projectPath)
+	}
+
+	authorized := []model.ProjectRef{}
+	for _, pRef := range projectRefs {
+		secret := pRef.GitLab.WebhookSecret
+		if secret != "" && subtle.ConstantTimeCompare([]byte(secret), []byte(gl.token)) == 1 {
+			authorized = append(authorized, pRef)
+		}
+	}
+	if len(authorized) == 0 {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    fmt.Sprintf("webhook token is not valid for any project tracking GitLab project '%s'", projectPath),
+		}
+	}
+
+	return authorized, nil
+}
+
+// handleMergeRequest creates a patch intent for a merge request that was
+// opened, reopened or had new commits pushed to it.
+func (gl *gitlabHookApi) handleMergeRequest(ctx context.Context, event thirdparty.GitLabMergeRequestEvent) error {
+	attrs := event.ObjectAttributes
+	switch attrs.Action {
+	case thirdparty.GitLabMergeRequestActionOpen, thirdparty.GitLabMergeRequestActionReopen:
+	case thirdparty.GitLabMergeRequestActionUpdate:
+		if attrs.OldRev == "" {
+			// The merge request was updated without new commits (e.g. its
+			// description was edited), so there is nothing new to test.
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	projectPath := event.Project.PathWithNamespace
+	projectRefs, err := gl.authorizedProjectRefs(ctx, projectPath)
+	if err != nil {
+		return err
+	}
+	var pRef *model.ProjectRef
+	for i := range projectRefs {
+		if projectRefs[i].Branch == attrs.TargetBranch && projectRefs[i].IsPRTestingEnabled() {
+			pRef = &projectRefs[i]
+			break
+		}
+	}
+	if pRef == nil {
+		grip.Debug(message.Fields{
+			"source":        "GitLab hook",
+			"msg_id":        gl.msgID,
+			"project_path":  projectPath,
+			"target_branch": attrs.TargetBranch,
+			"message":       "no project has merge request testing enabled for the target branch",
+		})
+		return nil
+	}
+
+	info, err := pRef.GitLab.Client().GetMergeRequest(ctx, projectPath, attrs.IID)
+	if err != nil {
+		return errors.Wrapf(err, "getting merge request !%d", attrs.IID)
+	}
+	headHash := info.DiffRefs.HeadSHA
+	if headHash == "" {
+		headHash = attrs.LastCommit.ID
+	}
+
+	mr := thirdparty.GitLabMergeRequest{
+		ProjectPath:       projectPath,
+		IID:               attrs.IID,
+		Title:             attrs.Title,
+		URL:               attrs.URL,
+		TargetBranch:      attrs.TargetBranch,
+		SourceBranch:      attrs.SourceBranch,
+		SourceProjectPath: attrs.Source.PathWithNamespace,
+		HeadHash:          headHash,
+		BaseHash:          info.DiffRefs.BaseSHA,
+		Author:            info.Author.Username,
+		AuthorUID:         info.Author.ID,
+	}
+	intent, err := patch.NewGitLabMergeRequestIntent(gl.msgID, patch.AutomatedCaller, mr)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "creating GitLab merge request intent").Error(),
+		}
+	}
+
+	return errors.Wrap(data.AddPRPatchIntent(intent, gl.queue), "saving GitLab merge request intent")
+}
+
+// handlePush enqueues repotracker jobs for the projects tracking the branch
+// that was pushed to.
+func (gl *gitlabHookApi) handlePush(ctx context.Context, event thirdparty.GitLabPushEvent) error {
+	if !strings.HasPrefix(event.Ref, "refs/heads/") {
+		return nil
+	}
+	branch := strings.TrimPrefix(event.Ref, "refs/heads/")
+
+	flags, err := evergreen.GetServiceFlags(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting service flags")
+	}
+	if flags.RepotrackerDisabled {
+		return errors.New("repotracker is disabled")
+	}
+
+	projectRefs, err := gl.authorizedProjectRefs(ctx, event.Project.PathWithNamespace)
+	if err != nil {
+		return err
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for _, pRef := range projectRefs {
+		if pRef.Branch != branch || !pRef.DoesTrackPushEvents() || pRef.IsRepotrackerDisabled() {
+			continue
+		}
+		j := units.NewRepotrackerJob(fmt.Sprintf("gitlab-push-%s", gl.msgID), pRef.Id)
+		catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, gl.queue, j), "enqueueing repotracker job for project '%s'", pRef.Id)
+	}
+
+	return catcher.Resolve()
+}
@@ -0,0 +1,327 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/parsley"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLogSearchLimit = 1000
+	maxLogSearchLimit     = 10000
+	maxLogSearchDays      = 30
+)
+
+// logSearchBaseHandler contains the search options shared by the log search
+// routes, which differ only in how they find the tasks to search.
+type logSearchBaseHandler struct {
+	opts taskoutput.LogSearchOptions
+}
+
+func (h *logSearchBaseHandler) parse(r *http.Request) error {
+	vals := r.URL.Query()
+
+	h.opts = taskoutput.LogSearchOptions{
+		Filter: parsley.Filter{
+			Expression:    vals.Get("expression"),
+			CaseSensitive: strings.ToLower(vals.Get("case_sensitive")) == "true",
+			ExactMatch:    strings.ToLower(vals.Get("exact_match")) != "false",
+		},
+		Limit: defaultLogSearchLimit,
+	}
+	if start := vals.Get("start"); start != "" {
+		ts, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return errors.Wrap(err, "parsing start time")
+		}
+
+		h.opts.Start = utility.ToInt64Ptr(ts.UnixNano())
+	}
+	if end := vals.Get("end"); end != "" {
+		ts, err := time.Parse(time.RFC3339, end)
+		if err != nil {
+			return errors.Wrap(err, "parsing end time")
+		}
+
+		h.opts.End = utility.ToInt64Ptr(ts.UnixNano())
+	}
+	if limit := vals.Get("limit"); limit != "" {
+		var err error
+		h.opts.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			return errors.Wrap(err, "parsing limit")
+		}
+		if h.opts.Limit <= 0 || h.opts.Limit > maxLogSearchLimit {
+			return errors.Errorf("limit must be between 1 and %d", maxLogSearchLimit)
+		}
+	}
+
+	return errors.Wrap(h.opts.Validate(), "invalid search options")
+}
+
+// search returns a response streaming the matches, one JSON object per line,
+// from the given tasks' logs.
+func (h *logSearchBaseHandler) search(ctx context.Context, tasks []task.Task) gimlet.Responder {
+	it, err := task.NewLogSearchIterator(ctx, tasks, h.opts)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "searching logs"))
+	}
+
+	return gimlet.NewTextResponse(&logSearchReader{it: it})
+}
+
+// logSearchReader reads the matches of a log search as newline-delimited JSON.
+type logSearchReader struct {
+	it   *task.LogSearchIterator
+	buf  bytes.Buffer
+	done bool
+}
+
+func (r *logSearchReader) Read(p []byte) (int, error) {
+	for r.buf.Len() == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		if !r.it.Next() {
+			r.done = true
+			err := r.it.Err()
+			if closeErr := r.it.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return 0, errors.Wrap(err, "searching logs")
+			}
+			continue
+		}
+
+		match := &model.APILogSearchMatch{}
+		match.BuildFromService(r.it.Item())
+		data, err := json.Marshal(match)
+		if err != nil {
+			return 0, errors.Wrap(err, "marshalling log search match")
+		}
+		r.buf.Write(data)
+		r.buf.WriteByte('\n')
+	}
+
+	return r.buf.Read(p)
+}
+
+// GET /tasks/{task_id}/logs/search
+type searchTaskLogsHandler struct {
+	tsk *task.Task
+
+	logSearchBaseHandler
+}
+
+func makeSearchTaskLogs() *searchTaskLogsHandler {
+	return &searchTaskLogsHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Search the logs of a task
+//	@Description	Returns the lines of a task's task and test logs that match a regular expression as newline-delimited JSON, one match per line. Searching a display task searches its execution tasks. Logs stored in Cedar Buildlogger are not searched.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/logs/search [get]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id			path		string	true	"Task ID."
+//	@Param			execution		query		int		false	"The 0-based number corresponding to the execution of the task ID. Defaults to the latest execution."
+//	@Param			expression		query		string	true	"Regular expression to search for."
+//	@Param			case_sensitive	query		bool	false	"If set to true, the expression is case sensitive."
+//	@Param			exact_match		query		bool	false	"If set to false, returns the lines that do not match the expression. Defaults to true."
+//	@Param			start			query		string	false	"Start of targeted time interval (inclusive) in RFC3339 format."
+//	@Param			end				query		string	false	"End of targeted time interval (inclusive) in RFC3339 format."
+//	@Param			limit			query		int		false	"The maximum number of matching lines to return. Defaults to 1000 and cannot exceed 10000."
+//	@Success		200				{object}	model.APILogSearchMatch
+func (h *searchTaskLogsHandler) Factory() gimlet.RouteHandler {
+	return &searchTaskLogsHandler{}
+}
+
+func (h *searchTaskLogsHandler) Parse(ctx context.Context, r *http.Request) error {
+	var execution *int
+	if execString := r.URL.Query().Get("execution"); execString != "" {
+		exec, err := strconv.Atoi(execString)
+		if err != nil {
+			return errors.Wrap(err, "parsing execution")
+		}
+
+		execution = utility.ToIntPtr(exec)
+	}
+
+	var err error
+	h.tsk, err = task.FindByIdExecution(ctx, gimlet.GetVars(r)["task_id"], execution)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrap(err, "finding task").Error(),
+		}
+	}
+	if h.tsk == nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    "task not found",
+		}
+	}
+
+	return h.parse(r)
+}
+
+func (h *searchTaskLogsHandler) Run(ctx context.Context) gimlet.Responder {
+	tasks := []task.Task{*h.tsk}
+	if h.tsk.DisplayOnly {
+		var err error
+		tasks, err = task.FindByExecutionTasksAndMaxExecution(ctx, h.tsk.ExecutionTasks, h.tsk.Execution)
+		if err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding execution tasks for display task '%s'", h.tsk.Id))
+		}
+	}
+
+	return h.search(ctx, tasks)
+}
+
+// GET /versions/{version_id}/logs/search
+type searchVersionLogsHandler struct {
+	versionID string
+
+	logSearchBaseHandler
+}
+
+func makeSearchVersionLogs() *searchVersionLogsHandler {
+	return &searchVersionLogsHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Search the logs of a version
+//	@Description	Returns the lines of the task and test logs of a version's tasks that match a regular expression as newline-delimited JSON, one match per line. Tasks are searched in order of build variant and display name. Logs stored in Cedar Buildlogger are not searched.
+//	@Tags			versions
+//	@Router			/versions/{version_id}/logs/search [get]
+//	@Security		Api-User || Api-Key
+//	@Param			version_id		path		string	true	"Version ID."
+//	@Param			expression		query		string	true	"Regular expression to search for."
+//	@Param			case_sensitive	query		bool	false	"If set to true, the expression is case sensitive."
+//	@Param			exact_match		query		bool	false	"If set to false, returns the lines that do not match the expression. Defaults to true."
+//	@Param			start			query		string	false	"Start of targeted time interval (inclusive) in RFC3339 format."
+//	@Param			end				query		string	false	"End of targeted time interval (inclusive) in RFC3339 format."
+//	@Param			limit			query		int		false	"The maximum number of matching lines to return. Defaults to 1000 and cannot exceed 10000."
+//	@Success		200				{object}	model.APILogSearchMatch
+func (h *searchVersionLogsHandler) Factory() gimlet.RouteHandler {
+	return &searchVersionLogsHandler{}
+}
+
+func (h *searchVersionLogsHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.versionID = gimlet.GetVars(r)["version_id"]
+
+	return h.parse(r)
+}
+
+func (h *searchVersionLogsHandler) Run(ctx context.Context) gimlet.Responder {
+	v, err := dbModel.VersionFindOneId(ctx, h.versionID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding version '%s'", h.versionID))
+	}
+	if v == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("version '%s' not found", h.versionID),
+		})
+	}
+
+	tasks, err := task.FindLogSearchTasksByVersion(ctx, h.versionID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding tasks for version '%s'", h.versionID))
+	}
+
+	return h.search(ctx, tasks)
+}
+
+// GET /projects/{project_id}/logs/search
+type searchProjectLogsHandler struct {
+	projectID    string
+	buildVariant string
+	days         int
+
+	logSearchBaseHandler
+}
+
+func makeSearchProjectLogs() *searchProjectLogsHandler {
+	return &searchProjectLogsHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Search the logs of a build variant
+//	@Description	Returns the lines of the task and test logs of a build variant's recent mainline tasks that match a regular expression as newline-delimited JSON, one match per line. Tasks are searched newest first, and at most 1000 tasks are searched. Logs stored in Cedar Buildlogger are not searched.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/logs/search [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id		path		string	true	"Project ID or identifier."
+//	@Param			variant			query		string	true	"Build variant to search."
+//	@Param			days			query		int		false	"The number of days of tasks to search. Defaults to 1 and cannot exceed 30."
+//	@Param			expression		query		string	true	"Regular expression to search for."
+//	@Param			case_sensitive	query		bool	false	"If set to true, the expression is case sensitive."
+//	@Param			exact_match		query		bool	false	"If set to false, returns the lines that do not match the expression. Defaults to true."
+//	@Param			start			query		string	false	"Start of targeted time interval (inclusive) in RFC3339 format."
+//	@Param			end				query		string	false	"End of targeted time interval (inclusive) in RFC3339 format."
+//	@Param			limit			query		int		false	"The maximum number of matching lines to return. Defaults to 1000 and cannot exceed 10000."
+//	@Success		200				{object}	model.APILogSearchMatch
+func (h *searchProjectLogsHandler) Factory() gimlet.RouteHandler {
+	return &searchProjectLogsHandler{}
+}
+
+func (h *searchProjectLogsHandler) Parse(ctx context.Context, r *http.Request) error {
+	vals := r.URL.Query()
+
+	var err error
+	h.projectID, err = dbModel.GetIdForProject(ctx, gimlet.GetVars(r)["project_id"])
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrap(err, "getting ID for project").Error(),
+		}
+	}
+
+	if h.buildVariant = vals.Get("variant"); h.buildVariant == "" {
+		return errors.New("must specify a build variant")
+	}
+
+	h.days = 1
+	if days := vals.Get("days"); days != "" {
+		h.days, err = strconv.Atoi(days)
+		if err != nil {
+			return errors.Wrap(err, "parsing days")
+		}
+		if h.days <= 0 || h.days > maxLogSearchDays {
+			return errors.Errorf("days must be between 1 and %d", maxLogSearchDays)
+		}
+	}
+
+	return h.parse(r)
+}
+
+func (h *searchProjectLogsHandler) Run(ctx context.Context) gimlet.Responder {
+	since := time.Now().AddDate(0, 0, -h.days)
+	tasks, err := task.FindLogSearchTasksByVariant(ctx, h.projectID, h.buildVariant, since)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding tasks for build variant '%s'", h.buildVariant))
+	}
+
+	return h.search(ctx, tasks)
+}
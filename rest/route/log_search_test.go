@@ -0,0 +1,162 @@
+package route
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/parsley"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSearchBaseHandlerParse(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		urlQuery string
+		expected taskoutput.LogSearchOptions
+		hasErr   bool
+	}{
+		{
+			name:   "MissingExpression",
+			hasErr: true,
+		},
+		{
+			name:     "InvalidExpression",
+			urlQuery: "expression=(",
+			hasErr:   true,
+		},
+		{
+			name:     "InvalidStart",
+			urlQuery: "expression=error&start=11-16-2023",
+			hasErr:   true,
+		},
+		{
+			name:     "InvalidLimit",
+			urlQuery: "expression=error&limit=NaN",
+			hasErr:   true,
+		},
+		{
+			name:     "LimitTooLarge",
+			urlQuery: fmt.Sprintf("expression=error&limit=%d", maxLogSearchLimit+1),
+			hasErr:   true,
+		},
+		{
+			name:     "DefaultParameters",
+			urlQuery: "expression=error",
+			expected: taskoutput.LogSearchOptions{
+				Filter: parsley.Filter{Expression: "error", ExactMatch: true},
+				Limit:  defaultLogSearchLimit,
+			},
+		},
+		{
+			name:     "ValidParameters",
+			urlQuery: "expression=error&case_sensitive=true&exact_match=false&start=2023-11-16T07:20:50.00Z&end=2023-11-16T08:20:50Z&limit=10",
+			expected: taskoutput.LogSearchOptions{
+				Filter: parsley.Filter{Expression: "error", CaseSensitive: true},
+				Start:  utility.ToInt64Ptr(time.Date(2023, time.November, 16, 7, 20, 50, 0, time.UTC).UnixNano()),
+				End:    utility.ToInt64Ptr(time.Date(2023, time.November, 16, 8, 20, 50, 0, time.UTC).UnixNano()),
+				Limit:  10,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			url, err := url.Parse(fmt.Sprintf("https://evergreen.mongodb.com/rest/v2/tasks/task/logs/search?%s", test.urlQuery))
+			require.NoError(t, err)
+			req := &http.Request{Method: "GET"}
+			req.URL = url
+
+			rh := &logSearchBaseHandler{}
+			err = rh.parse(req)
+			if test.hasErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, rh.opts)
+			}
+		})
+	}
+}
+
+func TestSearchTaskLogsHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := evergreen.GetEnvironment()
+
+	require.NoError(t, env.DB().Drop(ctx))
+	defer func() {
+		assert.NoError(t, env.DB().Drop(ctx))
+	}()
+
+	bucketConfig := evergreen.BucketConfig{Type: evergreen.BucketTypeFilesystem, Name: t.TempDir()}
+	output := &taskoutput.TaskOutput{
+		TaskLogs: taskoutput.TaskLogOutput{Version: 1, BucketConfig: bucketConfig},
+		TestLogs: taskoutput.TestLogOutput{Version: 1, BucketConfig: bucketConfig},
+	}
+	execTask := &task.Task{Id: "exec_task", Project: "project", Status: evergreen.TaskFailed, TaskOutputInfo: output}
+	_, err := env.DB().Collection(task.Collection).InsertOne(ctx, execTask)
+	require.NoError(t, err)
+	displayTask := &task.Task{Id: "display_task", DisplayOnly: true, ExecutionTasks: []string{execTask.Id}}
+	_, err = env.DB().Collection(task.Collection).InsertOne(ctx, displayTask)
+	require.NoError(t, err)
+
+	ts := time.Now()
+	require.NoError(t, output.TaskLogs.Append(ctx, taskoutput.TaskOptions{ProjectID: execTask.Project, TaskID: execTask.Id}, taskoutput.TaskLogTypeTask, []log.LogLine{
+		{Priority: level.Info, Timestamp: ts.UnixNano(), Data: "running tests"},
+		{Priority: level.Error, Timestamp: ts.UnixNano(), Data: "segfault in test"},
+	}))
+	require.NoError(t, output.TestLogs.Append(ctx, taskoutput.TaskOptions{ProjectID: execTask.Project, TaskID: execTask.Id}, "test.log", []log.LogLine{
+		{Priority: level.Info, Timestamp: ts.UnixNano(), Data: "segfault"},
+	}))
+
+	for _, taskID := range []string{execTask.Id, displayTask.Id} {
+		t.Run(taskID, func(t *testing.T) {
+			url, err := url.Parse(fmt.Sprintf("https://evergreen.mongodb.com/rest/v2/tasks/%s/logs/search?expression=SEGFAULT", taskID))
+			require.NoError(t, err)
+			req := &http.Request{Method: "GET"}
+			req.URL = url
+			req = gimlet.SetURLVars(req, map[string]string{"task_id": taskID})
+
+			rh := makeSearchTaskLogs().Factory()
+			require.NoError(t, rh.Parse(ctx, req))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			r, ok := resp.Data().(io.Reader)
+			require.True(t, ok)
+			var matches []model.APILogSearchMatch
+			scanner := bufio.NewScanner(r)
+			for scanner.Scan() {
+				var match model.APILogSearchMatch
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &match))
+				matches = append(matches, match)
+			}
+			require.NoError(t, scanner.Err())
+
+			require.Len(t, matches, 2)
+			assert.Equal(t, execTask.Id, utility.FromStringPtr(matches[0].TaskID))
+			assert.Equal(t, string(taskoutput.TaskLogTypeTask), utility.FromStringPtr(matches[0].LogType))
+			assert.Nil(t, matches[0].TestLogPath)
+			assert.Equal(t, 1, matches[0].LineNumber)
+			assert.Equal(t, "segfault in test", utility.FromStringPtr(matches[0].Message))
+			assert.Equal(t, "E", utility.FromStringPtr(matches[0].Severity))
+			assert.Nil(t, matches[1].LogType)
+			assert.Equal(t, "test.log", utility.FromStringPtr(matches[1].TestLogPath))
+			assert.Equal(t, 0, matches[1].LineNumber)
+		})
+	}
+}
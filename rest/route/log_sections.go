@@ -0,0 +1,87 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// GET /tasks/{task_id}/logs/sections
+type getTaskLogSectionsHandler struct {
+	tsk *task.Task
+}
+
+func makeGetTaskLogSections() *getTaskLogSectionsHandler {
+	return &getTaskLogSectionsHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get the sections of a task's logs
+//	@Description	Returns the sections of a task's logs as trees. The agent records a section for every block, function and command that runs, and tasks can mark their own sections with the agent's local task API. Each section has the time range of the log lines it contains, its duration and its status. Logs stored in Cedar Buildlogger do not have sections.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/logs/sections [get]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id		path	string	true	"Task ID."
+//	@Param			execution	query	int		false	"The 0-based number corresponding to the execution of the task ID. Defaults to the latest execution."
+//	@Success		200			{array}	model.APILogSection
+func (h *getTaskLogSectionsHandler) Factory() gimlet.RouteHandler {
+	return &getTaskLogSectionsHandler{}
+}
+
+func (h *getTaskLogSectionsHandler) Parse(ctx context.Context, r *http.Request) error {
+	var execution *int
+	if execString := r.URL.Query().Get("execution"); execString != "" {
+		exec, err := strconv.Atoi(execString)
+		if err != nil {
+			return errors.Wrap(err, "parsing execution")
+		}
+
+		execution = utility.ToIntPtr(exec)
+	}
+
+	var err error
+	h.tsk, err = task.FindByIdExecution(ctx, gimlet.GetVars(r)["task_id"], execution)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrap(err, "finding task").Error(),
+		}
+	}
+	if h.tsk == nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    "task not found",
+		}
+	}
+	if h.tsk.DisplayOnly {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "display tasks do not have logs",
+		}
+	}
+
+	return nil
+}
+
+func (h *getTaskLogSectionsHandler) Run(ctx context.Context) gimlet.Responder {
+	roots, err := h.tsk.GetLogSections(ctx)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting log sections for task '%s'", h.tsk.Id))
+	}
+
+	sections := []model.APILogSection{}
+	for _, root := range roots {
+		section := model.APILogSection{}
+		section.BuildFromService(*root)
+		sections = append(sections, section)
+	}
+
+	return gimlet.NewJSONResponse(sections)
+}
@@ -0,0 +1,87 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTaskLogSectionsHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := evergreen.GetEnvironment()
+
+	require.NoError(t, env.DB().Drop(ctx))
+	defer func() {
+		assert.NoError(t, env.DB().Drop(ctx))
+	}()
+
+	bucketConfig := evergreen.BucketConfig{Type: evergreen.BucketTypeFilesystem, Name: t.TempDir()}
+	output := &taskoutput.TaskOutput{
+		TaskLogs: taskoutput.TaskLogOutput{Version: 1, BucketConfig: bucketConfig},
+	}
+	tsk := &task.Task{Id: "task", Project: "project", Status: evergreen.TaskFailed, TaskOutputInfo: output}
+	_, err := env.DB().Collection(task.Collection).InsertOne(ctx, tsk)
+	require.NoError(t, err)
+	displayTask := &task.Task{Id: "display_task", DisplayOnly: true, ExecutionTasks: []string{tsk.Id}}
+	_, err = env.DB().Collection(task.Collection).InsertOne(ctx, displayTask)
+	require.NoError(t, err)
+
+	ts := time.Now()
+	require.NoError(t, output.TaskLogs.AppendSections(ctx, taskoutput.TaskOptions{ProjectID: tsk.Project, TaskID: tsk.Id}, 0, []log.Section{
+		{ID: "main", Name: "main", Type: log.SectionTypeBlock, Status: log.SectionStatusFailed, Start: ts.UnixNano(), End: ts.Add(2 * time.Second).UnixNano()},
+		{ID: "cmd", ParentID: "main", Name: "'shell.exec'", Type: log.SectionTypeCommand, Status: log.SectionStatusFailed, Start: ts.UnixNano(), End: ts.Add(time.Second).UnixNano()},
+		{ID: "user", ParentID: "cmd", Name: "compile", Type: log.SectionTypeUser, Status: log.SectionStatusRunning, Start: ts.UnixNano()},
+	}))
+
+	makeRequest := func(taskID string) *http.Request {
+		url, err := url.Parse(fmt.Sprintf("https://evergreen.mongodb.com/rest/v2/tasks/%s/logs/sections", taskID))
+		require.NoError(t, err)
+		req := &http.Request{Method: "GET"}
+		req.URL = url
+		return gimlet.SetURLVars(req, map[string]string{"task_id": taskID})
+	}
+
+	t.Run("ReturnsSectionTree", func(t *testing.T) {
+		rh := makeGetTaskLogSections().Factory()
+		require.NoError(t, rh.Parse(ctx, makeRequest(tsk.Id)))
+		resp := rh.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		sections, ok := resp.Data().([]model.APILogSection)
+		require.True(t, ok)
+		require.Len(t, sections, 1)
+		assert.Equal(t, "main", utility.FromStringPtr(sections[0].Name))
+		assert.Equal(t, string(log.SectionTypeBlock), utility.FromStringPtr(sections[0].Type))
+		assert.Equal(t, 2*time.Second, sections[0].Duration.ToDuration())
+		require.Len(t, sections[0].Children, 1)
+		cmd := sections[0].Children[0]
+		assert.Equal(t, string(log.SectionStatusFailed), utility.FromStringPtr(cmd.Status))
+		require.Len(t, cmd.Children, 1)
+		assert.Equal(t, "compile", utility.FromStringPtr(cmd.Children[0].Name))
+		assert.Nil(t, cmd.Children[0].End)
+		assert.Zero(t, cmd.Children[0].Duration)
+		assert.Empty(t, cmd.Children[0].Children)
+	})
+	t.Run("FailsForDisplayTask", func(t *testing.T) {
+		rh := makeGetTaskLogSections().Factory()
+		assert.Error(t, rh.Parse(ctx, makeRequest(displayTask.Id)))
+	})
+	t.Run("FailsForNonexistentTask", func(t *testing.T) {
+		rh := makeGetTaskLogSections().Factory()
+		assert.Error(t, rh.Parse(ctx, makeRequest("nonexistent")))
+	})
+}
@@ -95,6 +95,12 @@ func (p *patchChangeStatusHandler) Run(ctx context.Context) gimlet.Responder {
 		ctx, cancel := p.env.Context()
 		defer cancel()
 		if err := data.SetPatchActivated(ctx, p.patchId, user.Username(), *p.Activated, p.env.Settings()); err != nil {
+			if dbModel.IsCostLimitExceeded(err) {
+				return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+					StatusCode: http.StatusForbidden,
+					Message:    errors.Wrap(err, "setting patch activation").Error(),
+				})
+			}
 			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "setting patch activation"))
 		}
 	}
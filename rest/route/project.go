@@ -337,6 +337,7 @@ func (h *projectIDPatchHandler) Parse(ctx context.Context, r *http.Request) erro
 		return errors.Wrap(err, "converting new project to service model")
 	}
 	newProjectRef.RepoRefId = oldProject.RepoRefId // this can't be modified by users
+	newProjectRef.GitLab.Unredact(oldProject.GitLab)
 
 	h.newProjectRef = newProjectRef
 	h.originalProject = oldProject
@@ -360,6 +361,9 @@ func (h *projectIDPatchHandler) Run(ctx context.Context) gimlet.Responder {
 	if err := h.newProjectRef.ValidateEnabledRepotracker(); err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "validating project repotracker"))
 	}
+	if err := h.newProjectRef.ValidateRepositoryHosting(); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "validating repository hosting settings"))
+	}
 
 	before, err := dbModel.GetProjectSettings(ctx, h.newProjectRef)
 	if err != nil {
@@ -7,8 +7,12 @@ import (
 	"net/http"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testselection"
 	"github.com/evergreen-ci/gimlet"
-	testselection "github.com/evergreen-ci/test-selection-client"
+	tss "github.com/evergreen-ci/test-selection-client"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
@@ -36,6 +40,13 @@ type SelectTestsRequest struct {
 	TaskName string `json:"task_name"`
 	// Tests is a list of test names.
 	Tests []string `json:"tests"`
+	// Mode is how the built-in test selector selects tests when no external
+	// test selection service is configured. If "prioritize" or empty, all of
+	// the tests are returned, ordered by how likely they are to fail given
+	// the files changed by the task's patch. If "exclusive", only the tests
+	// likely to fail are returned, or all of the tests if none are likely to
+	// fail.
+	Mode string `json:"mode,omitempty"`
 }
 
 func makeSelectTestsHandler(env evergreen.Environment) gimlet.RouteHandler {
@@ -45,7 +56,7 @@ func makeSelectTestsHandler(env evergreen.Environment) gimlet.RouteHandler {
 // Factory creates an instance of the handler.
 //
 //	@Summary		Select tests
-//	@Description	Return a subset of tests to run for a given task. This endpoint is not yet ready. Please do not use it.
+//	@Description	Return a subset of tests to run for a given task. If no external test selection service is configured, tests are ranked by how often they have previously failed in the task when the files changed by the task's patch were changed.
 //	@Tags			select
 //	@Router			/select/tests [post]
 //	@Param			{object}	body	SelectTestsRequest	true	"Select tests request"
@@ -72,27 +83,28 @@ func (t *selectTestsHandler) Parse(ctx context.Context, r *http.Request) error {
 	catcher.NewWhen(t.selectTests.TaskID == "", "task ID is required")
 	catcher.NewWhen(t.selectTests.TaskName == "", "task name is required")
 	catcher.NewWhen(len(t.selectTests.Tests) == 0, "tests array must not be empty")
+	catcher.Add(testselection.ValidateMode(t.selectTests.Mode))
 	return catcher.Resolve()
 }
 
 func (t *selectTestsHandler) Run(ctx context.Context) gimlet.Responder {
 	tssBaseURL := t.env.Settings().TestSelection.URL
 	if tssBaseURL == "" {
-		return gimlet.NewJSONResponse(t.selectTests)
+		return t.selectTestsBuiltIn(ctx)
 	}
 
 	httpClient := utility.GetHTTPClient()
 	defer utility.PutHTTPClient(httpClient)
-	conf := testselection.NewConfiguration()
+	conf := tss.NewConfiguration()
 	conf.HTTPClient = httpClient
-	conf.Servers = testselection.ServerConfigurations{
-		testselection.ServerConfiguration{
+	conf.Servers = tss.ServerConfigurations{
+		tss.ServerConfiguration{
 			URL:         tssBaseURL,
 			Description: "Test selection service",
 		},
 	}
-	c := testselection.NewAPIClient(conf)
-	reqBody := testselection.BodySelectTestsApiTestSelectionSelectTestsProjectIdRequesterBuildVariantNameTaskIdTaskNamePost{
+	c := tss.NewAPIClient(conf)
+	reqBody := tss.BodySelectTestsApiTestSelectionSelectTestsProjectIdRequesterBuildVariantNameTaskIdTaskNamePost{
 		TestNames: t.selectTests.Tests,
 	}
 	selectedTests, resp, err := c.TestSelectionAPI.SelectTestsApiTestSelectionSelectTestsProjectIdRequesterBuildVariantNameTaskIdTaskNamePost(ctx, t.selectTests.Project, t.selectTests.Requester, t.selectTests.BuildVariant, t.selectTests.TaskID, t.selectTests.TaskName).
@@ -109,3 +121,48 @@ func (t *selectTestsHandler) Run(ctx context.Context) gimlet.Responder {
 	rhResp.Tests = selectedTests
 	return gimlet.NewJSONResponse(rhResp)
 }
+
+// selectTestsBuiltIn selects tests using Evergreen's built-in test selector,
+// which ranks the tests by how often they have failed in the task when the
+// files changed by the task's patch were changed. Tests are returned unchanged
+// if the task is not part of a patch or its project does not have test
+// selection enabled.
+func (t *selectTestsHandler) selectTestsBuiltIn(ctx context.Context) gimlet.Responder {
+	tsk, err := task.FindOneId(ctx, t.selectTests.TaskID)
+	if err != nil {
+		return gimlet.NewJSONInternalErrorResponse(errors.Wrapf(err, "finding task '%s'", t.selectTests.TaskID))
+	}
+	if tsk == nil || !evergreen.IsPatchRequester(tsk.Requester) {
+		return gimlet.NewJSONResponse(t.selectTests)
+	}
+
+	pRef, err := model.FindMergedProjectRef(ctx, tsk.Project, tsk.Version, false)
+	if err != nil {
+		return gimlet.NewJSONInternalErrorResponse(errors.Wrapf(err, "finding project '%s'", tsk.Project))
+	}
+	if pRef == nil || !pRef.IsTestSelectionEnabled() {
+		return gimlet.NewJSONResponse(t.selectTests)
+	}
+
+	p, err := patch.FindOne(ctx, patch.ByVersion(tsk.Version).Project(patch.ExcludePatchDiff))
+	if err != nil {
+		return gimlet.NewJSONInternalErrorResponse(errors.Wrapf(err, "finding patch for version '%s'", tsk.Version))
+	}
+	if p == nil {
+		return gimlet.NewJSONResponse(t.selectTests)
+	}
+
+	scores, err := testselection.Rank(ctx, t.env, testselection.RankOptions{
+		ProjectID:    tsk.Project,
+		TaskName:     tsk.DisplayName,
+		ChangedFiles: p.FilesChanged(),
+		Tests:        t.selectTests.Tests,
+	})
+	if err != nil {
+		return gimlet.NewJSONInternalErrorResponse(errors.Wrapf(err, "ranking tests for task '%s'", tsk.Id))
+	}
+
+	rhResp := t.selectTests
+	rhResp.Tests = testselection.SelectTests(scores, t.selectTests.Mode)
+	return gimlet.NewJSONResponse(rhResp)
+}
@@ -3,10 +3,22 @@ package route
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testselection"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -108,4 +120,97 @@ func TestSelectTestsHandler(t *testing.T) {
 	req, _ = http.NewRequest(http.MethodPost, "/select/tests", bytes.NewBuffer(j))
 	sth = makeSelectTestsHandler(env)
 	require.Error(t, sth.Parse(ctx, req), "request should fail to parse when tests are empty")
+
+	j = []byte(`{
+		"project": "my-project",
+		"requester": "patch",
+		"build_variant": "variant",
+		"task_id": "my-task-1234",
+		"task_name": "my-task",
+		"tests": ["test1", "test2", "test3"],
+		"mode": "invalid"
+	}`)
+	req, _ = http.NewRequest(http.MethodPost, "/select/tests", bytes.NewBuffer(j))
+	sth = makeSelectTestsHandler(env)
+	require.Error(t, sth.Parse(ctx, req), "request should fail to parse when mode is invalid")
+}
+
+func TestSelectTestsHandlerBuiltIn(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(model.ProjectRefCollection, task.Collection, patch.Collection, testselection.FileStatsCollection, testselection.TestFailuresCollection))
+	}
+	clearAll()
+	defer clearAll()
+
+	pRef := model.ProjectRef{
+		Id:                   "project_id",
+		Identifier:           "project",
+		TestSelectionEnabled: utility.TruePtr(),
+	}
+	require.NoError(t, pRef.Insert())
+	p := patch.Patch{
+		Id:      mgobson.NewObjectId(),
+		Project: "project_id",
+		Patches: []patch.ModulePatch{{PatchSet: patch.PatchSet{Summary: []thirdparty.Summary{{Name: "a.go"}}}}},
+	}
+	p.Version = p.Id.Hex()
+	require.NoError(t, p.Insert())
+	tsk := task.Task{
+		Id:          "task_id",
+		Project:     "project_id",
+		DisplayName: "task",
+		Version:     p.Version,
+		Requester:   evergreen.PatchVersionRequester,
+	}
+	require.NoError(t, tsk.Insert())
+	require.NoError(t, testselection.RecordTaskRun(ctx, env, testselection.TaskRun{
+		ProjectID:    "project_id",
+		TaskName:     "task",
+		ChangedFiles: []string{"a.go"},
+		FailedTests:  []string{"test3"},
+	}))
+
+	selectTests := func(t *testing.T, mode string) []string {
+		body, err := json.Marshal(SelectTestsRequest{
+			Project:      "project",
+			Requester:    evergreen.PatchVersionRequester,
+			BuildVariant: "variant",
+			TaskID:       "task_id",
+			TaskName:     "task",
+			Tests:        []string{"test1", "test2", "test3"},
+			Mode:         mode,
+		})
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPost, "/select/tests", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		sth := makeSelectTestsHandler(env)
+		require.NoError(t, sth.Parse(ctx, req))
+
+		resp := sth.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+		selectRequest, ok := resp.Data().(SelectTestsRequest)
+		require.True(t, ok)
+		return selectRequest.Tests
+	}
+
+	for mode, expected := range map[string][]string{
+		"":                           {"test3", "test1", "test2"},
+		testselection.ModePrioritize: {"test3", "test1", "test2"},
+		testselection.ModeExclusive:  {"test3"},
+	} {
+		t.Run("Mode"+mode, func(t *testing.T) {
+			assert.Equal(t, expected, selectTests(t, mode))
+		})
+	}
+
+	t.Run("ReturnsTestsUnchangedWhenTestSelectionIsDisabled", func(t *testing.T) {
+		pRef.TestSelectionEnabled = utility.FalsePtr()
+		require.NoError(t, pRef.Upsert())
+
+		assert.Equal(t, []string{"test1", "test2", "test3"}, selectTests(t, testselection.ModeExclusive))
+	})
 }
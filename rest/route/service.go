@@ -117,6 +117,12 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/task/{task_id}/check_run").Version(2).Post().Wrap(requireTask).RouteHandler(makeCheckRun(settings))
 	app.AddRoute("/task/{task_id}/aws/assume_role").Version(2).Post().Wrap(requireTask).RouteHandler(makeAWSAssumeRole(stsManager))
 	app.AddRoute("/task/{task_id}/aws/s3_credentials").Version(2).Post().Wrap(requireTask).RouteHandler(makeAWSS3Credentials(env, stsManager, awsRoleARN))
+	app.AddRoute("/task/{task_id}/build_cache/restore").Version(2).Post().Wrap(requireTask).RouteHandler(makeBuildCacheRestore())
+	app.AddRoute("/task/{task_id}/build_cache/save").Version(2).Post().Wrap(requireTask).RouteHandler(makeBuildCacheSave())
+	app.AddRoute("/task/{task_id}/command_profile").Version(2).Post().Wrap(requireTask).RouteHandler(makeCommandProfileAdd())
+	app.AddRoute("/task/{task_id}/checkpoint").Version(2).Get().Wrap(requireTask).RouteHandler(makeCheckpointGet())
+	app.AddRoute("/task/{task_id}/checkpoint").Version(2).Post().Wrap(requireTask).RouteHandler(makeCheckpointSave())
+	app.AddRoute("/task/{task_id}/checkpoint/resume").Version(2).Post().Wrap(requireTask).RouteHandler(makeCheckpointResume())
 
 	// REST v2 API Routes
 	app.AddRoute("/").Version(2).Get().Wrap(requireUser).RouteHandler(makePlaceHolder())
@@ -155,6 +161,7 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/distros/{distro_id}/copy/{new_distro_id}").Version(2).Put().Wrap(requireUser, editDistroSettings).RouteHandler(makeCopyDistro())
 
 	app.AddRoute("/hooks/github").Version(2).Post().Wrap(requireValidGithubPayload).RouteHandler(makeGithubHooksRoute(sc, opts.APIQueue, opts.GithubSecret, settings))
+	app.AddRoute("/hooks/gitlab").Version(2).Post().RouteHandler(makeGitLabHooksRoute(opts.APIQueue))
 	app.AddRoute("/hooks/aws").Version(2).Post().Wrap(requireValidSNSPayload).RouteHandler(makeEC2SNS(env, opts.APIQueue))
 	app.AddRoute("/hooks/aws/ecs").Version(2).Post().Wrap(requireValidSNSPayload).RouteHandler(makeECSSNS(env, opts.APIQueue))
 
@@ -205,6 +212,7 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/projects/{project_id}/copy").Version(2).Post().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makeCopyProject(env))
 	app.AddRoute("/projects/{project_id}/copy/variables").Version(2).Post().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makeCopyVariables())
 	app.AddRoute("/projects/{project_id}/events").Version(2).Get().Wrap(requireUser, addProject, requireProjectAdmin, viewProjectSettings).RouteHandler(makeFetchProjectEvents(opts.URL))
+	app.AddRoute("/projects/{project_id}/logs/search").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeSearchProjectLogs())
 	app.AddRoute("/projects/{project_id}/patches").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makePatchesByProjectRoute(opts.URL))
 	app.AddRoute("/projects/{project_id}/recent_versions").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeFetchProjectVersionsLegacy())
 	app.AddRoute("/projects/{project_id}/revisions/{commit_hash}/tasks").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeTasksByProjectAndCommitHandler(parsleyURL, opts.URL))
@@ -212,6 +220,13 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/projects/{project_id}/task_stats").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectTaskStats(opts.URL))
 	app.AddRoute("/projects/{project_id}/versions").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectVersionsHandler(opts.URL))
 	app.AddRoute("/projects/{project_id}/versions").Version(2).Patch().Wrap(requireUser, requireProjectAdmin).RouteHandler(makeModifyProjectVersionsHandler(opts.URL))
+	app.AddRoute("/projects/{project_id}/test_quarantine").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeGetTestQuarantine(env))
+	app.AddRoute("/projects/{project_id}/test_quarantine").Version(2).Patch().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makePatchTestQuarantine(env))
+	app.AddRoute("/projects/{project_id}/cost").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeGetProjectCost(env))
+	app.AddRoute("/projects/{project_id}/cost_budget").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeGetProjectCostBudget(env))
+	app.AddRoute("/projects/{project_id}/cost_budget").Version(2).Put().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makePutProjectCostBudget(env))
+	app.AddRoute("/projects/{project_id}/artifact_retention").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeGetProjectArtifactRetention(env))
+	app.AddRoute("/projects/{project_id}/artifact_retention").Version(2).Put().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makePutProjectArtifactRetention(env))
 	app.AddRoute("/projects/{project_id}/tasks/{task_name}").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectTasksHandler(opts.URL))
 	app.AddRoute("/projects/{project_id}/task_executions").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectTaskExecutionsHandler())
 	app.AddRoute("/projects/{project_id}/patch_trigger_aliases").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeFetchPatchTriggerAliases())
@@ -236,6 +251,8 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/tasks/{task_id}/annotation").Version(2).Patch().Wrap(requireUser, editAnnotations).RouteHandler(makePatchAnnotationsByTask())
 	app.AddRoute("/tasks/{task_id}/created_ticket").Version(2).Put().Wrap(requireUser, editAnnotations).RouteHandler(makeCreatedTicketByTask())
 	app.AddRoute("/tasks/{task_id}/abort").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makeTaskAbortHandler())
+	app.AddRoute("/tasks/{task_id}/find_culprit").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetTaskCulpritSearches())
+	app.AddRoute("/tasks/{task_id}/find_culprit").Version(2).Post().Wrap(requireUser, addProject, editTasks).RouteHandler(makeTaskFindCulpritHandler(env))
 	app.AddRoute("/tasks/{task_id}/manifest").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetManifestHandler())
 	app.AddRoute("/tasks/{task_id}/restart").Version(2).Post().Wrap(requireUser, addProject, editTasks).RouteHandler(makeTaskRestartHandler())
 	app.AddRoute("/tasks/{task_id}/tests").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeFetchTestsForTask(env, sc))
@@ -243,10 +260,13 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/tasks/{task_id}/generated_tasks").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetGeneratedTasks())
 	app.AddRoute("/tasks/{task_id}/build/TaskLogs").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeGetTaskLogs(opts.URL))
 	app.AddRoute("/tasks/{task_id}/build/TestLogs/{path}").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeGetTestLogs(opts.URL))
+	app.AddRoute("/tasks/{task_id}/logs/sections").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetTaskLogSections())
+	app.AddRoute("/tasks/{task_id}/logs/search").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeSearchTaskLogs())
 	app.AddRoute("/tasks/{task_id}/github_dynamic_access_tokens").Version(2).Delete().Wrap(requireUser, viewTasks).RouteHandler(makeDeleteGitHubDynamicAccessTokens())
 	app.AddRoute("/user/settings").Version(2).Get().Wrap(requireUser).RouteHandler(makeFetchUserConfig())
 	app.AddRoute("/user/settings").Version(2).Post().Wrap(requireUser).RouteHandler(makeSetUserConfig())
 	app.AddRoute("/users/{user_id}").Version(2).Get().Wrap(requireUser).RouteHandler(makeGetUserHandler())
+	app.AddRoute("/users/{user_id}/cost").Version(2).Get().Wrap(requireUser).RouteHandler(makeGetUserCost(env))
 	app.AddRoute("/users/{user_id}/hosts").Version(2).Get().Wrap(requireUser).RouteHandler(makeFetchHosts(opts.URL))
 	app.AddRoute("/users/{user_id}/patches").Version(2).Get().Wrap(requireUser).RouteHandler(makeUserPatchHandler(opts.URL))
 	app.AddRoute("/users/offboard_user").Version(2).Post().Wrap(requireUser, editRoles).RouteHandler(makeOffboardUser(env))
@@ -259,6 +279,7 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/versions/{version_id}").Version(2).Patch().Wrap(requireUser, editTasks).RouteHandler(makePatchVersion())
 	app.AddRoute("/versions/{version_id}/abort").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makeAbortVersion())
 	app.AddRoute("/versions/{version_id}/builds").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetVersionBuilds(env))
+	app.AddRoute("/versions/{version_id}/logs/search").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeSearchVersionLogs())
 	app.AddRoute("/versions/{version_id}/restart").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makeRestartVersion())
 	app.AddRoute("/versions/{version_id}/annotations").Version(2).Get().Wrap(requireUser, viewAnnotations).RouteHandler(makeFetchAnnotationsByVersion())
 
@@ -101,6 +101,7 @@ func (tgh *taskGetHandler) Run(ctx context.Context) gimlet.Responder {
 		IncludeProjectIdentifier: true,
 		IncludeAMI:               true,
 		IncludeArtifacts:         true,
+		IncludeCommandProfiles:   true,
 		LogURL:                   tgh.url,
 		ParsleyLogURL:            tgh.parsleyURL,
 	})
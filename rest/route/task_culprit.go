@@ -0,0 +1,131 @@
+package route
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen"
+	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// POST /tasks/{task_id}/find_culprit
+type taskFindCulpritHandler struct {
+	opts model.APICulpritSearchOptions
+
+	task *task.Task
+	env  evergreen.Environment
+}
+
+func makeTaskFindCulpritHandler(env evergreen.Environment) gimlet.RouteHandler {
+	return &taskFindCulpritHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Find the commit that caused a task to fail
+//	@Description	Starts a search for the commit that caused a failing mainline task, or a failing test in it, to start failing. The search activates the minimal set of earlier tasks needed to bisect the commits since the task last passed. Once the first failing commit is found, it's added to the task's annotation as a suspected issue and subscribers to the task's culprit-found trigger are notified. If a search is already running for the task and test, that search is returned.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/find_culprit [post]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id		path		string							true	"task ID"
+//	@Param			{object}	body		model.APICulpritSearchOptions	false	"parameters"
+//	@Success		200			{object}	model.APICulpritSearch
+func (h *taskFindCulpritHandler) Factory() gimlet.RouteHandler {
+	return &taskFindCulpritHandler{env: h.env}
+}
+
+func (h *taskFindCulpritHandler) Parse(ctx context.Context, r *http.Request) error {
+	projCtx := MustHaveProjectContext(ctx)
+	if projCtx.Task == nil {
+		return gimlet.ErrorResponse{
+			Message:    "task not found",
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	h.task = projCtx.Task
+
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return errors.Wrap(err, "reading body")
+	}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &h.opts); err != nil {
+			return errors.Wrap(err, "parsing culprit search options from JSON request body")
+		}
+	}
+
+	return nil
+}
+
+func (h *taskFindCulpritHandler) Run(ctx context.Context) gimlet.Responder {
+	search, err := serviceModel.StartCulpritSearch(ctx, h.env, h.task, h.opts.TestName, MustHaveUser(ctx).Id)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrapf(err, "starting culprit search for task '%s'", h.task.Id).Error(),
+		})
+	}
+	// Activate the first task right away rather than waiting for the next
+	// culprit finder job.
+	grip.Error(message.WrapError(search.Advance(ctx, h.env), message.Fields{
+		"message":   "could not advance culprit search",
+		"search_id": search.ID,
+		"task_id":   h.task.Id,
+	}))
+
+	apiSearch := &model.APICulpritSearch{}
+	apiSearch.BuildFromService(*search)
+
+	return gimlet.NewJSONResponse(apiSearch)
+}
+
+// GET /tasks/{task_id}/find_culprit
+type taskCulpritSearchesGetHandler struct {
+	taskID string
+}
+
+func makeGetTaskCulpritSearches() gimlet.RouteHandler {
+	return &taskCulpritSearchesGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a task's culprit searches
+//	@Description	Returns the searches for the commit that caused the task to fail, newest first.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/find_culprit [get]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id	path	string	true	"task ID"
+//	@Success		200		{array}	model.APICulpritSearch
+func (h *taskCulpritSearchesGetHandler) Factory() gimlet.RouteHandler {
+	return &taskCulpritSearchesGetHandler{}
+}
+
+func (h *taskCulpritSearchesGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.taskID = gimlet.GetVars(r)["task_id"]
+	return nil
+}
+
+func (h *taskCulpritSearchesGetHandler) Run(ctx context.Context) gimlet.Responder {
+	searches, err := serviceModel.FindCulpritSearchesByTask(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	apiSearches := []model.APICulpritSearch{}
+	for _, s := range searches {
+		apiSearch := model.APICulpritSearch{}
+		apiSearch.BuildFromService(s)
+		apiSearches = append(apiSearches, apiSearch)
+	}
+
+	return gimlet.NewJSONResponse(apiSearches)
+}
@@ -29,6 +29,8 @@ type testGetHandler struct {
 	limit      int
 	latest     bool
 
+	excludeQuarantined bool
+
 	task *task.Task
 	env  evergreen.Environment
 	sc   data.Connector
@@ -48,14 +50,15 @@ func makeFetchTestsForTask(env evergreen.Environment, sc data.Connector) gimlet.
 //	@Tags			tests
 //	@Router			/tasks/{task_id}/tests [get]
 //	@Security		Api-User || Api-Key
-//	@Param			task_id		path	string	true	"task ID"
-//	@Param			start_at	query	string	false	"The identifier of the test to start at in the pagination"
-//	@Param			limit		query	int		false	"The number of tests to be returned per page of pagination. Defaults to 100"
-//	@Param			status		query	string	false	"A status of test to limit the results to."
-//	@Param			execution	query	int		false	"The 0-based number corresponding to the execution of the task. Defaults to 0, meaning the first time the task was run."
-//	@Param			test_name	query	string	false	"Only return the test matching the name."
-//	@Param			latest		query	bool	false	"Return tests from the latest execution. Cannot be used with execution."
-//	@Success		200			{array}	model.APITest
+//	@Param			task_id				path	string	true	"task ID"
+//	@Param			start_at			query	string	false	"The identifier of the test to start at in the pagination"
+//	@Param			limit				query	int		false	"The number of tests to be returned per page of pagination. Defaults to 100"
+//	@Param			status				query	string	false	"A status of test to limit the results to."
+//	@Param			execution			query	int		false	"The 0-based number corresponding to the execution of the task. Defaults to 0, meaning the first time the task was run."
+//	@Param			test_name			query	string	false	"Only return the test matching the name."
+//	@Param			latest				query	bool	false	"Return tests from the latest execution. Cannot be used with execution."
+//	@Param			exclude_quarantined	query	bool	false	"Omit the tests quarantined in the task's project."
+//	@Success		200					{array}	model.APITest
 func (hgh *testGetHandler) Factory() gimlet.RouteHandler {
 	return &testGetHandler{
 		env: hgh.env,
@@ -122,6 +125,7 @@ func (tgh *testGetHandler) Parse(ctx context.Context, r *http.Request) error {
 		}
 	}
 	tgh.testName = vals.Get("test_name")
+	tgh.excludeQuarantined = vals.Get("exclude_quarantined") == "true"
 	tgh.limit, err = getLimit(vals)
 	if err != nil {
 		return errors.Wrap(err, "getting limit")
@@ -131,14 +135,24 @@ func (tgh *testGetHandler) Parse(ctx context.Context, r *http.Request) error {
 }
 
 func (tgh *testGetHandler) Run(ctx context.Context) gimlet.Responder {
+	var quarantinedTests []string
+	if tgh.excludeQuarantined {
+		var err error
+		quarantinedTests, err = testresult.FindQuarantinedTestNames(ctx, tgh.env, tgh.task.Project)
+		if err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting quarantined tests"))
+		}
+	}
+
 	results, err := tgh.task.GetTestResults(
 		ctx,
 		tgh.env,
 		&testresult.FilterOptions{
-			TestName: tgh.testName,
-			Statuses: tgh.testStatus,
-			Limit:    tgh.limit,
-			Page:     tgh.key,
+			TestName:         tgh.testName,
+			Statuses:         tgh.testStatus,
+			Limit:            tgh.limit,
+			Page:             tgh.key,
+			ExcludeTestNames: quarantinedTests,
 		},
 	)
 	if err != nil {
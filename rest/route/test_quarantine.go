@@ -0,0 +1,138 @@
+package route
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// getTestQuarantineProjectID returns the ID of the project in the request's
+// URL.
+func getTestQuarantineProjectID(ctx context.Context, r *http.Request) (string, error) {
+	projectID, err := dbModel.GetIdForProject(ctx, gimlet.GetVars(r)["project_id"])
+	if err != nil {
+		return "", gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrap(err, "getting ID for project").Error(),
+		}
+	}
+
+	return projectID, nil
+}
+
+// GET /projects/{project_id}/test_quarantine
+type testQuarantineGetHandler struct {
+	projectID string
+	env       evergreen.Environment
+}
+
+func makeGetTestQuarantine(env evergreen.Environment) gimlet.RouteHandler {
+	return &testQuarantineGetHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a project's quarantined tests
+//	@Description	Returns the tests quarantined in a project. Tests are automatically quarantined when they frequently flip between passing and failing when their task is retried on the same revision, and can also be quarantined manually by project admins. Failures of quarantined tests do not trigger test regression notifications.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/test_quarantine [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string	true	"Project ID or identifier."
+//	@Success		200			{object}	model.APITestQuarantine
+func (h *testQuarantineGetHandler) Factory() gimlet.RouteHandler {
+	return &testQuarantineGetHandler{env: h.env}
+}
+
+func (h *testQuarantineGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	var err error
+	h.projectID, err = getTestQuarantineProjectID(ctx, r)
+	return err
+}
+
+func (h *testQuarantineGetHandler) Run(ctx context.Context) gimlet.Responder {
+	quarantine, err := testresult.FindQuarantine(ctx, h.env, h.projectID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding quarantined tests for project '%s'", h.projectID))
+	}
+
+	apiQuarantine := &model.APITestQuarantine{}
+	apiQuarantine.BuildFromService(*quarantine)
+
+	return gimlet.NewJSONResponse(apiQuarantine)
+}
+
+// PATCH /projects/{project_id}/test_quarantine
+type testQuarantinePatchHandler struct {
+	projectID string
+	update    model.APITestQuarantineUpdate
+	env       evergreen.Environment
+}
+
+func makePatchTestQuarantine(env evergreen.Environment) gimlet.RouteHandler {
+	return &testQuarantinePatchHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Modify a project's quarantined tests
+//	@Description	Quarantines and unquarantines tests in a project. Unquarantined tests are exempt from being automatically quarantined again unless they are explicitly quarantined. Returns the project's updated quarantined tests.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/test_quarantine [patch]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string							true	"Project ID or identifier."
+//	@Param			{object}	body		model.APITestQuarantineUpdate	true	"Tests to quarantine and unquarantine."
+//	@Success		200			{object}	model.APITestQuarantine
+func (h *testQuarantinePatchHandler) Factory() gimlet.RouteHandler {
+	return &testQuarantinePatchHandler{env: h.env}
+}
+
+func (h *testQuarantinePatchHandler) Parse(ctx context.Context, r *http.Request) error {
+	var err error
+	h.projectID, err = getTestQuarantineProjectID(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	if err = utility.ReadJSON(r.Body, &h.update); err != nil {
+		return errors.Wrap(err, "reading test quarantine update from JSON request body")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(len(h.update.Quarantine) == 0 && len(h.update.Unquarantine) == 0, "must specify at least one test to quarantine or unquarantine")
+	for _, testName := range h.update.Quarantine {
+		catcher.NewWhen(testName == "", "cannot quarantine a test with an empty name")
+		catcher.ErrorfWhen(utility.StringSliceContains(h.update.Unquarantine, testName), "cannot both quarantine and unquarantine test '%s'", testName)
+	}
+	for _, testName := range h.update.Unquarantine {
+		catcher.NewWhen(testName == "", "cannot unquarantine a test with an empty name")
+	}
+
+	return catcher.Resolve()
+}
+
+func (h *testQuarantinePatchHandler) Run(ctx context.Context) gimlet.Responder {
+	if err := testresult.QuarantineTests(ctx, h.env, h.projectID, h.update.Quarantine); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+	if err := testresult.UnquarantineTests(ctx, h.env, h.projectID, h.update.Unquarantine); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	quarantine, err := testresult.FindQuarantine(ctx, h.env, h.projectID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding quarantined tests for project '%s'", h.projectID))
+	}
+
+	apiQuarantine := &model.APITestQuarantine{}
+	apiQuarantine.BuildFromService(*quarantine)
+
+	return gimlet.NewJSONResponse(apiQuarantine)
+}
@@ -0,0 +1,120 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/db"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestQuarantineHandlers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(dbModel.ProjectRefCollection, testresult.QuarantineCollection))
+	}
+	clearAll()
+	defer clearAll()
+
+	makeRequest := func(t *testing.T, method string, body any) *http.Request {
+		var data []byte
+		if body != nil {
+			var err error
+			data, err = json.Marshal(body)
+			require.NoError(t, err)
+		}
+		req, err := http.NewRequest(method, "/projects/project_identifier/test_quarantine", bytes.NewBuffer(data))
+		require.NoError(t, err)
+
+		return gimlet.SetURLVars(req, map[string]string{"project_id": "project_identifier"})
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"GetReturnsEmptyQuarantine": func(t *testing.T) {
+			rh := makeGetTestQuarantine(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodGet, nil)))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiQuarantine, ok := resp.Data().(*model.APITestQuarantine)
+			require.True(t, ok)
+			assert.Equal(t, "project_id", utility.FromStringPtr(apiQuarantine.ProjectID))
+			assert.Empty(t, apiQuarantine.QuarantinedTests)
+			assert.Nil(t, apiQuarantine.LastAnalyzed)
+		},
+		"GetReturnsDetectedAndManualTests": func(t *testing.T) {
+			require.NoError(t, testresult.SetDetectedFlakyTests(ctx, env, "project_id", []testresult.TestFlakiness{{TestName: "detected", NumRuns: 2, NumFlakyRuns: 2, Score: 1}}, 0.2, 2))
+			require.NoError(t, testresult.QuarantineTests(ctx, env, "project_id", []string{"manual"}))
+
+			rh := makeGetTestQuarantine(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodGet, nil)))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiQuarantine, ok := resp.Data().(*model.APITestQuarantine)
+			require.True(t, ok)
+			assert.Equal(t, []string{"detected", "manual"}, apiQuarantine.QuarantinedTests)
+			require.Len(t, apiQuarantine.DetectedTests, 1)
+			assert.Equal(t, "detected", utility.FromStringPtr(apiQuarantine.DetectedTests[0].TestName))
+			assert.Equal(t, 1.0, apiQuarantine.DetectedTests[0].Score)
+			assert.Equal(t, []string{"manual"}, apiQuarantine.ManualTests)
+			assert.NotNil(t, apiQuarantine.LastAnalyzed)
+		},
+		"GetFailsForNonexistentProject": func(t *testing.T) {
+			req := gimlet.SetURLVars(makeRequest(t, http.MethodGet, nil), map[string]string{"project_id": "nonexistent"})
+			assert.Error(t, makeGetTestQuarantine(env).Factory().Parse(ctx, req))
+		},
+		"PatchQuarantinesAndUnquarantinesTests": func(t *testing.T) {
+			require.NoError(t, testresult.SetDetectedFlakyTests(ctx, env, "project_id", []testresult.TestFlakiness{{TestName: "detected", NumRuns: 2, NumFlakyRuns: 2, Score: 1}}, 0.2, 2))
+
+			rh := makePatchTestQuarantine(env).Factory()
+			require.NoError(t, rh.Parse(ctx, makeRequest(t, http.MethodPatch, model.APITestQuarantineUpdate{
+				Quarantine:   []string{"manual"},
+				Unquarantine: []string{"detected"},
+			})))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiQuarantine, ok := resp.Data().(*model.APITestQuarantine)
+			require.True(t, ok)
+			assert.Equal(t, []string{"manual"}, apiQuarantine.QuarantinedTests)
+			assert.Equal(t, []string{"detected"}, apiQuarantine.ExemptTests)
+
+			names, err := testresult.FindQuarantinedTestNames(ctx, env, "project_id")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"manual"}, names)
+		},
+		"PatchFailsWithoutTests": func(t *testing.T) {
+			rh := makePatchTestQuarantine(env).Factory()
+			assert.Error(t, rh.Parse(ctx, makeRequest(t, http.MethodPatch, model.APITestQuarantineUpdate{})))
+		},
+		"PatchFailsWithConflictingTests": func(t *testing.T) {
+			rh := makePatchTestQuarantine(env).Factory()
+			assert.Error(t, rh.Parse(ctx, makeRequest(t, http.MethodPatch, model.APITestQuarantineUpdate{
+				Quarantine:   []string{"test"},
+				Unquarantine: []string{"test"},
+			})))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			pRef := dbModel.ProjectRef{Id: "project_id", Identifier: "project_identifier"}
+			require.NoError(t, pRef.Insert())
+
+			tCase(t)
+		})
+	}
+}
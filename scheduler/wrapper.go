@@ -99,6 +99,16 @@ func PlanDistro(ctx context.Context, conf Configuration, s *evergreen.Settings)
 		"duration_secs": time.Since(taskFindingBegins).Seconds(),
 	})
 
+	// Tasks that can reuse the results of a previous task with the same
+	// inputs are finished now rather than being dispatched.
+	tasks, err = model.SkipCachedTasks(ctx, s, tasks)
+	grip.Error(message.WrapError(err, message.Fields{
+		"message":  "could not check task cache for some tasks",
+		"runner":   RunnerName,
+		"distro":   distro.Id,
+		"instance": schedulerInstanceID,
+	}))
+
 	/////////////////
 	// planning phase
 	/////////////////
@@ -412,6 +412,10 @@ func (as *APIServer) existingPatchRequest(w http.ResponseWriter, r *http.Request
 
 		_, err = model.FinalizePatch(ctx, p, evergreen.PatchVersionRequester)
 		if err != nil {
+			if model.IsCostLimitExceeded(err) {
+				as.LoggedError(w, r, http.StatusForbidden, err)
+				return
+			}
 			as.LoggedError(w, r, http.StatusInternalServerError, err)
 			return
 		}
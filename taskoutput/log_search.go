@@ -0,0 +1,186 @@
+package taskoutput
+
+import (
+	"context"
+
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/parsley"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// LogSearchOptions represents the arguments for searching the logs belonging
+// to a task run.
+type LogSearchOptions struct {
+	// Filter is the Parsley filter that log lines must satisfy to match.
+	Filter parsley.Filter
+	// Start is the start time (inclusive) of the time range filter,
+	// represented as a Unix timestamp in nanoseconds. Defaults to
+	// unbounded.
+	Start *int64
+	// End is the end time (inclusive) of the time range filter,
+	// represented as a Unix timestamp in nanoseconds. Defaults to
+	// unbounded.
+	End *int64
+	// Limit limits the number of matching lines returned. Ignored if less
+	// than or equal to 0.
+	Limit int
+}
+
+// Validate checks that the search options are valid.
+func (o LogSearchOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(parsley.ValidateFilters([]parsley.Filter{o.Filter}))
+	catcher.NewWhen(o.Start != nil && o.End != nil && *o.Start > *o.End, "start time cannot be after end time")
+	catcher.NewWhen(o.Limit < 0, "limit cannot be negative")
+
+	return catcher.Resolve()
+}
+
+func (o LogSearchOptions) export(logNames ...string) log.SearchOptions {
+	return log.SearchOptions{
+		LogNames: logNames,
+		Filter:   o.Filter,
+		Start:    o.Start,
+		End:      o.End,
+		Limit:    o.Limit,
+	}
+}
+
+// LogSearchMatch is a line in a task run's task or test logs that matches a
+// search.
+type LogSearchMatch struct {
+	log.SearchMatch
+	// LogType is the type of the task log containing the line. Empty if
+	// the line belongs to a test log.
+	LogType TaskLogType
+	// TestLogPath is the path, relative to the task run's test logs
+	// directory, of the test log containing the line. Empty if the line
+	// belongs to a task log.
+	TestLogPath string
+}
+
+// LogSearchIterator iterates over the lines of a task run's logs that match a
+// search. The task logs are searched first, followed by the test logs.
+type LogSearchIterator struct {
+	ctx      context.Context
+	taskOpts TaskOptions
+	opts     LogSearchOptions
+	searches []logSearch
+	catcher  grip.Catcher
+
+	current    log.SearchIterator
+	annotate   func(*LogSearchMatch)
+	matchCount int
+	item       LogSearchMatch
+	closed     bool
+}
+
+// logSearch is a search of one type of log belonging to a task run.
+type logSearch struct {
+	search   func(context.Context, TaskOptions, LogSearchOptions) (log.SearchIterator, error)
+	annotate func(*LogSearchMatch)
+}
+
+// EmptyLogSearchIterator returns a convenience log search iterator with no
+// matches.
+func EmptyLogSearchIterator() *LogSearchIterator {
+	return &LogSearchIterator{catcher: grip.NewBasicCatcher()}
+}
+
+// SearchLogs returns an iterator over the lines of the task and test logs
+// belonging to the specified task run that match the given options.
+func (o TaskOutput) SearchLogs(ctx context.Context, taskOpts TaskOptions, searchOpts LogSearchOptions) (*LogSearchIterator, error) {
+	if err := searchOpts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid search options")
+	}
+
+	return &LogSearchIterator{
+		ctx:      ctx,
+		taskOpts: taskOpts,
+		opts:     searchOpts,
+		searches: []logSearch{
+			{
+				search: o.TaskLogs.Search,
+				annotate: func(match *LogSearchMatch) {
+					match.LogType = o.TaskLogs.getLogType(taskOpts, match.LogName)
+				},
+			},
+			{
+				search: o.TestLogs.Search,
+				annotate: func(match *LogSearchMatch) {
+					match.TestLogPath = o.TestLogs.getLogPath(taskOpts, match.LogName)
+				},
+			},
+		},
+		catcher: grip.NewBasicCatcher(),
+	}, nil
+}
+
+// Next returns true if the iterator has another matching line, false
+// otherwise.
+func (it *LogSearchIterator) Next() bool {
+	if it.closed || it.catcher.HasErrors() {
+		return false
+	}
+
+	for {
+		if it.current == nil {
+			if len(it.searches) == 0 {
+				return false
+			}
+
+			opts := it.opts
+			if opts.Limit > 0 {
+				if opts.Limit -= it.matchCount; opts.Limit <= 0 {
+					return false
+				}
+			}
+
+			next := it.searches[0]
+			it.searches = it.searches[1:]
+			current, err := next.search(it.ctx, it.taskOpts, opts)
+			if err != nil {
+				it.catcher.Wrap(err, "searching logs")
+				return false
+			}
+			it.current = current
+			it.annotate = next.annotate
+		}
+
+		if it.current.Next() {
+			it.item = LogSearchMatch{SearchMatch: it.current.Item()}
+			it.annotate(&it.item)
+			it.matchCount++
+
+			return true
+		}
+
+		it.catcher.Add(it.current.Err())
+		it.catcher.Add(it.current.Close())
+		it.current = nil
+		if it.catcher.HasErrors() {
+			return false
+		}
+	}
+}
+
+// Item returns the current matching line held by the iterator.
+func (it *LogSearchIterator) Item() LogSearchMatch { return it.item }
+
+// Err returns any errors captured by the iterator.
+func (it *LogSearchIterator) Err() error { return it.catcher.Resolve() }
+
+// Close closes the iterator.
+func (it *LogSearchIterator) Close() error {
+	if it.closed {
+		return nil
+	}
+	it.closed = true
+
+	if it.current != nil {
+		return it.current.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package taskoutput
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/parsley"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchLogs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketConfig := evergreen.BucketConfig{Type: evergreen.BucketTypeFilesystem, Name: t.TempDir()}
+	output := TaskOutput{
+		TaskLogs: TaskLogOutput{Version: 1, BucketConfig: bucketConfig},
+		TestLogs: TestLogOutput{Version: 1, BucketConfig: bucketConfig},
+	}
+	taskOpts := TaskOptions{ProjectID: "project", TaskID: "task", Execution: 1}
+
+	ts := time.Now().UnixNano()
+	taskLines := []log.LogLine{
+		{Priority: level.Info, Timestamp: ts, Data: "running tests"},
+		{Priority: level.Error, Timestamp: ts + 1, Data: "command failed: exit code 1"},
+	}
+	require.NoError(t, output.TaskLogs.Append(ctx, taskOpts, TaskLogTypeTask, taskLines))
+	testLines := []log.LogLine{
+		{Priority: level.Info, Timestamp: ts, Data: "starting test"},
+		{Priority: level.Info, Timestamp: ts + 1, Data: "assertion failed"},
+	}
+	require.NoError(t, output.TestLogs.Append(ctx, taskOpts, "suite/test.log", testLines))
+	require.NoError(t, output.TaskLogs.Append(ctx, TaskOptions{ProjectID: "project", TaskID: "task", Execution: 0}, TaskLogTypeTask, taskLines))
+
+	search := func(t *testing.T, output TaskOutput, opts LogSearchOptions) []LogSearchMatch {
+		it, err := output.SearchLogs(ctx, taskOpts, opts)
+		require.NoError(t, err)
+
+		var matches []LogSearchMatch
+		for it.Next() {
+			matches = append(matches, it.Item())
+		}
+		require.NoError(t, it.Err())
+		assert.NoError(t, it.Close())
+
+		return matches
+	}
+
+	t.Run("TaskAndTestLogs", func(t *testing.T) {
+		matches := search(t, output, LogSearchOptions{Filter: parsley.Filter{Expression: "failed", ExactMatch: true}})
+		require.Len(t, matches, 2)
+
+		assert.Equal(t, TaskLogTypeTask, matches[0].LogType)
+		assert.Empty(t, matches[0].TestLogPath)
+		assert.Equal(t, 1, matches[0].LineNumber)
+		assert.Equal(t, taskLines[1].Data, matches[0].Data)
+
+		assert.Empty(t, matches[1].LogType)
+		assert.Equal(t, "suite/test.log", matches[1].TestLogPath)
+		assert.Equal(t, 1, matches[1].LineNumber)
+		assert.Equal(t, testLines[1].Data, matches[1].Data)
+	})
+	t.Run("LimitAcrossLogTypes", func(t *testing.T) {
+		matches := search(t, output, LogSearchOptions{Filter: parsley.Filter{Expression: "test", ExactMatch: true}, Limit: 2})
+		require.Len(t, matches, 2)
+		assert.Equal(t, TaskLogTypeTask, matches[0].LogType)
+		assert.Equal(t, "suite/test.log", matches[1].TestLogPath)
+	})
+	t.Run("CedarLogsNeverMatch", func(t *testing.T) {
+		assert.Empty(t, search(t, TaskOutput{}, LogSearchOptions{Filter: parsley.Filter{Expression: "failed", ExactMatch: true}}))
+	})
+	t.Run("FailsWithInvalidOptions", func(t *testing.T) {
+		for name, opts := range map[string]LogSearchOptions{
+			"EmptyExpression":   {Filter: parsley.Filter{ExactMatch: true}},
+			"InvalidExpression": {Filter: parsley.Filter{Expression: "(", ExactMatch: true}},
+			"NegativeLimit":     {Filter: parsley.Filter{Expression: "failed", ExactMatch: true}, Limit: -1},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := output.SearchLogs(ctx, taskOpts, opts)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -128,6 +128,50 @@ func (o TaskLogOutput) Get(ctx context.Context, taskOpts TaskOptions, getOpts Ta
 	})
 }
 
+// Search returns the lines of the task logs belonging to the specified task run
+// that match the given options. Task logs stored in Cedar Buildlogger cannot be
+// searched and never match.
+func (o TaskLogOutput) Search(ctx context.Context, taskOpts TaskOptions, searchOpts LogSearchOptions) (log.SearchIterator, error) {
+	if o.Version == 0 {
+		return log.EmptySearchIterator(), nil
+	}
+
+	svc, err := o.getLogService(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting log service")
+	}
+
+	return svc.Search(ctx, searchOpts.export(o.getLogName(taskOpts, TaskLogTypeAll)))
+}
+
+// AppendSections appends section records to the task logs of the given task
+// run. Sections span all of the run's task logs. Records for a section replace
+// the records for it appended with a lower sequence.
+func (o TaskLogOutput) AppendSections(ctx context.Context, taskOpts TaskOptions, sequence int, sections []log.Section) error {
+	svc, err := o.getLogService(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting log service")
+	}
+
+	return svc.AppendSections(ctx, o.getLogName(taskOpts, TaskLogTypeAll), sequence, sections)
+}
+
+// GetSections returns the sections of the task logs belonging to the specified
+// task run, ordered by start time. Task logs stored in Cedar Buildlogger do not
+// have sections.
+func (o TaskLogOutput) GetSections(ctx context.Context, taskOpts TaskOptions) ([]log.Section, error) {
+	if o.Version == 0 {
+		return nil, nil
+	}
+
+	svc, err := o.getLogService(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting log service")
+	}
+
+	return svc.GetSections(ctx, o.getLogName(taskOpts, TaskLogTypeAll))
+}
+
 func (o TaskLogOutput) getLogName(taskOpts TaskOptions, logType TaskLogType) string {
 	prefix := fmt.Sprintf("%s/%s/%d/%s", taskOpts.ProjectID, taskOpts.TaskID, taskOpts.Execution, o.ID())
 
@@ -146,13 +190,19 @@ func (o TaskLogOutput) getLogName(taskOpts TaskOptions, logType TaskLogType) str
 	return fmt.Sprintf("%s/%s", prefix, logTypePrefix)
 }
 
-func (o TaskLogOutput) getLogService(ctx context.Context) (log.LogService, error) {
-	b, err := newBucket(ctx, o.BucketConfig, o.AWSCredentials)
-	if err != nil {
-		return nil, err
+// getLogType returns the type of the task log with the given name.
+func (o TaskLogOutput) getLogType(taskOpts TaskOptions, logName string) TaskLogType {
+	for _, logType := range []TaskLogType{TaskLogTypeAgent, TaskLogTypeSystem, TaskLogTypeTask} {
+		if logName == o.getLogName(taskOpts, logType) {
+			return logType
+		}
 	}
 
-	return log.NewLogServiceV0(b), nil
+	return TaskLogTypeAll
+}
+
+func (o TaskLogOutput) getLogService(ctx context.Context) (log.LogService, error) {
+	return newLogService(ctx, o.BucketConfig, o.AWSCredentials)
 }
 
 // getBuildloggerLogs makes request to Cedar Buildlogger for logs.
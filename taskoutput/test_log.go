@@ -3,6 +3,7 @@ package taskoutput
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/evergreen-ci/evergreen"
@@ -92,8 +93,28 @@ func (o TestLogOutput) Get(ctx context.Context, taskOpts TaskOptions, getOpts Te
 	})
 }
 
+// Search returns the lines of the test logs belonging to the specified task run
+// that match the given options. Test logs stored in Cedar Buildlogger cannot be
+// searched and never match.
+func (o TestLogOutput) Search(ctx context.Context, taskOpts TaskOptions, searchOpts LogSearchOptions) (log.SearchIterator, error) {
+	if o.Version == 0 {
+		return log.EmptySearchIterator(), nil
+	}
+
+	svc, err := o.getLogService(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting log service")
+	}
+
+	return svc.Search(ctx, searchOpts.export(o.getLogPrefix(taskOpts)))
+}
+
+func (o TestLogOutput) getLogPrefix(taskOpts TaskOptions) string {
+	return fmt.Sprintf("%s/%s/%d/%s", taskOpts.ProjectID, taskOpts.TaskID, taskOpts.Execution, o.ID())
+}
+
 func (o TestLogOutput) getLogNames(taskOpts TaskOptions, logPaths []string) []string {
-	prefix := fmt.Sprintf("%s/%s/%d/%s", taskOpts.ProjectID, taskOpts.TaskID, taskOpts.Execution, o.ID())
+	prefix := o.getLogPrefix(taskOpts)
 
 	logNames := make([]string, len(logPaths))
 	for i, path := range logPaths {
@@ -103,13 +124,14 @@ func (o TestLogOutput) getLogNames(taskOpts TaskOptions, logPaths []string) []st
 	return logNames
 }
 
-func (o TestLogOutput) getLogService(ctx context.Context) (log.LogService, error) {
-	b, err := newBucket(ctx, o.BucketConfig, o.AWSCredentials)
-	if err != nil {
-		return nil, err
-	}
+// getLogPath returns the path, relative to the task run's test logs
+// directory, of the test log with the given name.
+func (o TestLogOutput) getLogPath(taskOpts TaskOptions, logName string) string {
+	return strings.TrimPrefix(logName, o.getLogPrefix(taskOpts)+"/")
+}
 
-	return log.NewLogServiceV0(b), nil
+func (o TestLogOutput) getLogService(ctx context.Context) (log.LogService, error) {
+	return newLogService(ctx, o.BucketConfig, o.AWSCredentials)
 }
 
 // getBuildloggerLogs makes request to Cedar Buildlogger for logs.
@@ -5,12 +5,29 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/log"
 	"github.com/evergreen-ci/pail"
 	"github.com/evergreen-ci/utility"
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// newLogService returns the log service for the given bucket config. The
+// filesystem bucket type uses the filesystem log service directly, while all
+// other bucket types use the V0 log service backed by a pail bucket.
+func newLogService(ctx context.Context, config evergreen.BucketConfig, creds aws.CredentialsProvider) (log.LogService, error) {
+	if config.Type == evergreen.BucketTypeFilesystem {
+		return log.NewLogServiceFS(config.Name), nil
+	}
+
+	b, err := newBucket(ctx, config, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return log.NewLogServiceV0(b), nil
+}
+
 func newBucket(ctx context.Context, config evergreen.BucketConfig, creds aws.CredentialsProvider) (pail.Bucket, error) {
 	switch config.Type {
 	case evergreen.BucketTypeS3:
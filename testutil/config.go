@@ -211,6 +211,18 @@ func MockConfig() *evergreen.Settings {
 				},
 			},
 		},
+		Cost: evergreen.CostConfig{
+			InstanceTypeRates: []evergreen.InstanceTypeRate{
+				{
+					InstanceType:       "m5.xlarge",
+					OnDemandHourlyRate: 0.192,
+					SpotHourlyRate:     0.07,
+				},
+			},
+			DefaultHourlyRate:     0.1,
+			PodVCPUHourlyRate:     0.04,
+			PodMemoryGBHourlyRate: 0.004,
+		},
 		DomainName:          "example.com",
 		Expansions:          map[string]string{"k2": "v2"},
 		GithubPRCreatorOrg:  "org",
@@ -0,0 +1,227 @@
+package thirdparty
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// GitMirrorDir is the directory in which mirror clones of plain git remotes
+// are kept.
+var GitMirrorDir = filepath.Join(os.TempDir(), "evergreen-git-mirrors")
+
+// gitMirrorLocks serializes updates to each mirror clone within the process.
+var gitMirrorLocks sync.Map
+
+const (
+	// gitLogFieldSeparator and gitLogRecordSeparator delimit the fields and
+	// commits in the output of git log.
+	gitLogFieldSeparator  = "\x1f"
+	gitLogRecordSeparator = "\x1e"
+	gitLogFormat          = "--format=%H%x1f%an%x1f%ae%x1f%ct%x1f%B%x1e"
+)
+
+// GitMirrorCommit is a commit read from a mirror clone.
+type GitMirrorCommit struct {
+	Hash        string
+	AuthorName  string
+	AuthorEmail string
+	CommitTime  time.Time
+	Message     string
+}
+
+// GitMirror is a bare mirror clone of a git remote that is read with git
+// itself. Project git remotes are validated to be https:// or ssh:// URLs, so
+// those are the only remotes it is used with.
+type GitMirror struct {
+	// URL is the git remote to mirror.
+	URL string
+	// Dir is the local directory containing the mirror clone.
+	Dir string
+}
+
+// NewGitMirror returns a mirror of the git remote at the given URL. The mirror
+// clone is kept in GitMirrorDir, in a directory that is unique to the URL.
+func NewGitMirror(remoteURL string) *GitMirror {
+	hash := sha256.Sum256([]byte(remoteURL))
+	return &GitMirror{
+		URL: remoteURL,
+		Dir: filepath.Join(GitMirrorDir, hex.EncodeToString(hash[:])[:16]+".git"),
+	}
+}
+
+// Sync clones the remote if the mirror does not exist yet and otherwise
+// fetches all refs from the remote, pruning deleted refs.
+func (m *GitMirror) Sync(ctx context.Context) error {
+	lock, _ := gitMirrorLocks.LoadOrStore(m.Dir, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	if _, err := os.Stat(filepath.Join(m.Dir, "HEAD")); err == nil {
+		_, err = m.git(ctx, "fetch", "--prune", "--quiet", "--", "origin")
+		return errors.Wrapf(err, "fetching from remote '%s'", m.URL)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(m.Dir), 0755); err != nil {
+		return errors.Wrap(err, "creating mirror parent directory")
+	}
+	// Remove any partial clone left behind by an earlier failure.
+	if err := os.RemoveAll(m.Dir); err != nil {
+		return errors.Wrap(err, "removing incomplete mirror")
+	}
+	cmd := exec.CommandContext(ctx, "git", "clone", "--mirror", "--quiet", "--", m.URL, m.Dir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "cloning remote '%s': %s", m.URL, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// git runs a git command against the mirror clone and returns its standard
+// output.
+func (m *GitMirror) git(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"--git-dir", m.Dir}, args...)...)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrapf(err, "running git %s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// Log returns at most max commits reachable from ref, from most recent to
+// least recent.
+func (m *GitMirror) Log(ctx context.Context, ref string, max int) ([]GitMirrorCommit, error) {
+	out, err := m.git(ctx, "log", gitLogFormat, "-n", strconv.Itoa(max), ref, "--")
+	if err != nil {
+		return nil, err
+	}
+	return parseGitLog(out)
+}
+
+// GetCommit returns the commit for the given revision.
+func (m *GitMirror) GetCommit(ctx context.Context, revision string) (*GitMirrorCommit, error) {
+	out, err := m.git(ctx, "log", gitLogFormat, "-n", "1", revision, "--")
+	if err != nil {
+		return nil, err
+	}
+	commits, err := parseGitLog(out)
+	if err != nil {
+		return nil, err
+	}
+	if len(commits) == 0 {
+		return nil, errors.Errorf("commit '%s' not found", revision)
+	}
+	return &commits[0], nil
+}
+
+func parseGitLog(out string) ([]GitMirrorCommit, error) {
+	commits := []GitMirrorCommit{}
+	for _, record := range strings.Split(out, gitLogRecordSeparator) {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		fields := strings.SplitN(record, gitLogFieldSeparator, 5)
+		if len(fields) != 5 {
+			return nil, errors.Errorf("malformed git log record '%s'", record)
+		}
+		timestamp, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing commit time for commit '%s'", fields[0])
+		}
+		commits = append(commits, GitMirrorCommit{
+			Hash:        fields[0],
+			AuthorName:  fields[1],
+			AuthorEmail: fields[2],
+			CommitTime:  time.Unix(timestamp, 0),
+			Message:     strings.TrimRight(fields[4], "\n"),
+		})
+	}
+	return commits, nil
+}
+
+// ChangedFiles returns the files changed by the given revision relative to
+// its first parent, or all of its files if it's a root commit.
+func (m *GitMirror) ChangedFiles(ctx context.Context, revision string) ([]string, error) {
+	var out string
+	var err error
+	if _, parentErr := m.git(ctx, "rev-parse", "--verify", "--quiet", revision+"^"); parentErr == nil {
+		out, err = m.git(ctx, "diff", "--name-only", revision+"^", revision, "--")
+	} else {
+		out, err = m.git(ctx, "diff-tree", "--no-commit-id", "--name-only", "-r", "--root", revision, "--")
+	}
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, file := range strings.Split(out, "\n") {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// MergeBase returns the best common ancestor of the two revisions.
+func (m *GitMirror) MergeBase(ctx context.Context, revision1, revision2 string) (string, error) {
+	out, err := m.git(ctx, "merge-base", revision1, revision2)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// HasRevision returns whether the revision exists in the mirror.
+func (m *GitMirror) HasRevision(ctx context.Context, revision string) bool {
+	_, err := m.git(ctx, "cat-file", "-e", revision+"^{commit}")
+	return err == nil
+}
+
+type gitMirrorFileNotFoundError struct {
+	path     string
+	revision string
+}
+
+func (e *gitMirrorFileNotFoundError) Error() string {
+	return fmt.Sprintf("file '%s' not found at revision '%s'", e.path, e.revision)
+}
+
+// IsGitMirrorFileNotFoundError returns whether the error is caused by a file
+// not existing in a mirror at the requested revision.
+func IsGitMirrorFileNotFoundError(err error) bool {
+	_, ok := errors.Cause(err).(*gitMirrorFileNotFoundError)
+	return ok
+}
+
+// GetFile returns the contents of the file at the given path as of the given
+// revision. If the revision is not in the mirror yet, the mirror is synced
+// first.
+func (m *GitMirror) GetFile(ctx context.Context, path, revision string) ([]byte, error) {
+	if !m.HasRevision(ctx, revision) {
+		if err := m.Sync(ctx); err != nil {
+			return nil, err
+		}
+	}
+	object := revision + ":" + strings.TrimPrefix(path, "/")
+	if _, err := m.git(ctx, "cat-file", "-e", object); err != nil {
+		return nil, &gitMirrorFileNotFoundError{path: path, revision: revision}
+	}
+	out, err := m.git(ctx, "show", object)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading file '%s' at revision '%s'", path, revision)
+	}
+	return []byte(out), nil
+}
@@ -0,0 +1,92 @@
+package thirdparty
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runGit runs a git command in the given directory and returns its trimmed
+// output.
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Evergreen", "GIT_AUTHOR_EMAIL=evergreen@example.com",
+		"GIT_COMMITTER_NAME=Evergreen", "GIT_COMMITTER_EMAIL=evergreen@example.com",
+	)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+// commitFile writes the file in the repo and commits it, returning the new
+// commit hash.
+func commitFile(t *testing.T, repo, name, contents, msg string) string {
+	require.NoError(t, os.WriteFile(filepath.Join(repo, name), []byte(contents), 0644))
+	runGit(t, repo, "add", name)
+	runGit(t, repo, "commit", "-q", "-m", msg)
+	return runGit(t, repo, "rev-parse", "HEAD")
+}
+
+func TestGitMirror(t *testing.T) {
+	ctx := t.Context()
+	GitMirrorDir = t.TempDir()
+
+	repo := t.TempDir()
+	runGit(t, repo, "init", "-q", "-b", "main")
+	first := commitFile(t, repo, "evergreen.yml", "tasks: []\n", "first")
+	second := commitFile(t, repo, "a.txt", "a\n", "second\n\nwith a body")
+
+	mirror := NewGitMirror("file://" + repo)
+	assert.Equal(t, mirror.Dir, NewGitMirror("file://"+repo).Dir)
+	require.NoError(t, mirror.Sync(ctx))
+
+	t.Run("Log", func(t *testing.T) {
+		commits, err := mirror.Log(ctx, "refs/heads/main", 10)
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		assert.Equal(t, second, commits[0].Hash)
+		assert.Equal(t, "second\n\nwith a body", commits[0].Message)
+		assert.Equal(t, "Evergreen", commits[0].AuthorName)
+		assert.Equal(t, "evergreen@example.com", commits[0].AuthorEmail)
+		assert.False(t, commits[0].CommitTime.IsZero())
+		assert.Equal(t, first, commits[1].Hash)
+	})
+	t.Run("ChangedFiles", func(t *testing.T) {
+		files, err := mirror.ChangedFiles(ctx, second)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.txt"}, files)
+
+		files, err = mirror.ChangedFiles(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"evergreen.yml"}, files)
+	})
+	t.Run("GetFile", func(t *testing.T) {
+		contents, err := mirror.GetFile(ctx, "evergreen.yml", first)
+		require.NoError(t, err)
+		assert.Equal(t, "tasks: []\n", string(contents))
+
+		_, err = mirror.GetFile(ctx, "a.txt", first)
+		assert.True(t, IsGitMirrorFileNotFoundError(err))
+	})
+	t.Run("GetFileSyncsNewRevisions", func(t *testing.T) {
+		third := commitFile(t, repo, "b.txt", "b\n", "third")
+		contents, err := mirror.GetFile(ctx, "b.txt", third)
+		require.NoError(t, err)
+		assert.Equal(t, "b\n", string(contents))
+	})
+	t.Run("MergeBase", func(t *testing.T) {
+		base, err := mirror.MergeBase(ctx, first, second)
+		require.NoError(t, err)
+		assert.Equal(t, first, base)
+	})
+	t.Run("SyncFailsForMissingRemote", func(t *testing.T) {
+		assert.Error(t, NewGitMirror(filepath.Join(repo, "nonexistent")).Sync(ctx))
+	})
+}
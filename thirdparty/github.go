@@ -185,6 +185,10 @@ type GithubMergeGroup struct {
 	// together, so there are as many commits as there are PRs in the merge
 	// group. This is only the title of the first commit in the merge group.
 	HeadCommit string `bson:"head_commit"`
+
+	// BatchID is the merge queue batch that the patch tests, if the merge
+	// group was batched with others.
+	BatchID string `bson:"batch_id,omitempty"`
 }
 
 // SendGithubStatusInput is the input to the SendPendingStatusToGithub function and contains
@@ -520,6 +524,50 @@ func GetGithubFile(ctx context.Context, owner, repo, path, ref string) (*github.
 	return file, nil
 }
 
+// GetGithubTree returns every entry in the repository's tree at the given
+// commit hash, including the entries in subdirectories. It errors if the tree
+// is too large for GitHub to return in full.
+func GetGithubTree(ctx context.Context, owner, repo, ref string) ([]*github.TreeEntry, error) {
+	caller := "GetGithubTree"
+	ctx, span := tracer.Start(ctx, caller, trace.WithAttributes(
+		attribute.String(githubEndpointAttribute, caller),
+		attribute.String(githubOwnerAttribute, owner),
+		attribute.String(githubRepoAttribute, repo),
+		attribute.String(githubRefAttribute, ref),
+	))
+	defer span.End()
+
+	token, err := getInstallationToken(ctx, owner, repo, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting installation token")
+	}
+
+	githubClient := getGithubClient(token, caller, retryConfig{retry: true})
+	defer githubClient.Close()
+
+	tree, resp, err := githubClient.Git.GetTree(ctx, owner, repo, ref, true)
+	if resp != nil {
+		defer resp.Body.Close()
+		span.SetAttributes(attribute.Bool(githubCachedAttribute, respFromCache(resp.Response)))
+		if err != nil {
+			return nil, parseGithubErrorResponse(resp)
+		}
+	} else {
+		errMsg := fmt.Sprintf("nil response from github for tree of '%s/%s' at '%s': %v", owner, repo, ref, err)
+		grip.Error(errMsg)
+		return nil, APIResponseError{errMsg}
+	}
+
+	if tree == nil {
+		return nil, APIRequestError{Message: "tree is nil"}
+	}
+	if tree.GetTruncated() {
+		return nil, errors.Errorf("tree of '%s/%s' at '%s' is too large to be returned in full", owner, repo, ref)
+	}
+
+	return tree.Entries, nil
+}
+
 // SendPendingStatusToGithub sends a pending status to a Github PR patch
 // associated with a given version.
 func SendPendingStatusToGithub(ctx context.Context, input SendGithubStatusInput, urlBase string) error {
@@ -0,0 +1,472 @@
+package thirdparty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultGitLabURL is the base URL of the hosted GitLab service.
+	DefaultGitLabURL = "https://gitlab.com"
+
+	// GitLabTokenHeader is the header that GitLab sets to the webhook's secret
+	// token when it delivers a webhook.
+	GitLabTokenHeader = "X-Gitlab-Token"
+	// GitLabEventHeader is the header that GitLab sets to the type of event
+	// when it delivers a webhook.
+	GitLabEventHeader = "X-Gitlab-Event"
+
+	GitLabMergeRequestHookEvent = "Merge Request Hook"
+	GitLabPushHookEvent         = "Push Hook"
+
+	gitLabCommitsPerPage = 100
+
+	// GitLabDeveloperAccessLevel is the lowest project access level that can
+	// push to the project's branches.
+	GitLabDeveloperAccessLevel = 30
+)
+
+// Commit statuses accepted by the GitLab commit status API.
+const (
+	GitLabStatusPending  = "pending"
+	GitLabStatusRunning  = "running"
+	GitLabStatusSuccess  = "success"
+	GitLabStatusFailed   = "failed"
+	GitLabStatusCanceled = "canceled"
+)
+
+// GitLabMergeRequest stores patch data for patches created from GitLab merge
+// requests.
+type GitLabMergeRequest struct {
+	// ProjectPath is the path with namespace of the project that the merge
+	// request targets (e.g. "group/repo").
+	ProjectPath  string `bson:"project_path"`
+	IID          int    `bson:"iid"`
+	Title        string `bson:"title"`
+	URL          string `bson:"url"`
+	TargetBranch string `bson:"target_branch"`
+	SourceBranch string `bson:"source_branch"`
+	// SourceProjectPath is the path with namespace of the project that the
+	// merge request's commits come from, which differs from ProjectPath for
+	// merge requests from forks.
+	SourceProjectPath string `bson:"source_project_path"`
+	HeadHash          string `bson:"head_hash"`
+	BaseHash          string `bson:"base_hash"`
+	Author            string `bson:"author"`
+	AuthorUID         int    `bson:"author_uid"`
+}
+
+var (
+	// BSON fields for GitLabMergeRequest
+	GitLabMergeRequestProjectPathKey = bsonutil.MustHaveTag(GitLabMergeRequest{}, "ProjectPath")
+	GitLabMergeRequestIIDKey         = bsonutil.MustHaveTag(GitLabMergeRequest{}, "IID")
+)
+
+// GitLabCommit is a commit returned by the GitLab API.
+type GitLabCommit struct {
+	ID            string    `json:"id"`
+	Title         string    `json:"title"`
+	Message       string    `json:"message"`
+	AuthorName    string    `json:"author_name"`
+	AuthorEmail   string    `json:"author_email"`
+	CommittedDate time.Time `json:"committed_date"`
+	ParentIDs     []string  `json:"parent_ids"`
+}
+
+// GitLabDiff is the diff of a single file returned by the GitLab API.
+type GitLabDiff struct {
+	OldPath     string `json:"old_path"`
+	NewPath     string `json:"new_path"`
+	Diff        string `json:"diff"`
+	NewFile     bool   `json:"new_file"`
+	RenamedFile bool   `json:"renamed_file"`
+	DeletedFile bool   `json:"deleted_file"`
+}
+
+// GitLabUser is a user returned by the GitLab API.
+type GitLabUser struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+}
+
+// GitLabProject is a project returned by the GitLab API or included in a
+// webhook payload.
+type GitLabProject struct {
+	ID                int    `json:"id"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+	DefaultBranch     string `json:"default_branch"`
+}
+
+// GitLabMergeRequestInfo is a merge request returned by the GitLab API.
+type GitLabMergeRequestInfo struct {
+	ID              int        `json:"id"`
+	IID             int        `json:"iid"`
+	ProjectID       int        `json:"project_id"`
+	SourceProjectID int        `json:"source_project_id"`
+	TargetProjectID int        `json:"target_project_id"`
+	Title           string     `json:"title"`
+	State           string     `json:"state"`
+	SourceBranch    string     `json:"source_branch"`
+	TargetBranch    string     `json:"target_branch"`
+	SHA             string     `json:"sha"`
+	WebURL          string     `json:"web_url"`
+	Author          GitLabUser `json:"author"`
+	DiffRefs        struct {
+		BaseSHA  string `json:"base_sha"`
+		HeadSHA  string `json:"head_sha"`
+		StartSHA string `json:"start_sha"`
+	} `json:"diff_refs"`
+}
+
+// GitLabMergeRequestEvent is the payload of a GitLab merge request webhook.
+type GitLabMergeRequestEvent struct {
+	ObjectKind       string        `json:"object_kind"`
+	User             GitLabUser    `json:"user"`
+	Project          GitLabProject `json:"project"`
+	ObjectAttributes struct {
+		IID          int           `json:"iid"`
+		Title        string        `json:"title"`
+		State        string        `json:"state"`
+		Action       string        `json:"action"`
+		URL          string        `json:"url"`
+		SourceBranch string        `json:"source_branch"`
+		TargetBranch string        `json:"target_branch"`
+		Source       GitLabProject `json:"source"`
+		Target       GitLabProject `json:"target"`
+		LastCommit   struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+		// OldRev is only set on update events that pushed new commits to
+		// the merge request.
+		OldRev string `json:"oldrev"`
+	} `json:"object_attributes"`
+}
+
+// Merge request webhook actions.
+const (
+	GitLabMergeRequestActionOpen   = "open"
+	GitLabMergeRequestActionReopen = "reopen"
+	GitLabMergeRequestActionUpdate = "update"
+)
+
+// GitLabPushEvent is the payload of a GitLab push webhook.
+type GitLabPushEvent struct {
+	ObjectKind string        `json:"object_kind"`
+	Ref        string        `json:"ref"`
+	Before     string        `json:"before"`
+	After      string        `json:"after"`
+	Project    GitLabProject `json:"project"`
+}
+
+// GitLabCommitStatus is a commit status to set in GitLab.
+type GitLabCommitStatus struct {
+	State       string `json:"state"`
+	Ref         string `json:"ref,omitempty"`
+	Name        string `json:"name,omitempty"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Valid checks that the commit status has a state that GitLab accepts.
+func (s *GitLabCommitStatus) Valid() bool {
+	switch s.State {
+	case GitLabStatusPending, GitLabStatusRunning, GitLabStatusSuccess, GitLabStatusFailed, GitLabStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// GitLabClient is a client for the GitLab REST API (v4) scoped to a single
+// GitLab instance.
+type GitLabClient struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// NewGitLabClient returns a client for the GitLab instance at the given base
+// URL that authenticates with the given access token. If the base URL is
+// empty, the hosted GitLab service is used.
+func NewGitLabClient(baseURL, token string) *GitLabClient {
+	if baseURL == "" {
+		baseURL = DefaultGitLabURL
+	}
+	return &GitLabClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		client:  &http.Client{Timeout: time.Minute},
+	}
+}
+
+type gitLabNotFoundError struct {
+	path string
+}
+
+func (e *gitLabNotFoundError) Error() string {
+	return fmt.Sprintf("GitLab resource '%s' not found", e.path)
+}
+
+// IsGitLabNotFoundError returns whether the error is from a GitLab resource
+// that does not exist.
+func IsGitLabNotFoundError(err error) bool {
+	_, ok := errors.Cause(err).(*gitLabNotFoundError)
+	return ok
+}
+
+func (c *GitLabClient) projectPath(projectPath string) string {
+	return "/projects/" + url.PathEscape(projectPath)
+}
+
+// do sends a request to the GitLab API and returns the response for
+// successful requests. The caller must close the response body.
+func (c *GitLabClient) do(ctx context.Context, method, path string, query url.Values, data any) (*http.Response, error) {
+	u := c.baseURL + "/api/v4" + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var body io.Reader
+	if data != nil {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshalling request body")
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request")
+	}
+	if data != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to GitLab '%s'", path)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, &gitLabNotFoundError{path: path}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, errors.Errorf("GitLab request '%s' returned unexpected status '%s': %s", path, resp.Status, string(msg))
+	}
+
+	return resp, nil
+}
+
+func (c *GitLabClient) getJSON(ctx context.Context, path string, query url.Values, out any) (*http.Response, error) {
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return resp, errors.Wrapf(utility.ReadJSON(resp.Body, out), "reading response from GitLab '%s'", path)
+}
+
+// GetCommits returns a page of the commits reachable from the ref, newest
+// first, along with the number of the next page. The next page is 0 if there
+// are no more commits.
+func (c *GitLabClient) GetCommits(ctx context.Context, projectPath, ref string, page int) ([]GitLabCommit, int, error) {
+	query := url.Values{}
+	query.Set("ref_name", ref)
+	query.Set("per_page", strconv.Itoa(gitLabCommitsPerPage))
+	query.Set("page", strconv.Itoa(page))
+
+	commits := []GitLabCommit{}
+	resp, err := c.getJSON(ctx, c.projectPath(projectPath)+"/repository/commits", query, &commits)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "getting commits for ref '%s'", ref)
+	}
+	nextPage, _ := strconv.Atoi(resp.Header.Get("X-Next-Page"))
+
+	return commits, nextPage, nil
+}
+
+// GetCommit returns the commit with the given SHA or ref.
+func (c *GitLabClient) GetCommit(ctx context.Context, projectPath, sha string) (*GitLabCommit, error) {
+	commit := &GitLabCommit{}
+	if _, err := c.getJSON(ctx, c.projectPath(projectPath)+"/repository/commits/"+url.PathEscape(sha), nil, commit); err != nil {
+		return nil, errors.Wrapf(err, "getting commit '%s'", sha)
+	}
+	return commit, nil
+}
+
+// GetCommitChangedFiles returns the paths of the files changed by the commit.
+func (c *GitLabClient) GetCommitChangedFiles(ctx context.Context, projectPath, sha string) ([]string, error) {
+	diffs := []GitLabDiff{}
+	if _, err := c.getJSON(ctx, c.projectPath(projectPath)+"/repository/commits/"+url.PathEscape(sha)+"/diff", nil, &diffs); err != nil {
+		return nil, errors.Wrapf(err, "getting diff for commit '%s'", sha)
+	}
+
+	files := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		if d.DeletedFile {
+			files = append(files, d.OldPath)
+			continue
+		}
+		files = append(files, d.NewPath)
+	}
+	return files, nil
+}
+
+// GetMergeBase returns the merge base of the given commits.
+func (c *GitLabClient) GetMergeBase(ctx context.Context, projectPath string, refs ...string) (string, error) {
+	query := url.Values{}
+	for _, ref := range refs {
+		query.Add("refs[]", ref)
+	}
+	commit := &GitLabCommit{}
+	if _, err := c.getJSON(ctx, c.projectPath(projectPath)+"/repository/merge_base", query, commit); err != nil {
+		return "", errors.Wrap(err, "getting merge base")
+	}
+	return commit.ID, nil
+}
+
+// GetFile returns the raw contents of the file at the given ref.
+func (c *GitLabClient) GetFile(ctx context.Context, projectPath, filePath, ref string) ([]byte, error) {
+	query := url.Values{}
+	query.Set("ref", ref)
+	resp, err := c.do(ctx, http.MethodGet, c.projectPath(projectPath)+"/repository/files/"+url.PathEscape(filePath)+"/raw", query, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting file '%s' at ref '%s'", filePath, ref)
+	}
+	defer resp.Body.Close()
+
+	contents, err := io.ReadAll(resp.Body)
+	return contents, errors.Wrapf(err, "reading file '%s'", filePath)
+}
+
+// GetMergeRequest returns the merge request with the given project-scoped ID.
+func (c *GitLabClient) GetMergeRequest(ctx context.Context, projectPath string, iid int) (*GitLabMergeRequestInfo, error) {
+	mr := &GitLabMergeRequestInfo{}
+	if _, err := c.getJSON(ctx, fmt.Sprintf("%s/merge_requests/%d", c.projectPath(projectPath), iid), nil, mr); err != nil {
+		return nil, errors.Wrapf(err, "getting merge request %d", iid)
+	}
+	return mr, nil
+}
+
+// GetProjectMemberAccessLevel returns the user's access level in the project,
+// including access inherited from the project's groups. It returns 0 if the
+// user is not a member of the project.
+func (c *GitLabClient) GetProjectMemberAccessLevel(ctx context.Context, projectPath string, userID int) (int, error) {
+	member := struct {
+		AccessLevel int `json:"access_level"`
+	}{}
+	_, err := c.getJSON(ctx, fmt.Sprintf("%s/members/all/%d", c.projectPath(projectPath), userID), nil, &member)
+	if IsGitLabNotFoundError(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "getting access level of user %d", userID)
+	}
+	return member.AccessLevel, nil
+}
+
+// GetMergeRequestDiff returns the merge request's changes as a unified diff
+// along with a summary of the changes to each file.
+func (c *GitLabClient) GetMergeRequestDiff(ctx context.Context, projectPath string, iid int) (string, []Summary, error) {
+	diffs := []GitLabDiff{}
+	if _, err := c.getJSON(ctx, fmt.Sprintf("%s/merge_requests/%d/diffs", c.projectPath(projectPath), iid), nil, &diffs); err != nil {
+		return "", nil, errors.Wrapf(err, "getting diff for merge request %d", iid)
+	}
+
+	return gitLabDiffsToPatch(diffs)
+}
+
+// gitLabDiffsToPatch reassembles per-file GitLab diffs, which omit the git
+// headers, into a unified diff that git can apply.
+func gitLabDiffsToPatch(diffs []GitLabDiff) (string, []Summary, error) {
+	var patch strings.Builder
+	summaries := make([]Summary, 0, len(diffs))
+	for _, d := range diffs {
+		oldPath := "a/" + d.OldPath
+		newPath := "b/" + d.NewPath
+		patch.WriteString(fmt.Sprintf("diff --git %s %s\n", oldPath, newPath))
+		switch {
+		case d.NewFile:
+			patch.WriteString("new file mode 100644\n")
+			oldPath = "/dev/null"
+		case d.DeletedFile:
+			patch.WriteString("deleted file mode 100644\n")
+			newPath = "/dev/null"
+		case d.RenamedFile:
+			patch.WriteString(fmt.Sprintf("rename from %s\nrename to %s\n", d.OldPath, d.NewPath))
+		}
+		summary := Summary{Name: d.NewPath}
+		if d.DeletedFile {
+			summary.Name = d.OldPath
+		}
+		if d.Diff != "" {
+			patch.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", oldPath, newPath))
+			patch.WriteString(d.Diff)
+			if !strings.HasSuffix(d.Diff, "\n") {
+				patch.WriteString("\n")
+			}
+			for _, line := range strings.Split(d.Diff, "\n") {
+				switch {
+				case strings.HasPrefix(line, "+"):
+					summary.Additions++
+				case strings.HasPrefix(line, "-"):
+					summary.Deletions++
+				}
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return patch.String(), summaries, nil
+}
+
+// SetCommitStatus sets the status of the commit.
+func (c *GitLabClient) SetCommitStatus(ctx context.Context, projectPath, sha string, status GitLabCommitStatus) error {
+	if !status.Valid() {
+		return errors.Errorf("invalid GitLab commit status state '%s'", status.State)
+	}
+	resp, err := c.do(ctx, http.MethodPost, c.projectPath(projectPath)+"/statuses/"+url.PathEscape(sha), nil, status)
+	if err != nil {
+		return errors.Wrapf(err, "setting status for commit '%s'", sha)
+	}
+	return resp.Body.Close()
+}
+
+// CreateMergeRequestNote adds a comment to the merge request.
+func (c *GitLabClient) CreateMergeRequestNote(ctx context.Context, projectPath string, iid int, body string) error {
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/merge_requests/%d/notes", c.projectPath(projectPath), iid), nil, map[string]string{"body": body})
+	if err != nil {
+		return errors.Wrapf(err, "creating note on merge request %d", iid)
+	}
+	return resp.Body.Close()
+}
+
+// FormGitLabURL returns the URL to clone the GitLab project at the given host,
+// authenticating with the given token if it is set.
+func FormGitLabURL(host, projectPath, token string) string {
+	if token != "" {
+		return fmt.Sprintf("https://oauth2:%s@%s/%s.git", token, host, projectPath)
+	}
+
+	return fmt.Sprintf("https://%s/%s.git", host, projectPath)
+}
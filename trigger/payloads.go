@@ -412,7 +412,8 @@ func makeCommonPayload(sub *event.Subscription, eventAttributes event.Attributes
 	}
 
 	switch sub.Subscriber.Type {
-	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
+	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType,
+		event.GitLabMergeRequestSubscriberType:
 		if len(data.githubDescription) == 0 {
 			return nil, errors.Errorf("GitHub subscriber not supported for trigger '%s'", sub.Trigger)
 		}
@@ -32,6 +32,7 @@ func init() {
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskStarted, makeTaskTriggers)
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskFinished, makeTaskTriggers)
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskBlocked, makeTaskTriggers)
+	registry.registerEventHandler(event.ResourceTypeTask, event.TaskCulpritFound, makeTaskCulpritTriggers)
 }
 
 const (
@@ -67,6 +68,20 @@ func makeTaskTriggers() eventHandler {
 	return t
 }
 
+// makeTaskCulpritTriggers returns the handler for events indicating that a
+// culprit search found the commit that caused a task to fail. It's kept
+// separate from the other task triggers so that those don't fire on it.
+func makeTaskCulpritTriggers() eventHandler {
+	t := &taskTriggers{
+		oldTestResults: map[string]*testresult.TestResult{},
+	}
+	t.base.triggers = map[string]trigger{
+		event.TriggerTaskCulpritFound: t.taskCulpritFound,
+	}
+
+	return t
+}
+
 // newAlertRecord creates an instance of an alert record for the given alert type, populating it
 // with as much data from the triggerContext as possible
 func newAlertRecord(subID string, t *task.Task, alertType string) *alertrecord.AlertRecord {
@@ -137,14 +152,18 @@ func getShouldExecuteError(t, previousTask *task.Task) message.Fields {
 }
 
 type taskTriggers struct {
-	event        *event.EventLogEntry
-	data         *event.TaskEventData
-	task         *task.Task
-	owner        string
-	uiConfig     evergreen.UIConfig
-	jiraMappings *evergreen.JIRANotificationsConfig
-	host         *host.Host
-	apiTask      *restModel.APITask
+	event *event.EventLogEntry
+	data  *event.TaskEventData
+	task  *task.Task
+	owner string
+	// culprit and culpritVersion are the first failing task and its version
+	// found by a culprit search.
+	culprit        *task.Task
+	culpritVersion *model.Version
+	uiConfig       evergreen.UIConfig
+	jiraMappings   *evergreen.JIRANotificationsConfig
+	host           *host.Host
+	apiTask        *restModel.APITask
 
 	oldTestResults map[string]*testresult.TestResult
 
@@ -189,6 +208,23 @@ func (t *taskTriggers) Fetch(ctx context.Context, e *event.EventLogEntry) error
 	}
 	t.owner = author
 
+	if t.data.CulpritTaskID != "" {
+		t.culprit, err = task.FindOneId(ctx, t.data.CulpritTaskID)
+		if err != nil {
+			return errors.Wrapf(err, "finding culprit task '%s'", t.data.CulpritTaskID)
+		}
+		if t.culprit == nil {
+			return errors.Errorf("culprit task '%s' not found", t.data.CulpritTaskID)
+		}
+		t.culpritVersion, err = model.VersionFindOneId(ctx, t.culprit.Version)
+		if err != nil {
+			return errors.Wrapf(err, "finding culprit version '%s'", t.culprit.Version)
+		}
+		if t.culpritVersion == nil {
+			return errors.Errorf("culprit version '%s' not found", t.culprit.Version)
+		}
+	}
+
 	if t.task.HostId != "" {
 		t.host, err = host.FindOneId(ctx, t.task.HostId)
 		if err != nil {
@@ -228,6 +264,9 @@ func (t *taskTriggers) Attributes() event.Attributes {
 	if t.owner != "" {
 		attributes.Owner = append(attributes.Owner, t.owner)
 	}
+	if t.culpritVersion != nil && t.culpritVersion.AuthorID != "" && t.culpritVersion.AuthorID != t.owner {
+		attributes.Owner = append(attributes.Owner, t.culpritVersion.AuthorID)
+	}
 
 	return attributes
 }
@@ -425,6 +464,18 @@ func (t *taskTriggers) taskOutcome(ctx context.Context, sub *event.Subscription)
 	return t.generate(ctx, sub, "", "")
 }
 
+func (t *taskTriggers) taskCulpritFound(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
+	if t.culpritVersion == nil {
+		return nil, nil
+	}
+
+	revision := t.culpritVersion.Revision
+	if len(revision) > 7 {
+		revision = revision[:7]
+	}
+	return t.generate(ctx, sub, fmt.Sprintf("a suspected culprit: commit %s by %s", revision, t.culpritVersion.Author), t.data.TestName)
+}
+
 func (t *taskTriggers) taskFailure(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
 	if t.task.IsPartOfDisplay(ctx) {
 		return nil, nil
@@ -844,6 +895,11 @@ func (t *taskTriggers) taskRegressionByTest(ctx context.Context, sub *event.Subs
 		t.oldTestResults = mapTestResultsByTestName(previousCompleteTask.LocalTestResults)
 	}
 
+	quarantine, err := testresult.FindQuarantine(ctx, evergreen.GetEnvironment(), t.task.Project)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding quarantined tests")
+	}
+
 	testsToAlert := []testresult.TestResult{}
 	hasFailingTest := false
 	for _, test := range t.task.LocalTestResults {
@@ -851,6 +907,11 @@ func (t *taskTriggers) taskRegressionByTest(ctx context.Context, sub *event.Subs
 			continue
 		}
 		hasFailingTest = true
+		// Quarantined tests are known to be flaky, so their failures
+		// should not alert anyone.
+		if quarantine.IsQuarantined(test.GetDisplayTestName()) {
+			continue
+		}
 		var match bool
 		match, err = testMatchesRegex(test.GetDisplayTestName(), sub)
 		if err != nil {
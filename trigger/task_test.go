@@ -169,6 +169,7 @@ func (s *taskSuite) TearDownSuite() {
 		event.SubscriptionsCollection,
 		build.Collection,
 		model.ProjectRefCollection,
+		testresult.QuarantineCollection,
 	))
 	s.NoError(testresult.ClearLocal(ctx, s.env))
 }
@@ -186,6 +187,7 @@ func (s *taskSuite) SetupTest() {
 		event.SubscriptionsCollection,
 		build.Collection,
 		model.ProjectRefCollection,
+		testresult.QuarantineCollection,
 	))
 	s.Require().NoError(testresult.ClearLocal(s.ctx, s.env))
 	startTime := time.Now().Truncate(time.Millisecond).Add(-time.Hour)
@@ -1020,6 +1022,25 @@ func (s *taskSuite) TestRegressionByTestWithPassingTests() {
 	s.tryDoubleTrigger(true)
 }
 
+func (s *taskSuite) TestRegressionByTestWithQuarantinedTests() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Require().NoError(testresult.QuarantineTests(ctx, s.env, s.task.Project, []string{"test_0"}))
+
+	// a failing quarantined test should not generate, nor should it fall
+	// back to a task regression
+	s.makeTask(29, evergreen.TaskFailed)
+	s.makeTest(ctx, "", evergreen.TestFailedStatus)
+	s.tryDoubleTrigger(false)
+
+	// but a failing test that isn't quarantined should
+	s.makeTask(30, evergreen.TaskFailed)
+	s.makeTest(ctx, "", evergreen.TestFailedStatus)
+	s.makeTest(ctx, "test_1", evergreen.TestFailedStatus)
+	s.tryDoubleTrigger(true)
+}
+
 func (s *taskSuite) TestRegressionByTestWithRegex() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -0,0 +1,303 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/pail"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	artifactRetentionJobName = "artifact-retention"
+
+	// artifactRetentionBatchSize is the number of tasks whose artifact entries
+	// are looked up at once.
+	artifactRetentionBatchSize = 500
+)
+
+func init() {
+	registry.AddJobType(artifactRetentionJobName, func() amboy.Job {
+		return makeArtifactRetentionJob()
+	})
+}
+
+// artifactBucketOpener opens the bucket that an artifact file is stored in.
+type artifactBucketOpener func(context.Context, artifact.File) (pail.Bucket, error)
+
+type artifactRetentionJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env        evergreen.Environment
+	openBucket artifactBucketOpener
+	// mainlineThresholds caches the lowest revision order number among the
+	// most recent mainline runs of a task.
+	mainlineThresholds map[string]int
+}
+
+func makeArtifactRetentionJob() *artifactRetentionJob {
+	return &artifactRetentionJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    artifactRetentionJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewArtifactRetentionJob returns a job that deletes the task artifacts that
+// have expired according to their projects' artifact retention policies and
+// marks them as expired.
+func NewArtifactRetentionJob(ts string) amboy.Job {
+	j := makeArtifactRetentionJob()
+	j.SetID(fmt.Sprintf("%s.%s", artifactRetentionJobName, ts))
+	j.SetScopes([]string{artifactRetentionJobName})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *artifactRetentionJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+	if j.openBucket == nil {
+		j.openBucket = j.openS3Bucket
+	}
+	j.mainlineThresholds = map[string]int{}
+
+	policies, err := artifact.FindAllRetentionPolicies(ctx, j.env)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	if len(policies) == 0 {
+		return
+	}
+
+	now := time.Now()
+	numExpired := 0
+	for i := range policies {
+		n, err := j.expireProjectArtifacts(ctx, &policies[i], now)
+		numExpired += n
+		j.AddError(errors.Wrapf(err, "expiring artifacts for project '%s'", policies[i].ProjectID))
+	}
+
+	grip.Info(message.Fields{
+		"message":      "expired task artifacts",
+		"job_id":       j.ID(),
+		"num_policies": len(policies),
+		"num_expired":  numExpired,
+	})
+}
+
+// expireProjectArtifacts expires the artifacts of the project's tasks that
+// were created long enough ago that their artifacts could have expired under
+// the project's retention policy. Since a task's artifacts are attached after
+// it's created, the artifacts of newer tasks can't have expired yet. It
+// returns the number of files that were expired.
+func (j *artifactRetentionJob) expireProjectArtifacts(ctx context.Context, policy *artifact.RetentionPolicy, now time.Time) (int, error) {
+	query := bson.M{
+		task.ProjectKey:    policy.ProjectID,
+		task.CreateTimeKey: bson.M{"$lt": now.Add(-policy.MinMaxAge())},
+	}
+	opts := options.Find().SetProjection(bson.M{
+		task.IdKey:                  1,
+		task.ProjectKey:             1,
+		task.RequesterKey:           1,
+		task.BuildVariantKey:        1,
+		task.DisplayNameKey:         1,
+		task.RevisionOrderNumberKey: 1,
+		task.FinishTimeKey:          1,
+	})
+	cur, err := j.env.DB().Collection(task.Collection).Find(ctx, query, opts)
+	if err != nil {
+		return 0, errors.Wrap(err, "finding tasks")
+	}
+	defer cur.Close(ctx)
+
+	numExpired := 0
+	tasks := []task.Task{}
+	for cur.Next(ctx) {
+		t := task.Task{}
+		if err = cur.Decode(&t); err != nil {
+			return numExpired, errors.Wrap(err, "decoding task")
+		}
+		tasks = append(tasks, t)
+		if len(tasks) < artifactRetentionBatchSize {
+			continue
+		}
+
+		n, err := j.expireTaskArtifacts(ctx, tasks, policy, now)
+		numExpired += n
+		if err != nil {
+			return numExpired, err
+		}
+		tasks = tasks[:0]
+	}
+	if err = cur.Err(); err != nil {
+		return numExpired, errors.Wrap(err, "iterating over tasks")
+	}
+	n, err := j.expireTaskArtifacts(ctx, tasks, policy, now)
+	return numExpired + n, err
+}
+
+// expireTaskArtifacts deletes and marks as expired the files attached to the
+// given tasks that have expired according to the project's retention policy.
+// It returns the number of files that were expired.
+func (j *artifactRetentionJob) expireTaskArtifacts(ctx context.Context, tasks []task.Task, policy *artifact.RetentionPolicy, now time.Time) (int, error) {
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+
+	taskIDs := make([]string, 0, len(tasks))
+	tasksByID := map[string]*task.Task{}
+	for i := range tasks {
+		taskIDs = append(taskIDs, tasks[i].Id)
+		tasksByID[tasks[i].Id] = &tasks[i]
+	}
+	cur, err := j.env.DB().Collection(artifact.Collection).Find(ctx, artifact.UnexpiredFilesForTasks(taskIDs))
+	if err != nil {
+		return 0, errors.Wrap(err, "finding artifact entries with unexpired files")
+	}
+	entries := []artifact.Entry{}
+	if err = cur.All(ctx, &entries); err != nil {
+		return 0, errors.Wrap(err, "decoding artifact entries")
+	}
+
+	numExpired := 0
+	catcher := grip.NewBasicCatcher()
+	for i := range entries {
+		entry := &entries[i]
+		t, ok := tasksByID[entry.TaskId]
+		if !ok {
+			continue
+		}
+		// Entries created before their creation time was recorded fall back to
+		// the task's finish time.
+		createTime := entry.CreateTime
+		if utility.IsZeroTime(createTime) {
+			createTime = t.FinishTime
+		}
+		if utility.IsZeroTime(createTime) {
+			continue
+		}
+
+		for fileIdx := range entry.Files {
+			file := entry.Files[fileIdx]
+			// Files that were only linked to rather than uploaded to S3 are
+			// not stored by us, so there's nothing to delete.
+			if file.Expired || file.Bucket == "" || file.FileKey == "" {
+				continue
+			}
+			rule := policy.FindRule(file.Name, t.Requester)
+			if rule == nil || now.Sub(createTime) < rule.MaxAge() {
+				continue
+			}
+			if rule.KeepLastMainline > 0 && t.Requester == evergreen.RepotrackerVersionRequester {
+				recent, err := j.isRecentMainlineRun(ctx, t, rule.KeepLastMainline)
+				if err != nil {
+					catcher.Wrapf(err, "checking if task '%s' is a recent mainline run", t.Id)
+					continue
+				}
+				if recent {
+					continue
+				}
+			}
+
+			// Other artifacts may still link to the same file, in which case
+			// only this link expires and the file is deleted once the last
+			// link to it expires.
+			shared, err := entry.HasOtherUnexpiredReferences(ctx, file.Bucket, file.FileKey)
+			if err != nil {
+				catcher.Wrapf(err, "checking for other references to file '%s' for task '%s'", file.Name, t.Id)
+				continue
+			}
+			if !shared {
+				if err := j.deleteFile(ctx, file); err != nil {
+					catcher.Wrapf(err, "deleting file '%s' for task '%s'", file.Name, t.Id)
+					continue
+				}
+			}
+			if err := entry.MarkFileExpired(ctx, file.Name, file.Link, now); err != nil {
+				catcher.Add(err)
+				continue
+			}
+			numExpired++
+		}
+	}
+
+	return numExpired, catcher.Resolve()
+}
+
+// isRecentMainlineRun returns whether the task is one of the given number of
+// most recent finished mainline runs of the same task in its build variant.
+func (j *artifactRetentionJob) isRecentMainlineRun(ctx context.Context, t *task.Task, keep int) (bool, error) {
+	key := fmt.Sprintf("%s.%s.%s.%d", t.Project, t.BuildVariant, t.DisplayName, keep)
+	threshold, ok := j.mainlineThresholds[key]
+	if !ok {
+		recentTasks, err := task.FindAll(ctx, db.Query(bson.M{
+			task.ProjectKey:      t.Project,
+			task.BuildVariantKey: t.BuildVariant,
+			task.DisplayNameKey:  t.DisplayName,
+			task.RequesterKey:    evergreen.RepotrackerVersionRequester,
+			task.StatusKey:       bson.M{"$in": evergreen.TaskCompletedStatuses},
+		}).WithFields(task.RevisionOrderNumberKey).Sort([]string{"-" + task.RevisionOrderNumberKey}).Limit(keep))
+		if err != nil {
+			return false, errors.Wrap(err, "finding recent mainline tasks")
+		}
+		if len(recentTasks) == keep {
+			threshold = recentTasks[len(recentTasks)-1].RevisionOrderNumber
+		}
+		j.mainlineThresholds[key] = threshold
+	}
+
+	return t.RevisionOrderNumber >= threshold, nil
+}
+
+func (j *artifactRetentionJob) deleteFile(ctx context.Context, file artifact.File) error {
+	bucket, err := j.openBucket(ctx, file)
+	if err != nil {
+		return errors.Wrapf(err, "opening bucket '%s'", file.Bucket)
+	}
+
+	return errors.Wrapf(bucket.Remove(ctx, file.FileKey), "removing file '%s' from bucket '%s'", file.FileKey, file.Bucket)
+}
+
+// openS3Bucket opens the S3 bucket that the file was uploaded to, using the
+// same region and credentials that were used to upload it. Buckets that the
+// app server has access to are opened with the app server's own credentials.
+func (j *artifactRetentionJob) openS3Bucket(ctx context.Context, file artifact.File) (pail.Bucket, error) {
+	opts := pail.S3Options{
+		Name:   file.Bucket,
+		Region: file.Region,
+	}
+	// Files attached before their bucket's region was recorded were uploaded
+	// to the default region unless the upload specified otherwise.
+	if opts.Region == "" {
+		opts.Region = evergreen.DefaultEC2Region
+	}
+	switch {
+	case utility.StringSliceContains(j.env.Settings().Buckets.InternalBuckets, file.Bucket):
+	case file.AWSRoleARN != "":
+		opts.AssumeRoleARN = file.AWSRoleARN
+	case file.AWSKey != "" && file.AWSSecret != "":
+		opts.Credentials = pail.CreateAWSStaticCredentials(file.AWSKey, file.AWSSecret, "")
+	}
+
+	return pail.NewS3Bucket(ctx, opts)
+}
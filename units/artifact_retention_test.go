@@ -0,0 +1,219 @@
+package units
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/pail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactRetentionJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(task.Collection, artifact.Collection, artifact.RetentionPolicyCollection))
+	}
+	clearAll()
+	defer clearAll()
+
+	insertTask := func(t *testing.T, id, requester string, order int) {
+		tsk := task.Task{
+			Id:                  id,
+			Project:             "project",
+			BuildVariant:        "bv",
+			DisplayName:         "task",
+			Requester:           requester,
+			RevisionOrderNumber: order,
+			Status:              evergreen.TaskSucceeded,
+			FinishTime:          time.Now(),
+		}
+		require.NoError(t, tsk.Insert())
+	}
+	insertEntry := func(t *testing.T, bucket pail.Bucket, taskID string, createTime time.Time, files ...artifact.File) {
+		for _, file := range files {
+			if file.FileKey != "" {
+				require.NoError(t, bucket.Put(ctx, file.FileKey, strings.NewReader("artifact")))
+			}
+		}
+		entry := artifact.Entry{
+			TaskId:     taskID,
+			Files:      files,
+			CreateTime: createTime,
+		}
+		require.NoError(t, entry.Upsert())
+	}
+	findFile := func(t *testing.T, taskID string) artifact.File {
+		entry, err := artifact.FindOne(ctx, artifact.ByTaskId(taskID))
+		require.NoError(t, err)
+		require.NotZero(t, entry)
+		require.Len(t, entry.Files, 1)
+		return entry.Files[0]
+	}
+	runJob := func(t *testing.T, bucket pail.Bucket) {
+		j := NewArtifactRetentionJob("ts").(*artifactRetentionJob)
+		j.env = env
+		j.openBucket = func(context.Context, artifact.File) (pail.Bucket, error) {
+			return bucket, nil
+		}
+		j.Run(ctx)
+		require.NoError(t, j.Error())
+	}
+	setPolicy := func(t *testing.T, rules ...artifact.RetentionRule) {
+		require.NoError(t, artifact.SetRetentionPolicy(ctx, env, artifact.RetentionPolicy{ProjectID: "project", Rules: rules}))
+	}
+	s3File := func(name string) artifact.File {
+		return artifact.File{Name: name, Link: "https://bucket.s3.amazonaws.com/" + name, Bucket: "bucket", FileKey: name}
+	}
+	old := time.Now().Add(-10 * 24 * time.Hour)
+
+	for tName, tCase := range map[string]func(t *testing.T, bucket pail.Bucket){
+		"ExpiresOldFilesMatchingRule": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t, artifact.RetentionRule{NamePattern: "*.tgz", MaxAgeDays: 7})
+			insertTask(t, "t0", evergreen.PatchVersionRequester, 1)
+			insertEntry(t, bucket, "t0", old, s3File("dist.tgz"))
+
+			runJob(t, bucket)
+
+			file := findFile(t, "t0")
+			assert.True(t, file.Expired)
+			assert.False(t, file.ExpiredAt.IsZero())
+			exists, err := bucket.Exists(ctx, "dist.tgz")
+			require.NoError(t, err)
+			assert.False(t, exists)
+		},
+		"KeepsFilesYoungerThanMaxAge": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t, artifact.RetentionRule{MaxAgeDays: 30})
+			insertTask(t, "t0", evergreen.PatchVersionRequester, 1)
+			insertEntry(t, bucket, "t0", old, s3File("dist.tgz"))
+
+			runJob(t, bucket)
+
+			assert.False(t, findFile(t, "t0").Expired)
+			exists, err := bucket.Exists(ctx, "dist.tgz")
+			require.NoError(t, err)
+			assert.True(t, exists)
+		},
+		"KeepsFilesNotMatchingAnyRule": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t,
+				artifact.RetentionRule{NamePattern: "*.log", MaxAgeDays: 1},
+				artifact.RetentionRule{Requesters: []string{evergreen.PatchVersionRequester}, MaxAgeDays: 1},
+			)
+			insertTask(t, "t0", evergreen.RepotrackerVersionRequester, 1)
+			insertEntry(t, bucket, "t0", old, s3File("dist.tgz"))
+
+			runJob(t, bucket)
+
+			assert.False(t, findFile(t, "t0").Expired)
+		},
+		"UsesFirstMatchingRule": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t,
+				artifact.RetentionRule{NamePattern: "*.tgz", MaxAgeDays: 30},
+				artifact.RetentionRule{MaxAgeDays: 1},
+			)
+			insertTask(t, "t0", evergreen.PatchVersionRequester, 1)
+			insertEntry(t, bucket, "t0", old, s3File("dist.tgz"))
+
+			runJob(t, bucket)
+
+			assert.False(t, findFile(t, "t0").Expired)
+		},
+		"KeepsLastMainlineRuns": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t, artifact.RetentionRule{MaxAgeDays: 7, KeepLastMainline: 1})
+			insertTask(t, "t0", evergreen.RepotrackerVersionRequester, 1)
+			insertTask(t, "t1", evergreen.RepotrackerVersionRequester, 2)
+			insertEntry(t, bucket, "t0", old, s3File("t0.tgz"))
+			insertEntry(t, bucket, "t1", old, s3File("t1.tgz"))
+
+			runJob(t, bucket)
+
+			assert.True(t, findFile(t, "t0").Expired)
+			assert.False(t, findFile(t, "t1").Expired)
+		},
+		"KeepsFilesThatOtherArtifactsStillLinkTo": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t, artifact.RetentionRule{MaxAgeDays: 7})
+			insertTask(t, "t0", evergreen.PatchVersionRequester, 1)
+			insertTask(t, "t1", evergreen.PatchVersionRequester, 2)
+			insertEntry(t, bucket, "t0", old, s3File("dist.tgz"))
+			insertEntry(t, bucket, "t1", time.Now(), s3File("dist.tgz"))
+
+			runJob(t, bucket)
+
+			assert.True(t, findFile(t, "t0").Expired)
+			assert.False(t, findFile(t, "t1").Expired)
+			exists, err := bucket.Exists(ctx, "dist.tgz")
+			require.NoError(t, err)
+			assert.True(t, exists, "file should not be deleted while another artifact still links to it")
+		},
+		"DeletesSharedFileOnceAllLinksExpire": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t, artifact.RetentionRule{MaxAgeDays: 7})
+			insertTask(t, "t0", evergreen.PatchVersionRequester, 1)
+			insertTask(t, "t1", evergreen.PatchVersionRequester, 2)
+			insertEntry(t, bucket, "t0", old, s3File("dist.tgz"))
+			insertEntry(t, bucket, "t1", old, s3File("dist.tgz"))
+
+			runJob(t, bucket)
+
+			assert.True(t, findFile(t, "t0").Expired)
+			assert.True(t, findFile(t, "t1").Expired)
+			exists, err := bucket.Exists(ctx, "dist.tgz")
+			require.NoError(t, err)
+			assert.False(t, exists)
+		},
+		"IgnoresTasksInProjectsWithoutPolicy": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t, artifact.RetentionRule{MaxAgeDays: 1})
+			tsk := task.Task{
+				Id:         "other",
+				Project:    "other_project",
+				Requester:  evergreen.PatchVersionRequester,
+				Status:     evergreen.TaskSucceeded,
+				FinishTime: old,
+			}
+			require.NoError(t, tsk.Insert())
+			insertEntry(t, bucket, "other", old, s3File("dist.tgz"))
+
+			runJob(t, bucket)
+
+			assert.False(t, findFile(t, "other").Expired)
+			exists, err := bucket.Exists(ctx, "dist.tgz")
+			require.NoError(t, err)
+			assert.True(t, exists)
+		},
+		"IgnoresFilesNotUploadedToBucket": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t, artifact.RetentionRule{MaxAgeDays: 1})
+			insertTask(t, "t0", evergreen.PatchVersionRequester, 1)
+			insertEntry(t, bucket, "t0", old, artifact.File{Name: "docs", Link: "https://example.com/docs"})
+
+			runJob(t, bucket)
+
+			assert.False(t, findFile(t, "t0").Expired)
+		},
+		"FallsBackToTaskFinishTimeWithoutCreateTime": func(t *testing.T, bucket pail.Bucket) {
+			setPolicy(t, artifact.RetentionRule{MaxAgeDays: 1})
+			insertTask(t, "t0", evergreen.PatchVersionRequester, 1)
+			insertEntry(t, bucket, "t0", time.Time{}, s3File("dist.tgz"))
+
+			runJob(t, bucket)
+
+			assert.False(t, findFile(t, "t0").Expired)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: filepath.Join(t.TempDir(), "bucket"), UseSlash: true})
+			require.NoError(t, err)
+			tCase(t, bucket)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package units
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/buildcache"
+	"github.com/evergreen-ci/pail"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const buildCacheExpirationJobName = "build-cache-expiration"
+
+func init() {
+	registry.AddJobType(buildCacheExpirationJobName, func() amboy.Job {
+		return makeBuildCacheExpirationJob()
+	})
+}
+
+type buildCacheExpirationJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env        evergreen.Environment
+	openBucket func(context.Context, evergreen.BucketConfig) (pail.Bucket, error)
+}
+
+func makeBuildCacheExpirationJob() *buildCacheExpirationJob {
+	return &buildCacheExpirationJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    buildCacheExpirationJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewBuildCacheExpirationJob returns a job that removes the least recently
+// used build cache entries of each project whose entries exceed the
+// project size limit.
+func NewBuildCacheExpirationJob(ts string) amboy.Job {
+	j := makeBuildCacheExpirationJob()
+	j.SetID(fmt.Sprintf("%s.%s", buildCacheExpirationJobName, ts))
+	j.SetScopes([]string{buildCacheExpirationJobName})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *buildCacheExpirationJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+	if j.openBucket == nil {
+		j.openBucket = openBuildCacheBucket
+	}
+
+	conf := j.env.Settings().Buckets.BuildCache
+	if !conf.IsEnabled() {
+		return
+	}
+	limitBytes := int64(conf.ProjectSizeLimitMB) * 1024 * 1024
+
+	projects, err := buildcache.FindProjectsLargerThan(ctx, limitBytes)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	if len(projects) == 0 {
+		return
+	}
+
+	bucket, err := j.openBucket(ctx, conf.Bucket)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "opening build cache bucket '%s'", conf.Bucket.Name))
+		return
+	}
+
+	for _, p := range projects {
+		numRemoved, err := j.expireProject(ctx, bucket, p, limitBytes)
+		j.AddError(errors.Wrapf(err, "expiring build cache entries for project '%s'", p.ProjectID))
+		grip.Info(message.Fields{
+			"message":     "expired least recently used build cache entries",
+			"job_id":      j.ID(),
+			"project":     p.ProjectID,
+			"size_bytes":  p.SizeBytes,
+			"limit_bytes": limitBytes,
+			"num_removed": numRemoved,
+		})
+	}
+}
+
+// expireProject removes the project's least recently used entries until
+// the project is within the size limit, along with any archives that are no
+// longer used by any entries. It returns the number of entries removed.
+func (j *buildCacheExpirationJob) expireProject(ctx context.Context, bucket pail.Bucket, p buildcache.ProjectSize, limitBytes int64) (int, error) {
+	entries, err := buildcache.FindByProjectLeastRecentlyUsed(ctx, p.ProjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	size := p.SizeBytes
+	numRemoved := 0
+	for _, e := range entries {
+		if size <= limitBytes {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return numRemoved, err
+		}
+
+		referenced, err := buildcache.Remove(ctx, e)
+		if err != nil {
+			return numRemoved, err
+		}
+		size -= e.SizeBytes
+		numRemoved++
+		if referenced {
+			continue
+		}
+		blobKey := apimodels.BuildCacheBlobKey(e.ProjectID, e.SHA256)
+		if err := bucket.Remove(ctx, blobKey); err != nil {
+			return numRemoved, errors.Wrapf(err, "removing archive '%s'", blobKey)
+		}
+	}
+
+	return numRemoved, nil
+}
+
+// openBuildCacheBucket opens the build cache bucket with the app server's
+// own credentials.
+func openBuildCacheBucket(ctx context.Context, conf evergreen.BucketConfig) (pail.Bucket, error) {
+	switch conf.Type {
+	case evergreen.BucketTypeS3:
+		return pail.NewS3Bucket(ctx, pail.S3Options{
+			Name:   conf.Name,
+			Region: evergreen.DefaultEC2Region,
+		})
+	case evergreen.BucketTypeLocal:
+		return pail.NewLocalBucket(pail.LocalOptions{
+			Path:     conf.Name,
+			UseSlash: true,
+		})
+	default:
+		return nil, errors.Errorf("unsupported build cache bucket type '%s'", conf.Type)
+	}
+}
@@ -0,0 +1,78 @@
+package units
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/buildcache"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCacheExpirationJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	const mb = 1024 * 1024
+	bucketConf := evergreen.BucketConfig{Name: t.TempDir(), Type: evergreen.BucketTypeLocal}
+	env.Settings().Buckets.BuildCache = evergreen.BuildCacheConfig{Bucket: bucketConf, ProjectSizeLimitMB: 6}
+	bucket, err := openBuildCacheBucket(ctx, bucketConf)
+	require.NoError(t, err)
+
+	require.NoError(t, db.ClearCollections(buildcache.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(buildcache.Collection))
+	}()
+
+	// Entries are saved from least to most recently used.
+	for _, e := range []buildcache.Entry{
+		{ProjectID: "p1", Key: "oldest", SHA256: "shared", SizeBytes: 4 * mb},
+		{ProjectID: "p1", Key: "old", SHA256: "unique", SizeBytes: 4 * mb},
+		{ProjectID: "p1", Key: "new", SHA256: "shared", SizeBytes: 4 * mb},
+		{ProjectID: "p1", Key: "newest", SHA256: "latest", SizeBytes: 1 * mb},
+		{ProjectID: "p2", Key: "small", SHA256: "small", SizeBytes: 5 * mb},
+	} {
+		saved, err := buildcache.Save(ctx, e)
+		require.NoError(t, err)
+		require.True(t, saved)
+		require.NoError(t, bucket.Put(ctx, apimodels.BuildCacheBlobKey(e.ProjectID, e.SHA256), strings.NewReader("archive")))
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	j, ok := NewBuildCacheExpirationJob("ts").(*buildCacheExpirationJob)
+	require.True(t, ok)
+	j.env = env
+	j.Run(ctx)
+	require.NoError(t, j.Error())
+
+	for key, shouldExist := range map[string]bool{
+		"oldest": false,
+		"old":    false,
+		"new":    true,
+		"newest": true,
+	} {
+		e, err := buildcache.FindOne(ctx, "p1", key)
+		require.NoError(t, err)
+		assert.Equal(t, shouldExist, e != nil, key)
+	}
+	e, err := buildcache.FindOne(ctx, "p2", "small")
+	require.NoError(t, err)
+	assert.NotNil(t, e, "project within the size limit should not be expired")
+
+	for hash, shouldExist := range map[string]bool{
+		"shared": true,
+		"unique": false,
+		"latest": true,
+	} {
+		exists, err := bucket.Exists(ctx, apimodels.BuildCacheBlobKey("p1", hash))
+		require.NoError(t, err)
+		assert.Equal(t, shouldExist, exists, hash)
+	}
+}
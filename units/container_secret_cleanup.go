@@ -9,10 +9,12 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/cloud"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/pod"
 	"github.com/mongodb/amboy"
 	"github.com/mongodb/amboy/job"
 	"github.com/mongodb/amboy/registry"
 	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 	"github.com/pkg/errors"
 )
 
@@ -33,6 +35,9 @@ type containerSecretCleanupJob struct {
 	smClient  cocoa.SecretsManagerClient
 	vault     cocoa.Vault
 	tagClient cocoa.TagClient
+	// k8sClients are the Kubernetes clients for each Kubernetes container
+	// pool, keyed by pool ID.
+	k8sClients map[string]cloud.KubernetesClient
 }
 
 func makeContainerSecretCleanupJob() *containerSecretCleanupJob {
@@ -64,12 +69,21 @@ func (j *containerSecretCleanupJob) Run(ctx context.Context) {
 		return
 	}
 
+	j.AddError(j.cleanupSecretsManagerSecrets(ctx))
+
+	for poolID, client := range j.k8sClients {
+		j.AddError(errors.Wrapf(j.cleanupKubernetesSecrets(ctx, client), "cleaning up stranded Kubernetes secrets in container pool '%s'", poolID))
+	}
+}
+
+// cleanupSecretsManagerSecrets deletes the Secrets Manager secrets that are
+// not tracked by Evergreen.
+func (j *containerSecretCleanupJob) cleanupSecretsManagerSecrets(ctx context.Context) error {
 	secretIDs, err := cloud.GetFilteredResourceIDs(ctx, j.tagClient, []string{cloud.SecretsManagerResourceFilter}, map[string][]string{
 		model.ContainerSecretTag: {strconv.FormatBool(false)},
 	}, j.env.Settings().PodLifecycle.MaxSecretCleanupRate)
 	if err != nil {
-		j.AddError(errors.Wrap(err, "getting stranded Secrets Manager secrets"))
-		return
+		return errors.Wrap(err, "getting stranded Secrets Manager secrets")
 	}
 
 	catcher := grip.NewBasicCatcher()
@@ -77,7 +91,47 @@ func (j *containerSecretCleanupJob) Run(ctx context.Context) {
 		catcher.Wrapf(j.vault.DeleteSecret(ctx, secretID), "secret '%s'", secretID)
 	}
 
-	j.AddError(errors.Wrap(catcher.Resolve(), "deleting secrets"))
+	return errors.Wrap(catcher.Resolve(), "deleting secrets")
+}
+
+// cleanupKubernetesSecrets deletes the Kubernetes secrets managed by Evergreen
+// whose pods have already been terminated or no longer exist.
+func (j *containerSecretCleanupJob) cleanupKubernetesSecrets(ctx context.Context, client cloud.KubernetesClient) error {
+	secrets, err := client.ListSecrets(ctx, cloud.KubernetesManagedSecretSelector(), j.env.Settings().PodLifecycle.MaxSecretCleanupRate)
+	if err != nil {
+		return errors.Wrap(err, "listing Kubernetes secrets")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for _, s := range secrets {
+		podID := s.Metadata.Labels[cloud.KubernetesPodIDLabel]
+		if podID == "" {
+			continue
+		}
+		p, err := pod.FindOneByID(ctx, podID)
+		if err != nil {
+			catcher.Wrapf(err, "finding pod '%s' owning secret '%s'", podID, s.Metadata.Name)
+			continue
+		}
+		if p != nil && p.Status != pod.StatusTerminated {
+			continue
+		}
+
+		if err := client.DeleteSecret(ctx, s.Metadata.Name); err != nil {
+			catcher.Wrapf(err, "secret '%s'", s.Metadata.Name)
+			continue
+		}
+
+		grip.Info(message.Fields{
+			"message":   "deleted stranded Kubernetes secret",
+			"secret":    s.Metadata.Name,
+			"namespace": client.Namespace(),
+			"pod":       podID,
+			"job":       j.ID(),
+		})
+	}
+
+	return catcher.Resolve()
 }
 
 func (j *containerSecretCleanupJob) populate(ctx context.Context) error {
@@ -109,5 +163,16 @@ func (j *containerSecretCleanupJob) populate(ctx context.Context) error {
 		j.tagClient = client
 	}
 
+	if j.k8sClients == nil {
+		j.k8sClients = map[string]cloud.KubernetesClient{}
+		for _, pool := range j.env.Settings().ContainerPools.GetKubernetesPools() {
+			client, err := cloud.NewKubernetesClient(pool.Kubernetes)
+			if err != nil {
+				return errors.Wrapf(err, "initializing Kubernetes client for container pool '%s'", pool.Id)
+			}
+			j.k8sClients[pool.Id] = client
+		}
+	}
+
 	return nil
 }
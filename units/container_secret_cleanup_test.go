@@ -11,9 +11,12 @@ import (
 	secretsmanagerTypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
 	cocoaMock "github.com/evergreen-ci/cocoa/mock"
 	"github.com/evergreen-ci/cocoa/secret"
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/cloud"
+	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/mock"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/pod"
 	"github.com/evergreen-ci/evergreen/testutil"
 	"github.com/evergreen-ci/utility"
 	"github.com/stretchr/testify/assert"
@@ -128,3 +131,105 @@ func TestContainerSecretCleanupJob(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerSecretCleanupJobWithKubernetesSecrets(t *testing.T) {
+	defer cocoaMock.ResetGlobalSecretCache()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = testutil.TestSpan(ctx, t)
+
+	defer func() {
+		assert.NoError(t, db.ClearCollections(pod.Collection))
+	}()
+
+	const namespace = "evergreen"
+	makeSecret := func(podID string) cloud.KubernetesSecret {
+		return cloud.KubernetesSecret{
+			Metadata: cloud.KubernetesObjectMeta{
+				Name: cloud.KubernetesPodSecretName(podID),
+				Labels: map[string]string{
+					cloud.KubernetesManagedByLabel: cloud.KubernetesManagedByValue,
+					cloud.KubernetesPodIDLabel:     podID,
+				},
+			},
+		}
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, j *containerSecretCleanupJob, server *cloud.MockKubernetesAPIServer){
+		"DeletesSecretsForTerminatedAndNonexistentPods": func(ctx context.Context, t *testing.T, j *containerSecretCleanupJob, server *cloud.MockKubernetesAPIServer) {
+			terminated := pod.Pod{ID: "terminated", Status: pod.StatusTerminated}
+			require.NoError(t, terminated.Insert())
+			server.SetSecret(namespace, makeSecret(terminated.ID))
+			server.SetSecret(namespace, makeSecret("nonexistent"))
+
+			j.Run(ctx)
+			assert.NoError(t, j.Error())
+
+			_, ok := server.GetSecret(namespace, cloud.KubernetesPodSecretName(terminated.ID))
+			assert.False(t, ok, "secret for terminated pod should have been deleted")
+			_, ok = server.GetSecret(namespace, cloud.KubernetesPodSecretName("nonexistent"))
+			assert.False(t, ok, "secret for nonexistent pod should have been deleted")
+		},
+		"KeepsSecretsForActivePods": func(ctx context.Context, t *testing.T, j *containerSecretCleanupJob, server *cloud.MockKubernetesAPIServer) {
+			running := pod.Pod{ID: "running", Status: pod.StatusRunning}
+			require.NoError(t, running.Insert())
+			server.SetSecret(namespace, makeSecret(running.ID))
+
+			j.Run(ctx)
+			assert.NoError(t, j.Error())
+
+			_, ok := server.GetSecret(namespace, cloud.KubernetesPodSecretName(running.ID))
+			assert.True(t, ok, "secret for running pod should still exist")
+		},
+		"KeepsSecretsNotManagedByEvergreen": func(ctx context.Context, t *testing.T, j *containerSecretCleanupJob, server *cloud.MockKubernetesAPIServer) {
+			server.SetSecret(namespace, cloud.KubernetesSecret{Metadata: cloud.KubernetesObjectMeta{Name: "unmanaged"}})
+
+			j.Run(ctx)
+			assert.NoError(t, j.Error())
+
+			_, ok := server.GetSecret(namespace, "unmanaged")
+			assert.True(t, ok, "unmanaged secret should still exist")
+		},
+		"FailsWhenAPIServerErrors": func(ctx context.Context, t *testing.T, j *containerSecretCleanupJob, server *cloud.MockKubernetesAPIServer) {
+			server.FailRequests = true
+
+			j.Run(ctx)
+			assert.Error(t, j.Error())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			tctx := testutil.TestSpan(ctx, t)
+
+			cocoaMock.ResetGlobalSecretCache()
+			require.NoError(t, db.ClearCollections(pod.Collection))
+
+			server := cloud.NewMockKubernetesAPIServer()
+			defer server.Close()
+
+			j, ok := NewContainerSecretCleanupJob(utility.RoundPartOfHour(0).Format(TSFormat)).(*containerSecretCleanupJob)
+			require.True(t, ok)
+			j.tagClient = &cocoaMock.TagClient{}
+			j.smClient = &cocoaMock.SecretsManagerClient{}
+			v, err := secret.NewBasicSecretsManager(*secret.NewBasicSecretsManagerOptions().
+				SetClient(j.smClient).
+				SetCache(&cloud.NoopSecretCache{Tag: model.ContainerSecretTag}))
+			require.NoError(t, err)
+			j.vault = cocoaMock.NewVault(v)
+
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(tctx))
+			env.EvergreenSettings.PodLifecycle.MaxSecretCleanupRate = 1000
+			env.EvergreenSettings.ContainerPools.Pools = []evergreen.ContainerPool{
+				{
+					Id:         "pool",
+					Provider:   evergreen.PodProviderKubernetes,
+					Kubernetes: server.Config(namespace),
+				},
+			}
+			j.env = env
+
+			tCase(tctx, t, j, server)
+		})
+	}
+}
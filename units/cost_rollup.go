@@ -0,0 +1,246 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/pod"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	costRollupJobName = "cost-rollup"
+
+	// costRollupTaskBatchSize is the number of finished tasks whose hosts,
+	// pods and patch authors are looked up at once.
+	costRollupTaskBatchSize = 1000
+)
+
+func init() {
+	registry.AddJobType(costRollupJobName, func() amboy.Job {
+		return makeCostRollupJob()
+	})
+}
+
+type costRollupJob struct {
+	Date     time.Time `bson:"date" json:"date" yaml:"date"`
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env evergreen.Environment
+}
+
+func makeCostRollupJob() *costRollupJob {
+	return &costRollupJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    costRollupJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewCostRollupJob returns a job that computes the cost of the tasks that
+// finished and the spawn hosts that ran during the UTC day containing the
+// given date and replaces that day's stored costs.
+func NewCostRollupJob(date time.Time, ts string) amboy.Job {
+	j := makeCostRollupJob()
+	j.Date = utility.GetUTCDay(date)
+	j.SetID(fmt.Sprintf("%s.%s.%s", costRollupJobName, j.Date.Format(time.DateOnly), ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", costRollupJobName, j.Date.Format(time.DateOnly))})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *costRollupJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	now := time.Now()
+	conf := j.env.Settings().Cost
+	rollup := cost.NewRollup(j.Date)
+
+	if err := j.addTaskCosts(ctx, conf, rollup); err != nil {
+		j.AddError(errors.Wrap(err, "adding task costs"))
+		return
+	}
+	if err := j.addSpawnHostCosts(ctx, conf, rollup, now); err != nil {
+		j.AddError(errors.Wrap(err, "adding spawn host costs"))
+		return
+	}
+	if err := rollup.Save(ctx, j.env); err != nil {
+		j.AddError(err)
+		return
+	}
+
+	grip.Info(message.Fields{
+		"message":   "rolled up daily costs",
+		"job_id":    j.ID(),
+		"date":      j.Date,
+		"num_costs": len(rollup.Costs()),
+	})
+}
+
+// addTaskCosts adds the costs of all of the task executions that finished
+// during the rollup's day. The tasks are read with a cursor and their hosts,
+// pods and patch authors are looked up in batches so that busy days don't
+// have to fit in memory at once.
+func (j *costRollupJob) addTaskCosts(ctx context.Context, conf evergreen.CostConfig, rollup *cost.Rollup) error {
+	query := bson.M{
+		task.FinishTimeKey:  bson.M{"$gte": j.Date, "$lt": j.Date.Add(24 * time.Hour)},
+		task.StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		task.DisplayOnlyKey: bson.M{"$ne": true},
+	}
+	opts := options.Find().SetProjection(bson.M{
+		task.IdKey:           1,
+		task.ProjectKey:      1,
+		task.BuildVariantKey: 1,
+		task.RequesterKey:    1,
+		task.VersionKey:      1,
+		task.StartTimeKey:    1,
+		task.FinishTimeKey:   1,
+		task.HostIdKey:       1,
+		task.PodIDKey:        1,
+	})
+
+	for _, collection := range []string{task.Collection, task.OldCollection} {
+		if err := j.addTaskCostsFromCollection(ctx, conf, rollup, collection, query, opts); err != nil {
+			return errors.Wrapf(err, "adding costs of tasks in collection '%s'", collection)
+		}
+	}
+
+	return nil
+}
+
+// addTaskCostsFromCollection adds the costs of the finished task executions in
+// the given task collection that match the query.
+func (j *costRollupJob) addTaskCostsFromCollection(ctx context.Context, conf evergreen.CostConfig, rollup *cost.Rollup, collection string, query bson.M, opts *options.FindOptions) error {
+	cur, err := j.env.DB().Collection(collection).Find(ctx, query, opts)
+	if err != nil {
+		return errors.Wrap(err, "finding finished tasks")
+	}
+	defer cur.Close(ctx)
+
+	tasks := make([]task.Task, 0, costRollupTaskBatchSize)
+	for cur.Next(ctx) {
+		t := task.Task{}
+		if err = cur.Decode(&t); err != nil {
+			return errors.Wrap(err, "decoding finished task")
+		}
+		tasks = append(tasks, t)
+		if len(tasks) < costRollupTaskBatchSize {
+			continue
+		}
+		if err = addTaskBatchCosts(ctx, conf, rollup, tasks); err != nil {
+			return err
+		}
+		tasks = tasks[:0]
+	}
+	if err = cur.Err(); err != nil {
+		return errors.Wrap(err, "iterating over finished tasks")
+	}
+
+	return addTaskBatchCosts(ctx, conf, rollup, tasks)
+}
+
+// addTaskBatchCosts adds the costs of a batch of finished task executions.
+func addTaskBatchCosts(ctx context.Context, conf evergreen.CostConfig, rollup *cost.Rollup, tasks []task.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	var hostIDs, podIDs, patchVersionIDs []string
+	for _, t := range tasks {
+		if t.HostId != "" {
+			hostIDs = append(hostIDs, t.HostId)
+		}
+		if t.PodID != "" {
+			podIDs = append(podIDs, t.PodID)
+		}
+		if evergreen.IsPatchRequester(t.Requester) {
+			patchVersionIDs = append(patchVersionIDs, t.Version)
+		}
+	}
+
+	hostRates := map[string]float64{}
+	if len(hostIDs) > 0 {
+		hosts, err := host.Find(ctx, host.ByIds(utility.UniqueStrings(hostIDs)))
+		if err != nil {
+			return errors.Wrap(err, "finding task hosts")
+		}
+		for i := range hosts {
+			hostRates[hosts[i].Id] = cost.HostHourlyRate(conf, &hosts[i])
+		}
+	}
+	podRates := map[string]float64{}
+	if len(podIDs) > 0 {
+		pods, err := pod.Find(db.Query(bson.M{pod.IDKey: bson.M{"$in": utility.UniqueStrings(podIDs)}}))
+		if err != nil {
+			return errors.Wrap(err, "finding task pods")
+		}
+		for i := range pods {
+			podRates[pods[i].ID] = cost.PodHourlyRate(conf, &pods[i])
+		}
+	}
+	authors := map[string]string{}
+	if len(patchVersionIDs) > 0 {
+		versions, err := model.VersionFind(model.VersionByIds(utility.UniqueStrings(patchVersionIDs)).WithFields(model.VersionIdKey, model.VersionAuthorKey))
+		if err != nil {
+			return errors.Wrap(err, "finding patch versions")
+		}
+		for _, v := range versions {
+			authors[v.Id] = v.Author
+		}
+	}
+
+	for i := range tasks {
+		t := &tasks[i]
+		rate := hostRates[t.HostId]
+		if t.PodID != "" {
+			rate = podRates[t.PodID]
+		}
+		rollup.AddTask(t, authors[t.Version], rate)
+	}
+
+	return nil
+}
+
+// addSpawnHostCosts adds the costs of all of the spawn hosts that were
+// running during the rollup's day.
+func (j *costRollupJob) addSpawnHostCosts(ctx context.Context, conf evergreen.CostConfig, rollup *cost.Rollup, now time.Time) error {
+	hosts, err := host.Find(ctx, bson.M{
+		host.UserHostKey:  true,
+		host.StartedByKey: bson.M{"$ne": evergreen.User},
+		host.StartTimeKey: bson.M{"$lt": j.Date.Add(24 * time.Hour)},
+		"$or": []bson.M{
+			{host.StatusKey: bson.M{"$ne": evergreen.HostTerminated}},
+			{host.TerminationTimeKey: bson.M{"$gte": j.Date}},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "finding spawn hosts")
+	}
+
+	for i := range hosts {
+		rollup.AddSpawnHost(&hosts[i], cost.HostHourlyRate(conf, &hosts[i]), now)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/pod"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostRollupJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(task.Collection, task.OldCollection, host.Collection, pod.Collection, model.VersionCollection, cost.DailyCostCollection))
+	}
+	clearAll()
+	defer clearAll()
+
+	day := time.Now().UTC().Add(-24 * time.Hour).Truncate(24 * time.Hour)
+	conf := env.Settings().Cost
+	findCosts := func(t *testing.T, projectID string) []cost.DailyCost {
+		costs, err := cost.FindProjectDailyCosts(ctx, env, projectID, day, day)
+		require.NoError(t, err)
+		return costs
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"AttributesHostTaskCostsToPatchAuthors": func(t *testing.T) {
+			h := host.Host{Id: "h0", Provider: evergreen.ProviderNameEc2OnDemand, InstanceType: "m5.xlarge"}
+			require.NoError(t, h.Insert(ctx))
+			v := model.Version{Id: "v0", Author: "author"}
+			require.NoError(t, v.Insert())
+			tsk := task.Task{
+				Id:           "t0",
+				Project:      "project",
+				BuildVariant: "variant",
+				Version:      "v0",
+				Requester:    evergreen.PatchVersionRequester,
+				Status:       evergreen.TaskSucceeded,
+				HostId:       "h0",
+				StartTime:    day.Add(time.Hour),
+				FinishTime:   day.Add(3 * time.Hour),
+			}
+			require.NoError(t, tsk.Insert())
+
+			j := NewCostRollupJob(day, "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			costs := findCosts(t, "project")
+			require.Len(t, costs, 1)
+			assert.Equal(t, "variant", costs[0].ID.BuildVariant)
+			assert.Equal(t, "author", costs[0].ID.User)
+			assert.InDelta(t, 2*conf.HourlyRate("m5.xlarge", false), costs[0].Cost, 1e-9)
+			assert.Equal(t, 1, costs[0].NumTasks)
+		},
+		"IncludesPreviousExecutions": func(t *testing.T) {
+			h := host.Host{Id: "h0", Provider: evergreen.ProviderNameEc2OnDemand, InstanceType: "m5.xlarge"}
+			require.NoError(t, h.Insert(ctx))
+			tsk := task.Task{Id: "t0", Project: "project", Requester: evergreen.RepotrackerVersionRequester, Status: evergreen.TaskFailed, HostId: "h0", StartTime: day, FinishTime: day.Add(time.Hour)}
+			require.NoError(t, tsk.Insert())
+			oldTask := task.Task{Id: "t0_0", OldTaskId: "t0", Project: "project", Requester: evergreen.RepotrackerVersionRequester, Status: evergreen.TaskFailed, HostId: "h0", StartTime: day, FinishTime: day.Add(time.Hour)}
+			require.NoError(t, db.Insert(task.OldCollection, &oldTask))
+
+			j := NewCostRollupJob(day, "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			costs := findCosts(t, "project")
+			require.Len(t, costs, 1)
+			assert.Equal(t, 2, costs[0].NumTasks)
+			assert.Equal(t, 2*time.Hour, costs[0].Runtime)
+		},
+		"IgnoresTasksFinishedOnOtherDays": func(t *testing.T) {
+			tsk := task.Task{
+				Id:         "t0",
+				Project:    "project",
+				Status:     evergreen.TaskSucceeded,
+				StartTime:  day.Add(-3 * time.Hour),
+				FinishTime: day.Add(-time.Hour),
+			}
+			require.NoError(t, tsk.Insert())
+
+			j := NewCostRollupJob(day, "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			assert.Empty(t, findCosts(t, "project"))
+		},
+		"AttributesSpawnHostCostsToOwners": func(t *testing.T) {
+			h := host.Host{
+				Id:           "h0",
+				Provider:     evergreen.ProviderNameEc2OnDemand,
+				InstanceType: "m5.xlarge",
+				UserHost:     true,
+				StartedBy:    "owner",
+				Status:       evergreen.HostStopped,
+				StartTime:    day.Add(-time.Hour),
+			}
+			require.NoError(t, h.Insert(ctx))
+
+			j := NewCostRollupJob(day, "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			costs, err := cost.FindUserDailyCosts(ctx, env, "owner", day, day)
+			require.NoError(t, err)
+			require.Len(t, costs, 1)
+			assert.Equal(t, cost.CostTypeSpawnHost, costs[0].ID.Type)
+			assert.InDelta(t, 24*conf.HourlyRate("m5.xlarge", false), costs[0].Cost, 1e-9)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			tCase(t)
+		})
+	}
+}
@@ -262,6 +262,14 @@ func lastContainerFinishTimeJobs(ctx context.Context, _ evergreen.Environment, t
 	return []amboy.Job{NewLastContainerFinishTimeJob(ts.Format(TSFormat))}, nil
 }
 
+func culpritFinderJobs(ctx context.Context, _ evergreen.Environment, ts time.Time) ([]amboy.Job, error) {
+	return []amboy.Job{NewCulpritFinderJob(ts.Format(TSFormat))}, nil
+}
+
+func mergeQueueBatchJobs(ctx context.Context, _ evergreen.Environment, ts time.Time) ([]amboy.Job, error) {
+	return []amboy.Job{NewMergeQueueBatchJob(ts.Format(TSFormat))}, nil
+}
+
 func parentDecommissionJobs(ctx context.Context, _ evergreen.Environment, ts time.Time) ([]amboy.Job, error) {
 	settings, err := evergreen.GetConfig(ctx)
 	if err != nil {
@@ -859,6 +867,83 @@ func PopulateCacheHistoricalTaskDataJob(part int) amboy.QueueOperation {
 	}
 }
 
+// PopulateFlakyTestDetectionJobs enqueues a daily job for each enabled project
+// to detect and quarantine its flaky tests.
+func PopulateFlakyTestDetectionJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		projects, err := model.FindAllMergedTrackedProjectRefs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "finding tracked projects")
+		}
+		// Although we don't run this hourly, we still queue hourly to improve resiliency.
+		ts := utility.RoundPartOfDay(0).Format(TSFormat)
+
+		catcher := grip.NewBasicCatcher()
+		for _, project := range projects {
+			if !project.Enabled {
+				continue
+			}
+
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewFlakyTestDetectionJob(project.Id, ts)), "enqueueing flaky test detection job for project '%s'", project.Identifier)
+		}
+
+		return catcher.Resolve()
+	}
+}
+
+func PopulateTestSelectionLearningJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		projects, err := model.FindAllMergedTrackedProjectRefs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "finding tracked projects")
+		}
+		ts := utility.RoundPartOfHour(0).Format(TSFormat)
+
+		catcher := grip.NewBasicCatcher()
+		for _, project := range projects {
+			if !project.Enabled || !project.IsTestSelectionEnabled() {
+				continue
+			}
+
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewTestSelectionLearningJob(project.Id, ts)), "enqueueing test selection learning job for project '%s'", project.Identifier)
+		}
+
+		return catcher.Resolve()
+	}
+}
+
+func PopulateCostRollupJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		now := time.Now()
+		catcher := grip.NewBasicCatcher()
+		// Roll up today's costs hourly so that budgets are enforced promptly,
+		// and roll up yesterday's costs once more to include the tasks that
+		// finished after the last hourly rollup.
+		catcher.Wrap(amboy.EnqueueUniqueJob(ctx, queue, NewCostRollupJob(now, utility.RoundPartOfHour(0).Format(TSFormat))), "enqueueing cost rollup job for today")
+		catcher.Wrap(amboy.EnqueueUniqueJob(ctx, queue, NewCostRollupJob(now.Add(-24*time.Hour), utility.RoundPartOfDay(0).Format(TSFormat))), "enqueueing cost rollup job for yesterday")
+
+		return catcher.Resolve()
+	}
+}
+
+func PopulateArtifactRetentionJob() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		return amboy.EnqueueUniqueJob(ctx, queue, NewArtifactRetentionJob(utility.RoundPartOfHour(0).Format(TSFormat)))
+	}
+}
+
+func PopulateBuildCacheExpirationJob() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		return amboy.EnqueueUniqueJob(ctx, queue, NewBuildCacheExpirationJob(utility.RoundPartOfHour(0).Format(TSFormat)))
+	}
+}
+
+func PopulateTaskCheckpointExpirationJob() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		return amboy.EnqueueUniqueJob(ctx, queue, NewTaskCheckpointExpirationJob(utility.RoundPartOfHour(0).Format(TSFormat)))
+	}
+}
+
 func PopulateSpawnhostExpirationCheckJob() amboy.QueueOperation {
 	return func(ctx context.Context, queue amboy.Queue) error {
 		hosts, err := host.FindSpawnhostsWithNoExpirationToExtend(ctx)
@@ -1145,6 +1230,11 @@ func podDefinitionCreationJobs(ctx context.Context, env evergreen.Environment, t
 
 	jobs := make([]amboy.Job, 0, len(pods))
 	for _, p := range pods {
+		if p.IsKubernetes() {
+			// Kubernetes pods are created directly from their container
+			// options, so they don't need a pod definition.
+			continue
+		}
 		jobs = append(jobs, NewPodDefinitionCreationJob(env.Settings().Providers.AWS.Pod.ECS, p.TaskContainerCreationOpts, ts.Format(TSFormat)))
 	}
 
@@ -47,6 +47,12 @@ func (j *cronsRemoteHourJob) Run(ctx context.Context) {
 
 	ops := []amboy.QueueOperation{
 		PopulateCacheHistoricalTaskDataJob(2),
+		PopulateFlakyTestDetectionJobs(),
+		PopulateTestSelectionLearningJobs(),
+		PopulateCostRollupJobs(),
+		PopulateArtifactRetentionJob(),
+		PopulateBuildCacheExpirationJob(),
+		PopulateTaskCheckpointExpirationJob(),
 		PopulateSpawnhostExpirationCheckJob(),
 		PopulateCloudCleanupJob(j.env),
 		PopulateVolumeExpirationCheckJob(),
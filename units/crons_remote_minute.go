@@ -49,9 +49,11 @@ func (j *cronsRemoteMinuteJob) Run(ctx context.Context) {
 		"host ready":                 hostReadyJob,
 		"background stats":           backgroundStatsJobs,
 		"container state":            containerStateJobs,
+		"culprit finder":             culpritFinderJobs,
 		"event send":                 sendNotificationJobs,
 		"host monitoring":            hostMonitoringJobs,
 		"last container finish time": lastContainerFinishTimeJobs,
+		"merge queue batch":          mergeQueueBatchJobs,
 		"oldest image removal":       oldestImageRemovalJobs,
 		"parent decommission":        parentDecommissionJobs,
 		"periodic notification":      periodicNotificationJobs,
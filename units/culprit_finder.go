@@ -0,0 +1,64 @@
+package units
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/pkg/errors"
+)
+
+const culpritFinderJobName = "culprit-finder"
+
+func init() {
+	registry.AddJobType(culpritFinderJobName, func() amboy.Job {
+		return makeCulpritFinderJob()
+	})
+}
+
+type culpritFinderJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env evergreen.Environment
+}
+
+func makeCulpritFinderJob() *culpritFinderJob {
+	return &culpritFinderJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    culpritFinderJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewCulpritFinderJob returns a job that advances every running culprit
+// search, activating the next task to bisect once the previous one finishes.
+func NewCulpritFinderJob(ts string) amboy.Job {
+	j := makeCulpritFinderJob()
+	j.SetID(fmt.Sprintf("%s.%s", culpritFinderJobName, ts))
+	j.SetScopes([]string{culpritFinderJobName})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *culpritFinderJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	searches, err := model.FindRunningCulpritSearches(ctx)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	for i := range searches {
+		j.AddError(errors.Wrapf(searches[i].Advance(ctx, j.env), "advancing culprit search '%s'", searches[i].ID))
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/githubapp"
 	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/amboy"
 	"github.com/mongodb/amboy/job"
@@ -131,6 +133,10 @@ func (j *eventSendJob) send(ctx context.Context, n *notification.Notification) e
 		return errors.Wrap(err, "getting sender key for notification")
 	}
 
+	if key == evergreen.SenderGitLabStatus {
+		return errors.Wrap(j.sendGitLabStatus(ctx, n), "sending GitLab status")
+	}
+
 	var sender send.Sender
 	if key == evergreen.SenderGithubStatus {
 		payload, ok := n.Payload.(*message.GithubStatus)
@@ -151,9 +157,65 @@ func (j *eventSendJob) send(ctx context.Context, n *notification.Notification) e
 	return nil
 }
 
+// sendGitLabStatus posts the notification's status to the GitLab merge
+// request's head commit using the project's GitLab token. When the patch as a
+// whole finishes, it also leaves a note on the merge request.
+func (j *eventSendJob) sendGitLabStatus(ctx context.Context, n *notification.Notification) error {
+	sub, ok := n.Subscriber.Target.(*event.GitLabMergeRequestSubscriber)
+	if !ok || sub == nil {
+		return errors.New("gitlab-merge-request subscriber is invalid")
+	}
+	payload, ok := n.Payload.(*message.GithubStatus)
+	if !ok || payload == nil {
+		return errors.New("gitlab-merge-request payload is invalid")
+	}
+
+	pRef, err := model.FindMergedProjectRef(ctx, sub.ProjectID, "", false)
+	if err != nil {
+		return errors.Wrapf(err, "finding project ref '%s'", sub.ProjectID)
+	}
+	if pRef == nil {
+		return errors.Errorf("project ref '%s' not found", sub.ProjectID)
+	}
+	if !pRef.GitLab.IsEnabled() {
+		return errors.Errorf("project ref '%s' does not have GitLab enabled", sub.ProjectID)
+	}
+
+	client := pRef.GitLab.Client()
+	status := thirdparty.GitLabCommitStatus{
+		State:       gitLabStateFromGithubState(payload.State),
+		Name:        payload.Context,
+		TargetURL:   payload.URL,
+		Description: payload.Description,
+	}
+	if err = client.SetCommitStatus(ctx, sub.ProjectPath, sub.Ref, status); err != nil {
+		return err
+	}
+
+	if payload.Context != thirdparty.GithubStatusDefaultContext || payload.State == message.GithubStatePending {
+		return nil
+	}
+	note := fmt.Sprintf("Evergreen patch %s: %s\n\n%s", status.State, payload.Description, payload.URL)
+	return errors.Wrap(client.CreateMergeRequestNote(ctx, sub.ProjectPath, sub.IID, note), "creating merge request note")
+}
+
+// gitLabStateFromGithubState converts the state of a GitHub status, which
+// notifications are built with, to the equivalent GitLab commit status state.
+func gitLabStateFromGithubState(state message.GithubState) string {
+	switch state {
+	case message.GithubStatePending:
+		return thirdparty.GitLabStatusPending
+	case message.GithubStateSuccess:
+		return thirdparty.GitLabStatusSuccess
+	default:
+		return thirdparty.GitLabStatusFailed
+	}
+}
+
 func (j *eventSendJob) checkDegradedMode(n *notification.Notification) error {
 	switch n.Subscriber.Type {
-	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
+	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType,
+		event.GitLabMergeRequestSubscriberType:
 		return checkFlag(j.flags.GithubStatusAPIDisabled)
 
 	case event.SlackSubscriberType:
@@ -0,0 +1,152 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	flakyTestDetectionJobName = "flaky-test-detection"
+
+	// flakyTestDetectionWindow is how far back the job looks for retried
+	// tasks.
+	flakyTestDetectionWindow = 14 * 24 * time.Hour
+	// flakyTestDetectionMaxTasks is the maximum number of retried tasks the
+	// job analyzes for a single project.
+	flakyTestDetectionMaxTasks = 2000
+)
+
+func init() {
+	registry.AddJobType(flakyTestDetectionJobName, func() amboy.Job {
+		return makeFlakyTestDetectionJob()
+	})
+}
+
+type flakyTestDetectionJob struct {
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env evergreen.Environment
+}
+
+func makeFlakyTestDetectionJob() *flakyTestDetectionJob {
+	return &flakyTestDetectionJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    flakyTestDetectionJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewFlakyTestDetectionJob returns a job that scores the flakiness of the
+// project's tests based on the results of its recently retried mainline tasks
+// and updates the project's automatically quarantined tests.
+func NewFlakyTestDetectionJob(projectID, ts string) amboy.Job {
+	j := makeFlakyTestDetectionJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", flakyTestDetectionJobName, projectID, ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", flakyTestDetectionJobName, projectID)})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *flakyTestDetectionJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	runs, err := j.getTaskRuns(ctx)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+
+	scores := testresult.ComputeFlakiness(runs)
+	if err = testresult.SetDetectedFlakyTests(ctx, j.env, j.ProjectID, scores, testresult.DefaultFlakinessThreshold, testresult.DefaultMinFlakyRuns); err != nil {
+		j.AddError(err)
+		return
+	}
+
+	grip.Info(message.Fields{
+		"message":     "scored test flakiness",
+		"job_id":      j.ID(),
+		"project":     j.ProjectID,
+		"num_runs":    len(runs),
+		"num_scored":  len(scores),
+		"window_days": flakyTestDetectionWindow.Hours() / 24,
+	})
+}
+
+// getTaskRuns returns the test results, across all executions, of each of the
+// project's mainline tasks that were retried within the detection window.
+func (j *flakyTestDetectionJob) getTaskRuns(ctx context.Context) ([][]testresult.TestResult, error) {
+	tasks, err := task.FindAll(ctx, db.Query(bson.M{
+		task.ProjectKey:     j.ProjectID,
+		task.RequesterKey:   bson.M{"$in": evergreen.SystemVersionRequesterTypes},
+		task.ExecutionKey:   bson.M{"$gt": 0},
+		task.FinishTimeKey:  bson.M{"$gte": time.Now().Add(-flakyTestDetectionWindow)},
+		task.StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		task.DisplayOnlyKey: bson.M{"$ne": true},
+	}).Sort([]string{"-" + task.FinishTimeKey}).Limit(flakyTestDetectionMaxTasks))
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding retried tasks for project '%s'", j.ProjectID)
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+
+	taskIDs := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		taskIDs = append(taskIDs, t.Id)
+	}
+	oldTasks, err := task.FindAllOld(ctx, db.Query(bson.M{task.OldTaskIdKey: bson.M{"$in": taskIDs}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding previous executions of retried tasks")
+	}
+	executions := map[string][]task.Task{}
+	for _, t := range oldTasks {
+		executions[t.OldTaskId] = append(executions[t.OldTaskId], t)
+	}
+
+	runs := make([][]testresult.TestResult, 0, len(tasks))
+	for _, t := range tasks {
+		var run []testresult.TestResult
+		for _, execution := range append(executions[t.Id], t) {
+			results, err := execution.GetTestResults(ctx, j.env, nil)
+			if err != nil {
+				// A single task's results being unavailable should not
+				// prevent scoring the rest of the project's tests.
+				grip.Warning(message.WrapError(err, message.Fields{
+					"message":   "could not get test results for task execution",
+					"job_id":    j.ID(),
+					"project":   j.ProjectID,
+					"task":      t.Id,
+					"execution": execution.Execution,
+				}))
+				continue
+			}
+			run = append(run, results.Results...)
+		}
+		if len(run) > 0 {
+			runs = append(runs, run)
+		}
+	}
+
+	return runs, nil
+}
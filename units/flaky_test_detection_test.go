@@ -0,0 +1,120 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlakyTestDetectionJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(task.Collection, task.OldCollection, testresult.QuarantineCollection))
+		require.NoError(t, testresult.ClearLocal(ctx, env))
+	}
+	clearAll()
+	defer clearAll()
+
+	// insertRetriedTask inserts a mainline task whose test with the given
+	// name had the given status in each of its executions.
+	insertRetriedTask := func(t *testing.T, id, projectID, testName string, statuses ...string) {
+		for execution, status := range statuses {
+			tsk := task.Task{
+				Id:             id,
+				Project:        projectID,
+				Requester:      evergreen.RepotrackerVersionRequester,
+				Execution:      execution,
+				Status:         evergreen.TaskFailed,
+				FinishTime:     time.Now(),
+				ResultsService: testresult.TestResultsServiceLocal,
+			}
+			if execution < len(statuses)-1 {
+				tsk.Id = fmt.Sprintf("%s_%d", id, execution)
+				tsk.OldTaskId = id
+				tsk.Archived = true
+				require.NoError(t, db.Insert(task.OldCollection, &tsk))
+			} else {
+				require.NoError(t, tsk.Insert())
+			}
+
+			require.NoError(t, testresult.InsertLocal(ctx, env, testresult.TestResult{
+				TaskID:    id,
+				Execution: execution,
+				TestName:  testName,
+				Status:    status,
+			}))
+		}
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"QuarantinesTestsThatFlipOnRetry": func(t *testing.T) {
+			insertRetriedTask(t, "t0", "project", "flaky", evergreen.TestFailedStatus, evergreen.TestSucceededStatus)
+			insertRetriedTask(t, "t1", "project", "flaky", evergreen.TestFailedStatus, evergreen.TestFailedStatus, evergreen.TestSucceededStatus)
+			insertRetriedTask(t, "t2", "project", "broken", evergreen.TestFailedStatus, evergreen.TestFailedStatus)
+			insertRetriedTask(t, "t3", "project", "broken", evergreen.TestFailedStatus, evergreen.TestFailedStatus)
+
+			j := NewFlakyTestDetectionJob("project", "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			q, err := testresult.FindQuarantine(ctx, env, "project")
+			require.NoError(t, err)
+			require.Len(t, q.Detected, 1)
+			assert.Equal(t, "flaky", q.Detected[0].TestName)
+			assert.Equal(t, 2, q.Detected[0].NumRuns)
+			assert.Equal(t, 2, q.Detected[0].NumFlakyRuns)
+			assert.Equal(t, 1.0, q.Detected[0].Score)
+		},
+		"IgnoresTestsThatFlipTooRarely": func(t *testing.T) {
+			insertRetriedTask(t, "t0", "project", "flaky", evergreen.TestFailedStatus, evergreen.TestSucceededStatus)
+
+			j := NewFlakyTestDetectionJob("project", "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			names, err := testresult.FindQuarantinedTestNames(ctx, env, "project")
+			require.NoError(t, err)
+			assert.Empty(t, names)
+		},
+		"IgnoresOtherProjects": func(t *testing.T) {
+			insertRetriedTask(t, "t0", "other_project", "flaky", evergreen.TestFailedStatus, evergreen.TestSucceededStatus)
+			insertRetriedTask(t, "t1", "other_project", "flaky", evergreen.TestFailedStatus, evergreen.TestSucceededStatus)
+
+			j := NewFlakyTestDetectionJob("project", "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			names, err := testresult.FindQuarantinedTestNames(ctx, env, "project")
+			require.NoError(t, err)
+			assert.Empty(t, names)
+		},
+		"PreservesManualQuarantine": func(t *testing.T) {
+			require.NoError(t, testresult.QuarantineTests(ctx, env, "project", []string{"manual"}))
+
+			j := NewFlakyTestDetectionJob("project", "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			names, err := testresult.FindQuarantinedTestNames(ctx, env, "project")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"manual"}, names)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			tCase(t)
+		})
+	}
+}
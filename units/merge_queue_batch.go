@@ -0,0 +1,237 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	mergeQueueBatchJobName = "merge-queue-batch"
+	// mergeQueueBatchTrigger is the trigger for notifications reporting the
+	// results of merge groups in a batch.
+	mergeQueueBatchTrigger = "merge-queue-batch"
+)
+
+func init() {
+	registry.AddJobType(mergeQueueBatchJobName, func() amboy.Job {
+		return makeMergeQueueBatchJob()
+	})
+}
+
+type mergeQueueBatchJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env evergreen.Environment
+}
+
+func makeMergeQueueBatchJob() *mergeQueueBatchJob {
+	return &mergeQueueBatchJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    mergeQueueBatchJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewMergeQueueBatchJob returns a job that starts testing merge queue batches
+// that are ready, bisects batches that failed, and reports the results of
+// each merge group in a batch to GitHub once they're known.
+func NewMergeQueueBatchJob(ts string) amboy.Job {
+	j := makeMergeQueueBatchJob()
+	j.SetID(fmt.Sprintf("%s.%s", mergeQueueBatchJobName, ts))
+	j.SetScopes([]string{mergeQueueBatchJobName})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *mergeQueueBatchJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	collecting, err := patch.FindMergeQueueBatchesByStatus(ctx, patch.MergeQueueBatchCollecting)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	now := time.Now()
+	for i := range collecting {
+		if !collecting[i].IsReadyToTest(now) {
+			continue
+		}
+		j.AddError(errors.Wrapf(startMergeQueueBatch(ctx, j.env, &collecting[i]), "starting merge queue batch '%s'", collecting[i].ID))
+	}
+
+	testing, err := patch.FindMergeQueueBatchesByStatus(ctx, patch.MergeQueueBatchTesting)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	for i := range testing {
+		j.AddError(errors.Wrapf(j.updateBatch(ctx, &testing[i]), "updating merge queue batch '%s'", testing[i].ID))
+	}
+}
+
+// updateBatch records the result of the batch's current run once its patch
+// finishes, reports the merge groups whose results are now known, and starts
+// the next bisection run if the failing merge group isn't known yet.
+func (j *mergeQueueBatchJob) updateBatch(ctx context.Context, b *patch.MergeQueueBatch) error {
+	run := b.CurrentRun()
+	if run == nil {
+		return startMergeQueueBatchRun(ctx, j.env, b, b.NextGroupToTest())
+	}
+	if run.Status == "" {
+		p, err := patch.FindOneId(ctx, run.PatchID)
+		if err != nil {
+			return errors.Wrapf(err, "finding patch '%s'", run.PatchID)
+		}
+		if p == nil || !evergreen.IsFinishedVersionStatus(p.Status) {
+			return nil
+		}
+		run.Status = p.Status
+	}
+
+	resolved := b.ResolveGroups()
+	if err := b.SaveProgress(ctx); err != nil {
+		return err
+	}
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(j.reportGroups(ctx, b, resolved), "reporting merge group results")
+
+	if next := b.NextGroupToTest(); next != -1 {
+		grip.Info(message.Fields{
+			"message":     "bisecting failed merge queue batch",
+			"job":         j.ID(),
+			"batch_id":    b.ID,
+			"project":     b.ProjectID,
+			"group_index": next,
+			"num_groups":  len(b.Groups),
+		})
+		catcher.Add(startMergeQueueBatchRun(ctx, j.env, b, next))
+	}
+	return catcher.Resolve()
+}
+
+// reportGroups sends the results of the merge groups at the given indexes to
+// GitHub for each Evergreen branch protection rule.
+func (j *mergeQueueBatchJob) reportGroups(ctx context.Context, b *patch.MergeQueueBatch, indexes []int) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+	rules, err := thirdparty.GetEvergreenBranchProtectionRules(ctx, b.Org, b.Repo, b.BaseBranch)
+	grip.Error(message.WrapError(err, message.Fields{
+		"message":  "failed to get branch protection rules",
+		"job":      j.ID(),
+		"batch_id": b.ID,
+		"org":      b.Org,
+		"repo":     b.Repo,
+		"branch":   b.BaseBranch,
+	}))
+	rules = utility.UniqueStrings(append(rules, thirdparty.GithubStatusDefaultContext))
+
+	culprit := b.Culprit()
+	notifications := []notification.Notification{}
+	catcher := grip.NewBasicCatcher()
+	for _, i := range indexes {
+		group := b.Groups[i]
+		status := &message.GithubStatus{
+			URL: fmt.Sprintf("%s/version/%s?redirect_spruce_users=true", j.env.Settings().Ui.Url, group.PatchID),
+		}
+		switch {
+		case group.Status == evergreen.VersionSucceeded:
+			status.State = message.GithubStateSuccess
+			status.Description = "merge queue batch succeeded"
+		case i == culprit:
+			status.State = message.GithubStateFailure
+			status.Description = "merge group failed in merge queue batch"
+		default:
+			status.State = message.GithubStateFailure
+			status.Description = "merge queue batch failed"
+		}
+
+		sub := event.NewGithubMergeAPISubscriber(event.GithubMergeSubscriber{
+			Owner: b.Org,
+			Repo:  b.Repo,
+			Ref:   group.HeadSHA,
+		})
+		// The GitHub merge subscriber isn't part of the notification ID, so
+		// the event ID must identify the merge group and the patch that
+		// determined its result.
+		eventID := fmt.Sprintf("%s-%s-%s", b.ID, group.PatchID, group.HeadSHA)
+		for _, rule := range rules {
+			payload := *status
+			payload.Context = rule
+			n, err := notification.New(fmt.Sprintf("%s-%s", eventID, rule), mergeQueueBatchTrigger, &sub, &payload)
+			if err != nil {
+				catcher.Wrapf(err, "creating notification for merge group '%s'", group.HeadSHA)
+				continue
+			}
+			notifications = append(notifications, *n)
+		}
+	}
+	if len(notifications) == 0 {
+		return catcher.Resolve()
+	}
+	if err := notification.InsertMany(notifications...); err != nil {
+		catcher.Wrap(err, "inserting notifications")
+		return catcher.Resolve()
+	}
+
+	flags, err := evergreen.GetServiceFlags(ctx)
+	if err != nil {
+		catcher.Wrap(err, "getting service flags")
+		return catcher.Resolve()
+	}
+	jobs, err := notificationJobs(ctx, notifications, flags, utility.RoundPartOfMinute(0))
+	catcher.Wrap(err, "getting notification jobs")
+	catcher.Wrap(j.env.RemoteQueue().PutMany(ctx, jobs), "enqueueing notification jobs")
+	return catcher.Resolve()
+}
+
+// startMergeQueueBatch stops the batch from collecting merge groups and starts
+// testing all of them together.
+func startMergeQueueBatch(ctx context.Context, env evergreen.Environment, b *patch.MergeQueueBatch) error {
+	started, err := b.StartTesting(ctx)
+	if err != nil {
+		return err
+	}
+	if !started {
+		return nil
+	}
+	return startMergeQueueBatchRun(ctx, env, b, b.NextGroupToTest())
+}
+
+// startMergeQueueBatchRun creates a patch that tests the head of the merge
+// group at the given index in the batch.
+func startMergeQueueBatchRun(ctx context.Context, env evergreen.Environment, b *patch.MergeQueueBatch, groupIndex int) error {
+	intent, err := patch.NewGithubMergeBatchIntent(b, groupIndex)
+	if err != nil {
+		return errors.Wrap(err, "creating merge queue batch intent")
+	}
+	if err = intent.Insert(); err != nil {
+		return errors.Wrap(err, "inserting merge queue batch intent")
+	}
+	patchID := mgobson.NewObjectId()
+	if err = b.AddRun(ctx, patchID.Hex(), groupIndex); err != nil {
+		return err
+	}
+	return errors.Wrap(env.RemoteQueue().Put(ctx, NewPatchIntentProcessor(env, patchID, intent)), "enqueueing merge queue batch patch intent")
+}
@@ -0,0 +1,184 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeQueueBatchJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	collections := []string{patch.MergeQueueBatchCollection, patch.Collection, patch.IntentCollection, notification.Collection}
+	defer func() {
+		assert.NoError(t, db.ClearCollections(collections...))
+	}()
+
+	opts := patch.MergeQueueBatchOptions{
+		ProjectID:  "project",
+		Org:        "evergreen-ci",
+		Repo:       "evergreen",
+		BaseBranch: "main",
+		BatchSize:  2,
+		Bisect:     true,
+		Wait:       time.Hour,
+	}
+	// makeTestingBatch creates a full batch that's testing its last merge
+	// group in a patch with the given status.
+	makeTestingBatch := func(t *testing.T, patchStatus string) *patch.MergeQueueBatch {
+		var b *patch.MergeQueueBatch
+		var err error
+		for _, sha := range []string{"sha0", "sha1"} {
+			b, err = patch.AddToMergeQueueBatch(ctx, opts, patch.MergeQueueGroup{
+				HeadRef: "refs/heads/gh-readonly-queue/main/pr-1-" + sha,
+				HeadSHA: sha,
+			})
+			require.NoError(t, err)
+		}
+		started, err := b.StartTesting(ctx)
+		require.NoError(t, err)
+		require.True(t, started)
+
+		patchID := mgobson.NewObjectId()
+		require.NoError(t, (&patch.Patch{Id: patchID, Status: patchStatus}).Insert())
+		require.NoError(t, b.AddRun(ctx, patchID.Hex(), 1))
+		return b
+	}
+	runJob := func(t *testing.T) {
+		j, ok := NewMergeQueueBatchJob(utility.RoundPartOfMinute(0).Format(TSFormat)).(*mergeQueueBatchJob)
+		require.True(t, ok)
+		j.env = env
+		j.Run(ctx)
+		assert.NoError(t, j.Error())
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"StartsBatchAfterWait": func(t *testing.T) {
+			waitOpts := opts
+			waitOpts.Wait = 0
+			b, err := patch.AddToMergeQueueBatch(ctx, waitOpts, patch.MergeQueueGroup{
+				HeadRef: "refs/heads/gh-readonly-queue/main/pr-1-sha0",
+				HeadSHA: "sha0",
+			})
+			require.NoError(t, err)
+
+			runJob(t)
+
+			dbBatch, err := patch.FindMergeQueueBatch(ctx, b.ID)
+			require.NoError(t, err)
+			require.NotNil(t, dbBatch)
+			assert.Equal(t, patch.MergeQueueBatchTesting, dbBatch.Status)
+			require.Len(t, dbBatch.Runs, 1)
+			assert.Equal(t, 0, dbBatch.Runs[0].GroupIndex)
+			assert.NotEmpty(t, dbBatch.Runs[0].PatchID)
+		},
+		"DoesNotStartBatchBeforeWait": func(t *testing.T) {
+			b, err := patch.AddToMergeQueueBatch(ctx, opts, patch.MergeQueueGroup{HeadSHA: "sha0"})
+			require.NoError(t, err)
+
+			runJob(t)
+
+			dbBatch, err := patch.FindMergeQueueBatch(ctx, b.ID)
+			require.NoError(t, err)
+			require.NotNil(t, dbBatch)
+			assert.Equal(t, patch.MergeQueueBatchCollecting, dbBatch.Status)
+			assert.Empty(t, dbBatch.Runs)
+		},
+		"WaitsForRunningPatch": func(t *testing.T) {
+			b := makeTestingBatch(t, evergreen.VersionStarted)
+
+			runJob(t)
+
+			dbBatch, err := patch.FindMergeQueueBatch(ctx, b.ID)
+			require.NoError(t, err)
+			require.NotNil(t, dbBatch)
+			assert.Equal(t, patch.MergeQueueBatchTesting, dbBatch.Status)
+			require.Len(t, dbBatch.Runs, 1)
+			assert.Empty(t, dbBatch.Runs[0].Status)
+		},
+		"FinishesSuccessfulBatch": func(t *testing.T) {
+			b := makeTestingBatch(t, evergreen.VersionSucceeded)
+
+			runJob(t)
+
+			dbBatch, err := patch.FindMergeQueueBatch(ctx, b.ID)
+			require.NoError(t, err)
+			require.NotNil(t, dbBatch)
+			assert.Equal(t, patch.MergeQueueBatchFinished, dbBatch.Status)
+			for _, g := range dbBatch.Groups {
+				assert.Equal(t, evergreen.VersionSucceeded, g.Status)
+			}
+
+			notifications := []notification.Notification{}
+			require.NoError(t, db.FindAllQContext(ctx, notification.Collection, db.Q{}, &notifications))
+			require.Len(t, notifications, len(dbBatch.Groups), "each merge group should have its own notification")
+			refs := []string{}
+			for _, n := range notifications {
+				sub, ok := n.Subscriber.Target.(*event.GithubMergeSubscriber)
+				require.True(t, ok)
+				refs = append(refs, sub.Ref)
+			}
+			assert.ElementsMatch(t, []string{"sha0", "sha1"}, refs)
+		},
+		"BisectsFailedBatch": func(t *testing.T) {
+			b := makeTestingBatch(t, evergreen.VersionFailed)
+
+			runJob(t)
+
+			dbBatch, err := patch.FindMergeQueueBatch(ctx, b.ID)
+			require.NoError(t, err)
+			require.NotNil(t, dbBatch)
+			assert.Equal(t, patch.MergeQueueBatchTesting, dbBatch.Status)
+			require.Len(t, dbBatch.Runs, 2)
+			assert.Equal(t, evergreen.VersionFailed, dbBatch.Runs[0].Status)
+			assert.Equal(t, 0, dbBatch.Runs[1].GroupIndex)
+			for _, g := range dbBatch.Groups {
+				assert.Empty(t, g.Status, "merge group results should not be known until bisection finishes")
+			}
+		},
+		"ReportsFailureOnlyForCulprit": func(t *testing.T) {
+			b := makeTestingBatch(t, evergreen.VersionFailed)
+			require.NoError(t, patch.SetMergeQueueBatchRunFailed(ctx, b.ID, b.Runs[0].PatchID))
+			patchID := mgobson.NewObjectId()
+			require.NoError(t, (&patch.Patch{Id: patchID, Status: evergreen.VersionFailed}).Insert())
+			require.NoError(t, b.AddRun(ctx, patchID.Hex(), 0))
+
+			runJob(t)
+
+			dbBatch, err := patch.FindMergeQueueBatch(ctx, b.ID)
+			require.NoError(t, err)
+			require.NotNil(t, dbBatch)
+			assert.Equal(t, patch.MergeQueueBatchFinished, dbBatch.Status)
+			assert.Equal(t, 0, dbBatch.Culprit())
+			assert.Equal(t, evergreen.VersionFailed, dbBatch.Groups[0].Status)
+			assert.Equal(t, patch.MergeQueueGroupRequeued, dbBatch.Groups[1].Status)
+
+			notifications := []notification.Notification{}
+			require.NoError(t, db.FindAllQContext(ctx, notification.Collection, db.Q{}, &notifications))
+			require.NotEmpty(t, notifications)
+			for _, n := range notifications {
+				sub, ok := n.Subscriber.Target.(*event.GithubMergeSubscriber)
+				require.True(t, ok)
+				assert.Equal(t, "sha0", sub.Ref, "only the culprit merge group should be reported")
+			}
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(collections...))
+			tCase(t)
+		})
+	}
+}
@@ -33,6 +33,10 @@ const (
 	githubDependabotUser       = "dependabot[bot]"
 	BuildTasksAndVariantsError = "building tasks and variants"
 	maxPatchIntentJobTime      = 10 * time.Minute
+
+	// gitLabMergeRequestNeedsAuthDescription is the status description for
+	// merge request patches that an authorized user must schedule.
+	gitLabMergeRequestNeedsAuthDescription = "patch must be manually authorized"
 )
 
 func init() {
@@ -140,7 +144,29 @@ func (j *patchIntentProcessor) Run(ctx context.Context) {
 		patchDoc.GithubPatchData.BaseRepo = p.Repo
 	}
 
+	if j.IntentType == patch.GithubMergeIntentType && patchDoc.GithubMergeData.BatchID == "" {
+		batched, err := j.addToMergeQueueBatch(ctx, patchDoc)
+		grip.Error(message.WrapError(err, message.Fields{
+			"message":  "could not add merge group to merge queue batch, testing it by itself",
+			"job":      j.ID(),
+			"owner":    patchDoc.GithubMergeData.Org,
+			"repo":     patchDoc.GithubMergeData.Repo,
+			"head_sha": patchDoc.GithubMergeData.HeadSHA,
+			"source":   "patch intents",
+		}))
+		if err == nil && batched {
+			return
+		}
+	}
+
 	if err = j.finishPatch(ctx, patchDoc); err != nil {
+		if batchID := patchDoc.GithubMergeData.BatchID; batchID != "" {
+			// The batch reports the failure to GitHub for each of its merge
+			// groups once it knows which ones are affected.
+			j.AddError(patch.SetMergeQueueBatchRunFailed(ctx, batchID, j.PatchID.Hex()))
+			j.AddError(err)
+			return
+		}
 		if j.IntentType == patch.GithubIntentType || j.IntentType == patch.GithubMergeIntentType {
 			if j.gitHubError == "" {
 				j.gitHubError = OtherErrors
@@ -166,6 +192,12 @@ func (j *patchIntentProcessor) Run(ctx context.Context) {
 			}
 			grip.Error(message.WrapError(err, msg))
 		}
+		if j.IntentType == patch.GitLabMergeRequestIntentType {
+			if j.gitHubError == "" {
+				j.gitHubError = OtherErrors
+			}
+			j.AddError(errors.Wrap(j.sendGitLabStatus(ctx, patchDoc, thirdparty.GitLabStatusFailed, j.gitHubError), "sending GitLab error status"))
+		}
 		j.AddError(err)
 		return
 	}
@@ -195,6 +227,16 @@ func (j *patchIntentProcessor) Run(ctx context.Context) {
 			false, patchDoc.Id.Hex(), patchDoc.GithubPatchData.BaseOwner,
 			patchDoc.GithubPatchData.BaseRepo, patchDoc.GithubPatchData.PRNumber))
 	}
+
+	if j.IntentType == patch.GitLabMergeRequestIntentType {
+		if patchDoc.Version == "" {
+			j.AddError(errors.Wrap(j.sendGitLabStatus(ctx, patchDoc, thirdparty.GitLabStatusFailed, gitLabMergeRequestNeedsAuthDescription), "sending GitLab status for unauthorized merge request"))
+			return
+		}
+		j.AddError(errors.Wrap(j.sendGitLabStatus(ctx, patchDoc, thirdparty.GitLabStatusPending, evergreen.PRTasksRunningDescription), "sending GitLab pending status"))
+		j.AddError(model.AbortPatchesWithGitLabMergeRequestData(ctx, patchDoc.CreateTime,
+			patchDoc.Id.Hex(), patchDoc.GitLabMRData.ProjectPath, patchDoc.GitLabMRData.IID))
+	}
 }
 
 func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.Patch) error {
@@ -219,6 +261,9 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 		if err := j.buildGithubMergeDoc(ctx, patchDoc); err != nil {
 			catcher.Wrap(err, "building GitHub merge queue patch document")
 		}
+	case patch.GitLabMergeRequestIntentType:
+		canFinalize, err = j.buildGitLabMergeRequestDoc(ctx, patchDoc)
+		catcher.Wrap(err, "building GitLab merge request patch document")
 	case patch.TriggerIntentType:
 		patchedProject, patchedParserProject, err = j.buildTriggerPatchDoc(ctx, patchDoc)
 		catcher.Wrap(err, "building trigger patch document")
@@ -326,6 +371,9 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 		j.sendGitHubSuccessMessages(ctx, patchDoc, pref, ignoredFiles)
 		return nil
 	}
+	if patchDoc.IsGitLabMergeRequestPatch() && patchedProject.IgnoresAllFiles(patchDoc.FilesChanged()) {
+		return errors.Wrap(j.sendGitLabStatus(ctx, patchDoc, thirdparty.GitLabStatusSuccess, ignoredFiles), "sending GitLab status for ignored files")
+	}
 
 	patchDoc.PatchedProjectConfig = patchedProjectConfig
 
@@ -356,6 +404,22 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 		j.gitHubError = NoTasksOrVariants
 		return errors.New("patch has no build variants or tasks")
 	}
+	// Don't create patches for PRs if none of their tasks watch the changed
+	// files.
+	if (patchDoc.IsGithubPRPatch() || patchDoc.IsGitLabMergeRequestPatch()) && patchedProject.HasPathFilters() {
+		pairs := model.VariantTasksToTVPairs(patchDoc.VariantsTasks)
+		remaining, skipped, err := patchedProject.FilterTVPairsByChangedFiles(pairs.ExecTasks, patchDoc.FilesChanged(), patchDoc.GetRequester())
+		if err != nil {
+			return errors.Wrap(err, "filtering tasks by changed files")
+		}
+		if len(skipped) > 0 && len(remaining) == 0 {
+			if patchDoc.IsGitLabMergeRequestPatch() {
+				return errors.Wrap(j.sendGitLabStatus(ctx, patchDoc, thirdparty.GitLabStatusSuccess, noWatchedFiles), "sending GitLab status for unwatched files")
+			}
+			j.sendGitHubSuccessMessages(ctx, patchDoc, pref, noWatchedFiles)
+			return nil
+		}
+	}
 
 	// set the patch number based on patch author
 	patchDoc.PatchNumber, err = j.user.IncPatchNumber()
@@ -409,10 +473,16 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 		catcher.Wrap(j.createGitHubSubscriptions(patchDoc), "creating GitHub PR patch subscriptions")
 	}
 
-	if patchDoc.IsMergeQueuePatch() {
+	// Patches testing a merge queue batch don't report to GitHub directly,
+	// since their results may not apply to every merge group in the batch.
+	if patchDoc.IsMergeQueuePatch() && patchDoc.GithubMergeData.BatchID == "" {
 		catcher.Wrap(j.createGitHubMergeSubscription(ctx, patchDoc), "creating GitHub merge queue subscriptions")
 	}
 
+	if patchDoc.IsGitLabMergeRequestPatch() {
+		catcher.Wrap(j.createGitLabSubscriptions(patchDoc), "creating GitLab merge request patch subscriptions")
+	}
+
 	if catcher.HasErrors() {
 		grip.Error(message.WrapError(catcher.Resolve(), message.Fields{
 			"message":     "failed to save subscription, patch will not notify",
@@ -546,6 +616,50 @@ func (j *patchIntentProcessor) createGitHubMergeSubscription(ctx context.Context
 	return catcher.Resolve()
 }
 
+// createGitLabSubscriptions creates subscriptions for notifications related to
+// GitLab merge request patches.
+func (j *patchIntentProcessor) createGitLabSubscriptions(p *patch.Patch) error {
+	catcher := grip.NewBasicCatcher()
+	glSub := event.NewGitLabMergeRequestSubscriber(event.GitLabMergeRequestSubscriber{
+		ProjectID:   p.Project,
+		ProjectPath: p.GitLabMRData.ProjectPath,
+		IID:         p.GitLabMRData.IID,
+		Ref:         p.GitLabMRData.HeadHash,
+	})
+	patchSub := event.NewExpiringPatchOutcomeSubscription(j.PatchID.Hex(), glSub)
+	catcher.Wrap(patchSub.Upsert(), "inserting patch subscription for GitLab merge request")
+	buildSub := event.NewExpiringBuildOutcomeSubscriptionByVersion(j.PatchID.Hex(), glSub)
+	catcher.Wrap(buildSub.Upsert(), "inserting build subscription for GitLab merge request")
+	return catcher.Resolve()
+}
+
+// sendGitLabStatus sends a status for the whole patch to the head commit of
+// the GitLab merge request.
+func (j *patchIntentProcessor) sendGitLabStatus(ctx context.Context, patchDoc *patch.Patch, state, desc string) error {
+	mr := patchDoc.GitLabMRData
+	pRefs, err := model.FindMergedEnabledProjectRefsByGitLabProjectPath(ctx, mr.ProjectPath)
+	if err != nil {
+		return errors.Wrapf(err, "finding project refs for GitLab project '%s'", mr.ProjectPath)
+	}
+	var pRef *model.ProjectRef
+	for i := range pRefs {
+		if pRef == nil || pRefs[i].Id == patchDoc.Project {
+			pRef = &pRefs[i]
+		}
+	}
+	if pRef == nil {
+		return errors.Errorf("no project ref found for GitLab project '%s'", mr.ProjectPath)
+	}
+
+	status := thirdparty.GitLabCommitStatus{
+		State:       state,
+		Name:        thirdparty.GithubStatusDefaultContext,
+		TargetURL:   fmt.Sprintf("%s/version/%s?redirect_spruce_users=true", j.env.Settings().Ui.Url, patchDoc.Id.Hex()),
+		Description: desc,
+	}
+	return pRef.GitLab.Client().SetCommitStatus(ctx, mr.ProjectPath, mr.HeadHash, status)
+}
+
 func (j *patchIntentProcessor) buildTasksAndVariants(ctx context.Context, patchDoc *patch.Patch, project *model.Project) error {
 	var err error
 	var reuseDef bool
@@ -1001,11 +1115,153 @@ func (j *patchIntentProcessor) buildGithubMergeDoc(ctx context.Context, patchDoc
 	return nil
 }
 
+func (j *patchIntentProcessor) buildGitLabMergeRequestDoc(ctx context.Context, patchDoc *patch.Patch) (bool, error) {
+	defer func() {
+		grip.Error(message.WrapError(j.intent.SetProcessed(ctx), message.Fields{
+			"message":     "could not mark patch intent as processed",
+			"intent_id":   j.IntentID,
+			"intent_type": j.IntentType,
+			"patch_id":    j.PatchID,
+			"source":      "patch intents",
+			"job":         j.ID(),
+		}))
+	}()
+
+	mr := patchDoc.GitLabMRData
+	projectRefs, err := model.FindMergedEnabledProjectRefsByGitLabProjectPath(ctx, mr.ProjectPath)
+	if err != nil {
+		return false, errors.Wrapf(err, "fetching project refs for GitLab project '%s'", mr.ProjectPath)
+	}
+	var projectRef *model.ProjectRef
+	for i := range projectRefs {
+		if projectRefs[i].Branch == mr.TargetBranch && projectRefs[i].IsPRTestingEnabled() {
+			projectRef = &projectRefs[i]
+			break
+		}
+	}
+	if projectRef == nil {
+		return false, errors.Errorf("project ref for GitLab project '%s' with branch '%s' and merge request testing enabled not found",
+			mr.ProjectPath, mr.TargetBranch)
+	}
+
+	j.user, err = findEvergreenUserForGitLabMergeRequest(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "finding GitLab merge request user")
+	}
+	patchDoc.Author = j.user.Id
+	patchDoc.Project = projectRef.Id
+
+	isAuthorized, err := isGitLabMergeRequestAuthorized(ctx, projectRef, mr)
+	if err != nil {
+		return false, errors.Wrapf(err, "checking authorization for merge request !%d", mr.IID)
+	}
+	if !isAuthorized {
+		grip.Info(message.Fields{
+			"message":      "GitLab merge request must be manually authorized",
+			"source":       "patch intents",
+			"job":          j.ID(),
+			"patch_id":     j.PatchID,
+			"project_path": mr.ProjectPath,
+			"source_path":  mr.SourceProjectPath,
+			"iid":          mr.IID,
+			"author":       mr.Author,
+		})
+	}
+
+	patchContent, summaries, err := projectRef.GitLab.Client().GetMergeRequestDiff(ctx, mr.ProjectPath, mr.IID)
+	if err != nil {
+		return false, errors.Wrapf(err, "getting diff for merge request !%d", mr.IID)
+	}
+
+	patchFileID := fmt.Sprintf("%s_%s", patchDoc.Id.Hex(), patchDoc.Githash)
+	patchDoc.Patches = append(patchDoc.Patches, patch.ModulePatch{
+		ModuleName: "",
+		Githash:    patchDoc.Githash,
+		PatchSet: patch.PatchSet{
+			PatchFileId: patchFileID,
+			Summary:     summaries,
+		},
+	})
+
+	if err = db.WriteGridFile(patch.GridFSPrefix, patchFileID, strings.NewReader(patchContent)); err != nil {
+		return false, errors.Wrap(err, "writing patch file to DB")
+	}
+
+	return isAuthorized, nil
+}
+
+// isGitLabMergeRequestAuthorized returns whether the merge request's patch can
+// run without an authorized user scheduling it. Merge requests from forks and
+// from authors who can't push to the project could otherwise run arbitrary
+// code with the project's private variables.
+func isGitLabMergeRequestAuthorized(ctx context.Context, projectRef *model.ProjectRef, mr thirdparty.GitLabMergeRequest) (bool, error) {
+	if mr.SourceProjectPath != mr.ProjectPath {
+		return false, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	accessLevel, err := projectRef.GitLab.Client().GetProjectMemberAccessLevel(ctx, mr.ProjectPath, mr.AuthorUID)
+	if err != nil {
+		return false, err
+	}
+	return accessLevel >= thirdparty.GitLabDeveloperAccessLevel, nil
+}
+
 // makeMergeQueueDescription returns a new description for a merge queue patch using the merge group.
 func makeMergeQueueDescription(mergeGroup thirdparty.GithubMergeGroup) string {
+	if mergeGroup.BatchID != "" {
+		return "GitHub Merge Queue batch: " + mergeGroup.HeadCommit + " (" + mergeGroup.HeadSHA[0:7] + ")"
+	}
 	return "GitHub Merge Queue: " + mergeGroup.HeadCommit + " (" + mergeGroup.HeadSHA[0:7] + ")"
 }
 
+// addToMergeQueueBatch adds the merge group to a merge queue batch if the
+// project batches merge groups, starting the batch if it's full. It returns
+// whether the merge group was batched, in which case it's tested as part of
+// the batch rather than by its own patch.
+func (j *patchIntentProcessor) addToMergeQueueBatch(ctx context.Context, patchDoc *patch.Patch) (bool, error) {
+	mergeData := patchDoc.GithubMergeData
+	projectRef, err := model.FindOneProjectRefWithCommitQueueByOwnerRepoAndBranch(ctx, mergeData.Org, mergeData.Repo, mergeData.BaseBranch)
+	if err != nil {
+		return false, errors.Wrapf(err, "fetching project ref for repo '%s/%s' with branch '%s'", mergeData.Org, mergeData.Repo, mergeData.BaseBranch)
+	}
+	if projectRef == nil || !projectRef.CommitQueue.ShouldBatchMergeQueue() {
+		return false, nil
+	}
+
+	b, err := patch.AddToMergeQueueBatch(ctx, patch.MergeQueueBatchOptions{
+		ProjectID:  projectRef.Id,
+		Org:        mergeData.Org,
+		Repo:       mergeData.Repo,
+		BaseBranch: mergeData.BaseBranch,
+		BatchSize:  projectRef.CommitQueue.MergeQueueBatchSize,
+		Bisect:     projectRef.CommitQueue.ShouldBisectMergeQueue(),
+		Wait:       projectRef.CommitQueue.MergeQueueBatchWait(),
+	}, patch.MergeQueueGroup{
+		MsgID:      j.intent.ID(),
+		HeadRef:    "refs/heads/" + mergeData.HeadBranch,
+		HeadSHA:    mergeData.HeadSHA,
+		HeadCommit: mergeData.HeadCommit,
+		BaseSHA:    patchDoc.Githash,
+	})
+	if err != nil {
+		return false, err
+	}
+	grip.Error(message.WrapError(j.intent.SetProcessed(ctx), message.Fields{
+		"message":     "could not mark patch intent as processed",
+		"intent_id":   j.IntentID,
+		"intent_type": j.IntentType,
+		"batch_id":    b.ID,
+		"source":      "patch intents",
+		"job":         j.ID(),
+	}))
+
+	if b.IsReadyToTest(time.Now()) {
+		j.AddError(errors.Wrapf(startMergeQueueBatch(ctx, j.env, b), "starting merge queue batch '%s'", b.ID))
+	}
+	return true, nil
+}
+
 func (j *patchIntentProcessor) buildTriggerPatchDoc(ctx context.Context, patchDoc *patch.Patch) (*model.Project, *model.ParserProject, error) {
 	defer func() {
 		grip.Error(message.WrapError(j.intent.SetProcessed(ctx), message.Fields{
@@ -1165,6 +1421,26 @@ func findEvergreenUserForGithubMergeGroup(ctx context.Context) (*user.DBUser, er
 	return u, err
 }
 
+func findEvergreenUserForGitLabMergeRequest(ctx context.Context) (*user.DBUser, error) {
+	u, err := user.FindOneContext(ctx, user.ById(evergreen.GitLabPatchUser))
+	if err != nil {
+		return u, errors.Wrap(err, "finding GitLab merge request user")
+	}
+	// and if that user doesn't exist, make it
+	if u == nil {
+		u = &user.DBUser{
+			Id:       evergreen.GitLabPatchUser,
+			DispName: "GitLab Merge Requests",
+			APIKey:   utility.RandomString(),
+		}
+		if err = u.Insert(); err != nil {
+			return nil, errors.Wrap(err, "inserting GitLab merge request user")
+		}
+	}
+
+	return u, err
+}
+
 func (j *patchIntentProcessor) isUserAuthorized(ctx context.Context, patchDoc *patch.Patch, requiredOrganization, githubUser string) (bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
@@ -2,8 +2,10 @@ package units
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"sort"
 	"strings"
 	"testing"
@@ -30,6 +32,7 @@ import (
 	"github.com/mongodb/amboy/registry"
 	"github.com/mongodb/grip/send"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -1762,3 +1765,43 @@ func TestMakeMergeQueueDescription(t *testing.T) {
 	mergeGroup := thirdparty.GithubMergeGroup{HeadSHA: "0e312ffabcdefghijklmnop", HeadCommit: "I'm a commit!"}
 	assert.Equal(t, "GitHub Merge Queue: I'm a commit! (0e312ff)", makeMergeQueueDescription(mergeGroup))
 }
+
+func TestIsGitLabMergeRequestAuthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/group%2Frepo/members/all/1":
+			fmt.Fprintf(w, `{"id": 1, "access_level": %d}`, thirdparty.GitLabDeveloperAccessLevel)
+		case "/api/v4/projects/group%2Frepo/members/all/2":
+			fmt.Fprint(w, `{"id": 2, "access_level": 20}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	pRef := &model.ProjectRef{GitLab: model.GitLabSettings{URL: server.URL, Owner: "group", Repo: "repo", Token: "token"}}
+
+	for tName, tCase := range map[string]struct {
+		mr         thirdparty.GitLabMergeRequest
+		authorized bool
+	}{
+		"DeveloperInProjectIsAuthorized": {
+			mr:         thirdparty.GitLabMergeRequest{ProjectPath: "group/repo", SourceProjectPath: "group/repo", AuthorUID: 1},
+			authorized: true,
+		},
+		"ReporterInProjectIsNotAuthorized": {
+			mr: thirdparty.GitLabMergeRequest{ProjectPath: "group/repo", SourceProjectPath: "group/repo", AuthorUID: 2},
+		},
+		"NonMemberIsNotAuthorized": {
+			mr: thirdparty.GitLabMergeRequest{ProjectPath: "group/repo", SourceProjectPath: "group/repo", AuthorUID: 3},
+		},
+		"ForkIsNotAuthorized": {
+			mr: thirdparty.GitLabMergeRequest{ProjectPath: "group/repo", SourceProjectPath: "someone/repo", AuthorUID: 1},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			authorized, err := isGitLabMergeRequestAuthorized(t.Context(), pRef, tCase.mr)
+			require.NoError(t, err)
+			assert.Equal(t, tCase.authorized, authorized)
+		})
+	}
+}
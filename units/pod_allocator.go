@@ -297,6 +297,15 @@ func (j *podAllocatorJob) getIntentPodOptions(ctx context.Context) (*pod.TaskInt
 			return nil, errors.Wrap(err, "expanding container image")
 		}
 	}
+	var provider evergreen.PodProvider
+	if poolID := j.task.ContainerOpts.Pool; poolID != "" {
+		pool := j.settings.ContainerPools.GetContainerPool(poolID)
+		if pool == nil {
+			return nil, errors.Errorf("container pool '%s' not found", poolID)
+		}
+		provider = pool.Provider
+	}
+
 	return &pod.TaskIntentPodOptions{
 		CPU:                 j.task.ContainerOpts.CPU,
 		MemoryMB:            j.task.ContainerOpts.MemoryMB,
@@ -308,5 +317,7 @@ func (j *podAllocatorJob) getIntentPodOptions(ctx context.Context) (*pod.TaskInt
 		WorkingDir:          j.task.ContainerOpts.WorkingDir,
 		PodSecretExternalID: podSecretExternalID,
 		PodSecretValue:      podSecret,
+		Provider:            provider,
+		ContainerPool:       j.task.ContainerOpts.Pool,
 	}, nil
 }
@@ -36,12 +36,15 @@ func TestPodAllocatorJob(t *testing.T) {
 
 	var originalPodLifecycleConf evergreen.PodLifecycleConfig
 	require.NoError(t, originalPodLifecycleConf.Get(ctx))
+	var originalContainerPoolsConf evergreen.ContainerPoolsConfig
+	require.NoError(t, originalContainerPoolsConf.Get(ctx))
 	originalFlags, err := evergreen.GetServiceFlags(ctx)
 	require.NoError(t, err)
 	// Since the tests depend on modifying the global environment, reset it to
 	// its initial state afterwards.
 	defer func() {
 		require.NoError(t, originalPodLifecycleConf.Set(ctx))
+		require.NoError(t, originalContainerPoolsConf.Set(ctx))
 		require.NoError(t, originalFlags.Set(ctx))
 	}()
 
@@ -94,6 +97,48 @@ func TestPodAllocatorJob(t *testing.T) {
 			require.Len(t, taskEvents, 1)
 			assert.Equal(t, event.ContainerAllocated, taskEvents[0].EventType)
 		},
+		"RunSucceedsWithKubernetesContainerPool": func(ctx context.Context, t *testing.T, j *podAllocatorJob, v cocoa.Vault, tsk task.Task, pRef model.ProjectRef) {
+			poolsConf := evergreen.ContainerPoolsConfig{
+				Pools: []evergreen.ContainerPool{
+					{
+						Id:       "k8s_pool",
+						Provider: evergreen.PodProviderKubernetes,
+						Kubernetes: evergreen.KubernetesConfig{
+							APIServerURL: "https://kubernetes.example.com",
+							Namespace:    "evergreen",
+						},
+					},
+				},
+			}
+			require.NoError(t, poolsConf.Set(ctx))
+			tsk.ContainerOpts.Pool = "k8s_pool"
+			require.NoError(t, tsk.Insert())
+
+			j.Run(ctx)
+
+			require.NoError(t, j.Error())
+
+			dbPod, err := pod.FindOne(ctx, db.Query(bson.M{}))
+			require.NoError(t, err)
+			require.NotZero(t, dbPod)
+			assert.Equal(t, pod.StatusInitializing, dbPod.Status)
+			assert.True(t, dbPod.IsKubernetes())
+			assert.Equal(t, "k8s_pool", dbPod.ContainerPool)
+			assert.Zero(t, dbPod.Family, "Kubernetes pods should not use a pod definition")
+		},
+		"RunFailsWithNonexistentContainerPool": func(ctx context.Context, t *testing.T, j *podAllocatorJob, v cocoa.Vault, tsk task.Task, pRef model.ProjectRef) {
+			require.NoError(t, (&evergreen.ContainerPoolsConfig{}).Set(ctx))
+			tsk.ContainerOpts.Pool = "nonexistent"
+			require.NoError(t, tsk.Insert())
+
+			j.Run(ctx)
+
+			assert.Error(t, j.Error())
+
+			dbPod, err := pod.FindOne(ctx, db.Query(bson.M{}))
+			assert.NoError(t, err)
+			assert.Zero(t, dbPod)
+		},
 		"RunSucceedsAndPopulatesRepoCreds": func(ctx context.Context, t *testing.T, j *podAllocatorJob, v cocoa.Vault, tsk task.Task, pRef model.ProjectRef) {
 			pRef.ContainerSecrets = append(pRef.ContainerSecrets, model.ContainerSecret{
 				Name:         "repo_creds_name",
@@ -337,12 +382,15 @@ func TestPopulatePodAllocatorJobs(t *testing.T) {
 
 	var originalPodLifecycleConf evergreen.PodLifecycleConfig
 	require.NoError(t, originalPodLifecycleConf.Get(ctx))
+	var originalContainerPoolsConf evergreen.ContainerPoolsConfig
+	require.NoError(t, originalContainerPoolsConf.Get(ctx))
 	originalFlags, err := evergreen.GetServiceFlags(ctx)
 	require.NoError(t, err)
 	// Since the tests depend on modifying the global environment, reset it to
 	// its initial state afterwards.
 	defer func() {
 		require.NoError(t, originalPodLifecycleConf.Set(ctx))
+		require.NoError(t, originalContainerPoolsConf.Set(ctx))
 		require.NoError(t, originalFlags.Set(ctx))
 	}()
 
@@ -40,6 +40,7 @@ type podCreationJob struct {
 	ecsClient     cocoa.ECSClient
 	ecsPod        cocoa.ECSPod
 	ecsPodCreator cocoa.ECSPodCreator
+	k8sClient     cloud.KubernetesClient
 	env           evergreen.Environment
 }
 
@@ -97,6 +98,11 @@ func (j *podCreationJob) Run(ctx context.Context) {
 
 	switch j.pod.Status {
 	case pod.StatusInitializing:
+		if j.pod.IsKubernetes() {
+			j.createKubernetesPod(ctx)
+			return
+		}
+
 		execOpts, err := cloud.ExportECSPodExecutionOptions(j.env.Settings().Providers.AWS.Pod.ECS, j.pod.TaskContainerCreationOpts)
 		if err != nil {
 			j.AddError(errors.Wrap(err, "getting pod execution options"))
@@ -120,25 +126,65 @@ func (j *podCreationJob) Run(ctx context.Context) {
 		j.ecsPod = p
 
 		res := p.Resources()
-		if err := j.pod.UpdateResources(ctx, cloud.ImportECSPodResources(res)); err != nil {
-			j.AddError(errors.Wrap(err, "updating pod resources"))
-		}
+		j.markPodStarting(ctx, cloud.ImportECSPodResources(res))
+	default:
+		j.AddError(errors.Errorf("not starting pod because pod status is '%s'", j.pod.Status))
+	}
+}
 
-		// Bump the last communication time to ensure that the pod has a
-		// sufficient grace period to start up.
-		if err := j.pod.UpdateLastCommunicated(ctx); err != nil {
-			j.AddError(errors.Wrap(err, "updating pod last communication time"))
-		}
+// createKubernetesPod starts the pod in its Kubernetes container pool. Unlike
+// ECS pods, Kubernetes pods do not need a pod definition, but their secrets
+// must be created in the cluster before the pod can start.
+func (j *podCreationJob) createKubernetesPod(ctx context.Context) {
+	pool := j.env.Settings().ContainerPools.GetContainerPool(j.pod.ContainerPool)
+	if pool == nil {
+		j.AddError(errors.Errorf("container pool '%s' not found", j.pod.ContainerPool))
+		return
+	}
 
-		if err := j.pod.UpdateStatus(ctx, pod.StatusStarting, "pod successfully started"); err != nil {
-			j.AddError(errors.Wrap(err, "marking pod as starting"))
-		}
+	secret, err := cloud.ExportKubernetesPodSecret(j.pod)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "exporting pod secret"))
+		return
+	}
+	kp, err := cloud.ExportKubernetesPod(j.env.Settings(), pool.Kubernetes, j.pod)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "exporting pod"))
+		return
+	}
 
-		if err := j.logTaskTimingStats(ctx); err != nil {
-			j.AddError(errors.Wrap(err, "logging task timing stats"))
-		}
-	default:
-		j.AddError(errors.Errorf("not starting pod because pod status is '%s'", j.pod.Status))
+	if err := j.k8sClient.CreateSecret(ctx, *secret); err != nil {
+		j.AddRetryableError(errors.Wrap(err, "creating pod secret"))
+		return
+	}
+
+	created, err := j.k8sClient.CreatePod(ctx, *kp)
+	if err != nil {
+		j.AddRetryableError(errors.Wrap(err, "starting pod"))
+		return
+	}
+
+	j.markPodStarting(ctx, cloud.ImportKubernetesPodResources(j.k8sClient.Namespace(), *created, secret.Metadata.Name))
+}
+
+// markPodStarting records the pod's cloud resources and marks it as starting.
+func (j *podCreationJob) markPodStarting(ctx context.Context, res pod.ResourceInfo) {
+	if err := j.pod.UpdateResources(ctx, res); err != nil {
+		j.AddError(errors.Wrap(err, "updating pod resources"))
+	}
+
+	// Bump the last communication time to ensure that the pod has a
+	// sufficient grace period to start up.
+	if err := j.pod.UpdateLastCommunicated(ctx); err != nil {
+		j.AddError(errors.Wrap(err, "updating pod last communication time"))
+	}
+
+	if err := j.pod.UpdateStatus(ctx, pod.StatusStarting, "pod successfully started"); err != nil {
+		j.AddError(errors.Wrap(err, "marking pod as starting"))
+	}
+
+	if err := j.logTaskTimingStats(ctx); err != nil {
+		j.AddError(errors.Wrap(err, "logging task timing stats"))
 	}
 }
 
@@ -164,6 +210,17 @@ func (j *podCreationJob) populateIfUnset(ctx context.Context) error {
 
 	settings := j.env.Settings()
 
+	if j.pod.IsKubernetes() {
+		if j.k8sClient == nil {
+			client, err := cloud.MakeKubernetesClient(settings, j.pod.ContainerPool)
+			if err != nil {
+				return errors.Wrap(err, "initializing Kubernetes client")
+			}
+			j.k8sClient = client
+		}
+		return nil
+	}
+
 	if j.ecsClient == nil {
 		client, err := cloud.MakeECSClient(ctx, settings)
 		if err != nil {
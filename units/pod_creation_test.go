@@ -197,3 +197,115 @@ func TestPodCreationJob(t *testing.T) {
 		})
 	}
 }
+
+func TestPodCreationJobWithKubernetesPod(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = testutil.TestSpan(ctx, t)
+
+	defer func() {
+		assert.NoError(t, db.ClearCollections(pod.Collection, dispatcher.Collection, event.EventCollection))
+	}()
+
+	const (
+		poolID    = "k8s_pool"
+		namespace = "evergreen"
+	)
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, j *podCreationJob, server *cloud.MockKubernetesAPIServer){
+		"Succeeds": func(ctx context.Context, t *testing.T, j *podCreationJob, server *cloud.MockKubernetesAPIServer) {
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			podName := cloud.KubernetesPodName(j.PodID)
+			kp, ok := server.GetPod(namespace, podName)
+			require.True(t, ok, "should have created the Kubernetes pod")
+			require.Len(t, kp.Spec.Containers, 1)
+			assert.Equal(t, "image", kp.Spec.Containers[0].Image)
+
+			secretName := cloud.KubernetesPodSecretName(j.PodID)
+			secret, ok := server.GetSecret(namespace, secretName)
+			require.True(t, ok, "should have created the pod's secret")
+			assert.Equal(t, "pod_secret_value", secret.StringData[pod.PodSecretEnvVar])
+
+			dbPod, err := pod.FindOneByID(ctx, j.PodID)
+			require.NoError(t, err)
+			require.NotZero(t, dbPod)
+			assert.Equal(t, pod.StatusStarting, dbPod.Status)
+			assert.Equal(t, podName, dbPod.Resources.ExternalID)
+			assert.Equal(t, namespace, dbPod.Resources.Cluster)
+			assert.Zero(t, dbPod.Resources.DefinitionID, "Kubernetes pods should not have a pod definition")
+			require.Len(t, dbPod.Resources.Containers, 1)
+			assert.Equal(t, []string{secretName}, dbPod.Resources.Containers[0].SecretIDs)
+		},
+		"SucceedsWithAlreadyCreatedPod": func(ctx context.Context, t *testing.T, j *podCreationJob, server *cloud.MockKubernetesAPIServer) {
+			server.SetPod(namespace, cloud.KubernetesPod{Metadata: cloud.KubernetesObjectMeta{Name: cloud.KubernetesPodName(j.PodID)}})
+
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			dbPod, err := pod.FindOneByID(ctx, j.PodID)
+			require.NoError(t, err)
+			require.NotZero(t, dbPod)
+			assert.Equal(t, pod.StatusStarting, dbPod.Status)
+		},
+		"RetriesWhenAPIServerErrors": func(ctx context.Context, t *testing.T, j *podCreationJob, server *cloud.MockKubernetesAPIServer) {
+			server.FailRequests = true
+
+			j.Run(ctx)
+			assert.Error(t, j.Error())
+			assert.True(t, j.RetryInfo().ShouldRetry(), "job should retry because the API server could not create the pod")
+
+			dbPod, err := pod.FindOneByID(ctx, j.PodID)
+			require.NoError(t, err)
+			require.NotZero(t, dbPod)
+			assert.Equal(t, pod.StatusInitializing, dbPod.Status)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			tctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			tctx = testutil.TestSpan(tctx, t)
+
+			require.NoError(t, db.ClearCollections(pod.Collection, dispatcher.Collection, event.EventCollection))
+
+			server := cloud.NewMockKubernetesAPIServer()
+			defer server.Close()
+
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(tctx))
+			env.EvergreenSettings.ContainerPools.Pools = []evergreen.ContainerPool{
+				{
+					Id:         poolID,
+					Provider:   evergreen.PodProviderKubernetes,
+					Kubernetes: server.Config(namespace),
+				},
+			}
+
+			p, err := pod.NewTaskIntentPod(evergreen.ECSConfig{AllowedImages: []string{"image"}}, pod.TaskIntentPodOptions{
+				MemoryMB:            256,
+				CPU:                 512,
+				OS:                  pod.OSLinux,
+				Arch:                pod.ArchAMD64,
+				Image:               "image",
+				WorkingDir:          "/working_dir",
+				PodSecretExternalID: "pod_secret_external_id",
+				PodSecretValue:      "pod_secret_value",
+				Provider:            evergreen.PodProviderKubernetes,
+				ContainerPool:       poolID,
+			})
+			require.NoError(t, err)
+			require.NoError(t, p.Insert())
+
+			pd := dispatcher.NewPodDispatcher("group_id", []string{}, []string{p.ID})
+			require.NoError(t, pd.Insert())
+
+			j, ok := NewPodCreationJob(p.ID, utility.RoundPartOfMinute(0).Format(TSFormat)).(*podCreationJob)
+			require.True(t, ok)
+			j.pod = p
+			j.env = env
+
+			tCase(tctx, t, j, server)
+		})
+	}
+}
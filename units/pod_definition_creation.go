@@ -54,7 +54,7 @@ func makePodDefinitionCreationJob() *podDefinitionCreationJob {
 }
 
 // NewPodDefinitionCreationJob creates a job that creates a pod definition in
-// preparation for running a pod.
+// preparation for running a pod. Only ECS pods run from pod definitions.
 func NewPodDefinitionCreationJob(ecsConf evergreen.ECSConfig, opts pod.TaskContainerCreationOptions, id string) amboy.Job {
 	j := makePodDefinitionCreationJob()
 	j.ContainerOpts = opts
@@ -39,6 +39,7 @@ type podHealthCheckJob struct {
 	pod       *pod.Pod
 	ecsClient cocoa.ECSClient
 	ecsPod    cocoa.ECSPod
+	k8sClient cloud.KubernetesClient
 }
 
 func makePodHealthCheckJob() *podHealthCheckJob {
@@ -111,6 +112,11 @@ func (j *podHealthCheckJob) Run(ctx context.Context) {
 		return
 	}
 
+	if j.pod.IsKubernetes() {
+		j.checkKubernetesPodHealth(ctx)
+		return
+	}
+
 	info, err := j.ecsPod.LatestStatusInfo(ctx)
 	if err != nil {
 		j.AddError(errors.Wrap(err, "getting cloud pod's status info"))
@@ -133,11 +139,7 @@ func (j *podHealthCheckJob) Run(ctx context.Context) {
 			"job":     j.ID(),
 		})
 
-		terminationJob := NewPodTerminationJob(j.PodID, fmt.Sprintf("pod health check detected status '%s'", info.Status), utility.RoundPartOfMinute(0))
-		if err := amboy.EnqueueUniqueJob(ctx, j.env.RemoteQueue(), terminationJob); err != nil {
-			j.AddError(errors.Wrap(err, "enqueueing job to terminate unhealthy pod"))
-			return
-		}
+		j.enqueueTerminationJob(ctx, fmt.Sprintf("pod health check detected status '%s'", info.Status))
 	default:
 		grip.Warning(message.Fields{
 			"message": "unable to determine pod health because it is in an unhandled state",
@@ -148,6 +150,59 @@ func (j *podHealthCheckJob) Run(ctx context.Context) {
 	}
 }
 
+// checkKubernetesPodHealth checks the health of the pod according to the phase
+// of the pod in Kubernetes.
+func (j *podHealthCheckJob) checkKubernetesPodHealth(ctx context.Context) {
+	kp, err := j.k8sClient.GetPod(ctx, j.pod.Resources.ExternalID)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "getting Kubernetes pod"))
+		return
+	}
+	if kp == nil {
+		grip.Info(message.Fields{
+			"message": "Kubernetes pod no longer exists",
+			"pod":     j.PodID,
+			"job":     j.ID(),
+		})
+		j.enqueueTerminationJob(ctx, "pod health check could not find the Kubernetes pod")
+		return
+	}
+
+	switch kp.Status.Phase {
+	case cloud.KubernetesPodPhasePending, cloud.KubernetesPodPhaseRunning:
+		grip.Info(message.Fields{
+			"message": "cloud pod is healthy",
+			"pod":     j.PodID,
+			"phase":   kp.Status.Phase,
+			"job":     j.ID(),
+		})
+	case cloud.KubernetesPodPhaseSucceeded, cloud.KubernetesPodPhaseFailed:
+		grip.Info(message.Fields{
+			"message": "cloud pod is unhealthy",
+			"pod":     j.PodID,
+			"phase":   kp.Status.Phase,
+			"reason":  kp.Status.Reason,
+			"job":     j.ID(),
+		})
+
+		j.enqueueTerminationJob(ctx, fmt.Sprintf("pod health check detected Kubernetes phase '%s'", kp.Status.Phase))
+	default:
+		grip.Warning(message.Fields{
+			"message": "unable to determine pod health because it is in an unhandled state",
+			"pod":     j.PodID,
+			"phase":   kp.Status.Phase,
+			"job":     j.ID(),
+		})
+	}
+}
+
+func (j *podHealthCheckJob) enqueueTerminationJob(ctx context.Context, reason string) {
+	terminationJob := NewPodTerminationJob(j.PodID, reason, utility.RoundPartOfMinute(0))
+	if err := amboy.EnqueueUniqueJob(ctx, j.env.RemoteQueue(), terminationJob); err != nil {
+		j.AddError(errors.Wrap(err, "enqueueing job to terminate unhealthy pod"))
+	}
+}
+
 func (j *podHealthCheckJob) populateIfUnset(ctx context.Context) error {
 	if j.env == nil {
 		j.env = evergreen.GetEnvironment()
@@ -164,6 +219,17 @@ func (j *podHealthCheckJob) populateIfUnset(ctx context.Context) error {
 		j.pod = p
 	}
 
+	if j.pod.IsKubernetes() {
+		if j.k8sClient == nil {
+			client, err := cloud.MakeKubernetesClient(j.env.Settings(), j.pod.ContainerPool)
+			if err != nil {
+				return errors.Wrap(err, "initializing Kubernetes client")
+			}
+			j.k8sClient = client
+		}
+		return nil
+	}
+
 	if j.ecsClient == nil {
 		client, err := cloud.MakeECSClient(ctx, j.env.Settings())
 		if err != nil {
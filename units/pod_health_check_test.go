@@ -6,6 +6,7 @@ import (
 	"time"
 
 	cocoaMock "github.com/evergreen-ci/cocoa/mock"
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/cloud"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/mock"
@@ -114,3 +115,92 @@ func TestPodHealthCheckJob(t *testing.T) {
 		})
 	}
 }
+
+func TestPodHealthCheckJobWithKubernetesPod(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = testutil.TestSpan(ctx, t)
+
+	defer func() {
+		assert.NoError(t, db.ClearCollections(pod.Collection))
+	}()
+
+	const namespace = "evergreen"
+	checkTerminationJobEnqueued := func(ctx context.Context, t *testing.T, j *podHealthCheckJob) bool {
+		for remoteQueueJob := range j.env.RemoteQueue().JobInfo(ctx) {
+			if remoteQueueJob.Type.Name == podTerminationJobName {
+				return true
+			}
+		}
+		return false
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, j *podHealthCheckJob, server *cloud.MockKubernetesAPIServer){
+		"NoopsForRunningCloudPod": func(ctx context.Context, t *testing.T, j *podHealthCheckJob, server *cloud.MockKubernetesAPIServer) {
+			server.SetPodPhase(namespace, j.pod.Resources.ExternalID, cloud.KubernetesPodPhaseRunning)
+
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+			assert.False(t, checkTerminationJobEnqueued(ctx, t, j), "should not enqueue pod termination job for healthy pod")
+		},
+		"EnqueuesPodTerminationJobForFailedCloudPod": func(ctx context.Context, t *testing.T, j *podHealthCheckJob, server *cloud.MockKubernetesAPIServer) {
+			server.SetPodPhase(namespace, j.pod.Resources.ExternalID, cloud.KubernetesPodPhaseFailed)
+
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+			assert.True(t, checkTerminationJobEnqueued(ctx, t, j), "should enqueue pod termination job for unhealthy pod")
+		},
+		"EnqueuesPodTerminationJobForMissingCloudPod": func(ctx context.Context, t *testing.T, j *podHealthCheckJob, server *cloud.MockKubernetesAPIServer) {
+			j.pod.Resources.ExternalID = "nonexistent"
+
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+			assert.True(t, checkTerminationJobEnqueued(ctx, t, j), "should enqueue pod termination job for missing pod")
+		},
+		"FailsWhenAPIServerErrors": func(ctx context.Context, t *testing.T, j *podHealthCheckJob, server *cloud.MockKubernetesAPIServer) {
+			server.FailRequests = true
+
+			j.Run(ctx)
+			assert.Error(t, j.Error())
+			assert.False(t, checkTerminationJobEnqueued(ctx, t, j))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			tctx, tcancel := context.WithTimeout(ctx, 30*time.Second)
+			defer tcancel()
+			tctx = testutil.TestSpan(tctx, t)
+
+			require.NoError(t, db.ClearCollections(pod.Collection))
+
+			server := cloud.NewMockKubernetesAPIServer()
+			defer server.Close()
+
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(tctx))
+
+			p := pod.Pod{
+				ID:            "pod_id",
+				Status:        pod.StatusRunning,
+				Provider:      evergreen.PodProviderKubernetes,
+				ContainerPool: "pool",
+				TimeInfo: pod.TimeInfo{
+					LastCommunicated: time.Now().Add(-time.Hour),
+				},
+			}
+			kp := cloud.KubernetesPod{Metadata: cloud.KubernetesObjectMeta{Name: cloud.KubernetesPodName(p.ID)}}
+			server.SetPod(namespace, kp)
+			p.Resources = cloud.ImportKubernetesPodResources(namespace, kp, cloud.KubernetesPodSecretName(p.ID))
+			require.NoError(t, p.Insert())
+
+			j, ok := NewPodHealthCheckJob(p.ID, time.Now()).(*podHealthCheckJob)
+			require.True(t, ok)
+			j.env = env
+			j.pod = &p
+			client, err := cloud.NewKubernetesClient(server.Config(namespace))
+			require.NoError(t, err)
+			j.k8sClient = client
+
+			tCase(tctx, t, j, server)
+		})
+	}
+}
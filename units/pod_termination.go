@@ -36,6 +36,7 @@ type podTerminationJob struct {
 	pod       *pod.Pod
 	ecsClient cocoa.ECSClient
 	ecsPod    cocoa.ECSPod
+	k8sClient cloud.KubernetesClient
 	env       evergreen.Environment
 }
 
@@ -92,6 +93,12 @@ func (j *podTerminationJob) Run(ctx context.Context) {
 			"job":                j.ID(),
 		})
 	case pod.StatusStarting, pod.StatusRunning, pod.StatusDecommissioned:
+		if j.k8sClient != nil {
+			if err := j.deleteKubernetesResources(ctx); err != nil {
+				j.AddError(errors.Wrap(err, "deleting Kubernetes pod resources"))
+				return
+			}
+		}
 		if j.ecsPod != nil {
 			if err := j.ecsPod.Delete(ctx); err != nil {
 				j.AddError(errors.Wrap(err, "deleting pod resources"))
@@ -134,7 +141,7 @@ func (j *podTerminationJob) populateIfUnset(ctx context.Context) error {
 		j.env = evergreen.GetEnvironment()
 	}
 
-	if j.ecsPod != nil && j.pod != nil {
+	if (j.ecsPod != nil || j.k8sClient != nil) && j.pod != nil {
 		return nil
 	}
 
@@ -158,6 +165,17 @@ func (j *podTerminationJob) populateIfUnset(ctx context.Context) error {
 
 	settings := j.env.Settings()
 
+	if j.pod.IsKubernetes() {
+		if j.k8sClient == nil {
+			client, err := cloud.MakeKubernetesClient(settings, j.pod.ContainerPool)
+			if err != nil {
+				return errors.Wrap(err, "initializing Kubernetes client")
+			}
+			j.k8sClient = client
+		}
+		return nil
+	}
+
 	if j.ecsClient == nil {
 		client, err := cloud.MakeECSClient(ctx, settings)
 		if err != nil {
@@ -177,6 +195,25 @@ func (j *podTerminationJob) populateIfUnset(ctx context.Context) error {
 	return nil
 }
 
+// deleteKubernetesResources deletes the Kubernetes pod along with the secrets
+// that its containers own.
+func (j *podTerminationJob) deleteKubernetesResources(ctx context.Context) error {
+	if j.pod.Resources.ExternalID != "" {
+		if err := j.k8sClient.DeletePod(ctx, j.pod.Resources.ExternalID); err != nil {
+			return err
+		}
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for _, c := range j.pod.Resources.Containers {
+		for _, secretID := range c.SecretIDs {
+			catcher.Wrapf(j.k8sClient.DeleteSecret(ctx, secretID), "deleting secret '%s'", secretID)
+		}
+	}
+
+	return catcher.Resolve()
+}
+
 // fixStrandedTasks fixes tasks that are in an invalid state due to termination
 // of this pod. If the pod is already running a task, that task is reset so that
 // it can re-run if possible. If the pod is part of a dispatcher that will have
@@ -388,6 +388,93 @@ func TestPodTerminationJob(t *testing.T) {
 	}
 }
 
+func TestPodTerminationJobWithKubernetesPod(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = testutil.TestSpan(ctx, t)
+
+	defer func() {
+		assert.NoError(t, db.ClearCollections(pod.Collection, dispatcher.Collection, event.EventCollection))
+	}()
+
+	const namespace = "evergreen"
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, j *podTerminationJob, server *cloud.MockKubernetesAPIServer){
+		"TerminatesAndDeletesResourcesForRunningPod": func(ctx context.Context, t *testing.T, j *podTerminationJob, server *cloud.MockKubernetesAPIServer) {
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			_, ok := server.GetPod(namespace, cloud.KubernetesPodName(j.PodID))
+			assert.False(t, ok, "should have deleted the Kubernetes pod")
+			_, ok = server.GetSecret(namespace, cloud.KubernetesPodSecretName(j.PodID))
+			assert.False(t, ok, "should have deleted the pod's secret")
+
+			dbPod, err := pod.FindOneByID(ctx, j.PodID)
+			require.NoError(t, err)
+			require.NotZero(t, dbPod)
+			assert.Equal(t, pod.StatusTerminated, dbPod.Status)
+		},
+		"SucceedsWhenCloudPodIsAlreadyDeleted": func(ctx context.Context, t *testing.T, j *podTerminationJob, server *cloud.MockKubernetesAPIServer) {
+			require.NoError(t, j.k8sClient.DeletePod(ctx, cloud.KubernetesPodName(j.PodID)))
+
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			dbPod, err := pod.FindOneByID(ctx, j.PodID)
+			require.NoError(t, err)
+			require.NotZero(t, dbPod)
+			assert.Equal(t, pod.StatusTerminated, dbPod.Status)
+		},
+		"FailsWhenAPIServerErrors": func(ctx context.Context, t *testing.T, j *podTerminationJob, server *cloud.MockKubernetesAPIServer) {
+			server.FailRequests = true
+
+			j.Run(ctx)
+			assert.Error(t, j.Error())
+
+			dbPod, err := pod.FindOneByID(ctx, j.PodID)
+			require.NoError(t, err)
+			require.NotZero(t, dbPod)
+			assert.Equal(t, pod.StatusRunning, dbPod.Status)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			tctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			tctx = testutil.TestSpan(tctx, t)
+
+			require.NoError(t, db.ClearCollections(pod.Collection, dispatcher.Collection, event.EventCollection))
+
+			server := cloud.NewMockKubernetesAPIServer()
+			defer server.Close()
+
+			p := pod.Pod{
+				ID:            "id",
+				Status:        pod.StatusRunning,
+				Provider:      evergreen.PodProviderKubernetes,
+				ContainerPool: "pool",
+			}
+			kp := cloud.KubernetesPod{Metadata: cloud.KubernetesObjectMeta{Name: cloud.KubernetesPodName(p.ID)}}
+			server.SetPod(namespace, kp)
+			secretName := cloud.KubernetesPodSecretName(p.ID)
+			server.SetSecret(namespace, cloud.KubernetesSecret{Metadata: cloud.KubernetesObjectMeta{Name: secretName}})
+			p.Resources = cloud.ImportKubernetesPodResources(namespace, kp, secretName)
+			require.NoError(t, p.Insert())
+
+			j, ok := NewPodTerminationJob(p.ID, "reason", utility.RoundPartOfMinute(0)).(*podTerminationJob)
+			require.True(t, ok)
+			j.pod = &p
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(tctx))
+			j.env = env
+			client, err := cloud.NewKubernetesClient(server.Config(namespace))
+			require.NoError(t, err)
+			j.k8sClient = client
+
+			tCase(tctx, t, j, server)
+		})
+	}
+}
+
 // generateTestingECSPod creates a pod in ECS from the given options. The
 // cluster must exist before this is called.
 func generateTestingECSPod(ctx context.Context, t *testing.T, client cocoa.ECSClient, cluster string, creationOpts pod.TaskContainerCreationOptions) cocoa.ECSPod {
@@ -93,7 +93,7 @@ func (j *repotrackerJob) Run(ctx context.Context) {
 		return
 	}
 
-	if !repotracker.CheckGithubAPIResources(ctx) {
+	if ref.IsHostedInGitHub() && !repotracker.CheckGithubAPIResources(ctx) {
 		j.AddError(errors.Errorf("skipping repotracker run for project '%s' because of GitHub API limit issues", j.ProjectID))
 		return
 	}
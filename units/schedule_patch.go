@@ -56,6 +56,9 @@ func SchedulePatch(ctx context.Context, env evergreen.Environment, patchId strin
 	}
 	_, err = model.FinalizePatch(newCxt, p, p.GetRequester())
 	if err != nil {
+		if model.IsCostLimitExceeded(err) {
+			return http.StatusForbidden, errors.Wrap(err, "finalizing patch")
+		}
 		return http.StatusInternalServerError, errors.Wrap(err, "finalizing patch")
 	}
 
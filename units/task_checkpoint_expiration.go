@@ -0,0 +1,118 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/checkpoint"
+	"github.com/evergreen-ci/pail"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	taskCheckpointExpirationJobName = "task-checkpoint-expiration"
+
+	// taskCheckpointTTL is how long task checkpoints are kept. A task can
+	// only resume from a checkpoint on its next execution, which starts
+	// soon after the previous one's host is lost, so older checkpoints can
+	// no longer be used.
+	taskCheckpointTTL = 7 * 24 * time.Hour
+)
+
+func init() {
+	registry.AddJobType(taskCheckpointExpirationJobName, func() amboy.Job {
+		return makeTaskCheckpointExpirationJob()
+	})
+}
+
+type taskCheckpointExpirationJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env        evergreen.Environment
+	openBucket func(context.Context, evergreen.BucketConfig) (pail.Bucket, error)
+}
+
+func makeTaskCheckpointExpirationJob() *taskCheckpointExpirationJob {
+	return &taskCheckpointExpirationJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    taskCheckpointExpirationJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewTaskCheckpointExpirationJob returns a job that removes task checkpoints
+// that are too old to resume from, along with their snapshots.
+func NewTaskCheckpointExpirationJob(ts string) amboy.Job {
+	j := makeTaskCheckpointExpirationJob()
+	j.SetID(fmt.Sprintf("%s.%s", taskCheckpointExpirationJobName, ts))
+	j.SetScopes([]string{taskCheckpointExpirationJobName})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *taskCheckpointExpirationJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+	if j.openBucket == nil {
+		j.openBucket = openBuildCacheBucket
+	}
+
+	conf := j.env.Settings().Buckets.BuildCache
+	if !conf.IsEnabled() {
+		return
+	}
+
+	checkpoints, err := checkpoint.FindCreatedBefore(ctx, time.Now().Add(-taskCheckpointTTL))
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	if len(checkpoints) == 0 {
+		return
+	}
+
+	bucket, err := j.openBucket(ctx, conf.Bucket)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "opening build cache bucket '%s'", conf.Bucket.Name))
+		return
+	}
+
+	numRemoved := 0
+	for _, c := range checkpoints {
+		if err := ctx.Err(); err != nil {
+			j.AddError(err)
+			break
+		}
+
+		snapshotKey := apimodels.TaskCheckpointSnapshotKey(c.ProjectID, c.ID, c.SHA256)
+		if err := bucket.Remove(ctx, snapshotKey); err != nil {
+			j.AddError(errors.Wrapf(err, "removing snapshot '%s'", snapshotKey))
+			continue
+		}
+		if err := checkpoint.Remove(ctx, c.ID); err != nil {
+			j.AddError(err)
+			continue
+		}
+		numRemoved++
+	}
+
+	grip.Info(message.Fields{
+		"message":     "expired task checkpoints",
+		"job_id":      j.ID(),
+		"num_expired": len(checkpoints),
+		"num_removed": numRemoved,
+	})
+}
@@ -0,0 +1,65 @@
+package units
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/checkpoint"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskCheckpointExpirationJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	bucketConf := evergreen.BucketConfig{Name: t.TempDir(), Type: evergreen.BucketTypeLocal}
+	env.Settings().Buckets.BuildCache = evergreen.BuildCacheConfig{Bucket: bucketConf}
+	bucket, err := openBuildCacheBucket(ctx, bucketConf)
+	require.NoError(t, err)
+
+	require.NoError(t, db.ClearCollections(checkpoint.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(checkpoint.Collection))
+	}()
+
+	for taskID, createdAt := range map[string]time.Time{
+		"expired": time.Now().Add(-2 * taskCheckpointTTL),
+		"recent":  time.Now().Add(-time.Hour),
+	} {
+		require.NoError(t, db.Insert(checkpoint.Collection, checkpoint.Checkpoint{
+			ID:        taskID,
+			ProjectID: "project",
+			Step:      "build",
+			SHA256:    taskID,
+			CreatedAt: createdAt,
+		}))
+		require.NoError(t, bucket.Put(ctx, apimodels.TaskCheckpointSnapshotKey("project", taskID, taskID), strings.NewReader("snapshot")))
+	}
+
+	j, ok := NewTaskCheckpointExpirationJob("ts").(*taskCheckpointExpirationJob)
+	require.True(t, ok)
+	j.env = env
+	j.Run(ctx)
+	require.NoError(t, j.Error())
+
+	for taskID, shouldExist := range map[string]bool{
+		"expired": false,
+		"recent":  true,
+	} {
+		c, err := checkpoint.FindOne(ctx, taskID)
+		require.NoError(t, err)
+		assert.Equal(t, shouldExist, c != nil, taskID)
+
+		exists, err := bucket.Exists(ctx, apimodels.TaskCheckpointSnapshotKey("project", taskID, taskID))
+		require.NoError(t, err)
+		assert.Equal(t, shouldExist, exists, taskID)
+	}
+}
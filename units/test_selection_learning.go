@@ -0,0 +1,196 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/model/testselection"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	testSelectionLearningJobName = "test-selection-learning"
+
+	// testSelectionLearningWindow is how far back the job looks for
+	// finished patch tasks the first time it runs for a project.
+	testSelectionLearningWindow = 7 * 24 * time.Hour
+	// testSelectionLearningMaxTasks is the maximum number of finished patch
+	// tasks a single job learns from. Any remaining tasks are learned from by
+	// the next job.
+	testSelectionLearningMaxTasks = 1000
+)
+
+func init() {
+	registry.AddJobType(testSelectionLearningJobName, func() amboy.Job {
+		return makeTestSelectionLearningJob()
+	})
+}
+
+type testSelectionLearningJob struct {
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env evergreen.Environment
+}
+
+func makeTestSelectionLearningJob() *testSelectionLearningJob {
+	return &testSelectionLearningJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    testSelectionLearningJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewTestSelectionLearningJob returns a job that learns which of the project's
+// tests fail when particular files are changed from the project's recently
+// finished patch tasks.
+func NewTestSelectionLearningJob(projectID, ts string) amboy.Job {
+	j := makeTestSelectionLearningJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", testSelectionLearningJobName, projectID, ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", testSelectionLearningJobName, projectID)})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *testSelectionLearningJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	status, err := testselection.GetLearningStatus(ctx, j.env, j.ProjectID)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	learnedUntil := status.LearnedUntil
+	if windowStart := time.Now().Add(-testSelectionLearningWindow); learnedUntil.Before(windowStart) {
+		learnedUntil = windowStart
+	}
+
+	tasks, err := task.FindAll(ctx, db.Query(bson.M{
+		task.ProjectKey:     j.ProjectID,
+		task.RequesterKey:   bson.M{"$in": evergreen.PatchRequesters},
+		task.FinishTimeKey:  bson.M{"$gt": learnedUntil},
+		task.StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		task.DisplayOnlyKey: bson.M{"$ne": true},
+	}).Sort([]string{task.FinishTimeKey}).Limit(testSelectionLearningMaxTasks))
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding finished patch tasks for project '%s'", j.ProjectID))
+		return
+	}
+	if len(tasks) == 0 {
+		return
+	}
+
+	changedFiles, err := j.getChangedFiles(tasks)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+
+	numLearned := 0
+	for _, t := range tasks {
+		files := changedFiles[t.Version]
+		if len(files) == 0 {
+			continue
+		}
+
+		failedTests, err := j.getFailedTests(ctx, t)
+		if err != nil {
+			// A single task's results being unavailable should not prevent
+			// learning from the rest of the project's tasks.
+			grip.Warning(message.WrapError(err, message.Fields{
+				"message": "could not get failed tests for task",
+				"job_id":  j.ID(),
+				"project": j.ProjectID,
+				"task":    t.Id,
+			}))
+			continue
+		}
+
+		if err = testselection.RecordTaskRun(ctx, j.env, testselection.TaskRun{
+			ProjectID:    j.ProjectID,
+			TaskName:     t.DisplayName,
+			ChangedFiles: files,
+			FailedTests:  failedTests,
+		}); err != nil {
+			j.AddError(err)
+			return
+		}
+		numLearned++
+	}
+
+	if err = testselection.SetLearnedUntil(ctx, j.env, j.ProjectID, tasks[len(tasks)-1].FinishTime); err != nil {
+		j.AddError(err)
+		return
+	}
+
+	grip.Info(message.Fields{
+		"message":     "learned test selection data from patch tasks",
+		"job_id":      j.ID(),
+		"project":     j.ProjectID,
+		"num_tasks":   len(tasks),
+		"num_learned": numLearned,
+	})
+}
+
+// getChangedFiles returns the files changed by the patch of each of the tasks'
+// versions.
+func (j *testSelectionLearningJob) getChangedFiles(tasks []task.Task) (map[string][]string, error) {
+	versionSet := map[string]bool{}
+	var versions []string
+	for _, t := range tasks {
+		if !versionSet[t.Version] {
+			versionSet[t.Version] = true
+			versions = append(versions, t.Version)
+		}
+	}
+
+	patches, err := patch.Find(patch.ByVersions(versions).Project(patch.ExcludePatchDiff))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding patches for tasks")
+	}
+
+	changedFiles := map[string][]string{}
+	for _, p := range patches {
+		changedFiles[p.Version] = p.FilesChanged()
+	}
+
+	return changedFiles, nil
+}
+
+// getFailedTests returns the display names of the task's failed tests.
+func (j *testSelectionLearningJob) getFailedTests(ctx context.Context, t task.Task) ([]string, error) {
+	if t.Status != evergreen.TaskFailed {
+		return nil, nil
+	}
+
+	results, err := t.GetTestResults(ctx, j.env, &testresult.FilterOptions{Statuses: []string{evergreen.TestFailedStatus}})
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting test results for task '%s'", t.Id)
+	}
+
+	failedTests := make([]string, 0, len(results.Results))
+	for _, result := range results.Results {
+		failedTests = append(failedTests, result.GetDisplayTestName())
+	}
+
+	return failedTests, nil
+}
@@ -0,0 +1,107 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/model/testselection"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestSelectionLearningJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+
+	clearAll := func() {
+		require.NoError(t, db.ClearCollections(task.Collection, patch.Collection, testselection.FileStatsCollection, testselection.TestFailuresCollection, testselection.StatusCollection))
+		require.NoError(t, testresult.ClearLocal(ctx, env))
+	}
+	clearAll()
+	defer clearAll()
+
+	insertPatch := func(t *testing.T, files ...string) string {
+		p := patch.Patch{Id: mgobson.NewObjectId(), Project: "project"}
+		p.Version = p.Id.Hex()
+		var summaries []thirdparty.Summary
+		for _, file := range files {
+			summaries = append(summaries, thirdparty.Summary{Name: file})
+		}
+		p.Patches = []patch.ModulePatch{{PatchSet: patch.PatchSet{Summary: summaries}}}
+		require.NoError(t, p.Insert())
+		return p.Version
+	}
+	insertTask := func(t *testing.T, id, version string, finishTime time.Time, failedTests ...string) {
+		tsk := task.Task{
+			Id:             id,
+			Project:        "project",
+			DisplayName:    "task",
+			Version:        version,
+			Requester:      evergreen.PatchVersionRequester,
+			Status:         evergreen.TaskSucceeded,
+			FinishTime:     finishTime,
+			ResultsService: testresult.TestResultsServiceLocal,
+		}
+		if len(failedTests) > 0 {
+			tsk.Status = evergreen.TaskFailed
+		}
+		require.NoError(t, tsk.Insert())
+		for _, testName := range failedTests {
+			require.NoError(t, testresult.InsertLocal(ctx, env, testresult.TestResult{
+				TaskID:   id,
+				TestName: testName,
+				Status:   evergreen.TestFailedStatus,
+			}))
+		}
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"LearnsFromFinishedPatchTasks": func(t *testing.T) {
+			now := time.Now()
+			insertTask(t, "t0", insertPatch(t, "a.go"), now.Add(-2*time.Hour), "test_a")
+			insertTask(t, "t1", insertPatch(t, "a.go"), now.Add(-time.Hour))
+
+			j := NewTestSelectionLearningJob("project", "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			scores, err := testselection.Rank(ctx, env, testselection.RankOptions{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go"}})
+			require.NoError(t, err)
+			require.Len(t, scores, 1)
+			assert.Equal(t, "test_a", scores[0].TestName)
+			assert.Equal(t, 0.5, scores[0].Score)
+
+			status, err := testselection.GetLearningStatus(ctx, env, "project")
+			require.NoError(t, err)
+			assert.WithinDuration(t, now.Add(-time.Hour), status.LearnedUntil, time.Second)
+		},
+		"SkipsAlreadyLearnedTasks": func(t *testing.T) {
+			now := time.Now()
+			require.NoError(t, testselection.SetLearnedUntil(ctx, env, "project", now.Add(-time.Hour)))
+			insertTask(t, "t0", insertPatch(t, "a.go"), now.Add(-2*time.Hour), "test_a")
+
+			j := NewTestSelectionLearningJob("project", "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			scores, err := testselection.Rank(ctx, env, testselection.RankOptions{ProjectID: "project", TaskName: "task", ChangedFiles: []string{"a.go"}})
+			require.NoError(t, err)
+			assert.Empty(t, scores)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			clearAll()
+			tCase(t)
+		})
+	}
+}
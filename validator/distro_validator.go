@@ -31,6 +31,7 @@ var distroSyntaxValidators = []distroValidator{
 	ensureValidArch,
 	ensureValidBootstrapSettings,
 	ensureValidStaticBootstrapSettings,
+	ensureValidLibvirtBootstrapSettings,
 	ensureHasNoUnauthorizedCharacters,
 	ensureHasValidHostAllocatorSettings,
 	ensureHasValidPlannerSettings,
@@ -277,6 +278,21 @@ func ensureValidStaticBootstrapSettings(ctx context.Context, d *distro.Distro, s
 	return nil
 }
 
+// ensureValidLibvirtBootstrapSettings checks that libvirt hosts are
+// bootstrapped with one of the allowed methods. Libvirt VMs are cloned from a
+// base image without any user data, so they must be provisioned over SSH.
+func ensureValidLibvirtBootstrapSettings(ctx context.Context, d *distro.Distro, s *evergreen.Settings) ValidationErrors {
+	if d.Provider == evergreen.ProviderNameLibvirt && d.BootstrapSettings.Method == distro.BootstrapMethodUserData {
+		return ValidationErrors{
+			{
+				Message: fmt.Sprintf("libvirt distro %s cannot be bootstrapped with user data", d.Id),
+				Level:   Error,
+			},
+		}
+	}
+	return nil
+}
+
 func ensureHasNonZeroID(ctx context.Context, d *distro.Distro, s *evergreen.Settings) ValidationErrors {
 	if d == nil {
 		return ValidationErrors{{Error, "distro cannot be nil"}}
@@ -659,6 +659,23 @@ func TestEnsureValidStaticBootstrapSettings(t *testing.T) {
 	assert.NotNil(t, ensureValidStaticBootstrapSettings(ctx, &d, &evergreen.Settings{}))
 }
 
+func TestEnsureValidLibvirtBootstrapSettings(t *testing.T) {
+	ctx := context.Background()
+	d := distro.Distro{
+		Provider: evergreen.ProviderNameLibvirt,
+	}
+	for _, method := range []string{
+		distro.BootstrapMethodLegacySSH,
+		distro.BootstrapMethodSSH,
+	} {
+		d.BootstrapSettings.Method = method
+		assert.Nil(t, ensureValidLibvirtBootstrapSettings(ctx, &d, &evergreen.Settings{}))
+	}
+
+	d.BootstrapSettings.Method = distro.BootstrapMethodUserData
+	assert.NotNil(t, ensureValidLibvirtBootstrapSettings(ctx, &d, &evergreen.Settings{}))
+}
+
 func TestEnsureHasValidVirtualWorkstationSettings(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
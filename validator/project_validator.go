@@ -1451,7 +1451,69 @@ func validateCommands(section string, project *model.Project,
 				Message: fmt.Sprintf("cannot specify both command '%s' and function '%s'", cmd.Command, cmd.Function),
 			})
 		}
+		errs = append(errs, validateCommandRetryPolicy(section, commandName, project, cmd)...)
+		if cmd.Command == "checkpoint.save" && section != "tasks" && section != "functions" {
+			errs = append(errs, ValidationError{
+				Level:   Warning,
+				Message: fmt.Sprintf("%s section in %s: checkpoints only skip a task's own commands when it resumes, so checkpoint.save has no effect here", section, commandName),
+			})
+		}
+	}
+	return errs
+}
+
+// validateCommandRetryPolicy checks that a command's retry policy is
+// compatible with the commands it applies to. The policy's own values are
+// already checked when the command is rendered.
+func validateCommandRetryPolicy(section, commandName string, project *model.Project, cmd model.PluginCommandConf) ValidationErrors {
+	if cmd.Retry == nil {
+		return nil
+	}
+
+	errs := ValidationErrors{}
+	retried := []model.PluginCommandConf{cmd}
+	if cmd.Function != "" {
+		if fn, ok := project.Functions[cmd.Function]; ok && fn != nil {
+			retried = fn.List()
+		}
 	}
+
+	if len(cmd.Retry.OnExitCodes) > 0 {
+		var reportsExitCodes bool
+		for _, c := range retried {
+			if c.Command == "shell.exec" || c.Command == "subprocess.exec" {
+				reportsExitCodes = true
+				break
+			}
+		}
+		if !reportsExitCodes {
+			errs = append(errs, ValidationError{
+				Level:   Error,
+				Message: fmt.Sprintf("%s section in %s: retry on_exit_codes can only be used with shell.exec or subprocess.exec commands", section, commandName),
+			})
+		}
+	}
+	if cmd.Retry.Attempts == 1 {
+		errs = append(errs, ValidationError{
+			Level:   Warning,
+			Message: fmt.Sprintf("%s section in %s: retry policy has only 1 attempt, so the command will never be retried", section, commandName),
+		})
+	}
+	for _, c := range retried {
+		if continueOnErr, _ := c.Params["continue_on_err"].(bool); continueOnErr {
+			errs = append(errs, ValidationError{
+				Level:   Warning,
+				Message: fmt.Sprintf("%s section in %s: retry policy has no effect on command '%s' because it sets continue_on_err", section, commandName, c.Command),
+			})
+		}
+		if background, _ := c.Params["background"].(bool); background {
+			errs = append(errs, ValidationError{
+				Level:   Warning,
+				Message: fmt.Sprintf("%s section in %s: retry policy has no effect on command '%s' because it runs in the background", section, commandName, c.Command),
+			})
+		}
+	}
+
 	return errs
 }
 
@@ -3546,6 +3546,150 @@ tasks:
 			So(validationErrs, ShouldResemble, ValidationErrors{})
 			So(len(validationErrs.AtLevel(Error)), ShouldEqual, 0)
 		})
+		Convey("an error should be thrown if a command has an invalid condition", func() {
+			project := &model.Project{
+				Tasks: []model.ProjectTask{
+					{
+						Name: "compile",
+						Commands: []model.PluginCommandConf{
+							{
+								Command: "shell.exec",
+								If:      "${is_patch} == true",
+								Params:  map[string]any{"script": "echo hi"},
+							},
+							{
+								Command: "shell.exec",
+								If:      "failure() &&",
+								Params:  map[string]any{"script": "echo hi"},
+							},
+						},
+					},
+				},
+			}
+			validationErrs := validatePluginCommands(project)
+			So(len(validationErrs.AtLevel(Error)), ShouldEqual, 1)
+			So(validationErrs.AtLevel(Error)[0].Message, ShouldContainSubstring, "invalid condition 'failure() &&'")
+		})
+		Convey("an error should be thrown if a command has an invalid retry policy", func() {
+			project := &model.Project{
+				Tasks: []model.ProjectTask{
+					{
+						Name: "compile",
+						Commands: []model.PluginCommandConf{
+							{
+								Command: "shell.exec",
+								Retry:   &model.CommandRetryPolicy{Attempts: 3, BackoffSecs: 10, OnExitCodes: []int{1}},
+								Params:  map[string]any{"script": "echo hi"},
+							},
+							{
+								Command: "shell.exec",
+								Retry:   &model.CommandRetryPolicy{Attempts: model.MaxCommandRetryAttempts + 1},
+								Params:  map[string]any{"script": "echo hi"},
+							},
+						},
+					},
+				},
+			}
+			validationErrs := validatePluginCommands(project)
+			So(len(validationErrs.AtLevel(Error)), ShouldEqual, 1)
+			So(validationErrs.AtLevel(Error)[0].Message, ShouldContainSubstring, "invalid retry policy")
+		})
+		Convey("an error should be thrown if a retry policy uses exit codes for a command without them", func() {
+			project := &model.Project{
+				Functions: map[string]*model.YAMLCommandSet{
+					"parse": {
+						SingleCommand: &model.PluginCommandConf{
+							Command: "gotest.parse_files",
+							Params:  map[string]any{"files": []any{"test"}},
+						},
+					},
+					"run": {
+						SingleCommand: &model.PluginCommandConf{
+							Command: "subprocess.exec",
+							Params:  map[string]any{"binary": "make"},
+						},
+					},
+				},
+				Tasks: []model.ProjectTask{
+					{
+						Name: "compile",
+						Commands: []model.PluginCommandConf{
+							{
+								Function: "parse",
+								Retry:    &model.CommandRetryPolicy{Attempts: 3, OnExitCodes: []int{1}},
+							},
+							{
+								Function: "run",
+								Retry:    &model.CommandRetryPolicy{Attempts: 3, OnExitCodes: []int{1}},
+							},
+						},
+					},
+				},
+			}
+			validationErrs := validatePluginCommands(project)
+			So(len(validationErrs.AtLevel(Error)), ShouldEqual, 1)
+			So(validationErrs.AtLevel(Error)[0].Message, ShouldContainSubstring, "'parse' function")
+			So(validationErrs.AtLevel(Error)[0].Message, ShouldContainSubstring, "on_exit_codes")
+		})
+		Convey("a warning should be returned if a checkpoint is saved outside of a task's commands", func() {
+			project := &model.Project{
+				Pre: &model.YAMLCommandSet{
+					SingleCommand: &model.PluginCommandConf{
+						Command: "checkpoint.save",
+						Params:  map[string]any{"step": "setup"},
+					},
+				},
+				Tasks: []model.ProjectTask{
+					{
+						Name: "compile",
+						Commands: []model.PluginCommandConf{
+							{
+								Command: "checkpoint.save",
+								Params:  map[string]any{"step": "compile"},
+							},
+						},
+					},
+				},
+			}
+			validationErrs := validatePluginCommands(project)
+			So(len(validationErrs.AtLevel(Error)), ShouldEqual, 0)
+			warnings := validationErrs.AtLevel(Warning)
+			So(len(warnings), ShouldEqual, 1)
+			So(warnings[0].Message, ShouldContainSubstring, "pre section in 'checkpoint.save' command")
+		})
+		Convey("a warning should be returned if a retry policy cannot retry the command", func() {
+			project := &model.Project{
+				Tasks: []model.ProjectTask{
+					{
+						Name: "compile",
+						Commands: []model.PluginCommandConf{
+							{
+								Command: "shell.exec",
+								Retry:   &model.CommandRetryPolicy{Attempts: 1},
+								Params:  map[string]any{"script": "echo hi"},
+							},
+							{
+								Command: "shell.exec",
+								Retry:   &model.CommandRetryPolicy{Attempts: 2},
+								Params:  map[string]any{"script": "echo hi", "continue_on_err": true},
+							},
+							{
+								Command: "shell.exec",
+								Retry:   &model.CommandRetryPolicy{Attempts: 2},
+								Params:  map[string]any{"script": "echo hi", "background": true},
+							},
+						},
+					},
+				},
+			}
+			validationErrs := validatePluginCommands(project)
+			So(len(validationErrs.AtLevel(Error)), ShouldEqual, 0)
+			warnings := validationErrs.AtLevel(Warning)
+			So(len(warnings), ShouldEqual, 3)
+			So(warnings[0].Message, ShouldContainSubstring, "only 1 attempt")
+			So(warnings[1].Message, ShouldContainSubstring, "continue_on_err")
+			So(warnings[2].Message, ShouldContainSubstring, "background")
+		})
 		Convey("an error should be thrown if a shell.exec command is missing params", func() {
 			project := &model.Project{
 				Functions: map[string]*model.YAMLCommandSet{